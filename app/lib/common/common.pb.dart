@@ -365,6 +365,9 @@ class AlertDetails extends $pb.GeneratedMessage {
     $core.String? geoCountry,
     $core.String? geoCity,
     $core.String? geoIsp,
+    $core.String? summary,
+    $core.Iterable<$core.MapEntry<$core.String, $core.String>>? fields,
+    ApprovalContext? context,
   }) {
     final result = create();
     if (sourceIp != null) result.sourceIp = sourceIp;
@@ -375,6 +378,9 @@ class AlertDetails extends $pb.GeneratedMessage {
     if (geoCountry != null) result.geoCountry = geoCountry;
     if (geoCity != null) result.geoCity = geoCity;
     if (geoIsp != null) result.geoIsp = geoIsp;
+    if (summary != null) result.summary = summary;
+    if (fields != null) result.fields.addEntries(fields);
+    if (context != null) result.context = context;
     return result;
   }
 
@@ -399,6 +405,14 @@ class AlertDetails extends $pb.GeneratedMessage {
     ..aOS(6, _omitFieldNames ? '' : 'geoCountry')
     ..aOS(7, _omitFieldNames ? '' : 'geoCity')
     ..aOS(8, _omitFieldNames ? '' : 'geoIsp')
+    ..aOS(9, _omitFieldNames ? '' : 'summary')
+    ..m<$core.String, $core.String>(10, _omitFieldNames ? '' : 'fields',
+        entryClassName: 'AlertDetails.FieldsEntry',
+        keyFieldType: $pb.PbFieldType.OS,
+        valueFieldType: $pb.PbFieldType.OS,
+        packageName: const $pb.PackageName('nitella'))
+    ..aOM<ApprovalContext>(11, _omitFieldNames ? '' : 'context',
+        subBuilder: ApprovalContext.create)
     ..hasRequiredFields = false;
 
   @$core.Deprecated('See https://github.com/google/protobuf.dart/issues/998.')
@@ -491,6 +505,187 @@ class AlertDetails extends $pb.GeneratedMessage {
   $core.bool hasGeoIsp() => $_has(7);
   @$pb.TagNumber(8)
   void clearGeoIsp() => $_clearField(8);
+
+  @$pb.TagNumber(9)
+  $core.String get summary => $_getSZ(8);
+  @$pb.TagNumber(9)
+  set summary($core.String value) => $_setString(8, value);
+  @$pb.TagNumber(9)
+  $core.bool hasSummary() => $_has(8);
+  @$pb.TagNumber(9)
+  void clearSummary() => $_clearField(9);
+
+  @$pb.TagNumber(10)
+  $pb.PbMap<$core.String, $core.String> get fields => $_getMap(9);
+
+  @$pb.TagNumber(11)
+  ApprovalContext get context => $_getN(10);
+  @$pb.TagNumber(11)
+  set context(ApprovalContext value) => $_setField(11, value);
+  @$pb.TagNumber(11)
+  $core.bool hasContext() => $_has(10);
+  @$pb.TagNumber(11)
+  void clearContext() => $_clearField(11);
+  @$pb.TagNumber(11)
+  ApprovalContext ensureContext() => $_ensure(10);
+}
+
+/// ApprovalContext is what a node knows about an approval request's source,
+/// gathered when the request is raised.
+class ApprovalContext extends $pb.GeneratedMessage {
+  factory ApprovalContext({
+    $fixnum.Int64? connectionCount,
+    $fixnum.Int64? blockedCount,
+    $fixnum.Int64? firstSeenUnix,
+    $fixnum.Int64? lastSeenUnix,
+    $core.int? priorApprovals,
+    $core.int? priorDenials,
+    $core.String? reverseDns,
+    $core.Iterable<$core.String>? ipSets,
+    $core.String? tlsSubject,
+    $core.String? tlsCn,
+  }) {
+    final result = create();
+    if (connectionCount != null) result.connectionCount = connectionCount;
+    if (blockedCount != null) result.blockedCount = blockedCount;
+    if (firstSeenUnix != null) result.firstSeenUnix = firstSeenUnix;
+    if (lastSeenUnix != null) result.lastSeenUnix = lastSeenUnix;
+    if (priorApprovals != null) result.priorApprovals = priorApprovals;
+    if (priorDenials != null) result.priorDenials = priorDenials;
+    if (reverseDns != null) result.reverseDns = reverseDns;
+    if (ipSets != null) result.ipSets.addAll(ipSets);
+    if (tlsSubject != null) result.tlsSubject = tlsSubject;
+    if (tlsCn != null) result.tlsCn = tlsCn;
+    return result;
+  }
+
+  ApprovalContext._();
+
+  factory ApprovalContext.fromBuffer($core.List<$core.int> data,
+          [$pb.ExtensionRegistry registry = $pb.ExtensionRegistry.EMPTY]) =>
+      create()..mergeFromBuffer(data, registry);
+  factory ApprovalContext.fromJson($core.String json,
+          [$pb.ExtensionRegistry registry = $pb.ExtensionRegistry.EMPTY]) =>
+      create()..mergeFromJson(json, registry);
+
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(
+      _omitMessageNames ? '' : 'ApprovalContext',
+      package: const $pb.PackageName(_omitMessageNames ? '' : 'nitella'),
+      createEmptyInstance: create)
+    ..aInt64(1, _omitFieldNames ? '' : 'connectionCount')
+    ..aInt64(2, _omitFieldNames ? '' : 'blockedCount')
+    ..aInt64(3, _omitFieldNames ? '' : 'firstSeenUnix')
+    ..aInt64(4, _omitFieldNames ? '' : 'lastSeenUnix')
+    ..aI(5, _omitFieldNames ? '' : 'priorApprovals')
+    ..aI(6, _omitFieldNames ? '' : 'priorDenials')
+    ..aOS(7, _omitFieldNames ? '' : 'reverseDns')
+    ..pPS(8, _omitFieldNames ? '' : 'ipSets')
+    ..aOS(9, _omitFieldNames ? '' : 'tlsSubject')
+    ..aOS(10, _omitFieldNames ? '' : 'tlsCn')
+    ..hasRequiredFields = false;
+
+  @$core.Deprecated('See https://github.com/google/protobuf.dart/issues/998.')
+  ApprovalContext clone() => deepCopy();
+  @$core.Deprecated('See https://github.com/google/protobuf.dart/issues/998.')
+  ApprovalContext copyWith(void Function(ApprovalContext) updates) =>
+      super.copyWith((message) => updates(message as ApprovalContext))
+          as ApprovalContext;
+
+  @$core.override
+  $pb.BuilderInfo get info_ => _i;
+
+  @$core.pragma('dart2js:noInline')
+  static ApprovalContext create() => ApprovalContext._();
+  @$core.override
+  ApprovalContext createEmptyInstance() => create();
+  @$core.pragma('dart2js:noInline')
+  static ApprovalContext getDefault() => _defaultInstance ??=
+      $pb.GeneratedMessage.$_defaultFor<ApprovalContext>(create);
+  static ApprovalContext? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $fixnum.Int64 get connectionCount => $_getI64(0);
+  @$pb.TagNumber(1)
+  set connectionCount($fixnum.Int64 value) => $_setInt64(0, value);
+  @$pb.TagNumber(1)
+  $core.bool hasConnectionCount() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearConnectionCount() => $_clearField(1);
+
+  @$pb.TagNumber(2)
+  $fixnum.Int64 get blockedCount => $_getI64(1);
+  @$pb.TagNumber(2)
+  set blockedCount($fixnum.Int64 value) => $_setInt64(1, value);
+  @$pb.TagNumber(2)
+  $core.bool hasBlockedCount() => $_has(1);
+  @$pb.TagNumber(2)
+  void clearBlockedCount() => $_clearField(2);
+
+  @$pb.TagNumber(3)
+  $fixnum.Int64 get firstSeenUnix => $_getI64(2);
+  @$pb.TagNumber(3)
+  set firstSeenUnix($fixnum.Int64 value) => $_setInt64(2, value);
+  @$pb.TagNumber(3)
+  $core.bool hasFirstSeenUnix() => $_has(2);
+  @$pb.TagNumber(3)
+  void clearFirstSeenUnix() => $_clearField(3);
+
+  @$pb.TagNumber(4)
+  $fixnum.Int64 get lastSeenUnix => $_getI64(3);
+  @$pb.TagNumber(4)
+  set lastSeenUnix($fixnum.Int64 value) => $_setInt64(3, value);
+  @$pb.TagNumber(4)
+  $core.bool hasLastSeenUnix() => $_has(3);
+  @$pb.TagNumber(4)
+  void clearLastSeenUnix() => $_clearField(4);
+
+  @$pb.TagNumber(5)
+  $core.int get priorApprovals => $_getIZ(4);
+  @$pb.TagNumber(5)
+  set priorApprovals($core.int value) => $_setSignedInt32(4, value);
+  @$pb.TagNumber(5)
+  $core.bool hasPriorApprovals() => $_has(4);
+  @$pb.TagNumber(5)
+  void clearPriorApprovals() => $_clearField(5);
+
+  @$pb.TagNumber(6)
+  $core.int get priorDenials => $_getIZ(5);
+  @$pb.TagNumber(6)
+  set priorDenials($core.int value) => $_setSignedInt32(5, value);
+  @$pb.TagNumber(6)
+  $core.bool hasPriorDenials() => $_has(5);
+  @$pb.TagNumber(6)
+  void clearPriorDenials() => $_clearField(6);
+
+  @$pb.TagNumber(7)
+  $core.String get reverseDns => $_getSZ(6);
+  @$pb.TagNumber(7)
+  set reverseDns($core.String value) => $_setString(6, value);
+  @$pb.TagNumber(7)
+  $core.bool hasReverseDns() => $_has(6);
+  @$pb.TagNumber(7)
+  void clearReverseDns() => $_clearField(7);
+
+  @$pb.TagNumber(8)
+  $pb.PbList<$core.String> get ipSets => $_getList(7);
+
+  @$pb.TagNumber(9)
+  $core.String get tlsSubject => $_getSZ(8);
+  @$pb.TagNumber(9)
+  set tlsSubject($core.String value) => $_setString(8, value);
+  @$pb.TagNumber(9)
+  $core.bool hasTlsSubject() => $_has(8);
+  @$pb.TagNumber(9)
+  void clearTlsSubject() => $_clearField(9);
+
+  @$pb.TagNumber(10)
+  $core.String get tlsCn => $_getSZ(9);
+  @$pb.TagNumber(10)
+  set tlsCn($core.String value) => $_setString(9, value);
+  @$pb.TagNumber(10)
+  $core.bool hasTlsCn() => $_has(9);
+  @$pb.TagNumber(10)
+  void clearTlsCn() => $_clearField(10);
 }
 
 /// GeoInfo contains geographical information for an IP address.
@@ -26,6 +26,8 @@ class ActionType extends $pb.ProtobufEnum {
       ActionType._(3, _omitEnumNames ? '' : 'ACTION_TYPE_MOCK');
   static const ActionType ACTION_TYPE_REQUIRE_APPROVAL =
       ActionType._(4, _omitEnumNames ? '' : 'ACTION_TYPE_REQUIRE_APPROVAL');
+  static const ActionType ACTION_TYPE_ALLOW_ALERT =
+      ActionType._(5, _omitEnumNames ? '' : 'ACTION_TYPE_ALLOW_ALERT');
 
   static const $core.List<ActionType> values = <ActionType>[
     ACTION_TYPE_UNSPECIFIED,
@@ -33,10 +35,11 @@ class ActionType extends $pb.ProtobufEnum {
     ACTION_TYPE_BLOCK,
     ACTION_TYPE_MOCK,
     ACTION_TYPE_REQUIRE_APPROVAL,
+    ACTION_TYPE_ALLOW_ALERT,
   ];
 
   static final $core.List<ActionType?> _byValue =
-      $pb.ProtobufEnum.$_initByValueList(values, 4);
+      $pb.ProtobufEnum.$_initByValueList(values, 5);
   static ActionType? valueOf($core.int value) =>
       value < 0 || value >= _byValue.length ? null : _byValue[value];
 
@@ -92,6 +95,36 @@ class MockPreset extends $pb.ProtobufEnum {
       MockPreset._(10, _omitEnumNames ? '' : 'MOCK_PRESET_TELNET_SECURE');
   static const MockPreset MOCK_PRESET_RAW_TARPIT =
       MockPreset._(11, _omitEnumNames ? '' : 'MOCK_PRESET_RAW_TARPIT');
+  static const MockPreset MOCK_PRESET_POSTGRES_SECURE =
+      MockPreset._(12, _omitEnumNames ? '' : 'MOCK_PRESET_POSTGRES_SECURE');
+  static const MockPreset MOCK_PRESET_POSTGRES_TARPIT =
+      MockPreset._(13, _omitEnumNames ? '' : 'MOCK_PRESET_POSTGRES_TARPIT');
+  static const MockPreset MOCK_PRESET_MONGODB_SECURE =
+      MockPreset._(14, _omitEnumNames ? '' : 'MOCK_PRESET_MONGODB_SECURE');
+  static const MockPreset MOCK_PRESET_MONGODB_TARPIT =
+      MockPreset._(15, _omitEnumNames ? '' : 'MOCK_PRESET_MONGODB_TARPIT');
+  static const MockPreset MOCK_PRESET_ELASTICSEARCH_SECURE = MockPreset._(
+      16, _omitEnumNames ? '' : 'MOCK_PRESET_ELASTICSEARCH_SECURE');
+  static const MockPreset MOCK_PRESET_ELASTICSEARCH_TARPIT = MockPreset._(
+      17, _omitEnumNames ? '' : 'MOCK_PRESET_ELASTICSEARCH_TARPIT');
+  static const MockPreset MOCK_PRESET_MEMCACHED_SECURE =
+      MockPreset._(18, _omitEnumNames ? '' : 'MOCK_PRESET_MEMCACHED_SECURE');
+  static const MockPreset MOCK_PRESET_MEMCACHED_TARPIT =
+      MockPreset._(19, _omitEnumNames ? '' : 'MOCK_PRESET_MEMCACHED_TARPIT');
+  static const MockPreset MOCK_PRESET_FTP_SECURE =
+      MockPreset._(20, _omitEnumNames ? '' : 'MOCK_PRESET_FTP_SECURE');
+  static const MockPreset MOCK_PRESET_FTP_TARPIT =
+      MockPreset._(21, _omitEnumNames ? '' : 'MOCK_PRESET_FTP_TARPIT');
+  static const MockPreset MOCK_PRESET_VNC_SECURE =
+      MockPreset._(22, _omitEnumNames ? '' : 'MOCK_PRESET_VNC_SECURE');
+  static const MockPreset MOCK_PRESET_VNC_TARPIT =
+      MockPreset._(23, _omitEnumNames ? '' : 'MOCK_PRESET_VNC_TARPIT');
+  static const MockPreset MOCK_PRESET_SMB_SECURE =
+      MockPreset._(24, _omitEnumNames ? '' : 'MOCK_PRESET_SMB_SECURE');
+  static const MockPreset MOCK_PRESET_LDAP_SECURE =
+      MockPreset._(25, _omitEnumNames ? '' : 'MOCK_PRESET_LDAP_SECURE');
+  static const MockPreset MOCK_PRESET_LDAP_TARPIT =
+      MockPreset._(26, _omitEnumNames ? '' : 'MOCK_PRESET_LDAP_TARPIT');
 
   static const $core.List<MockPreset> values = <MockPreset>[
     MOCK_PRESET_UNSPECIFIED,
@@ -106,10 +139,25 @@ class MockPreset extends $pb.ProtobufEnum {
     MOCK_PRESET_RDP_SECURE,
     MOCK_PRESET_TELNET_SECURE,
     MOCK_PRESET_RAW_TARPIT,
+    MOCK_PRESET_POSTGRES_SECURE,
+    MOCK_PRESET_POSTGRES_TARPIT,
+    MOCK_PRESET_MONGODB_SECURE,
+    MOCK_PRESET_MONGODB_TARPIT,
+    MOCK_PRESET_ELASTICSEARCH_SECURE,
+    MOCK_PRESET_ELASTICSEARCH_TARPIT,
+    MOCK_PRESET_MEMCACHED_SECURE,
+    MOCK_PRESET_MEMCACHED_TARPIT,
+    MOCK_PRESET_FTP_SECURE,
+    MOCK_PRESET_FTP_TARPIT,
+    MOCK_PRESET_VNC_SECURE,
+    MOCK_PRESET_VNC_TARPIT,
+    MOCK_PRESET_SMB_SECURE,
+    MOCK_PRESET_LDAP_SECURE,
+    MOCK_PRESET_LDAP_TARPIT,
   ];
 
   static final $core.List<MockPreset?> _byValue =
-      $pb.ProtobufEnum.$_initByValueList(values, 11);
+      $pb.ProtobufEnum.$_initByValueList(values, 26);
   static MockPreset? valueOf($core.int value) =>
       value < 0 || value >= _byValue.length ? null : _byValue[value];
 
@@ -24,6 +24,7 @@ const ActionType$json = {
     {'1': 'ACTION_TYPE_BLOCK', '2': 2},
     {'1': 'ACTION_TYPE_MOCK', '2': 3},
     {'1': 'ACTION_TYPE_REQUIRE_APPROVAL', '2': 4},
+    {'1': 'ACTION_TYPE_ALLOW_ALERT', '2': 5},
   ],
 };
 
@@ -31,7 +32,8 @@ const ActionType$json = {
 final $typed_data.Uint8List actionTypeDescriptor = $convert.base64Decode(
     'CgpBY3Rpb25UeXBlEhsKF0FDVElPTl9UWVBFX1VOU1BFQ0lGSUVEEAASFQoRQUNUSU9OX1RZUE'
     'VfQUxMT1cQARIVChFBQ1RJT05fVFlQRV9CTE9DSxACEhQKEEFDVElPTl9UWVBFX01PQ0sQAxIg'
-    'ChxBQ1RJT05fVFlQRV9SRVFVSVJFX0FQUFJPVkFMEAQ=');
+    'ChxBQ1RJT05fVFlQRV9SRVFVSVJFX0FQUFJPVkFMEAQSGwoXQUNUSU9OX1RZUEVfQUxMT1dfQU'
+    'xFUlQQBQ==');
 
 @$core.Deprecated('Use fallbackActionDescriptor instead')
 const FallbackAction$json = {
@@ -64,6 +66,21 @@ const MockPreset$json = {
     {'1': 'MOCK_PRESET_RDP_SECURE', '2': 9},
     {'1': 'MOCK_PRESET_TELNET_SECURE', '2': 10},
     {'1': 'MOCK_PRESET_RAW_TARPIT', '2': 11},
+    {'1': 'MOCK_PRESET_POSTGRES_SECURE', '2': 12},
+    {'1': 'MOCK_PRESET_POSTGRES_TARPIT', '2': 13},
+    {'1': 'MOCK_PRESET_MONGODB_SECURE', '2': 14},
+    {'1': 'MOCK_PRESET_MONGODB_TARPIT', '2': 15},
+    {'1': 'MOCK_PRESET_ELASTICSEARCH_SECURE', '2': 16},
+    {'1': 'MOCK_PRESET_ELASTICSEARCH_TARPIT', '2': 17},
+    {'1': 'MOCK_PRESET_MEMCACHED_SECURE', '2': 18},
+    {'1': 'MOCK_PRESET_MEMCACHED_TARPIT', '2': 19},
+    {'1': 'MOCK_PRESET_FTP_SECURE', '2': 20},
+    {'1': 'MOCK_PRESET_FTP_TARPIT', '2': 21},
+    {'1': 'MOCK_PRESET_VNC_SECURE', '2': 22},
+    {'1': 'MOCK_PRESET_VNC_TARPIT', '2': 23},
+    {'1': 'MOCK_PRESET_SMB_SECURE', '2': 24},
+    {'1': 'MOCK_PRESET_LDAP_SECURE', '2': 25},
+    {'1': 'MOCK_PRESET_LDAP_TARPIT', '2': 26},
   ],
 };
 
@@ -75,7 +92,15 @@ final $typed_data.Uint8List mockPresetDescriptor = $convert.base64Decode(
     'RUUF80MDEQBRIcChhNT0NLX1BSRVNFVF9SRURJU19TRUNVUkUQBhIcChhNT0NLX1BSRVNFVF9N'
     'WVNRTF9TRUNVUkUQBxIcChhNT0NLX1BSRVNFVF9NWVNRTF9UQVJQSVQQCBIaChZNT0NLX1BSRV'
     'NFVF9SRFBfU0VDVVJFEAkSHQoZTU9DS19QUkVTRVRfVEVMTkVUX1NFQ1VSRRAKEhoKFk1PQ0tf'
-    'UFJFU0VUX1JBV19UQVJQSVQQCw==');
+    'UFJFU0VUX1JBV19UQVJQSVQQCxIfChtNT0NLX1BSRVNFVF9QT1NUR1JFU19TRUNVUkUQDBIfCh'
+    'tNT0NLX1BSRVNFVF9QT1NUR1JFU19UQVJQSVQQDRIeChpNT0NLX1BSRVNFVF9NT05HT0RCX1NF'
+    'Q1VSRRAOEh4KGk1PQ0tfUFJFU0VUX01PTkdPREJfVEFSUElUEA8SJAogTU9DS19QUkVTRVRfRU'
+    'xBU1RJQ1NFQVJDSF9TRUNVUkUQEBIkCiBNT0NLX1BSRVNFVF9FTEFTVElDU0VBUkNIX1RBUlBJ'
+    'VBAREiAKHE1PQ0tfUFJFU0VUX01FTUNBQ0hFRF9TRUNVUkUQEhIgChxNT0NLX1BSRVNFVF9NRU'
+    '1DQUNIRURfVEFSUElUEBMSGgoWTU9DS19QUkVTRVRfRlRQX1NFQ1VSRRAUEhoKFk1PQ0tfUFJF'
+    'U0VUX0ZUUF9UQVJQSVQQFRIaChZNT0NLX1BSRVNFVF9WTkNfU0VDVVJFEBYSGgoWTU9DS19QUk'
+    'VTRVRfVk5DX1RBUlBJVBAXEhoKFk1PQ0tfUFJFU0VUX1NNQl9TRUNVUkUQGBIbChdNT0NLX1BS'
+    'RVNFVF9MREFQX1NFQ1VSRRAZEhsKF01PQ0tfUFJFU0VUX0xEQVBfVEFSUElUEBo=');
 
 @$core.Deprecated('Use conditionTypeDescriptor instead')
 const ConditionType$json = {
@@ -333,7 +358,35 @@ const AlertDetails$json = {
     {'1': 'geo_country', '3': 6, '4': 1, '5': 9, '10': 'geoCountry'},
     {'1': 'geo_city', '3': 7, '4': 1, '5': 9, '10': 'geoCity'},
     {'1': 'geo_isp', '3': 8, '4': 1, '5': 9, '10': 'geoIsp'},
+    {'1': 'summary', '3': 9, '4': 1, '5': 9, '10': 'summary'},
+    {
+      '1': 'fields',
+      '3': 10,
+      '4': 3,
+      '5': 11,
+      '6': '.nitella.AlertDetails.FieldsEntry',
+      '10': 'fields'
+    },
+    {
+      '1': 'context',
+      '3': 11,
+      '4': 1,
+      '5': 11,
+      '6': '.nitella.ApprovalContext',
+      '10': 'context'
+    },
+  ],
+  '3': [AlertDetails_FieldsEntry$json],
+};
+
+@$core.Deprecated('Use alertDetailsDescriptor instead')
+const AlertDetails_FieldsEntry$json = {
+  '1': 'FieldsEntry',
+  '2': [
+    {'1': 'key', '3': 1, '4': 1, '5': 9, '10': 'key'},
+    {'1': 'value', '3': 2, '4': 1, '5': 9, '10': 'value'},
   ],
+  '7': {'7': true},
 };
 
 /// Descriptor for `AlertDetails`. Decode as a `google.protobuf.DescriptorProto`.
@@ -342,7 +395,37 @@ final $typed_data.Uint8List alertDetailsDescriptor = $convert.base64Decode(
     'lvbhgCIAEoCVILZGVzdGluYXRpb24SGQoIcHJveHlfaWQYAyABKAlSB3Byb3h5SWQSHQoKcHJv'
     'eHlfbmFtZRgEIAEoCVIJcHJveHlOYW1lEhcKB3J1bGVfaWQYBSABKAlSBnJ1bGVJZBIfCgtnZW'
     '9fY291bnRyeRgGIAEoCVIKZ2VvQ291bnRyeRIZCghnZW9fY2l0eRgHIAEoCVIHZ2VvQ2l0eRIX'
-    'CgdnZW9faXNwGAggASgJUgZnZW9Jc3A=');
+    'CgdnZW9faXNwGAggASgJUgZnZW9Jc3ASGAoHc3VtbWFyeRgJIAEoCVIHc3VtbWFyeRI5CgZmaW'
+    'VsZHMYCiADKAsyIS5uaXRlbGxhLkFsZXJ0RGV0YWlscy5GaWVsZHNFbnRyeVIGZmllbGRzEjIK'
+    'B2NvbnRleHQYCyABKAsyGC5uaXRlbGxhLkFwcHJvdmFsQ29udGV4dFIHY29udGV4dBo5CgtGaW'
+    'VsZHNFbnRyeRIQCgNrZXkYASABKAlSA2tleRIUCgV2YWx1ZRgCIAEoCVIFdmFsdWU6AjgB');
+
+@$core.Deprecated('Use approvalContextDescriptor instead')
+const ApprovalContext$json = {
+  '1': 'ApprovalContext',
+  '2': [
+    {'1': 'connection_count', '3': 1, '4': 1, '5': 3, '10': 'connectionCount'},
+    {'1': 'blocked_count', '3': 2, '4': 1, '5': 3, '10': 'blockedCount'},
+    {'1': 'first_seen_unix', '3': 3, '4': 1, '5': 3, '10': 'firstSeenUnix'},
+    {'1': 'last_seen_unix', '3': 4, '4': 1, '5': 3, '10': 'lastSeenUnix'},
+    {'1': 'prior_approvals', '3': 5, '4': 1, '5': 5, '10': 'priorApprovals'},
+    {'1': 'prior_denials', '3': 6, '4': 1, '5': 5, '10': 'priorDenials'},
+    {'1': 'reverse_dns', '3': 7, '4': 1, '5': 9, '10': 'reverseDns'},
+    {'1': 'ip_sets', '3': 8, '4': 3, '5': 9, '10': 'ipSets'},
+    {'1': 'tls_subject', '3': 9, '4': 1, '5': 9, '10': 'tlsSubject'},
+    {'1': 'tls_cn', '3': 10, '4': 1, '5': 9, '10': 'tlsCn'},
+  ],
+};
+
+/// Descriptor for `ApprovalContext`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List approvalContextDescriptor = $convert.base64Decode(
+    'Cg9BcHByb3ZhbENvbnRleHQSKQoQY29ubmVjdGlvbl9jb3VudBgBIAEoA1IPY29ubmVjdGlvbk'
+    'NvdW50EiMKDWJsb2NrZWRfY291bnQYAiABKANSDGJsb2NrZWRDb3VudBImCg9maXJzdF9zZWVu'
+    'X3VuaXgYAyABKANSDWZpcnN0U2VlblVuaXgSJAoObGFzdF9zZWVuX3VuaXgYBCABKANSDGxhc3'
+    'RTZWVuVW5peBInCg9wcmlvcl9hcHByb3ZhbHMYBSABKAVSDnByaW9yQXBwcm92YWxzEiMKDXBy'
+    'aW9yX2RlbmlhbHMYBiABKAVSDHByaW9yRGVuaWFscxIfCgtyZXZlcnNlX2RucxgHIAEoCVIKcm'
+    'V2ZXJzZURucxIXCgdpcF9zZXRzGAggAygJUgZpcFNldHMSHwoLdGxzX3N1YmplY3QYCSABKAlS'
+    'CnRsc1N1YmplY3QSFQoGdGxzX2NuGAogASgJUgV0bHNDbg==');
 
 @$core.Deprecated('Use geoInfoDescriptor instead')
 const GeoInfo$json = {
@@ -308,6 +308,7 @@ class IdentityInfo extends $pb.GeneratedMessage {
     $core.String? rootCertPem,
     $3.Timestamp? createdAt,
     $core.int? pairedNodes,
+    $core.String? approverPublicKeyPem,
   }) {
     final result = create();
     if (exists != null) result.exists = exists;
@@ -317,6 +318,8 @@ class IdentityInfo extends $pb.GeneratedMessage {
     if (rootCertPem != null) result.rootCertPem = rootCertPem;
     if (createdAt != null) result.createdAt = createdAt;
     if (pairedNodes != null) result.pairedNodes = pairedNodes;
+    if (approverPublicKeyPem != null)
+      result.approverPublicKeyPem = approverPublicKeyPem;
     return result;
   }
 
@@ -341,6 +344,7 @@ class IdentityInfo extends $pb.GeneratedMessage {
     ..aOM<$3.Timestamp>(6, _omitFieldNames ? '' : 'createdAt',
         subBuilder: $3.Timestamp.create)
     ..aI(7, _omitFieldNames ? '' : 'pairedNodes')
+    ..aOS(8, _omitFieldNames ? '' : 'approverPublicKeyPem')
     ..hasRequiredFields = false;
 
   @$core.Deprecated('See https://github.com/google/protobuf.dart/issues/998.')
@@ -426,6 +430,15 @@ class IdentityInfo extends $pb.GeneratedMessage {
   $core.bool hasPairedNodes() => $_has(6);
   @$pb.TagNumber(7)
   void clearPairedNodes() => $_clearField(7);
+
+  @$pb.TagNumber(8)
+  $core.String get approverPublicKeyPem => $_getSZ(7);
+  @$pb.TagNumber(8)
+  set approverPublicKeyPem($core.String value) => $_setString(7, value);
+  @$pb.TagNumber(8)
+  $core.bool hasApproverPublicKeyPem() => $_has(7);
+  @$pb.TagNumber(8)
+  void clearApproverPublicKeyPem() => $_clearField(8);
 }
 
 class CreateIdentityRequest extends $pb.GeneratedMessage {
@@ -5771,6 +5784,11 @@ class ApprovalRequest extends $pb.GeneratedMessage {
     $3.Timestamp? timestamp,
     $core.String? tlsCn,
     $core.String? tlsFingerprint,
+    $core.int? approvals,
+    $core.int? quorum,
+    $5.ApprovalContext? context,
+    $core.bool? resolved,
+    $core.String? resolution,
   }) {
     final result = create();
     if (requestId != null) result.requestId = requestId;
@@ -5787,6 +5805,11 @@ class ApprovalRequest extends $pb.GeneratedMessage {
     if (timestamp != null) result.timestamp = timestamp;
     if (tlsCn != null) result.tlsCn = tlsCn;
     if (tlsFingerprint != null) result.tlsFingerprint = tlsFingerprint;
+    if (approvals != null) result.approvals = approvals;
+    if (quorum != null) result.quorum = quorum;
+    if (context != null) result.context = context;
+    if (resolved != null) result.resolved = resolved;
+    if (resolution != null) result.resolution = resolution;
     return result;
   }
 
@@ -5819,6 +5842,12 @@ class ApprovalRequest extends $pb.GeneratedMessage {
         subBuilder: $3.Timestamp.create)
     ..aOS(13, _omitFieldNames ? '' : 'tlsCn')
     ..aOS(14, _omitFieldNames ? '' : 'tlsFingerprint')
+    ..aI(15, _omitFieldNames ? '' : 'approvals')
+    ..aI(16, _omitFieldNames ? '' : 'quorum')
+    ..aOM<$5.ApprovalContext>(17, _omitFieldNames ? '' : 'context',
+        subBuilder: $5.ApprovalContext.create)
+    ..aOB(18, _omitFieldNames ? '' : 'resolved')
+    ..aOS(19, _omitFieldNames ? '' : 'resolution')
     ..hasRequiredFields = false;
 
   @$core.Deprecated('See https://github.com/google/protobuf.dart/issues/998.')
@@ -5969,6 +5998,53 @@ class ApprovalRequest extends $pb.GeneratedMessage {
   $core.bool hasTlsFingerprint() => $_has(13);
   @$pb.TagNumber(14)
   void clearTlsFingerprint() => $_clearField(14);
+
+  @$pb.TagNumber(15)
+  $core.int get approvals => $_getIZ(14);
+  @$pb.TagNumber(15)
+  set approvals($core.int value) => $_setSignedInt32(14, value);
+  @$pb.TagNumber(15)
+  $core.bool hasApprovals() => $_has(14);
+  @$pb.TagNumber(15)
+  void clearApprovals() => $_clearField(15);
+
+  @$pb.TagNumber(16)
+  $core.int get quorum => $_getIZ(15);
+  @$pb.TagNumber(16)
+  set quorum($core.int value) => $_setSignedInt32(15, value);
+  @$pb.TagNumber(16)
+  $core.bool hasQuorum() => $_has(15);
+  @$pb.TagNumber(16)
+  void clearQuorum() => $_clearField(16);
+
+  @$pb.TagNumber(17)
+  $5.ApprovalContext get context => $_getN(16);
+  @$pb.TagNumber(17)
+  set context($5.ApprovalContext value) => $_setField(17, value);
+  @$pb.TagNumber(17)
+  $core.bool hasContext() => $_has(16);
+  @$pb.TagNumber(17)
+  void clearContext() => $_clearField(17);
+  @$pb.TagNumber(17)
+  $5.ApprovalContext ensureContext() => $_ensure(16);
+
+  @$pb.TagNumber(18)
+  $core.bool get resolved => $_getBF(17);
+  @$pb.TagNumber(18)
+  set resolved($core.bool value) => $_setBool(17, value);
+  @$pb.TagNumber(18)
+  $core.bool hasResolved() => $_has(17);
+  @$pb.TagNumber(18)
+  void clearResolved() => $_clearField(18);
+
+  @$pb.TagNumber(19)
+  $core.String get resolution => $_getSZ(18);
+  @$pb.TagNumber(19)
+  set resolution($core.String value) => $_setString(18, value);
+  @$pb.TagNumber(19)
+  $core.bool hasResolution() => $_has(18);
+  @$pb.TagNumber(19)
+  void clearResolution() => $_clearField(19);
 }
 
 class ListPendingApprovalsRequest extends $pb.GeneratedMessage {
@@ -7024,6 +7100,7 @@ class ApprovalHistoryEntry extends $pb.GeneratedMessage {
     DenyBlockType? blockType,
     $core.String? ruleId,
     $3.Timestamp? decidedAt,
+    $core.String? tlsCn,
   }) {
     final result = create();
     if (requestId != null) result.requestId = requestId;
@@ -7039,6 +7116,7 @@ class ApprovalHistoryEntry extends $pb.GeneratedMessage {
     if (blockType != null) result.blockType = blockType;
     if (ruleId != null) result.ruleId = ruleId;
     if (decidedAt != null) result.decidedAt = decidedAt;
+    if (tlsCn != null) result.tlsCn = tlsCn;
     return result;
   }
 
@@ -7072,6 +7150,7 @@ class ApprovalHistoryEntry extends $pb.GeneratedMessage {
     ..aOS(12, _omitFieldNames ? '' : 'ruleId')
     ..aOM<$3.Timestamp>(13, _omitFieldNames ? '' : 'decidedAt',
         subBuilder: $3.Timestamp.create)
+    ..aOS(14, _omitFieldNames ? '' : 'tlsCn')
     ..hasRequiredFields = false;
 
   @$core.Deprecated('See https://github.com/google/protobuf.dart/issues/998.')
@@ -7213,6 +7292,15 @@ class ApprovalHistoryEntry extends $pb.GeneratedMessage {
   void clearDecidedAt() => $_clearField(13);
   @$pb.TagNumber(13)
   $3.Timestamp ensureDecidedAt() => $_ensure(12);
+
+  @$pb.TagNumber(14)
+  $core.String get tlsCn => $_getSZ(13);
+  @$pb.TagNumber(14)
+  set tlsCn($core.String value) => $_setString(13, value);
+  @$pb.TagNumber(14)
+  $core.bool hasTlsCn() => $_has(13);
+  @$pb.TagNumber(14)
+  void clearTlsCn() => $_clearField(14);
 }
 
 class ListApprovalHistoryRequest extends $pb.GeneratedMessage {
@@ -7481,6 +7569,419 @@ class ClearApprovalHistoryResponse extends $pb.GeneratedMessage {
   void clearDeletedCount() => $_clearField(3);
 }
 
+class ListRuleProposalsRequest extends $pb.GeneratedMessage {
+  factory ListRuleProposalsRequest({
+    $core.String? nodeId,
+    $core.int? minDecisions,
+  }) {
+    final result = create();
+    if (nodeId != null) result.nodeId = nodeId;
+    if (minDecisions != null) result.minDecisions = minDecisions;
+    return result;
+  }
+
+  ListRuleProposalsRequest._();
+
+  factory ListRuleProposalsRequest.fromBuffer($core.List<$core.int> data,
+          [$pb.ExtensionRegistry registry = $pb.ExtensionRegistry.EMPTY]) =>
+      create()..mergeFromBuffer(data, registry);
+  factory ListRuleProposalsRequest.fromJson($core.String json,
+          [$pb.ExtensionRegistry registry = $pb.ExtensionRegistry.EMPTY]) =>
+      create()..mergeFromJson(json, registry);
+
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(
+      _omitMessageNames ? '' : 'ListRuleProposalsRequest',
+      package: const $pb.PackageName(_omitMessageNames ? '' : 'nitella.local'),
+      createEmptyInstance: create)
+    ..aOS(1, _omitFieldNames ? '' : 'nodeId')
+    ..aI(2, _omitFieldNames ? '' : 'minDecisions')
+    ..hasRequiredFields = false;
+
+  @$core.Deprecated('See https://github.com/google/protobuf.dart/issues/998.')
+  ListRuleProposalsRequest clone() => deepCopy();
+  @$core.Deprecated('See https://github.com/google/protobuf.dart/issues/998.')
+  ListRuleProposalsRequest copyWith(
+          void Function(ListRuleProposalsRequest) updates) =>
+      super.copyWith((message) => updates(message as ListRuleProposalsRequest))
+          as ListRuleProposalsRequest;
+
+  @$core.override
+  $pb.BuilderInfo get info_ => _i;
+
+  @$core.pragma('dart2js:noInline')
+  static ListRuleProposalsRequest create() => ListRuleProposalsRequest._();
+  @$core.override
+  ListRuleProposalsRequest createEmptyInstance() => create();
+  @$core.pragma('dart2js:noInline')
+  static ListRuleProposalsRequest getDefault() => _defaultInstance ??=
+      $pb.GeneratedMessage.$_defaultFor<ListRuleProposalsRequest>(create);
+  static ListRuleProposalsRequest? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $core.String get nodeId => $_getSZ(0);
+  @$pb.TagNumber(1)
+  set nodeId($core.String value) => $_setString(0, value);
+  @$pb.TagNumber(1)
+  $core.bool hasNodeId() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearNodeId() => $_clearField(1);
+
+  @$pb.TagNumber(2)
+  $core.int get minDecisions => $_getIZ(1);
+  @$pb.TagNumber(2)
+  set minDecisions($core.int value) => $_setSignedInt32(1, value);
+  @$pb.TagNumber(2)
+  $core.bool hasMinDecisions() => $_has(1);
+  @$pb.TagNumber(2)
+  void clearMinDecisions() => $_clearField(2);
+}
+
+/// RuleProposal is a permanent rule covering decisions the user keeps making
+/// by hand, learned from the approval history.
+class RuleProposal extends $pb.GeneratedMessage {
+  factory RuleProposal({
+    $core.String? proposalId,
+    $core.String? nodeId,
+    $core.String? proxyId,
+    $2.Rule? rule,
+    $core.String? summary,
+    $core.int? decisionCount,
+    $core.Iterable<ApprovalHistoryEntry>? matched,
+  }) {
+    final result = create();
+    if (proposalId != null) result.proposalId = proposalId;
+    if (nodeId != null) result.nodeId = nodeId;
+    if (proxyId != null) result.proxyId = proxyId;
+    if (rule != null) result.rule = rule;
+    if (summary != null) result.summary = summary;
+    if (decisionCount != null) result.decisionCount = decisionCount;
+    if (matched != null) result.matched.addAll(matched);
+    return result;
+  }
+
+  RuleProposal._();
+
+  factory RuleProposal.fromBuffer($core.List<$core.int> data,
+          [$pb.ExtensionRegistry registry = $pb.ExtensionRegistry.EMPTY]) =>
+      create()..mergeFromBuffer(data, registry);
+  factory RuleProposal.fromJson($core.String json,
+          [$pb.ExtensionRegistry registry = $pb.ExtensionRegistry.EMPTY]) =>
+      create()..mergeFromJson(json, registry);
+
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(
+      _omitMessageNames ? '' : 'RuleProposal',
+      package: const $pb.PackageName(_omitMessageNames ? '' : 'nitella.local'),
+      createEmptyInstance: create)
+    ..aOS(1, _omitFieldNames ? '' : 'proposalId')
+    ..aOS(2, _omitFieldNames ? '' : 'nodeId')
+    ..aOS(3, _omitFieldNames ? '' : 'proxyId')
+    ..aOM<$2.Rule>(4, _omitFieldNames ? '' : 'rule', subBuilder: $2.Rule.create)
+    ..aOS(5, _omitFieldNames ? '' : 'summary')
+    ..aI(6, _omitFieldNames ? '' : 'decisionCount')
+    ..pPM<ApprovalHistoryEntry>(7, _omitFieldNames ? '' : 'matched',
+        subBuilder: ApprovalHistoryEntry.create)
+    ..hasRequiredFields = false;
+
+  @$core.Deprecated('See https://github.com/google/protobuf.dart/issues/998.')
+  RuleProposal clone() => deepCopy();
+  @$core.Deprecated('See https://github.com/google/protobuf.dart/issues/998.')
+  RuleProposal copyWith(void Function(RuleProposal) updates) =>
+      super.copyWith((message) => updates(message as RuleProposal))
+          as RuleProposal;
+
+  @$core.override
+  $pb.BuilderInfo get info_ => _i;
+
+  @$core.pragma('dart2js:noInline')
+  static RuleProposal create() => RuleProposal._();
+  @$core.override
+  RuleProposal createEmptyInstance() => create();
+  @$core.pragma('dart2js:noInline')
+  static RuleProposal getDefault() => _defaultInstance ??=
+      $pb.GeneratedMessage.$_defaultFor<RuleProposal>(create);
+  static RuleProposal? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $core.String get proposalId => $_getSZ(0);
+  @$pb.TagNumber(1)
+  set proposalId($core.String value) => $_setString(0, value);
+  @$pb.TagNumber(1)
+  $core.bool hasProposalId() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearProposalId() => $_clearField(1);
+
+  @$pb.TagNumber(2)
+  $core.String get nodeId => $_getSZ(1);
+  @$pb.TagNumber(2)
+  set nodeId($core.String value) => $_setString(1, value);
+  @$pb.TagNumber(2)
+  $core.bool hasNodeId() => $_has(1);
+  @$pb.TagNumber(2)
+  void clearNodeId() => $_clearField(2);
+
+  @$pb.TagNumber(3)
+  $core.String get proxyId => $_getSZ(2);
+  @$pb.TagNumber(3)
+  set proxyId($core.String value) => $_setString(2, value);
+  @$pb.TagNumber(3)
+  $core.bool hasProxyId() => $_has(2);
+  @$pb.TagNumber(3)
+  void clearProxyId() => $_clearField(3);
+
+  @$pb.TagNumber(4)
+  $2.Rule get rule => $_getN(3);
+  @$pb.TagNumber(4)
+  set rule($2.Rule value) => $_setField(4, value);
+  @$pb.TagNumber(4)
+  $core.bool hasRule() => $_has(3);
+  @$pb.TagNumber(4)
+  void clearRule() => $_clearField(4);
+  @$pb.TagNumber(4)
+  $2.Rule ensureRule() => $_ensure(3);
+
+  @$pb.TagNumber(5)
+  $core.String get summary => $_getSZ(4);
+  @$pb.TagNumber(5)
+  set summary($core.String value) => $_setString(4, value);
+  @$pb.TagNumber(5)
+  $core.bool hasSummary() => $_has(4);
+  @$pb.TagNumber(5)
+  void clearSummary() => $_clearField(5);
+
+  @$pb.TagNumber(6)
+  $core.int get decisionCount => $_getIZ(5);
+  @$pb.TagNumber(6)
+  set decisionCount($core.int value) => $_setSignedInt32(5, value);
+  @$pb.TagNumber(6)
+  $core.bool hasDecisionCount() => $_has(5);
+  @$pb.TagNumber(6)
+  void clearDecisionCount() => $_clearField(6);
+
+  @$pb.TagNumber(7)
+  $pb.PbList<ApprovalHistoryEntry> get matched => $_getList(6);
+}
+
+class ListRuleProposalsResponse extends $pb.GeneratedMessage {
+  factory ListRuleProposalsResponse({
+    $core.Iterable<RuleProposal>? proposals,
+  }) {
+    final result = create();
+    if (proposals != null) result.proposals.addAll(proposals);
+    return result;
+  }
+
+  ListRuleProposalsResponse._();
+
+  factory ListRuleProposalsResponse.fromBuffer($core.List<$core.int> data,
+          [$pb.ExtensionRegistry registry = $pb.ExtensionRegistry.EMPTY]) =>
+      create()..mergeFromBuffer(data, registry);
+  factory ListRuleProposalsResponse.fromJson($core.String json,
+          [$pb.ExtensionRegistry registry = $pb.ExtensionRegistry.EMPTY]) =>
+      create()..mergeFromJson(json, registry);
+
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(
+      _omitMessageNames ? '' : 'ListRuleProposalsResponse',
+      package: const $pb.PackageName(_omitMessageNames ? '' : 'nitella.local'),
+      createEmptyInstance: create)
+    ..pPM<RuleProposal>(1, _omitFieldNames ? '' : 'proposals',
+        subBuilder: RuleProposal.create)
+    ..hasRequiredFields = false;
+
+  @$core.Deprecated('See https://github.com/google/protobuf.dart/issues/998.')
+  ListRuleProposalsResponse clone() => deepCopy();
+  @$core.Deprecated('See https://github.com/google/protobuf.dart/issues/998.')
+  ListRuleProposalsResponse copyWith(
+          void Function(ListRuleProposalsResponse) updates) =>
+      super.copyWith((message) => updates(message as ListRuleProposalsResponse))
+          as ListRuleProposalsResponse;
+
+  @$core.override
+  $pb.BuilderInfo get info_ => _i;
+
+  @$core.pragma('dart2js:noInline')
+  static ListRuleProposalsResponse create() => ListRuleProposalsResponse._();
+  @$core.override
+  ListRuleProposalsResponse createEmptyInstance() => create();
+  @$core.pragma('dart2js:noInline')
+  static ListRuleProposalsResponse getDefault() => _defaultInstance ??=
+      $pb.GeneratedMessage.$_defaultFor<ListRuleProposalsResponse>(create);
+  static ListRuleProposalsResponse? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $pb.PbList<RuleProposal> get proposals => $_getList(0);
+}
+
+class AcceptRuleProposalRequest extends $pb.GeneratedMessage {
+  factory AcceptRuleProposalRequest({
+    $core.String? proposalId,
+    $core.String? nodeId,
+    $core.int? minDecisions,
+  }) {
+    final result = create();
+    if (proposalId != null) result.proposalId = proposalId;
+    if (nodeId != null) result.nodeId = nodeId;
+    if (minDecisions != null) result.minDecisions = minDecisions;
+    return result;
+  }
+
+  AcceptRuleProposalRequest._();
+
+  factory AcceptRuleProposalRequest.fromBuffer($core.List<$core.int> data,
+          [$pb.ExtensionRegistry registry = $pb.ExtensionRegistry.EMPTY]) =>
+      create()..mergeFromBuffer(data, registry);
+  factory AcceptRuleProposalRequest.fromJson($core.String json,
+          [$pb.ExtensionRegistry registry = $pb.ExtensionRegistry.EMPTY]) =>
+      create()..mergeFromJson(json, registry);
+
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(
+      _omitMessageNames ? '' : 'AcceptRuleProposalRequest',
+      package: const $pb.PackageName(_omitMessageNames ? '' : 'nitella.local'),
+      createEmptyInstance: create)
+    ..aOS(1, _omitFieldNames ? '' : 'proposalId')
+    ..aOS(2, _omitFieldNames ? '' : 'nodeId')
+    ..aI(3, _omitFieldNames ? '' : 'minDecisions')
+    ..hasRequiredFields = false;
+
+  @$core.Deprecated('See https://github.com/google/protobuf.dart/issues/998.')
+  AcceptRuleProposalRequest clone() => deepCopy();
+  @$core.Deprecated('See https://github.com/google/protobuf.dart/issues/998.')
+  AcceptRuleProposalRequest copyWith(
+          void Function(AcceptRuleProposalRequest) updates) =>
+      super.copyWith((message) => updates(message as AcceptRuleProposalRequest))
+          as AcceptRuleProposalRequest;
+
+  @$core.override
+  $pb.BuilderInfo get info_ => _i;
+
+  @$core.pragma('dart2js:noInline')
+  static AcceptRuleProposalRequest create() => AcceptRuleProposalRequest._();
+  @$core.override
+  AcceptRuleProposalRequest createEmptyInstance() => create();
+  @$core.pragma('dart2js:noInline')
+  static AcceptRuleProposalRequest getDefault() => _defaultInstance ??=
+      $pb.GeneratedMessage.$_defaultFor<AcceptRuleProposalRequest>(create);
+  static AcceptRuleProposalRequest? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $core.String get proposalId => $_getSZ(0);
+  @$pb.TagNumber(1)
+  set proposalId($core.String value) => $_setString(0, value);
+  @$pb.TagNumber(1)
+  $core.bool hasProposalId() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearProposalId() => $_clearField(1);
+
+  @$pb.TagNumber(2)
+  $core.String get nodeId => $_getSZ(1);
+  @$pb.TagNumber(2)
+  set nodeId($core.String value) => $_setString(1, value);
+  @$pb.TagNumber(2)
+  $core.bool hasNodeId() => $_has(1);
+  @$pb.TagNumber(2)
+  void clearNodeId() => $_clearField(2);
+
+  @$pb.TagNumber(3)
+  $core.int get minDecisions => $_getIZ(2);
+  @$pb.TagNumber(3)
+  set minDecisions($core.int value) => $_setSignedInt32(2, value);
+  @$pb.TagNumber(3)
+  $core.bool hasMinDecisions() => $_has(2);
+  @$pb.TagNumber(3)
+  void clearMinDecisions() => $_clearField(3);
+}
+
+class AcceptRuleProposalResponse extends $pb.GeneratedMessage {
+  factory AcceptRuleProposalResponse({
+    $core.bool? success,
+    $core.String? error,
+    $core.String? ruleId,
+    $core.int? rulesCreated,
+  }) {
+    final result = create();
+    if (success != null) result.success = success;
+    if (error != null) result.error = error;
+    if (ruleId != null) result.ruleId = ruleId;
+    if (rulesCreated != null) result.rulesCreated = rulesCreated;
+    return result;
+  }
+
+  AcceptRuleProposalResponse._();
+
+  factory AcceptRuleProposalResponse.fromBuffer($core.List<$core.int> data,
+          [$pb.ExtensionRegistry registry = $pb.ExtensionRegistry.EMPTY]) =>
+      create()..mergeFromBuffer(data, registry);
+  factory AcceptRuleProposalResponse.fromJson($core.String json,
+          [$pb.ExtensionRegistry registry = $pb.ExtensionRegistry.EMPTY]) =>
+      create()..mergeFromJson(json, registry);
+
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(
+      _omitMessageNames ? '' : 'AcceptRuleProposalResponse',
+      package: const $pb.PackageName(_omitMessageNames ? '' : 'nitella.local'),
+      createEmptyInstance: create)
+    ..aOB(1, _omitFieldNames ? '' : 'success')
+    ..aOS(2, _omitFieldNames ? '' : 'error')
+    ..aOS(3, _omitFieldNames ? '' : 'ruleId')
+    ..aI(4, _omitFieldNames ? '' : 'rulesCreated')
+    ..hasRequiredFields = false;
+
+  @$core.Deprecated('See https://github.com/google/protobuf.dart/issues/998.')
+  AcceptRuleProposalResponse clone() => deepCopy();
+  @$core.Deprecated('See https://github.com/google/protobuf.dart/issues/998.')
+  AcceptRuleProposalResponse copyWith(
+          void Function(AcceptRuleProposalResponse) updates) =>
+      super.copyWith(
+              (message) => updates(message as AcceptRuleProposalResponse))
+          as AcceptRuleProposalResponse;
+
+  @$core.override
+  $pb.BuilderInfo get info_ => _i;
+
+  @$core.pragma('dart2js:noInline')
+  static AcceptRuleProposalResponse create() => AcceptRuleProposalResponse._();
+  @$core.override
+  AcceptRuleProposalResponse createEmptyInstance() => create();
+  @$core.pragma('dart2js:noInline')
+  static AcceptRuleProposalResponse getDefault() => _defaultInstance ??=
+      $pb.GeneratedMessage.$_defaultFor<AcceptRuleProposalResponse>(create);
+  static AcceptRuleProposalResponse? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $core.bool get success => $_getBF(0);
+  @$pb.TagNumber(1)
+  set success($core.bool value) => $_setBool(0, value);
+  @$pb.TagNumber(1)
+  $core.bool hasSuccess() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearSuccess() => $_clearField(1);
+
+  @$pb.TagNumber(2)
+  $core.String get error => $_getSZ(1);
+  @$pb.TagNumber(2)
+  set error($core.String value) => $_setString(1, value);
+  @$pb.TagNumber(2)
+  $core.bool hasError() => $_has(1);
+  @$pb.TagNumber(2)
+  void clearError() => $_clearField(2);
+
+  @$pb.TagNumber(3)
+  $core.String get ruleId => $_getSZ(2);
+  @$pb.TagNumber(3)
+  set ruleId($core.String value) => $_setString(2, value);
+  @$pb.TagNumber(3)
+  $core.bool hasRuleId() => $_has(2);
+  @$pb.TagNumber(3)
+  void clearRuleId() => $_clearField(3);
+
+  @$pb.TagNumber(4)
+  $core.int get rulesCreated => $_getIZ(3);
+  @$pb.TagNumber(4)
+  set rulesCreated($core.int value) => $_setSignedInt32(3, value);
+  @$pb.TagNumber(4)
+  $core.bool hasRulesCreated() => $_has(3);
+  @$pb.TagNumber(4)
+  void clearRulesCreated() => $_clearField(4);
+}
+
 class ConnectionStats extends $pb.GeneratedMessage {
   factory ConnectionStats({
     $fixnum.Int64? activeConnections,
@@ -14351,6 +14852,99 @@ class RestartListenersNodeRequest extends $pb.GeneratedMessage {
   void clearNodeId() => $_clearField(1);
 }
 
+class GetMockTranscriptsNodeRequest extends $pb.GeneratedMessage {
+  factory GetMockTranscriptsNodeRequest({
+    $core.String? nodeId,
+    $core.String? connId,
+    $core.String? sourceIp,
+    $core.int? limit,
+  }) {
+    final result = create();
+    if (nodeId != null) result.nodeId = nodeId;
+    if (connId != null) result.connId = connId;
+    if (sourceIp != null) result.sourceIp = sourceIp;
+    if (limit != null) result.limit = limit;
+    return result;
+  }
+
+  GetMockTranscriptsNodeRequest._();
+
+  factory GetMockTranscriptsNodeRequest.fromBuffer($core.List<$core.int> data,
+          [$pb.ExtensionRegistry registry = $pb.ExtensionRegistry.EMPTY]) =>
+      create()..mergeFromBuffer(data, registry);
+  factory GetMockTranscriptsNodeRequest.fromJson($core.String json,
+          [$pb.ExtensionRegistry registry = $pb.ExtensionRegistry.EMPTY]) =>
+      create()..mergeFromJson(json, registry);
+
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(
+      _omitMessageNames ? '' : 'GetMockTranscriptsNodeRequest',
+      package: const $pb.PackageName(_omitMessageNames ? '' : 'nitella.local'),
+      createEmptyInstance: create)
+    ..aOS(1, _omitFieldNames ? '' : 'nodeId')
+    ..aOS(2, _omitFieldNames ? '' : 'connId')
+    ..aOS(3, _omitFieldNames ? '' : 'sourceIp')
+    ..aI(4, _omitFieldNames ? '' : 'limit')
+    ..hasRequiredFields = false;
+
+  @$core.Deprecated('See https://github.com/google/protobuf.dart/issues/998.')
+  GetMockTranscriptsNodeRequest clone() => deepCopy();
+  @$core.Deprecated('See https://github.com/google/protobuf.dart/issues/998.')
+  GetMockTranscriptsNodeRequest copyWith(
+          void Function(GetMockTranscriptsNodeRequest) updates) =>
+      super.copyWith(
+              (message) => updates(message as GetMockTranscriptsNodeRequest))
+          as GetMockTranscriptsNodeRequest;
+
+  @$core.override
+  $pb.BuilderInfo get info_ => _i;
+
+  @$core.pragma('dart2js:noInline')
+  static GetMockTranscriptsNodeRequest create() =>
+      GetMockTranscriptsNodeRequest._();
+  @$core.override
+  GetMockTranscriptsNodeRequest createEmptyInstance() => create();
+  @$core.pragma('dart2js:noInline')
+  static GetMockTranscriptsNodeRequest getDefault() => _defaultInstance ??=
+      $pb.GeneratedMessage.$_defaultFor<GetMockTranscriptsNodeRequest>(create);
+  static GetMockTranscriptsNodeRequest? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $core.String get nodeId => $_getSZ(0);
+  @$pb.TagNumber(1)
+  set nodeId($core.String value) => $_setString(0, value);
+  @$pb.TagNumber(1)
+  $core.bool hasNodeId() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearNodeId() => $_clearField(1);
+
+  @$pb.TagNumber(2)
+  $core.String get connId => $_getSZ(1);
+  @$pb.TagNumber(2)
+  set connId($core.String value) => $_setString(1, value);
+  @$pb.TagNumber(2)
+  $core.bool hasConnId() => $_has(1);
+  @$pb.TagNumber(2)
+  void clearConnId() => $_clearField(2);
+
+  @$pb.TagNumber(3)
+  $core.String get sourceIp => $_getSZ(2);
+  @$pb.TagNumber(3)
+  set sourceIp($core.String value) => $_setString(2, value);
+  @$pb.TagNumber(3)
+  $core.bool hasSourceIp() => $_has(2);
+  @$pb.TagNumber(3)
+  void clearSourceIp() => $_clearField(3);
+
+  @$pb.TagNumber(4)
+  $core.int get limit => $_getIZ(3);
+  @$pb.TagNumber(4)
+  set limit($core.int value) => $_setSignedInt32(3, value);
+  @$pb.TagNumber(4)
+  $core.bool hasLimit() => $_has(3);
+  @$pb.TagNumber(4)
+  void clearLimit() => $_clearField(4);
+}
+
 class NodeStatusChange extends $pb.GeneratedMessage {
   factory NodeStatusChange({
     $core.String? nodeId,
@@ -405,6 +405,22 @@ class MobileLogicServiceClient extends $grpc.Client {
     return $createUnaryCall(_$clearApprovalHistory, request, options: options);
   }
 
+  /// Propose permanent rules for decisions repeated in the approval history
+  $grpc.ResponseFuture<$0.ListRuleProposalsResponse> listRuleProposals(
+    $0.ListRuleProposalsRequest request, {
+    $grpc.CallOptions? options,
+  }) {
+    return $createUnaryCall(_$listRuleProposals, request, options: options);
+  }
+
+  /// Create the rule a proposal describes
+  $grpc.ResponseFuture<$0.AcceptRuleProposalResponse> acceptRuleProposal(
+    $0.AcceptRuleProposalRequest request, {
+    $grpc.CallOptions? options,
+  }) {
+    return $createUnaryCall(_$acceptRuleProposal, request, options: options);
+  }
+
   /// Get connection statistics summary
   $grpc.ResponseFuture<$0.ConnectionStats> getConnectionStats(
     $0.GetConnectionStatsRequest request, {
@@ -808,6 +824,14 @@ class MobileLogicServiceClient extends $grpc.Client {
     return $createUnaryCall(_$restartListeners, request, options: options);
   }
 
+  /// Get fake shell transcripts recorded by honeypot (mock) listeners on a node
+  $grpc.ResponseFuture<$2.GetMockTranscriptsResponse> getMockTranscripts(
+    $0.GetMockTranscriptsNodeRequest request, {
+    $grpc.CallOptions? options,
+  }) {
+    return $createUnaryCall(_$getMockTranscripts, request, options: options);
+  }
+
   /// List proxy configs stored locally on this device
   $grpc.ResponseFuture<$0.ListLocalProxyConfigsResponse> listLocalProxyConfigs(
     $0.ListLocalProxyConfigsRequest request, {
@@ -1259,6 +1283,16 @@ class MobileLogicServiceClient extends $grpc.Client {
       '/nitella.local.MobileLogicService/ClearApprovalHistory',
       ($0.ClearApprovalHistoryRequest value) => value.writeToBuffer(),
       $0.ClearApprovalHistoryResponse.fromBuffer);
+  static final _$listRuleProposals = $grpc.ClientMethod<
+          $0.ListRuleProposalsRequest, $0.ListRuleProposalsResponse>(
+      '/nitella.local.MobileLogicService/ListRuleProposals',
+      ($0.ListRuleProposalsRequest value) => value.writeToBuffer(),
+      $0.ListRuleProposalsResponse.fromBuffer);
+  static final _$acceptRuleProposal = $grpc.ClientMethod<
+          $0.AcceptRuleProposalRequest, $0.AcceptRuleProposalResponse>(
+      '/nitella.local.MobileLogicService/AcceptRuleProposal',
+      ($0.AcceptRuleProposalRequest value) => value.writeToBuffer(),
+      $0.AcceptRuleProposalResponse.fromBuffer);
   static final _$getConnectionStats =
       $grpc.ClientMethod<$0.GetConnectionStatsRequest, $0.ConnectionStats>(
           '/nitella.local.MobileLogicService/GetConnectionStats',
@@ -1498,6 +1532,11 @@ class MobileLogicServiceClient extends $grpc.Client {
       '/nitella.local.MobileLogicService/RestartListeners',
       ($0.RestartListenersNodeRequest value) => value.writeToBuffer(),
       $2.RestartListenersResponse.fromBuffer);
+  static final _$getMockTranscripts = $grpc.ClientMethod<
+          $0.GetMockTranscriptsNodeRequest, $2.GetMockTranscriptsResponse>(
+      '/nitella.local.MobileLogicService/GetMockTranscripts',
+      ($0.GetMockTranscriptsNodeRequest value) => value.writeToBuffer(),
+      $2.GetMockTranscriptsResponse.fromBuffer);
   static final _$listLocalProxyConfigs = $grpc.ClientMethod<
           $0.ListLocalProxyConfigsRequest, $0.ListLocalProxyConfigsResponse>(
       '/nitella.local.MobileLogicService/ListLocalProxyConfigs',
@@ -2014,6 +2053,24 @@ abstract class MobileLogicServiceBase extends $grpc.Service {
         ($core.List<$core.int> value) =>
             $0.ClearApprovalHistoryRequest.fromBuffer(value),
         ($0.ClearApprovalHistoryResponse value) => value.writeToBuffer()));
+    $addMethod($grpc.ServiceMethod<$0.ListRuleProposalsRequest,
+            $0.ListRuleProposalsResponse>(
+        'ListRuleProposals',
+        listRuleProposals_Pre,
+        false,
+        false,
+        ($core.List<$core.int> value) =>
+            $0.ListRuleProposalsRequest.fromBuffer(value),
+        ($0.ListRuleProposalsResponse value) => value.writeToBuffer()));
+    $addMethod($grpc.ServiceMethod<$0.AcceptRuleProposalRequest,
+            $0.AcceptRuleProposalResponse>(
+        'AcceptRuleProposal',
+        acceptRuleProposal_Pre,
+        false,
+        false,
+        ($core.List<$core.int> value) =>
+            $0.AcceptRuleProposalRequest.fromBuffer(value),
+        ($0.AcceptRuleProposalResponse value) => value.writeToBuffer()));
     $addMethod(
         $grpc.ServiceMethod<$0.GetConnectionStatsRequest, $0.ConnectionStats>(
             'GetConnectionStats',
@@ -2415,6 +2472,15 @@ abstract class MobileLogicServiceBase extends $grpc.Service {
         ($core.List<$core.int> value) =>
             $0.RestartListenersNodeRequest.fromBuffer(value),
         ($2.RestartListenersResponse value) => value.writeToBuffer()));
+    $addMethod($grpc.ServiceMethod<$0.GetMockTranscriptsNodeRequest,
+            $2.GetMockTranscriptsResponse>(
+        'GetMockTranscripts',
+        getMockTranscripts_Pre,
+        false,
+        false,
+        ($core.List<$core.int> value) =>
+            $0.GetMockTranscriptsNodeRequest.fromBuffer(value),
+        ($2.GetMockTranscriptsResponse value) => value.writeToBuffer()));
     $addMethod($grpc.ServiceMethod<$0.ListLocalProxyConfigsRequest,
             $0.ListLocalProxyConfigsResponse>(
         'ListLocalProxyConfigs',
@@ -3041,6 +3107,24 @@ abstract class MobileLogicServiceBase extends $grpc.Service {
   $async.Future<$0.ClearApprovalHistoryResponse> clearApprovalHistory(
       $grpc.ServiceCall call, $0.ClearApprovalHistoryRequest request);
 
+  $async.Future<$0.ListRuleProposalsResponse> listRuleProposals_Pre(
+      $grpc.ServiceCall $call,
+      $async.Future<$0.ListRuleProposalsRequest> $request) async {
+    return listRuleProposals($call, await $request);
+  }
+
+  $async.Future<$0.ListRuleProposalsResponse> listRuleProposals(
+      $grpc.ServiceCall call, $0.ListRuleProposalsRequest request);
+
+  $async.Future<$0.AcceptRuleProposalResponse> acceptRuleProposal_Pre(
+      $grpc.ServiceCall $call,
+      $async.Future<$0.AcceptRuleProposalRequest> $request) async {
+    return acceptRuleProposal($call, await $request);
+  }
+
+  $async.Future<$0.AcceptRuleProposalResponse> acceptRuleProposal(
+      $grpc.ServiceCall call, $0.AcceptRuleProposalRequest request);
+
   $async.Future<$0.ConnectionStats> getConnectionStats_Pre(
       $grpc.ServiceCall $call,
       $async.Future<$0.GetConnectionStatsRequest> $request) async {
@@ -3457,6 +3541,15 @@ abstract class MobileLogicServiceBase extends $grpc.Service {
   $async.Future<$2.RestartListenersResponse> restartListeners(
       $grpc.ServiceCall call, $0.RestartListenersNodeRequest request);
 
+  $async.Future<$2.GetMockTranscriptsResponse> getMockTranscripts_Pre(
+      $grpc.ServiceCall $call,
+      $async.Future<$0.GetMockTranscriptsNodeRequest> $request) async {
+    return getMockTranscripts($call, await $request);
+  }
+
+  $async.Future<$2.GetMockTranscriptsResponse> getMockTranscripts(
+      $grpc.ServiceCall call, $0.GetMockTranscriptsNodeRequest request);
+
   $async.Future<$0.ListLocalProxyConfigsResponse> listLocalProxyConfigs_Pre(
       $grpc.ServiceCall $call,
       $async.Future<$0.ListLocalProxyConfigsRequest> $request) async {
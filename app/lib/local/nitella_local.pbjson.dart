@@ -280,6 +280,13 @@ const IdentityInfo$json = {
       '10': 'createdAt'
     },
     {'1': 'paired_nodes', '3': 7, '4': 1, '5': 5, '10': 'pairedNodes'},
+    {
+      '1': 'approver_public_key_pem',
+      '3': 8,
+      '4': 1,
+      '5': 9,
+      '10': 'approverPublicKeyPem'
+    },
   ],
 };
 
@@ -289,7 +296,8 @@ final $typed_data.Uint8List identityInfoDescriptor = $convert.base64Decode(
     'Zsb2NrZWQSIAoLZmluZ2VycHJpbnQYAyABKAlSC2ZpbmdlcnByaW50Eh0KCmVtb2ppX2hhc2gY'
     'BCABKAlSCWVtb2ppSGFzaBIiCg1yb290X2NlcnRfcGVtGAUgASgJUgtyb290Q2VydFBlbRI5Cg'
     'pjcmVhdGVkX2F0GAYgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcFIJY3JlYXRlZEF0'
-    'EiEKDHBhaXJlZF9ub2RlcxgHIAEoBVILcGFpcmVkTm9kZXM=');
+    'EiEKDHBhaXJlZF9ub2RlcxgHIAEoBVILcGFpcmVkTm9kZXMSNQoXYXBwcm92ZXJfcHVibGljX2'
+    'tleV9wZW0YCCABKAlSFGFwcHJvdmVyUHVibGljS2V5UGVt');
 
 @$core.Deprecated('Use createIdentityRequestDescriptor instead')
 const CreateIdentityRequest$json = {
@@ -1756,6 +1764,18 @@ const ApprovalRequest$json = {
     },
     {'1': 'tls_cn', '3': 13, '4': 1, '5': 9, '10': 'tlsCn'},
     {'1': 'tls_fingerprint', '3': 14, '4': 1, '5': 9, '10': 'tlsFingerprint'},
+    {'1': 'approvals', '3': 15, '4': 1, '5': 5, '10': 'approvals'},
+    {'1': 'quorum', '3': 16, '4': 1, '5': 5, '10': 'quorum'},
+    {
+      '1': 'context',
+      '3': 17,
+      '4': 1,
+      '5': 11,
+      '6': '.nitella.ApprovalContext',
+      '10': 'context'
+    },
+    {'1': 'resolved', '3': 18, '4': 1, '5': 8, '10': 'resolved'},
+    {'1': 'resolution', '3': 19, '4': 1, '5': 9, '10': 'resolution'},
   ],
 };
 
@@ -1769,7 +1789,10 @@ final $typed_data.Uint8List approvalRequestDescriptor = $convert.base64Decode(
     'sKCXJ1bGVfbmFtZRgKIAEoCVIIcnVsZU5hbWUSIgoDZ2VvGAsgASgLMhAubml0ZWxsYS5HZW9J'
     'bmZvUgNnZW8SOAoJdGltZXN0YW1wGAwgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcF'
     'IJdGltZXN0YW1wEhUKBnRsc19jbhgNIAEoCVIFdGxzQ24SJwoPdGxzX2ZpbmdlcnByaW50GA4g'
-    'ASgJUg50bHNGaW5nZXJwcmludA==');
+    'ASgJUg50bHNGaW5nZXJwcmludBIcCglhcHByb3ZhbHMYDyABKAVSCWFwcHJvdmFscxIWCgZxdW'
+    '9ydW0YECABKAVSBnF1b3J1bRIyCgdjb250ZXh0GBEgASgLMhgubml0ZWxsYS5BcHByb3ZhbENv'
+    'bnRleHRSB2NvbnRleHQSGgoIcmVzb2x2ZWQYEiABKAhSCHJlc29sdmVkEh4KCnJlc29sdXRpb2'
+    '4YEyABKAlSCnJlc29sdXRpb24=');
 
 @$core.Deprecated('Use listPendingApprovalsRequestDescriptor instead')
 const ListPendingApprovalsRequest$json = {
@@ -2135,6 +2158,7 @@ const ApprovalHistoryEntry$json = {
       '6': '.google.protobuf.Timestamp',
       '10': 'decidedAt'
     },
+    {'1': 'tls_cn', '3': 14, '4': 1, '5': 9, '10': 'tlsCn'},
   ],
 };
 
@@ -2149,7 +2173,7 @@ final $typed_data.Uint8List approvalHistoryEntryDescriptor = $convert.base64Deco
     'X3NlY29uZHMYCiABKANSD2R1cmF0aW9uU2Vjb25kcxI7CgpibG9ja190eXBlGAsgASgOMhwubm'
     'l0ZWxsYS5sb2NhbC5EZW55QmxvY2tUeXBlUglibG9ja1R5cGUSFwoHcnVsZV9pZBgMIAEoCVIG'
     'cnVsZUlkEjkKCmRlY2lkZWRfYXQYDSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wUg'
-    'lkZWNpZGVkQXQ=');
+    'lkZWNpZGVkQXQSFQoGdGxzX2NuGA4gASgJUgV0bHNDbg==');
 
 @$core.Deprecated('Use listApprovalHistoryRequestDescriptor instead')
 const ListApprovalHistoryRequest$json = {
@@ -2216,6 +2240,113 @@ final $typed_data.Uint8List clearApprovalHistoryResponseDescriptor =
         'MSFAoFZXJyb3IYAiABKAlSBWVycm9yEiMKDWRlbGV0ZWRfY291bnQYAyABKAVSDGRlbGV0ZWRD'
         'b3VudA==');
 
+@$core.Deprecated('Use listRuleProposalsRequestDescriptor instead')
+const ListRuleProposalsRequest$json = {
+  '1': 'ListRuleProposalsRequest',
+  '2': [
+    {'1': 'node_id', '3': 1, '4': 1, '5': 9, '10': 'nodeId'},
+    {'1': 'min_decisions', '3': 2, '4': 1, '5': 5, '10': 'minDecisions'},
+  ],
+};
+
+/// Descriptor for `ListRuleProposalsRequest`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List listRuleProposalsRequestDescriptor =
+    $convert.base64Decode(
+        'ChhMaXN0UnVsZVByb3Bvc2Fsc1JlcXVlc3QSFwoHbm9kZV9pZBgBIAEoCVIGbm9kZUlkEiMKDW'
+        '1pbl9kZWNpc2lvbnMYAiABKAVSDG1pbkRlY2lzaW9ucw==');
+
+@$core.Deprecated('Use ruleProposalDescriptor instead')
+const RuleProposal$json = {
+  '1': 'RuleProposal',
+  '2': [
+    {'1': 'proposal_id', '3': 1, '4': 1, '5': 9, '10': 'proposalId'},
+    {'1': 'node_id', '3': 2, '4': 1, '5': 9, '10': 'nodeId'},
+    {'1': 'proxy_id', '3': 3, '4': 1, '5': 9, '10': 'proxyId'},
+    {
+      '1': 'rule',
+      '3': 4,
+      '4': 1,
+      '5': 11,
+      '6': '.nitella.proxy.Rule',
+      '10': 'rule'
+    },
+    {'1': 'summary', '3': 5, '4': 1, '5': 9, '10': 'summary'},
+    {'1': 'decision_count', '3': 6, '4': 1, '5': 5, '10': 'decisionCount'},
+    {
+      '1': 'matched',
+      '3': 7,
+      '4': 3,
+      '5': 11,
+      '6': '.nitella.local.ApprovalHistoryEntry',
+      '10': 'matched'
+    },
+  ],
+};
+
+/// Descriptor for `RuleProposal`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List ruleProposalDescriptor = $convert.base64Decode(
+    'CgxSdWxlUHJvcG9zYWwSHwoLcHJvcG9zYWxfaWQYASABKAlSCnByb3Bvc2FsSWQSFwoHbm9kZV'
+    '9pZBgCIAEoCVIGbm9kZUlkEhkKCHByb3h5X2lkGAMgASgJUgdwcm94eUlkEicKBHJ1bGUYBCAB'
+    'KAsyEy5uaXRlbGxhLnByb3h5LlJ1bGVSBHJ1bGUSGAoHc3VtbWFyeRgFIAEoCVIHc3VtbWFyeR'
+    'IlCg5kZWNpc2lvbl9jb3VudBgGIAEoBVINZGVjaXNpb25Db3VudBI9CgdtYXRjaGVkGAcgAygL'
+    'MiMubml0ZWxsYS5sb2NhbC5BcHByb3ZhbEhpc3RvcnlFbnRyeVIHbWF0Y2hlZA==');
+
+@$core.Deprecated('Use listRuleProposalsResponseDescriptor instead')
+const ListRuleProposalsResponse$json = {
+  '1': 'ListRuleProposalsResponse',
+  '2': [
+    {
+      '1': 'proposals',
+      '3': 1,
+      '4': 3,
+      '5': 11,
+      '6': '.nitella.local.RuleProposal',
+      '10': 'proposals'
+    },
+  ],
+};
+
+/// Descriptor for `ListRuleProposalsResponse`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List listRuleProposalsResponseDescriptor =
+    $convert.base64Decode(
+        'ChlMaXN0UnVsZVByb3Bvc2Fsc1Jlc3BvbnNlEjkKCXByb3Bvc2FscxgBIAMoCzIbLm5pdGVsbG'
+        'EubG9jYWwuUnVsZVByb3Bvc2FsUglwcm9wb3NhbHM=');
+
+@$core.Deprecated('Use acceptRuleProposalRequestDescriptor instead')
+const AcceptRuleProposalRequest$json = {
+  '1': 'AcceptRuleProposalRequest',
+  '2': [
+    {'1': 'proposal_id', '3': 1, '4': 1, '5': 9, '10': 'proposalId'},
+    {'1': 'node_id', '3': 2, '4': 1, '5': 9, '10': 'nodeId'},
+    {'1': 'min_decisions', '3': 3, '4': 1, '5': 5, '10': 'minDecisions'},
+  ],
+};
+
+/// Descriptor for `AcceptRuleProposalRequest`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List acceptRuleProposalRequestDescriptor =
+    $convert.base64Decode(
+        'ChlBY2NlcHRSdWxlUHJvcG9zYWxSZXF1ZXN0Eh8KC3Byb3Bvc2FsX2lkGAEgASgJUgpwcm9wb3'
+        'NhbElkEhcKB25vZGVfaWQYAiABKAlSBm5vZGVJZBIjCg1taW5fZGVjaXNpb25zGAMgASgFUgxt'
+        'aW5EZWNpc2lvbnM=');
+
+@$core.Deprecated('Use acceptRuleProposalResponseDescriptor instead')
+const AcceptRuleProposalResponse$json = {
+  '1': 'AcceptRuleProposalResponse',
+  '2': [
+    {'1': 'success', '3': 1, '4': 1, '5': 8, '10': 'success'},
+    {'1': 'error', '3': 2, '4': 1, '5': 9, '10': 'error'},
+    {'1': 'rule_id', '3': 3, '4': 1, '5': 9, '10': 'ruleId'},
+    {'1': 'rules_created', '3': 4, '4': 1, '5': 5, '10': 'rulesCreated'},
+  ],
+};
+
+/// Descriptor for `AcceptRuleProposalResponse`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List acceptRuleProposalResponseDescriptor =
+    $convert.base64Decode(
+        'ChpBY2NlcHRSdWxlUHJvcG9zYWxSZXNwb25zZRIYCgdzdWNjZXNzGAEgASgIUgdzdWNjZXNzEh'
+        'QKBWVycm9yGAIgASgJUgVlcnJvchIXCgdydWxlX2lkGAMgASgJUgZydWxlSWQSIwoNcnVsZXNf'
+        'Y3JlYXRlZBgEIAEoBVIMcnVsZXNDcmVhdGVk');
+
 @$core.Deprecated('Use connectionStatsDescriptor instead')
 const ConnectionStats$json = {
   '1': 'ConnectionStats',
@@ -4018,6 +4149,24 @@ final $typed_data.Uint8List restartListenersNodeRequestDescriptor =
     $convert.base64Decode(
         'ChtSZXN0YXJ0TGlzdGVuZXJzTm9kZVJlcXVlc3QSFwoHbm9kZV9pZBgBIAEoCVIGbm9kZUlk');
 
+@$core.Deprecated('Use getMockTranscriptsNodeRequestDescriptor instead')
+const GetMockTranscriptsNodeRequest$json = {
+  '1': 'GetMockTranscriptsNodeRequest',
+  '2': [
+    {'1': 'node_id', '3': 1, '4': 1, '5': 9, '10': 'nodeId'},
+    {'1': 'conn_id', '3': 2, '4': 1, '5': 9, '10': 'connId'},
+    {'1': 'source_ip', '3': 3, '4': 1, '5': 9, '10': 'sourceIp'},
+    {'1': 'limit', '3': 4, '4': 1, '5': 5, '10': 'limit'},
+  ],
+};
+
+/// Descriptor for `GetMockTranscriptsNodeRequest`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List getMockTranscriptsNodeRequestDescriptor =
+    $convert.base64Decode(
+        'Ch1HZXRNb2NrVHJhbnNjcmlwdHNOb2RlUmVxdWVzdBIXCgdub2RlX2lkGAEgASgJUgZub2RlSW'
+        'QSFwoHY29ubl9pZBgCIAEoCVIGY29ubklkEhsKCXNvdXJjZV9pcBgDIAEoCVIIc291cmNlSXAS'
+        'FAoFbGltaXQYBCABKAVSBWxpbWl0');
+
 @$core.Deprecated('Use nodeStatusChangeDescriptor instead')
 const NodeStatusChange$json = {
   '1': 'NodeStatusChange',
@@ -4,7 +4,7 @@ import 'dart:async';
 import 'package:synurang/synurang.dart' as synurang;
 import 'nitella_local.pb.dart';
 import 'package:protobuf/well_known_types/google/protobuf/empty.pb.dart';
-import 'package:nitella_app/proxy/proxy.pb.dart' show ConfigureGeoIPResponse, GetGeoIPStatusResponse, RestartListenersResponse, Rule;
+import 'package:nitella_app/proxy/proxy.pb.dart' show ConfigureGeoIPResponse, GetGeoIPStatusResponse, GetMockTranscriptsResponse, RestartListenersResponse, Rule;
 
 class MobileLogicServiceFfi {
   static Future<InitializeResponse> Initialize(InitializeRequest request) async {
@@ -283,6 +283,18 @@ class MobileLogicServiceFfi {
     return ClearApprovalHistoryResponse.fromBuffer(resultBytes);
   }
 
+  static Future<ListRuleProposalsResponse> ListRuleProposals(ListRuleProposalsRequest request) async {
+    final bytes = request.writeToBuffer();
+    final resultBytes = await synurang.invokeBackendAsync('/nitella.local.MobileLogicService/ListRuleProposals', bytes);
+    return ListRuleProposalsResponse.fromBuffer(resultBytes);
+  }
+
+  static Future<AcceptRuleProposalResponse> AcceptRuleProposal(AcceptRuleProposalRequest request) async {
+    final bytes = request.writeToBuffer();
+    final resultBytes = await synurang.invokeBackendAsync('/nitella.local.MobileLogicService/AcceptRuleProposal', bytes);
+    return AcceptRuleProposalResponse.fromBuffer(resultBytes);
+  }
+
   static Future<ConnectionStats> GetConnectionStats(GetConnectionStatsRequest request) async {
     final bytes = request.writeToBuffer();
     final resultBytes = await synurang.invokeBackendAsync('/nitella.local.MobileLogicService/GetConnectionStats', bytes);
@@ -577,6 +589,12 @@ class MobileLogicServiceFfi {
     return RestartListenersResponse.fromBuffer(resultBytes);
   }
 
+  static Future<GetMockTranscriptsResponse> GetMockTranscripts(GetMockTranscriptsNodeRequest request) async {
+    final bytes = request.writeToBuffer();
+    final resultBytes = await synurang.invokeBackendAsync('/nitella.local.MobileLogicService/GetMockTranscripts', bytes);
+    return GetMockTranscriptsResponse.fromBuffer(resultBytes);
+  }
+
   static Future<ListLocalProxyConfigsResponse> ListLocalProxyConfigs(ListLocalProxyConfigsRequest request) async {
     final bytes = request.writeToBuffer();
     final resultBytes = await synurang.invokeBackendAsync('/nitella.local.MobileLogicService/ListLocalProxyConfigs', bytes);
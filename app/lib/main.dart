@@ -135,6 +135,7 @@ class _AppRootState extends State<AppRoot> {
   // Simulates checking if app was launched via notification
   void _checkInitialNotification() {
     // In prod: RemoteMessage? initialMessage = await FirebaseMessaging.instance.getInitialMessage();
+    // and hand initialMessage.data to MobileUIServiceImpl().handlePushData
     // For now, no-op or check environment for testing
   }
 
@@ -227,6 +228,7 @@ class MainScreen extends ConsumerStatefulWidget {
 class _MainScreenState extends ConsumerState<MainScreen> {
   int _currentIndex = 0;
   StreamSubscription? _approvalSub;
+  StreamSubscription? _removalSub;
   StreamSubscription? _alertSub;
   DateTime _lastAlertSnackAt = DateTime.fromMillisecondsSinceEpoch(0);
 
@@ -248,6 +250,13 @@ class _MainScreenState extends ConsumerState<MainScreen> {
       _onIncomingAlert(message: 'New connection request received');
     });
 
+    // Requests settled elsewhere (another device, the node, a push) are
+    // dropped without a snackbar.
+    _removalSub = uiService.requestRemovalStream.listen((requestId) {
+      if (!mounted) return;
+      ref.read(activeApprovalsProvider.notifier).removeApproval(requestId);
+    });
+
     // Listen for raw alerts too (fallback path for hub-streamed events).
     _alertSub = uiService.alertStream.listen((alert) {
       final title = alert.title.trim();
@@ -261,6 +270,7 @@ class _MainScreenState extends ConsumerState<MainScreen> {
   @override
   void dispose() {
     _approvalSub?.cancel();
+    _removalSub?.cancel();
     _alertSub?.cancel();
     super.dispose();
   }
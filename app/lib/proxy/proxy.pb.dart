@@ -519,6 +519,7 @@ class CreateProxyRequest extends $pb.GeneratedMessage {
     ClientAuthType? clientAuthType,
     $core.Iterable<$core.String>? tags,
     HealthCheckConfig? healthCheck,
+    ConnectionThresholds? thresholds,
   }) {
     final result = create();
     if (listenAddr != null) result.listenAddr = listenAddr;
@@ -534,6 +535,7 @@ class CreateProxyRequest extends $pb.GeneratedMessage {
     if (clientAuthType != null) result.clientAuthType = clientAuthType;
     if (tags != null) result.tags.addAll(tags);
     if (healthCheck != null) result.healthCheck = healthCheck;
+    if (thresholds != null) result.thresholds = thresholds;
     return result;
   }
 
@@ -569,6 +571,8 @@ class CreateProxyRequest extends $pb.GeneratedMessage {
     ..pPS(12, _omitFieldNames ? '' : 'tags')
     ..aOM<HealthCheckConfig>(13, _omitFieldNames ? '' : 'healthCheck',
         subBuilder: HealthCheckConfig.create)
+    ..aOM<ConnectionThresholds>(14, _omitFieldNames ? '' : 'thresholds',
+        subBuilder: ConnectionThresholds.create)
     ..hasRequiredFields = false;
 
   @$core.Deprecated('See https://github.com/google/protobuf.dart/issues/998.')
@@ -702,6 +706,17 @@ class CreateProxyRequest extends $pb.GeneratedMessage {
   void clearHealthCheck() => $_clearField(13);
   @$pb.TagNumber(13)
   HealthCheckConfig ensureHealthCheck() => $_ensure(12);
+
+  @$pb.TagNumber(14)
+  ConnectionThresholds get thresholds => $_getN(13);
+  @$pb.TagNumber(14)
+  set thresholds(ConnectionThresholds value) => $_setField(14, value);
+  @$pb.TagNumber(14)
+  $core.bool hasThresholds() => $_has(13);
+  @$pb.TagNumber(14)
+  void clearThresholds() => $_clearField(14);
+  @$pb.TagNumber(14)
+  ConnectionThresholds ensureThresholds() => $_ensure(13);
 }
 
 class HealthCheckConfig extends $pb.GeneratedMessage {
@@ -1261,6 +1276,7 @@ class UpdateProxyRequest extends $pb.GeneratedMessage {
     ClientAuthType? clientAuthType,
     $core.Iterable<$core.String>? tags,
     HealthCheckConfig? healthCheck,
+    ConnectionThresholds? thresholds,
   }) {
     final result = create();
     if (proxyId != null) result.proxyId = proxyId;
@@ -1277,6 +1293,7 @@ class UpdateProxyRequest extends $pb.GeneratedMessage {
     if (clientAuthType != null) result.clientAuthType = clientAuthType;
     if (tags != null) result.tags.addAll(tags);
     if (healthCheck != null) result.healthCheck = healthCheck;
+    if (thresholds != null) result.thresholds = thresholds;
     return result;
   }
 
@@ -1313,6 +1330,8 @@ class UpdateProxyRequest extends $pb.GeneratedMessage {
     ..pPS(13, _omitFieldNames ? '' : 'tags')
     ..aOM<HealthCheckConfig>(14, _omitFieldNames ? '' : 'healthCheck',
         subBuilder: HealthCheckConfig.create)
+    ..aOM<ConnectionThresholds>(15, _omitFieldNames ? '' : 'thresholds',
+        subBuilder: ConnectionThresholds.create)
     ..hasRequiredFields = false;
 
   @$core.Deprecated('See https://github.com/google/protobuf.dart/issues/998.')
@@ -1455,6 +1474,17 @@ class UpdateProxyRequest extends $pb.GeneratedMessage {
   void clearHealthCheck() => $_clearField(14);
   @$pb.TagNumber(14)
   HealthCheckConfig ensureHealthCheck() => $_ensure(13);
+
+  @$pb.TagNumber(15)
+  ConnectionThresholds get thresholds => $_getN(14);
+  @$pb.TagNumber(15)
+  set thresholds(ConnectionThresholds value) => $_setField(15, value);
+  @$pb.TagNumber(15)
+  $core.bool hasThresholds() => $_has(14);
+  @$pb.TagNumber(15)
+  void clearThresholds() => $_clearField(15);
+  @$pb.TagNumber(15)
+  ConnectionThresholds ensureThresholds() => $_ensure(14);
 }
 
 class UpdateProxyResponse extends $pb.GeneratedMessage {
@@ -1676,6 +1706,11 @@ class ProxyStatus extends $pb.GeneratedMessage {
     $core.Iterable<$core.String>? tags,
     HealthCheckConfig? healthCheck,
     HealthStatus? healthStatus,
+    $fixnum.Int64? decisions,
+    $fixnum.Int64? decisionLatencyTotalUs,
+    $fixnum.Int64? decisionLatencyMaxUs,
+    $core.Iterable<$core.MapEntry<$core.String, $fixnum.Int64>>? ruleHits,
+    $fixnum.Int64? rateLimited,
   }) {
     final result = create();
     if (proxyId != null) result.proxyId = proxyId;
@@ -1696,6 +1731,13 @@ class ProxyStatus extends $pb.GeneratedMessage {
     if (tags != null) result.tags.addAll(tags);
     if (healthCheck != null) result.healthCheck = healthCheck;
     if (healthStatus != null) result.healthStatus = healthStatus;
+    if (decisions != null) result.decisions = decisions;
+    if (decisionLatencyTotalUs != null)
+      result.decisionLatencyTotalUs = decisionLatencyTotalUs;
+    if (decisionLatencyMaxUs != null)
+      result.decisionLatencyMaxUs = decisionLatencyMaxUs;
+    if (ruleHits != null) result.ruleHits.addEntries(ruleHits);
+    if (rateLimited != null) result.rateLimited = rateLimited;
     return result;
   }
 
@@ -1737,6 +1779,15 @@ class ProxyStatus extends $pb.GeneratedMessage {
         subBuilder: HealthCheckConfig.create)
     ..aE<HealthStatus>(18, _omitFieldNames ? '' : 'healthStatus',
         enumValues: HealthStatus.values)
+    ..aInt64(19, _omitFieldNames ? '' : 'decisions')
+    ..aInt64(20, _omitFieldNames ? '' : 'decisionLatencyTotalUs')
+    ..aInt64(21, _omitFieldNames ? '' : 'decisionLatencyMaxUs')
+    ..m<$core.String, $fixnum.Int64>(22, _omitFieldNames ? '' : 'ruleHits',
+        entryClassName: 'ProxyStatus.RuleHitsEntry',
+        keyFieldType: $pb.PbFieldType.OS,
+        valueFieldType: $pb.PbFieldType.O6,
+        packageName: const $pb.PackageName('nitella.proxy'))
+    ..aInt64(23, _omitFieldNames ? '' : 'rateLimited')
     ..hasRequiredFields = false;
 
   @$core.Deprecated('See https://github.com/google/protobuf.dart/issues/998.')
@@ -1915,6 +1966,47 @@ class ProxyStatus extends $pb.GeneratedMessage {
   $core.bool hasHealthStatus() => $_has(17);
   @$pb.TagNumber(18)
   void clearHealthStatus() => $_clearField(18);
+
+  /// Time from accept to the forwarding decision (approval waits excluded)
+  @$pb.TagNumber(19)
+  $fixnum.Int64 get decisions => $_getI64(18);
+  @$pb.TagNumber(19)
+  set decisions($fixnum.Int64 value) => $_setInt64(18, value);
+  @$pb.TagNumber(19)
+  $core.bool hasDecisions() => $_has(18);
+  @$pb.TagNumber(19)
+  void clearDecisions() => $_clearField(19);
+
+  @$pb.TagNumber(20)
+  $fixnum.Int64 get decisionLatencyTotalUs => $_getI64(19);
+  @$pb.TagNumber(20)
+  set decisionLatencyTotalUs($fixnum.Int64 value) => $_setInt64(19, value);
+  @$pb.TagNumber(20)
+  $core.bool hasDecisionLatencyTotalUs() => $_has(19);
+  @$pb.TagNumber(20)
+  void clearDecisionLatencyTotalUs() => $_clearField(20);
+
+  @$pb.TagNumber(21)
+  $fixnum.Int64 get decisionLatencyMaxUs => $_getI64(20);
+  @$pb.TagNumber(21)
+  set decisionLatencyMaxUs($fixnum.Int64 value) => $_setInt64(20, value);
+  @$pb.TagNumber(21)
+  $core.bool hasDecisionLatencyMaxUs() => $_has(20);
+  @$pb.TagNumber(21)
+  void clearDecisionLatencyMaxUs() => $_clearField(21);
+
+  /// Connections matched per rule ID, and those blocked by rule rate limits
+  @$pb.TagNumber(22)
+  $pb.PbMap<$core.String, $fixnum.Int64> get ruleHits => $_getMap(21);
+
+  @$pb.TagNumber(23)
+  $fixnum.Int64 get rateLimited => $_getI64(22);
+  @$pb.TagNumber(23)
+  set rateLimited($fixnum.Int64 value) => $_setInt64(22, value);
+  @$pb.TagNumber(23)
+  $core.bool hasRateLimited() => $_has(22);
+  @$pb.TagNumber(23)
+  void clearRateLimited() => $_clearField(23);
 }
 
 class ReloadRulesRequest extends $pb.GeneratedMessage {
@@ -2363,6 +2455,11 @@ class Rule extends $pb.GeneratedMessage {
     RateLimitConfig? rateLimit,
     MockConfig? mockResponse,
     $core.String? expression,
+    $2.Timestamp? notBefore,
+    $2.Timestamp? expiresAt,
+    $core.String? schedule,
+    ConnectionThresholds? thresholds,
+    ApprovalPolicy? approvalPolicy,
   }) {
     final result = create();
     if (id != null) result.id = id;
@@ -2375,6 +2472,11 @@ class Rule extends $pb.GeneratedMessage {
     if (rateLimit != null) result.rateLimit = rateLimit;
     if (mockResponse != null) result.mockResponse = mockResponse;
     if (expression != null) result.expression = expression;
+    if (notBefore != null) result.notBefore = notBefore;
+    if (expiresAt != null) result.expiresAt = expiresAt;
+    if (schedule != null) result.schedule = schedule;
+    if (thresholds != null) result.thresholds = thresholds;
+    if (approvalPolicy != null) result.approvalPolicy = approvalPolicy;
     return result;
   }
 
@@ -2405,6 +2507,15 @@ class Rule extends $pb.GeneratedMessage {
     ..aOM<MockConfig>(9, _omitFieldNames ? '' : 'mockResponse',
         subBuilder: MockConfig.create)
     ..aOS(10, _omitFieldNames ? '' : 'expression')
+    ..aOM<$2.Timestamp>(11, _omitFieldNames ? '' : 'notBefore',
+        subBuilder: $2.Timestamp.create)
+    ..aOM<$2.Timestamp>(12, _omitFieldNames ? '' : 'expiresAt',
+        subBuilder: $2.Timestamp.create)
+    ..aOS(13, _omitFieldNames ? '' : 'schedule')
+    ..aOM<ConnectionThresholds>(14, _omitFieldNames ? '' : 'thresholds',
+        subBuilder: ConnectionThresholds.create)
+    ..aOM<ApprovalPolicy>(15, _omitFieldNames ? '' : 'approvalPolicy',
+        subBuilder: ApprovalPolicy.create)
     ..hasRequiredFields = false;
 
   @$core.Deprecated('See https://github.com/google/protobuf.dart/issues/998.')
@@ -2515,6 +2626,355 @@ class Rule extends $pb.GeneratedMessage {
   $core.bool hasExpression() => $_has(9);
   @$pb.TagNumber(10)
   void clearExpression() => $_clearField(10);
+
+  /// Lifetime: the rule only matches from not_before until expires_at (unset
+  /// = no bound). Expired rules are removed or disabled by the node.
+  @$pb.TagNumber(11)
+  $2.Timestamp get notBefore => $_getN(10);
+  @$pb.TagNumber(11)
+  set notBefore($2.Timestamp value) => $_setField(11, value);
+  @$pb.TagNumber(11)
+  $core.bool hasNotBefore() => $_has(10);
+  @$pb.TagNumber(11)
+  void clearNotBefore() => $_clearField(11);
+  @$pb.TagNumber(11)
+  $2.Timestamp ensureNotBefore() => $_ensure(10);
+
+  @$pb.TagNumber(12)
+  $2.Timestamp get expiresAt => $_getN(11);
+  @$pb.TagNumber(12)
+  set expiresAt($2.Timestamp value) => $_setField(12, value);
+  @$pb.TagNumber(12)
+  $core.bool hasExpiresAt() => $_has(11);
+  @$pb.TagNumber(12)
+  void clearExpiresAt() => $_clearField(12);
+  @$pb.TagNumber(12)
+  $2.Timestamp ensureExpiresAt() => $_ensure(11);
+
+  /// Recurring active window in the node's local time, e.g.
+  /// "Mon-Fri 09:00-17:00", "Sat,Sun" or "22:00-06:00" (empty = always).
+  @$pb.TagNumber(13)
+  $core.String get schedule => $_getSZ(12);
+  @$pb.TagNumber(13)
+  set schedule($core.String value) => $_setString(12, value);
+  @$pb.TagNumber(13)
+  $core.bool hasSchedule() => $_has(12);
+  @$pb.TagNumber(13)
+  void clearSchedule() => $_clearField(13);
+
+  /// Limits for connections this rule forwards (overrides the listener's)
+  @$pb.TagNumber(14)
+  ConnectionThresholds get thresholds => $_getN(13);
+  @$pb.TagNumber(14)
+  set thresholds(ConnectionThresholds value) => $_setField(14, value);
+  @$pb.TagNumber(14)
+  $core.bool hasThresholds() => $_has(13);
+  @$pb.TagNumber(14)
+  void clearThresholds() => $_clearField(14);
+  @$pb.TagNumber(14)
+  ConnectionThresholds ensureThresholds() => $_ensure(13);
+
+  /// How a REQUIRE_APPROVAL rule waits for a decision (unset = hold the
+  /// client silently for 2 minutes, then deny)
+  @$pb.TagNumber(15)
+  ApprovalPolicy get approvalPolicy => $_getN(14);
+  @$pb.TagNumber(15)
+  set approvalPolicy(ApprovalPolicy value) => $_setField(15, value);
+  @$pb.TagNumber(15)
+  $core.bool hasApprovalPolicy() => $_has(14);
+  @$pb.TagNumber(15)
+  void clearApprovalPolicy() => $_clearField(15);
+  @$pb.TagNumber(15)
+  ApprovalPolicy ensureApprovalPolicy() => $_ensure(14);
+}
+
+/// ApprovalPolicy is how long a connection waits for an approval decision,
+/// what happens when none arrives, and what the client sees meanwhile.
+class ApprovalPolicy extends $pb.GeneratedMessage {
+  factory ApprovalPolicy({
+    $fixnum.Int64? timeoutSeconds,
+    ApprovalTimeoutAction? onTimeout,
+    $1.MockPreset? timeoutMock,
+    ApprovalHold? hold,
+    $core.int? quorum,
+    LocalApprover? local,
+  }) {
+    final result = create();
+    if (timeoutSeconds != null) result.timeoutSeconds = timeoutSeconds;
+    if (onTimeout != null) result.onTimeout = onTimeout;
+    if (timeoutMock != null) result.timeoutMock = timeoutMock;
+    if (hold != null) result.hold = hold;
+    if (quorum != null) result.quorum = quorum;
+    if (local != null) result.local = local;
+    return result;
+  }
+
+  ApprovalPolicy._();
+
+  factory ApprovalPolicy.fromBuffer($core.List<$core.int> data,
+          [$pb.ExtensionRegistry registry = $pb.ExtensionRegistry.EMPTY]) =>
+      create()..mergeFromBuffer(data, registry);
+  factory ApprovalPolicy.fromJson($core.String json,
+          [$pb.ExtensionRegistry registry = $pb.ExtensionRegistry.EMPTY]) =>
+      create()..mergeFromJson(json, registry);
+
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(
+      _omitMessageNames ? '' : 'ApprovalPolicy',
+      package: const $pb.PackageName(_omitMessageNames ? '' : 'nitella.proxy'),
+      createEmptyInstance: create)
+    ..aInt64(1, _omitFieldNames ? '' : 'timeoutSeconds')
+    ..aE<ApprovalTimeoutAction>(2, _omitFieldNames ? '' : 'onTimeout',
+        enumValues: ApprovalTimeoutAction.values)
+    ..aE<$1.MockPreset>(3, _omitFieldNames ? '' : 'timeoutMock',
+        enumValues: $1.MockPreset.values)
+    ..aE<ApprovalHold>(4, _omitFieldNames ? '' : 'hold',
+        enumValues: ApprovalHold.values)
+    ..aI(5, _omitFieldNames ? '' : 'quorum')
+    ..aOM<LocalApprover>(6, _omitFieldNames ? '' : 'local',
+        subBuilder: LocalApprover.create)
+    ..hasRequiredFields = false;
+
+  @$core.Deprecated('See https://github.com/google/protobuf.dart/issues/998.')
+  ApprovalPolicy clone() => deepCopy();
+  @$core.Deprecated('See https://github.com/google/protobuf.dart/issues/998.')
+  ApprovalPolicy copyWith(void Function(ApprovalPolicy) updates) =>
+      super.copyWith((message) => updates(message as ApprovalPolicy))
+          as ApprovalPolicy;
+
+  @$core.override
+  $pb.BuilderInfo get info_ => _i;
+
+  @$core.pragma('dart2js:noInline')
+  static ApprovalPolicy create() => ApprovalPolicy._();
+  @$core.override
+  ApprovalPolicy createEmptyInstance() => create();
+  @$core.pragma('dart2js:noInline')
+  static ApprovalPolicy getDefault() => _defaultInstance ??=
+      $pb.GeneratedMessage.$_defaultFor<ApprovalPolicy>(create);
+  static ApprovalPolicy? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $fixnum.Int64 get timeoutSeconds => $_getI64(0);
+  @$pb.TagNumber(1)
+  set timeoutSeconds($fixnum.Int64 value) => $_setInt64(0, value);
+  @$pb.TagNumber(1)
+  $core.bool hasTimeoutSeconds() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearTimeoutSeconds() => $_clearField(1);
+
+  @$pb.TagNumber(2)
+  ApprovalTimeoutAction get onTimeout => $_getN(1);
+  @$pb.TagNumber(2)
+  set onTimeout(ApprovalTimeoutAction value) => $_setField(2, value);
+  @$pb.TagNumber(2)
+  $core.bool hasOnTimeout() => $_has(1);
+  @$pb.TagNumber(2)
+  void clearOnTimeout() => $_clearField(2);
+
+  @$pb.TagNumber(3)
+  $1.MockPreset get timeoutMock => $_getN(2);
+  @$pb.TagNumber(3)
+  set timeoutMock($1.MockPreset value) => $_setField(3, value);
+  @$pb.TagNumber(3)
+  $core.bool hasTimeoutMock() => $_has(2);
+  @$pb.TagNumber(3)
+  void clearTimeoutMock() => $_clearField(3);
+
+  @$pb.TagNumber(4)
+  ApprovalHold get hold => $_getN(3);
+  @$pb.TagNumber(4)
+  set hold(ApprovalHold value) => $_setField(4, value);
+  @$pb.TagNumber(4)
+  $core.bool hasHold() => $_has(3);
+  @$pb.TagNumber(4)
+  void clearHold() => $_clearField(4);
+
+  @$pb.TagNumber(5)
+  $core.int get quorum => $_getIZ(4);
+  @$pb.TagNumber(5)
+  set quorum($core.int value) => $_setSignedInt32(4, value);
+  @$pb.TagNumber(5)
+  $core.bool hasQuorum() => $_has(4);
+  @$pb.TagNumber(5)
+  void clearQuorum() => $_clearField(5);
+
+  @$pb.TagNumber(6)
+  LocalApprover get local => $_getN(5);
+  @$pb.TagNumber(6)
+  set local(LocalApprover value) => $_setField(6, value);
+  @$pb.TagNumber(6)
+  $core.bool hasLocal() => $_has(5);
+  @$pb.TagNumber(6)
+  void clearLocal() => $_clearField(6);
+  @$pb.TagNumber(6)
+  LocalApprover ensureLocal() => $_ensure(5);
+}
+
+/// LocalApprover resolves approval requests without the Hub. It names an
+/// approver defined on the node: one of the YAML config's approvers, or
+/// "queue" for the node's --approval-socket. What runs or is called is node
+/// configuration, never part of a rule. Requests and decisions are JSON
+/// (see docs/APPROVAL_SYSTEM.md).
+class LocalApprover extends $pb.GeneratedMessage {
+  factory LocalApprover({
+    $core.String? name,
+  }) {
+    final result = create();
+    if (name != null) result.name = name;
+    return result;
+  }
+
+  LocalApprover._();
+
+  factory LocalApprover.fromBuffer($core.List<$core.int> data,
+          [$pb.ExtensionRegistry registry = $pb.ExtensionRegistry.EMPTY]) =>
+      create()..mergeFromBuffer(data, registry);
+  factory LocalApprover.fromJson($core.String json,
+          [$pb.ExtensionRegistry registry = $pb.ExtensionRegistry.EMPTY]) =>
+      create()..mergeFromJson(json, registry);
+
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(
+      _omitMessageNames ? '' : 'LocalApprover',
+      package: const $pb.PackageName(_omitMessageNames ? '' : 'nitella.proxy'),
+      createEmptyInstance: create)
+    ..aOS(6, _omitFieldNames ? '' : 'name')
+    ..hasRequiredFields = false;
+
+  @$core.Deprecated('See https://github.com/google/protobuf.dart/issues/998.')
+  LocalApprover clone() => deepCopy();
+  @$core.Deprecated('See https://github.com/google/protobuf.dart/issues/998.')
+  LocalApprover copyWith(void Function(LocalApprover) updates) =>
+      super.copyWith((message) => updates(message as LocalApprover))
+          as LocalApprover;
+
+  @$core.override
+  $pb.BuilderInfo get info_ => _i;
+
+  @$core.pragma('dart2js:noInline')
+  static LocalApprover create() => LocalApprover._();
+  @$core.override
+  LocalApprover createEmptyInstance() => create();
+  @$core.pragma('dart2js:noInline')
+  static LocalApprover getDefault() => _defaultInstance ??=
+      $pb.GeneratedMessage.$_defaultFor<LocalApprover>(create);
+  static LocalApprover? _defaultInstance;
+
+  @$pb.TagNumber(6)
+  $core.String get name => $_getSZ(0);
+  @$pb.TagNumber(6)
+  set name($core.String value) => $_setString(0, value);
+  @$pb.TagNumber(6)
+  $core.bool hasName() => $_has(0);
+  @$pb.TagNumber(6)
+  void clearName() => $_clearField(6);
+}
+
+/// ConnectionThresholds are limits checked while a forwarded connection is
+/// open. A zero limit is not checked.
+class ConnectionThresholds extends $pb.GeneratedMessage {
+  factory ConnectionThresholds({
+    $fixnum.Int64? maxBytesOut,
+    $fixnum.Int64? maxSourceBytesOutHour,
+    $fixnum.Int64? maxDurationSeconds,
+    ThresholdAction? action,
+    $fixnum.Int64? throttleBytesPerSecond,
+  }) {
+    final result = create();
+    if (maxBytesOut != null) result.maxBytesOut = maxBytesOut;
+    if (maxSourceBytesOutHour != null)
+      result.maxSourceBytesOutHour = maxSourceBytesOutHour;
+    if (maxDurationSeconds != null)
+      result.maxDurationSeconds = maxDurationSeconds;
+    if (action != null) result.action = action;
+    if (throttleBytesPerSecond != null)
+      result.throttleBytesPerSecond = throttleBytesPerSecond;
+    return result;
+  }
+
+  ConnectionThresholds._();
+
+  factory ConnectionThresholds.fromBuffer($core.List<$core.int> data,
+          [$pb.ExtensionRegistry registry = $pb.ExtensionRegistry.EMPTY]) =>
+      create()..mergeFromBuffer(data, registry);
+  factory ConnectionThresholds.fromJson($core.String json,
+          [$pb.ExtensionRegistry registry = $pb.ExtensionRegistry.EMPTY]) =>
+      create()..mergeFromJson(json, registry);
+
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(
+      _omitMessageNames ? '' : 'ConnectionThresholds',
+      package: const $pb.PackageName(_omitMessageNames ? '' : 'nitella.proxy'),
+      createEmptyInstance: create)
+    ..aInt64(1, _omitFieldNames ? '' : 'maxBytesOut')
+    ..aInt64(2, _omitFieldNames ? '' : 'maxSourceBytesOutHour')
+    ..aInt64(3, _omitFieldNames ? '' : 'maxDurationSeconds')
+    ..aE<ThresholdAction>(4, _omitFieldNames ? '' : 'action',
+        enumValues: ThresholdAction.values)
+    ..aInt64(5, _omitFieldNames ? '' : 'throttleBytesPerSecond')
+    ..hasRequiredFields = false;
+
+  @$core.Deprecated('See https://github.com/google/protobuf.dart/issues/998.')
+  ConnectionThresholds clone() => deepCopy();
+  @$core.Deprecated('See https://github.com/google/protobuf.dart/issues/998.')
+  ConnectionThresholds copyWith(void Function(ConnectionThresholds) updates) =>
+      super.copyWith((message) => updates(message as ConnectionThresholds))
+          as ConnectionThresholds;
+
+  @$core.override
+  $pb.BuilderInfo get info_ => _i;
+
+  @$core.pragma('dart2js:noInline')
+  static ConnectionThresholds create() => ConnectionThresholds._();
+  @$core.override
+  ConnectionThresholds createEmptyInstance() => create();
+  @$core.pragma('dart2js:noInline')
+  static ConnectionThresholds getDefault() => _defaultInstance ??=
+      $pb.GeneratedMessage.$_defaultFor<ConnectionThresholds>(create);
+  static ConnectionThresholds? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $fixnum.Int64 get maxBytesOut => $_getI64(0);
+  @$pb.TagNumber(1)
+  set maxBytesOut($fixnum.Int64 value) => $_setInt64(0, value);
+  @$pb.TagNumber(1)
+  $core.bool hasMaxBytesOut() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearMaxBytesOut() => $_clearField(1);
+
+  @$pb.TagNumber(2)
+  $fixnum.Int64 get maxSourceBytesOutHour => $_getI64(1);
+  @$pb.TagNumber(2)
+  set maxSourceBytesOutHour($fixnum.Int64 value) => $_setInt64(1, value);
+  @$pb.TagNumber(2)
+  $core.bool hasMaxSourceBytesOutHour() => $_has(1);
+  @$pb.TagNumber(2)
+  void clearMaxSourceBytesOutHour() => $_clearField(2);
+
+  @$pb.TagNumber(3)
+  $fixnum.Int64 get maxDurationSeconds => $_getI64(2);
+  @$pb.TagNumber(3)
+  set maxDurationSeconds($fixnum.Int64 value) => $_setInt64(2, value);
+  @$pb.TagNumber(3)
+  $core.bool hasMaxDurationSeconds() => $_has(2);
+  @$pb.TagNumber(3)
+  void clearMaxDurationSeconds() => $_clearField(3);
+
+  @$pb.TagNumber(4)
+  ThresholdAction get action => $_getN(3);
+  @$pb.TagNumber(4)
+  set action(ThresholdAction value) => $_setField(4, value);
+  @$pb.TagNumber(4)
+  $core.bool hasAction() => $_has(3);
+  @$pb.TagNumber(4)
+  void clearAction() => $_clearField(4);
+
+  @$pb.TagNumber(5)
+  $fixnum.Int64 get throttleBytesPerSecond => $_getI64(4);
+  @$pb.TagNumber(5)
+  set throttleBytesPerSecond($fixnum.Int64 value) => $_setInt64(4, value);
+  @$pb.TagNumber(5)
+  $core.bool hasThrottleBytesPerSecond() => $_has(4);
+  @$pb.TagNumber(5)
+  void clearThrottleBytesPerSecond() => $_clearField(5);
 }
 
 class Condition extends $pb.GeneratedMessage {
@@ -2743,12 +3203,43 @@ class MockConfig extends $pb.GeneratedMessage {
     $core.String? protocol,
     $core.List<$core.int>? payload,
     $core.int? delayMs,
+    $core.bool? acceptLogin,
+    $core.Iterable<$core.String>? httpRoutePacks,
+    $core.String? httpRoutesFile,
+    $core.Iterable<$core.String>? canaryPaths,
+    $core.int? canaryBlockSeconds,
+    $core.String? smbDialect,
+    $core.String? smbOsVersion,
+    $core.String? smbHostname,
+    $core.String? scriptFile,
+    $core.String? script,
+    $core.String? clonedPreset,
+    $core.bool? tls,
+    $core.String? tlsCommonName,
+    $core.String? tlsOrganization,
+    $core.Iterable<$core.String>? tlsSans,
   }) {
     final result = create();
     if (preset != null) result.preset = preset;
     if (protocol != null) result.protocol = protocol;
     if (payload != null) result.payload = payload;
     if (delayMs != null) result.delayMs = delayMs;
+    if (acceptLogin != null) result.acceptLogin = acceptLogin;
+    if (httpRoutePacks != null) result.httpRoutePacks.addAll(httpRoutePacks);
+    if (httpRoutesFile != null) result.httpRoutesFile = httpRoutesFile;
+    if (canaryPaths != null) result.canaryPaths.addAll(canaryPaths);
+    if (canaryBlockSeconds != null)
+      result.canaryBlockSeconds = canaryBlockSeconds;
+    if (smbDialect != null) result.smbDialect = smbDialect;
+    if (smbOsVersion != null) result.smbOsVersion = smbOsVersion;
+    if (smbHostname != null) result.smbHostname = smbHostname;
+    if (scriptFile != null) result.scriptFile = scriptFile;
+    if (script != null) result.script = script;
+    if (clonedPreset != null) result.clonedPreset = clonedPreset;
+    if (tls != null) result.tls = tls;
+    if (tlsCommonName != null) result.tlsCommonName = tlsCommonName;
+    if (tlsOrganization != null) result.tlsOrganization = tlsOrganization;
+    if (tlsSans != null) result.tlsSans.addAll(tlsSans);
     return result;
   }
 
@@ -2771,6 +3262,21 @@ class MockConfig extends $pb.GeneratedMessage {
     ..a<$core.List<$core.int>>(
         3, _omitFieldNames ? '' : 'payload', $pb.PbFieldType.OY)
     ..aI(4, _omitFieldNames ? '' : 'delayMs')
+    ..aOB(5, _omitFieldNames ? '' : 'acceptLogin')
+    ..pPS(6, _omitFieldNames ? '' : 'httpRoutePacks')
+    ..aOS(7, _omitFieldNames ? '' : 'httpRoutesFile')
+    ..pPS(8, _omitFieldNames ? '' : 'canaryPaths')
+    ..aI(9, _omitFieldNames ? '' : 'canaryBlockSeconds')
+    ..aOS(10, _omitFieldNames ? '' : 'smbDialect')
+    ..aOS(11, _omitFieldNames ? '' : 'smbOsVersion')
+    ..aOS(12, _omitFieldNames ? '' : 'smbHostname')
+    ..aOS(13, _omitFieldNames ? '' : 'scriptFile')
+    ..aOS(14, _omitFieldNames ? '' : 'script')
+    ..aOS(15, _omitFieldNames ? '' : 'clonedPreset')
+    ..aOB(16, _omitFieldNames ? '' : 'tls')
+    ..aOS(17, _omitFieldNames ? '' : 'tlsCommonName')
+    ..aOS(18, _omitFieldNames ? '' : 'tlsOrganization')
+    ..pPS(19, _omitFieldNames ? '' : 'tlsSans')
     ..hasRequiredFields = false;
 
   @$core.Deprecated('See https://github.com/google/protobuf.dart/issues/998.')
@@ -2826,57 +3332,180 @@ class MockConfig extends $pb.GeneratedMessage {
   $core.bool hasDelayMs() => $_has(3);
   @$pb.TagNumber(4)
   void clearDelayMs() => $_clearField(4);
-}
-
-class AddRuleRequest extends $pb.GeneratedMessage {
-  factory AddRuleRequest({
-    $core.String? proxyId,
-    Rule? rule,
-  }) {
-    final result = create();
-    if (proxyId != null) result.proxyId = proxyId;
-    if (rule != null) result.rule = rule;
-    return result;
-  }
 
-  AddRuleRequest._();
+  @$pb.TagNumber(5)
+  $core.bool get acceptLogin => $_getBF(4);
+  @$pb.TagNumber(5)
+  set acceptLogin($core.bool value) => $_setBool(4, value);
+  @$pb.TagNumber(5)
+  $core.bool hasAcceptLogin() => $_has(4);
+  @$pb.TagNumber(5)
+  void clearAcceptLogin() => $_clearField(5);
 
-  factory AddRuleRequest.fromBuffer($core.List<$core.int> data,
-          [$pb.ExtensionRegistry registry = $pb.ExtensionRegistry.EMPTY]) =>
-      create()..mergeFromBuffer(data, registry);
-  factory AddRuleRequest.fromJson($core.String json,
-          [$pb.ExtensionRegistry registry = $pb.ExtensionRegistry.EMPTY]) =>
-      create()..mergeFromJson(json, registry);
+  /// HTTP honeypot (protocol "http"): serve a route table instead of a fixed page
+  @$pb.TagNumber(6)
+  $pb.PbList<$core.String> get httpRoutePacks => $_getList(5);
 
-  static final $pb.BuilderInfo _i = $pb.BuilderInfo(
-      _omitMessageNames ? '' : 'AddRuleRequest',
-      package: const $pb.PackageName(_omitMessageNames ? '' : 'nitella.proxy'),
-      createEmptyInstance: create)
-    ..aOS(1, _omitFieldNames ? '' : 'proxyId')
-    ..aOM<Rule>(2, _omitFieldNames ? '' : 'rule', subBuilder: Rule.create)
-    ..hasRequiredFields = false;
+  @$pb.TagNumber(7)
+  $core.String get httpRoutesFile => $_getSZ(6);
+  @$pb.TagNumber(7)
+  set httpRoutesFile($core.String value) => $_setString(6, value);
+  @$pb.TagNumber(7)
+  $core.bool hasHttpRoutesFile() => $_has(6);
+  @$pb.TagNumber(7)
+  void clearHttpRoutesFile() => $_clearField(7);
 
-  @$core.Deprecated('See https://github.com/google/protobuf.dart/issues/998.')
-  AddRuleRequest clone() => deepCopy();
-  @$core.Deprecated('See https://github.com/google/protobuf.dart/issues/998.')
-  AddRuleRequest copyWith(void Function(AddRuleRequest) updates) =>
-      super.copyWith((message) => updates(message as AddRuleRequest))
-          as AddRuleRequest;
+  @$pb.TagNumber(8)
+  $pb.PbList<$core.String> get canaryPaths => $_getList(7);
 
-  @$core.override
-  $pb.BuilderInfo get info_ => _i;
+  @$pb.TagNumber(9)
+  $core.int get canaryBlockSeconds => $_getIZ(8);
+  @$pb.TagNumber(9)
+  set canaryBlockSeconds($core.int value) => $_setSignedInt32(8, value);
+  @$pb.TagNumber(9)
+  $core.bool hasCanaryBlockSeconds() => $_has(8);
+  @$pb.TagNumber(9)
+  void clearCanaryBlockSeconds() => $_clearField(9);
 
-  @$core.pragma('dart2js:noInline')
-  static AddRuleRequest create() => AddRuleRequest._();
-  @$core.override
-  AddRuleRequest createEmptyInstance() => create();
-  @$core.pragma('dart2js:noInline')
-  static AddRuleRequest getDefault() => _defaultInstance ??=
-      $pb.GeneratedMessage.$_defaultFor<AddRuleRequest>(create);
-  static AddRuleRequest? _defaultInstance;
+  /// SMB (protocol "smb"): identity revealed to scanners
+  @$pb.TagNumber(10)
+  $core.String get smbDialect => $_getSZ(9);
+  @$pb.TagNumber(10)
+  set smbDialect($core.String value) => $_setString(9, value);
+  @$pb.TagNumber(10)
+  $core.bool hasSmbDialect() => $_has(9);
+  @$pb.TagNumber(10)
+  void clearSmbDialect() => $_clearField(10);
 
-  @$pb.TagNumber(1)
-  $core.String get proxyId => $_getSZ(0);
+  @$pb.TagNumber(11)
+  $core.String get smbOsVersion => $_getSZ(10);
+  @$pb.TagNumber(11)
+  set smbOsVersion($core.String value) => $_setString(10, value);
+  @$pb.TagNumber(11)
+  $core.bool hasSmbOsVersion() => $_has(10);
+  @$pb.TagNumber(11)
+  void clearSmbOsVersion() => $_clearField(11);
+
+  @$pb.TagNumber(12)
+  $core.String get smbHostname => $_getSZ(11);
+  @$pb.TagNumber(12)
+  set smbHostname($core.String value) => $_setString(11, value);
+  @$pb.TagNumber(12)
+  $core.bool hasSmbHostname() => $_has(11);
+  @$pb.TagNumber(12)
+  void clearSmbHostname() => $_clearField(12);
+
+  /// Scripted mock (protocol "script"): expect/send conversation, see docs/MOCK.md
+  @$pb.TagNumber(13)
+  $core.String get scriptFile => $_getSZ(12);
+  @$pb.TagNumber(13)
+  set scriptFile($core.String value) => $_setString(12, value);
+  @$pb.TagNumber(13)
+  $core.bool hasScriptFile() => $_has(12);
+  @$pb.TagNumber(13)
+  void clearScriptFile() => $_clearField(13);
+
+  @$pb.TagNumber(14)
+  $core.String get script => $_getSZ(13);
+  @$pb.TagNumber(14)
+  set script($core.String value) => $_setString(13, value);
+  @$pb.TagNumber(14)
+  $core.bool hasScript() => $_has(13);
+  @$pb.TagNumber(14)
+  void clearScript() => $_clearField(14);
+
+  @$pb.TagNumber(15)
+  $core.String get clonedPreset => $_getSZ(14);
+  @$pb.TagNumber(15)
+  set clonedPreset($core.String value) => $_setString(14, value);
+  @$pb.TagNumber(15)
+  $core.bool hasClonedPreset() => $_has(14);
+  @$pb.TagNumber(15)
+  void clearClonedPreset() => $_clearField(15);
+
+  /// TLS-wrapped mock (HTTPS, SMTPS, ...): handshake with a generated
+  /// self-signed certificate, record the client's JA3/JA4 fingerprint, then
+  /// run the protocol mock inside the TLS session
+  @$pb.TagNumber(16)
+  $core.bool get tls => $_getBF(15);
+  @$pb.TagNumber(16)
+  set tls($core.bool value) => $_setBool(15, value);
+  @$pb.TagNumber(16)
+  $core.bool hasTls() => $_has(15);
+  @$pb.TagNumber(16)
+  void clearTls() => $_clearField(16);
+
+  @$pb.TagNumber(17)
+  $core.String get tlsCommonName => $_getSZ(16);
+  @$pb.TagNumber(17)
+  set tlsCommonName($core.String value) => $_setString(16, value);
+  @$pb.TagNumber(17)
+  $core.bool hasTlsCommonName() => $_has(16);
+  @$pb.TagNumber(17)
+  void clearTlsCommonName() => $_clearField(17);
+
+  @$pb.TagNumber(18)
+  $core.String get tlsOrganization => $_getSZ(17);
+  @$pb.TagNumber(18)
+  set tlsOrganization($core.String value) => $_setString(17, value);
+  @$pb.TagNumber(18)
+  $core.bool hasTlsOrganization() => $_has(17);
+  @$pb.TagNumber(18)
+  void clearTlsOrganization() => $_clearField(18);
+
+  @$pb.TagNumber(19)
+  $pb.PbList<$core.String> get tlsSans => $_getList(18);
+}
+
+class AddRuleRequest extends $pb.GeneratedMessage {
+  factory AddRuleRequest({
+    $core.String? proxyId,
+    Rule? rule,
+  }) {
+    final result = create();
+    if (proxyId != null) result.proxyId = proxyId;
+    if (rule != null) result.rule = rule;
+    return result;
+  }
+
+  AddRuleRequest._();
+
+  factory AddRuleRequest.fromBuffer($core.List<$core.int> data,
+          [$pb.ExtensionRegistry registry = $pb.ExtensionRegistry.EMPTY]) =>
+      create()..mergeFromBuffer(data, registry);
+  factory AddRuleRequest.fromJson($core.String json,
+          [$pb.ExtensionRegistry registry = $pb.ExtensionRegistry.EMPTY]) =>
+      create()..mergeFromJson(json, registry);
+
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(
+      _omitMessageNames ? '' : 'AddRuleRequest',
+      package: const $pb.PackageName(_omitMessageNames ? '' : 'nitella.proxy'),
+      createEmptyInstance: create)
+    ..aOS(1, _omitFieldNames ? '' : 'proxyId')
+    ..aOM<Rule>(2, _omitFieldNames ? '' : 'rule', subBuilder: Rule.create)
+    ..hasRequiredFields = false;
+
+  @$core.Deprecated('See https://github.com/google/protobuf.dart/issues/998.')
+  AddRuleRequest clone() => deepCopy();
+  @$core.Deprecated('See https://github.com/google/protobuf.dart/issues/998.')
+  AddRuleRequest copyWith(void Function(AddRuleRequest) updates) =>
+      super.copyWith((message) => updates(message as AddRuleRequest))
+          as AddRuleRequest;
+
+  @$core.override
+  $pb.BuilderInfo get info_ => _i;
+
+  @$core.pragma('dart2js:noInline')
+  static AddRuleRequest create() => AddRuleRequest._();
+  @$core.override
+  AddRuleRequest createEmptyInstance() => create();
+  @$core.pragma('dart2js:noInline')
+  static AddRuleRequest getDefault() => _defaultInstance ??=
+      $pb.GeneratedMessage.$_defaultFor<AddRuleRequest>(create);
+  static AddRuleRequest? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $core.String get proxyId => $_getSZ(0);
   @$pb.TagNumber(1)
   set proxyId($core.String value) => $_setString(0, value);
   @$pb.TagNumber(1)
@@ -3707,6 +4336,8 @@ class ConnectionEvent extends $pb.GeneratedMessage {
     $fixnum.Int64? bytesIn,
     $fixnum.Int64? bytesOut,
     $1.GeoInfo? geo,
+    MockCapture? capture,
+    $core.String? threshold,
   }) {
     final result = create();
     if (connId != null) result.connId = connId;
@@ -3720,6 +4351,8 @@ class ConnectionEvent extends $pb.GeneratedMessage {
     if (bytesIn != null) result.bytesIn = bytesIn;
     if (bytesOut != null) result.bytesOut = bytesOut;
     if (geo != null) result.geo = geo;
+    if (capture != null) result.capture = capture;
+    if (threshold != null) result.threshold = threshold;
     return result;
   }
 
@@ -3750,6 +4383,9 @@ class ConnectionEvent extends $pb.GeneratedMessage {
     ..aInt64(10, _omitFieldNames ? '' : 'bytesOut')
     ..aOM<$1.GeoInfo>(11, _omitFieldNames ? '' : 'geo',
         subBuilder: $1.GeoInfo.create)
+    ..aOM<MockCapture>(12, _omitFieldNames ? '' : 'capture',
+        subBuilder: MockCapture.create)
+    ..aOS(13, _omitFieldNames ? '' : 'threshold')
     ..hasRequiredFields = false;
 
   @$core.Deprecated('See https://github.com/google/protobuf.dart/issues/998.')
@@ -3873,6 +4509,131 @@ class ConnectionEvent extends $pb.GeneratedMessage {
   void clearGeo() => $_clearField(11);
   @$pb.TagNumber(11)
   $1.GeoInfo ensureGeo() => $_ensure(10);
+
+  /// Attacker input captured by a mock (EVENT_TYPE_MOCK_CAPTURE only)
+  @$pb.TagNumber(12)
+  MockCapture get capture => $_getN(11);
+  @$pb.TagNumber(12)
+  set capture(MockCapture value) => $_setField(12, value);
+  @$pb.TagNumber(12)
+  $core.bool hasCapture() => $_has(11);
+  @$pb.TagNumber(12)
+  void clearCapture() => $_clearField(12);
+  @$pb.TagNumber(12)
+  MockCapture ensureCapture() => $_ensure(11);
+
+  /// Limit crossed (EVENT_TYPE_THRESHOLD_* only): "bytes_out",
+  /// "source_bytes_out_hour" or "duration"
+  @$pb.TagNumber(13)
+  $core.String get threshold => $_getSZ(12);
+  @$pb.TagNumber(13)
+  set threshold($core.String value) => $_setString(12, value);
+  @$pb.TagNumber(13)
+  $core.bool hasThreshold() => $_has(12);
+  @$pb.TagNumber(13)
+  void clearThreshold() => $_clearField(13);
+}
+
+/// MockCapture is data an attacker sent to a mock (honeypot) listener.
+/// It is never forwarded to a backend.
+class MockCapture extends $pb.GeneratedMessage {
+  factory MockCapture({
+    $core.String? protocol,
+    $core.String? kind,
+    $core.String? username,
+    $core.String? password,
+    $core.Iterable<$core.MapEntry<$core.String, $core.String>>? fields,
+  }) {
+    final result = create();
+    if (protocol != null) result.protocol = protocol;
+    if (kind != null) result.kind = kind;
+    if (username != null) result.username = username;
+    if (password != null) result.password = password;
+    if (fields != null) result.fields.addEntries(fields);
+    return result;
+  }
+
+  MockCapture._();
+
+  factory MockCapture.fromBuffer($core.List<$core.int> data,
+          [$pb.ExtensionRegistry registry = $pb.ExtensionRegistry.EMPTY]) =>
+      create()..mergeFromBuffer(data, registry);
+  factory MockCapture.fromJson($core.String json,
+          [$pb.ExtensionRegistry registry = $pb.ExtensionRegistry.EMPTY]) =>
+      create()..mergeFromJson(json, registry);
+
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(
+      _omitMessageNames ? '' : 'MockCapture',
+      package: const $pb.PackageName(_omitMessageNames ? '' : 'nitella.proxy'),
+      createEmptyInstance: create)
+    ..aOS(1, _omitFieldNames ? '' : 'protocol')
+    ..aOS(2, _omitFieldNames ? '' : 'kind')
+    ..aOS(3, _omitFieldNames ? '' : 'username')
+    ..aOS(4, _omitFieldNames ? '' : 'password')
+    ..m<$core.String, $core.String>(5, _omitFieldNames ? '' : 'fields',
+        entryClassName: 'MockCapture.FieldsEntry',
+        keyFieldType: $pb.PbFieldType.OS,
+        valueFieldType: $pb.PbFieldType.OS,
+        packageName: const $pb.PackageName('nitella.proxy'))
+    ..hasRequiredFields = false;
+
+  @$core.Deprecated('See https://github.com/google/protobuf.dart/issues/998.')
+  MockCapture clone() => deepCopy();
+  @$core.Deprecated('See https://github.com/google/protobuf.dart/issues/998.')
+  MockCapture copyWith(void Function(MockCapture) updates) =>
+      super.copyWith((message) => updates(message as MockCapture))
+          as MockCapture;
+
+  @$core.override
+  $pb.BuilderInfo get info_ => _i;
+
+  @$core.pragma('dart2js:noInline')
+  static MockCapture create() => MockCapture._();
+  @$core.override
+  MockCapture createEmptyInstance() => create();
+  @$core.pragma('dart2js:noInline')
+  static MockCapture getDefault() => _defaultInstance ??=
+      $pb.GeneratedMessage.$_defaultFor<MockCapture>(create);
+  static MockCapture? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $core.String get protocol => $_getSZ(0);
+  @$pb.TagNumber(1)
+  set protocol($core.String value) => $_setString(0, value);
+  @$pb.TagNumber(1)
+  $core.bool hasProtocol() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearProtocol() => $_clearField(1);
+
+  @$pb.TagNumber(2)
+  $core.String get kind => $_getSZ(1);
+  @$pb.TagNumber(2)
+  set kind($core.String value) => $_setString(1, value);
+  @$pb.TagNumber(2)
+  $core.bool hasKind() => $_has(1);
+  @$pb.TagNumber(2)
+  void clearKind() => $_clearField(2);
+
+  @$pb.TagNumber(3)
+  $core.String get username => $_getSZ(2);
+  @$pb.TagNumber(3)
+  set username($core.String value) => $_setString(2, value);
+  @$pb.TagNumber(3)
+  $core.bool hasUsername() => $_has(2);
+  @$pb.TagNumber(3)
+  void clearUsername() => $_clearField(3);
+
+  @$pb.TagNumber(4)
+  $core.String get password => $_getSZ(3);
+  @$pb.TagNumber(4)
+  set password($core.String value) => $_setString(3, value);
+  @$pb.TagNumber(4)
+  $core.bool hasPassword() => $_has(3);
+  @$pb.TagNumber(4)
+  void clearPassword() => $_clearField(4);
+
+  @$pb.TagNumber(5)
+  $pb.PbMap<$core.String, $core.String> get fields => $_getMap(4);
 }
 
 class StreamMetricsRequest extends $pb.GeneratedMessage {
@@ -3950,6 +4711,8 @@ class MetricsSample extends $pb.GeneratedMessage {
     $fixnum.Int64? bytesInRate,
     $fixnum.Int64? bytesOutRate,
     $fixnum.Int64? blockedTotal,
+    $fixnum.Int64? tarpitActive,
+    $fixnum.Int64? tarpitMemoryBytes,
   }) {
     final result = create();
     if (timestamp != null) result.timestamp = timestamp;
@@ -3958,6 +4721,8 @@ class MetricsSample extends $pb.GeneratedMessage {
     if (bytesInRate != null) result.bytesInRate = bytesInRate;
     if (bytesOutRate != null) result.bytesOutRate = bytesOutRate;
     if (blockedTotal != null) result.blockedTotal = blockedTotal;
+    if (tarpitActive != null) result.tarpitActive = tarpitActive;
+    if (tarpitMemoryBytes != null) result.tarpitMemoryBytes = tarpitMemoryBytes;
     return result;
   }
 
@@ -3980,6 +4745,8 @@ class MetricsSample extends $pb.GeneratedMessage {
     ..aInt64(4, _omitFieldNames ? '' : 'bytesInRate')
     ..aInt64(5, _omitFieldNames ? '' : 'bytesOutRate')
     ..aInt64(6, _omitFieldNames ? '' : 'blockedTotal')
+    ..aInt64(7, _omitFieldNames ? '' : 'tarpitActive')
+    ..aInt64(8, _omitFieldNames ? '' : 'tarpitMemoryBytes')
     ..hasRequiredFields = false;
 
   @$core.Deprecated('See https://github.com/google/protobuf.dart/issues/998.')
@@ -4054,6 +4821,24 @@ class MetricsSample extends $pb.GeneratedMessage {
   $core.bool hasBlockedTotal() => $_has(5);
   @$pb.TagNumber(6)
   void clearBlockedTotal() => $_clearField(6);
+
+  @$pb.TagNumber(7)
+  $fixnum.Int64 get tarpitActive => $_getI64(6);
+  @$pb.TagNumber(7)
+  set tarpitActive($fixnum.Int64 value) => $_setInt64(6, value);
+  @$pb.TagNumber(7)
+  $core.bool hasTarpitActive() => $_has(6);
+  @$pb.TagNumber(7)
+  void clearTarpitActive() => $_clearField(7);
+
+  @$pb.TagNumber(8)
+  $fixnum.Int64 get tarpitMemoryBytes => $_getI64(7);
+  @$pb.TagNumber(8)
+  set tarpitMemoryBytes($fixnum.Int64 value) => $_setInt64(7, value);
+  @$pb.TagNumber(8)
+  $core.bool hasTarpitMemoryBytes() => $_has(7);
+  @$pb.TagNumber(8)
+  void clearTarpitMemoryBytes() => $_clearField(8);
 }
 
 /// EncryptedStreamPayload wraps E2E encrypted streaming data.
@@ -5325,6 +6110,8 @@ class StatsSummaryResponse extends $pb.GeneratedMessage {
     $fixnum.Int64? activeConnections,
     $core.int? proxyCount,
     $2.Timestamp? timestamp,
+    TarpitStats? tarpit,
+    $core.Iterable<AlertSinkStats>? alertSinks,
   }) {
     final result = create();
     if (totalConnections != null) result.totalConnections = totalConnections;
@@ -5337,6 +6124,8 @@ class StatsSummaryResponse extends $pb.GeneratedMessage {
     if (activeConnections != null) result.activeConnections = activeConnections;
     if (proxyCount != null) result.proxyCount = proxyCount;
     if (timestamp != null) result.timestamp = timestamp;
+    if (tarpit != null) result.tarpit = tarpit;
+    if (alertSinks != null) result.alertSinks.addAll(alertSinks);
     return result;
   }
 
@@ -5364,6 +6153,10 @@ class StatsSummaryResponse extends $pb.GeneratedMessage {
     ..aI(9, _omitFieldNames ? '' : 'proxyCount')
     ..aOM<$2.Timestamp>(10, _omitFieldNames ? '' : 'timestamp',
         subBuilder: $2.Timestamp.create)
+    ..aOM<TarpitStats>(11, _omitFieldNames ? '' : 'tarpit',
+        subBuilder: TarpitStats.create)
+    ..pPM<AlertSinkStats>(12, _omitFieldNames ? '' : 'alertSinks',
+        subBuilder: AlertSinkStats.create)
     ..hasRequiredFields = false;
 
   @$core.Deprecated('See https://github.com/google/protobuf.dart/issues/998.')
@@ -5476,147 +6269,449 @@ class StatsSummaryResponse extends $pb.GeneratedMessage {
   void clearTimestamp() => $_clearField(10);
   @$pb.TagNumber(10)
   $2.Timestamp ensureTimestamp() => $_ensure(9);
+
+  @$pb.TagNumber(11)
+  TarpitStats get tarpit => $_getN(10);
+  @$pb.TagNumber(11)
+  set tarpit(TarpitStats value) => $_setField(11, value);
+  @$pb.TagNumber(11)
+  $core.bool hasTarpit() => $_has(10);
+  @$pb.TagNumber(11)
+  void clearTarpit() => $_clearField(11);
+  @$pb.TagNumber(11)
+  TarpitStats ensureTarpit() => $_ensure(10);
+
+  @$pb.TagNumber(12)
+  $pb.PbList<AlertSinkStats> get alertSinks => $_getList(11);
 }
 
-class ResolveApprovalRequest extends $pb.GeneratedMessage {
-  factory ResolveApprovalRequest({
-    $core.String? reqId,
-    $1.ApprovalActionType? action,
-    $1.ApprovalRetentionMode? retentionMode,
-    $fixnum.Int64? durationSeconds,
-    $core.String? reason,
+/// AlertSinkStats reports delivery to one node-local alert sink (webhook,
+/// syslog or smtp).
+class AlertSinkStats extends $pb.GeneratedMessage {
+  factory AlertSinkStats({
+    $core.String? name,
+    $core.String? type,
+    $fixnum.Int64? sentTotal,
+    $fixnum.Int64? retriesTotal,
+    $fixnum.Int64? deadLetterTotal,
+    $fixnum.Int64? queued,
+    $core.String? lastError,
   }) {
     final result = create();
-    if (reqId != null) result.reqId = reqId;
-    if (action != null) result.action = action;
-    if (retentionMode != null) result.retentionMode = retentionMode;
-    if (durationSeconds != null) result.durationSeconds = durationSeconds;
-    if (reason != null) result.reason = reason;
+    if (name != null) result.name = name;
+    if (type != null) result.type = type;
+    if (sentTotal != null) result.sentTotal = sentTotal;
+    if (retriesTotal != null) result.retriesTotal = retriesTotal;
+    if (deadLetterTotal != null) result.deadLetterTotal = deadLetterTotal;
+    if (queued != null) result.queued = queued;
+    if (lastError != null) result.lastError = lastError;
     return result;
   }
 
-  ResolveApprovalRequest._();
+  AlertSinkStats._();
 
-  factory ResolveApprovalRequest.fromBuffer($core.List<$core.int> data,
+  factory AlertSinkStats.fromBuffer($core.List<$core.int> data,
           [$pb.ExtensionRegistry registry = $pb.ExtensionRegistry.EMPTY]) =>
       create()..mergeFromBuffer(data, registry);
-  factory ResolveApprovalRequest.fromJson($core.String json,
+  factory AlertSinkStats.fromJson($core.String json,
           [$pb.ExtensionRegistry registry = $pb.ExtensionRegistry.EMPTY]) =>
       create()..mergeFromJson(json, registry);
 
   static final $pb.BuilderInfo _i = $pb.BuilderInfo(
-      _omitMessageNames ? '' : 'ResolveApprovalRequest',
+      _omitMessageNames ? '' : 'AlertSinkStats',
       package: const $pb.PackageName(_omitMessageNames ? '' : 'nitella.proxy'),
       createEmptyInstance: create)
-    ..aOS(1, _omitFieldNames ? '' : 'reqId')
-    ..aE<$1.ApprovalActionType>(2, _omitFieldNames ? '' : 'action',
-        enumValues: $1.ApprovalActionType.values)
-    ..aE<$1.ApprovalRetentionMode>(3, _omitFieldNames ? '' : 'retentionMode',
-        enumValues: $1.ApprovalRetentionMode.values)
-    ..aInt64(4, _omitFieldNames ? '' : 'durationSeconds')
-    ..aOS(5, _omitFieldNames ? '' : 'reason')
+    ..aOS(1, _omitFieldNames ? '' : 'name')
+    ..aOS(2, _omitFieldNames ? '' : 'type')
+    ..aInt64(3, _omitFieldNames ? '' : 'sentTotal')
+    ..aInt64(4, _omitFieldNames ? '' : 'retriesTotal')
+    ..aInt64(5, _omitFieldNames ? '' : 'deadLetterTotal')
+    ..aInt64(6, _omitFieldNames ? '' : 'queued')
+    ..aOS(7, _omitFieldNames ? '' : 'lastError')
     ..hasRequiredFields = false;
 
   @$core.Deprecated('See https://github.com/google/protobuf.dart/issues/998.')
-  ResolveApprovalRequest clone() => deepCopy();
+  AlertSinkStats clone() => deepCopy();
   @$core.Deprecated('See https://github.com/google/protobuf.dart/issues/998.')
-  ResolveApprovalRequest copyWith(
-          void Function(ResolveApprovalRequest) updates) =>
-      super.copyWith((message) => updates(message as ResolveApprovalRequest))
-          as ResolveApprovalRequest;
+  AlertSinkStats copyWith(void Function(AlertSinkStats) updates) =>
+      super.copyWith((message) => updates(message as AlertSinkStats))
+          as AlertSinkStats;
 
   @$core.override
   $pb.BuilderInfo get info_ => _i;
 
   @$core.pragma('dart2js:noInline')
-  static ResolveApprovalRequest create() => ResolveApprovalRequest._();
+  static AlertSinkStats create() => AlertSinkStats._();
   @$core.override
-  ResolveApprovalRequest createEmptyInstance() => create();
+  AlertSinkStats createEmptyInstance() => create();
   @$core.pragma('dart2js:noInline')
-  static ResolveApprovalRequest getDefault() => _defaultInstance ??=
-      $pb.GeneratedMessage.$_defaultFor<ResolveApprovalRequest>(create);
-  static ResolveApprovalRequest? _defaultInstance;
+  static AlertSinkStats getDefault() => _defaultInstance ??=
+      $pb.GeneratedMessage.$_defaultFor<AlertSinkStats>(create);
+  static AlertSinkStats? _defaultInstance;
 
   @$pb.TagNumber(1)
-  $core.String get reqId => $_getSZ(0);
+  $core.String get name => $_getSZ(0);
   @$pb.TagNumber(1)
-  set reqId($core.String value) => $_setString(0, value);
+  set name($core.String value) => $_setString(0, value);
   @$pb.TagNumber(1)
-  $core.bool hasReqId() => $_has(0);
+  $core.bool hasName() => $_has(0);
   @$pb.TagNumber(1)
-  void clearReqId() => $_clearField(1);
+  void clearName() => $_clearField(1);
 
   @$pb.TagNumber(2)
-  $1.ApprovalActionType get action => $_getN(1);
+  $core.String get type => $_getSZ(1);
   @$pb.TagNumber(2)
-  set action($1.ApprovalActionType value) => $_setField(2, value);
+  set type($core.String value) => $_setString(1, value);
   @$pb.TagNumber(2)
-  $core.bool hasAction() => $_has(1);
+  $core.bool hasType() => $_has(1);
   @$pb.TagNumber(2)
-  void clearAction() => $_clearField(2);
+  void clearType() => $_clearField(2);
 
   @$pb.TagNumber(3)
-  $1.ApprovalRetentionMode get retentionMode => $_getN(2);
+  $fixnum.Int64 get sentTotal => $_getI64(2);
   @$pb.TagNumber(3)
-  set retentionMode($1.ApprovalRetentionMode value) => $_setField(3, value);
+  set sentTotal($fixnum.Int64 value) => $_setInt64(2, value);
   @$pb.TagNumber(3)
-  $core.bool hasRetentionMode() => $_has(2);
+  $core.bool hasSentTotal() => $_has(2);
   @$pb.TagNumber(3)
-  void clearRetentionMode() => $_clearField(3);
+  void clearSentTotal() => $_clearField(3);
 
   @$pb.TagNumber(4)
-  $fixnum.Int64 get durationSeconds => $_getI64(3);
+  $fixnum.Int64 get retriesTotal => $_getI64(3);
   @$pb.TagNumber(4)
-  set durationSeconds($fixnum.Int64 value) => $_setInt64(3, value);
+  set retriesTotal($fixnum.Int64 value) => $_setInt64(3, value);
   @$pb.TagNumber(4)
-  $core.bool hasDurationSeconds() => $_has(3);
+  $core.bool hasRetriesTotal() => $_has(3);
   @$pb.TagNumber(4)
-  void clearDurationSeconds() => $_clearField(4);
+  void clearRetriesTotal() => $_clearField(4);
 
   @$pb.TagNumber(5)
-  $core.String get reason => $_getSZ(4);
+  $fixnum.Int64 get deadLetterTotal => $_getI64(4);
   @$pb.TagNumber(5)
-  set reason($core.String value) => $_setString(4, value);
+  set deadLetterTotal($fixnum.Int64 value) => $_setInt64(4, value);
   @$pb.TagNumber(5)
-  $core.bool hasReason() => $_has(4);
+  $core.bool hasDeadLetterTotal() => $_has(4);
   @$pb.TagNumber(5)
-  void clearReason() => $_clearField(5);
+  void clearDeadLetterTotal() => $_clearField(5);
+
+  @$pb.TagNumber(6)
+  $fixnum.Int64 get queued => $_getI64(5);
+  @$pb.TagNumber(6)
+  set queued($fixnum.Int64 value) => $_setInt64(5, value);
+  @$pb.TagNumber(6)
+  $core.bool hasQueued() => $_has(5);
+  @$pb.TagNumber(6)
+  void clearQueued() => $_clearField(6);
+
+  @$pb.TagNumber(7)
+  $core.String get lastError => $_getSZ(6);
+  @$pb.TagNumber(7)
+  set lastError($core.String value) => $_setString(6, value);
+  @$pb.TagNumber(7)
+  $core.bool hasLastError() => $_has(6);
+  @$pb.TagNumber(7)
+  void clearLastError() => $_clearField(7);
 }
 
-class ResolveApprovalResponse extends $pb.GeneratedMessage {
-  factory ResolveApprovalResponse({
-    $core.bool? success,
-    $core.String? errorMessage,
+/// TarpitStats reports node-wide tarpit budget usage. Limits of 0 mean
+/// unlimited.
+class TarpitStats extends $pb.GeneratedMessage {
+  factory TarpitStats({
+    $fixnum.Int64? activeConns,
+    $fixnum.Int64? maxConns,
+    $fixnum.Int64? memoryBytes,
+    $fixnum.Int64? maxMemoryBytes,
+    $fixnum.Int64? admittedTotal,
+    $fixnum.Int64? rejectedTotal,
+    $core.bool? adaptive,
   }) {
     final result = create();
-    if (success != null) result.success = success;
-    if (errorMessage != null) result.errorMessage = errorMessage;
+    if (activeConns != null) result.activeConns = activeConns;
+    if (maxConns != null) result.maxConns = maxConns;
+    if (memoryBytes != null) result.memoryBytes = memoryBytes;
+    if (maxMemoryBytes != null) result.maxMemoryBytes = maxMemoryBytes;
+    if (admittedTotal != null) result.admittedTotal = admittedTotal;
+    if (rejectedTotal != null) result.rejectedTotal = rejectedTotal;
+    if (adaptive != null) result.adaptive = adaptive;
     return result;
   }
 
-  ResolveApprovalResponse._();
+  TarpitStats._();
 
-  factory ResolveApprovalResponse.fromBuffer($core.List<$core.int> data,
+  factory TarpitStats.fromBuffer($core.List<$core.int> data,
           [$pb.ExtensionRegistry registry = $pb.ExtensionRegistry.EMPTY]) =>
       create()..mergeFromBuffer(data, registry);
-  factory ResolveApprovalResponse.fromJson($core.String json,
+  factory TarpitStats.fromJson($core.String json,
           [$pb.ExtensionRegistry registry = $pb.ExtensionRegistry.EMPTY]) =>
       create()..mergeFromJson(json, registry);
 
   static final $pb.BuilderInfo _i = $pb.BuilderInfo(
-      _omitMessageNames ? '' : 'ResolveApprovalResponse',
+      _omitMessageNames ? '' : 'TarpitStats',
       package: const $pb.PackageName(_omitMessageNames ? '' : 'nitella.proxy'),
       createEmptyInstance: create)
-    ..aOB(1, _omitFieldNames ? '' : 'success')
-    ..aOS(2, _omitFieldNames ? '' : 'errorMessage')
+    ..aInt64(1, _omitFieldNames ? '' : 'activeConns')
+    ..aInt64(2, _omitFieldNames ? '' : 'maxConns')
+    ..aInt64(3, _omitFieldNames ? '' : 'memoryBytes')
+    ..aInt64(4, _omitFieldNames ? '' : 'maxMemoryBytes')
+    ..aInt64(5, _omitFieldNames ? '' : 'admittedTotal')
+    ..aInt64(6, _omitFieldNames ? '' : 'rejectedTotal')
+    ..aOB(7, _omitFieldNames ? '' : 'adaptive')
     ..hasRequiredFields = false;
 
   @$core.Deprecated('See https://github.com/google/protobuf.dart/issues/998.')
-  ResolveApprovalResponse clone() => deepCopy();
+  TarpitStats clone() => deepCopy();
   @$core.Deprecated('See https://github.com/google/protobuf.dart/issues/998.')
-  ResolveApprovalResponse copyWith(
-          void Function(ResolveApprovalResponse) updates) =>
-      super.copyWith((message) => updates(message as ResolveApprovalResponse))
+  TarpitStats copyWith(void Function(TarpitStats) updates) =>
+      super.copyWith((message) => updates(message as TarpitStats))
+          as TarpitStats;
+
+  @$core.override
+  $pb.BuilderInfo get info_ => _i;
+
+  @$core.pragma('dart2js:noInline')
+  static TarpitStats create() => TarpitStats._();
+  @$core.override
+  TarpitStats createEmptyInstance() => create();
+  @$core.pragma('dart2js:noInline')
+  static TarpitStats getDefault() => _defaultInstance ??=
+      $pb.GeneratedMessage.$_defaultFor<TarpitStats>(create);
+  static TarpitStats? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $fixnum.Int64 get activeConns => $_getI64(0);
+  @$pb.TagNumber(1)
+  set activeConns($fixnum.Int64 value) => $_setInt64(0, value);
+  @$pb.TagNumber(1)
+  $core.bool hasActiveConns() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearActiveConns() => $_clearField(1);
+
+  @$pb.TagNumber(2)
+  $fixnum.Int64 get maxConns => $_getI64(1);
+  @$pb.TagNumber(2)
+  set maxConns($fixnum.Int64 value) => $_setInt64(1, value);
+  @$pb.TagNumber(2)
+  $core.bool hasMaxConns() => $_has(1);
+  @$pb.TagNumber(2)
+  void clearMaxConns() => $_clearField(2);
+
+  @$pb.TagNumber(3)
+  $fixnum.Int64 get memoryBytes => $_getI64(2);
+  @$pb.TagNumber(3)
+  set memoryBytes($fixnum.Int64 value) => $_setInt64(2, value);
+  @$pb.TagNumber(3)
+  $core.bool hasMemoryBytes() => $_has(2);
+  @$pb.TagNumber(3)
+  void clearMemoryBytes() => $_clearField(3);
+
+  @$pb.TagNumber(4)
+  $fixnum.Int64 get maxMemoryBytes => $_getI64(3);
+  @$pb.TagNumber(4)
+  set maxMemoryBytes($fixnum.Int64 value) => $_setInt64(3, value);
+  @$pb.TagNumber(4)
+  $core.bool hasMaxMemoryBytes() => $_has(3);
+  @$pb.TagNumber(4)
+  void clearMaxMemoryBytes() => $_clearField(4);
+
+  @$pb.TagNumber(5)
+  $fixnum.Int64 get admittedTotal => $_getI64(4);
+  @$pb.TagNumber(5)
+  set admittedTotal($fixnum.Int64 value) => $_setInt64(4, value);
+  @$pb.TagNumber(5)
+  $core.bool hasAdmittedTotal() => $_has(4);
+  @$pb.TagNumber(5)
+  void clearAdmittedTotal() => $_clearField(5);
+
+  @$pb.TagNumber(6)
+  $fixnum.Int64 get rejectedTotal => $_getI64(5);
+  @$pb.TagNumber(6)
+  set rejectedTotal($fixnum.Int64 value) => $_setInt64(5, value);
+  @$pb.TagNumber(6)
+  $core.bool hasRejectedTotal() => $_has(5);
+  @$pb.TagNumber(6)
+  void clearRejectedTotal() => $_clearField(6);
+
+  @$pb.TagNumber(7)
+  $core.bool get adaptive => $_getBF(6);
+  @$pb.TagNumber(7)
+  set adaptive($core.bool value) => $_setBool(6, value);
+  @$pb.TagNumber(7)
+  $core.bool hasAdaptive() => $_has(6);
+  @$pb.TagNumber(7)
+  void clearAdaptive() => $_clearField(7);
+}
+
+class ResolveApprovalRequest extends $pb.GeneratedMessage {
+  factory ResolveApprovalRequest({
+    $core.String? reqId,
+    $1.ApprovalActionType? action,
+    $1.ApprovalRetentionMode? retentionMode,
+    $fixnum.Int64? durationSeconds,
+    $core.String? reason,
+    $core.List<$core.int>? approverKey,
+    $core.List<$core.int>? signature,
+  }) {
+    final result = create();
+    if (reqId != null) result.reqId = reqId;
+    if (action != null) result.action = action;
+    if (retentionMode != null) result.retentionMode = retentionMode;
+    if (durationSeconds != null) result.durationSeconds = durationSeconds;
+    if (reason != null) result.reason = reason;
+    if (approverKey != null) result.approverKey = approverKey;
+    if (signature != null) result.signature = signature;
+    return result;
+  }
+
+  ResolveApprovalRequest._();
+
+  factory ResolveApprovalRequest.fromBuffer($core.List<$core.int> data,
+          [$pb.ExtensionRegistry registry = $pb.ExtensionRegistry.EMPTY]) =>
+      create()..mergeFromBuffer(data, registry);
+  factory ResolveApprovalRequest.fromJson($core.String json,
+          [$pb.ExtensionRegistry registry = $pb.ExtensionRegistry.EMPTY]) =>
+      create()..mergeFromJson(json, registry);
+
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(
+      _omitMessageNames ? '' : 'ResolveApprovalRequest',
+      package: const $pb.PackageName(_omitMessageNames ? '' : 'nitella.proxy'),
+      createEmptyInstance: create)
+    ..aOS(1, _omitFieldNames ? '' : 'reqId')
+    ..aE<$1.ApprovalActionType>(2, _omitFieldNames ? '' : 'action',
+        enumValues: $1.ApprovalActionType.values)
+    ..aE<$1.ApprovalRetentionMode>(3, _omitFieldNames ? '' : 'retentionMode',
+        enumValues: $1.ApprovalRetentionMode.values)
+    ..aInt64(4, _omitFieldNames ? '' : 'durationSeconds')
+    ..aOS(5, _omitFieldNames ? '' : 'reason')
+    ..a<$core.List<$core.int>>(
+        6, _omitFieldNames ? '' : 'approverKey', $pb.PbFieldType.OY)
+    ..a<$core.List<$core.int>>(
+        7, _omitFieldNames ? '' : 'signature', $pb.PbFieldType.OY)
+    ..hasRequiredFields = false;
+
+  @$core.Deprecated('See https://github.com/google/protobuf.dart/issues/998.')
+  ResolveApprovalRequest clone() => deepCopy();
+  @$core.Deprecated('See https://github.com/google/protobuf.dart/issues/998.')
+  ResolveApprovalRequest copyWith(
+          void Function(ResolveApprovalRequest) updates) =>
+      super.copyWith((message) => updates(message as ResolveApprovalRequest))
+          as ResolveApprovalRequest;
+
+  @$core.override
+  $pb.BuilderInfo get info_ => _i;
+
+  @$core.pragma('dart2js:noInline')
+  static ResolveApprovalRequest create() => ResolveApprovalRequest._();
+  @$core.override
+  ResolveApprovalRequest createEmptyInstance() => create();
+  @$core.pragma('dart2js:noInline')
+  static ResolveApprovalRequest getDefault() => _defaultInstance ??=
+      $pb.GeneratedMessage.$_defaultFor<ResolveApprovalRequest>(create);
+  static ResolveApprovalRequest? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $core.String get reqId => $_getSZ(0);
+  @$pb.TagNumber(1)
+  set reqId($core.String value) => $_setString(0, value);
+  @$pb.TagNumber(1)
+  $core.bool hasReqId() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearReqId() => $_clearField(1);
+
+  @$pb.TagNumber(2)
+  $1.ApprovalActionType get action => $_getN(1);
+  @$pb.TagNumber(2)
+  set action($1.ApprovalActionType value) => $_setField(2, value);
+  @$pb.TagNumber(2)
+  $core.bool hasAction() => $_has(1);
+  @$pb.TagNumber(2)
+  void clearAction() => $_clearField(2);
+
+  @$pb.TagNumber(3)
+  $1.ApprovalRetentionMode get retentionMode => $_getN(2);
+  @$pb.TagNumber(3)
+  set retentionMode($1.ApprovalRetentionMode value) => $_setField(3, value);
+  @$pb.TagNumber(3)
+  $core.bool hasRetentionMode() => $_has(2);
+  @$pb.TagNumber(3)
+  void clearRetentionMode() => $_clearField(3);
+
+  @$pb.TagNumber(4)
+  $fixnum.Int64 get durationSeconds => $_getI64(3);
+  @$pb.TagNumber(4)
+  set durationSeconds($fixnum.Int64 value) => $_setInt64(3, value);
+  @$pb.TagNumber(4)
+  $core.bool hasDurationSeconds() => $_has(3);
+  @$pb.TagNumber(4)
+  void clearDurationSeconds() => $_clearField(4);
+
+  @$pb.TagNumber(5)
+  $core.String get reason => $_getSZ(4);
+  @$pb.TagNumber(5)
+  set reason($core.String value) => $_setString(4, value);
+  @$pb.TagNumber(5)
+  $core.bool hasReason() => $_has(4);
+  @$pb.TagNumber(5)
+  void clearReason() => $_clearField(5);
+
+  @$pb.TagNumber(6)
+  $core.List<$core.int> get approverKey => $_getN(5);
+  @$pb.TagNumber(6)
+  set approverKey($core.List<$core.int> value) => $_setBytes(5, value);
+  @$pb.TagNumber(6)
+  $core.bool hasApproverKey() => $_has(5);
+  @$pb.TagNumber(6)
+  void clearApproverKey() => $_clearField(6);
+
+  @$pb.TagNumber(7)
+  $core.List<$core.int> get signature => $_getN(6);
+  @$pb.TagNumber(7)
+  set signature($core.List<$core.int> value) => $_setBytes(6, value);
+  @$pb.TagNumber(7)
+  $core.bool hasSignature() => $_has(6);
+  @$pb.TagNumber(7)
+  void clearSignature() => $_clearField(7);
+}
+
+class ResolveApprovalResponse extends $pb.GeneratedMessage {
+  factory ResolveApprovalResponse({
+    $core.bool? success,
+    $core.String? errorMessage,
+    $core.int? approvals,
+    $core.int? quorum,
+  }) {
+    final result = create();
+    if (success != null) result.success = success;
+    if (errorMessage != null) result.errorMessage = errorMessage;
+    if (approvals != null) result.approvals = approvals;
+    if (quorum != null) result.quorum = quorum;
+    return result;
+  }
+
+  ResolveApprovalResponse._();
+
+  factory ResolveApprovalResponse.fromBuffer($core.List<$core.int> data,
+          [$pb.ExtensionRegistry registry = $pb.ExtensionRegistry.EMPTY]) =>
+      create()..mergeFromBuffer(data, registry);
+  factory ResolveApprovalResponse.fromJson($core.String json,
+          [$pb.ExtensionRegistry registry = $pb.ExtensionRegistry.EMPTY]) =>
+      create()..mergeFromJson(json, registry);
+
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(
+      _omitMessageNames ? '' : 'ResolveApprovalResponse',
+      package: const $pb.PackageName(_omitMessageNames ? '' : 'nitella.proxy'),
+      createEmptyInstance: create)
+    ..aOB(1, _omitFieldNames ? '' : 'success')
+    ..aOS(2, _omitFieldNames ? '' : 'errorMessage')
+    ..aI(3, _omitFieldNames ? '' : 'approvals')
+    ..aI(4, _omitFieldNames ? '' : 'quorum')
+    ..hasRequiredFields = false;
+
+  @$core.Deprecated('See https://github.com/google/protobuf.dart/issues/998.')
+  ResolveApprovalResponse clone() => deepCopy();
+  @$core.Deprecated('See https://github.com/google/protobuf.dart/issues/998.')
+  ResolveApprovalResponse copyWith(
+          void Function(ResolveApprovalResponse) updates) =>
+      super.copyWith((message) => updates(message as ResolveApprovalResponse))
           as ResolveApprovalResponse;
 
   @$core.override
@@ -5648,6 +6743,24 @@ class ResolveApprovalResponse extends $pb.GeneratedMessage {
   $core.bool hasErrorMessage() => $_has(1);
   @$pb.TagNumber(2)
   void clearErrorMessage() => $_clearField(2);
+
+  @$pb.TagNumber(3)
+  $core.int get approvals => $_getIZ(2);
+  @$pb.TagNumber(3)
+  set approvals($core.int value) => $_setSignedInt32(2, value);
+  @$pb.TagNumber(3)
+  $core.bool hasApprovals() => $_has(2);
+  @$pb.TagNumber(3)
+  void clearApprovals() => $_clearField(3);
+
+  @$pb.TagNumber(4)
+  $core.int get quorum => $_getIZ(3);
+  @$pb.TagNumber(4)
+  set quorum($core.int value) => $_setSignedInt32(3, value);
+  @$pb.TagNumber(4)
+  $core.bool hasQuorum() => $_has(3);
+  @$pb.TagNumber(4)
+  void clearQuorum() => $_clearField(4);
 }
 
 class ActiveApproval extends $pb.GeneratedMessage {
@@ -5667,6 +6780,10 @@ class ActiveApproval extends $pb.GeneratedMessage {
     $core.String? geoCountry,
     $core.String? geoCity,
     $core.String? geoIsp,
+    $core.bool? pending,
+    $core.int? approvals,
+    $core.int? quorum,
+    $core.Iterable<$core.String>? approvers,
   }) {
     final result = create();
     if (key != null) result.key = key;
@@ -5684,6 +6801,10 @@ class ActiveApproval extends $pb.GeneratedMessage {
     if (geoCountry != null) result.geoCountry = geoCountry;
     if (geoCity != null) result.geoCity = geoCity;
     if (geoIsp != null) result.geoIsp = geoIsp;
+    if (pending != null) result.pending = pending;
+    if (approvals != null) result.approvals = approvals;
+    if (quorum != null) result.quorum = quorum;
+    if (approvers != null) result.approvers.addAll(approvers);
     return result;
   }
 
@@ -5717,6 +6838,10 @@ class ActiveApproval extends $pb.GeneratedMessage {
     ..aOS(13, _omitFieldNames ? '' : 'geoCountry')
     ..aOS(14, _omitFieldNames ? '' : 'geoCity')
     ..aOS(15, _omitFieldNames ? '' : 'geoIsp')
+    ..aOB(16, _omitFieldNames ? '' : 'pending')
+    ..aI(17, _omitFieldNames ? '' : 'approvals')
+    ..aI(18, _omitFieldNames ? '' : 'quorum')
+    ..pPS(19, _omitFieldNames ? '' : 'approvers')
     ..hasRequiredFields = false;
 
   @$core.Deprecated('See https://github.com/google/protobuf.dart/issues/998.')
@@ -5870,6 +6995,36 @@ class ActiveApproval extends $pb.GeneratedMessage {
   $core.bool hasGeoIsp() => $_has(14);
   @$pb.TagNumber(15)
   void clearGeoIsp() => $_clearField(15);
+
+  @$pb.TagNumber(16)
+  $core.bool get pending => $_getBF(15);
+  @$pb.TagNumber(16)
+  set pending($core.bool value) => $_setBool(15, value);
+  @$pb.TagNumber(16)
+  $core.bool hasPending() => $_has(15);
+  @$pb.TagNumber(16)
+  void clearPending() => $_clearField(16);
+
+  @$pb.TagNumber(17)
+  $core.int get approvals => $_getIZ(16);
+  @$pb.TagNumber(17)
+  set approvals($core.int value) => $_setSignedInt32(16, value);
+  @$pb.TagNumber(17)
+  $core.bool hasApprovals() => $_has(16);
+  @$pb.TagNumber(17)
+  void clearApprovals() => $_clearField(17);
+
+  @$pb.TagNumber(18)
+  $core.int get quorum => $_getIZ(17);
+  @$pb.TagNumber(18)
+  set quorum($core.int value) => $_setSignedInt32(17, value);
+  @$pb.TagNumber(18)
+  $core.bool hasQuorum() => $_has(17);
+  @$pb.TagNumber(18)
+  void clearQuorum() => $_clearField(18);
+
+  @$pb.TagNumber(19)
+  $pb.PbList<$core.String> get approvers => $_getList(18);
 }
 
 class ListActiveApprovalsRequest extends $pb.GeneratedMessage {
@@ -6138,6 +7293,623 @@ class CancelApprovalResponse extends $pb.GeneratedMessage {
   void clearConnectionsClosed() => $_clearField(3);
 }
 
+class GetMockTranscriptsRequest extends $pb.GeneratedMessage {
+  factory GetMockTranscriptsRequest({
+    $core.String? connId,
+    $core.String? sourceIp,
+    $core.int? limit,
+  }) {
+    final result = create();
+    if (connId != null) result.connId = connId;
+    if (sourceIp != null) result.sourceIp = sourceIp;
+    if (limit != null) result.limit = limit;
+    return result;
+  }
+
+  GetMockTranscriptsRequest._();
+
+  factory GetMockTranscriptsRequest.fromBuffer($core.List<$core.int> data,
+          [$pb.ExtensionRegistry registry = $pb.ExtensionRegistry.EMPTY]) =>
+      create()..mergeFromBuffer(data, registry);
+  factory GetMockTranscriptsRequest.fromJson($core.String json,
+          [$pb.ExtensionRegistry registry = $pb.ExtensionRegistry.EMPTY]) =>
+      create()..mergeFromJson(json, registry);
+
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(
+      _omitMessageNames ? '' : 'GetMockTranscriptsRequest',
+      package: const $pb.PackageName(_omitMessageNames ? '' : 'nitella.proxy'),
+      createEmptyInstance: create)
+    ..aOS(1, _omitFieldNames ? '' : 'connId')
+    ..aOS(2, _omitFieldNames ? '' : 'sourceIp')
+    ..aI(3, _omitFieldNames ? '' : 'limit')
+    ..hasRequiredFields = false;
+
+  @$core.Deprecated('See https://github.com/google/protobuf.dart/issues/998.')
+  GetMockTranscriptsRequest clone() => deepCopy();
+  @$core.Deprecated('See https://github.com/google/protobuf.dart/issues/998.')
+  GetMockTranscriptsRequest copyWith(
+          void Function(GetMockTranscriptsRequest) updates) =>
+      super.copyWith((message) => updates(message as GetMockTranscriptsRequest))
+          as GetMockTranscriptsRequest;
+
+  @$core.override
+  $pb.BuilderInfo get info_ => _i;
+
+  @$core.pragma('dart2js:noInline')
+  static GetMockTranscriptsRequest create() => GetMockTranscriptsRequest._();
+  @$core.override
+  GetMockTranscriptsRequest createEmptyInstance() => create();
+  @$core.pragma('dart2js:noInline')
+  static GetMockTranscriptsRequest getDefault() => _defaultInstance ??=
+      $pb.GeneratedMessage.$_defaultFor<GetMockTranscriptsRequest>(create);
+  static GetMockTranscriptsRequest? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $core.String get connId => $_getSZ(0);
+  @$pb.TagNumber(1)
+  set connId($core.String value) => $_setString(0, value);
+  @$pb.TagNumber(1)
+  $core.bool hasConnId() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearConnId() => $_clearField(1);
+
+  @$pb.TagNumber(2)
+  $core.String get sourceIp => $_getSZ(1);
+  @$pb.TagNumber(2)
+  set sourceIp($core.String value) => $_setString(1, value);
+  @$pb.TagNumber(2)
+  $core.bool hasSourceIp() => $_has(1);
+  @$pb.TagNumber(2)
+  void clearSourceIp() => $_clearField(2);
+
+  @$pb.TagNumber(3)
+  $core.int get limit => $_getIZ(2);
+  @$pb.TagNumber(3)
+  set limit($core.int value) => $_setSignedInt32(2, value);
+  @$pb.TagNumber(3)
+  $core.bool hasLimit() => $_has(2);
+  @$pb.TagNumber(3)
+  void clearLimit() => $_clearField(3);
+}
+
+/// MockTranscript is a fake shell session recorded by a mock listener.
+class MockTranscript extends $pb.GeneratedMessage {
+  factory MockTranscript({
+    $core.String? connId,
+    $core.String? sourceIp,
+    $core.int? sourcePort,
+    $core.String? ruleId,
+    $2.Timestamp? startTime,
+    $fixnum.Int64? durationMs,
+    $core.String? geoCountry,
+    $core.String? transcript,
+  }) {
+    final result = create();
+    if (connId != null) result.connId = connId;
+    if (sourceIp != null) result.sourceIp = sourceIp;
+    if (sourcePort != null) result.sourcePort = sourcePort;
+    if (ruleId != null) result.ruleId = ruleId;
+    if (startTime != null) result.startTime = startTime;
+    if (durationMs != null) result.durationMs = durationMs;
+    if (geoCountry != null) result.geoCountry = geoCountry;
+    if (transcript != null) result.transcript = transcript;
+    return result;
+  }
+
+  MockTranscript._();
+
+  factory MockTranscript.fromBuffer($core.List<$core.int> data,
+          [$pb.ExtensionRegistry registry = $pb.ExtensionRegistry.EMPTY]) =>
+      create()..mergeFromBuffer(data, registry);
+  factory MockTranscript.fromJson($core.String json,
+          [$pb.ExtensionRegistry registry = $pb.ExtensionRegistry.EMPTY]) =>
+      create()..mergeFromJson(json, registry);
+
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(
+      _omitMessageNames ? '' : 'MockTranscript',
+      package: const $pb.PackageName(_omitMessageNames ? '' : 'nitella.proxy'),
+      createEmptyInstance: create)
+    ..aOS(1, _omitFieldNames ? '' : 'connId')
+    ..aOS(2, _omitFieldNames ? '' : 'sourceIp')
+    ..aI(3, _omitFieldNames ? '' : 'sourcePort')
+    ..aOS(4, _omitFieldNames ? '' : 'ruleId')
+    ..aOM<$2.Timestamp>(5, _omitFieldNames ? '' : 'startTime',
+        subBuilder: $2.Timestamp.create)
+    ..aInt64(6, _omitFieldNames ? '' : 'durationMs')
+    ..aOS(7, _omitFieldNames ? '' : 'geoCountry')
+    ..aOS(8, _omitFieldNames ? '' : 'transcript')
+    ..hasRequiredFields = false;
+
+  @$core.Deprecated('See https://github.com/google/protobuf.dart/issues/998.')
+  MockTranscript clone() => deepCopy();
+  @$core.Deprecated('See https://github.com/google/protobuf.dart/issues/998.')
+  MockTranscript copyWith(void Function(MockTranscript) updates) =>
+      super.copyWith((message) => updates(message as MockTranscript))
+          as MockTranscript;
+
+  @$core.override
+  $pb.BuilderInfo get info_ => _i;
+
+  @$core.pragma('dart2js:noInline')
+  static MockTranscript create() => MockTranscript._();
+  @$core.override
+  MockTranscript createEmptyInstance() => create();
+  @$core.pragma('dart2js:noInline')
+  static MockTranscript getDefault() => _defaultInstance ??=
+      $pb.GeneratedMessage.$_defaultFor<MockTranscript>(create);
+  static MockTranscript? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $core.String get connId => $_getSZ(0);
+  @$pb.TagNumber(1)
+  set connId($core.String value) => $_setString(0, value);
+  @$pb.TagNumber(1)
+  $core.bool hasConnId() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearConnId() => $_clearField(1);
+
+  @$pb.TagNumber(2)
+  $core.String get sourceIp => $_getSZ(1);
+  @$pb.TagNumber(2)
+  set sourceIp($core.String value) => $_setString(1, value);
+  @$pb.TagNumber(2)
+  $core.bool hasSourceIp() => $_has(1);
+  @$pb.TagNumber(2)
+  void clearSourceIp() => $_clearField(2);
+
+  @$pb.TagNumber(3)
+  $core.int get sourcePort => $_getIZ(2);
+  @$pb.TagNumber(3)
+  set sourcePort($core.int value) => $_setSignedInt32(2, value);
+  @$pb.TagNumber(3)
+  $core.bool hasSourcePort() => $_has(2);
+  @$pb.TagNumber(3)
+  void clearSourcePort() => $_clearField(3);
+
+  @$pb.TagNumber(4)
+  $core.String get ruleId => $_getSZ(3);
+  @$pb.TagNumber(4)
+  set ruleId($core.String value) => $_setString(3, value);
+  @$pb.TagNumber(4)
+  $core.bool hasRuleId() => $_has(3);
+  @$pb.TagNumber(4)
+  void clearRuleId() => $_clearField(4);
+
+  @$pb.TagNumber(5)
+  $2.Timestamp get startTime => $_getN(4);
+  @$pb.TagNumber(5)
+  set startTime($2.Timestamp value) => $_setField(5, value);
+  @$pb.TagNumber(5)
+  $core.bool hasStartTime() => $_has(4);
+  @$pb.TagNumber(5)
+  void clearStartTime() => $_clearField(5);
+  @$pb.TagNumber(5)
+  $2.Timestamp ensureStartTime() => $_ensure(4);
+
+  @$pb.TagNumber(6)
+  $fixnum.Int64 get durationMs => $_getI64(5);
+  @$pb.TagNumber(6)
+  set durationMs($fixnum.Int64 value) => $_setInt64(5, value);
+  @$pb.TagNumber(6)
+  $core.bool hasDurationMs() => $_has(5);
+  @$pb.TagNumber(6)
+  void clearDurationMs() => $_clearField(6);
+
+  @$pb.TagNumber(7)
+  $core.String get geoCountry => $_getSZ(6);
+  @$pb.TagNumber(7)
+  set geoCountry($core.String value) => $_setString(6, value);
+  @$pb.TagNumber(7)
+  $core.bool hasGeoCountry() => $_has(6);
+  @$pb.TagNumber(7)
+  void clearGeoCountry() => $_clearField(7);
+
+  @$pb.TagNumber(8)
+  $core.String get transcript => $_getSZ(7);
+  @$pb.TagNumber(8)
+  set transcript($core.String value) => $_setString(7, value);
+  @$pb.TagNumber(8)
+  $core.bool hasTranscript() => $_has(7);
+  @$pb.TagNumber(8)
+  void clearTranscript() => $_clearField(8);
+}
+
+class GetMockTranscriptsResponse extends $pb.GeneratedMessage {
+  factory GetMockTranscriptsResponse({
+    $core.Iterable<MockTranscript>? transcripts,
+  }) {
+    final result = create();
+    if (transcripts != null) result.transcripts.addAll(transcripts);
+    return result;
+  }
+
+  GetMockTranscriptsResponse._();
+
+  factory GetMockTranscriptsResponse.fromBuffer($core.List<$core.int> data,
+          [$pb.ExtensionRegistry registry = $pb.ExtensionRegistry.EMPTY]) =>
+      create()..mergeFromBuffer(data, registry);
+  factory GetMockTranscriptsResponse.fromJson($core.String json,
+          [$pb.ExtensionRegistry registry = $pb.ExtensionRegistry.EMPTY]) =>
+      create()..mergeFromJson(json, registry);
+
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(
+      _omitMessageNames ? '' : 'GetMockTranscriptsResponse',
+      package: const $pb.PackageName(_omitMessageNames ? '' : 'nitella.proxy'),
+      createEmptyInstance: create)
+    ..pPM<MockTranscript>(1, _omitFieldNames ? '' : 'transcripts',
+        subBuilder: MockTranscript.create)
+    ..hasRequiredFields = false;
+
+  @$core.Deprecated('See https://github.com/google/protobuf.dart/issues/998.')
+  GetMockTranscriptsResponse clone() => deepCopy();
+  @$core.Deprecated('See https://github.com/google/protobuf.dart/issues/998.')
+  GetMockTranscriptsResponse copyWith(
+          void Function(GetMockTranscriptsResponse) updates) =>
+      super.copyWith(
+              (message) => updates(message as GetMockTranscriptsResponse))
+          as GetMockTranscriptsResponse;
+
+  @$core.override
+  $pb.BuilderInfo get info_ => _i;
+
+  @$core.pragma('dart2js:noInline')
+  static GetMockTranscriptsResponse create() => GetMockTranscriptsResponse._();
+  @$core.override
+  GetMockTranscriptsResponse createEmptyInstance() => create();
+  @$core.pragma('dart2js:noInline')
+  static GetMockTranscriptsResponse getDefault() => _defaultInstance ??=
+      $pb.GeneratedMessage.$_defaultFor<GetMockTranscriptsResponse>(create);
+  static GetMockTranscriptsResponse? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $pb.PbList<MockTranscript> get transcripts => $_getList(0);
+}
+
+/// CloneBannerRequest records the banner of a real backend as a cloned preset.
+/// Cloning an existing name refreshes it; protocol and backend_addr may then
+/// be omitted to reuse the stored ones.
+class CloneBannerRequest extends $pb.GeneratedMessage {
+  factory CloneBannerRequest({
+    $core.String? name,
+    $core.String? protocol,
+    $core.String? backendAddr,
+    $core.int? timeoutMs,
+  }) {
+    final result = create();
+    if (name != null) result.name = name;
+    if (protocol != null) result.protocol = protocol;
+    if (backendAddr != null) result.backendAddr = backendAddr;
+    if (timeoutMs != null) result.timeoutMs = timeoutMs;
+    return result;
+  }
+
+  CloneBannerRequest._();
+
+  factory CloneBannerRequest.fromBuffer($core.List<$core.int> data,
+          [$pb.ExtensionRegistry registry = $pb.ExtensionRegistry.EMPTY]) =>
+      create()..mergeFromBuffer(data, registry);
+  factory CloneBannerRequest.fromJson($core.String json,
+          [$pb.ExtensionRegistry registry = $pb.ExtensionRegistry.EMPTY]) =>
+      create()..mergeFromJson(json, registry);
+
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(
+      _omitMessageNames ? '' : 'CloneBannerRequest',
+      package: const $pb.PackageName(_omitMessageNames ? '' : 'nitella.proxy'),
+      createEmptyInstance: create)
+    ..aOS(1, _omitFieldNames ? '' : 'name')
+    ..aOS(2, _omitFieldNames ? '' : 'protocol')
+    ..aOS(3, _omitFieldNames ? '' : 'backendAddr')
+    ..aI(4, _omitFieldNames ? '' : 'timeoutMs')
+    ..hasRequiredFields = false;
+
+  @$core.Deprecated('See https://github.com/google/protobuf.dart/issues/998.')
+  CloneBannerRequest clone() => deepCopy();
+  @$core.Deprecated('See https://github.com/google/protobuf.dart/issues/998.')
+  CloneBannerRequest copyWith(void Function(CloneBannerRequest) updates) =>
+      super.copyWith((message) => updates(message as CloneBannerRequest))
+          as CloneBannerRequest;
+
+  @$core.override
+  $pb.BuilderInfo get info_ => _i;
+
+  @$core.pragma('dart2js:noInline')
+  static CloneBannerRequest create() => CloneBannerRequest._();
+  @$core.override
+  CloneBannerRequest createEmptyInstance() => create();
+  @$core.pragma('dart2js:noInline')
+  static CloneBannerRequest getDefault() => _defaultInstance ??=
+      $pb.GeneratedMessage.$_defaultFor<CloneBannerRequest>(create);
+  static CloneBannerRequest? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $core.String get name => $_getSZ(0);
+  @$pb.TagNumber(1)
+  set name($core.String value) => $_setString(0, value);
+  @$pb.TagNumber(1)
+  $core.bool hasName() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearName() => $_clearField(1);
+
+  @$pb.TagNumber(2)
+  $core.String get protocol => $_getSZ(1);
+  @$pb.TagNumber(2)
+  set protocol($core.String value) => $_setString(1, value);
+  @$pb.TagNumber(2)
+  $core.bool hasProtocol() => $_has(1);
+  @$pb.TagNumber(2)
+  void clearProtocol() => $_clearField(2);
+
+  @$pb.TagNumber(3)
+  $core.String get backendAddr => $_getSZ(2);
+  @$pb.TagNumber(3)
+  set backendAddr($core.String value) => $_setString(2, value);
+  @$pb.TagNumber(3)
+  $core.bool hasBackendAddr() => $_has(2);
+  @$pb.TagNumber(3)
+  void clearBackendAddr() => $_clearField(3);
+
+  @$pb.TagNumber(4)
+  $core.int get timeoutMs => $_getIZ(3);
+  @$pb.TagNumber(4)
+  set timeoutMs($core.int value) => $_setSignedInt32(3, value);
+  @$pb.TagNumber(4)
+  $core.bool hasTimeoutMs() => $_has(3);
+  @$pb.TagNumber(4)
+  void clearTimeoutMs() => $_clearField(4);
+}
+
+/// ClonedPreset is a banner recorded from a real backend.
+class ClonedPreset extends $pb.GeneratedMessage {
+  factory ClonedPreset({
+    $core.String? name,
+    $core.String? protocol,
+    $core.String? backendAddr,
+    $core.String? banner,
+    $2.Timestamp? clonedAt,
+  }) {
+    final result = create();
+    if (name != null) result.name = name;
+    if (protocol != null) result.protocol = protocol;
+    if (backendAddr != null) result.backendAddr = backendAddr;
+    if (banner != null) result.banner = banner;
+    if (clonedAt != null) result.clonedAt = clonedAt;
+    return result;
+  }
+
+  ClonedPreset._();
+
+  factory ClonedPreset.fromBuffer($core.List<$core.int> data,
+          [$pb.ExtensionRegistry registry = $pb.ExtensionRegistry.EMPTY]) =>
+      create()..mergeFromBuffer(data, registry);
+  factory ClonedPreset.fromJson($core.String json,
+          [$pb.ExtensionRegistry registry = $pb.ExtensionRegistry.EMPTY]) =>
+      create()..mergeFromJson(json, registry);
+
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(
+      _omitMessageNames ? '' : 'ClonedPreset',
+      package: const $pb.PackageName(_omitMessageNames ? '' : 'nitella.proxy'),
+      createEmptyInstance: create)
+    ..aOS(1, _omitFieldNames ? '' : 'name')
+    ..aOS(2, _omitFieldNames ? '' : 'protocol')
+    ..aOS(3, _omitFieldNames ? '' : 'backendAddr')
+    ..aOS(4, _omitFieldNames ? '' : 'banner')
+    ..aOM<$2.Timestamp>(5, _omitFieldNames ? '' : 'clonedAt',
+        subBuilder: $2.Timestamp.create)
+    ..hasRequiredFields = false;
+
+  @$core.Deprecated('See https://github.com/google/protobuf.dart/issues/998.')
+  ClonedPreset clone() => deepCopy();
+  @$core.Deprecated('See https://github.com/google/protobuf.dart/issues/998.')
+  ClonedPreset copyWith(void Function(ClonedPreset) updates) =>
+      super.copyWith((message) => updates(message as ClonedPreset))
+          as ClonedPreset;
+
+  @$core.override
+  $pb.BuilderInfo get info_ => _i;
+
+  @$core.pragma('dart2js:noInline')
+  static ClonedPreset create() => ClonedPreset._();
+  @$core.override
+  ClonedPreset createEmptyInstance() => create();
+  @$core.pragma('dart2js:noInline')
+  static ClonedPreset getDefault() => _defaultInstance ??=
+      $pb.GeneratedMessage.$_defaultFor<ClonedPreset>(create);
+  static ClonedPreset? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $core.String get name => $_getSZ(0);
+  @$pb.TagNumber(1)
+  set name($core.String value) => $_setString(0, value);
+  @$pb.TagNumber(1)
+  $core.bool hasName() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearName() => $_clearField(1);
+
+  @$pb.TagNumber(2)
+  $core.String get protocol => $_getSZ(1);
+  @$pb.TagNumber(2)
+  set protocol($core.String value) => $_setString(1, value);
+  @$pb.TagNumber(2)
+  $core.bool hasProtocol() => $_has(1);
+  @$pb.TagNumber(2)
+  void clearProtocol() => $_clearField(2);
+
+  @$pb.TagNumber(3)
+  $core.String get backendAddr => $_getSZ(2);
+  @$pb.TagNumber(3)
+  set backendAddr($core.String value) => $_setString(2, value);
+  @$pb.TagNumber(3)
+  $core.bool hasBackendAddr() => $_has(2);
+  @$pb.TagNumber(3)
+  void clearBackendAddr() => $_clearField(3);
+
+  @$pb.TagNumber(4)
+  $core.String get banner => $_getSZ(3);
+  @$pb.TagNumber(4)
+  set banner($core.String value) => $_setString(3, value);
+  @$pb.TagNumber(4)
+  $core.bool hasBanner() => $_has(3);
+  @$pb.TagNumber(4)
+  void clearBanner() => $_clearField(4);
+
+  @$pb.TagNumber(5)
+  $2.Timestamp get clonedAt => $_getN(4);
+  @$pb.TagNumber(5)
+  set clonedAt($2.Timestamp value) => $_setField(5, value);
+  @$pb.TagNumber(5)
+  $core.bool hasClonedAt() => $_has(4);
+  @$pb.TagNumber(5)
+  void clearClonedAt() => $_clearField(5);
+  @$pb.TagNumber(5)
+  $2.Timestamp ensureClonedAt() => $_ensure(4);
+}
+
+class CloneBannerResponse extends $pb.GeneratedMessage {
+  factory CloneBannerResponse({
+    ClonedPreset? preset,
+  }) {
+    final result = create();
+    if (preset != null) result.preset = preset;
+    return result;
+  }
+
+  CloneBannerResponse._();
+
+  factory CloneBannerResponse.fromBuffer($core.List<$core.int> data,
+          [$pb.ExtensionRegistry registry = $pb.ExtensionRegistry.EMPTY]) =>
+      create()..mergeFromBuffer(data, registry);
+  factory CloneBannerResponse.fromJson($core.String json,
+          [$pb.ExtensionRegistry registry = $pb.ExtensionRegistry.EMPTY]) =>
+      create()..mergeFromJson(json, registry);
+
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(
+      _omitMessageNames ? '' : 'CloneBannerResponse',
+      package: const $pb.PackageName(_omitMessageNames ? '' : 'nitella.proxy'),
+      createEmptyInstance: create)
+    ..aOM<ClonedPreset>(1, _omitFieldNames ? '' : 'preset',
+        subBuilder: ClonedPreset.create)
+    ..hasRequiredFields = false;
+
+  @$core.Deprecated('See https://github.com/google/protobuf.dart/issues/998.')
+  CloneBannerResponse clone() => deepCopy();
+  @$core.Deprecated('See https://github.com/google/protobuf.dart/issues/998.')
+  CloneBannerResponse copyWith(void Function(CloneBannerResponse) updates) =>
+      super.copyWith((message) => updates(message as CloneBannerResponse))
+          as CloneBannerResponse;
+
+  @$core.override
+  $pb.BuilderInfo get info_ => _i;
+
+  @$core.pragma('dart2js:noInline')
+  static CloneBannerResponse create() => CloneBannerResponse._();
+  @$core.override
+  CloneBannerResponse createEmptyInstance() => create();
+  @$core.pragma('dart2js:noInline')
+  static CloneBannerResponse getDefault() => _defaultInstance ??=
+      $pb.GeneratedMessage.$_defaultFor<CloneBannerResponse>(create);
+  static CloneBannerResponse? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  ClonedPreset get preset => $_getN(0);
+  @$pb.TagNumber(1)
+  set preset(ClonedPreset value) => $_setField(1, value);
+  @$pb.TagNumber(1)
+  $core.bool hasPreset() => $_has(0);
+  @$pb.TagNumber(1)
+  void clearPreset() => $_clearField(1);
+  @$pb.TagNumber(1)
+  ClonedPreset ensurePreset() => $_ensure(0);
+}
+
+class ListClonedPresetsRequest extends $pb.GeneratedMessage {
+  factory ListClonedPresetsRequest() => create();
+
+  ListClonedPresetsRequest._();
+
+  factory ListClonedPresetsRequest.fromBuffer($core.List<$core.int> data,
+          [$pb.ExtensionRegistry registry = $pb.ExtensionRegistry.EMPTY]) =>
+      create()..mergeFromBuffer(data, registry);
+  factory ListClonedPresetsRequest.fromJson($core.String json,
+          [$pb.ExtensionRegistry registry = $pb.ExtensionRegistry.EMPTY]) =>
+      create()..mergeFromJson(json, registry);
+
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(
+      _omitMessageNames ? '' : 'ListClonedPresetsRequest',
+      package: const $pb.PackageName(_omitMessageNames ? '' : 'nitella.proxy'),
+      createEmptyInstance: create)
+    ..hasRequiredFields = false;
+
+  @$core.Deprecated('See https://github.com/google/protobuf.dart/issues/998.')
+  ListClonedPresetsRequest clone() => deepCopy();
+  @$core.Deprecated('See https://github.com/google/protobuf.dart/issues/998.')
+  ListClonedPresetsRequest copyWith(
+          void Function(ListClonedPresetsRequest) updates) =>
+      super.copyWith((message) => updates(message as ListClonedPresetsRequest))
+          as ListClonedPresetsRequest;
+
+  @$core.override
+  $pb.BuilderInfo get info_ => _i;
+
+  @$core.pragma('dart2js:noInline')
+  static ListClonedPresetsRequest create() => ListClonedPresetsRequest._();
+  @$core.override
+  ListClonedPresetsRequest createEmptyInstance() => create();
+  @$core.pragma('dart2js:noInline')
+  static ListClonedPresetsRequest getDefault() => _defaultInstance ??=
+      $pb.GeneratedMessage.$_defaultFor<ListClonedPresetsRequest>(create);
+  static ListClonedPresetsRequest? _defaultInstance;
+}
+
+class ListClonedPresetsResponse extends $pb.GeneratedMessage {
+  factory ListClonedPresetsResponse({
+    $core.Iterable<ClonedPreset>? presets,
+  }) {
+    final result = create();
+    if (presets != null) result.presets.addAll(presets);
+    return result;
+  }
+
+  ListClonedPresetsResponse._();
+
+  factory ListClonedPresetsResponse.fromBuffer($core.List<$core.int> data,
+          [$pb.ExtensionRegistry registry = $pb.ExtensionRegistry.EMPTY]) =>
+      create()..mergeFromBuffer(data, registry);
+  factory ListClonedPresetsResponse.fromJson($core.String json,
+          [$pb.ExtensionRegistry registry = $pb.ExtensionRegistry.EMPTY]) =>
+      create()..mergeFromJson(json, registry);
+
+  static final $pb.BuilderInfo _i = $pb.BuilderInfo(
+      _omitMessageNames ? '' : 'ListClonedPresetsResponse',
+      package: const $pb.PackageName(_omitMessageNames ? '' : 'nitella.proxy'),
+      createEmptyInstance: create)
+    ..pPM<ClonedPreset>(1, _omitFieldNames ? '' : 'presets',
+        subBuilder: ClonedPreset.create)
+    ..hasRequiredFields = false;
+
+  @$core.Deprecated('See https://github.com/google/protobuf.dart/issues/998.')
+  ListClonedPresetsResponse clone() => deepCopy();
+  @$core.Deprecated('See https://github.com/google/protobuf.dart/issues/998.')
+  ListClonedPresetsResponse copyWith(
+          void Function(ListClonedPresetsResponse) updates) =>
+      super.copyWith((message) => updates(message as ListClonedPresetsResponse))
+          as ListClonedPresetsResponse;
+
+  @$core.override
+  $pb.BuilderInfo get info_ => _i;
+
+  @$core.pragma('dart2js:noInline')
+  static ListClonedPresetsResponse create() => ListClonedPresetsResponse._();
+  @$core.override
+  ListClonedPresetsResponse createEmptyInstance() => create();
+  @$core.pragma('dart2js:noInline')
+  static ListClonedPresetsResponse getDefault() => _defaultInstance ??=
+      $pb.GeneratedMessage.$_defaultFor<ListClonedPresetsResponse>(create);
+  static ListClonedPresetsResponse? _defaultInstance;
+
+  @$pb.TagNumber(1)
+  $pb.PbList<ClonedPreset> get presets => $_getList(0);
+}
+
 class SendCommandRequest extends $pb.GeneratedMessage {
   factory SendCommandRequest({
     $1.EncryptedPayload? encrypted,
@@ -90,6 +90,84 @@ class HealthStatus extends $pb.ProtobufEnum {
   const HealthStatus._(super.value, super.name);
 }
 
+class ApprovalTimeoutAction extends $pb.ProtobufEnum {
+  static const ApprovalTimeoutAction APPROVAL_TIMEOUT_UNSPECIFIED =
+      ApprovalTimeoutAction._(
+          0, _omitEnumNames ? '' : 'APPROVAL_TIMEOUT_UNSPECIFIED');
+  static const ApprovalTimeoutAction APPROVAL_TIMEOUT_DENY =
+      ApprovalTimeoutAction._(1, _omitEnumNames ? '' : 'APPROVAL_TIMEOUT_DENY');
+  static const ApprovalTimeoutAction APPROVAL_TIMEOUT_ALLOW =
+      ApprovalTimeoutAction._(
+          2, _omitEnumNames ? '' : 'APPROVAL_TIMEOUT_ALLOW');
+  static const ApprovalTimeoutAction APPROVAL_TIMEOUT_MOCK =
+      ApprovalTimeoutAction._(3, _omitEnumNames ? '' : 'APPROVAL_TIMEOUT_MOCK');
+
+  static const $core.List<ApprovalTimeoutAction> values =
+      <ApprovalTimeoutAction>[
+    APPROVAL_TIMEOUT_UNSPECIFIED,
+    APPROVAL_TIMEOUT_DENY,
+    APPROVAL_TIMEOUT_ALLOW,
+    APPROVAL_TIMEOUT_MOCK,
+  ];
+
+  static final $core.List<ApprovalTimeoutAction?> _byValue =
+      $pb.ProtobufEnum.$_initByValueList(values, 3);
+  static ApprovalTimeoutAction? valueOf($core.int value) =>
+      value < 0 || value >= _byValue.length ? null : _byValue[value];
+
+  const ApprovalTimeoutAction._(super.value, super.name);
+}
+
+class ApprovalHold extends $pb.ProtobufEnum {
+  static const ApprovalHold APPROVAL_HOLD_UNSPECIFIED =
+      ApprovalHold._(0, _omitEnumNames ? '' : 'APPROVAL_HOLD_UNSPECIFIED');
+  static const ApprovalHold APPROVAL_HOLD_SILENT =
+      ApprovalHold._(1, _omitEnumNames ? '' : 'APPROVAL_HOLD_SILENT');
+  static const ApprovalHold APPROVAL_HOLD_TARPIT =
+      ApprovalHold._(2, _omitEnumNames ? '' : 'APPROVAL_HOLD_TARPIT');
+  static const ApprovalHold APPROVAL_HOLD_HTTP_PENDING =
+      ApprovalHold._(3, _omitEnumNames ? '' : 'APPROVAL_HOLD_HTTP_PENDING');
+
+  static const $core.List<ApprovalHold> values = <ApprovalHold>[
+    APPROVAL_HOLD_UNSPECIFIED,
+    APPROVAL_HOLD_SILENT,
+    APPROVAL_HOLD_TARPIT,
+    APPROVAL_HOLD_HTTP_PENDING,
+  ];
+
+  static final $core.List<ApprovalHold?> _byValue =
+      $pb.ProtobufEnum.$_initByValueList(values, 3);
+  static ApprovalHold? valueOf($core.int value) =>
+      value < 0 || value >= _byValue.length ? null : _byValue[value];
+
+  const ApprovalHold._(super.value, super.name);
+}
+
+class ThresholdAction extends $pb.ProtobufEnum {
+  static const ThresholdAction THRESHOLD_ACTION_UNSPECIFIED = ThresholdAction._(
+      0, _omitEnumNames ? '' : 'THRESHOLD_ACTION_UNSPECIFIED');
+  static const ThresholdAction THRESHOLD_ACTION_ALERT =
+      ThresholdAction._(1, _omitEnumNames ? '' : 'THRESHOLD_ACTION_ALERT');
+  static const ThresholdAction THRESHOLD_ACTION_CLOSE =
+      ThresholdAction._(2, _omitEnumNames ? '' : 'THRESHOLD_ACTION_CLOSE');
+  static const ThresholdAction THRESHOLD_ACTION_THROTTLE =
+      ThresholdAction._(3, _omitEnumNames ? '' : 'THRESHOLD_ACTION_THROTTLE');
+
+  static const $core.List<ThresholdAction> values = <ThresholdAction>[
+    THRESHOLD_ACTION_UNSPECIFIED,
+    THRESHOLD_ACTION_ALERT,
+    THRESHOLD_ACTION_CLOSE,
+    THRESHOLD_ACTION_THROTTLE,
+  ];
+
+  static final $core.List<ThresholdAction?> _byValue =
+      $pb.ProtobufEnum.$_initByValueList(values, 3);
+  static ThresholdAction? valueOf($core.int value) =>
+      value < 0 || value >= _byValue.length ? null : _byValue[value];
+
+  const ThresholdAction._(super.value, super.name);
+}
+
 class EventType extends $pb.ProtobufEnum {
   static const EventType EVENT_TYPE_UNSPECIFIED =
       EventType._(0, _omitEnumNames ? '' : 'EVENT_TYPE_UNSPECIFIED');
@@ -103,6 +181,18 @@ class EventType extends $pb.ProtobufEnum {
       EventType._(4, _omitEnumNames ? '' : 'EVENT_TYPE_PENDING_APPROVAL');
   static const EventType EVENT_TYPE_APPROVED =
       EventType._(5, _omitEnumNames ? '' : 'EVENT_TYPE_APPROVED');
+  static const EventType EVENT_TYPE_MOCK_CAPTURE =
+      EventType._(6, _omitEnumNames ? '' : 'EVENT_TYPE_MOCK_CAPTURE');
+  static const EventType EVENT_TYPE_MOCK_INTERACTION =
+      EventType._(7, _omitEnumNames ? '' : 'EVENT_TYPE_MOCK_INTERACTION');
+  static const EventType EVENT_TYPE_ALERT =
+      EventType._(8, _omitEnumNames ? '' : 'EVENT_TYPE_ALERT');
+  static const EventType EVENT_TYPE_THRESHOLD_ALERT =
+      EventType._(9, _omitEnumNames ? '' : 'EVENT_TYPE_THRESHOLD_ALERT');
+  static const EventType EVENT_TYPE_THRESHOLD_CLOSED =
+      EventType._(10, _omitEnumNames ? '' : 'EVENT_TYPE_THRESHOLD_CLOSED');
+  static const EventType EVENT_TYPE_THRESHOLD_THROTTLED =
+      EventType._(11, _omitEnumNames ? '' : 'EVENT_TYPE_THRESHOLD_THROTTLED');
 
   static const $core.List<EventType> values = <EventType>[
     EVENT_TYPE_UNSPECIFIED,
@@ -111,10 +201,16 @@ class EventType extends $pb.ProtobufEnum {
     EVENT_TYPE_BLOCKED,
     EVENT_TYPE_PENDING_APPROVAL,
     EVENT_TYPE_APPROVED,
+    EVENT_TYPE_MOCK_CAPTURE,
+    EVENT_TYPE_MOCK_INTERACTION,
+    EVENT_TYPE_ALERT,
+    EVENT_TYPE_THRESHOLD_ALERT,
+    EVENT_TYPE_THRESHOLD_CLOSED,
+    EVENT_TYPE_THRESHOLD_THROTTLED,
   ];
 
   static final $core.List<EventType?> _byValue =
-      $pb.ProtobufEnum.$_initByValueList(values, 5);
+      $pb.ProtobufEnum.$_initByValueList(values, 11);
   static EventType? valueOf($core.int value) =>
       value < 0 || value >= _byValue.length ? null : _byValue[value];
 
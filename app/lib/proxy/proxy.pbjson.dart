@@ -65,6 +65,58 @@ final $typed_data.Uint8List healthStatusDescriptor = $convert.base64Decode(
     'RVU19IRUFMVEhZEAESGwoXSEVBTFRIX1NUQVRVU19VTkhFQUxUSFkQAhIaChZIRUFMVEhfU1RB'
     'VFVTX1NUQVJUSU5HEAM=');
 
+@$core.Deprecated('Use approvalTimeoutActionDescriptor instead')
+const ApprovalTimeoutAction$json = {
+  '1': 'ApprovalTimeoutAction',
+  '2': [
+    {'1': 'APPROVAL_TIMEOUT_UNSPECIFIED', '2': 0},
+    {'1': 'APPROVAL_TIMEOUT_DENY', '2': 1},
+    {'1': 'APPROVAL_TIMEOUT_ALLOW', '2': 2},
+    {'1': 'APPROVAL_TIMEOUT_MOCK', '2': 3},
+  ],
+};
+
+/// Descriptor for `ApprovalTimeoutAction`. Decode as a `google.protobuf.EnumDescriptorProto`.
+final $typed_data.Uint8List approvalTimeoutActionDescriptor =
+    $convert.base64Decode(
+        'ChVBcHByb3ZhbFRpbWVvdXRBY3Rpb24SIAocQVBQUk9WQUxfVElNRU9VVF9VTlNQRUNJRklFRB'
+        'AAEhkKFUFQUFJPVkFMX1RJTUVPVVRfREVOWRABEhoKFkFQUFJPVkFMX1RJTUVPVVRfQUxMT1cQ'
+        'AhIZChVBUFBST1ZBTF9USU1FT1VUX01PQ0sQAw==');
+
+@$core.Deprecated('Use approvalHoldDescriptor instead')
+const ApprovalHold$json = {
+  '1': 'ApprovalHold',
+  '2': [
+    {'1': 'APPROVAL_HOLD_UNSPECIFIED', '2': 0},
+    {'1': 'APPROVAL_HOLD_SILENT', '2': 1},
+    {'1': 'APPROVAL_HOLD_TARPIT', '2': 2},
+    {'1': 'APPROVAL_HOLD_HTTP_PENDING', '2': 3},
+  ],
+};
+
+/// Descriptor for `ApprovalHold`. Decode as a `google.protobuf.EnumDescriptorProto`.
+final $typed_data.Uint8List approvalHoldDescriptor = $convert.base64Decode(
+    'CgxBcHByb3ZhbEhvbGQSHQoZQVBQUk9WQUxfSE9MRF9VTlNQRUNJRklFRBAAEhgKFEFQUFJPVk'
+    'FMX0hPTERfU0lMRU5UEAESGAoUQVBQUk9WQUxfSE9MRF9UQVJQSVQQAhIeChpBUFBST1ZBTF9I'
+    'T0xEX0hUVFBfUEVORElORxAD');
+
+@$core.Deprecated('Use thresholdActionDescriptor instead')
+const ThresholdAction$json = {
+  '1': 'ThresholdAction',
+  '2': [
+    {'1': 'THRESHOLD_ACTION_UNSPECIFIED', '2': 0},
+    {'1': 'THRESHOLD_ACTION_ALERT', '2': 1},
+    {'1': 'THRESHOLD_ACTION_CLOSE', '2': 2},
+    {'1': 'THRESHOLD_ACTION_THROTTLE', '2': 3},
+  ],
+};
+
+/// Descriptor for `ThresholdAction`. Decode as a `google.protobuf.EnumDescriptorProto`.
+final $typed_data.Uint8List thresholdActionDescriptor = $convert.base64Decode(
+    'Cg9UaHJlc2hvbGRBY3Rpb24SIAocVEhSRVNIT0xEX0FDVElPTl9VTlNQRUNJRklFRBAAEhoKFl'
+    'RIUkVTSE9MRF9BQ1RJT05fQUxFUlQQARIaChZUSFJFU0hPTERfQUNUSU9OX0NMT1NFEAISHQoZ'
+    'VEhSRVNIT0xEX0FDVElPTl9USFJPVFRMRRAD');
+
 @$core.Deprecated('Use eventTypeDescriptor instead')
 const EventType$json = {
   '1': 'EventType',
@@ -75,6 +127,12 @@ const EventType$json = {
     {'1': 'EVENT_TYPE_BLOCKED', '2': 3},
     {'1': 'EVENT_TYPE_PENDING_APPROVAL', '2': 4},
     {'1': 'EVENT_TYPE_APPROVED', '2': 5},
+    {'1': 'EVENT_TYPE_MOCK_CAPTURE', '2': 6},
+    {'1': 'EVENT_TYPE_MOCK_INTERACTION', '2': 7},
+    {'1': 'EVENT_TYPE_ALERT', '2': 8},
+    {'1': 'EVENT_TYPE_THRESHOLD_ALERT', '2': 9},
+    {'1': 'EVENT_TYPE_THRESHOLD_CLOSED', '2': 10},
+    {'1': 'EVENT_TYPE_THRESHOLD_THROTTLED', '2': 11},
   ],
 };
 
@@ -83,7 +141,10 @@ final $typed_data.Uint8List eventTypeDescriptor = $convert.base64Decode(
     'CglFdmVudFR5cGUSGgoWRVZFTlRfVFlQRV9VTlNQRUNJRklFRBAAEhgKFEVWRU5UX1RZUEVfQ0'
     '9OTkVDVEVEEAESFQoRRVZFTlRfVFlQRV9DTE9TRUQQAhIWChJFVkVOVF9UWVBFX0JMT0NLRUQQ'
     'AxIfChtFVkVOVF9UWVBFX1BFTkRJTkdfQVBQUk9WQUwQBBIXChNFVkVOVF9UWVBFX0FQUFJPVk'
-    'VEEAU=');
+    'VEEAUSGwoXRVZFTlRfVFlQRV9NT0NLX0NBUFRVUkUQBhIfChtFVkVOVF9UWVBFX01PQ0tfSU5U'
+    'RVJBQ1RJT04QBxIUChBFVkVOVF9UWVBFX0FMRVJUEAgSHgoaRVZFTlRfVFlQRV9USFJFU0hPTE'
+    'RfQUxFUlQQCRIfChtFVkVOVF9UWVBFX1RIUkVTSE9MRF9DTE9TRUQQChIiCh5FVkVOVF9UWVBF'
+    'X1RIUkVTSE9MRF9USFJPVFRMRUQQCw==');
 
 @$core.Deprecated('Use configureGeoIPRequestDescriptor instead')
 const ConfigureGeoIPRequest$json = {
@@ -256,6 +317,14 @@ const CreateProxyRequest$json = {
       '6': '.nitella.proxy.HealthCheckConfig',
       '10': 'healthCheck'
     },
+    {
+      '1': 'thresholds',
+      '3': 14,
+      '4': 1,
+      '5': 11,
+      '6': '.nitella.proxy.ConnectionThresholds',
+      '10': 'thresholds'
+    },
   ],
 };
 
@@ -271,7 +340,8 @@ final $typed_data.Uint8List createProxyRequestDescriptor = $convert.base64Decode
     'gKIAEoDjITLm5pdGVsbGEuTW9ja1ByZXNldFIMZmFsbGJhY2tNb2NrEkcKEGNsaWVudF9hdXRo'
     'X3R5cGUYCyABKA4yHS5uaXRlbGxhLnByb3h5LkNsaWVudEF1dGhUeXBlUg5jbGllbnRBdXRoVH'
     'lwZRISCgR0YWdzGAwgAygJUgR0YWdzEkMKDGhlYWx0aF9jaGVjaxgNIAEoCzIgLm5pdGVsbGEu'
-    'cHJveHkuSGVhbHRoQ2hlY2tDb25maWdSC2hlYWx0aENoZWNr');
+    'cHJveHkuSGVhbHRoQ2hlY2tDb25maWdSC2hlYWx0aENoZWNrEkMKCnRocmVzaG9sZHMYDiABKA'
+    'syIy5uaXRlbGxhLnByb3h5LkNvbm5lY3Rpb25UaHJlc2hvbGRzUgp0aHJlc2hvbGRz');
 
 @$core.Deprecated('Use healthCheckConfigDescriptor instead')
 const HealthCheckConfig$json = {
@@ -455,6 +525,14 @@ const UpdateProxyRequest$json = {
       '6': '.nitella.proxy.HealthCheckConfig',
       '10': 'healthCheck'
     },
+    {
+      '1': 'thresholds',
+      '3': 15,
+      '4': 1,
+      '5': 11,
+      '6': '.nitella.proxy.ConnectionThresholds',
+      '10': 'thresholds'
+    },
   ],
 };
 
@@ -471,7 +549,8 @@ final $typed_data.Uint8List updateProxyRequestDescriptor = $convert.base64Decode
     'ZmFsbGJhY2tNb2NrEkcKEGNsaWVudF9hdXRoX3R5cGUYDCABKA4yHS5uaXRlbGxhLnByb3h5Lk'
     'NsaWVudEF1dGhUeXBlUg5jbGllbnRBdXRoVHlwZRISCgR0YWdzGA0gAygJUgR0YWdzEkMKDGhl'
     'YWx0aF9jaGVjaxgOIAEoCzIgLm5pdGVsbGEucHJveHkuSGVhbHRoQ2hlY2tDb25maWdSC2hlYW'
-    'x0aENoZWNr');
+    'x0aENoZWNrEkMKCnRocmVzaG9sZHMYDyABKAsyIy5uaXRlbGxhLnByb3h5LkNvbm5lY3Rpb25U'
+    'aHJlc2hvbGRzUgp0aHJlc2hvbGRz');
 
 @$core.Deprecated('Use updateProxyResponseDescriptor instead')
 const UpdateProxyResponse$json = {
@@ -598,7 +677,42 @@ const ProxyStatus$json = {
       '6': '.nitella.proxy.HealthStatus',
       '10': 'healthStatus'
     },
+    {'1': 'decisions', '3': 19, '4': 1, '5': 3, '10': 'decisions'},
+    {
+      '1': 'decision_latency_total_us',
+      '3': 20,
+      '4': 1,
+      '5': 3,
+      '10': 'decisionLatencyTotalUs'
+    },
+    {
+      '1': 'decision_latency_max_us',
+      '3': 21,
+      '4': 1,
+      '5': 3,
+      '10': 'decisionLatencyMaxUs'
+    },
+    {
+      '1': 'rule_hits',
+      '3': 22,
+      '4': 3,
+      '5': 11,
+      '6': '.nitella.proxy.ProxyStatus.RuleHitsEntry',
+      '10': 'ruleHits'
+    },
+    {'1': 'rate_limited', '3': 23, '4': 1, '5': 3, '10': 'rateLimited'},
+  ],
+  '3': [ProxyStatus_RuleHitsEntry$json],
+};
+
+@$core.Deprecated('Use proxyStatusDescriptor instead')
+const ProxyStatus_RuleHitsEntry$json = {
+  '1': 'RuleHitsEntry',
+  '2': [
+    {'1': 'key', '3': 1, '4': 1, '5': 9, '10': 'key'},
+    {'1': 'value', '3': 2, '4': 1, '5': 3, '10': 'value'},
   ],
+  '7': {'7': true},
 };
 
 /// Descriptor for `ProxyStatus`. Decode as a `google.protobuf.DescriptorProto`.
@@ -617,7 +731,13 @@ final $typed_data.Uint8List proxyStatusDescriptor = $convert.base64Decode(
     'V0aF90eXBlGA8gASgOMh0ubml0ZWxsYS5wcm94eS5DbGllbnRBdXRoVHlwZVIOY2xpZW50QXV0'
     'aFR5cGUSEgoEdGFncxgQIAMoCVIEdGFncxJDCgxoZWFsdGhfY2hlY2sYESABKAsyIC5uaXRlbG'
     'xhLnByb3h5LkhlYWx0aENoZWNrQ29uZmlnUgtoZWFsdGhDaGVjaxJACg1oZWFsdGhfc3RhdHVz'
-    'GBIgASgOMhsubml0ZWxsYS5wcm94eS5IZWFsdGhTdGF0dXNSDGhlYWx0aFN0YXR1cw==');
+    'GBIgASgOMhsubml0ZWxsYS5wcm94eS5IZWFsdGhTdGF0dXNSDGhlYWx0aFN0YXR1cxIcCglkZW'
+    'Npc2lvbnMYEyABKANSCWRlY2lzaW9ucxI5ChlkZWNpc2lvbl9sYXRlbmN5X3RvdGFsX3VzGBQg'
+    'ASgDUhZkZWNpc2lvbkxhdGVuY3lUb3RhbFVzEjUKF2RlY2lzaW9uX2xhdGVuY3lfbWF4X3VzGB'
+    'UgASgDUhRkZWNpc2lvbkxhdGVuY3lNYXhVcxJFCglydWxlX2hpdHMYFiADKAsyKC5uaXRlbGxh'
+    'LnByb3h5LlByb3h5U3RhdHVzLlJ1bGVIaXRzRW50cnlSCHJ1bGVIaXRzEiEKDHJhdGVfbGltaX'
+    'RlZBgXIAEoA1ILcmF0ZUxpbWl0ZWQaOwoNUnVsZUhpdHNFbnRyeRIQCgNrZXkYASABKAlSA2tl'
+    'eRIUCgV2YWx1ZRgCIAEoA1IFdmFsdWU6AjgB');
 
 @$core.Deprecated('Use reloadRulesRequestDescriptor instead')
 const ReloadRulesRequest$json = {
@@ -768,6 +888,39 @@ const Rule$json = {
       '10': 'mockResponse'
     },
     {'1': 'expression', '3': 10, '4': 1, '5': 9, '10': 'expression'},
+    {
+      '1': 'not_before',
+      '3': 11,
+      '4': 1,
+      '5': 11,
+      '6': '.google.protobuf.Timestamp',
+      '10': 'notBefore'
+    },
+    {
+      '1': 'expires_at',
+      '3': 12,
+      '4': 1,
+      '5': 11,
+      '6': '.google.protobuf.Timestamp',
+      '10': 'expiresAt'
+    },
+    {'1': 'schedule', '3': 13, '4': 1, '5': 9, '10': 'schedule'},
+    {
+      '1': 'thresholds',
+      '3': 14,
+      '4': 1,
+      '5': 11,
+      '6': '.nitella.proxy.ConnectionThresholds',
+      '10': 'thresholds'
+    },
+    {
+      '1': 'approval_policy',
+      '3': 15,
+      '4': 1,
+      '5': 11,
+      '6': '.nitella.proxy.ApprovalPolicy',
+      '10': 'approvalPolicy'
+    },
   ],
 };
 
@@ -780,7 +933,124 @@ final $typed_data.Uint8List ruleDescriptor = $convert.base64Decode(
     'CVINdGFyZ2V0QmFja2VuZBI9CgpyYXRlX2xpbWl0GAggASgLMh4ubml0ZWxsYS5wcm94eS5SYX'
     'RlTGltaXRDb25maWdSCXJhdGVMaW1pdBI+Cg1tb2NrX3Jlc3BvbnNlGAkgASgLMhkubml0ZWxs'
     'YS5wcm94eS5Nb2NrQ29uZmlnUgxtb2NrUmVzcG9uc2USHgoKZXhwcmVzc2lvbhgKIAEoCVIKZX'
-    'hwcmVzc2lvbg==');
+    'hwcmVzc2lvbhI5Cgpub3RfYmVmb3JlGAsgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFt'
+    'cFIJbm90QmVmb3JlEjkKCmV4cGlyZXNfYXQYDCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZX'
+    'N0YW1wUglleHBpcmVzQXQSGgoIc2NoZWR1bGUYDSABKAlSCHNjaGVkdWxlEkMKCnRocmVzaG9s'
+    'ZHMYDiABKAsyIy5uaXRlbGxhLnByb3h5LkNvbm5lY3Rpb25UaHJlc2hvbGRzUgp0aHJlc2hvbG'
+    'RzEkYKD2FwcHJvdmFsX3BvbGljeRgPIAEoCzIdLm5pdGVsbGEucHJveHkuQXBwcm92YWxQb2xp'
+    'Y3lSDmFwcHJvdmFsUG9saWN5');
+
+@$core.Deprecated('Use approvalPolicyDescriptor instead')
+const ApprovalPolicy$json = {
+  '1': 'ApprovalPolicy',
+  '2': [
+    {'1': 'timeout_seconds', '3': 1, '4': 1, '5': 3, '10': 'timeoutSeconds'},
+    {
+      '1': 'on_timeout',
+      '3': 2,
+      '4': 1,
+      '5': 14,
+      '6': '.nitella.proxy.ApprovalTimeoutAction',
+      '10': 'onTimeout'
+    },
+    {
+      '1': 'timeout_mock',
+      '3': 3,
+      '4': 1,
+      '5': 14,
+      '6': '.nitella.MockPreset',
+      '10': 'timeoutMock'
+    },
+    {
+      '1': 'hold',
+      '3': 4,
+      '4': 1,
+      '5': 14,
+      '6': '.nitella.proxy.ApprovalHold',
+      '10': 'hold'
+    },
+    {'1': 'quorum', '3': 5, '4': 1, '5': 5, '10': 'quorum'},
+    {
+      '1': 'local',
+      '3': 6,
+      '4': 1,
+      '5': 11,
+      '6': '.nitella.proxy.LocalApprover',
+      '10': 'local'
+    },
+  ],
+};
+
+/// Descriptor for `ApprovalPolicy`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List approvalPolicyDescriptor = $convert.base64Decode(
+    'Cg5BcHByb3ZhbFBvbGljeRInCg90aW1lb3V0X3NlY29uZHMYASABKANSDnRpbWVvdXRTZWNvbm'
+    'RzEkMKCm9uX3RpbWVvdXQYAiABKA4yJC5uaXRlbGxhLnByb3h5LkFwcHJvdmFsVGltZW91dEFj'
+    'dGlvblIJb25UaW1lb3V0EjYKDHRpbWVvdXRfbW9jaxgDIAEoDjITLm5pdGVsbGEuTW9ja1ByZX'
+    'NldFILdGltZW91dE1vY2sSLwoEaG9sZBgEIAEoDjIbLm5pdGVsbGEucHJveHkuQXBwcm92YWxI'
+    'b2xkUgRob2xkEhYKBnF1b3J1bRgFIAEoBVIGcXVvcnVtEjIKBWxvY2FsGAYgASgLMhwubml0ZW'
+    'xsYS5wcm94eS5Mb2NhbEFwcHJvdmVyUgVsb2NhbA==');
+
+@$core.Deprecated('Use localApproverDescriptor instead')
+const LocalApprover$json = {
+  '1': 'LocalApprover',
+  '2': [
+    {'1': 'name', '3': 6, '4': 1, '5': 9, '10': 'name'},
+  ],
+  '9': [
+    {'1': 1, '2': 6},
+  ],
+};
+
+/// Descriptor for `LocalApprover`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List localApproverDescriptor =
+    $convert.base64Decode('Cg1Mb2NhbEFwcHJvdmVyEhIKBG5hbWUYBiABKAlSBG5hbWVKBAgBEAY=');
+
+@$core.Deprecated('Use connectionThresholdsDescriptor instead')
+const ConnectionThresholds$json = {
+  '1': 'ConnectionThresholds',
+  '2': [
+    {'1': 'max_bytes_out', '3': 1, '4': 1, '5': 3, '10': 'maxBytesOut'},
+    {
+      '1': 'max_source_bytes_out_hour',
+      '3': 2,
+      '4': 1,
+      '5': 3,
+      '10': 'maxSourceBytesOutHour'
+    },
+    {
+      '1': 'max_duration_seconds',
+      '3': 3,
+      '4': 1,
+      '5': 3,
+      '10': 'maxDurationSeconds'
+    },
+    {
+      '1': 'action',
+      '3': 4,
+      '4': 1,
+      '5': 14,
+      '6': '.nitella.proxy.ThresholdAction',
+      '10': 'action'
+    },
+    {
+      '1': 'throttle_bytes_per_second',
+      '3': 5,
+      '4': 1,
+      '5': 3,
+      '10': 'throttleBytesPerSecond'
+    },
+  ],
+};
+
+/// Descriptor for `ConnectionThresholds`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List connectionThresholdsDescriptor =
+    $convert.base64Decode(
+        'ChRDb25uZWN0aW9uVGhyZXNob2xkcxIiCg1tYXhfYnl0ZXNfb3V0GAEgASgDUgttYXhCeXRlc0'
+        '91dBI4ChltYXhfc291cmNlX2J5dGVzX291dF9ob3VyGAIgASgDUhVtYXhTb3VyY2VCeXRlc091'
+        'dEhvdXISMAoUbWF4X2R1cmF0aW9uX3NlY29uZHMYAyABKANSEm1heER1cmF0aW9uU2Vjb25kcx'
+        'I2CgZhY3Rpb24YBCABKA4yHi5uaXRlbGxhLnByb3h5LlRocmVzaG9sZEFjdGlvblIGYWN0aW9u'
+        'EjkKGXRocm90dGxlX2J5dGVzX3Blcl9zZWNvbmQYBSABKANSFnRocm90dGxlQnl0ZXNQZXJTZW'
+        'NvbmQ=');
 
 @$core.Deprecated('Use conditionDescriptor instead')
 const Condition$json = {
@@ -869,6 +1139,27 @@ const MockConfig$json = {
     {'1': 'protocol', '3': 2, '4': 1, '5': 9, '10': 'protocol'},
     {'1': 'payload', '3': 3, '4': 1, '5': 12, '10': 'payload'},
     {'1': 'delay_ms', '3': 4, '4': 1, '5': 5, '10': 'delayMs'},
+    {'1': 'accept_login', '3': 5, '4': 1, '5': 8, '10': 'acceptLogin'},
+    {'1': 'http_route_packs', '3': 6, '4': 3, '5': 9, '10': 'httpRoutePacks'},
+    {'1': 'http_routes_file', '3': 7, '4': 1, '5': 9, '10': 'httpRoutesFile'},
+    {'1': 'canary_paths', '3': 8, '4': 3, '5': 9, '10': 'canaryPaths'},
+    {
+      '1': 'canary_block_seconds',
+      '3': 9,
+      '4': 1,
+      '5': 5,
+      '10': 'canaryBlockSeconds'
+    },
+    {'1': 'smb_dialect', '3': 10, '4': 1, '5': 9, '10': 'smbDialect'},
+    {'1': 'smb_os_version', '3': 11, '4': 1, '5': 9, '10': 'smbOsVersion'},
+    {'1': 'smb_hostname', '3': 12, '4': 1, '5': 9, '10': 'smbHostname'},
+    {'1': 'script_file', '3': 13, '4': 1, '5': 9, '10': 'scriptFile'},
+    {'1': 'script', '3': 14, '4': 1, '5': 9, '10': 'script'},
+    {'1': 'cloned_preset', '3': 15, '4': 1, '5': 9, '10': 'clonedPreset'},
+    {'1': 'tls', '3': 16, '4': 1, '5': 8, '10': 'tls'},
+    {'1': 'tls_common_name', '3': 17, '4': 1, '5': 9, '10': 'tlsCommonName'},
+    {'1': 'tls_organization', '3': 18, '4': 1, '5': 9, '10': 'tlsOrganization'},
+    {'1': 'tls_sans', '3': 19, '4': 3, '5': 9, '10': 'tlsSans'},
   ],
 };
 
@@ -876,7 +1167,17 @@ const MockConfig$json = {
 final $typed_data.Uint8List mockConfigDescriptor = $convert.base64Decode(
     'CgpNb2NrQ29uZmlnEisKBnByZXNldBgBIAEoDjITLm5pdGVsbGEuTW9ja1ByZXNldFIGcHJlc2'
     'V0EhoKCHByb3RvY29sGAIgASgJUghwcm90b2NvbBIYCgdwYXlsb2FkGAMgASgMUgdwYXlsb2Fk'
-    'EhkKCGRlbGF5X21zGAQgASgFUgdkZWxheU1z');
+    'EhkKCGRlbGF5X21zGAQgASgFUgdkZWxheU1zEiEKDGFjY2VwdF9sb2dpbhgFIAEoCFILYWNjZX'
+    'B0TG9naW4SKAoQaHR0cF9yb3V0ZV9wYWNrcxgGIAMoCVIOaHR0cFJvdXRlUGFja3MSKAoQaHR0'
+    'cF9yb3V0ZXNfZmlsZRgHIAEoCVIOaHR0cFJvdXRlc0ZpbGUSIQoMY2FuYXJ5X3BhdGhzGAggAy'
+    'gJUgtjYW5hcnlQYXRocxIwChRjYW5hcnlfYmxvY2tfc2Vjb25kcxgJIAEoBVISY2FuYXJ5Qmxv'
+    'Y2tTZWNvbmRzEh8KC3NtYl9kaWFsZWN0GAogASgJUgpzbWJEaWFsZWN0EiQKDnNtYl9vc192ZX'
+    'JzaW9uGAsgASgJUgxzbWJPc1ZlcnNpb24SIQoMc21iX2hvc3RuYW1lGAwgASgJUgtzbWJIb3N0'
+    'bmFtZRIfCgtzY3JpcHRfZmlsZRgNIAEoCVIKc2NyaXB0RmlsZRIWCgZzY3JpcHQYDiABKAlSBn'
+    'NjcmlwdBIjCg1jbG9uZWRfcHJlc2V0GA8gASgJUgxjbG9uZWRQcmVzZXQSEAoDdGxzGBAgASgI'
+    'UgN0bHMSJgoPdGxzX2NvbW1vbl9uYW1lGBEgASgJUg10bHNDb21tb25OYW1lEikKEHRsc19vcm'
+    'dhbml6YXRpb24YEiABKAlSD3Rsc09yZ2FuaXphdGlvbhIZCgh0bHNfc2FucxgTIAMoCVIHdGxz'
+    'U2Fucw==');
 
 @$core.Deprecated('Use addRuleRequestDescriptor instead')
 const AddRuleRequest$json = {
@@ -1154,6 +1455,15 @@ const ConnectionEvent$json = {
       '6': '.nitella.GeoInfo',
       '10': 'geo'
     },
+    {
+      '1': 'capture',
+      '3': 12,
+      '4': 1,
+      '5': 11,
+      '6': '.nitella.proxy.MockCapture',
+      '10': 'capture'
+    },
+    {'1': 'threshold', '3': 13, '4': 1, '5': 9, '10': 'threshold'},
   ],
 };
 
@@ -1166,7 +1476,46 @@ final $typed_data.Uint8List connectionEventDescriptor = $convert.base64Decode(
     'bXASIQoMcnVsZV9tYXRjaGVkGAcgASgJUgtydWxlTWF0Y2hlZBI2CgxhY3Rpb25fdGFrZW4YCC'
     'ABKA4yEy5uaXRlbGxhLkFjdGlvblR5cGVSC2FjdGlvblRha2VuEhkKCGJ5dGVzX2luGAkgASgD'
     'UgdieXRlc0luEhsKCWJ5dGVzX291dBgKIAEoA1IIYnl0ZXNPdXQSIgoDZ2VvGAsgASgLMhAubm'
-    'l0ZWxsYS5HZW9JbmZvUgNnZW8=');
+    'l0ZWxsYS5HZW9JbmZvUgNnZW8SNAoHY2FwdHVyZRgMIAEoCzIaLm5pdGVsbGEucHJveHkuTW9j'
+    'a0NhcHR1cmVSB2NhcHR1cmUSHAoJdGhyZXNob2xkGA0gASgJUgl0aHJlc2hvbGQ=');
+
+@$core.Deprecated('Use mockCaptureDescriptor instead')
+const MockCapture$json = {
+  '1': 'MockCapture',
+  '2': [
+    {'1': 'protocol', '3': 1, '4': 1, '5': 9, '10': 'protocol'},
+    {'1': 'kind', '3': 2, '4': 1, '5': 9, '10': 'kind'},
+    {'1': 'username', '3': 3, '4': 1, '5': 9, '10': 'username'},
+    {'1': 'password', '3': 4, '4': 1, '5': 9, '10': 'password'},
+    {
+      '1': 'fields',
+      '3': 5,
+      '4': 3,
+      '5': 11,
+      '6': '.nitella.proxy.MockCapture.FieldsEntry',
+      '10': 'fields'
+    },
+  ],
+  '3': [MockCapture_FieldsEntry$json],
+};
+
+@$core.Deprecated('Use mockCaptureDescriptor instead')
+const MockCapture_FieldsEntry$json = {
+  '1': 'FieldsEntry',
+  '2': [
+    {'1': 'key', '3': 1, '4': 1, '5': 9, '10': 'key'},
+    {'1': 'value', '3': 2, '4': 1, '5': 9, '10': 'value'},
+  ],
+  '7': {'7': true},
+};
+
+/// Descriptor for `MockCapture`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List mockCaptureDescriptor = $convert.base64Decode(
+    'CgtNb2NrQ2FwdHVyZRIaCghwcm90b2NvbBgBIAEoCVIIcHJvdG9jb2wSEgoEa2luZBgCIAEoCV'
+    'IEa2luZBIaCgh1c2VybmFtZRgDIAEoCVIIdXNlcm5hbWUSGgoIcGFzc3dvcmQYBCABKAlSCHBh'
+    'c3N3b3JkEj4KBmZpZWxkcxgFIAMoCzImLm5pdGVsbGEucHJveHkuTW9ja0NhcHR1cmUuRmllbG'
+    'RzRW50cnlSBmZpZWxkcxo5CgtGaWVsZHNFbnRyeRIQCgNrZXkYASABKAlSA2tleRIUCgV2YWx1'
+    'ZRgCIAEoCVIFdmFsdWU6AjgB');
 
 @$core.Deprecated('Use streamMetricsRequestDescriptor instead')
 const StreamMetricsRequest$json = {
@@ -1192,6 +1541,14 @@ const MetricsSample$json = {
     {'1': 'bytes_in_rate', '3': 4, '4': 1, '5': 3, '10': 'bytesInRate'},
     {'1': 'bytes_out_rate', '3': 5, '4': 1, '5': 3, '10': 'bytesOutRate'},
     {'1': 'blocked_total', '3': 6, '4': 1, '5': 3, '10': 'blockedTotal'},
+    {'1': 'tarpit_active', '3': 7, '4': 1, '5': 3, '10': 'tarpitActive'},
+    {
+      '1': 'tarpit_memory_bytes',
+      '3': 8,
+      '4': 1,
+      '5': 3,
+      '10': 'tarpitMemoryBytes'
+    },
   ],
 };
 
@@ -1201,7 +1558,8 @@ final $typed_data.Uint8List metricsSampleDescriptor = $convert.base64Decode(
     '9jb25ucxgCIAEoA1ILYWN0aXZlQ29ubnMSHwoLdG90YWxfY29ubnMYAyABKANSCnRvdGFsQ29u'
     'bnMSIgoNYnl0ZXNfaW5fcmF0ZRgEIAEoA1ILYnl0ZXNJblJhdGUSJAoOYnl0ZXNfb3V0X3JhdG'
     'UYBSABKANSDGJ5dGVzT3V0UmF0ZRIjCg1ibG9ja2VkX3RvdGFsGAYgASgDUgxibG9ja2VkVG90'
-    'YWw=');
+    'YWwSIwoNdGFycGl0X2FjdGl2ZRgHIAEoA1IMdGFycGl0QWN0aXZlEi4KE3RhcnBpdF9tZW1vcn'
+    'lfYnl0ZXMYCCABKANSEXRhcnBpdE1lbW9yeUJ5dGVz');
 
 @$core.Deprecated('Use encryptedStreamPayloadDescriptor instead')
 const EncryptedStreamPayload$json = {
@@ -1536,6 +1894,22 @@ const StatsSummaryResponse$json = {
       '6': '.google.protobuf.Timestamp',
       '10': 'timestamp'
     },
+    {
+      '1': 'tarpit',
+      '3': 11,
+      '4': 1,
+      '5': 11,
+      '6': '.nitella.proxy.TarpitStats',
+      '10': 'tarpit'
+    },
+    {
+      '1': 'alert_sinks',
+      '3': 12,
+      '4': 3,
+      '5': 11,
+      '6': '.nitella.proxy.AlertSinkStats',
+      '10': 'alertSinks'
+    },
   ],
 };
 
@@ -1548,7 +1922,53 @@ final $typed_data.Uint8List statsSummaryResponseDescriptor = $convert.base64Deco
     'DWJsb2NrZWRfdG90YWwYBiABKANSDGJsb2NrZWRUb3RhbBIjCg1hbGxvd2VkX3RvdGFsGAcgAS'
     'gDUgxhbGxvd2VkVG90YWwSLQoSYWN0aXZlX2Nvbm5lY3Rpb25zGAggASgDUhFhY3RpdmVDb25u'
     'ZWN0aW9ucxIfCgtwcm94eV9jb3VudBgJIAEoBVIKcHJveHlDb3VudBI4Cgl0aW1lc3RhbXAYCi'
-    'ABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wUgl0aW1lc3RhbXA=');
+    'ABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wUgl0aW1lc3RhbXASMgoGdGFycGl0GAsg'
+    'ASgLMhoubml0ZWxsYS5wcm94eS5UYXJwaXRTdGF0c1IGdGFycGl0Ej4KC2FsZXJ0X3NpbmtzGA'
+    'wgAygLMh0ubml0ZWxsYS5wcm94eS5BbGVydFNpbmtTdGF0c1IKYWxlcnRTaW5rcw==');
+
+@$core.Deprecated('Use alertSinkStatsDescriptor instead')
+const AlertSinkStats$json = {
+  '1': 'AlertSinkStats',
+  '2': [
+    {'1': 'name', '3': 1, '4': 1, '5': 9, '10': 'name'},
+    {'1': 'type', '3': 2, '4': 1, '5': 9, '10': 'type'},
+    {'1': 'sent_total', '3': 3, '4': 1, '5': 3, '10': 'sentTotal'},
+    {'1': 'retries_total', '3': 4, '4': 1, '5': 3, '10': 'retriesTotal'},
+    {'1': 'dead_letter_total', '3': 5, '4': 1, '5': 3, '10': 'deadLetterTotal'},
+    {'1': 'queued', '3': 6, '4': 1, '5': 3, '10': 'queued'},
+    {'1': 'last_error', '3': 7, '4': 1, '5': 9, '10': 'lastError'},
+  ],
+};
+
+/// Descriptor for `AlertSinkStats`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List alertSinkStatsDescriptor = $convert.base64Decode(
+    'Cg5BbGVydFNpbmtTdGF0cxISCgRuYW1lGAEgASgJUgRuYW1lEhIKBHR5cGUYAiABKAlSBHR5cG'
+    'USHQoKc2VudF90b3RhbBgDIAEoA1IJc2VudFRvdGFsEiMKDXJldHJpZXNfdG90YWwYBCABKANS'
+    'DHJldHJpZXNUb3RhbBIqChFkZWFkX2xldHRlcl90b3RhbBgFIAEoA1IPZGVhZExldHRlclRvdG'
+    'FsEhYKBnF1ZXVlZBgGIAEoA1IGcXVldWVkEh0KCmxhc3RfZXJyb3IYByABKAlSCWxhc3RFcnJv'
+    'cg==');
+
+@$core.Deprecated('Use tarpitStatsDescriptor instead')
+const TarpitStats$json = {
+  '1': 'TarpitStats',
+  '2': [
+    {'1': 'active_conns', '3': 1, '4': 1, '5': 3, '10': 'activeConns'},
+    {'1': 'max_conns', '3': 2, '4': 1, '5': 3, '10': 'maxConns'},
+    {'1': 'memory_bytes', '3': 3, '4': 1, '5': 3, '10': 'memoryBytes'},
+    {'1': 'max_memory_bytes', '3': 4, '4': 1, '5': 3, '10': 'maxMemoryBytes'},
+    {'1': 'admitted_total', '3': 5, '4': 1, '5': 3, '10': 'admittedTotal'},
+    {'1': 'rejected_total', '3': 6, '4': 1, '5': 3, '10': 'rejectedTotal'},
+    {'1': 'adaptive', '3': 7, '4': 1, '5': 8, '10': 'adaptive'},
+  ],
+};
+
+/// Descriptor for `TarpitStats`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List tarpitStatsDescriptor = $convert.base64Decode(
+    'CgtUYXJwaXRTdGF0cxIhCgxhY3RpdmVfY29ubnMYASABKANSC2FjdGl2ZUNvbm5zEhsKCW1heF'
+    '9jb25ucxgCIAEoA1IIbWF4Q29ubnMSIQoMbWVtb3J5X2J5dGVzGAMgASgDUgttZW1vcnlCeXRl'
+    'cxIoChBtYXhfbWVtb3J5X2J5dGVzGAQgASgDUg5tYXhNZW1vcnlCeXRlcxIlCg5hZG1pdHRlZF'
+    '90b3RhbBgFIAEoA1INYWRtaXR0ZWRUb3RhbBIlCg5yZWplY3RlZF90b3RhbBgGIAEoA1INcmVq'
+    'ZWN0ZWRUb3RhbBIaCghhZGFwdGl2ZRgHIAEoCFIIYWRhcHRpdmU=');
 
 @$core.Deprecated('Use resolveApprovalRequestDescriptor instead')
 const ResolveApprovalRequest$json = {
@@ -1573,6 +1993,8 @@ const ResolveApprovalRequest$json = {
     },
     {'1': 'duration_seconds', '3': 4, '4': 1, '5': 3, '10': 'durationSeconds'},
     {'1': 'reason', '3': 5, '4': 1, '5': 9, '10': 'reason'},
+    {'1': 'approver_key', '3': 6, '4': 1, '5': 12, '10': 'approverKey'},
+    {'1': 'signature', '3': 7, '4': 1, '5': 12, '10': 'signature'},
   ],
 };
 
@@ -1582,7 +2004,8 @@ final $typed_data.Uint8List resolveApprovalRequestDescriptor = $convert.base64De
     '9uGAIgASgOMhsubml0ZWxsYS5BcHByb3ZhbEFjdGlvblR5cGVSBmFjdGlvbhJFCg5yZXRlbnRp'
     'b25fbW9kZRgDIAEoDjIeLm5pdGVsbGEuQXBwcm92YWxSZXRlbnRpb25Nb2RlUg1yZXRlbnRpb2'
     '5Nb2RlEikKEGR1cmF0aW9uX3NlY29uZHMYBCABKANSD2R1cmF0aW9uU2Vjb25kcxIWCgZyZWFz'
-    'b24YBSABKAlSBnJlYXNvbg==');
+    'b24YBSABKAlSBnJlYXNvbhIhCgxhcHByb3Zlcl9rZXkYBiABKAxSC2FwcHJvdmVyS2V5EhwKCX'
+    'NpZ25hdHVyZRgHIAEoDFIJc2lnbmF0dXJl');
 
 @$core.Deprecated('Use resolveApprovalResponseDescriptor instead')
 const ResolveApprovalResponse$json = {
@@ -1590,6 +2013,8 @@ const ResolveApprovalResponse$json = {
   '2': [
     {'1': 'success', '3': 1, '4': 1, '5': 8, '10': 'success'},
     {'1': 'error_message', '3': 2, '4': 1, '5': 9, '10': 'errorMessage'},
+    {'1': 'approvals', '3': 3, '4': 1, '5': 5, '10': 'approvals'},
+    {'1': 'quorum', '3': 4, '4': 1, '5': 5, '10': 'quorum'},
   ],
 };
 
@@ -1597,7 +2022,8 @@ const ResolveApprovalResponse$json = {
 final $typed_data.Uint8List resolveApprovalResponseDescriptor =
     $convert.base64Decode(
         'ChdSZXNvbHZlQXBwcm92YWxSZXNwb25zZRIYCgdzdWNjZXNzGAEgASgIUgdzdWNjZXNzEiMKDW'
-        'Vycm9yX21lc3NhZ2UYAiABKAlSDGVycm9yTWVzc2FnZQ==');
+        'Vycm9yX21lc3NhZ2UYAiABKAlSDGVycm9yTWVzc2FnZRIcCglhcHByb3ZhbHMYAyABKAVSCWFw'
+        'cHJvdmFscxIWCgZxdW9ydW0YBCABKAVSBnF1b3J1bQ==');
 
 @$core.Deprecated('Use activeApprovalDescriptor instead')
 const ActiveApproval$json = {
@@ -1632,6 +2058,10 @@ const ActiveApproval$json = {
     {'1': 'geo_country', '3': 13, '4': 1, '5': 9, '10': 'geoCountry'},
     {'1': 'geo_city', '3': 14, '4': 1, '5': 9, '10': 'geoCity'},
     {'1': 'geo_isp', '3': 15, '4': 1, '5': 9, '10': 'geoIsp'},
+    {'1': 'pending', '3': 16, '4': 1, '5': 8, '10': 'pending'},
+    {'1': 'approvals', '3': 17, '4': 1, '5': 5, '10': 'approvals'},
+    {'1': 'quorum', '3': 18, '4': 1, '5': 5, '10': 'quorum'},
+    {'1': 'approvers', '3': 19, '4': 3, '5': 9, '10': 'approvers'},
   ],
 };
 
@@ -1646,7 +2076,9 @@ final $typed_data.Uint8List activeApprovalDescriptor = $convert.base64Decode(
     'X291dBgKIAEoA1IIYnl0ZXNPdXQSIwoNYmxvY2tlZF9jb3VudBgLIAEoA1IMYmxvY2tlZENvdW'
     '50EhkKCGNvbm5faWRzGAwgAygJUgdjb25uSWRzEh8KC2dlb19jb3VudHJ5GA0gASgJUgpnZW9D'
     'b3VudHJ5EhkKCGdlb19jaXR5GA4gASgJUgdnZW9DaXR5EhcKB2dlb19pc3AYDyABKAlSBmdlb0'
-    'lzcA==');
+    'lzcBIYCgdwZW5kaW5nGBAgASgIUgdwZW5kaW5nEhwKCWFwcHJvdmFscxgRIAEoBVIJYXBwcm92'
+    'YWxzEhYKBnF1b3J1bRgSIAEoBVIGcXVvcnVtEhwKCWFwcHJvdmVycxgTIAMoCVIJYXBwcm92ZX'
+    'Jz');
 
 @$core.Deprecated('Use listActiveApprovalsRequestDescriptor instead')
 const ListActiveApprovalsRequest$json = {
@@ -1726,6 +2158,169 @@ final $typed_data.Uint8List cancelApprovalResponseDescriptor = $convert.base64De
     'Jyb3JfbWVzc2FnZRgCIAEoCVIMZXJyb3JNZXNzYWdlEi0KEmNvbm5lY3Rpb25zX2Nsb3NlZBgD'
     'IAEoBVIRY29ubmVjdGlvbnNDbG9zZWQ=');
 
+@$core.Deprecated('Use getMockTranscriptsRequestDescriptor instead')
+const GetMockTranscriptsRequest$json = {
+  '1': 'GetMockTranscriptsRequest',
+  '2': [
+    {'1': 'conn_id', '3': 1, '4': 1, '5': 9, '10': 'connId'},
+    {'1': 'source_ip', '3': 2, '4': 1, '5': 9, '10': 'sourceIp'},
+    {'1': 'limit', '3': 3, '4': 1, '5': 5, '10': 'limit'},
+  ],
+};
+
+/// Descriptor for `GetMockTranscriptsRequest`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List getMockTranscriptsRequestDescriptor =
+    $convert.base64Decode(
+        'ChlHZXRNb2NrVHJhbnNjcmlwdHNSZXF1ZXN0EhcKB2Nvbm5faWQYASABKAlSBmNvbm5JZBIbCg'
+        'lzb3VyY2VfaXAYAiABKAlSCHNvdXJjZUlwEhQKBWxpbWl0GAMgASgFUgVsaW1pdA==');
+
+@$core.Deprecated('Use mockTranscriptDescriptor instead')
+const MockTranscript$json = {
+  '1': 'MockTranscript',
+  '2': [
+    {'1': 'conn_id', '3': 1, '4': 1, '5': 9, '10': 'connId'},
+    {'1': 'source_ip', '3': 2, '4': 1, '5': 9, '10': 'sourceIp'},
+    {'1': 'source_port', '3': 3, '4': 1, '5': 5, '10': 'sourcePort'},
+    {'1': 'rule_id', '3': 4, '4': 1, '5': 9, '10': 'ruleId'},
+    {
+      '1': 'start_time',
+      '3': 5,
+      '4': 1,
+      '5': 11,
+      '6': '.google.protobuf.Timestamp',
+      '10': 'startTime'
+    },
+    {'1': 'duration_ms', '3': 6, '4': 1, '5': 3, '10': 'durationMs'},
+    {'1': 'geo_country', '3': 7, '4': 1, '5': 9, '10': 'geoCountry'},
+    {'1': 'transcript', '3': 8, '4': 1, '5': 9, '10': 'transcript'},
+  ],
+};
+
+/// Descriptor for `MockTranscript`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List mockTranscriptDescriptor = $convert.base64Decode(
+    'Cg5Nb2NrVHJhbnNjcmlwdBIXCgdjb25uX2lkGAEgASgJUgZjb25uSWQSGwoJc291cmNlX2lwGA'
+    'IgASgJUghzb3VyY2VJcBIfCgtzb3VyY2VfcG9ydBgDIAEoBVIKc291cmNlUG9ydBIXCgdydWxl'
+    'X2lkGAQgASgJUgZydWxlSWQSOQoKc3RhcnRfdGltZRgFIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi'
+    '5UaW1lc3RhbXBSCXN0YXJ0VGltZRIfCgtkdXJhdGlvbl9tcxgGIAEoA1IKZHVyYXRpb25NcxIf'
+    'CgtnZW9fY291bnRyeRgHIAEoCVIKZ2VvQ291bnRyeRIeCgp0cmFuc2NyaXB0GAggASgJUgp0cm'
+    'Fuc2NyaXB0');
+
+@$core.Deprecated('Use getMockTranscriptsResponseDescriptor instead')
+const GetMockTranscriptsResponse$json = {
+  '1': 'GetMockTranscriptsResponse',
+  '2': [
+    {
+      '1': 'transcripts',
+      '3': 1,
+      '4': 3,
+      '5': 11,
+      '6': '.nitella.proxy.MockTranscript',
+      '10': 'transcripts'
+    },
+  ],
+};
+
+/// Descriptor for `GetMockTranscriptsResponse`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List getMockTranscriptsResponseDescriptor =
+    $convert.base64Decode(
+        'ChpHZXRNb2NrVHJhbnNjcmlwdHNSZXNwb25zZRI/Cgt0cmFuc2NyaXB0cxgBIAMoCzIdLm5pdG'
+        'VsbGEucHJveHkuTW9ja1RyYW5zY3JpcHRSC3RyYW5zY3JpcHRz');
+
+@$core.Deprecated('Use cloneBannerRequestDescriptor instead')
+const CloneBannerRequest$json = {
+  '1': 'CloneBannerRequest',
+  '2': [
+    {'1': 'name', '3': 1, '4': 1, '5': 9, '10': 'name'},
+    {'1': 'protocol', '3': 2, '4': 1, '5': 9, '10': 'protocol'},
+    {'1': 'backend_addr', '3': 3, '4': 1, '5': 9, '10': 'backendAddr'},
+    {'1': 'timeout_ms', '3': 4, '4': 1, '5': 5, '10': 'timeoutMs'},
+  ],
+};
+
+/// Descriptor for `CloneBannerRequest`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List cloneBannerRequestDescriptor =
+    $convert.base64Decode(
+        'ChJDbG9uZUJhbm5lclJlcXVlc3QSEgoEbmFtZRgBIAEoCVIEbmFtZRIaCghwcm90b2NvbBgCIA'
+        'EoCVIIcHJvdG9jb2wSIQoMYmFja2VuZF9hZGRyGAMgASgJUgtiYWNrZW5kQWRkchIdCgp0aW1l'
+        'b3V0X21zGAQgASgFUgl0aW1lb3V0TXM=');
+
+@$core.Deprecated('Use clonedPresetDescriptor instead')
+const ClonedPreset$json = {
+  '1': 'ClonedPreset',
+  '2': [
+    {'1': 'name', '3': 1, '4': 1, '5': 9, '10': 'name'},
+    {'1': 'protocol', '3': 2, '4': 1, '5': 9, '10': 'protocol'},
+    {'1': 'backend_addr', '3': 3, '4': 1, '5': 9, '10': 'backendAddr'},
+    {'1': 'banner', '3': 4, '4': 1, '5': 9, '10': 'banner'},
+    {
+      '1': 'cloned_at',
+      '3': 5,
+      '4': 1,
+      '5': 11,
+      '6': '.google.protobuf.Timestamp',
+      '10': 'clonedAt'
+    },
+  ],
+};
+
+/// Descriptor for `ClonedPreset`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List clonedPresetDescriptor = $convert.base64Decode(
+    'CgxDbG9uZWRQcmVzZXQSEgoEbmFtZRgBIAEoCVIEbmFtZRIaCghwcm90b2NvbBgCIAEoCVIIcH'
+    'JvdG9jb2wSIQoMYmFja2VuZF9hZGRyGAMgASgJUgtiYWNrZW5kQWRkchIWCgZiYW5uZXIYBCAB'
+    'KAlSBmJhbm5lchI3CgljbG9uZWRfYXQYBSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW'
+    '1wUghjbG9uZWRBdA==');
+
+@$core.Deprecated('Use cloneBannerResponseDescriptor instead')
+const CloneBannerResponse$json = {
+  '1': 'CloneBannerResponse',
+  '2': [
+    {
+      '1': 'preset',
+      '3': 1,
+      '4': 1,
+      '5': 11,
+      '6': '.nitella.proxy.ClonedPreset',
+      '10': 'preset'
+    },
+  ],
+};
+
+/// Descriptor for `CloneBannerResponse`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List cloneBannerResponseDescriptor =
+    $convert.base64Decode(
+        'ChNDbG9uZUJhbm5lclJlc3BvbnNlEjMKBnByZXNldBgBIAEoCzIbLm5pdGVsbGEucHJveHkuQ2'
+        'xvbmVkUHJlc2V0UgZwcmVzZXQ=');
+
+@$core.Deprecated('Use listClonedPresetsRequestDescriptor instead')
+const ListClonedPresetsRequest$json = {
+  '1': 'ListClonedPresetsRequest',
+};
+
+/// Descriptor for `ListClonedPresetsRequest`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List listClonedPresetsRequestDescriptor =
+    $convert.base64Decode('ChhMaXN0Q2xvbmVkUHJlc2V0c1JlcXVlc3Q=');
+
+@$core.Deprecated('Use listClonedPresetsResponseDescriptor instead')
+const ListClonedPresetsResponse$json = {
+  '1': 'ListClonedPresetsResponse',
+  '2': [
+    {
+      '1': 'presets',
+      '3': 1,
+      '4': 3,
+      '5': 11,
+      '6': '.nitella.proxy.ClonedPreset',
+      '10': 'presets'
+    },
+  ],
+};
+
+/// Descriptor for `ListClonedPresetsResponse`. Decode as a `google.protobuf.DescriptorProto`.
+final $typed_data.Uint8List listClonedPresetsResponseDescriptor =
+    $convert.base64Decode(
+        'ChlMaXN0Q2xvbmVkUHJlc2V0c1Jlc3BvbnNlEjUKB3ByZXNldHMYASADKAsyGy5uaXRlbGxhLn'
+        'Byb3h5LkNsb25lZFByZXNldFIHcHJlc2V0cw==');
+
 @$core.Deprecated('Use sendCommandRequestDescriptor instead')
 const SendCommandRequest$json = {
   '1': 'SendCommandRequest',
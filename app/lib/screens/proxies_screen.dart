@@ -520,6 +520,51 @@ class _ProxiesScreenState extends ConsumerState<ProxiesScreen> {
       DropdownMenuItem(
           value: common.MockPreset.MOCK_PRESET_TELNET_SECURE,
           child: Text('Telnet - Reject')),
+      DropdownMenuItem(
+          value: common.MockPreset.MOCK_PRESET_POSTGRES_SECURE,
+          child: Text('PostgreSQL - Auth Failed')),
+      DropdownMenuItem(
+          value: common.MockPreset.MOCK_PRESET_POSTGRES_TARPIT,
+          child: Text('PostgreSQL - Tarpit')),
+      DropdownMenuItem(
+          value: common.MockPreset.MOCK_PRESET_MONGODB_SECURE,
+          child: Text('MongoDB - Auth Failed')),
+      DropdownMenuItem(
+          value: common.MockPreset.MOCK_PRESET_MONGODB_TARPIT,
+          child: Text('MongoDB - Tarpit')),
+      DropdownMenuItem(
+          value: common.MockPreset.MOCK_PRESET_ELASTICSEARCH_SECURE,
+          child: Text('Elasticsearch - Unauthorized')),
+      DropdownMenuItem(
+          value: common.MockPreset.MOCK_PRESET_ELASTICSEARCH_TARPIT,
+          child: Text('Elasticsearch - Tarpit')),
+      DropdownMenuItem(
+          value: common.MockPreset.MOCK_PRESET_MEMCACHED_SECURE,
+          child: Text('Memcached - Auth Failed')),
+      DropdownMenuItem(
+          value: common.MockPreset.MOCK_PRESET_MEMCACHED_TARPIT,
+          child: Text('Memcached - Tarpit')),
+      DropdownMenuItem(
+          value: common.MockPreset.MOCK_PRESET_FTP_SECURE,
+          child: Text('FTP - Login Incorrect')),
+      DropdownMenuItem(
+          value: common.MockPreset.MOCK_PRESET_FTP_TARPIT,
+          child: Text('FTP - Tarpit')),
+      DropdownMenuItem(
+          value: common.MockPreset.MOCK_PRESET_VNC_SECURE,
+          child: Text('VNC - Auth Failed')),
+      DropdownMenuItem(
+          value: common.MockPreset.MOCK_PRESET_VNC_TARPIT,
+          child: Text('VNC - Tarpit')),
+      DropdownMenuItem(
+          value: common.MockPreset.MOCK_PRESET_SMB_SECURE,
+          child: Text('SMB - Logon Failure')),
+      DropdownMenuItem(
+          value: common.MockPreset.MOCK_PRESET_LDAP_SECURE,
+          child: Text('LDAP - Invalid Credentials')),
+      DropdownMenuItem(
+          value: common.MockPreset.MOCK_PRESET_LDAP_TARPIT,
+          child: Text('LDAP - Tarpit')),
       DropdownMenuItem(
           value: common.MockPreset.MOCK_PRESET_RAW_TARPIT,
           child: Text('Raw - Tarpit (any protocol)')),
@@ -24,6 +24,7 @@ class _RequestsScreenState extends ConsumerState<RequestsScreen>
   bool _isLoading = false;
   List<local.ApprovalHistoryEntry> _history = [];
   int _historyTotalCount = 0;
+  List<local.RuleProposal> _proposals = [];
   List<int> _approveDurationOptions = const [];
   int _defaultApproveDurationSeconds = 0;
   List<local_enum.DenyBlockType> _denyBlockOptions = const [
@@ -103,6 +104,61 @@ class _RequestsScreenState extends ConsumerState<RequestsScreen>
         _denyBlockOptions = denyOptions;
       }
     });
+    await _loadRuleProposals();
+  }
+
+  Future<void> _loadRuleProposals() async {
+    try {
+      final client = ref.read(logicServiceProvider);
+      final resp =
+          await client.listRuleProposals(local.ListRuleProposalsRequest());
+      if (!mounted) return;
+      setState(() => _proposals = resp.proposals);
+    } catch (e) {
+      debugPrint("Failed to load rule proposals: $e");
+    }
+  }
+
+  Future<void> _acceptRuleProposal(local.RuleProposal proposal) async {
+    try {
+      final client = ref.read(logicServiceProvider);
+      final resp =
+          await client.acceptRuleProposal(local.AcceptRuleProposalRequest(
+        proposalId: proposal.proposalId,
+        nodeId: proposal.nodeId,
+      ));
+      if (!resp.success) {
+        if (mounted) {
+          ScaffoldMessenger.of(context).showSnackBar(
+            SnackBar(content: Text('Failed to create rule: ${resp.error}')),
+          );
+        }
+        return;
+      }
+      if (mounted) {
+        // The rule exists; a warning means the history was not updated
+        final warning = resp.error.trim();
+        final message = warning.isNotEmpty
+            ? warning
+            : 'Created ${resp.rulesCreated} rule(s): ${proposal.rule.name}';
+        ScaffoldMessenger.of(context).showSnackBar(
+          SnackBar(
+            content: Text(message),
+            backgroundColor:
+                warning.isNotEmpty ? Colors.orange.shade700 : null,
+          ),
+        );
+      }
+      await _loadApprovalsSnapshot();
+    } catch (e) {
+      debugPrint("Failed to accept rule proposal: $e");
+      if (mounted) {
+        ScaffoldMessenger.of(context).showSnackBar(
+          SnackBar(
+              content: Text('Failed to create rule: ${friendlyError(e)}')),
+        );
+      }
+    }
   }
 
   Future<void> _clearApprovalHistory() async {
@@ -288,9 +344,18 @@ class _RequestsScreenState extends ConsumerState<RequestsScreen>
                 )
               : ListView.builder(
                   padding: const EdgeInsets.all(16),
-                  itemCount: history.length,
+                  itemCount: _proposals.length + history.length,
                   itemBuilder: (context, index) {
-                    return _HistoryCard(item: history[index]);
+                    // Suggested rules first, then the decisions
+                    if (index < _proposals.length) {
+                      final proposal = _proposals[index];
+                      return _ProposalCard(
+                        proposal: proposal,
+                        onAccept: () => _acceptRuleProposal(proposal),
+                      );
+                    }
+                    return _HistoryCard(
+                        item: history[index - _proposals.length]);
                   },
                 ),
         ),
@@ -340,6 +405,96 @@ class _RequestsScreenState extends ConsumerState<RequestsScreen>
   }
 }
 
+/// A rule suggested from repeated decisions, with a preview of the past
+/// decisions it would have matched.
+class _ProposalCard extends StatelessWidget {
+  final local.RuleProposal proposal;
+  final VoidCallback onAccept;
+
+  const _ProposalCard({required this.proposal, required this.onAccept});
+
+  @override
+  Widget build(BuildContext context) {
+    final isAllow =
+        proposal.rule.action == common_enum.ActionType.ACTION_TYPE_ALLOW;
+    final color = isAllow ? Colors.green : Colors.red;
+
+    return Card(
+      margin: const EdgeInsets.only(bottom: 8),
+      child: Padding(
+        padding: const EdgeInsets.all(12),
+        child: Row(
+          crossAxisAlignment: CrossAxisAlignment.start,
+          children: [
+            const Icon(Icons.lightbulb_outline, color: Colors.amber, size: 20),
+            const SizedBox(width: 12),
+            Expanded(
+              child: Column(
+                crossAxisAlignment: CrossAxisAlignment.start,
+                children: [
+                  Text(
+                    'Suggested rule',
+                    style: TextStyle(
+                      fontWeight: FontWeight.w600,
+                      color: color,
+                    ),
+                  ),
+                  const SizedBox(height: 4),
+                  Text(proposal.summary),
+                  Text(
+                    proposal.rule.name,
+                    style: TextStyle(
+                      fontSize: 13,
+                      color: Colors.grey.shade600,
+                    ),
+                  ),
+                  Row(
+                    mainAxisAlignment: MainAxisAlignment.end,
+                    children: [
+                      TextButton(
+                        onPressed: () => _showMatched(context),
+                        child: Text('Preview (${proposal.matched.length})'),
+                      ),
+                      TextButton(
+                        onPressed: onAccept,
+                        child: const Text('Create Rule'),
+                      ),
+                    ],
+                  ),
+                ],
+              ),
+            ),
+          ],
+        ),
+      ),
+    );
+  }
+
+  void _showMatched(BuildContext context) {
+    showDialog(
+      context: context,
+      builder: (context) => AlertDialog(
+        title: Text(proposal.rule.name),
+        content: SizedBox(
+          width: double.maxFinite,
+          child: ListView(
+            shrinkWrap: true,
+            children: [
+              for (final item in proposal.matched) _HistoryCard(item: item),
+            ],
+          ),
+        ),
+        actions: [
+          TextButton(
+            onPressed: () => Navigator.pop(context),
+            child: const Text('Close'),
+          ),
+        ],
+      ),
+    );
+  }
+}
+
 class _HistoryCard extends StatelessWidget {
   final local.ApprovalHistoryEntry item;
 
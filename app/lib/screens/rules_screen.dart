@@ -1,5 +1,8 @@
+import 'dart:async';
+
 import 'package:flutter/material.dart';
 import 'package:flutter_riverpod/flutter_riverpod.dart';
+import 'package:protobuf/well_known_types/google/protobuf/timestamp.pb.dart';
 import 'package:nitella_app/common/common.pb.dart' as common;
 import 'package:nitella_app/proxy/proxy.pb.dart' as proxy;
 import 'package:nitella_app/local/nitella_local.pb.dart' as local;
@@ -7,6 +10,7 @@ import '../main.dart';
 import '../utils/error_helper.dart';
 import '../utils/biometric_guard.dart';
 import '../utils/rule_composer_options.dart';
+import '../utils/time_ago.dart';
 
 class RulesScreen extends ConsumerStatefulWidget {
   final String nodeId;
@@ -29,11 +33,34 @@ class _RulesScreenState extends ConsumerState<RulesScreen> {
   local.RuleComposerPolicy? _composerPolicy;
   bool _isLoading = true;
   String? _error;
+  Timer? _countdownTimer;
+
+  /// Lifetimes offered when adding a rule (null = no expiry).
+  static const _activeForOptions = <Duration?>[
+    null,
+    Duration(hours: 1),
+    Duration(hours: 2),
+    Duration(hours: 8),
+    Duration(days: 1),
+    Duration(days: 7),
+  ];
 
   @override
   void initState() {
     super.initState();
     _refreshRules();
+    // Keep expiry countdowns current
+    _countdownTimer = Timer.periodic(const Duration(seconds: 30), (_) {
+      if (mounted && _rules.any((r) => r.hasExpiresAt() || r.hasNotBefore())) {
+        setState(() {});
+      }
+    });
+  }
+
+  @override
+  void dispose() {
+    _countdownTimer?.cancel();
+    super.dispose();
   }
 
   Future<void> _refreshRules() async {
@@ -109,6 +136,8 @@ class _RulesScreenState extends ConsumerState<RulesScreen> {
     int priority = _defaultRulePriority();
     List<proxy.Condition> conditions = [];
     bool showAdvanced = false;
+    Duration? activeFor;
+    final scheduleController = TextEditingController();
 
     await showDialog(
       context: context,
@@ -126,6 +155,20 @@ class _RulesScreenState extends ConsumerState<RulesScreen> {
                   allowedActions: _actionOptions(include: action),
                   onActionChanged: (v) => setDialogState(() => action = v),
                 ),
+                const SizedBox(height: 12),
+                DropdownButtonFormField<Duration?>(
+                  initialValue: activeFor,
+                  decoration: const InputDecoration(labelText: 'Active for'),
+                  items: _activeForOptions
+                      .map((d) => DropdownMenuItem(
+                            value: d,
+                            child: Text(d == null
+                                ? 'Until removed'
+                                : formatTimeLeft(d)),
+                          ))
+                      .toList(),
+                  onChanged: (v) => setDialogState(() => activeFor = v),
+                ),
                 const SizedBox(height: 16),
 
                 // Condition section header
@@ -280,6 +323,14 @@ class _RulesScreenState extends ConsumerState<RulesScreen> {
                       hintText: 'e.g., 192.168.1.100:8080',
                     ),
                   ),
+                  const SizedBox(height: 12),
+                  TextField(
+                    controller: scheduleController,
+                    decoration: const InputDecoration(
+                      labelText: 'Schedule (optional)',
+                      hintText: 'e.g., Mon-Fri 09:00-17:00',
+                    ),
+                  ),
                 ],
               ],
             ),
@@ -311,6 +362,11 @@ class _RulesScreenState extends ConsumerState<RulesScreen> {
                     targetBackend: backendController.text,
                     conditions: allConditions,
                     enabled: true,
+                    expiresAt: activeFor == null
+                        ? null
+                        : Timestamp.fromDateTime(
+                            DateTime.now().add(activeFor!)),
+                    schedule: scheduleController.text.trim(),
                   );
 
                   final client = ref.read(logicServiceProvider);
@@ -419,6 +475,11 @@ class _RulesScreenState extends ConsumerState<RulesScreen> {
                     targetBackend: backendController.text,
                     expression: expressionController.text,
                     enabled: true,
+                    notBefore:
+                        existing.hasNotBefore() ? existing.notBefore : null,
+                    expiresAt:
+                        existing.hasExpiresAt() ? existing.expiresAt : null,
+                    schedule: existing.schedule,
                   )..id = existing.id;
 
                   final client = ref.read(logicServiceProvider);
@@ -824,6 +885,8 @@ class _RulesScreenState extends ConsumerState<RulesScreen> {
         return 'Mock';
       case common.ActionType.ACTION_TYPE_REQUIRE_APPROVAL:
         return 'Require Approval';
+      case common.ActionType.ACTION_TYPE_ALLOW_ALERT:
+        return 'Allow and Alert';
       default:
         return _humanizeEnumName(action.name, 'ACTION_TYPE_');
     }
@@ -851,6 +914,8 @@ class _RulesScreenState extends ConsumerState<RulesScreen> {
         return 'MOCK';
       case common.ActionType.ACTION_TYPE_REQUIRE_APPROVAL:
         return '2FA';
+      case common.ActionType.ACTION_TYPE_ALLOW_ALERT:
+        return 'ALERT';
       default:
         return '?';
     }
@@ -866,11 +931,32 @@ class _RulesScreenState extends ConsumerState<RulesScreen> {
         return Colors.orange;
       case common.ActionType.ACTION_TYPE_REQUIRE_APPROVAL:
         return Colors.blue;
+      case common.ActionType.ACTION_TYPE_ALLOW_ALERT:
+        return Colors.teal;
       default:
         return Colors.grey;
     }
   }
 
+  /// Describes when a rule is active, with a countdown to its start or
+  /// expiry. Empty for rules that are always active.
+  String _describeLifetime(proxy.Rule rule) {
+    final now = DateTime.now();
+    final parts = <String>[];
+    if (rule.hasExpiresAt() && !now.isBefore(rule.expiresAt.toDateTime())) {
+      parts.add('Expired');
+    } else if (rule.hasNotBefore() &&
+        now.isBefore(rule.notBefore.toDateTime())) {
+      parts.add('Starts in '
+          '${formatTimeLeft(rule.notBefore.toDateTime().difference(now))}');
+    } else if (rule.hasExpiresAt()) {
+      parts.add('Expires in '
+          '${formatTimeLeft(rule.expiresAt.toDateTime().difference(now))}');
+    }
+    if (rule.schedule.isNotEmpty) parts.add(rule.schedule);
+    return parts.join(', ');
+  }
+
   String _describeRule(proxy.Rule rule) {
     // Show conditions if available, otherwise show expression
     if (rule.conditions.isNotEmpty) {
@@ -924,6 +1010,7 @@ class _RulesScreenState extends ConsumerState<RulesScreen> {
                           itemBuilder: (context, index) {
                             final rule = _rules[index];
                             final desc = _describeRule(rule);
+                            final lifetime = _describeLifetime(rule);
                             return Card(
                               margin: const EdgeInsets.symmetric(
                                   horizontal: 12, vertical: 6),
@@ -940,6 +1027,23 @@ class _RulesScreenState extends ConsumerState<RulesScreen> {
                                   crossAxisAlignment: CrossAxisAlignment.start,
                                   children: [
                                     Text('Priority: ${rule.priority}'),
+                                    if (lifetime.isNotEmpty)
+                                      Row(
+                                        children: [
+                                          const Icon(Icons.timer_outlined,
+                                              size: 14, color: Colors.orange),
+                                          const SizedBox(width: 4),
+                                          Flexible(
+                                            child: Text(
+                                              lifetime,
+                                              style: const TextStyle(
+                                                  fontSize: 12,
+                                                  color: Colors.orange),
+                                              overflow: TextOverflow.ellipsis,
+                                            ),
+                                          ),
+                                        ],
+                                      ),
                                     if (desc.isNotEmpty)
                                       Text(
                                         desc,
@@ -246,6 +246,14 @@ class _SettingsScreenState extends ConsumerState<SettingsScreen> {
                   onTap: () => _exportCACertificate(context),
                 ),
                 const Divider(height: 1),
+                ListTile(
+                  leading: const Icon(Icons.how_to_vote),
+                  title: const Text('Copy Approver Key'),
+                  subtitle: const Text('Register on nodes for quorum approvals'),
+                  trailing: const Icon(Icons.copy),
+                  onTap: () => _copyApproverKey(context),
+                ),
+                const Divider(height: 1),
                 ListTile(
                   leading: const Icon(Icons.verified_user),
                   title: const Text('Signed Certificates'),
@@ -721,6 +729,33 @@ class _SettingsScreenState extends ConsumerState<SettingsScreen> {
     }
   }
 
+  Future<void> _copyApproverKey(BuildContext context) async {
+    final scaffoldMessenger = ScaffoldMessenger.of(context);
+    try {
+      final client = ref.read(logicServiceProvider);
+      final identity = await client.getIdentity(Empty());
+      if (identity.approverPublicKeyPem.isEmpty) {
+        scaffoldMessenger.showSnackBar(
+          const SnackBar(content: Text('No approver key (identity locked?)')),
+        );
+        return;
+      }
+      await Clipboard.setData(
+          ClipboardData(text: identity.approverPublicKeyPem));
+      scaffoldMessenger.showSnackBar(
+        const SnackBar(
+            content: Text(
+                'Approver key copied. Add it to the node\'s --approver-keys file.')),
+      );
+    } catch (e) {
+      if (mounted) {
+        scaffoldMessenger.showSnackBar(
+          SnackBar(content: Text('Error: ${friendlyError(e)}')),
+        );
+      }
+    }
+  }
+
   void _showResetDialog(BuildContext context) {
     showDialog(
       context: context,
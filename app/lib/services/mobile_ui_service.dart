@@ -91,10 +91,29 @@ class MobileUIServiceImpl extends local.MobileUIServiceBase {
   }
 
   void _onApprovalRequestSync(local.ApprovalRequest request) {
+    if (request.resolved) {
+      // Settled by another device or the node: dismiss, don't re-announce
+      logger.d(
+          "MobileUIService: Approval resolved: ${request.requestId} (${request.resolution})");
+      _requestRemovalController.add(request.requestId);
+      return;
+    }
     logger.d("MobileUIService: Approval Request: ${request.requestId}");
     _approvalRequestController.add(request);
   }
 
+  /// Handles the data payload of a push notification. An approval_resolved
+  /// push carries the ID of a request that no longer needs a decision.
+  void handlePushData(Map<String, dynamic> data) {
+    if (data['type'] == 'approval_resolved') {
+      final requestId = data['req_id'];
+      if (requestId is String && requestId.isNotEmpty) {
+        logger.d("MobileUIService: Approval resolved push: $requestId");
+        _requestRemovalController.add(requestId);
+      }
+    }
+  }
+
   void _onNodeStatusChangeSync(local.NodeStatusChange request) {
     logger.d("MobileUIService: Node status change: ${request.nodeId} online=${request.online}");
     _nodeStatusController.add(request);
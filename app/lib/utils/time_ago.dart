@@ -12,3 +12,19 @@ String formatTimeAgo(DateTime timestamp, {DateTime? now}) {
   }
   return '${diff.inDays}d ago';
 }
+
+/// Formats a remaining duration as "2d 3h", "1h 23m" or "45m".
+String formatTimeLeft(Duration d) {
+  if (d.isNegative || d.inMinutes < 1) {
+    return '<1m';
+  }
+  if (d.inHours < 1) {
+    return '${d.inMinutes}m';
+  }
+  if (d.inDays < 1) {
+    final m = d.inMinutes % 60;
+    return m == 0 ? '${d.inHours}h' : '${d.inHours}h ${m}m';
+  }
+  final h = d.inHours % 24;
+  return h == 0 ? '${d.inDays}d' : '${d.inDays}d ${h}h';
+}
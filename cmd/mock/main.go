@@ -6,6 +6,7 @@ import (
 	"net"
 	"os"
 	"os/signal"
+	"strings"
 	"sync/atomic"
 	"syscall"
 
@@ -15,14 +16,38 @@ import (
 
 func main() {
 	port := flag.Int("port", 8080, "Port to listen on")
-	protocol := flag.String("protocol", "http", "Protocol to emulate (http, ssh, mysql, mssql, rdp, telnet, redis, smtp)")
+	protocol := flag.String("protocol", "http", "Protocol to emulate (http, ssh, mysql, mssql, rdp, telnet, redis, smtp, postgres, mongodb, elasticsearch, memcached, ftp, vnc, smb, ldap, script)")
 	delay := flag.Int("delay", 0, "Delay in milliseconds before sending response")
 	payloadStr := flag.String("payload", "", "Custom payload to send (overrides default)")
 	tarpit := flag.Bool("tarpit", false, "Enable tarpit mode - waste attacker time with slow/endless responses")
 	dripInterval := flag.Int("drip", 0, "Drip interval in ms (bytes sent one at a time with this delay)")
 	maxConns := flag.Int("max-conns", 128, "Maximum concurrent connections (prevents resource exhaustion)")
+	interactive := flag.Bool("interactive", false, "SSH: complete the handshake and capture credentials")
+	acceptLogin := flag.Bool("accept-login", false, "SSH/Telnet: accept any password into a fake shell (SSH: implies -interactive)")
+	routesFile := flag.String("routes", "", "HTTP: YAML route table file")
+	packs := flag.String("pack", "", "HTTP: comma-separated route packs ("+strings.Join(mockproto.HTTPRoutePackNames(), ", ")+")")
+	canary := flag.String("canary", "", "HTTP: comma-separated canary paths to flag in captures")
+	smbDialect := flag.String("smb-dialect", "", "SMB: dialect to negotiate (2.0.2, 2.1, 3.0, 3.0.2, 3.1.1; default 3.1.1)")
+	smbOS := flag.String("smb-os", "", "SMB: Windows version revealed as major.minor.build (default 10.0.17763)")
+	smbHostname := flag.String("smb-hostname", "", "SMB: NetBIOS computer name (default FILESRV01)")
+	scriptFile := flag.String("script", "", "YAML expect/send script to run (implies -protocol script)")
+	useTLS := flag.Bool("tls", false, "Wrap the mock in TLS with a generated self-signed certificate (HTTPS, SMTPS, ...)")
+	tlsCN := flag.String("tls-cn", "", "TLS: certificate common name (default: client SNI, else localhost)")
+	tlsOrg := flag.String("tls-org", "", "TLS: certificate organization")
+	tlsSANs := flag.String("tls-san", "", "TLS: comma-separated certificate DNS names and IPs (default: common name)")
 	flag.Parse()
 
+	var script *mockproto.Script
+	if *scriptFile != "" {
+		s, err := mockproto.LoadScript(*scriptFile)
+		if err != nil {
+			log.Fatalf("Failed to load script: %v", err)
+		}
+		script = s
+		*protocol = "script"
+		log.Printf("Script: %s (%d steps)", *scriptFile, len(s.Steps))
+	}
+
 	addr := fmt.Sprintf("0.0.0.0:%d", *port)
 	listener, err := net.Listen("tcp", addr)
 	if err != nil {
@@ -38,6 +63,32 @@ func main() {
 
 	payload := []byte(*payloadStr)
 
+	var routes []mockproto.HTTPRoute
+	if *routesFile != "" {
+		r, err := mockproto.LoadHTTPRoutes(*routesFile)
+		if err != nil {
+			log.Fatalf("Failed to load routes: %v", err)
+		}
+		routes = append(routes, r...)
+	}
+	for _, name := range splitList(*packs) {
+		pack := mockproto.HTTPRoutePack(name)
+		if pack == nil {
+			log.Fatalf("Unknown route pack: %s", name)
+		}
+		routes = append(routes, pack...)
+	}
+	if len(routes) > 0 {
+		log.Printf("HTTP routes: %d", len(routes))
+	}
+	canaryPaths := splitList(*canary)
+
+	var tlsConfig *mockproto.TLSConfig
+	if *useTLS {
+		tlsConfig = &mockproto.TLSConfig{CommonName: *tlsCN, Organization: *tlsOrg, SANs: splitList(*tlsSANs)}
+		log.Printf("TLS enabled (self-signed certificate)")
+	}
+
 	// Connection limiter to prevent resource exhaustion
 	connSem := make(chan struct{}, *maxConns)
 	var activeConns atomic.Int64
@@ -87,6 +138,18 @@ func main() {
 				Tarpit:         *tarpit,
 				DripIntervalMs: *dripInterval,
 				DripBanner:     *dripInterval > 0,
+				CompleteKex:    *interactive || *acceptLogin,
+				AcceptLogin:    *acceptLogin,
+				HTTPRoutes:     routes,
+				CanaryPaths:    canaryPaths,
+				SMBDialect:     *smbDialect,
+				SMBOSVersion:   *smbOS,
+				SMBHostname:    *smbHostname,
+				Script:         script,
+				TLS:            tlsConfig,
+				OnCapture: func(cp mockproto.Capture) {
+					log.Printf("Capture from %s: %s %s user=%q password=%q %v", c.RemoteAddr(), cp.Protocol, cp.Kind, cp.Username, cp.Password, cp.Fields)
+				},
 			}
 			if err := mockproto.HandleConnection(c, config); err != nil {
 				log.Printf("Error handling connection: %v", err)
@@ -95,3 +158,14 @@ func main() {
 		}(conn)
 	}
 }
+
+// splitList splits a comma-separated flag value, dropping empty items.
+func splitList(s string) []string {
+	var items []string
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
@@ -352,6 +352,8 @@ func (h *HubCLI) handleHubCommand(args []string) {
 		h.cmdHubApprove(args[1:])
 	case "deny":
 		h.cmdHubDeny(args[1:])
+	case "proposals":
+		h.cmdHubProposals(args[1:])
 	case "proxy":
 		cmdHubProxy(args[1:])
 	case "send":
@@ -395,6 +397,8 @@ Hub Mode Commands:
   pending                        - List pending approval requests
   approve [once|cache] <id> [duration] - Approve a connection (cache default: 300s)
   deny [once|cache] <id> [duration] [reason] - Deny a connection (default: once)
+  proposals [node_id]            - Rules proposed from repeated approval decisions
+  proposals accept <id>          - Create a proposed rule
 
   Proxy Management (E2E encrypted):
   proxy                          - Show proxy help
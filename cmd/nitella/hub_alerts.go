@@ -56,6 +56,11 @@ func (h *HubCLI) startBackgroundAlertStream() {
 
 // handleApprovalCallback processes an approval request received from the backend stream.
 func (h *HubCLI) handleApprovalCallback(req *pb.ApprovalRequest) {
+	if req.Resolved {
+		displayResolved(req)
+		return
+	}
+
 	// Build display info
 	info := AlertInfo{
 		ID:       req.RequestId,
@@ -72,6 +77,9 @@ func (h *HubCLI) handleApprovalCallback(req *pb.ApprovalRequest) {
 	info.SourceIP = req.SourceIp
 	info.DestAddr = req.DestAddr
 	info.ProxyID = req.ProxyId
+	info.Approvals = req.Approvals
+	info.Quorum = req.Quorum
+	info.Context = req.Context
 
 	if req.Geo != nil {
 		info.GeoCountry = req.Geo.Country
@@ -89,6 +97,20 @@ func (h *HubCLI) stopBackgroundAlertStream() {
 	}
 }
 
+// displayResolved tells the user an approval request no longer needs a
+// decision: it was decided, possibly on another device, or expired.
+func displayResolved(req *pb.ApprovalRequest) {
+	resolution := req.Resolution
+	if resolution == "" {
+		resolution = "resolved"
+	}
+	shell.NotifyActive(fmt.Sprintf("\033[2m[%s] Approval %s from %s closed: %s\033[0m",
+		time.Now().Format("15:04:05"),
+		sanitizeForTerminal(shortApprovalRequestID(req.RequestId)),
+		sanitizeForTerminal(req.SourceIp),
+		sanitizeForTerminal(resolution)))
+}
+
 // AlertInfo contains unified alert display information.
 type AlertInfo struct {
 	ID         string
@@ -102,6 +124,9 @@ type AlertInfo struct {
 	GeoCountry string
 	GeoCity    string
 	GeoISP     string
+	Approvals  int32 // Allow votes so far (quorum rules)
+	Quorum     int32
+	Context    *commonpb.ApprovalContext
 }
 
 // displayAlert shows an alert notification with unified formatting.
@@ -138,12 +163,44 @@ func displayAlert(info AlertInfo) {
 	if info.GeoISP != "" {
 		sb.WriteString(fmt.Sprintf("  ISP:    %s\n", sanitizeForTerminal(info.GeoISP)))
 	}
+	for _, line := range approvalContextLines(info.Context) {
+		sb.WriteString(sanitizeForTerminal(line) + "\n")
+	}
+	if info.Quorum > 1 {
+		sb.WriteString(fmt.Sprintf("  Votes:  %d/%d approvals\n", info.Approvals, info.Quorum))
+	}
 
 	sb.WriteString(fmt.Sprintf("  \033[1;32m→ approve [once|cache] %s [duration]\033[0m  or  \033[1;31m→ deny [once|cache] %s [duration]\033[0m", shortID, shortID))
 
 	shell.NotifyActive(sb.String())
 }
 
+// approvalContextLines describes what the node knows about an approval
+// request's source.
+func approvalContextLines(c *commonpb.ApprovalContext) []string {
+	if c == nil {
+		return nil
+	}
+	var lines []string
+	if c.ReverseDns != "" {
+		lines = append(lines, "  rDNS:   "+c.ReverseDns)
+	}
+	if c.ConnectionCount > 0 {
+		lines = append(lines, fmt.Sprintf("  Seen:   %d connections (%d blocked) since %s",
+			c.ConnectionCount, c.BlockedCount, time.Unix(c.FirstSeenUnix, 0).Format("2006-01-02")))
+	}
+	if c.PriorApprovals > 0 || c.PriorDenials > 0 {
+		lines = append(lines, fmt.Sprintf("  Prior:  %d approved, %d denied", c.PriorApprovals, c.PriorDenials))
+	}
+	if len(c.IpSets) > 0 {
+		lines = append(lines, "  Sets:   "+strings.Join(c.IpSets, ", "))
+	}
+	if c.TlsSubject != "" {
+		lines = append(lines, "  Cert:   "+c.TlsSubject)
+	}
+	return lines
+}
+
 func (h *HubCLI) cmdHubPending(args []string) {
 	if h.ensureHubConnected() == nil {
 		return
@@ -184,6 +241,12 @@ func (h *HubCLI) cmdHubPending(args []string) {
 		if req.Geo != nil && req.Geo.Country != "" {
 			fmt.Printf("  Geo:    %s\n", req.Geo.Country)
 		}
+		for _, line := range approvalContextLines(req.Context) {
+			fmt.Println(sanitizeForTerminal(line))
+		}
+		if req.Quorum > 1 {
+			fmt.Printf("  Votes:  %d/%d approvals\n", req.Approvals, req.Quorum)
+		}
 		fmt.Println()
 	}
 	fmt.Println("Use 'approve [once|cache] <id> [duration]' or 'deny [once|cache] <id> [duration]' to respond.")
@@ -442,3 +505,73 @@ func (h *HubCLI) cmdHubDeny(args []string) {
 	}
 	h.executeApprovalDecision(args[requestArgIdx], pb.ApprovalDecision_APPROVAL_DECISION_DENY, mode, duration, reason)
 }
+
+func (h *HubCLI) cmdHubProposals(args []string) {
+	if h.ensureHubConnected() == nil {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	if len(args) > 0 && args[0] == "accept" {
+		if !cli.RequireArgs(args[1:], 1, "Usage: proposals accept <id>") {
+			return
+		}
+		resp, err := client.AcceptRuleProposal(ctx, &pb.AcceptRuleProposalRequest{ProposalId: args[1]})
+		if err != nil {
+			fmt.Printf("Error: %v\n", err)
+			return
+		}
+		if !resp.Success {
+			fmt.Printf("Error: %s\n", resp.Error)
+			return
+		}
+		fmt.Printf("Created %d rule(s) from proposal %s (rule: %s)\n", resp.RulesCreated, args[1], resp.RuleId)
+		if resp.Error != "" {
+			fmt.Printf("Warning: %s\n", resp.Error)
+		}
+		return
+	}
+
+	req := &pb.ListRuleProposalsRequest{}
+	if len(args) > 0 {
+		req.NodeId = args[0]
+	}
+	resp, err := client.ListRuleProposals(ctx, req)
+	if err != nil {
+		fmt.Printf("Error listing rule proposals: %v\n", err)
+		return
+	}
+	if len(resp.Proposals) == 0 {
+		fmt.Println("No rule proposals. Rules are proposed once the same decision has been made repeatedly.")
+		return
+	}
+
+	fmt.Printf("\nRule Proposals (%d):\n", len(resp.Proposals))
+	fmt.Println(strings.Repeat("-", 80))
+	for _, p := range resp.Proposals {
+		fmt.Printf("ID: %s\n", p.ProposalId)
+		fmt.Printf("  %s\n", p.Summary)
+		scope := p.ProxyId
+		if scope == "" {
+			scope = "all proxies"
+		}
+		fmt.Printf("  Node:   %s (%s)\n", p.NodeId, scope)
+		fmt.Printf("  Rule:   %s\n", p.GetRule().GetName())
+		fmt.Println("  Would have matched:")
+		for i, e := range p.Matched {
+			if i == 5 {
+				fmt.Printf("    ... and %d more\n", len(p.Matched)-i)
+				break
+			}
+			ts := "unknown"
+			if e.DecidedAt != nil {
+				ts = e.DecidedAt.AsTime().Local().Format("2006-01-02 15:04")
+			}
+			fmt.Printf("    %s  %s -> %s\n", ts, e.SourceIp, e.DestAddr)
+		}
+		fmt.Println()
+	}
+	fmt.Println("Use 'proposals accept <id>' to create a rule.")
+}
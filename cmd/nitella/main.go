@@ -3,8 +3,10 @@ package main
 import (
 	"bufio"
 	"context"
+	"crypto/ed25519"
 	"flag"
 	"fmt"
+	"net"
 	"os"
 	"os/signal"
 	"path/filepath"
@@ -79,6 +81,9 @@ func main() {
 	flag.StringVar(&stunServer, "stun", "", "STUN server URL for P2P")
 	flag.BoolVar(&overrideBackendConfig, "override-backend-config", false, "Allow --hub/--stun/NITELLA_HUB/NITELLA_STUN to override backend-stored settings for this run")
 
+	// Quorum approvals
+	approverKeyPath := flag.String("approver-key", "", "Path to this device's approver key (created if missing); approval decisions are signed with it for quorum rules")
+
 	// Profiling (only effective with -tags pprof)
 	pprofPort := flag.Int("pprof-port", 0, "Port for pprof HTTP server (0 = disabled, requires -tags pprof build)")
 
@@ -110,6 +115,15 @@ func main() {
 		os.Exit(1)
 	}
 
+	if *approverKeyPath != "" {
+		key, err := loadApproverKey(*approverKeyPath)
+		if err != nil {
+			fmt.Printf("Failed to load approver key: %v\n", err)
+			os.Exit(1)
+		}
+		svc.SetApproverKey(key)
+	}
+
 	// Initialize Hub CLI state
 	hubCLI = NewHubCLI(hubAddress, hubToken, stunServer, overrideBackendConfig)
 
@@ -357,6 +371,32 @@ func printCreatedIdentity(mnemonic string, info *pbLocal.IdentityInfo, encrypted
 	fmt.Println()
 }
 
+// loadApproverKey loads the approver key at path, creating it on first use
+// along with path.pub, the public key nodes register with --approver-keys.
+func loadApproverKey(path string) (ed25519.PrivateKey, error) {
+	keyPEM, err := os.ReadFile(path)
+	if err == nil {
+		return nitellacrypto.DecodePrivateKeyFromPEM(keyPEM)
+	}
+	if !os.IsNotExist(err) {
+		return nil, err
+	}
+
+	key, err := nitellacrypto.GenerateKey()
+	if err != nil {
+		return nil, err
+	}
+	if err := os.WriteFile(path, nitellacrypto.EncodePrivateKeyToPEM(key), 0600); err != nil {
+		return nil, err
+	}
+	pubPath := path + ".pub"
+	if err := os.WriteFile(pubPath, nitellacrypto.EncodePublicKeyToPEM(key.Public().(ed25519.PublicKey)), 0644); err != nil {
+		return nil, err
+	}
+	fmt.Printf("Created approver key %s. Add %s to the nodes' --approver-keys file.\n", path, pubPath)
+	return key, nil
+}
+
 // showPassphraseStrength displays passphrase strength analysis
 func showPassphraseStrength(pass string, interactive bool) bool {
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
@@ -579,12 +619,13 @@ func newHubCompletion() *shell.SimpleCompletion {
 	return &shell.SimpleCompletion{
 		RootCommands: []string{
 			"config", "login", "register", "status", "nodes", "node",
-			"alerts", "pending", "approve", "deny", "templates", "proxy",
+			"alerts", "pending", "approve", "deny", "proposals", "templates", "proxy",
 			"identity", "pair", "debug", "help", "exit",
 		},
 		SubCommands: map[string][]string{
 			"config":    {"set"},
 			"node":      {"status", "rules", "metrics"},
+			"proposals": {"accept"},
 			"templates": {"sync", "push"},
 			"proxy":     {"import", "list", "show", "edit", "export", "delete", "validate", "push", "pull", "history", "diff", "flush", "apply", "status", "unapply"},
 			"identity":  {"export-ca"},
@@ -624,6 +665,8 @@ Alerts & Approvals (auto-streaming in background):
   pending                        - List pending approval requests
   approve [once|cache] <id> [duration] - Approve request
   deny [once|cache] <id> [duration] [reason] - Deny a pending request
+  proposals [node_id]            - Rules proposed from repeated approval decisions
+  proposals accept <id>          - Create a proposed rule
 
 Templates:
   templates                      - List available templates
@@ -649,7 +692,7 @@ func newCompletion() *shell.SimpleCompletion {
 		RootCommands: []string{
 			"status", "list", "ls", "proxy", "rule", "conn", "connections",
 			"block", "allow", "global-rules", "approvals", "stream", "metrics", "debug", "restart",
-			"geoip", "lookup", "help", "exit",
+			"geoip", "lookup", "transcripts", "help", "exit",
 		},
 		SubCommands: map[string][]string{
 			"proxy":        {"create", "delete", "enable", "disable", "update"},
@@ -710,6 +753,8 @@ func handleCommand(input string) {
 		cmdGeoIP(args)
 	case "lookup":
 		cmdLookupIP(args)
+	case "transcripts":
+		cmdTranscripts(args)
 	default:
 		fmt.Printf("Unknown command: %s. Type 'help' for available commands.\n", cmd)
 	}
@@ -751,6 +796,8 @@ Nitella CLI (Local Mode) Commands:
   geoip config remote <provider>         - Configure remote API provider
   lookup <ip>                  - Lookup GeoIP information for an IP
 
+  transcripts [ip|conn_id]     - Show honeypot fake shell transcripts
+
   stream                       - Stream connection events
   metrics [interval]           - Stream metrics (default: 1 second interval)
   debug [runtime|grpc|goroutine] - Show local backend debug stats
@@ -990,11 +1037,12 @@ func cmdRule(args []string) {
 			fmt.Println("No rules configured.")
 			return
 		}
-		fmt.Printf("\n%-36s  %-20s  %-8s  %-8s  %-8s\n", "ID", "Name", "Priority", "Action", "Enabled")
-		fmt.Println(strings.Repeat("-", 90))
+		now := time.Now()
+		fmt.Printf("\n%-36s  %-20s  %-8s  %-8s  %-8s  %s\n", "ID", "Name", "Priority", "Action", "Enabled", "Active")
+		fmt.Println(strings.Repeat("-", 110))
 		for _, r := range resp.Rules {
-			fmt.Printf("%-36s  %-20s  %-8d  %-8s  %-8v\n",
-				r.Id, truncate(r.Name, 20), r.Priority, r.Action.String(), r.Enabled)
+			fmt.Printf("%-36s  %-20s  %-8d  %-8s  %-8v  %s\n",
+				r.Id, truncate(r.Name, 20), r.Priority, r.Action.String(), r.Enabled, ruleLifetimeLabel(r, now))
 		}
 		fmt.Println()
 
@@ -1679,6 +1727,45 @@ func formatBytes(b int64) string {
 	return fmt.Sprintf("%.1f %cB", float64(b)/float64(div), "KMGTPE"[exp])
 }
 
+// ruleLifetimeLabel describes when a rule is active, with a countdown to
+// its start or expiry.
+func ruleLifetimeLabel(r *pb.Rule, now time.Time) string {
+	var parts []string
+	switch {
+	case r.ExpiresAt != nil && !now.Before(r.ExpiresAt.AsTime()):
+		parts = append(parts, "expired")
+	case r.NotBefore != nil && now.Before(r.NotBefore.AsTime()):
+		parts = append(parts, "starts in "+formatCountdown(r.NotBefore.AsTime().Sub(now)))
+		if r.ExpiresAt != nil {
+			parts = append(parts, "for "+formatCountdown(r.ExpiresAt.AsTime().Sub(r.NotBefore.AsTime())))
+		}
+	case r.ExpiresAt != nil:
+		parts = append(parts, "expires in "+formatCountdown(r.ExpiresAt.AsTime().Sub(now)))
+	}
+	if r.Schedule != "" {
+		parts = append(parts, r.Schedule)
+	}
+	if len(parts) == 0 {
+		return "always"
+	}
+	return strings.Join(parts, ", ")
+}
+
+// formatCountdown formats d as "2d3h", "1h23m" or "45s".
+func formatCountdown(d time.Duration) string {
+	d = d.Round(time.Second)
+	switch {
+	case d >= 24*time.Hour:
+		return fmt.Sprintf("%dd%dh", d/(24*time.Hour), d%(24*time.Hour)/time.Hour)
+	case d >= time.Hour:
+		return fmt.Sprintf("%dh%dm", d/time.Hour, d%time.Hour/time.Minute)
+	case d >= time.Minute:
+		return fmt.Sprintf("%dm%ds", d/time.Minute, d%time.Minute/time.Second)
+	default:
+		return fmt.Sprintf("%ds", d/time.Second)
+	}
+}
+
 func truncate(s string, maxLen int) string {
 	if len(s) <= maxLen {
 		return s
@@ -1837,3 +1924,37 @@ func cmdLookupIP(args []string) {
 	fmt.Printf("  Cached:       %v\n", resp.Cached)
 	fmt.Println()
 }
+
+func cmdTranscripts(args []string) {
+	req := &pbLocal.GetMockTranscriptsNodeRequest{NodeId: localNodeID}
+	if len(args) > 0 {
+		if net.ParseIP(args[0]) != nil {
+			req.SourceIp = args[0]
+		} else {
+			req.ConnId = args[0]
+		}
+	}
+
+	ctx, cancel := authAPICtx()
+	defer cancel()
+
+	resp, err := client.GetMockTranscripts(ctx, req)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+	if len(resp.Transcripts) == 0 {
+		fmt.Println("No transcripts recorded.")
+		return
+	}
+
+	for _, t := range resp.Transcripts {
+		fmt.Printf("\n=== %s from %s:%d", t.ConnId, t.SourceIp, t.SourcePort)
+		if t.GeoCountry != "" {
+			fmt.Printf(" (%s)", t.GeoCountry)
+		}
+		fmt.Printf(" at %s, %s ===\n", t.StartTime.AsTime().Local().Format(time.RFC3339), time.Duration(t.DurationMs)*time.Millisecond)
+		fmt.Print(t.Transcript)
+	}
+	fmt.Println()
+}
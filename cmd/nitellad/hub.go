@@ -30,6 +30,7 @@ import (
 	"github.com/ivere27/nitella/pkg/identity"
 	"github.com/ivere27/nitella/pkg/log"
 	"github.com/ivere27/nitella/pkg/node"
+	"github.com/ivere27/nitella/pkg/p2p"
 	"github.com/ivere27/nitella/pkg/pairing"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
@@ -239,21 +240,29 @@ func initHub(pm *node.ProxyManager) (bool, error) {
 	// Set metrics provider
 	hubClient.SetMetricsProvider(&proxyMetricsProvider{pm: pm})
 
-	// Create ApprovalManager with HubClient as AlertSender
-	approvalManager := node.NewApprovalManager(hubClient)
+	// Create ApprovalManager with HubClient as AlertSender; alerts are
+	// copied to the node-local sinks, if any
+	alerts := pm.AlertSinks.Tee(hubClient)
+	approvalManager := node.NewApprovalManager(alerts)
 	pm.SetApprovalManager(approvalManager)
 
-	// Set P2P approval decision handler
-	hubClient.SetApprovalDecisionHandler(func(reqID string, allowed bool, durationSeconds int64, reason string) {
-		log.Printf("[P2P] Received approval decision for %s: allowed=%v, duration=%ds, reason=%q",
-			reqID, allowed, durationSeconds, reason)
-		if pm.Approval != nil {
-			meta := pm.Approval.Resolve(reqID, allowed, durationSeconds, reason)
-			if meta != nil {
-				log.Printf("[P2P] Resolved approval for %s from %s", reqID, meta.SourceIP)
-			} else {
-				log.Printf("[P2P] No pending approval found for %s (may have timed out)", reqID)
-			}
+	// Honeypot captures are alerted through the Hub as well
+	pm.SetAlertSender(alerts)
+
+	// Set P2P approval decision handler. Decisions take the same path as
+	// RESOLVE_APPROVAL commands, so signed votes count towards quorums.
+	hubClient.SetApprovalDecisionHandler(func(decision *p2p.ApprovalDecision) {
+		req := &pb.ResolveApprovalRequest{
+			ReqId:           decision.RequestID,
+			Action:          common.ApprovalActionType(decision.Action),
+			RetentionMode:   common.ApprovalRetentionMode(decision.RetentionMode),
+			DurationSeconds: decision.DurationSeconds,
+			Reason:          decision.Reason,
+			ApproverKey:     decision.ApproverKey,
+			Signature:       decision.Signature,
+		}
+		if _, err := resolveApprovalRequest(pm, req); err != nil {
+			log.Printf("[P2P] Approval decision for %s rejected: %v", decision.RequestID, err)
 		}
 	})
 
@@ -930,6 +939,14 @@ func handleHubCommandInternal(pm *node.ProxyManager, cmd string, params []byte)
 	case "COMMAND_TYPE_CANCEL_APPROVAL":
 		return cancelApproval(pm, params)
 
+	// Honeypot
+	case "COMMAND_TYPE_GET_MOCK_TRANSCRIPTS":
+		return getMockTranscripts(pm, params)
+	case "COMMAND_TYPE_CLONE_BANNER":
+		return cloneBanner(pm, params)
+	case "COMMAND_TYPE_LIST_CLONED_PRESETS":
+		return proto.Marshal(pm.ListClonedPresets())
+
 	default:
 		return nil, fmt.Errorf("unknown command: %s", cmd)
 	}
@@ -950,6 +967,7 @@ func getStatus(pm *node.ProxyManager) ([]byte, error) {
 		TotalConnections: totalConns,
 		TotalBytesIn:     bytesIn,
 		TotalBytesOut:    bytesOut,
+		AlertSinks:       pm.AlertSinks.Stats(),
 	}
 	return proto.Marshal(resp)
 }
@@ -1082,6 +1100,8 @@ func getMetrics(pm *node.ProxyManager) ([]byte, error) {
 		ActiveConnections: activeConns,
 		ProxyCount:        int32(len(statuses)),
 		Timestamp:         timestamppb.Now(),
+		Tarpit:            pm.Tarpit.Stats(),
+		AlertSinks:        pm.AlertSinks.Stats(),
 	}
 	return proto.Marshal(resp)
 }
@@ -1283,7 +1303,16 @@ func resolveApproval(pm *node.ProxyManager, params []byte) ([]byte, error) {
 	if err := proto.Unmarshal(params, &req); err != nil {
 		return nil, fmt.Errorf("failed to parse resolve approval request: %w", err)
 	}
+	resp, err := resolveApprovalRequest(pm, &req)
+	if err != nil {
+		return nil, err
+	}
+	return proto.Marshal(resp)
+}
 
+// resolveApprovalRequest applies an approval decision, received from the
+// Hub or over P2P, to its pending request.
+func resolveApprovalRequest(pm *node.ProxyManager, req *pb.ResolveApprovalRequest) (*pb.ResolveApprovalResponse, error) {
 	log.Printf("[Hub] Parsed RESOLVE_APPROVAL: req_id=%q, action=%v, duration=%ds, reason=%q",
 		req.ReqId, req.Action, req.DurationSeconds, req.Reason)
 
@@ -1307,18 +1336,27 @@ func resolveApproval(pm *node.ProxyManager, params []byte) ([]byte, error) {
 	log.Printf("[Hub] Resolving approval request %s (action=%v, mode=%v, duration=%ds)",
 		req.ReqId, req.Action, retentionMode, durationSeconds)
 
-	meta := pm.Approval.ResolveWithRetention(req.ReqId, allowed, durationSeconds, req.Reason, retentionMode)
-	if meta == nil {
+	meta, status, err := pm.Approval.ResolveRequest(req, durationSeconds, retentionMode)
+	if err == node.ErrApprovalNotFound {
 		return nil, fmt.Errorf("no pending approval found for request %s", req.ReqId)
 	}
+	if err != nil {
+		return nil, err
+	}
 
-	log.Printf("[Hub] Approval %s resolved: allowed=%v, mode=%v, duration=%ds, reason=%q, source=%s",
-		req.ReqId, allowed, retentionMode, durationSeconds, req.Reason, meta.SourceIP)
-
-	resp := &pb.ResolveApprovalResponse{
-		Success: true,
+	if status.Resolved {
+		log.Printf("[Hub] Approval %s resolved: allowed=%v, mode=%v, duration=%ds, reason=%q, source=%s",
+			req.ReqId, allowed, retentionMode, durationSeconds, req.Reason, meta.SourceIP)
+	} else {
+		log.Printf("[Hub] Approval %s has %d/%d approvals, source=%s",
+			req.ReqId, status.Approvals, status.Quorum, meta.SourceIP)
 	}
-	return proto.Marshal(resp)
+
+	return &pb.ResolveApprovalResponse{
+		Success:   true,
+		Approvals: int32(status.Approvals),
+		Quorum:    int32(status.Quorum),
+	}, nil
 }
 
 // ===========================================================================
@@ -1582,6 +1620,20 @@ func listActiveApprovals(pm *node.ProxyManager, params []byte) ([]byte, error) {
 			ConnIds: connIDs, GeoCountry: e.GeoCountry, GeoCity: e.GeoCity, GeoIsp: e.GeoISP,
 		})
 	}
+	for _, e := range pm.Approval.GetPendingQuorums() {
+		if req.ProxyId != "" && e.ProxyID != req.ProxyId {
+			continue
+		}
+		if req.SourceIp != "" && e.SourceIP != req.SourceIp {
+			continue
+		}
+		approvals = append(approvals, &pb.ActiveApproval{
+			Key: e.Key(), SourceIp: e.SourceIP, RuleId: e.RuleID, ProxyId: e.ProxyID,
+			CreatedAt: timestamppb.New(e.CreatedAt), ExpiresAt: timestamppb.New(e.ExpiresAt),
+			GeoCountry: e.GeoCountry, GeoCity: e.GeoCity, GeoIsp: e.GeoISP,
+			Pending: true, Approvals: int32(len(e.Approvers)), Quorum: int32(e.Quorum), Approvers: e.Approvers,
+		})
+	}
 	return proto.Marshal(&pb.ListActiveApprovalsResponse{Approvals: approvals})
 }
 
@@ -1619,6 +1671,34 @@ func cancelApproval(pm *node.ProxyManager, params []byte) ([]byte, error) {
 	return proto.Marshal(&pb.CancelApprovalResponse{Success: true, ConnectionsClosed: connectionsClosed})
 }
 
+// ===========================================================================
+// Honeypot Commands
+// ===========================================================================
+
+func getMockTranscripts(pm *node.ProxyManager, params []byte) ([]byte, error) {
+	var req pb.GetMockTranscriptsRequest
+	if err := proto.Unmarshal(params, &req); err != nil {
+		return nil, err
+	}
+	resp, err := pm.GetMockTranscripts(&req)
+	if err != nil {
+		return nil, err
+	}
+	return proto.Marshal(resp)
+}
+
+func cloneBanner(pm *node.ProxyManager, params []byte) ([]byte, error) {
+	var req pb.CloneBannerRequest
+	if err := proto.Unmarshal(params, &req); err != nil {
+		return nil, err
+	}
+	resp, err := pm.CloneBanner(&req)
+	if err != nil {
+		return nil, err
+	}
+	return proto.Marshal(resp)
+}
+
 // ensureHubCA resolves the Hub CA certificate, using TOFU if necessary
 func ensureHubCA(hubAddr string) ([]byte, error) {
 	// 1. If explicit flag provided, use it
@@ -1767,6 +1847,8 @@ func applyProxyTemplate(pm *node.ProxyManager, req *pb.ApplyProxyRequest) ([]byt
 			actionType = common.ActionType_ACTION_TYPE_MOCK
 		case "approval":
 			actionType = common.ActionType_ACTION_TYPE_REQUIRE_APPROVAL
+		case "alert":
+			actionType = common.ActionType_ACTION_TYPE_ALLOW_ALERT
 		default:
 			actionType = common.ActionType_ACTION_TYPE_ALLOW
 		}
@@ -1784,6 +1866,13 @@ func applyProxyTemplate(pm *node.ProxyManager, req *pb.ApplyProxyRequest) ([]byt
 			fallbackAction = common.FallbackAction_FALLBACK_ACTION_UNSPECIFIED
 		}
 
+		thresholds, err := node.ThresholdsFromConfig(ep.Thresholds)
+		if err != nil {
+			lastError = err
+			log.Printf("[Hub] Failed to create listener %s: %v", name, err)
+			continue
+		}
+
 		// Create Proxy
 		resp, err := pm.CreateProxy(&pb.CreateProxyRequest{
 			ListenAddr:     ep.Address,
@@ -1793,6 +1882,7 @@ func applyProxyTemplate(pm *node.ProxyManager, req *pb.ApplyProxyRequest) ([]byt
 			DefaultMock:    node.StringToMockPreset(ep.DefaultMock),
 			FallbackAction: fallbackAction,
 			FallbackMock:   node.StringToMockPreset(ep.FallbackMock),
+			Thresholds:     thresholds,
 		})
 
 		if err != nil {
@@ -3,10 +3,12 @@ package main
 import (
 	"crypto/ed25519"
 	"crypto/rand"
+	"crypto/tls"
 	"encoding/hex"
 	"flag"
 	"fmt"
 	"net"
+	"net/http"
 	"os"
 	"os/signal"
 	"path/filepath"
@@ -22,7 +24,9 @@ import (
 	"github.com/ivere27/nitella/pkg/identity"
 	"github.com/ivere27/nitella/pkg/log"
 	"github.com/ivere27/nitella/pkg/node"
+	"github.com/ivere27/nitella/pkg/node/accesslog"
 	"github.com/ivere27/nitella/pkg/node/admincert"
+	"github.com/ivere27/nitella/pkg/node/alertsink"
 	"github.com/ivere27/nitella/pkg/node/stats"
 	nitellaPprof "github.com/ivere27/nitella/pkg/pprof"
 	"github.com/ivere27/nitella/pkg/server"
@@ -51,6 +55,8 @@ func main() {
 	backendTarget := flag.String("backend", "", "Default backend address")
 	dbPath := flag.String("db-path", "nitella.db", "Path to SQLite database")
 	statsDB := flag.String("stats-db", "", "Path to statistics database (default: same dir as config)")
+	accessLogPath := flag.String("access-log", "", "Path to a structured access log, one record per connection outcome (overrides config)")
+	accessLogFormat := flag.String("access-log-format", "", "Access log format: json (default), cef or leef (overrides config)")
 	processMode := flag.Bool("process-mode", false, "Run each proxy as a separate child process (for isolation)")
 	adminDataDir := flag.String("admin-data-dir", "", "Data directory for admin API certificates (default: same as db-path directory)")
 
@@ -73,6 +79,40 @@ func main() {
 	adminPort := flag.Int("admin-port", 0, "Port for Admin gRPC API (0 = disabled)")
 	adminToken := flag.String("admin-token", os.Getenv("NITELLA_TOKEN"), "Authentication token for Admin API (env: NITELLA_TOKEN)")
 
+	// Metrics flags
+	metricsPort := flag.Int("metrics-port", 0, "Port for the Prometheus /metrics endpoint (0 = disabled)")
+	metricsMTLS := flag.Bool("metrics-mtls", false, "Serve metrics over TLS, requiring client certificates signed by the admin CA")
+
+	// Honeypot reputation flags
+	honeypotBlock := flag.String("honeypot-block", "", "Block sources globally after they touch a mock listener: touch, capture (credentials only), or empty to disable")
+	honeypotBlockDuration := flag.Duration("honeypot-block-duration", 10*time.Minute, "Duration of the first honeypot block")
+	honeypotBlockEscalation := flag.Float64("honeypot-block-escalation", 2, "Honeypot block duration multiplier for each repeat offense")
+	honeypotBlockMax := flag.Duration("honeypot-block-max", 24*time.Hour, "Longest honeypot block; offenses are forgotten this long after a block ends")
+	honeypotBlockExempt := flag.String("honeypot-block-exempt", "", "Comma-separated IPs/CIDRs never blocked by honeypot reputation")
+
+	// Tarpit budget flags
+	tarpitMaxConns := flag.Int("tarpit-max-conns", 1024, "Maximum concurrent tarpitted connections across all listeners; excess connections are closed immediately (0 = unlimited)")
+	tarpitMaxMemoryMB := flag.Int("tarpit-max-memory-mb", 64, "Maximum estimated memory held by tarpits in MB (0 = unlimited)")
+	tarpitAdaptive := flag.Bool("tarpit-adaptive", true, "Double the tarpit drip interval for each recent repeat visit of a source")
+	tarpitMaxDrip := flag.Duration("tarpit-max-drip", 10*time.Second, "Longest adaptive tarpit drip interval")
+
+	// Connection alert flags (ALLOW_ALERT action)
+	alertDedup := flag.Duration("alert-dedup", cfgpkg.DefaultConnectionAlertDedup, "Fold repeat ALLOW_ALERT connections (or honeypot captures) from a source to the same proxy and rule into one alert")
+	alertRate := flag.Int("alert-rate", cfgpkg.DefaultConnectionAlertRate, "Maximum ALLOW_ALERT, threshold and honeypot capture alerts sent per minute (0 = unlimited)")
+
+	// Quorum approval flags
+	approverKeys := flag.String("approver-keys", "", "Path to PEM public keys of approvers whose signed votes count towards approval quorums")
+
+	// Local approval flags
+	approvalSocket := flag.String("approval-socket", "", "Unix socket serving approval requests to a local UI (rules naming the \"queue\" local approver)")
+
+	// Approval context flags
+	approvalRDNS := flag.Bool("approval-rdns", true, "Attach the source's forward-confirmed reverse DNS name to approval requests")
+	ipSets := flag.String("ip-sets", "", "Comma-separated name=path IP set files (one IP/CIDR per line); approval requests name the sets holding their source")
+
+	// Rule lifetime flags
+	ruleExpiry := flag.String("rule-expiry", string(node.RuleExpiryRemove), "What to do with rules past their expiry: remove, disable")
+
 	// Profiling flags (only effective with -tags pprof)
 	pprofPort := flag.Int("pprof-port", 0, "Port for pprof HTTP server (0 = disabled, requires -tags pprof build)")
 
@@ -183,6 +223,57 @@ func main() {
 	if geoIPClient != nil {
 		pm.GeoIP.SetClient(geoIPClient)
 	}
+	pm.SetTarpitBudget(node.TarpitBudgetConfig{
+		MaxConns:        *tarpitMaxConns,
+		MaxMemory:       int64(*tarpitMaxMemoryMB) << 20,
+		Adaptive:        *tarpitAdaptive,
+		MaxDripInterval: *tarpitMaxDrip,
+	})
+	pm.SetConnectionAlerts(node.ConnectionAlertConfig{Dedup: *alertDedup, PerMinute: *alertRate})
+	if yamlConfig != nil && len(yamlConfig.Alerts.Sinks) > 0 {
+		sinks, err := alertsink.NewDispatcher(yamlConfig.Alerts.Sinks)
+		if err != nil {
+			log.Fatalf("Invalid alert sinks: %v", err)
+		}
+		defer sinks.Close()
+		pm.SetAlertSinks(sinks)
+		log.Printf("[INFO] Delivering alerts to %d local sinks", len(yamlConfig.Alerts.Sinks))
+	}
+	var accessLogConfig cfgpkg.AccessLogConfig
+	if yamlConfig != nil {
+		accessLogConfig = yamlConfig.AccessLog
+	}
+	if *accessLogPath != "" {
+		accessLogConfig.Path = *accessLogPath
+	}
+	if *accessLogFormat != "" {
+		accessLogConfig.Format = *accessLogFormat
+	}
+	if accessLogConfig.Path != "" {
+		accessLog, err := accesslog.New(accessLogConfig)
+		if err != nil {
+			log.Fatalf("Failed to open access log: %v", err)
+		}
+		defer accessLog.Close()
+		pm.SetAccessLog(accessLog)
+		log.Printf("[INFO] Writing access log to %s", accessLogConfig.Path)
+	}
+	if err := pm.SetRuleExpiry(node.RuleExpiryMode(*ruleExpiry)); err != nil {
+		log.Fatalf("Invalid -rule-expiry: %v", err)
+	}
+	if *honeypotBlock != "" {
+		err := pm.SetHoneypotBlock(node.HoneypotBlockConfig{
+			Trigger:     *honeypotBlock,
+			Duration:    *honeypotBlockDuration,
+			Escalation:  *honeypotBlockEscalation,
+			MaxDuration: *honeypotBlockMax,
+			Exempt:      strings.Split(*honeypotBlockExempt, ","),
+		})
+		if err != nil {
+			log.Fatalf("Invalid honeypot block settings: %v", err)
+		}
+		log.Printf("[INFO] Honeypot reputation enabled (trigger: %s, first block: %s)", *honeypotBlock, *honeypotBlockDuration)
+	}
 
 	// Initialize DB persistence
 	if *configFile == "" {
@@ -230,6 +321,7 @@ func main() {
 		keyPEM        string
 		caPEM         string
 		clientAuth    pb.ClientAuthType
+		thresholds    *pb.ConnectionThresholds
 	}
 
 	var listeners []listenerConfig
@@ -247,6 +339,11 @@ func main() {
 				caPEM:         caPEM,
 				clientAuth:    clientAuth,
 			}
+			thresholds, err := node.ThresholdsFromConfig(ep.Thresholds)
+			if err != nil {
+				log.Fatalf("Entry point %s: %v", name, err)
+			}
+			lc.thresholds = thresholds
 			// Find associated service
 			for _, router := range yamlConfig.TCP.Routers {
 				if containsString(router.EntryPoints, name) && router.Service != "" {
@@ -305,6 +402,8 @@ func main() {
 			actionType = common.ActionType_ACTION_TYPE_MOCK
 		case "approval":
 			actionType = common.ActionType_ACTION_TYPE_REQUIRE_APPROVAL
+		case "alert":
+			actionType = common.ActionType_ACTION_TYPE_ALLOW_ALERT
 		default:
 			actionType = common.ActionType_ACTION_TYPE_ALLOW
 		}
@@ -319,6 +418,7 @@ func main() {
 			ClientAuthType: lc.clientAuth,
 			DefaultAction:  actionType,
 			DefaultMock:    node.StringToMockPreset(lc.defaultMock),
+			Thresholds:     lc.thresholds,
 		})
 		if err != nil || !resp.Success {
 			log.Fatalf("Failed to start proxy %s: %v %s", lc.name, err, resp.ErrorMessage)
@@ -351,6 +451,9 @@ func main() {
 		} else if defaultAction == "approval" {
 			defaultRule.Action = common.ActionType_ACTION_TYPE_REQUIRE_APPROVAL
 			log.Printf("  Default: APPROVAL (manual approval mode)")
+		} else if defaultAction == "alert" {
+			defaultRule.Action = common.ActionType_ACTION_TYPE_ALLOW_ALERT
+			log.Printf("  Default: ALLOW + ALERT (alert mode)")
 		} else {
 			defaultRule.Action = common.ActionType_ACTION_TYPE_ALLOW
 			log.Printf("  Default: ALLOW (blacklist mode)")
@@ -374,7 +477,63 @@ func main() {
 	// Ensure ApprovalManager is initialized even if Hub is inactive (standalone mode)
 	if pm.Approval == nil {
 		log.Println("[INFO] Initializing local ApprovalManager (standalone mode)")
-		pm.SetApprovalManager(node.NewApprovalManager(&localAlertSender{}))
+		pm.SetApprovalManager(node.NewApprovalManager(pm.AlertSinks.Tee(&localAlertSender{})))
+	}
+	if pm.Alerts() == nil && pm.AlertSinks != nil {
+		// Honeypot and connection alerts only reach the local sinks
+		pm.SetAlertSender(pm.AlertSinks)
+	}
+	if *approverKeys != "" {
+		data, err := os.ReadFile(*approverKeys)
+		if err != nil {
+			log.Fatalf("Failed to read approver keys: %v", err)
+		}
+		keys, err := node.ParseApproverKeys(data)
+		if err != nil {
+			log.Fatalf("Invalid approver keys: %v", err)
+		}
+		pm.Approval.SetApprovers(keys)
+		log.Printf("[INFO] Registered %d approver keys for quorum approvals", len(keys))
+	}
+	if yamlConfig != nil && len(yamlConfig.Approvers) > 0 {
+		if err := pm.Approval.SetLocalApprovers(yamlConfig.Approvers); err != nil {
+			log.Fatalf("Invalid local approvers: %v", err)
+		}
+		log.Printf("[INFO] Registered %d local approvers", len(yamlConfig.Approvers))
+	}
+	if *approvalSocket != "" {
+		queue, err := node.ListenApprovalQueue(*approvalSocket)
+		if err != nil {
+			log.Fatalf("Failed to listen on approval socket: %v", err)
+		}
+		defer queue.Close()
+		pm.Approval.SetQueue(queue)
+		log.Printf("[INFO] Serving approval requests on %s", *approvalSocket)
+	}
+	if *approvalRDNS {
+		pm.Approval.SetReverseResolver(node.NewReverseResolver())
+	}
+	if *ipSets != "" {
+		var sets []*node.IPSet
+		for _, spec := range strings.Split(*ipSets, ",") {
+			name, path, ok := strings.Cut(strings.TrimSpace(spec), "=")
+			if !ok || name == "" || path == "" {
+				log.Fatalf("Invalid IP set %q (want name=path)", spec)
+			}
+			set, err := node.LoadIPSet(name, path)
+			if err != nil {
+				log.Fatalf("Failed to load IP set: %v", err)
+			}
+			sets = append(sets, set)
+			log.Printf("[INFO] Loaded IP set %s (%d entries)", name, set.Len())
+		}
+		pm.Approval.SetIPSets(sets)
+	}
+
+	// Determine admin data directory for certificates
+	adminCertDir := *adminDataDir
+	if adminCertDir == "" {
+		adminCertDir = filepath.Dir(*dbPath)
 	}
 
 	// Start Admin API Server with TLS
@@ -386,12 +545,6 @@ func main() {
 			log.Printf("[Admin] Generated token (keep secret): %s", *adminToken)
 		}
 
-		// Determine admin data directory for certificates
-		adminCertDir := *adminDataDir
-		if adminCertDir == "" {
-			adminCertDir = filepath.Dir(*dbPath)
-		}
-
 		// Initialize certificate manager (auto-generates CA and server cert)
 		certMgr, err := admincert.New(adminCertDir)
 		if err != nil {
@@ -423,6 +576,25 @@ func main() {
 		}()
 	}
 
+	// Start Prometheus metrics endpoint, optionally behind admin CA mTLS
+	var metricsServer *http.Server
+	if *metricsPort > 0 {
+		var metricsTLS *tls.Config
+		if *metricsMTLS {
+			certMgr, err := admincert.New(adminCertDir)
+			if err != nil {
+				log.Fatalf("Failed to initialize metrics TLS: %v", err)
+			}
+			metricsTLS = certMgr.GetMTLSConfig()
+			log.Printf("[Metrics] Scrapers need a client certificate signed by %s", certMgr.GetCACertPath())
+		}
+		metricsServer, err = startMetricsServer(pm, *metricsPort, metricsTLS)
+		if err != nil {
+			log.Fatalf("Failed to listen on metrics port %d: %v", *metricsPort, err)
+		}
+		log.Printf("[Metrics] Serving /metrics on :%d (mTLS: %v)", *metricsPort, *metricsMTLS)
+	}
+
 	// Wait for shutdown signal
 	sigCh := make(chan os.Signal, 1)
 	signal.Notify(sigCh, os.Interrupt, syscall.SIGTERM)
@@ -432,6 +604,9 @@ func main() {
 	if adminServer != nil {
 		adminServer.GracefulStop()
 	}
+	if metricsServer != nil {
+		metricsServer.Close()
+	}
 	// Close Hub connection
 	closeHub()
 	// Close ProxyManager (stops all listeners, health checks, GeoIP)
@@ -473,12 +648,18 @@ Proxy Options:
   -config string       Path to YAML config file
   -db-path string      Path to SQLite database (default "nitella.db")
   -stats-db string     Path to statistics database
+  -access-log string   Path to a structured access log (overrides config)
+  -access-log-format   Access log format: json, cef or leef (default "json")
   -process-mode        Run each proxy as separate child process (for isolation)
 
 Admin API Options:
   -admin-port int      Port for Admin gRPC API (0 = disabled)
   -admin-token string  Authentication token (env: NITELLA_TOKEN)
 
+Metrics Options:
+  -metrics-port int    Port for the Prometheus /metrics endpoint (0 = disabled)
+  -metrics-mtls        Require client certificates signed by the admin CA
+
 Hub Mode Options:
   -hub string          Hub server address (env: NITELLA_HUB)
   -hub-user-id string  User ID for Hub registration (env: NITELLA_HUB_USER_ID)
@@ -494,6 +675,24 @@ TLS Options:
   -tls-ca string       Path to Client CA Certificate
   -mtls                Require Client Certificates (mTLS)
 
+Honeypot Options:
+  -honeypot-block str  Block mock visitors globally: touch, capture (default: disabled)
+  -honeypot-block-duration dur   First honeypot block (default 10m)
+  -honeypot-block-escalation f   Block multiplier per repeat offense (default 2)
+  -honeypot-block-max dur        Longest honeypot block (default 24h)
+  -honeypot-block-exempt str     IPs/CIDRs never honeypot-blocked
+  -tarpit-max-conns int          Concurrent tarpitted connections (default 1024, 0 = unlimited)
+  -tarpit-max-memory-mb int      Estimated tarpit memory in MB (default 64, 0 = unlimited)
+  -tarpit-adaptive               Raise drip interval for repeat visitors (default: true)
+  -tarpit-max-drip dur           Longest adaptive drip interval (default 10s)
+
+Alert Options:
+  -alert-dedup dur     Fold repeat ALLOW_ALERT connections and captures into one alert (default 10m)
+  -alert-rate int      ALLOW_ALERT, threshold and capture alerts per minute (default 30, 0 = unlimited)
+
+Rule Options:
+  -rule-expiry string  Expired rules are removed or disabled: remove, disable (default "remove")
+
 GeoIP Options:
   -geoip-city string   Path to GeoIP2 City DB (MaxMind)
   -geoip-isp string    Path to GeoIP2 ISP/ASN DB (MaxMind)
@@ -560,6 +759,8 @@ func runChild() {
 
 	// Create ProxyManager for this child (FFI listeners)
 	pm := node.NewProxyManager(node.ListenerModeFfi)
+	// The parent expires rules and persists the result
+	pm.DisableRuleExpiry()
 
 	// Create gRPC server with NO TLS (IPC is local/anonymous)
 	grpcServer := grpc.NewServer()
@@ -0,0 +1,52 @@
+package main
+
+import (
+	"bytes"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/ivere27/nitella/pkg/log"
+	"github.com/ivere27/nitella/pkg/node"
+	"github.com/ivere27/nitella/pkg/node/metrics"
+)
+
+// startMetricsServer serves pm's metrics at /metrics on port, over TLS
+// when tlsConfig is set.
+func startMetricsServer(pm *node.ProxyManager, port int, tlsConfig *tls.Config) (*http.Server, error) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/metrics", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		// Render first so a failure is reported as an error, not a short scrape
+		var buf bytes.Buffer
+		if err := pm.WriteMetrics(&buf); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", metrics.ContentType)
+		w.Write(buf.Bytes())
+	})
+
+	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", port))
+	if err != nil {
+		return nil, err
+	}
+	if tlsConfig != nil {
+		lis = tls.NewListener(lis, tlsConfig)
+	}
+	srv := &http.Server{
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	go func() {
+		if err := srv.Serve(lis); err != nil && err != http.ErrServerClosed {
+			log.Printf("[Metrics] Server error: %v", err)
+		}
+	}()
+	return srv, nil
+}
@@ -30,6 +30,7 @@ const (
 	ActionType_ACTION_TYPE_BLOCK            ActionType = 2
 	ActionType_ACTION_TYPE_MOCK             ActionType = 3
 	ActionType_ACTION_TYPE_REQUIRE_APPROVAL ActionType = 4 // Real-time user approval required
+	ActionType_ACTION_TYPE_ALLOW_ALERT      ActionType = 5 // Allow and alert (deduplicated, rate-limited)
 )
 
 // Enum value maps for ActionType.
@@ -40,6 +41,7 @@ var (
 		2: "ACTION_TYPE_BLOCK",
 		3: "ACTION_TYPE_MOCK",
 		4: "ACTION_TYPE_REQUIRE_APPROVAL",
+		5: "ACTION_TYPE_ALLOW_ALERT",
 	}
 	ActionType_value = map[string]int32{
 		"ACTION_TYPE_UNSPECIFIED":      0,
@@ -47,6 +49,7 @@ var (
 		"ACTION_TYPE_BLOCK":            2,
 		"ACTION_TYPE_MOCK":             3,
 		"ACTION_TYPE_REQUIRE_APPROVAL": 4,
+		"ACTION_TYPE_ALLOW_ALERT":      5,
 	}
 )
 
@@ -131,18 +134,33 @@ func (FallbackAction) EnumDescriptor() ([]byte, []int) {
 type MockPreset int32
 
 const (
-	MockPreset_MOCK_PRESET_UNSPECIFIED   MockPreset = 0
-	MockPreset_MOCK_PRESET_SSH_SECURE    MockPreset = 1
-	MockPreset_MOCK_PRESET_SSH_TARPIT    MockPreset = 2
-	MockPreset_MOCK_PRESET_HTTP_403      MockPreset = 3
-	MockPreset_MOCK_PRESET_HTTP_404      MockPreset = 4
-	MockPreset_MOCK_PRESET_HTTP_401      MockPreset = 5
-	MockPreset_MOCK_PRESET_REDIS_SECURE  MockPreset = 6
-	MockPreset_MOCK_PRESET_MYSQL_SECURE  MockPreset = 7
-	MockPreset_MOCK_PRESET_MYSQL_TARPIT  MockPreset = 8
-	MockPreset_MOCK_PRESET_RDP_SECURE    MockPreset = 9
-	MockPreset_MOCK_PRESET_TELNET_SECURE MockPreset = 10
-	MockPreset_MOCK_PRESET_RAW_TARPIT    MockPreset = 11
+	MockPreset_MOCK_PRESET_UNSPECIFIED          MockPreset = 0
+	MockPreset_MOCK_PRESET_SSH_SECURE           MockPreset = 1
+	MockPreset_MOCK_PRESET_SSH_TARPIT           MockPreset = 2
+	MockPreset_MOCK_PRESET_HTTP_403             MockPreset = 3
+	MockPreset_MOCK_PRESET_HTTP_404             MockPreset = 4
+	MockPreset_MOCK_PRESET_HTTP_401             MockPreset = 5
+	MockPreset_MOCK_PRESET_REDIS_SECURE         MockPreset = 6
+	MockPreset_MOCK_PRESET_MYSQL_SECURE         MockPreset = 7
+	MockPreset_MOCK_PRESET_MYSQL_TARPIT         MockPreset = 8
+	MockPreset_MOCK_PRESET_RDP_SECURE           MockPreset = 9
+	MockPreset_MOCK_PRESET_TELNET_SECURE        MockPreset = 10
+	MockPreset_MOCK_PRESET_RAW_TARPIT           MockPreset = 11
+	MockPreset_MOCK_PRESET_POSTGRES_SECURE      MockPreset = 12
+	MockPreset_MOCK_PRESET_POSTGRES_TARPIT      MockPreset = 13
+	MockPreset_MOCK_PRESET_MONGODB_SECURE       MockPreset = 14
+	MockPreset_MOCK_PRESET_MONGODB_TARPIT       MockPreset = 15
+	MockPreset_MOCK_PRESET_ELASTICSEARCH_SECURE MockPreset = 16
+	MockPreset_MOCK_PRESET_ELASTICSEARCH_TARPIT MockPreset = 17
+	MockPreset_MOCK_PRESET_MEMCACHED_SECURE     MockPreset = 18
+	MockPreset_MOCK_PRESET_MEMCACHED_TARPIT     MockPreset = 19
+	MockPreset_MOCK_PRESET_FTP_SECURE           MockPreset = 20
+	MockPreset_MOCK_PRESET_FTP_TARPIT           MockPreset = 21
+	MockPreset_MOCK_PRESET_VNC_SECURE           MockPreset = 22
+	MockPreset_MOCK_PRESET_VNC_TARPIT           MockPreset = 23
+	MockPreset_MOCK_PRESET_SMB_SECURE           MockPreset = 24
+	MockPreset_MOCK_PRESET_LDAP_SECURE          MockPreset = 25
+	MockPreset_MOCK_PRESET_LDAP_TARPIT          MockPreset = 26
 )
 
 // Enum value maps for MockPreset.
@@ -160,20 +178,50 @@ var (
 		9:  "MOCK_PRESET_RDP_SECURE",
 		10: "MOCK_PRESET_TELNET_SECURE",
 		11: "MOCK_PRESET_RAW_TARPIT",
+		12: "MOCK_PRESET_POSTGRES_SECURE",
+		13: "MOCK_PRESET_POSTGRES_TARPIT",
+		14: "MOCK_PRESET_MONGODB_SECURE",
+		15: "MOCK_PRESET_MONGODB_TARPIT",
+		16: "MOCK_PRESET_ELASTICSEARCH_SECURE",
+		17: "MOCK_PRESET_ELASTICSEARCH_TARPIT",
+		18: "MOCK_PRESET_MEMCACHED_SECURE",
+		19: "MOCK_PRESET_MEMCACHED_TARPIT",
+		20: "MOCK_PRESET_FTP_SECURE",
+		21: "MOCK_PRESET_FTP_TARPIT",
+		22: "MOCK_PRESET_VNC_SECURE",
+		23: "MOCK_PRESET_VNC_TARPIT",
+		24: "MOCK_PRESET_SMB_SECURE",
+		25: "MOCK_PRESET_LDAP_SECURE",
+		26: "MOCK_PRESET_LDAP_TARPIT",
 	}
 	MockPreset_value = map[string]int32{
-		"MOCK_PRESET_UNSPECIFIED":   0,
-		"MOCK_PRESET_SSH_SECURE":    1,
-		"MOCK_PRESET_SSH_TARPIT":    2,
-		"MOCK_PRESET_HTTP_403":      3,
-		"MOCK_PRESET_HTTP_404":      4,
-		"MOCK_PRESET_HTTP_401":      5,
-		"MOCK_PRESET_REDIS_SECURE":  6,
-		"MOCK_PRESET_MYSQL_SECURE":  7,
-		"MOCK_PRESET_MYSQL_TARPIT":  8,
-		"MOCK_PRESET_RDP_SECURE":    9,
-		"MOCK_PRESET_TELNET_SECURE": 10,
-		"MOCK_PRESET_RAW_TARPIT":    11,
+		"MOCK_PRESET_UNSPECIFIED":          0,
+		"MOCK_PRESET_SSH_SECURE":           1,
+		"MOCK_PRESET_SSH_TARPIT":           2,
+		"MOCK_PRESET_HTTP_403":             3,
+		"MOCK_PRESET_HTTP_404":             4,
+		"MOCK_PRESET_HTTP_401":             5,
+		"MOCK_PRESET_REDIS_SECURE":         6,
+		"MOCK_PRESET_MYSQL_SECURE":         7,
+		"MOCK_PRESET_MYSQL_TARPIT":         8,
+		"MOCK_PRESET_RDP_SECURE":           9,
+		"MOCK_PRESET_TELNET_SECURE":        10,
+		"MOCK_PRESET_RAW_TARPIT":           11,
+		"MOCK_PRESET_POSTGRES_SECURE":      12,
+		"MOCK_PRESET_POSTGRES_TARPIT":      13,
+		"MOCK_PRESET_MONGODB_SECURE":       14,
+		"MOCK_PRESET_MONGODB_TARPIT":       15,
+		"MOCK_PRESET_ELASTICSEARCH_SECURE": 16,
+		"MOCK_PRESET_ELASTICSEARCH_TARPIT": 17,
+		"MOCK_PRESET_MEMCACHED_SECURE":     18,
+		"MOCK_PRESET_MEMCACHED_TARPIT":     19,
+		"MOCK_PRESET_FTP_SECURE":           20,
+		"MOCK_PRESET_FTP_TARPIT":           21,
+		"MOCK_PRESET_VNC_SECURE":           22,
+		"MOCK_PRESET_VNC_TARPIT":           23,
+		"MOCK_PRESET_SMB_SECURE":           24,
+		"MOCK_PRESET_LDAP_SECURE":          25,
+		"MOCK_PRESET_LDAP_TARPIT":          26,
 	}
 )
 
@@ -912,6 +960,9 @@ type AlertDetails struct {
 	GeoCountry    string                 `protobuf:"bytes,6,opt,name=geo_country,json=geoCountry,proto3" json:"geo_country,omitempty"`
 	GeoCity       string                 `protobuf:"bytes,7,opt,name=geo_city,json=geoCity,proto3" json:"geo_city,omitempty"`
 	GeoIsp        string                 `protobuf:"bytes,8,opt,name=geo_isp,json=geoIsp,proto3" json:"geo_isp,omitempty"`
+	Summary       string                 `protobuf:"bytes,9,opt,name=summary,proto3" json:"summary,omitempty"`                                                                          // Human-readable description (non-approval alerts)
+	Fields        map[string]string      `protobuf:"bytes,10,rep,name=fields,proto3" json:"fields,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // Structured attributes, e.g. captured credentials
+	Context       *ApprovalContext       `protobuf:"bytes,11,opt,name=context,proto3" json:"context,omitempty"`                                                                         // What the node knows about the source (approval requests)
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1002,6 +1053,145 @@ func (x *AlertDetails) GetGeoIsp() string {
 	return ""
 }
 
+func (x *AlertDetails) GetSummary() string {
+	if x != nil {
+		return x.Summary
+	}
+	return ""
+}
+
+func (x *AlertDetails) GetFields() map[string]string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *AlertDetails) GetContext() *ApprovalContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+// ApprovalContext is what a node knows about an approval request's source,
+// gathered when the request is raised.
+type ApprovalContext struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ConnectionCount int64                  `protobuf:"varint,1,opt,name=connection_count,json=connectionCount,proto3" json:"connection_count,omitempty"` // Earlier connections from the source IP
+	BlockedCount    int64                  `protobuf:"varint,2,opt,name=blocked_count,json=blockedCount,proto3" json:"blocked_count,omitempty"`          // Earlier blocked connections from the source IP
+	FirstSeenUnix   int64                  `protobuf:"varint,3,opt,name=first_seen_unix,json=firstSeenUnix,proto3" json:"first_seen_unix,omitempty"`     // 0 = not seen before
+	LastSeenUnix    int64                  `protobuf:"varint,4,opt,name=last_seen_unix,json=lastSeenUnix,proto3" json:"last_seen_unix,omitempty"`
+	PriorApprovals  int32                  `protobuf:"varint,5,opt,name=prior_approvals,json=priorApprovals,proto3" json:"prior_approvals,omitempty"` // Earlier approval decisions for the source IP
+	PriorDenials    int32                  `protobuf:"varint,6,opt,name=prior_denials,json=priorDenials,proto3" json:"prior_denials,omitempty"`
+	ReverseDns      string                 `protobuf:"bytes,7,opt,name=reverse_dns,json=reverseDns,proto3" json:"reverse_dns,omitempty"` // PTR name, empty when unresolved
+	IpSets          []string               `protobuf:"bytes,8,rep,name=ip_sets,json=ipSets,proto3" json:"ip_sets,omitempty"`             // Configured IP sets containing the source IP
+	TlsSubject      string                 `protobuf:"bytes,9,opt,name=tls_subject,json=tlsSubject,proto3" json:"tls_subject,omitempty"` // Client certificate subject, when presented
+	TlsCn           string                 `protobuf:"bytes,10,opt,name=tls_cn,json=tlsCn,proto3" json:"tls_cn,omitempty"`               // Client certificate common name
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ApprovalContext) Reset() {
+	*x = ApprovalContext{}
+	mi := &file_common_common_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApprovalContext) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApprovalContext) ProtoMessage() {}
+
+func (x *ApprovalContext) ProtoReflect() protoreflect.Message {
+	mi := &file_common_common_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApprovalContext.ProtoReflect.Descriptor instead.
+func (*ApprovalContext) Descriptor() ([]byte, []int) {
+	return file_common_common_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ApprovalContext) GetConnectionCount() int64 {
+	if x != nil {
+		return x.ConnectionCount
+	}
+	return 0
+}
+
+func (x *ApprovalContext) GetBlockedCount() int64 {
+	if x != nil {
+		return x.BlockedCount
+	}
+	return 0
+}
+
+func (x *ApprovalContext) GetFirstSeenUnix() int64 {
+	if x != nil {
+		return x.FirstSeenUnix
+	}
+	return 0
+}
+
+func (x *ApprovalContext) GetLastSeenUnix() int64 {
+	if x != nil {
+		return x.LastSeenUnix
+	}
+	return 0
+}
+
+func (x *ApprovalContext) GetPriorApprovals() int32 {
+	if x != nil {
+		return x.PriorApprovals
+	}
+	return 0
+}
+
+func (x *ApprovalContext) GetPriorDenials() int32 {
+	if x != nil {
+		return x.PriorDenials
+	}
+	return 0
+}
+
+func (x *ApprovalContext) GetReverseDns() string {
+	if x != nil {
+		return x.ReverseDns
+	}
+	return ""
+}
+
+func (x *ApprovalContext) GetIpSets() []string {
+	if x != nil {
+		return x.IpSets
+	}
+	return nil
+}
+
+func (x *ApprovalContext) GetTlsSubject() string {
+	if x != nil {
+		return x.TlsSubject
+	}
+	return ""
+}
+
+func (x *ApprovalContext) GetTlsCn() string {
+	if x != nil {
+		return x.TlsCn
+	}
+	return ""
+}
+
 // GeoInfo contains geographical information for an IP address.
 type GeoInfo struct {
 	state   protoimpl.MessageState `protogen:"open.v1"`
@@ -1027,7 +1217,7 @@ type GeoInfo struct {
 
 func (x *GeoInfo) Reset() {
 	*x = GeoInfo{}
-	mi := &file_common_common_proto_msgTypes[4]
+	mi := &file_common_common_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1039,7 +1229,7 @@ func (x *GeoInfo) String() string {
 func (*GeoInfo) ProtoMessage() {}
 
 func (x *GeoInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_common_common_proto_msgTypes[4]
+	mi := &file_common_common_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1052,7 +1242,7 @@ func (x *GeoInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GeoInfo.ProtoReflect.Descriptor instead.
 func (*GeoInfo) Descriptor() ([]byte, []int) {
-	return file_common_common_proto_rawDescGZIP(), []int{4}
+	return file_common_common_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *GeoInfo) GetCountry() string {
@@ -1182,7 +1372,7 @@ const file_common_common_proto_rawDesc = "" +
 	"\bmetadata\x18\a \x03(\v2\x1c.nitella.Alert.MetadataEntryR\bmetadata\x1a;\n" +
 	"\rMetadataEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xf5\x01\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xb9\x03\n" +
 	"\fAlertDetails\x12\x1b\n" +
 	"\tsource_ip\x18\x01 \x01(\tR\bsourceIp\x12 \n" +
 	"\vdestination\x18\x02 \x01(\tR\vdestination\x12\x19\n" +
@@ -1193,7 +1383,28 @@ const file_common_common_proto_rawDesc = "" +
 	"\vgeo_country\x18\x06 \x01(\tR\n" +
 	"geoCountry\x12\x19\n" +
 	"\bgeo_city\x18\a \x01(\tR\ageoCity\x12\x17\n" +
-	"\ageo_isp\x18\b \x01(\tR\x06geoIsp\"\xe6\x02\n" +
+	"\ageo_isp\x18\b \x01(\tR\x06geoIsp\x12\x18\n" +
+	"\asummary\x18\t \x01(\tR\asummary\x129\n" +
+	"\x06fields\x18\n" +
+	" \x03(\v2!.nitella.AlertDetails.FieldsEntryR\x06fields\x122\n" +
+	"\acontext\x18\v \x01(\v2\x18.nitella.ApprovalContextR\acontext\x1a9\n" +
+	"\vFieldsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xef\x02\n" +
+	"\x0fApprovalContext\x12)\n" +
+	"\x10connection_count\x18\x01 \x01(\x03R\x0fconnectionCount\x12#\n" +
+	"\rblocked_count\x18\x02 \x01(\x03R\fblockedCount\x12&\n" +
+	"\x0ffirst_seen_unix\x18\x03 \x01(\x03R\rfirstSeenUnix\x12$\n" +
+	"\x0elast_seen_unix\x18\x04 \x01(\x03R\flastSeenUnix\x12'\n" +
+	"\x0fprior_approvals\x18\x05 \x01(\x05R\x0epriorApprovals\x12#\n" +
+	"\rprior_denials\x18\x06 \x01(\x05R\fpriorDenials\x12\x1f\n" +
+	"\vreverse_dns\x18\a \x01(\tR\n" +
+	"reverseDns\x12\x17\n" +
+	"\aip_sets\x18\b \x03(\tR\x06ipSets\x12\x1f\n" +
+	"\vtls_subject\x18\t \x01(\tR\n" +
+	"tlsSubject\x12\x15\n" +
+	"\x06tls_cn\x18\n" +
+	" \x01(\tR\x05tlsCn\"\xe6\x02\n" +
 	"\aGeoInfo\x12\x18\n" +
 	"\acountry\x18\x01 \x01(\tR\acountry\x12\x12\n" +
 	"\x04city\x18\x02 \x01(\tR\x04city\x12\x10\n" +
@@ -1211,18 +1422,19 @@ const file_common_common_proto_rawDesc = "" +
 	"\x02as\x18\f \x01(\tR\x02as\x12\x16\n" +
 	"\x06source\x18\r \x01(\tR\x06source\x12\x1d\n" +
 	"\n" +
-	"latency_ms\x18\x0e \x01(\x03R\tlatencyMs*\x8f\x01\n" +
+	"latency_ms\x18\x0e \x01(\x03R\tlatencyMs*\xac\x01\n" +
 	"\n" +
 	"ActionType\x12\x1b\n" +
 	"\x17ACTION_TYPE_UNSPECIFIED\x10\x00\x12\x15\n" +
 	"\x11ACTION_TYPE_ALLOW\x10\x01\x12\x15\n" +
 	"\x11ACTION_TYPE_BLOCK\x10\x02\x12\x14\n" +
 	"\x10ACTION_TYPE_MOCK\x10\x03\x12 \n" +
-	"\x1cACTION_TYPE_REQUIRE_APPROVAL\x10\x04*f\n" +
+	"\x1cACTION_TYPE_REQUIRE_APPROVAL\x10\x04\x12\x1b\n" +
+	"\x17ACTION_TYPE_ALLOW_ALERT\x10\x05*f\n" +
 	"\x0eFallbackAction\x12\x1f\n" +
 	"\x1bFALLBACK_ACTION_UNSPECIFIED\x10\x00\x12\x19\n" +
 	"\x15FALLBACK_ACTION_CLOSE\x10\x01\x12\x18\n" +
-	"\x14FALLBACK_ACTION_MOCK\x10\x02*\xe0\x02\n" +
+	"\x14FALLBACK_ACTION_MOCK\x10\x02*\xb8\x06\n" +
 	"\n" +
 	"MockPreset\x12\x1b\n" +
 	"\x17MOCK_PRESET_UNSPECIFIED\x10\x00\x12\x1a\n" +
@@ -1237,7 +1449,22 @@ const file_common_common_proto_rawDesc = "" +
 	"\x16MOCK_PRESET_RDP_SECURE\x10\t\x12\x1d\n" +
 	"\x19MOCK_PRESET_TELNET_SECURE\x10\n" +
 	"\x12\x1a\n" +
-	"\x16MOCK_PRESET_RAW_TARPIT\x10\v*\x95\x03\n" +
+	"\x16MOCK_PRESET_RAW_TARPIT\x10\v\x12\x1f\n" +
+	"\x1bMOCK_PRESET_POSTGRES_SECURE\x10\f\x12\x1f\n" +
+	"\x1bMOCK_PRESET_POSTGRES_TARPIT\x10\r\x12\x1e\n" +
+	"\x1aMOCK_PRESET_MONGODB_SECURE\x10\x0e\x12\x1e\n" +
+	"\x1aMOCK_PRESET_MONGODB_TARPIT\x10\x0f\x12$\n" +
+	" MOCK_PRESET_ELASTICSEARCH_SECURE\x10\x10\x12$\n" +
+	" MOCK_PRESET_ELASTICSEARCH_TARPIT\x10\x11\x12 \n" +
+	"\x1cMOCK_PRESET_MEMCACHED_SECURE\x10\x12\x12 \n" +
+	"\x1cMOCK_PRESET_MEMCACHED_TARPIT\x10\x13\x12\x1a\n" +
+	"\x16MOCK_PRESET_FTP_SECURE\x10\x14\x12\x1a\n" +
+	"\x16MOCK_PRESET_FTP_TARPIT\x10\x15\x12\x1a\n" +
+	"\x16MOCK_PRESET_VNC_SECURE\x10\x16\x12\x1a\n" +
+	"\x16MOCK_PRESET_VNC_TARPIT\x10\x17\x12\x1a\n" +
+	"\x16MOCK_PRESET_SMB_SECURE\x10\x18\x12\x1b\n" +
+	"\x17MOCK_PRESET_LDAP_SECURE\x10\x19\x12\x1b\n" +
+	"\x17MOCK_PRESET_LDAP_TARPIT\x10\x1a*\x95\x03\n" +
 	"\rConditionType\x12\x1e\n" +
 	"\x1aCONDITION_TYPE_UNSPECIFIED\x10\x00\x12\x1c\n" +
 	"\x18CONDITION_TYPE_SOURCE_IP\x10\x01\x12\x1e\n" +
@@ -1302,7 +1529,7 @@ func file_common_common_proto_rawDescGZIP() []byte {
 }
 
 var file_common_common_proto_enumTypes = make([]protoimpl.EnumInfo, 11)
-var file_common_common_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_common_common_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
 var file_common_common_proto_goTypes = []any{
 	(ActionType)(0),              // 0: nitella.ActionType
 	(FallbackAction)(0),          // 1: nitella.FallbackAction
@@ -1319,18 +1546,22 @@ var file_common_common_proto_goTypes = []any{
 	(*SecureCommandPayload)(nil), // 12: nitella.SecureCommandPayload
 	(*Alert)(nil),                // 13: nitella.Alert
 	(*AlertDetails)(nil),         // 14: nitella.AlertDetails
-	(*GeoInfo)(nil),              // 15: nitella.GeoInfo
-	nil,                          // 16: nitella.Alert.MetadataEntry
+	(*ApprovalContext)(nil),      // 15: nitella.ApprovalContext
+	(*GeoInfo)(nil),              // 16: nitella.GeoInfo
+	nil,                          // 17: nitella.Alert.MetadataEntry
+	nil,                          // 18: nitella.AlertDetails.FieldsEntry
 }
 var file_common_common_proto_depIdxs = []int32{
 	10, // 0: nitella.EncryptedPayload.algorithm:type_name -> nitella.CryptoAlgorithm
 	11, // 1: nitella.Alert.encrypted:type_name -> nitella.EncryptedPayload
-	16, // 2: nitella.Alert.metadata:type_name -> nitella.Alert.MetadataEntry
-	3,  // [3:3] is the sub-list for method output_type
-	3,  // [3:3] is the sub-list for method input_type
-	3,  // [3:3] is the sub-list for extension type_name
-	3,  // [3:3] is the sub-list for extension extendee
-	0,  // [0:3] is the sub-list for field type_name
+	17, // 2: nitella.Alert.metadata:type_name -> nitella.Alert.MetadataEntry
+	18, // 3: nitella.AlertDetails.fields:type_name -> nitella.AlertDetails.FieldsEntry
+	15, // 4: nitella.AlertDetails.context:type_name -> nitella.ApprovalContext
+	5,  // [5:5] is the sub-list for method output_type
+	5,  // [5:5] is the sub-list for method input_type
+	5,  // [5:5] is the sub-list for extension type_name
+	5,  // [5:5] is the sub-list for extension extendee
+	0,  // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_common_common_proto_init() }
@@ -1344,7 +1575,7 @@ func file_common_common_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_common_common_proto_rawDesc), len(file_common_common_proto_rawDesc)),
 			NumEnums:      11,
-			NumMessages:   6,
+			NumMessages:   8,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -171,6 +171,10 @@ const (
 	// Approval Management (Direct gRPC SecureCommand)
 	CommandType_COMMAND_TYPE_LIST_ACTIVE_APPROVALS CommandType = 70
 	CommandType_COMMAND_TYPE_CANCEL_APPROVAL       CommandType = 71
+	// Honeypot (Direct gRPC SecureCommand)
+	CommandType_COMMAND_TYPE_GET_MOCK_TRANSCRIPTS CommandType = 80
+	CommandType_COMMAND_TYPE_CLONE_BANNER         CommandType = 81 // Clone (or refresh) a backend banner as a mock preset
+	CommandType_COMMAND_TYPE_LIST_CLONED_PRESETS  CommandType = 82
 )
 
 // Enum value maps for CommandType.
@@ -208,6 +212,9 @@ var (
 		62: "COMMAND_TYPE_LOOKUP_IP",
 		70: "COMMAND_TYPE_LIST_ACTIVE_APPROVALS",
 		71: "COMMAND_TYPE_CANCEL_APPROVAL",
+		80: "COMMAND_TYPE_GET_MOCK_TRANSCRIPTS",
+		81: "COMMAND_TYPE_CLONE_BANNER",
+		82: "COMMAND_TYPE_LIST_CLONED_PRESETS",
 	}
 	CommandType_value = map[string]int32{
 		"COMMAND_TYPE_UNSPECIFIED":            0,
@@ -242,6 +249,9 @@ var (
 		"COMMAND_TYPE_LOOKUP_IP":              62,
 		"COMMAND_TYPE_LIST_ACTIVE_APPROVALS":  70,
 		"COMMAND_TYPE_CANCEL_APPROVAL":        71,
+		"COMMAND_TYPE_GET_MOCK_TRANSCRIPTS":   80,
+		"COMMAND_TYPE_CLONE_BANNER":           81,
+		"COMMAND_TYPE_LIST_CLONED_PRESETS":    82,
 	}
 )
 
@@ -1439,7 +1449,7 @@ const file_hub_hub_common_proto_rawDesc = "" +
 	"\x13NODE_STATUS_OFFLINE\x10\x01\x12\x16\n" +
 	"\x12NODE_STATUS_ONLINE\x10\x02\x12\x17\n" +
 	"\x13NODE_STATUS_BLOCKED\x10\x03\x12\x1a\n" +
-	"\x16NODE_STATUS_CONNECTING\x10\x04*\x98\b\n" +
+	"\x16NODE_STATUS_CONNECTING\x10\x04*\x84\t\n" +
 	"\vCommandType\x12\x1c\n" +
 	"\x18COMMAND_TYPE_UNSPECIFIED\x10\x00\x12\x19\n" +
 	"\x15COMMAND_TYPE_ADD_RULE\x10\x02\x12\x1c\n" +
@@ -1473,7 +1483,10 @@ const file_hub_hub_common_proto_rawDesc = "" +
 	"\x1dCOMMAND_TYPE_GET_GEOIP_STATUS\x10=\x12\x1a\n" +
 	"\x16COMMAND_TYPE_LOOKUP_IP\x10>\x12&\n" +
 	"\"COMMAND_TYPE_LIST_ACTIVE_APPROVALS\x10F\x12 \n" +
-	"\x1cCOMMAND_TYPE_CANCEL_APPROVAL\x10G\"\x04\b\x01\x10\x01B(Z&github.com/ivere27/nitella/pkg/api/hubb\x06proto3"
+	"\x1cCOMMAND_TYPE_CANCEL_APPROVAL\x10G\x12%\n" +
+	"!COMMAND_TYPE_GET_MOCK_TRANSCRIPTS\x10P\x12\x1d\n" +
+	"\x19COMMAND_TYPE_CLONE_BANNER\x10Q\x12$\n" +
+	" COMMAND_TYPE_LIST_CLONED_PRESETS\x10R\"\x04\b\x01\x10\x01B(Z&github.com/ivere27/nitella/pkg/api/hubb\x06proto3"
 
 var (
 	file_hub_hub_common_proto_rawDescOnce sync.Once
@@ -645,7 +645,7 @@ func (x ConnectionEvent_EventType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ConnectionEvent_EventType.Descriptor instead.
 func (ConnectionEvent_EventType) EnumDescriptor() ([]byte, []int) {
-	return file_local_nitella_local_proto_rawDescGZIP(), []int{92, 0}
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{97, 0}
 }
 
 type OnboardHubResponse_Stage int32
@@ -697,7 +697,7 @@ func (x OnboardHubResponse_Stage) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use OnboardHubResponse_Stage.Descriptor instead.
 func (OnboardHubResponse_Stage) EnumDescriptor() ([]byte, []int) {
-	return file_local_nitella_local_proto_rawDescGZIP(), []int{148, 0}
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{153, 0}
 }
 
 type InitializeRequest struct {
@@ -905,16 +905,17 @@ func (x *BootstrapStateResponse) GetRequireBiometric() bool {
 }
 
 type IdentityInfo struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Exists        bool                   `protobuf:"varint,1,opt,name=exists,proto3" json:"exists,omitempty"`
-	Locked        bool                   `protobuf:"varint,2,opt,name=locked,proto3" json:"locked,omitempty"`
-	Fingerprint   string                 `protobuf:"bytes,3,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"`                      // SHA256 fingerprint of root cert
-	EmojiHash     string                 `protobuf:"bytes,4,opt,name=emoji_hash,json=emojiHash,proto3" json:"emoji_hash,omitempty"`         // Visual emoji representation
-	RootCertPem   string                 `protobuf:"bytes,5,opt,name=root_cert_pem,json=rootCertPem,proto3" json:"root_cert_pem,omitempty"` // Root CA certificate (public)
-	CreatedAt     *timestamp.Timestamp   `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	PairedNodes   int32                  `protobuf:"varint,7,opt,name=paired_nodes,json=pairedNodes,proto3" json:"paired_nodes,omitempty"` // Number of paired nodes
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Exists               bool                   `protobuf:"varint,1,opt,name=exists,proto3" json:"exists,omitempty"`
+	Locked               bool                   `protobuf:"varint,2,opt,name=locked,proto3" json:"locked,omitempty"`
+	Fingerprint          string                 `protobuf:"bytes,3,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"`                      // SHA256 fingerprint of root cert
+	EmojiHash            string                 `protobuf:"bytes,4,opt,name=emoji_hash,json=emojiHash,proto3" json:"emoji_hash,omitempty"`         // Visual emoji representation
+	RootCertPem          string                 `protobuf:"bytes,5,opt,name=root_cert_pem,json=rootCertPem,proto3" json:"root_cert_pem,omitempty"` // Root CA certificate (public)
+	CreatedAt            *timestamp.Timestamp   `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	PairedNodes          int32                  `protobuf:"varint,7,opt,name=paired_nodes,json=pairedNodes,proto3" json:"paired_nodes,omitempty"`                               // Number of paired nodes
+	ApproverPublicKeyPem string                 `protobuf:"bytes,8,opt,name=approver_public_key_pem,json=approverPublicKeyPem,proto3" json:"approver_public_key_pem,omitempty"` // This device's approver key, for nodes' --approver-keys
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
 }
 
 func (x *IdentityInfo) Reset() {
@@ -996,6 +997,13 @@ func (x *IdentityInfo) GetPairedNodes() int32 {
 	return 0
 }
 
+func (x *IdentityInfo) GetApproverPublicKeyPem() string {
+	if x != nil {
+		return x.ApproverPublicKeyPem
+	}
+	return ""
+}
+
 type CreateIdentityRequest struct {
 	state               protoimpl.MessageState `protogen:"open.v1"`
 	Passphrase          string                 `protobuf:"bytes,1,opt,name=passphrase,proto3" json:"passphrase,omitempty"`                                                 // Optional passphrase to encrypt key
@@ -5004,21 +5012,26 @@ func (x *RemoveGlobalRuleResponse) GetError() string {
 }
 
 type ApprovalRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	RequestId      string                 `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
-	NodeId         string                 `protobuf:"bytes,2,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
-	NodeName       string                 `protobuf:"bytes,3,opt,name=node_name,json=nodeName,proto3" json:"node_name,omitempty"`
-	ProxyId        string                 `protobuf:"bytes,4,opt,name=proxy_id,json=proxyId,proto3" json:"proxy_id,omitempty"`
-	ProxyName      string                 `protobuf:"bytes,5,opt,name=proxy_name,json=proxyName,proto3" json:"proxy_name,omitempty"`
-	SourceIp       string                 `protobuf:"bytes,6,opt,name=source_ip,json=sourceIp,proto3" json:"source_ip,omitempty"`
-	SourcePort     int32                  `protobuf:"varint,7,opt,name=source_port,json=sourcePort,proto3" json:"source_port,omitempty"`
-	DestAddr       string                 `protobuf:"bytes,8,opt,name=dest_addr,json=destAddr,proto3" json:"dest_addr,omitempty"`
-	RuleId         string                 `protobuf:"bytes,9,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
-	RuleName       string                 `protobuf:"bytes,10,opt,name=rule_name,json=ruleName,proto3" json:"rule_name,omitempty"`
-	Geo            *common.GeoInfo        `protobuf:"bytes,11,opt,name=geo,proto3" json:"geo,omitempty"`
-	Timestamp      *timestamp.Timestamp   `protobuf:"bytes,12,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	TlsCn          string                 `protobuf:"bytes,13,opt,name=tls_cn,json=tlsCn,proto3" json:"tls_cn,omitempty"`                            // TLS Common Name (if present)
-	TlsFingerprint string                 `protobuf:"bytes,14,opt,name=tls_fingerprint,json=tlsFingerprint,proto3" json:"tls_fingerprint,omitempty"` // TLS fingerprint (if present)
+	state          protoimpl.MessageState  `protogen:"open.v1"`
+	RequestId      string                  `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	NodeId         string                  `protobuf:"bytes,2,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	NodeName       string                  `protobuf:"bytes,3,opt,name=node_name,json=nodeName,proto3" json:"node_name,omitempty"`
+	ProxyId        string                  `protobuf:"bytes,4,opt,name=proxy_id,json=proxyId,proto3" json:"proxy_id,omitempty"`
+	ProxyName      string                  `protobuf:"bytes,5,opt,name=proxy_name,json=proxyName,proto3" json:"proxy_name,omitempty"`
+	SourceIp       string                  `protobuf:"bytes,6,opt,name=source_ip,json=sourceIp,proto3" json:"source_ip,omitempty"`
+	SourcePort     int32                   `protobuf:"varint,7,opt,name=source_port,json=sourcePort,proto3" json:"source_port,omitempty"`
+	DestAddr       string                  `protobuf:"bytes,8,opt,name=dest_addr,json=destAddr,proto3" json:"dest_addr,omitempty"`
+	RuleId         string                  `protobuf:"bytes,9,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	RuleName       string                  `protobuf:"bytes,10,opt,name=rule_name,json=ruleName,proto3" json:"rule_name,omitempty"`
+	Geo            *common.GeoInfo         `protobuf:"bytes,11,opt,name=geo,proto3" json:"geo,omitempty"`
+	Timestamp      *timestamp.Timestamp    `protobuf:"bytes,12,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	TlsCn          string                  `protobuf:"bytes,13,opt,name=tls_cn,json=tlsCn,proto3" json:"tls_cn,omitempty"`                            // TLS Common Name (if present)
+	TlsFingerprint string                  `protobuf:"bytes,14,opt,name=tls_fingerprint,json=tlsFingerprint,proto3" json:"tls_fingerprint,omitempty"` // TLS fingerprint (if present)
+	Approvals      int32                   `protobuf:"varint,15,opt,name=approvals,proto3" json:"approvals,omitempty"`                                // Allow votes so far (quorum rules)
+	Quorum         int32                   `protobuf:"varint,16,opt,name=quorum,proto3" json:"quorum,omitempty"`                                      // Allow votes needed (0 = any single decision)
+	Context        *common.ApprovalContext `protobuf:"bytes,17,opt,name=context,proto3" json:"context,omitempty"`                                     // Source history, reverse DNS, IP set hits
+	Resolved       bool                    `protobuf:"varint,18,opt,name=resolved,proto3" json:"resolved,omitempty"`                                  // No longer pending (e.g. decided on another device); dismiss the prompt
+	Resolution     string                  `protobuf:"bytes,19,opt,name=resolution,proto3" json:"resolution,omitempty"`                               // How it ended: allow, deny, expired or withdrawn
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -5151,6 +5164,41 @@ func (x *ApprovalRequest) GetTlsFingerprint() string {
 	return ""
 }
 
+func (x *ApprovalRequest) GetApprovals() int32 {
+	if x != nil {
+		return x.Approvals
+	}
+	return 0
+}
+
+func (x *ApprovalRequest) GetQuorum() int32 {
+	if x != nil {
+		return x.Quorum
+	}
+	return 0
+}
+
+func (x *ApprovalRequest) GetContext() *common.ApprovalContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *ApprovalRequest) GetResolved() bool {
+	if x != nil {
+		return x.Resolved
+	}
+	return false
+}
+
+func (x *ApprovalRequest) GetResolution() string {
+	if x != nil {
+		return x.Resolution
+	}
+	return ""
+}
+
 type ListPendingApprovalsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	NodeId        string                 `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"` // Optional: filter by node
@@ -5938,6 +5986,7 @@ type ApprovalHistoryEntry struct {
 	BlockType       DenyBlockType          `protobuf:"varint,11,opt,name=block_type,json=blockType,proto3,enum=nitella.local.DenyBlockType" json:"block_type,omitempty"`
 	RuleId          string                 `protobuf:"bytes,12,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
 	DecidedAt       *timestamp.Timestamp   `protobuf:"bytes,13,opt,name=decided_at,json=decidedAt,proto3" json:"decided_at,omitempty"`
+	TlsCn           string                 `protobuf:"bytes,14,opt,name=tls_cn,json=tlsCn,proto3" json:"tls_cn,omitempty"` // TLS Common Name (if present)
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
@@ -6063,6 +6112,13 @@ func (x *ApprovalHistoryEntry) GetDecidedAt() *timestamp.Timestamp {
 	return nil
 }
 
+func (x *ApprovalHistoryEntry) GetTlsCn() string {
+	if x != nil {
+		return x.TlsCn
+	}
+	return ""
+}
+
 type ListApprovalHistoryRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	NodeId        string                 `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"` // Optional: filter by node
@@ -6271,36 +6327,28 @@ func (x *ClearApprovalHistoryResponse) GetDeletedCount() int32 {
 	return 0
 }
 
-type ConnectionStats struct {
-	state                          protoimpl.MessageState `protogen:"open.v1"`
-	ActiveConnections              int64                  `protobuf:"varint,1,opt,name=active_connections,json=activeConnections,proto3" json:"active_connections,omitempty"`
-	TotalConnections               int64                  `protobuf:"varint,2,opt,name=total_connections,json=totalConnections,proto3" json:"total_connections,omitempty"`
-	BytesIn                        int64                  `protobuf:"varint,3,opt,name=bytes_in,json=bytesIn,proto3" json:"bytes_in,omitempty"`
-	BytesOut                       int64                  `protobuf:"varint,4,opt,name=bytes_out,json=bytesOut,proto3" json:"bytes_out,omitempty"`
-	BlockedTotal                   int64                  `protobuf:"varint,5,opt,name=blocked_total,json=blockedTotal,proto3" json:"blocked_total,omitempty"`
-	AllowedTotal                   int64                  `protobuf:"varint,6,opt,name=allowed_total,json=allowedTotal,proto3" json:"allowed_total,omitempty"`
-	UniqueIps                      int64                  `protobuf:"varint,7,opt,name=unique_ips,json=uniqueIps,proto3" json:"unique_ips,omitempty"`
-	UniqueCountries                int64                  `protobuf:"varint,8,opt,name=unique_countries,json=uniqueCountries,proto3" json:"unique_countries,omitempty"`
-	PendingApprovals               int64                  `protobuf:"varint,9,opt,name=pending_approvals,json=pendingApprovals,proto3" json:"pending_approvals,omitempty"`
-	RecommendedPollIntervalSeconds int32                  `protobuf:"varint,10,opt,name=recommended_poll_interval_seconds,json=recommendedPollIntervalSeconds,proto3" json:"recommended_poll_interval_seconds,omitempty"`
-	unknownFields                  protoimpl.UnknownFields
-	sizeCache                      protoimpl.SizeCache
+type ListRuleProposalsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NodeId        string                 `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`                    // Optional: filter by node
+	MinDecisions  int32                  `protobuf:"varint,2,opt,name=min_decisions,json=minDecisions,proto3" json:"min_decisions,omitempty"` // Decisions a pattern needs (0 = backend default)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ConnectionStats) Reset() {
-	*x = ConnectionStats{}
+func (x *ListRuleProposalsRequest) Reset() {
+	*x = ListRuleProposalsRequest{}
 	mi := &file_local_nitella_local_proto_msgTypes[80]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ConnectionStats) String() string {
+func (x *ListRuleProposalsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ConnectionStats) ProtoMessage() {}
+func (*ListRuleProposalsRequest) ProtoMessage() {}
 
-func (x *ConnectionStats) ProtoReflect() protoreflect.Message {
+func (x *ListRuleProposalsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_local_nitella_local_proto_msgTypes[80]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6312,104 +6360,141 @@ func (x *ConnectionStats) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ConnectionStats.ProtoReflect.Descriptor instead.
-func (*ConnectionStats) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListRuleProposalsRequest.ProtoReflect.Descriptor instead.
+func (*ListRuleProposalsRequest) Descriptor() ([]byte, []int) {
 	return file_local_nitella_local_proto_rawDescGZIP(), []int{80}
 }
 
-func (x *ConnectionStats) GetActiveConnections() int64 {
+func (x *ListRuleProposalsRequest) GetNodeId() string {
 	if x != nil {
-		return x.ActiveConnections
+		return x.NodeId
 	}
-	return 0
+	return ""
 }
 
-func (x *ConnectionStats) GetTotalConnections() int64 {
+func (x *ListRuleProposalsRequest) GetMinDecisions() int32 {
 	if x != nil {
-		return x.TotalConnections
+		return x.MinDecisions
 	}
 	return 0
 }
 
-func (x *ConnectionStats) GetBytesIn() int64 {
+// RuleProposal is a permanent rule covering decisions the user keeps making
+// by hand, learned from the approval history.
+type RuleProposal struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	ProposalId    string                  `protobuf:"bytes,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"` // Stable while the history supports the proposal
+	NodeId        string                  `protobuf:"bytes,2,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	ProxyId       string                  `protobuf:"bytes,3,opt,name=proxy_id,json=proxyId,proto3" json:"proxy_id,omitempty"` // Empty = every proxy on the node
+	Rule          *proxy.Rule             `protobuf:"bytes,4,opt,name=rule,proto3" json:"rule,omitempty"`                      // The rule AcceptRuleProposal creates
+	Summary       string                  `protobuf:"bytes,5,opt,name=summary,proto3" json:"summary,omitempty"`                // e.g. "Approved 14 requests from ISP Korea Telecom (AS4766), Mon-Fri 09:00-18:00"
+	DecisionCount int32                   `protobuf:"varint,6,opt,name=decision_count,json=decisionCount,proto3" json:"decision_count,omitempty"`
+	Matched       []*ApprovalHistoryEntry `protobuf:"bytes,7,rep,name=matched,proto3" json:"matched,omitempty"` // Preview: the past decisions the rule would have matched
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RuleProposal) Reset() {
+	*x = RuleProposal{}
+	mi := &file_local_nitella_local_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RuleProposal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RuleProposal) ProtoMessage() {}
+
+func (x *RuleProposal) ProtoReflect() protoreflect.Message {
+	mi := &file_local_nitella_local_proto_msgTypes[81]
 	if x != nil {
-		return x.BytesIn
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *ConnectionStats) GetBytesOut() int64 {
+// Deprecated: Use RuleProposal.ProtoReflect.Descriptor instead.
+func (*RuleProposal) Descriptor() ([]byte, []int) {
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *RuleProposal) GetProposalId() string {
 	if x != nil {
-		return x.BytesOut
+		return x.ProposalId
 	}
-	return 0
+	return ""
 }
 
-func (x *ConnectionStats) GetBlockedTotal() int64 {
+func (x *RuleProposal) GetNodeId() string {
 	if x != nil {
-		return x.BlockedTotal
+		return x.NodeId
 	}
-	return 0
+	return ""
 }
 
-func (x *ConnectionStats) GetAllowedTotal() int64 {
+func (x *RuleProposal) GetProxyId() string {
 	if x != nil {
-		return x.AllowedTotal
+		return x.ProxyId
 	}
-	return 0
+	return ""
 }
 
-func (x *ConnectionStats) GetUniqueIps() int64 {
+func (x *RuleProposal) GetRule() *proxy.Rule {
 	if x != nil {
-		return x.UniqueIps
+		return x.Rule
 	}
-	return 0
+	return nil
 }
 
-func (x *ConnectionStats) GetUniqueCountries() int64 {
+func (x *RuleProposal) GetSummary() string {
 	if x != nil {
-		return x.UniqueCountries
+		return x.Summary
 	}
-	return 0
+	return ""
 }
 
-func (x *ConnectionStats) GetPendingApprovals() int64 {
+func (x *RuleProposal) GetDecisionCount() int32 {
 	if x != nil {
-		return x.PendingApprovals
+		return x.DecisionCount
 	}
 	return 0
 }
 
-func (x *ConnectionStats) GetRecommendedPollIntervalSeconds() int32 {
+func (x *RuleProposal) GetMatched() []*ApprovalHistoryEntry {
 	if x != nil {
-		return x.RecommendedPollIntervalSeconds
+		return x.Matched
 	}
-	return 0
+	return nil
 }
 
-type GetConnectionStatsRequest struct {
+type ListRuleProposalsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	NodeId        string                 `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`    // Optional: specific node
-	ProxyId       string                 `protobuf:"bytes,2,opt,name=proxy_id,json=proxyId,proto3" json:"proxy_id,omitempty"` // Optional: specific proxy
+	Proposals     []*RuleProposal        `protobuf:"bytes,1,rep,name=proposals,proto3" json:"proposals,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetConnectionStatsRequest) Reset() {
-	*x = GetConnectionStatsRequest{}
-	mi := &file_local_nitella_local_proto_msgTypes[81]
+func (x *ListRuleProposalsResponse) Reset() {
+	*x = ListRuleProposalsResponse{}
+	mi := &file_local_nitella_local_proto_msgTypes[82]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetConnectionStatsRequest) String() string {
+func (x *ListRuleProposalsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetConnectionStatsRequest) ProtoMessage() {}
+func (*ListRuleProposalsResponse) ProtoMessage() {}
 
-func (x *GetConnectionStatsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_local_nitella_local_proto_msgTypes[81]
+func (x *ListRuleProposalsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_local_nitella_local_proto_msgTypes[82]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6420,58 +6505,42 @@ func (x *GetConnectionStatsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetConnectionStatsRequest.ProtoReflect.Descriptor instead.
-func (*GetConnectionStatsRequest) Descriptor() ([]byte, []int) {
-	return file_local_nitella_local_proto_rawDescGZIP(), []int{81}
-}
-
-func (x *GetConnectionStatsRequest) GetNodeId() string {
-	if x != nil {
-		return x.NodeId
-	}
-	return ""
+// Deprecated: Use ListRuleProposalsResponse.ProtoReflect.Descriptor instead.
+func (*ListRuleProposalsResponse) Descriptor() ([]byte, []int) {
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{82}
 }
 
-func (x *GetConnectionStatsRequest) GetProxyId() string {
+func (x *ListRuleProposalsResponse) GetProposals() []*RuleProposal {
 	if x != nil {
-		return x.ProxyId
+		return x.Proposals
 	}
-	return ""
+	return nil
 }
 
-type ConnectionInfo struct {
+type AcceptRuleProposalRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ConnId        string                 `protobuf:"bytes,1,opt,name=conn_id,json=connId,proto3" json:"conn_id,omitempty"`
-	NodeId        string                 `protobuf:"bytes,2,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
-	ProxyId       string                 `protobuf:"bytes,3,opt,name=proxy_id,json=proxyId,proto3" json:"proxy_id,omitempty"`
-	SourceIp      string                 `protobuf:"bytes,4,opt,name=source_ip,json=sourceIp,proto3" json:"source_ip,omitempty"`
-	SourcePort    int32                  `protobuf:"varint,5,opt,name=source_port,json=sourcePort,proto3" json:"source_port,omitempty"`
-	DestAddr      string                 `protobuf:"bytes,6,opt,name=dest_addr,json=destAddr,proto3" json:"dest_addr,omitempty"`
-	StartTime     *timestamp.Timestamp   `protobuf:"bytes,7,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
-	BytesIn       int64                  `protobuf:"varint,8,opt,name=bytes_in,json=bytesIn,proto3" json:"bytes_in,omitempty"`
-	BytesOut      int64                  `protobuf:"varint,9,opt,name=bytes_out,json=bytesOut,proto3" json:"bytes_out,omitempty"`
-	Geo           *common.GeoInfo        `protobuf:"bytes,10,opt,name=geo,proto3" json:"geo,omitempty"`
-	RuleMatched   string                 `protobuf:"bytes,11,opt,name=rule_matched,json=ruleMatched,proto3" json:"rule_matched,omitempty"`
-	Action        common.ActionType      `protobuf:"varint,12,opt,name=action,proto3,enum=nitella.ActionType" json:"action,omitempty"`
+	ProposalId    string                 `protobuf:"bytes,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	NodeId        string                 `protobuf:"bytes,2,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`                    // Optional: narrows the lookup
+	MinDecisions  int32                  `protobuf:"varint,3,opt,name=min_decisions,json=minDecisions,proto3" json:"min_decisions,omitempty"` // As passed to ListRuleProposals
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ConnectionInfo) Reset() {
-	*x = ConnectionInfo{}
-	mi := &file_local_nitella_local_proto_msgTypes[82]
+func (x *AcceptRuleProposalRequest) Reset() {
+	*x = AcceptRuleProposalRequest{}
+	mi := &file_local_nitella_local_proto_msgTypes[83]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ConnectionInfo) String() string {
+func (x *AcceptRuleProposalRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ConnectionInfo) ProtoMessage() {}
+func (*AcceptRuleProposalRequest) ProtoMessage() {}
 
-func (x *ConnectionInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_local_nitella_local_proto_msgTypes[82]
+func (x *AcceptRuleProposalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_local_nitella_local_proto_msgTypes[83]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6482,101 +6551,406 @@ func (x *ConnectionInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ConnectionInfo.ProtoReflect.Descriptor instead.
-func (*ConnectionInfo) Descriptor() ([]byte, []int) {
-	return file_local_nitella_local_proto_rawDescGZIP(), []int{82}
+// Deprecated: Use AcceptRuleProposalRequest.ProtoReflect.Descriptor instead.
+func (*AcceptRuleProposalRequest) Descriptor() ([]byte, []int) {
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{83}
 }
 
-func (x *ConnectionInfo) GetConnId() string {
+func (x *AcceptRuleProposalRequest) GetProposalId() string {
 	if x != nil {
-		return x.ConnId
+		return x.ProposalId
 	}
 	return ""
 }
 
-func (x *ConnectionInfo) GetNodeId() string {
+func (x *AcceptRuleProposalRequest) GetNodeId() string {
 	if x != nil {
 		return x.NodeId
 	}
 	return ""
 }
 
-func (x *ConnectionInfo) GetProxyId() string {
+func (x *AcceptRuleProposalRequest) GetMinDecisions() int32 {
 	if x != nil {
-		return x.ProxyId
+		return x.MinDecisions
 	}
-	return ""
+	return 0
 }
 
-func (x *ConnectionInfo) GetSourceIp() string {
-	if x != nil {
-		return x.SourceIp
-	}
-	return ""
+type AcceptRuleProposalResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	RuleId        string                 `protobuf:"bytes,3,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"` // First rule created
+	RulesCreated  int32                  `protobuf:"varint,4,opt,name=rules_created,json=rulesCreated,proto3" json:"rules_created,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ConnectionInfo) GetSourcePort() int32 {
-	if x != nil {
-		return x.SourcePort
-	}
-	return 0
+func (x *AcceptRuleProposalResponse) Reset() {
+	*x = AcceptRuleProposalResponse{}
+	mi := &file_local_nitella_local_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *ConnectionInfo) GetDestAddr() string {
-	if x != nil {
-		return x.DestAddr
-	}
-	return ""
+func (x *AcceptRuleProposalResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *ConnectionInfo) GetStartTime() *timestamp.Timestamp {
+func (*AcceptRuleProposalResponse) ProtoMessage() {}
+
+func (x *AcceptRuleProposalResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_local_nitella_local_proto_msgTypes[84]
 	if x != nil {
-		return x.StartTime
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *ConnectionInfo) GetBytesIn() int64 {
-	if x != nil {
-		return x.BytesIn
-	}
-	return 0
+// Deprecated: Use AcceptRuleProposalResponse.ProtoReflect.Descriptor instead.
+func (*AcceptRuleProposalResponse) Descriptor() ([]byte, []int) {
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{84}
 }
 
-func (x *ConnectionInfo) GetBytesOut() int64 {
+func (x *AcceptRuleProposalResponse) GetSuccess() bool {
 	if x != nil {
-		return x.BytesOut
+		return x.Success
 	}
-	return 0
+	return false
 }
 
-func (x *ConnectionInfo) GetGeo() *common.GeoInfo {
+func (x *AcceptRuleProposalResponse) GetError() string {
 	if x != nil {
-		return x.Geo
+		return x.Error
 	}
-	return nil
+	return ""
 }
 
-func (x *ConnectionInfo) GetRuleMatched() string {
+func (x *AcceptRuleProposalResponse) GetRuleId() string {
 	if x != nil {
-		return x.RuleMatched
+		return x.RuleId
 	}
 	return ""
 }
 
-func (x *ConnectionInfo) GetAction() common.ActionType {
+func (x *AcceptRuleProposalResponse) GetRulesCreated() int32 {
 	if x != nil {
-		return x.Action
+		return x.RulesCreated
 	}
-	return common.ActionType(0)
+	return 0
 }
 
-type ListConnectionsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	NodeId        string                 `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
-	ProxyId       string                 `protobuf:"bytes,2,opt,name=proxy_id,json=proxyId,proto3" json:"proxy_id,omitempty"`           // Optional: filter by proxy
-	ActiveOnly    bool                   `protobuf:"varint,3,opt,name=active_only,json=activeOnly,proto3" json:"active_only,omitempty"` // Only show active connections
-	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+type ConnectionStats struct {
+	state                          protoimpl.MessageState `protogen:"open.v1"`
+	ActiveConnections              int64                  `protobuf:"varint,1,opt,name=active_connections,json=activeConnections,proto3" json:"active_connections,omitempty"`
+	TotalConnections               int64                  `protobuf:"varint,2,opt,name=total_connections,json=totalConnections,proto3" json:"total_connections,omitempty"`
+	BytesIn                        int64                  `protobuf:"varint,3,opt,name=bytes_in,json=bytesIn,proto3" json:"bytes_in,omitempty"`
+	BytesOut                       int64                  `protobuf:"varint,4,opt,name=bytes_out,json=bytesOut,proto3" json:"bytes_out,omitempty"`
+	BlockedTotal                   int64                  `protobuf:"varint,5,opt,name=blocked_total,json=blockedTotal,proto3" json:"blocked_total,omitempty"`
+	AllowedTotal                   int64                  `protobuf:"varint,6,opt,name=allowed_total,json=allowedTotal,proto3" json:"allowed_total,omitempty"`
+	UniqueIps                      int64                  `protobuf:"varint,7,opt,name=unique_ips,json=uniqueIps,proto3" json:"unique_ips,omitempty"`
+	UniqueCountries                int64                  `protobuf:"varint,8,opt,name=unique_countries,json=uniqueCountries,proto3" json:"unique_countries,omitempty"`
+	PendingApprovals               int64                  `protobuf:"varint,9,opt,name=pending_approvals,json=pendingApprovals,proto3" json:"pending_approvals,omitempty"`
+	RecommendedPollIntervalSeconds int32                  `protobuf:"varint,10,opt,name=recommended_poll_interval_seconds,json=recommendedPollIntervalSeconds,proto3" json:"recommended_poll_interval_seconds,omitempty"`
+	unknownFields                  protoimpl.UnknownFields
+	sizeCache                      protoimpl.SizeCache
+}
+
+func (x *ConnectionStats) Reset() {
+	*x = ConnectionStats{}
+	mi := &file_local_nitella_local_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConnectionStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConnectionStats) ProtoMessage() {}
+
+func (x *ConnectionStats) ProtoReflect() protoreflect.Message {
+	mi := &file_local_nitella_local_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConnectionStats.ProtoReflect.Descriptor instead.
+func (*ConnectionStats) Descriptor() ([]byte, []int) {
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *ConnectionStats) GetActiveConnections() int64 {
+	if x != nil {
+		return x.ActiveConnections
+	}
+	return 0
+}
+
+func (x *ConnectionStats) GetTotalConnections() int64 {
+	if x != nil {
+		return x.TotalConnections
+	}
+	return 0
+}
+
+func (x *ConnectionStats) GetBytesIn() int64 {
+	if x != nil {
+		return x.BytesIn
+	}
+	return 0
+}
+
+func (x *ConnectionStats) GetBytesOut() int64 {
+	if x != nil {
+		return x.BytesOut
+	}
+	return 0
+}
+
+func (x *ConnectionStats) GetBlockedTotal() int64 {
+	if x != nil {
+		return x.BlockedTotal
+	}
+	return 0
+}
+
+func (x *ConnectionStats) GetAllowedTotal() int64 {
+	if x != nil {
+		return x.AllowedTotal
+	}
+	return 0
+}
+
+func (x *ConnectionStats) GetUniqueIps() int64 {
+	if x != nil {
+		return x.UniqueIps
+	}
+	return 0
+}
+
+func (x *ConnectionStats) GetUniqueCountries() int64 {
+	if x != nil {
+		return x.UniqueCountries
+	}
+	return 0
+}
+
+func (x *ConnectionStats) GetPendingApprovals() int64 {
+	if x != nil {
+		return x.PendingApprovals
+	}
+	return 0
+}
+
+func (x *ConnectionStats) GetRecommendedPollIntervalSeconds() int32 {
+	if x != nil {
+		return x.RecommendedPollIntervalSeconds
+	}
+	return 0
+}
+
+type GetConnectionStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NodeId        string                 `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`    // Optional: specific node
+	ProxyId       string                 `protobuf:"bytes,2,opt,name=proxy_id,json=proxyId,proto3" json:"proxy_id,omitempty"` // Optional: specific proxy
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetConnectionStatsRequest) Reset() {
+	*x = GetConnectionStatsRequest{}
+	mi := &file_local_nitella_local_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetConnectionStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConnectionStatsRequest) ProtoMessage() {}
+
+func (x *GetConnectionStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_local_nitella_local_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConnectionStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetConnectionStatsRequest) Descriptor() ([]byte, []int) {
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *GetConnectionStatsRequest) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *GetConnectionStatsRequest) GetProxyId() string {
+	if x != nil {
+		return x.ProxyId
+	}
+	return ""
+}
+
+type ConnectionInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ConnId        string                 `protobuf:"bytes,1,opt,name=conn_id,json=connId,proto3" json:"conn_id,omitempty"`
+	NodeId        string                 `protobuf:"bytes,2,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	ProxyId       string                 `protobuf:"bytes,3,opt,name=proxy_id,json=proxyId,proto3" json:"proxy_id,omitempty"`
+	SourceIp      string                 `protobuf:"bytes,4,opt,name=source_ip,json=sourceIp,proto3" json:"source_ip,omitempty"`
+	SourcePort    int32                  `protobuf:"varint,5,opt,name=source_port,json=sourcePort,proto3" json:"source_port,omitempty"`
+	DestAddr      string                 `protobuf:"bytes,6,opt,name=dest_addr,json=destAddr,proto3" json:"dest_addr,omitempty"`
+	StartTime     *timestamp.Timestamp   `protobuf:"bytes,7,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	BytesIn       int64                  `protobuf:"varint,8,opt,name=bytes_in,json=bytesIn,proto3" json:"bytes_in,omitempty"`
+	BytesOut      int64                  `protobuf:"varint,9,opt,name=bytes_out,json=bytesOut,proto3" json:"bytes_out,omitempty"`
+	Geo           *common.GeoInfo        `protobuf:"bytes,10,opt,name=geo,proto3" json:"geo,omitempty"`
+	RuleMatched   string                 `protobuf:"bytes,11,opt,name=rule_matched,json=ruleMatched,proto3" json:"rule_matched,omitempty"`
+	Action        common.ActionType      `protobuf:"varint,12,opt,name=action,proto3,enum=nitella.ActionType" json:"action,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConnectionInfo) Reset() {
+	*x = ConnectionInfo{}
+	mi := &file_local_nitella_local_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConnectionInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConnectionInfo) ProtoMessage() {}
+
+func (x *ConnectionInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_local_nitella_local_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConnectionInfo.ProtoReflect.Descriptor instead.
+func (*ConnectionInfo) Descriptor() ([]byte, []int) {
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *ConnectionInfo) GetConnId() string {
+	if x != nil {
+		return x.ConnId
+	}
+	return ""
+}
+
+func (x *ConnectionInfo) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *ConnectionInfo) GetProxyId() string {
+	if x != nil {
+		return x.ProxyId
+	}
+	return ""
+}
+
+func (x *ConnectionInfo) GetSourceIp() string {
+	if x != nil {
+		return x.SourceIp
+	}
+	return ""
+}
+
+func (x *ConnectionInfo) GetSourcePort() int32 {
+	if x != nil {
+		return x.SourcePort
+	}
+	return 0
+}
+
+func (x *ConnectionInfo) GetDestAddr() string {
+	if x != nil {
+		return x.DestAddr
+	}
+	return ""
+}
+
+func (x *ConnectionInfo) GetStartTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *ConnectionInfo) GetBytesIn() int64 {
+	if x != nil {
+		return x.BytesIn
+	}
+	return 0
+}
+
+func (x *ConnectionInfo) GetBytesOut() int64 {
+	if x != nil {
+		return x.BytesOut
+	}
+	return 0
+}
+
+func (x *ConnectionInfo) GetGeo() *common.GeoInfo {
+	if x != nil {
+		return x.Geo
+	}
+	return nil
+}
+
+func (x *ConnectionInfo) GetRuleMatched() string {
+	if x != nil {
+		return x.RuleMatched
+	}
+	return ""
+}
+
+func (x *ConnectionInfo) GetAction() common.ActionType {
+	if x != nil {
+		return x.Action
+	}
+	return common.ActionType(0)
+}
+
+type ListConnectionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NodeId        string                 `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	ProxyId       string                 `protobuf:"bytes,2,opt,name=proxy_id,json=proxyId,proto3" json:"proxy_id,omitempty"`           // Optional: filter by proxy
+	ActiveOnly    bool                   `protobuf:"varint,3,opt,name=active_only,json=activeOnly,proto3" json:"active_only,omitempty"` // Only show active connections
+	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
 	Offset        int32                  `protobuf:"varint,5,opt,name=offset,proto3" json:"offset,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -6584,7 +6958,7 @@ type ListConnectionsRequest struct {
 
 func (x *ListConnectionsRequest) Reset() {
 	*x = ListConnectionsRequest{}
-	mi := &file_local_nitella_local_proto_msgTypes[83]
+	mi := &file_local_nitella_local_proto_msgTypes[88]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6596,7 +6970,7 @@ func (x *ListConnectionsRequest) String() string {
 func (*ListConnectionsRequest) ProtoMessage() {}
 
 func (x *ListConnectionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_local_nitella_local_proto_msgTypes[83]
+	mi := &file_local_nitella_local_proto_msgTypes[88]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6609,7 +6983,7 @@ func (x *ListConnectionsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListConnectionsRequest.ProtoReflect.Descriptor instead.
 func (*ListConnectionsRequest) Descriptor() ([]byte, []int) {
-	return file_local_nitella_local_proto_rawDescGZIP(), []int{83}
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{88}
 }
 
 func (x *ListConnectionsRequest) GetNodeId() string {
@@ -6657,7 +7031,7 @@ type ListConnectionsResponse struct {
 
 func (x *ListConnectionsResponse) Reset() {
 	*x = ListConnectionsResponse{}
-	mi := &file_local_nitella_local_proto_msgTypes[84]
+	mi := &file_local_nitella_local_proto_msgTypes[89]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6669,7 +7043,7 @@ func (x *ListConnectionsResponse) String() string {
 func (*ListConnectionsResponse) ProtoMessage() {}
 
 func (x *ListConnectionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_local_nitella_local_proto_msgTypes[84]
+	mi := &file_local_nitella_local_proto_msgTypes[89]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6682,7 +7056,7 @@ func (x *ListConnectionsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListConnectionsResponse.ProtoReflect.Descriptor instead.
 func (*ListConnectionsResponse) Descriptor() ([]byte, []int) {
-	return file_local_nitella_local_proto_rawDescGZIP(), []int{84}
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{89}
 }
 
 func (x *ListConnectionsResponse) GetConnections() []*ConnectionInfo {
@@ -6713,7 +7087,7 @@ type GetIPStatsRequest struct {
 
 func (x *GetIPStatsRequest) Reset() {
 	*x = GetIPStatsRequest{}
-	mi := &file_local_nitella_local_proto_msgTypes[85]
+	mi := &file_local_nitella_local_proto_msgTypes[90]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6725,7 +7099,7 @@ func (x *GetIPStatsRequest) String() string {
 func (*GetIPStatsRequest) ProtoMessage() {}
 
 func (x *GetIPStatsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_local_nitella_local_proto_msgTypes[85]
+	mi := &file_local_nitella_local_proto_msgTypes[90]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6738,7 +7112,7 @@ func (x *GetIPStatsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetIPStatsRequest.ProtoReflect.Descriptor instead.
 func (*GetIPStatsRequest) Descriptor() ([]byte, []int) {
-	return file_local_nitella_local_proto_rawDescGZIP(), []int{85}
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{90}
 }
 
 func (x *GetIPStatsRequest) GetNodeId() string {
@@ -6802,7 +7176,7 @@ type IPStats struct {
 
 func (x *IPStats) Reset() {
 	*x = IPStats{}
-	mi := &file_local_nitella_local_proto_msgTypes[86]
+	mi := &file_local_nitella_local_proto_msgTypes[91]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6814,7 +7188,7 @@ func (x *IPStats) String() string {
 func (*IPStats) ProtoMessage() {}
 
 func (x *IPStats) ProtoReflect() protoreflect.Message {
-	mi := &file_local_nitella_local_proto_msgTypes[86]
+	mi := &file_local_nitella_local_proto_msgTypes[91]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6827,7 +7201,7 @@ func (x *IPStats) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IPStats.ProtoReflect.Descriptor instead.
 func (*IPStats) Descriptor() ([]byte, []int) {
-	return file_local_nitella_local_proto_rawDescGZIP(), []int{86}
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{91}
 }
 
 func (x *IPStats) GetSourceIp() string {
@@ -6917,7 +7291,7 @@ type GetIPStatsResponse struct {
 
 func (x *GetIPStatsResponse) Reset() {
 	*x = GetIPStatsResponse{}
-	mi := &file_local_nitella_local_proto_msgTypes[87]
+	mi := &file_local_nitella_local_proto_msgTypes[92]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6929,7 +7303,7 @@ func (x *GetIPStatsResponse) String() string {
 func (*GetIPStatsResponse) ProtoMessage() {}
 
 func (x *GetIPStatsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_local_nitella_local_proto_msgTypes[87]
+	mi := &file_local_nitella_local_proto_msgTypes[92]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6942,7 +7316,7 @@ func (x *GetIPStatsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetIPStatsResponse.ProtoReflect.Descriptor instead.
 func (*GetIPStatsResponse) Descriptor() ([]byte, []int) {
-	return file_local_nitella_local_proto_rawDescGZIP(), []int{87}
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{92}
 }
 
 func (x *GetIPStatsResponse) GetStats() []*IPStats {
@@ -6970,7 +7344,7 @@ type GetGeoStatsRequest struct {
 
 func (x *GetGeoStatsRequest) Reset() {
 	*x = GetGeoStatsRequest{}
-	mi := &file_local_nitella_local_proto_msgTypes[88]
+	mi := &file_local_nitella_local_proto_msgTypes[93]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6982,7 +7356,7 @@ func (x *GetGeoStatsRequest) String() string {
 func (*GetGeoStatsRequest) ProtoMessage() {}
 
 func (x *GetGeoStatsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_local_nitella_local_proto_msgTypes[88]
+	mi := &file_local_nitella_local_proto_msgTypes[93]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6995,7 +7369,7 @@ func (x *GetGeoStatsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetGeoStatsRequest.ProtoReflect.Descriptor instead.
 func (*GetGeoStatsRequest) Descriptor() ([]byte, []int) {
-	return file_local_nitella_local_proto_rawDescGZIP(), []int{88}
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{93}
 }
 
 func (x *GetGeoStatsRequest) GetNodeId() string {
@@ -7033,7 +7407,7 @@ type GeoStats struct {
 
 func (x *GeoStats) Reset() {
 	*x = GeoStats{}
-	mi := &file_local_nitella_local_proto_msgTypes[89]
+	mi := &file_local_nitella_local_proto_msgTypes[94]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7045,7 +7419,7 @@ func (x *GeoStats) String() string {
 func (*GeoStats) ProtoMessage() {}
 
 func (x *GeoStats) ProtoReflect() protoreflect.Message {
-	mi := &file_local_nitella_local_proto_msgTypes[89]
+	mi := &file_local_nitella_local_proto_msgTypes[94]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7058,7 +7432,7 @@ func (x *GeoStats) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GeoStats.ProtoReflect.Descriptor instead.
 func (*GeoStats) Descriptor() ([]byte, []int) {
-	return file_local_nitella_local_proto_rawDescGZIP(), []int{89}
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{94}
 }
 
 func (x *GeoStats) GetType() GeoStatsType {
@@ -7113,7 +7487,7 @@ type GetGeoStatsResponse struct {
 
 func (x *GetGeoStatsResponse) Reset() {
 	*x = GetGeoStatsResponse{}
-	mi := &file_local_nitella_local_proto_msgTypes[90]
+	mi := &file_local_nitella_local_proto_msgTypes[95]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7125,7 +7499,7 @@ func (x *GetGeoStatsResponse) String() string {
 func (*GetGeoStatsResponse) ProtoMessage() {}
 
 func (x *GetGeoStatsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_local_nitella_local_proto_msgTypes[90]
+	mi := &file_local_nitella_local_proto_msgTypes[95]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7138,7 +7512,7 @@ func (x *GetGeoStatsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetGeoStatsResponse.ProtoReflect.Descriptor instead.
 func (*GetGeoStatsResponse) Descriptor() ([]byte, []int) {
-	return file_local_nitella_local_proto_rawDescGZIP(), []int{90}
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{95}
 }
 
 func (x *GetGeoStatsResponse) GetStats() []*GeoStats {
@@ -7165,7 +7539,7 @@ type StreamConnectionsRequest struct {
 
 func (x *StreamConnectionsRequest) Reset() {
 	*x = StreamConnectionsRequest{}
-	mi := &file_local_nitella_local_proto_msgTypes[91]
+	mi := &file_local_nitella_local_proto_msgTypes[96]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7177,7 +7551,7 @@ func (x *StreamConnectionsRequest) String() string {
 func (*StreamConnectionsRequest) ProtoMessage() {}
 
 func (x *StreamConnectionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_local_nitella_local_proto_msgTypes[91]
+	mi := &file_local_nitella_local_proto_msgTypes[96]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7190,7 +7564,7 @@ func (x *StreamConnectionsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StreamConnectionsRequest.ProtoReflect.Descriptor instead.
 func (*StreamConnectionsRequest) Descriptor() ([]byte, []int) {
-	return file_local_nitella_local_proto_rawDescGZIP(), []int{91}
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{96}
 }
 
 func (x *StreamConnectionsRequest) GetNodeId() string {
@@ -7228,7 +7602,7 @@ type ConnectionEvent struct {
 
 func (x *ConnectionEvent) Reset() {
 	*x = ConnectionEvent{}
-	mi := &file_local_nitella_local_proto_msgTypes[92]
+	mi := &file_local_nitella_local_proto_msgTypes[97]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7240,7 +7614,7 @@ func (x *ConnectionEvent) String() string {
 func (*ConnectionEvent) ProtoMessage() {}
 
 func (x *ConnectionEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_local_nitella_local_proto_msgTypes[92]
+	mi := &file_local_nitella_local_proto_msgTypes[97]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7253,7 +7627,7 @@ func (x *ConnectionEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ConnectionEvent.ProtoReflect.Descriptor instead.
 func (*ConnectionEvent) Descriptor() ([]byte, []int) {
-	return file_local_nitella_local_proto_rawDescGZIP(), []int{92}
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{97}
 }
 
 func (x *ConnectionEvent) GetConnId() string {
@@ -7362,7 +7736,7 @@ type CloseConnectionRequest struct {
 
 func (x *CloseConnectionRequest) Reset() {
 	*x = CloseConnectionRequest{}
-	mi := &file_local_nitella_local_proto_msgTypes[93]
+	mi := &file_local_nitella_local_proto_msgTypes[98]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7374,7 +7748,7 @@ func (x *CloseConnectionRequest) String() string {
 func (*CloseConnectionRequest) ProtoMessage() {}
 
 func (x *CloseConnectionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_local_nitella_local_proto_msgTypes[93]
+	mi := &file_local_nitella_local_proto_msgTypes[98]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7387,7 +7761,7 @@ func (x *CloseConnectionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CloseConnectionRequest.ProtoReflect.Descriptor instead.
 func (*CloseConnectionRequest) Descriptor() ([]byte, []int) {
-	return file_local_nitella_local_proto_rawDescGZIP(), []int{93}
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{98}
 }
 
 func (x *CloseConnectionRequest) GetNodeId() string {
@@ -7455,7 +7829,7 @@ type CloseConnectionResponse struct {
 
 func (x *CloseConnectionResponse) Reset() {
 	*x = CloseConnectionResponse{}
-	mi := &file_local_nitella_local_proto_msgTypes[94]
+	mi := &file_local_nitella_local_proto_msgTypes[99]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7467,7 +7841,7 @@ func (x *CloseConnectionResponse) String() string {
 func (*CloseConnectionResponse) ProtoMessage() {}
 
 func (x *CloseConnectionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_local_nitella_local_proto_msgTypes[94]
+	mi := &file_local_nitella_local_proto_msgTypes[99]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7480,7 +7854,7 @@ func (x *CloseConnectionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CloseConnectionResponse.ProtoReflect.Descriptor instead.
 func (*CloseConnectionResponse) Descriptor() ([]byte, []int) {
-	return file_local_nitella_local_proto_rawDescGZIP(), []int{94}
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{99}
 }
 
 func (x *CloseConnectionResponse) GetSuccess() bool {
@@ -7507,7 +7881,7 @@ type CloseAllConnectionsRequest struct {
 
 func (x *CloseAllConnectionsRequest) Reset() {
 	*x = CloseAllConnectionsRequest{}
-	mi := &file_local_nitella_local_proto_msgTypes[95]
+	mi := &file_local_nitella_local_proto_msgTypes[100]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7519,7 +7893,7 @@ func (x *CloseAllConnectionsRequest) String() string {
 func (*CloseAllConnectionsRequest) ProtoMessage() {}
 
 func (x *CloseAllConnectionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_local_nitella_local_proto_msgTypes[95]
+	mi := &file_local_nitella_local_proto_msgTypes[100]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7532,7 +7906,7 @@ func (x *CloseAllConnectionsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CloseAllConnectionsRequest.ProtoReflect.Descriptor instead.
 func (*CloseAllConnectionsRequest) Descriptor() ([]byte, []int) {
-	return file_local_nitella_local_proto_rawDescGZIP(), []int{95}
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{100}
 }
 
 func (x *CloseAllConnectionsRequest) GetNodeId() string {
@@ -7560,7 +7934,7 @@ type CloseAllConnectionsResponse struct {
 
 func (x *CloseAllConnectionsResponse) Reset() {
 	*x = CloseAllConnectionsResponse{}
-	mi := &file_local_nitella_local_proto_msgTypes[96]
+	mi := &file_local_nitella_local_proto_msgTypes[101]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7572,7 +7946,7 @@ func (x *CloseAllConnectionsResponse) String() string {
 func (*CloseAllConnectionsResponse) ProtoMessage() {}
 
 func (x *CloseAllConnectionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_local_nitella_local_proto_msgTypes[96]
+	mi := &file_local_nitella_local_proto_msgTypes[101]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7585,7 +7959,7 @@ func (x *CloseAllConnectionsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CloseAllConnectionsResponse.ProtoReflect.Descriptor instead.
 func (*CloseAllConnectionsResponse) Descriptor() ([]byte, []int) {
-	return file_local_nitella_local_proto_rawDescGZIP(), []int{96}
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{101}
 }
 
 func (x *CloseAllConnectionsResponse) GetSuccess() bool {
@@ -7618,7 +7992,7 @@ type CloseAllNodeConnectionsRequest struct {
 
 func (x *CloseAllNodeConnectionsRequest) Reset() {
 	*x = CloseAllNodeConnectionsRequest{}
-	mi := &file_local_nitella_local_proto_msgTypes[97]
+	mi := &file_local_nitella_local_proto_msgTypes[102]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7630,7 +8004,7 @@ func (x *CloseAllNodeConnectionsRequest) String() string {
 func (*CloseAllNodeConnectionsRequest) ProtoMessage() {}
 
 func (x *CloseAllNodeConnectionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_local_nitella_local_proto_msgTypes[97]
+	mi := &file_local_nitella_local_proto_msgTypes[102]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7643,7 +8017,7 @@ func (x *CloseAllNodeConnectionsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CloseAllNodeConnectionsRequest.ProtoReflect.Descriptor instead.
 func (*CloseAllNodeConnectionsRequest) Descriptor() ([]byte, []int) {
-	return file_local_nitella_local_proto_rawDescGZIP(), []int{97}
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{102}
 }
 
 func (x *CloseAllNodeConnectionsRequest) GetNodeId() string {
@@ -7666,7 +8040,7 @@ type CloseAllNodeConnectionsResponse struct {
 
 func (x *CloseAllNodeConnectionsResponse) Reset() {
 	*x = CloseAllNodeConnectionsResponse{}
-	mi := &file_local_nitella_local_proto_msgTypes[98]
+	mi := &file_local_nitella_local_proto_msgTypes[103]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7678,7 +8052,7 @@ func (x *CloseAllNodeConnectionsResponse) String() string {
 func (*CloseAllNodeConnectionsResponse) ProtoMessage() {}
 
 func (x *CloseAllNodeConnectionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_local_nitella_local_proto_msgTypes[98]
+	mi := &file_local_nitella_local_proto_msgTypes[103]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7691,7 +8065,7 @@ func (x *CloseAllNodeConnectionsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CloseAllNodeConnectionsResponse.ProtoReflect.Descriptor instead.
 func (*CloseAllNodeConnectionsResponse) Descriptor() ([]byte, []int) {
-	return file_local_nitella_local_proto_rawDescGZIP(), []int{98}
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{103}
 }
 
 func (x *CloseAllNodeConnectionsResponse) GetSuccess() bool {
@@ -7738,7 +8112,7 @@ type StartPairingRequest struct {
 
 func (x *StartPairingRequest) Reset() {
 	*x = StartPairingRequest{}
-	mi := &file_local_nitella_local_proto_msgTypes[99]
+	mi := &file_local_nitella_local_proto_msgTypes[104]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7750,7 +8124,7 @@ func (x *StartPairingRequest) String() string {
 func (*StartPairingRequest) ProtoMessage() {}
 
 func (x *StartPairingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_local_nitella_local_proto_msgTypes[99]
+	mi := &file_local_nitella_local_proto_msgTypes[104]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7763,7 +8137,7 @@ func (x *StartPairingRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StartPairingRequest.ProtoReflect.Descriptor instead.
 func (*StartPairingRequest) Descriptor() ([]byte, []int) {
-	return file_local_nitella_local_proto_rawDescGZIP(), []int{99}
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{104}
 }
 
 func (x *StartPairingRequest) GetNodeName() string {
@@ -7784,7 +8158,7 @@ type StartPairingResponse struct {
 
 func (x *StartPairingResponse) Reset() {
 	*x = StartPairingResponse{}
-	mi := &file_local_nitella_local_proto_msgTypes[100]
+	mi := &file_local_nitella_local_proto_msgTypes[105]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7796,7 +8170,7 @@ func (x *StartPairingResponse) String() string {
 func (*StartPairingResponse) ProtoMessage() {}
 
 func (x *StartPairingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_local_nitella_local_proto_msgTypes[100]
+	mi := &file_local_nitella_local_proto_msgTypes[105]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7809,7 +8183,7 @@ func (x *StartPairingResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StartPairingResponse.ProtoReflect.Descriptor instead.
 func (*StartPairingResponse) Descriptor() ([]byte, []int) {
-	return file_local_nitella_local_proto_rawDescGZIP(), []int{100}
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{105}
 }
 
 func (x *StartPairingResponse) GetSessionId() string {
@@ -7842,7 +8216,7 @@ type JoinPairingRequest struct {
 
 func (x *JoinPairingRequest) Reset() {
 	*x = JoinPairingRequest{}
-	mi := &file_local_nitella_local_proto_msgTypes[101]
+	mi := &file_local_nitella_local_proto_msgTypes[106]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7854,7 +8228,7 @@ func (x *JoinPairingRequest) String() string {
 func (*JoinPairingRequest) ProtoMessage() {}
 
 func (x *JoinPairingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_local_nitella_local_proto_msgTypes[101]
+	mi := &file_local_nitella_local_proto_msgTypes[106]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7867,7 +8241,7 @@ func (x *JoinPairingRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use JoinPairingRequest.ProtoReflect.Descriptor instead.
 func (*JoinPairingRequest) Descriptor() ([]byte, []int) {
-	return file_local_nitella_local_proto_rawDescGZIP(), []int{101}
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{106}
 }
 
 func (x *JoinPairingRequest) GetPairingCode() string {
@@ -7894,7 +8268,7 @@ type JoinPairingResponse struct {
 
 func (x *JoinPairingResponse) Reset() {
 	*x = JoinPairingResponse{}
-	mi := &file_local_nitella_local_proto_msgTypes[102]
+	mi := &file_local_nitella_local_proto_msgTypes[107]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -7906,7 +8280,7 @@ func (x *JoinPairingResponse) String() string {
 func (*JoinPairingResponse) ProtoMessage() {}
 
 func (x *JoinPairingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_local_nitella_local_proto_msgTypes[102]
+	mi := &file_local_nitella_local_proto_msgTypes[107]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7919,7 +8293,7 @@ func (x *JoinPairingResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use JoinPairingResponse.ProtoReflect.Descriptor instead.
 func (*JoinPairingResponse) Descriptor() ([]byte, []int) {
-	return file_local_nitella_local_proto_rawDescGZIP(), []int{102}
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{107}
 }
 
 func (x *JoinPairingResponse) GetSuccess() bool {
@@ -7994,7 +8368,7 @@ type CompletePairingRequest struct {
 
 func (x *CompletePairingRequest) Reset() {
 	*x = CompletePairingRequest{}
-	mi := &file_local_nitella_local_proto_msgTypes[103]
+	mi := &file_local_nitella_local_proto_msgTypes[108]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8006,7 +8380,7 @@ func (x *CompletePairingRequest) String() string {
 func (*CompletePairingRequest) ProtoMessage() {}
 
 func (x *CompletePairingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_local_nitella_local_proto_msgTypes[103]
+	mi := &file_local_nitella_local_proto_msgTypes[108]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8019,7 +8393,7 @@ func (x *CompletePairingRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CompletePairingRequest.ProtoReflect.Descriptor instead.
 func (*CompletePairingRequest) Descriptor() ([]byte, []int) {
-	return file_local_nitella_local_proto_rawDescGZIP(), []int{103}
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{108}
 }
 
 func (x *CompletePairingRequest) GetSessionId() string {
@@ -8040,7 +8414,7 @@ type CompletePairingResponse struct {
 
 func (x *CompletePairingResponse) Reset() {
 	*x = CompletePairingResponse{}
-	mi := &file_local_nitella_local_proto_msgTypes[104]
+	mi := &file_local_nitella_local_proto_msgTypes[109]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8052,7 +8426,7 @@ func (x *CompletePairingResponse) String() string {
 func (*CompletePairingResponse) ProtoMessage() {}
 
 func (x *CompletePairingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_local_nitella_local_proto_msgTypes[104]
+	mi := &file_local_nitella_local_proto_msgTypes[109]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8065,7 +8439,7 @@ func (x *CompletePairingResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CompletePairingResponse.ProtoReflect.Descriptor instead.
 func (*CompletePairingResponse) Descriptor() ([]byte, []int) {
-	return file_local_nitella_local_proto_rawDescGZIP(), []int{104}
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{109}
 }
 
 func (x *CompletePairingResponse) GetSuccess() bool {
@@ -8100,7 +8474,7 @@ type FinalizePairingRequest struct {
 
 func (x *FinalizePairingRequest) Reset() {
 	*x = FinalizePairingRequest{}
-	mi := &file_local_nitella_local_proto_msgTypes[105]
+	mi := &file_local_nitella_local_proto_msgTypes[110]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8112,7 +8486,7 @@ func (x *FinalizePairingRequest) String() string {
 func (*FinalizePairingRequest) ProtoMessage() {}
 
 func (x *FinalizePairingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_local_nitella_local_proto_msgTypes[105]
+	mi := &file_local_nitella_local_proto_msgTypes[110]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8125,7 +8499,7 @@ func (x *FinalizePairingRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FinalizePairingRequest.ProtoReflect.Descriptor instead.
 func (*FinalizePairingRequest) Descriptor() ([]byte, []int) {
-	return file_local_nitella_local_proto_rawDescGZIP(), []int{105}
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{110}
 }
 
 func (x *FinalizePairingRequest) GetSessionId() string {
@@ -8163,7 +8537,7 @@ type FinalizePairingResponse struct {
 
 func (x *FinalizePairingResponse) Reset() {
 	*x = FinalizePairingResponse{}
-	mi := &file_local_nitella_local_proto_msgTypes[106]
+	mi := &file_local_nitella_local_proto_msgTypes[111]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8175,7 +8549,7 @@ func (x *FinalizePairingResponse) String() string {
 func (*FinalizePairingResponse) ProtoMessage() {}
 
 func (x *FinalizePairingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_local_nitella_local_proto_msgTypes[106]
+	mi := &file_local_nitella_local_proto_msgTypes[111]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8188,7 +8562,7 @@ func (x *FinalizePairingResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FinalizePairingResponse.ProtoReflect.Descriptor instead.
 func (*FinalizePairingResponse) Descriptor() ([]byte, []int) {
-	return file_local_nitella_local_proto_rawDescGZIP(), []int{106}
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{111}
 }
 
 func (x *FinalizePairingResponse) GetSuccess() bool {
@@ -8242,7 +8616,7 @@ type CancelPairingRequest struct {
 
 func (x *CancelPairingRequest) Reset() {
 	*x = CancelPairingRequest{}
-	mi := &file_local_nitella_local_proto_msgTypes[107]
+	mi := &file_local_nitella_local_proto_msgTypes[112]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8254,7 +8628,7 @@ func (x *CancelPairingRequest) String() string {
 func (*CancelPairingRequest) ProtoMessage() {}
 
 func (x *CancelPairingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_local_nitella_local_proto_msgTypes[107]
+	mi := &file_local_nitella_local_proto_msgTypes[112]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8267,7 +8641,7 @@ func (x *CancelPairingRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CancelPairingRequest.ProtoReflect.Descriptor instead.
 func (*CancelPairingRequest) Descriptor() ([]byte, []int) {
-	return file_local_nitella_local_proto_rawDescGZIP(), []int{107}
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{112}
 }
 
 func (x *CancelPairingRequest) GetSessionId() string {
@@ -8285,7 +8659,7 @@ type GenerateQRCodeRequest struct {
 
 func (x *GenerateQRCodeRequest) Reset() {
 	*x = GenerateQRCodeRequest{}
-	mi := &file_local_nitella_local_proto_msgTypes[108]
+	mi := &file_local_nitella_local_proto_msgTypes[113]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8297,7 +8671,7 @@ func (x *GenerateQRCodeRequest) String() string {
 func (*GenerateQRCodeRequest) ProtoMessage() {}
 
 func (x *GenerateQRCodeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_local_nitella_local_proto_msgTypes[108]
+	mi := &file_local_nitella_local_proto_msgTypes[113]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8310,7 +8684,7 @@ func (x *GenerateQRCodeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GenerateQRCodeRequest.ProtoReflect.Descriptor instead.
 func (*GenerateQRCodeRequest) Descriptor() ([]byte, []int) {
-	return file_local_nitella_local_proto_rawDescGZIP(), []int{108}
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{113}
 }
 
 type GenerateQRCodeResponse struct {
@@ -8323,7 +8697,7 @@ type GenerateQRCodeResponse struct {
 
 func (x *GenerateQRCodeResponse) Reset() {
 	*x = GenerateQRCodeResponse{}
-	mi := &file_local_nitella_local_proto_msgTypes[109]
+	mi := &file_local_nitella_local_proto_msgTypes[114]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8335,7 +8709,7 @@ func (x *GenerateQRCodeResponse) String() string {
 func (*GenerateQRCodeResponse) ProtoMessage() {}
 
 func (x *GenerateQRCodeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_local_nitella_local_proto_msgTypes[109]
+	mi := &file_local_nitella_local_proto_msgTypes[114]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8348,7 +8722,7 @@ func (x *GenerateQRCodeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GenerateQRCodeResponse.ProtoReflect.Descriptor instead.
 func (*GenerateQRCodeResponse) Descriptor() ([]byte, []int) {
-	return file_local_nitella_local_proto_rawDescGZIP(), []int{109}
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{114}
 }
 
 func (x *GenerateQRCodeResponse) GetQrData() []byte {
@@ -8374,7 +8748,7 @@ type ScanQRCodeRequest struct {
 
 func (x *ScanQRCodeRequest) Reset() {
 	*x = ScanQRCodeRequest{}
-	mi := &file_local_nitella_local_proto_msgTypes[110]
+	mi := &file_local_nitella_local_proto_msgTypes[115]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8386,7 +8760,7 @@ func (x *ScanQRCodeRequest) String() string {
 func (*ScanQRCodeRequest) ProtoMessage() {}
 
 func (x *ScanQRCodeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_local_nitella_local_proto_msgTypes[110]
+	mi := &file_local_nitella_local_proto_msgTypes[115]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8399,7 +8773,7 @@ func (x *ScanQRCodeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ScanQRCodeRequest.ProtoReflect.Descriptor instead.
 func (*ScanQRCodeRequest) Descriptor() ([]byte, []int) {
-	return file_local_nitella_local_proto_rawDescGZIP(), []int{110}
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{115}
 }
 
 func (x *ScanQRCodeRequest) GetQrData() []byte {
@@ -8424,7 +8798,7 @@ type ScanQRCodeResponse struct {
 
 func (x *ScanQRCodeResponse) Reset() {
 	*x = ScanQRCodeResponse{}
-	mi := &file_local_nitella_local_proto_msgTypes[111]
+	mi := &file_local_nitella_local_proto_msgTypes[116]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8436,7 +8810,7 @@ func (x *ScanQRCodeResponse) String() string {
 func (*ScanQRCodeResponse) ProtoMessage() {}
 
 func (x *ScanQRCodeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_local_nitella_local_proto_msgTypes[111]
+	mi := &file_local_nitella_local_proto_msgTypes[116]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8449,7 +8823,7 @@ func (x *ScanQRCodeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ScanQRCodeResponse.ProtoReflect.Descriptor instead.
 func (*ScanQRCodeResponse) Descriptor() ([]byte, []int) {
-	return file_local_nitella_local_proto_rawDescGZIP(), []int{111}
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{116}
 }
 
 func (x *ScanQRCodeResponse) GetSuccess() bool {
@@ -8513,7 +8887,7 @@ type GenerateQRReplyRequest struct {
 
 func (x *GenerateQRReplyRequest) Reset() {
 	*x = GenerateQRReplyRequest{}
-	mi := &file_local_nitella_local_proto_msgTypes[112]
+	mi := &file_local_nitella_local_proto_msgTypes[117]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8525,7 +8899,7 @@ func (x *GenerateQRReplyRequest) String() string {
 func (*GenerateQRReplyRequest) ProtoMessage() {}
 
 func (x *GenerateQRReplyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_local_nitella_local_proto_msgTypes[112]
+	mi := &file_local_nitella_local_proto_msgTypes[117]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8538,7 +8912,7 @@ func (x *GenerateQRReplyRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GenerateQRReplyRequest.ProtoReflect.Descriptor instead.
 func (*GenerateQRReplyRequest) Descriptor() ([]byte, []int) {
-	return file_local_nitella_local_proto_rawDescGZIP(), []int{112}
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{117}
 }
 
 func (x *GenerateQRReplyRequest) GetNodeId() string {
@@ -8579,7 +8953,7 @@ type GenerateQRReplyResponse struct {
 
 func (x *GenerateQRReplyResponse) Reset() {
 	*x = GenerateQRReplyResponse{}
-	mi := &file_local_nitella_local_proto_msgTypes[113]
+	mi := &file_local_nitella_local_proto_msgTypes[118]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8591,7 +8965,7 @@ func (x *GenerateQRReplyResponse) String() string {
 func (*GenerateQRReplyResponse) ProtoMessage() {}
 
 func (x *GenerateQRReplyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_local_nitella_local_proto_msgTypes[113]
+	mi := &file_local_nitella_local_proto_msgTypes[118]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8604,7 +8978,7 @@ func (x *GenerateQRReplyResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GenerateQRReplyResponse.ProtoReflect.Descriptor instead.
 func (*GenerateQRReplyResponse) Descriptor() ([]byte, []int) {
-	return file_local_nitella_local_proto_rawDescGZIP(), []int{113}
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{118}
 }
 
 func (x *GenerateQRReplyResponse) GetQrData() []byte {
@@ -8640,7 +9014,7 @@ type Template struct {
 
 func (x *Template) Reset() {
 	*x = Template{}
-	mi := &file_local_nitella_local_proto_msgTypes[114]
+	mi := &file_local_nitella_local_proto_msgTypes[119]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8652,7 +9026,7 @@ func (x *Template) String() string {
 func (*Template) ProtoMessage() {}
 
 func (x *Template) ProtoReflect() protoreflect.Message {
-	mi := &file_local_nitella_local_proto_msgTypes[114]
+	mi := &file_local_nitella_local_proto_msgTypes[119]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8665,7 +9039,7 @@ func (x *Template) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Template.ProtoReflect.Descriptor instead.
 func (*Template) Descriptor() ([]byte, []int) {
-	return file_local_nitella_local_proto_rawDescGZIP(), []int{114}
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{119}
 }
 
 func (x *Template) GetTemplateId() string {
@@ -8752,7 +9126,7 @@ type ProxyTemplate struct {
 
 func (x *ProxyTemplate) Reset() {
 	*x = ProxyTemplate{}
-	mi := &file_local_nitella_local_proto_msgTypes[115]
+	mi := &file_local_nitella_local_proto_msgTypes[120]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8764,7 +9138,7 @@ func (x *ProxyTemplate) String() string {
 func (*ProxyTemplate) ProtoMessage() {}
 
 func (x *ProxyTemplate) ProtoReflect() protoreflect.Message {
-	mi := &file_local_nitella_local_proto_msgTypes[115]
+	mi := &file_local_nitella_local_proto_msgTypes[120]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8777,7 +9151,7 @@ func (x *ProxyTemplate) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProxyTemplate.ProtoReflect.Descriptor instead.
 func (*ProxyTemplate) Descriptor() ([]byte, []int) {
-	return file_local_nitella_local_proto_rawDescGZIP(), []int{115}
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{120}
 }
 
 func (x *ProxyTemplate) GetName() string {
@@ -8832,7 +9206,7 @@ type ListTemplatesRequest struct {
 
 func (x *ListTemplatesRequest) Reset() {
 	*x = ListTemplatesRequest{}
-	mi := &file_local_nitella_local_proto_msgTypes[116]
+	mi := &file_local_nitella_local_proto_msgTypes[121]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8844,7 +9218,7 @@ func (x *ListTemplatesRequest) String() string {
 func (*ListTemplatesRequest) ProtoMessage() {}
 
 func (x *ListTemplatesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_local_nitella_local_proto_msgTypes[116]
+	mi := &file_local_nitella_local_proto_msgTypes[121]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8857,7 +9231,7 @@ func (x *ListTemplatesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListTemplatesRequest.ProtoReflect.Descriptor instead.
 func (*ListTemplatesRequest) Descriptor() ([]byte, []int) {
-	return file_local_nitella_local_proto_rawDescGZIP(), []int{116}
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{121}
 }
 
 func (x *ListTemplatesRequest) GetIncludePublic() bool {
@@ -8884,7 +9258,7 @@ type ListTemplatesResponse struct {
 
 func (x *ListTemplatesResponse) Reset() {
 	*x = ListTemplatesResponse{}
-	mi := &file_local_nitella_local_proto_msgTypes[117]
+	mi := &file_local_nitella_local_proto_msgTypes[122]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8896,7 +9270,7 @@ func (x *ListTemplatesResponse) String() string {
 func (*ListTemplatesResponse) ProtoMessage() {}
 
 func (x *ListTemplatesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_local_nitella_local_proto_msgTypes[117]
+	mi := &file_local_nitella_local_proto_msgTypes[122]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8909,7 +9283,7 @@ func (x *ListTemplatesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListTemplatesResponse.ProtoReflect.Descriptor instead.
 func (*ListTemplatesResponse) Descriptor() ([]byte, []int) {
-	return file_local_nitella_local_proto_rawDescGZIP(), []int{117}
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{122}
 }
 
 func (x *ListTemplatesResponse) GetTemplates() []*Template {
@@ -8935,7 +9309,7 @@ type GetTemplateRequest struct {
 
 func (x *GetTemplateRequest) Reset() {
 	*x = GetTemplateRequest{}
-	mi := &file_local_nitella_local_proto_msgTypes[118]
+	mi := &file_local_nitella_local_proto_msgTypes[123]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8947,7 +9321,7 @@ func (x *GetTemplateRequest) String() string {
 func (*GetTemplateRequest) ProtoMessage() {}
 
 func (x *GetTemplateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_local_nitella_local_proto_msgTypes[118]
+	mi := &file_local_nitella_local_proto_msgTypes[123]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8960,7 +9334,7 @@ func (x *GetTemplateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetTemplateRequest.ProtoReflect.Descriptor instead.
 func (*GetTemplateRequest) Descriptor() ([]byte, []int) {
-	return file_local_nitella_local_proto_rawDescGZIP(), []int{118}
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{123}
 }
 
 func (x *GetTemplateRequest) GetTemplateId() string {
@@ -8983,7 +9357,7 @@ type CreateTemplateRequest struct {
 
 func (x *CreateTemplateRequest) Reset() {
 	*x = CreateTemplateRequest{}
-	mi := &file_local_nitella_local_proto_msgTypes[119]
+	mi := &file_local_nitella_local_proto_msgTypes[124]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -8995,7 +9369,7 @@ func (x *CreateTemplateRequest) String() string {
 func (*CreateTemplateRequest) ProtoMessage() {}
 
 func (x *CreateTemplateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_local_nitella_local_proto_msgTypes[119]
+	mi := &file_local_nitella_local_proto_msgTypes[124]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9008,7 +9382,7 @@ func (x *CreateTemplateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateTemplateRequest.ProtoReflect.Descriptor instead.
 func (*CreateTemplateRequest) Descriptor() ([]byte, []int) {
-	return file_local_nitella_local_proto_rawDescGZIP(), []int{119}
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{124}
 }
 
 func (x *CreateTemplateRequest) GetName() string {
@@ -9057,7 +9431,7 @@ type ApplyTemplateRequest struct {
 
 func (x *ApplyTemplateRequest) Reset() {
 	*x = ApplyTemplateRequest{}
-	mi := &file_local_nitella_local_proto_msgTypes[120]
+	mi := &file_local_nitella_local_proto_msgTypes[125]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9069,7 +9443,7 @@ func (x *ApplyTemplateRequest) String() string {
 func (*ApplyTemplateRequest) ProtoMessage() {}
 
 func (x *ApplyTemplateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_local_nitella_local_proto_msgTypes[120]
+	mi := &file_local_nitella_local_proto_msgTypes[125]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9082,7 +9456,7 @@ func (x *ApplyTemplateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ApplyTemplateRequest.ProtoReflect.Descriptor instead.
 func (*ApplyTemplateRequest) Descriptor() ([]byte, []int) {
-	return file_local_nitella_local_proto_rawDescGZIP(), []int{120}
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{125}
 }
 
 func (x *ApplyTemplateRequest) GetTemplateId() string {
@@ -9118,7 +9492,7 @@ type ApplyTemplateResponse struct {
 
 func (x *ApplyTemplateResponse) Reset() {
 	*x = ApplyTemplateResponse{}
-	mi := &file_local_nitella_local_proto_msgTypes[121]
+	mi := &file_local_nitella_local_proto_msgTypes[126]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9130,7 +9504,7 @@ func (x *ApplyTemplateResponse) String() string {
 func (*ApplyTemplateResponse) ProtoMessage() {}
 
 func (x *ApplyTemplateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_local_nitella_local_proto_msgTypes[121]
+	mi := &file_local_nitella_local_proto_msgTypes[126]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9143,7 +9517,7 @@ func (x *ApplyTemplateResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ApplyTemplateResponse.ProtoReflect.Descriptor instead.
 func (*ApplyTemplateResponse) Descriptor() ([]byte, []int) {
-	return file_local_nitella_local_proto_rawDescGZIP(), []int{121}
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{126}
 }
 
 func (x *ApplyTemplateResponse) GetSuccess() bool {
@@ -9183,7 +9557,7 @@ type DeleteTemplateRequest struct {
 
 func (x *DeleteTemplateRequest) Reset() {
 	*x = DeleteTemplateRequest{}
-	mi := &file_local_nitella_local_proto_msgTypes[122]
+	mi := &file_local_nitella_local_proto_msgTypes[127]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9195,7 +9569,7 @@ func (x *DeleteTemplateRequest) String() string {
 func (*DeleteTemplateRequest) ProtoMessage() {}
 
 func (x *DeleteTemplateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_local_nitella_local_proto_msgTypes[122]
+	mi := &file_local_nitella_local_proto_msgTypes[127]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9208,7 +9582,7 @@ func (x *DeleteTemplateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteTemplateRequest.ProtoReflect.Descriptor instead.
 func (*DeleteTemplateRequest) Descriptor() ([]byte, []int) {
-	return file_local_nitella_local_proto_rawDescGZIP(), []int{122}
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{127}
 }
 
 func (x *DeleteTemplateRequest) GetTemplateId() string {
@@ -9229,7 +9603,7 @@ type SyncTemplatesResponse struct {
 
 func (x *SyncTemplatesResponse) Reset() {
 	*x = SyncTemplatesResponse{}
-	mi := &file_local_nitella_local_proto_msgTypes[123]
+	mi := &file_local_nitella_local_proto_msgTypes[128]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9241,7 +9615,7 @@ func (x *SyncTemplatesResponse) String() string {
 func (*SyncTemplatesResponse) ProtoMessage() {}
 
 func (x *SyncTemplatesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_local_nitella_local_proto_msgTypes[123]
+	mi := &file_local_nitella_local_proto_msgTypes[128]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9254,7 +9628,7 @@ func (x *SyncTemplatesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SyncTemplatesResponse.ProtoReflect.Descriptor instead.
 func (*SyncTemplatesResponse) Descriptor() ([]byte, []int) {
-	return file_local_nitella_local_proto_rawDescGZIP(), []int{123}
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{128}
 }
 
 func (x *SyncTemplatesResponse) GetUploaded() int32 {
@@ -9287,7 +9661,7 @@ type ExportTemplateYamlRequest struct {
 
 func (x *ExportTemplateYamlRequest) Reset() {
 	*x = ExportTemplateYamlRequest{}
-	mi := &file_local_nitella_local_proto_msgTypes[124]
+	mi := &file_local_nitella_local_proto_msgTypes[129]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9299,7 +9673,7 @@ func (x *ExportTemplateYamlRequest) String() string {
 func (*ExportTemplateYamlRequest) ProtoMessage() {}
 
 func (x *ExportTemplateYamlRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_local_nitella_local_proto_msgTypes[124]
+	mi := &file_local_nitella_local_proto_msgTypes[129]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9312,7 +9686,7 @@ func (x *ExportTemplateYamlRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExportTemplateYamlRequest.ProtoReflect.Descriptor instead.
 func (*ExportTemplateYamlRequest) Descriptor() ([]byte, []int) {
-	return file_local_nitella_local_proto_rawDescGZIP(), []int{124}
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{129}
 }
 
 func (x *ExportTemplateYamlRequest) GetTemplateId() string {
@@ -9334,7 +9708,7 @@ type ExportTemplateYamlResponse struct {
 
 func (x *ExportTemplateYamlResponse) Reset() {
 	*x = ExportTemplateYamlResponse{}
-	mi := &file_local_nitella_local_proto_msgTypes[125]
+	mi := &file_local_nitella_local_proto_msgTypes[130]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9346,7 +9720,7 @@ func (x *ExportTemplateYamlResponse) String() string {
 func (*ExportTemplateYamlResponse) ProtoMessage() {}
 
 func (x *ExportTemplateYamlResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_local_nitella_local_proto_msgTypes[125]
+	mi := &file_local_nitella_local_proto_msgTypes[130]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9359,7 +9733,7 @@ func (x *ExportTemplateYamlResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExportTemplateYamlResponse.ProtoReflect.Descriptor instead.
 func (*ExportTemplateYamlResponse) Descriptor() ([]byte, []int) {
-	return file_local_nitella_local_proto_rawDescGZIP(), []int{125}
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{130}
 }
 
 func (x *ExportTemplateYamlResponse) GetSuccess() bool {
@@ -9399,7 +9773,7 @@ type ImportTemplateYamlRequest struct {
 
 func (x *ImportTemplateYamlRequest) Reset() {
 	*x = ImportTemplateYamlRequest{}
-	mi := &file_local_nitella_local_proto_msgTypes[126]
+	mi := &file_local_nitella_local_proto_msgTypes[131]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9411,7 +9785,7 @@ func (x *ImportTemplateYamlRequest) String() string {
 func (*ImportTemplateYamlRequest) ProtoMessage() {}
 
 func (x *ImportTemplateYamlRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_local_nitella_local_proto_msgTypes[126]
+	mi := &file_local_nitella_local_proto_msgTypes[131]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9424,7 +9798,7 @@ func (x *ImportTemplateYamlRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ImportTemplateYamlRequest.ProtoReflect.Descriptor instead.
 func (*ImportTemplateYamlRequest) Descriptor() ([]byte, []int) {
-	return file_local_nitella_local_proto_rawDescGZIP(), []int{126}
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{131}
 }
 
 func (x *ImportTemplateYamlRequest) GetYaml() string {
@@ -9449,7 +9823,7 @@ type ImportTemplateYamlResponse struct {
 
 func (x *ImportTemplateYamlResponse) Reset() {
 	*x = ImportTemplateYamlResponse{}
-	mi := &file_local_nitella_local_proto_msgTypes[127]
+	mi := &file_local_nitella_local_proto_msgTypes[132]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9461,7 +9835,7 @@ func (x *ImportTemplateYamlResponse) String() string {
 func (*ImportTemplateYamlResponse) ProtoMessage() {}
 
 func (x *ImportTemplateYamlResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_local_nitella_local_proto_msgTypes[127]
+	mi := &file_local_nitella_local_proto_msgTypes[132]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9474,7 +9848,7 @@ func (x *ImportTemplateYamlResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ImportTemplateYamlResponse.ProtoReflect.Descriptor instead.
 func (*ImportTemplateYamlResponse) Descriptor() ([]byte, []int) {
-	return file_local_nitella_local_proto_rawDescGZIP(), []int{127}
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{132}
 }
 
 func (x *ImportTemplateYamlResponse) GetSuccess() bool {
@@ -9560,7 +9934,7 @@ type Settings struct {
 
 func (x *Settings) Reset() {
 	*x = Settings{}
-	mi := &file_local_nitella_local_proto_msgTypes[128]
+	mi := &file_local_nitella_local_proto_msgTypes[133]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9572,7 +9946,7 @@ func (x *Settings) String() string {
 func (*Settings) ProtoMessage() {}
 
 func (x *Settings) ProtoReflect() protoreflect.Message {
-	mi := &file_local_nitella_local_proto_msgTypes[128]
+	mi := &file_local_nitella_local_proto_msgTypes[133]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9585,7 +9959,7 @@ func (x *Settings) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Settings.ProtoReflect.Descriptor instead.
 func (*Settings) Descriptor() ([]byte, []int) {
-	return file_local_nitella_local_proto_rawDescGZIP(), []int{128}
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{133}
 }
 
 func (x *Settings) GetHubAddress() string {
@@ -9724,7 +10098,7 @@ type UpdateSettingsRequest struct {
 
 func (x *UpdateSettingsRequest) Reset() {
 	*x = UpdateSettingsRequest{}
-	mi := &file_local_nitella_local_proto_msgTypes[129]
+	mi := &file_local_nitella_local_proto_msgTypes[134]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9736,7 +10110,7 @@ func (x *UpdateSettingsRequest) String() string {
 func (*UpdateSettingsRequest) ProtoMessage() {}
 
 func (x *UpdateSettingsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_local_nitella_local_proto_msgTypes[129]
+	mi := &file_local_nitella_local_proto_msgTypes[134]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9749,7 +10123,7 @@ func (x *UpdateSettingsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateSettingsRequest.ProtoReflect.Descriptor instead.
 func (*UpdateSettingsRequest) Descriptor() ([]byte, []int) {
-	return file_local_nitella_local_proto_rawDescGZIP(), []int{129}
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{134}
 }
 
 func (x *UpdateSettingsRequest) GetSettings() *Settings {
@@ -9777,7 +10151,7 @@ type SettingsOverviewSnapshot struct {
 
 func (x *SettingsOverviewSnapshot) Reset() {
 	*x = SettingsOverviewSnapshot{}
-	mi := &file_local_nitella_local_proto_msgTypes[130]
+	mi := &file_local_nitella_local_proto_msgTypes[135]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9789,7 +10163,7 @@ func (x *SettingsOverviewSnapshot) String() string {
 func (*SettingsOverviewSnapshot) ProtoMessage() {}
 
 func (x *SettingsOverviewSnapshot) ProtoReflect() protoreflect.Message {
-	mi := &file_local_nitella_local_proto_msgTypes[130]
+	mi := &file_local_nitella_local_proto_msgTypes[135]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9802,7 +10176,7 @@ func (x *SettingsOverviewSnapshot) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SettingsOverviewSnapshot.ProtoReflect.Descriptor instead.
 func (*SettingsOverviewSnapshot) Descriptor() ([]byte, []int) {
-	return file_local_nitella_local_proto_rawDescGZIP(), []int{130}
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{135}
 }
 
 func (x *SettingsOverviewSnapshot) GetIdentity() *IdentityInfo {
@@ -9837,7 +10211,7 @@ type RegisterFCMTokenRequest struct {
 
 func (x *RegisterFCMTokenRequest) Reset() {
 	*x = RegisterFCMTokenRequest{}
-	mi := &file_local_nitella_local_proto_msgTypes[131]
+	mi := &file_local_nitella_local_proto_msgTypes[136]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9849,7 +10223,7 @@ func (x *RegisterFCMTokenRequest) String() string {
 func (*RegisterFCMTokenRequest) ProtoMessage() {}
 
 func (x *RegisterFCMTokenRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_local_nitella_local_proto_msgTypes[131]
+	mi := &file_local_nitella_local_proto_msgTypes[136]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9862,7 +10236,7 @@ func (x *RegisterFCMTokenRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RegisterFCMTokenRequest.ProtoReflect.Descriptor instead.
 func (*RegisterFCMTokenRequest) Descriptor() ([]byte, []int) {
-	return file_local_nitella_local_proto_rawDescGZIP(), []int{131}
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{136}
 }
 
 func (x *RegisterFCMTokenRequest) GetFcmToken() string {
@@ -9899,7 +10273,7 @@ type ConnectToHubRequest struct {
 
 func (x *ConnectToHubRequest) Reset() {
 	*x = ConnectToHubRequest{}
-	mi := &file_local_nitella_local_proto_msgTypes[132]
+	mi := &file_local_nitella_local_proto_msgTypes[137]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9911,7 +10285,7 @@ func (x *ConnectToHubRequest) String() string {
 func (*ConnectToHubRequest) ProtoMessage() {}
 
 func (x *ConnectToHubRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_local_nitella_local_proto_msgTypes[132]
+	mi := &file_local_nitella_local_proto_msgTypes[137]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9924,7 +10298,7 @@ func (x *ConnectToHubRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ConnectToHubRequest.ProtoReflect.Descriptor instead.
 func (*ConnectToHubRequest) Descriptor() ([]byte, []int) {
-	return file_local_nitella_local_proto_rawDescGZIP(), []int{132}
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{137}
 }
 
 func (x *ConnectToHubRequest) GetHubAddress() string {
@@ -9971,7 +10345,7 @@ type FetchHubCARequest struct {
 
 func (x *FetchHubCARequest) Reset() {
 	*x = FetchHubCARequest{}
-	mi := &file_local_nitella_local_proto_msgTypes[133]
+	mi := &file_local_nitella_local_proto_msgTypes[138]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -9983,7 +10357,7 @@ func (x *FetchHubCARequest) String() string {
 func (*FetchHubCARequest) ProtoMessage() {}
 
 func (x *FetchHubCARequest) ProtoReflect() protoreflect.Message {
-	mi := &file_local_nitella_local_proto_msgTypes[133]
+	mi := &file_local_nitella_local_proto_msgTypes[138]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9996,7 +10370,7 @@ func (x *FetchHubCARequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FetchHubCARequest.ProtoReflect.Descriptor instead.
 func (*FetchHubCARequest) Descriptor() ([]byte, []int) {
-	return file_local_nitella_local_proto_rawDescGZIP(), []int{133}
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{138}
 }
 
 func (x *FetchHubCARequest) GetHubAddress() string {
@@ -10021,7 +10395,7 @@ type FetchHubCAResponse struct {
 
 func (x *FetchHubCAResponse) Reset() {
 	*x = FetchHubCAResponse{}
-	mi := &file_local_nitella_local_proto_msgTypes[134]
+	mi := &file_local_nitella_local_proto_msgTypes[139]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10033,7 +10407,7 @@ func (x *FetchHubCAResponse) String() string {
 func (*FetchHubCAResponse) ProtoMessage() {}
 
 func (x *FetchHubCAResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_local_nitella_local_proto_msgTypes[134]
+	mi := &file_local_nitella_local_proto_msgTypes[139]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10046,7 +10420,7 @@ func (x *FetchHubCAResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FetchHubCAResponse.ProtoReflect.Descriptor instead.
 func (*FetchHubCAResponse) Descriptor() ([]byte, []int) {
-	return file_local_nitella_local_proto_rawDescGZIP(), []int{134}
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{139}
 }
 
 func (x *FetchHubCAResponse) GetSuccess() bool {
@@ -10108,7 +10482,7 @@ type ConnectToHubResponse struct {
 
 func (x *ConnectToHubResponse) Reset() {
 	*x = ConnectToHubResponse{}
-	mi := &file_local_nitella_local_proto_msgTypes[135]
+	mi := &file_local_nitella_local_proto_msgTypes[140]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10120,7 +10494,7 @@ func (x *ConnectToHubResponse) String() string {
 func (*ConnectToHubResponse) ProtoMessage() {}
 
 func (x *ConnectToHubResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_local_nitella_local_proto_msgTypes[135]
+	mi := &file_local_nitella_local_proto_msgTypes[140]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10133,7 +10507,7 @@ func (x *ConnectToHubResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ConnectToHubResponse.ProtoReflect.Descriptor instead.
 func (*ConnectToHubResponse) Descriptor() ([]byte, []int) {
-	return file_local_nitella_local_proto_rawDescGZIP(), []int{135}
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{140}
 }
 
 func (x *ConnectToHubResponse) GetSuccess() bool {
@@ -10166,7 +10540,7 @@ type HubStatus struct {
 
 func (x *HubStatus) Reset() {
 	*x = HubStatus{}
-	mi := &file_local_nitella_local_proto_msgTypes[136]
+	mi := &file_local_nitella_local_proto_msgTypes[141]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10178,7 +10552,7 @@ func (x *HubStatus) String() string {
 func (*HubStatus) ProtoMessage() {}
 
 func (x *HubStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_local_nitella_local_proto_msgTypes[136]
+	mi := &file_local_nitella_local_proto_msgTypes[141]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10191,7 +10565,7 @@ func (x *HubStatus) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HubStatus.ProtoReflect.Descriptor instead.
 func (*HubStatus) Descriptor() ([]byte, []int) {
-	return file_local_nitella_local_proto_rawDescGZIP(), []int{136}
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{141}
 }
 
 func (x *HubStatus) GetConnected() bool {
@@ -10263,7 +10637,7 @@ type HubSettingsSnapshot struct {
 
 func (x *HubSettingsSnapshot) Reset() {
 	*x = HubSettingsSnapshot{}
-	mi := &file_local_nitella_local_proto_msgTypes[137]
+	mi := &file_local_nitella_local_proto_msgTypes[142]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10275,7 +10649,7 @@ func (x *HubSettingsSnapshot) String() string {
 func (*HubSettingsSnapshot) ProtoMessage() {}
 
 func (x *HubSettingsSnapshot) ProtoReflect() protoreflect.Message {
-	mi := &file_local_nitella_local_proto_msgTypes[137]
+	mi := &file_local_nitella_local_proto_msgTypes[142]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10288,7 +10662,7 @@ func (x *HubSettingsSnapshot) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HubSettingsSnapshot.ProtoReflect.Descriptor instead.
 func (*HubSettingsSnapshot) Descriptor() ([]byte, []int) {
-	return file_local_nitella_local_proto_rawDescGZIP(), []int{137}
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{142}
 }
 
 func (x *HubSettingsSnapshot) GetStatus() *HubStatus {
@@ -10344,7 +10718,7 @@ type HubOverview struct {
 
 func (x *HubOverview) Reset() {
 	*x = HubOverview{}
-	mi := &file_local_nitella_local_proto_msgTypes[138]
+	mi := &file_local_nitella_local_proto_msgTypes[143]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10356,7 +10730,7 @@ func (x *HubOverview) String() string {
 func (*HubOverview) ProtoMessage() {}
 
 func (x *HubOverview) ProtoReflect() protoreflect.Message {
-	mi := &file_local_nitella_local_proto_msgTypes[138]
+	mi := &file_local_nitella_local_proto_msgTypes[143]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10369,7 +10743,7 @@ func (x *HubOverview) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HubOverview.ProtoReflect.Descriptor instead.
 func (*HubOverview) Descriptor() ([]byte, []int) {
-	return file_local_nitella_local_proto_rawDescGZIP(), []int{138}
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{143}
 }
 
 func (x *HubOverview) GetHubConnected() bool {
@@ -10451,7 +10825,7 @@ type GetHubDashboardSnapshotRequest struct {
 
 func (x *GetHubDashboardSnapshotRequest) Reset() {
 	*x = GetHubDashboardSnapshotRequest{}
-	mi := &file_local_nitella_local_proto_msgTypes[139]
+	mi := &file_local_nitella_local_proto_msgTypes[144]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10463,7 +10837,7 @@ func (x *GetHubDashboardSnapshotRequest) String() string {
 func (*GetHubDashboardSnapshotRequest) ProtoMessage() {}
 
 func (x *GetHubDashboardSnapshotRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_local_nitella_local_proto_msgTypes[139]
+	mi := &file_local_nitella_local_proto_msgTypes[144]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10476,7 +10850,7 @@ func (x *GetHubDashboardSnapshotRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetHubDashboardSnapshotRequest.ProtoReflect.Descriptor instead.
 func (*GetHubDashboardSnapshotRequest) Descriptor() ([]byte, []int) {
-	return file_local_nitella_local_proto_rawDescGZIP(), []int{139}
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{144}
 }
 
 func (x *GetHubDashboardSnapshotRequest) GetNodeFilter() string {
@@ -10497,7 +10871,7 @@ type HubDashboardSnapshot struct {
 
 func (x *HubDashboardSnapshot) Reset() {
 	*x = HubDashboardSnapshot{}
-	mi := &file_local_nitella_local_proto_msgTypes[140]
+	mi := &file_local_nitella_local_proto_msgTypes[145]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10509,7 +10883,7 @@ func (x *HubDashboardSnapshot) String() string {
 func (*HubDashboardSnapshot) ProtoMessage() {}
 
 func (x *HubDashboardSnapshot) ProtoReflect() protoreflect.Message {
-	mi := &file_local_nitella_local_proto_msgTypes[140]
+	mi := &file_local_nitella_local_proto_msgTypes[145]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10522,7 +10896,7 @@ func (x *HubDashboardSnapshot) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HubDashboardSnapshot.ProtoReflect.Descriptor instead.
 func (*HubDashboardSnapshot) Descriptor() ([]byte, []int) {
-	return file_local_nitella_local_proto_rawDescGZIP(), []int{140}
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{145}
 }
 
 func (x *HubDashboardSnapshot) GetOverview() *HubOverview {
@@ -10557,7 +10931,7 @@ type RegisterUserRequest struct {
 
 func (x *RegisterUserRequest) Reset() {
 	*x = RegisterUserRequest{}
-	mi := &file_local_nitella_local_proto_msgTypes[141]
+	mi := &file_local_nitella_local_proto_msgTypes[146]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10569,7 +10943,7 @@ func (x *RegisterUserRequest) String() string {
 func (*RegisterUserRequest) ProtoMessage() {}
 
 func (x *RegisterUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_local_nitella_local_proto_msgTypes[141]
+	mi := &file_local_nitella_local_proto_msgTypes[146]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10582,7 +10956,7 @@ func (x *RegisterUserRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RegisterUserRequest.ProtoReflect.Descriptor instead.
 func (*RegisterUserRequest) Descriptor() ([]byte, []int) {
-	return file_local_nitella_local_proto_rawDescGZIP(), []int{141}
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{146}
 }
 
 func (x *RegisterUserRequest) GetEmail() string {
@@ -10620,7 +10994,7 @@ type RegisterUserResponse struct {
 
 func (x *RegisterUserResponse) Reset() {
 	*x = RegisterUserResponse{}
-	mi := &file_local_nitella_local_proto_msgTypes[142]
+	mi := &file_local_nitella_local_proto_msgTypes[147]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10632,7 +11006,7 @@ func (x *RegisterUserResponse) String() string {
 func (*RegisterUserResponse) ProtoMessage() {}
 
 func (x *RegisterUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_local_nitella_local_proto_msgTypes[142]
+	mi := &file_local_nitella_local_proto_msgTypes[147]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10645,7 +11019,7 @@ func (x *RegisterUserResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RegisterUserResponse.ProtoReflect.Descriptor instead.
 func (*RegisterUserResponse) Descriptor() ([]byte, []int) {
-	return file_local_nitella_local_proto_rawDescGZIP(), []int{142}
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{147}
 }
 
 func (x *RegisterUserResponse) GetSuccess() bool {
@@ -10709,7 +11083,7 @@ type OnboardHubRequest struct {
 
 func (x *OnboardHubRequest) Reset() {
 	*x = OnboardHubRequest{}
-	mi := &file_local_nitella_local_proto_msgTypes[143]
+	mi := &file_local_nitella_local_proto_msgTypes[148]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10721,7 +11095,7 @@ func (x *OnboardHubRequest) String() string {
 func (*OnboardHubRequest) ProtoMessage() {}
 
 func (x *OnboardHubRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_local_nitella_local_proto_msgTypes[143]
+	mi := &file_local_nitella_local_proto_msgTypes[148]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10734,7 +11108,7 @@ func (x *OnboardHubRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OnboardHubRequest.ProtoReflect.Descriptor instead.
 func (*OnboardHubRequest) Descriptor() ([]byte, []int) {
-	return file_local_nitella_local_proto_rawDescGZIP(), []int{143}
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{148}
 }
 
 func (x *OnboardHubRequest) GetHubAddress() string {
@@ -10806,7 +11180,7 @@ type EnsureHubRegisteredRequest struct {
 
 func (x *EnsureHubRegisteredRequest) Reset() {
 	*x = EnsureHubRegisteredRequest{}
-	mi := &file_local_nitella_local_proto_msgTypes[144]
+	mi := &file_local_nitella_local_proto_msgTypes[149]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10818,7 +11192,7 @@ func (x *EnsureHubRegisteredRequest) String() string {
 func (*EnsureHubRegisteredRequest) ProtoMessage() {}
 
 func (x *EnsureHubRegisteredRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_local_nitella_local_proto_msgTypes[144]
+	mi := &file_local_nitella_local_proto_msgTypes[149]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10831,7 +11205,7 @@ func (x *EnsureHubRegisteredRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EnsureHubRegisteredRequest.ProtoReflect.Descriptor instead.
 func (*EnsureHubRegisteredRequest) Descriptor() ([]byte, []int) {
-	return file_local_nitella_local_proto_rawDescGZIP(), []int{144}
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{149}
 }
 
 func (x *EnsureHubRegisteredRequest) GetHubAddress() string {
@@ -10880,7 +11254,7 @@ type EnsureHubConnectedRequest struct {
 
 func (x *EnsureHubConnectedRequest) Reset() {
 	*x = EnsureHubConnectedRequest{}
-	mi := &file_local_nitella_local_proto_msgTypes[145]
+	mi := &file_local_nitella_local_proto_msgTypes[150]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10892,7 +11266,7 @@ func (x *EnsureHubConnectedRequest) String() string {
 func (*EnsureHubConnectedRequest) ProtoMessage() {}
 
 func (x *EnsureHubConnectedRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_local_nitella_local_proto_msgTypes[145]
+	mi := &file_local_nitella_local_proto_msgTypes[150]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10905,7 +11279,7 @@ func (x *EnsureHubConnectedRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EnsureHubConnectedRequest.ProtoReflect.Descriptor instead.
 func (*EnsureHubConnectedRequest) Descriptor() ([]byte, []int) {
-	return file_local_nitella_local_proto_rawDescGZIP(), []int{145}
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{150}
 }
 
 func (x *EnsureHubConnectedRequest) GetHubAddress() string {
@@ -10943,7 +11317,7 @@ type HubTrustChallenge struct {
 
 func (x *HubTrustChallenge) Reset() {
 	*x = HubTrustChallenge{}
-	mi := &file_local_nitella_local_proto_msgTypes[146]
+	mi := &file_local_nitella_local_proto_msgTypes[151]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10955,7 +11329,7 @@ func (x *HubTrustChallenge) String() string {
 func (*HubTrustChallenge) ProtoMessage() {}
 
 func (x *HubTrustChallenge) ProtoReflect() protoreflect.Message {
-	mi := &file_local_nitella_local_proto_msgTypes[146]
+	mi := &file_local_nitella_local_proto_msgTypes[151]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10968,7 +11342,7 @@ func (x *HubTrustChallenge) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HubTrustChallenge.ProtoReflect.Descriptor instead.
 func (*HubTrustChallenge) Descriptor() ([]byte, []int) {
-	return file_local_nitella_local_proto_rawDescGZIP(), []int{146}
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{151}
 }
 
 func (x *HubTrustChallenge) GetCaPem() []byte {
@@ -11023,7 +11397,7 @@ type ResolveHubTrustChallengeRequest struct {
 
 func (x *ResolveHubTrustChallengeRequest) Reset() {
 	*x = ResolveHubTrustChallengeRequest{}
-	mi := &file_local_nitella_local_proto_msgTypes[147]
+	mi := &file_local_nitella_local_proto_msgTypes[152]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11035,7 +11409,7 @@ func (x *ResolveHubTrustChallengeRequest) String() string {
 func (*ResolveHubTrustChallengeRequest) ProtoMessage() {}
 
 func (x *ResolveHubTrustChallengeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_local_nitella_local_proto_msgTypes[147]
+	mi := &file_local_nitella_local_proto_msgTypes[152]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11048,7 +11422,7 @@ func (x *ResolveHubTrustChallengeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResolveHubTrustChallengeRequest.ProtoReflect.Descriptor instead.
 func (*ResolveHubTrustChallengeRequest) Descriptor() ([]byte, []int) {
-	return file_local_nitella_local_proto_rawDescGZIP(), []int{147}
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{152}
 }
 
 func (x *ResolveHubTrustChallengeRequest) GetChallengeId() string {
@@ -11083,7 +11457,7 @@ type OnboardHubResponse struct {
 
 func (x *OnboardHubResponse) Reset() {
 	*x = OnboardHubResponse{}
-	mi := &file_local_nitella_local_proto_msgTypes[148]
+	mi := &file_local_nitella_local_proto_msgTypes[153]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11095,7 +11469,7 @@ func (x *OnboardHubResponse) String() string {
 func (*OnboardHubResponse) ProtoMessage() {}
 
 func (x *OnboardHubResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_local_nitella_local_proto_msgTypes[148]
+	mi := &file_local_nitella_local_proto_msgTypes[153]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11108,7 +11482,7 @@ func (x *OnboardHubResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OnboardHubResponse.ProtoReflect.Descriptor instead.
 func (*OnboardHubResponse) Descriptor() ([]byte, []int) {
-	return file_local_nitella_local_proto_rawDescGZIP(), []int{148}
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{153}
 }
 
 func (x *OnboardHubResponse) GetStage() OnboardHubResponse_Stage {
@@ -11190,7 +11564,7 @@ type LookupIPRequest struct {
 
 func (x *LookupIPRequest) Reset() {
 	*x = LookupIPRequest{}
-	mi := &file_local_nitella_local_proto_msgTypes[149]
+	mi := &file_local_nitella_local_proto_msgTypes[154]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11202,7 +11576,7 @@ func (x *LookupIPRequest) String() string {
 func (*LookupIPRequest) ProtoMessage() {}
 
 func (x *LookupIPRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_local_nitella_local_proto_msgTypes[149]
+	mi := &file_local_nitella_local_proto_msgTypes[154]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11215,7 +11589,7 @@ func (x *LookupIPRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LookupIPRequest.ProtoReflect.Descriptor instead.
 func (*LookupIPRequest) Descriptor() ([]byte, []int) {
-	return file_local_nitella_local_proto_rawDescGZIP(), []int{149}
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{154}
 }
 
 func (x *LookupIPRequest) GetIp() string {
@@ -11235,7 +11609,7 @@ type LookupIPResponse struct {
 
 func (x *LookupIPResponse) Reset() {
 	*x = LookupIPResponse{}
-	mi := &file_local_nitella_local_proto_msgTypes[150]
+	mi := &file_local_nitella_local_proto_msgTypes[155]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11247,7 +11621,7 @@ func (x *LookupIPResponse) String() string {
 func (*LookupIPResponse) ProtoMessage() {}
 
 func (x *LookupIPResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_local_nitella_local_proto_msgTypes[150]
+	mi := &file_local_nitella_local_proto_msgTypes[155]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11260,7 +11634,7 @@ func (x *LookupIPResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LookupIPResponse.ProtoReflect.Descriptor instead.
 func (*LookupIPResponse) Descriptor() ([]byte, []int) {
-	return file_local_nitella_local_proto_rawDescGZIP(), []int{150}
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{155}
 }
 
 func (x *LookupIPResponse) GetGeo() *common.GeoInfo {
@@ -11287,7 +11661,7 @@ type ConfigureGeoIPNodeRequest struct {
 
 func (x *ConfigureGeoIPNodeRequest) Reset() {
 	*x = ConfigureGeoIPNodeRequest{}
-	mi := &file_local_nitella_local_proto_msgTypes[151]
+	mi := &file_local_nitella_local_proto_msgTypes[156]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11299,7 +11673,7 @@ func (x *ConfigureGeoIPNodeRequest) String() string {
 func (*ConfigureGeoIPNodeRequest) ProtoMessage() {}
 
 func (x *ConfigureGeoIPNodeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_local_nitella_local_proto_msgTypes[151]
+	mi := &file_local_nitella_local_proto_msgTypes[156]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11312,7 +11686,7 @@ func (x *ConfigureGeoIPNodeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ConfigureGeoIPNodeRequest.ProtoReflect.Descriptor instead.
 func (*ConfigureGeoIPNodeRequest) Descriptor() ([]byte, []int) {
-	return file_local_nitella_local_proto_rawDescGZIP(), []int{151}
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{156}
 }
 
 func (x *ConfigureGeoIPNodeRequest) GetNodeId() string {
@@ -11338,7 +11712,7 @@ type GetGeoIPStatusNodeRequest struct {
 
 func (x *GetGeoIPStatusNodeRequest) Reset() {
 	*x = GetGeoIPStatusNodeRequest{}
-	mi := &file_local_nitella_local_proto_msgTypes[152]
+	mi := &file_local_nitella_local_proto_msgTypes[157]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11350,7 +11724,7 @@ func (x *GetGeoIPStatusNodeRequest) String() string {
 func (*GetGeoIPStatusNodeRequest) ProtoMessage() {}
 
 func (x *GetGeoIPStatusNodeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_local_nitella_local_proto_msgTypes[152]
+	mi := &file_local_nitella_local_proto_msgTypes[157]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11363,7 +11737,7 @@ func (x *GetGeoIPStatusNodeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetGeoIPStatusNodeRequest.ProtoReflect.Descriptor instead.
 func (*GetGeoIPStatusNodeRequest) Descriptor() ([]byte, []int) {
-	return file_local_nitella_local_proto_rawDescGZIP(), []int{152}
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{157}
 }
 
 func (x *GetGeoIPStatusNodeRequest) GetNodeId() string {
@@ -11382,7 +11756,7 @@ type RestartListenersNodeRequest struct {
 
 func (x *RestartListenersNodeRequest) Reset() {
 	*x = RestartListenersNodeRequest{}
-	mi := &file_local_nitella_local_proto_msgTypes[153]
+	mi := &file_local_nitella_local_proto_msgTypes[158]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11394,7 +11768,7 @@ func (x *RestartListenersNodeRequest) String() string {
 func (*RestartListenersNodeRequest) ProtoMessage() {}
 
 func (x *RestartListenersNodeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_local_nitella_local_proto_msgTypes[153]
+	mi := &file_local_nitella_local_proto_msgTypes[158]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11407,7 +11781,7 @@ func (x *RestartListenersNodeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RestartListenersNodeRequest.ProtoReflect.Descriptor instead.
 func (*RestartListenersNodeRequest) Descriptor() ([]byte, []int) {
-	return file_local_nitella_local_proto_rawDescGZIP(), []int{153}
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{158}
 }
 
 func (x *RestartListenersNodeRequest) GetNodeId() string {
@@ -11417,6 +11791,74 @@ func (x *RestartListenersNodeRequest) GetNodeId() string {
 	return ""
 }
 
+type GetMockTranscriptsNodeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NodeId        string                 `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	ConnId        string                 `protobuf:"bytes,2,opt,name=conn_id,json=connId,proto3" json:"conn_id,omitempty"`       // Optional: a single session
+	SourceIp      string                 `protobuf:"bytes,3,opt,name=source_ip,json=sourceIp,proto3" json:"source_ip,omitempty"` // Optional: filter by IP
+	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMockTranscriptsNodeRequest) Reset() {
+	*x = GetMockTranscriptsNodeRequest{}
+	mi := &file_local_nitella_local_proto_msgTypes[159]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMockTranscriptsNodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMockTranscriptsNodeRequest) ProtoMessage() {}
+
+func (x *GetMockTranscriptsNodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_local_nitella_local_proto_msgTypes[159]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMockTranscriptsNodeRequest.ProtoReflect.Descriptor instead.
+func (*GetMockTranscriptsNodeRequest) Descriptor() ([]byte, []int) {
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{159}
+}
+
+func (x *GetMockTranscriptsNodeRequest) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *GetMockTranscriptsNodeRequest) GetConnId() string {
+	if x != nil {
+		return x.ConnId
+	}
+	return ""
+}
+
+func (x *GetMockTranscriptsNodeRequest) GetSourceIp() string {
+	if x != nil {
+		return x.SourceIp
+	}
+	return ""
+}
+
+func (x *GetMockTranscriptsNodeRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
 type NodeStatusChange struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	NodeId        string                 `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
@@ -11429,7 +11871,7 @@ type NodeStatusChange struct {
 
 func (x *NodeStatusChange) Reset() {
 	*x = NodeStatusChange{}
-	mi := &file_local_nitella_local_proto_msgTypes[154]
+	mi := &file_local_nitella_local_proto_msgTypes[160]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11441,7 +11883,7 @@ func (x *NodeStatusChange) String() string {
 func (*NodeStatusChange) ProtoMessage() {}
 
 func (x *NodeStatusChange) ProtoReflect() protoreflect.Message {
-	mi := &file_local_nitella_local_proto_msgTypes[154]
+	mi := &file_local_nitella_local_proto_msgTypes[160]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11454,7 +11896,7 @@ func (x *NodeStatusChange) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NodeStatusChange.ProtoReflect.Descriptor instead.
 func (*NodeStatusChange) Descriptor() ([]byte, []int) {
-	return file_local_nitella_local_proto_rawDescGZIP(), []int{154}
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{160}
 }
 
 func (x *NodeStatusChange) GetNodeId() string {
@@ -11500,7 +11942,7 @@ type Alert struct {
 
 func (x *Alert) Reset() {
 	*x = Alert{}
-	mi := &file_local_nitella_local_proto_msgTypes[155]
+	mi := &file_local_nitella_local_proto_msgTypes[161]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11512,7 +11954,7 @@ func (x *Alert) String() string {
 func (*Alert) ProtoMessage() {}
 
 func (x *Alert) ProtoReflect() protoreflect.Message {
-	mi := &file_local_nitella_local_proto_msgTypes[155]
+	mi := &file_local_nitella_local_proto_msgTypes[161]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11525,7 +11967,7 @@ func (x *Alert) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Alert.ProtoReflect.Descriptor instead.
 func (*Alert) Descriptor() ([]byte, []int) {
-	return file_local_nitella_local_proto_rawDescGZIP(), []int{155}
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{161}
 }
 
 func (x *Alert) GetId() string {
@@ -11588,7 +12030,7 @@ type ToastMessage struct {
 
 func (x *ToastMessage) Reset() {
 	*x = ToastMessage{}
-	mi := &file_local_nitella_local_proto_msgTypes[156]
+	mi := &file_local_nitella_local_proto_msgTypes[162]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11600,7 +12042,7 @@ func (x *ToastMessage) String() string {
 func (*ToastMessage) ProtoMessage() {}
 
 func (x *ToastMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_local_nitella_local_proto_msgTypes[156]
+	mi := &file_local_nitella_local_proto_msgTypes[162]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11613,7 +12055,7 @@ func (x *ToastMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ToastMessage.ProtoReflect.Descriptor instead.
 func (*ToastMessage) Descriptor() ([]byte, []int) {
-	return file_local_nitella_local_proto_rawDescGZIP(), []int{156}
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{162}
 }
 
 func (x *ToastMessage) GetMessage() string {
@@ -11649,7 +12091,7 @@ type P2PStatus struct {
 
 func (x *P2PStatus) Reset() {
 	*x = P2PStatus{}
-	mi := &file_local_nitella_local_proto_msgTypes[157]
+	mi := &file_local_nitella_local_proto_msgTypes[163]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11661,7 +12103,7 @@ func (x *P2PStatus) String() string {
 func (*P2PStatus) ProtoMessage() {}
 
 func (x *P2PStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_local_nitella_local_proto_msgTypes[157]
+	mi := &file_local_nitella_local_proto_msgTypes[163]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11674,7 +12116,7 @@ func (x *P2PStatus) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use P2PStatus.ProtoReflect.Descriptor instead.
 func (*P2PStatus) Descriptor() ([]byte, []int) {
-	return file_local_nitella_local_proto_rawDescGZIP(), []int{157}
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{163}
 }
 
 func (x *P2PStatus) GetEnabled() bool {
@@ -11715,7 +12157,7 @@ type P2PSettingsSnapshot struct {
 
 func (x *P2PSettingsSnapshot) Reset() {
 	*x = P2PSettingsSnapshot{}
-	mi := &file_local_nitella_local_proto_msgTypes[158]
+	mi := &file_local_nitella_local_proto_msgTypes[164]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11727,7 +12169,7 @@ func (x *P2PSettingsSnapshot) String() string {
 func (*P2PSettingsSnapshot) ProtoMessage() {}
 
 func (x *P2PSettingsSnapshot) ProtoReflect() protoreflect.Message {
-	mi := &file_local_nitella_local_proto_msgTypes[158]
+	mi := &file_local_nitella_local_proto_msgTypes[164]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11740,7 +12182,7 @@ func (x *P2PSettingsSnapshot) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use P2PSettingsSnapshot.ProtoReflect.Descriptor instead.
 func (*P2PSettingsSnapshot) Descriptor() ([]byte, []int) {
-	return file_local_nitella_local_proto_rawDescGZIP(), []int{158}
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{164}
 }
 
 func (x *P2PSettingsSnapshot) GetStatus() *P2PStatus {
@@ -11766,7 +12208,7 @@ type SetP2PModeRequest struct {
 
 func (x *SetP2PModeRequest) Reset() {
 	*x = SetP2PModeRequest{}
-	mi := &file_local_nitella_local_proto_msgTypes[159]
+	mi := &file_local_nitella_local_proto_msgTypes[165]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11778,7 +12220,7 @@ func (x *SetP2PModeRequest) String() string {
 func (*SetP2PModeRequest) ProtoMessage() {}
 
 func (x *SetP2PModeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_local_nitella_local_proto_msgTypes[159]
+	mi := &file_local_nitella_local_proto_msgTypes[165]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11791,7 +12233,7 @@ func (x *SetP2PModeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetP2PModeRequest.ProtoReflect.Descriptor instead.
 func (*SetP2PModeRequest) Descriptor() ([]byte, []int) {
-	return file_local_nitella_local_proto_rawDescGZIP(), []int{159}
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{165}
 }
 
 func (x *SetP2PModeRequest) GetMode() common.P2PMode {
@@ -11817,7 +12259,7 @@ type LocalProxyConfig struct {
 
 func (x *LocalProxyConfig) Reset() {
 	*x = LocalProxyConfig{}
-	mi := &file_local_nitella_local_proto_msgTypes[160]
+	mi := &file_local_nitella_local_proto_msgTypes[166]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11829,7 +12271,7 @@ func (x *LocalProxyConfig) String() string {
 func (*LocalProxyConfig) ProtoMessage() {}
 
 func (x *LocalProxyConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_local_nitella_local_proto_msgTypes[160]
+	mi := &file_local_nitella_local_proto_msgTypes[166]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11842,7 +12284,7 @@ func (x *LocalProxyConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LocalProxyConfig.ProtoReflect.Descriptor instead.
 func (*LocalProxyConfig) Descriptor() ([]byte, []int) {
-	return file_local_nitella_local_proto_rawDescGZIP(), []int{160}
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{166}
 }
 
 func (x *LocalProxyConfig) GetProxyId() string {
@@ -11909,7 +12351,7 @@ type ListLocalProxyConfigsRequest struct {
 
 func (x *ListLocalProxyConfigsRequest) Reset() {
 	*x = ListLocalProxyConfigsRequest{}
-	mi := &file_local_nitella_local_proto_msgTypes[161]
+	mi := &file_local_nitella_local_proto_msgTypes[167]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11921,7 +12363,7 @@ func (x *ListLocalProxyConfigsRequest) String() string {
 func (*ListLocalProxyConfigsRequest) ProtoMessage() {}
 
 func (x *ListLocalProxyConfigsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_local_nitella_local_proto_msgTypes[161]
+	mi := &file_local_nitella_local_proto_msgTypes[167]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11934,7 +12376,7 @@ func (x *ListLocalProxyConfigsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListLocalProxyConfigsRequest.ProtoReflect.Descriptor instead.
 func (*ListLocalProxyConfigsRequest) Descriptor() ([]byte, []int) {
-	return file_local_nitella_local_proto_rawDescGZIP(), []int{161}
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{167}
 }
 
 type ListLocalProxyConfigsResponse struct {
@@ -11946,7 +12388,7 @@ type ListLocalProxyConfigsResponse struct {
 
 func (x *ListLocalProxyConfigsResponse) Reset() {
 	*x = ListLocalProxyConfigsResponse{}
-	mi := &file_local_nitella_local_proto_msgTypes[162]
+	mi := &file_local_nitella_local_proto_msgTypes[168]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11958,7 +12400,7 @@ func (x *ListLocalProxyConfigsResponse) String() string {
 func (*ListLocalProxyConfigsResponse) ProtoMessage() {}
 
 func (x *ListLocalProxyConfigsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_local_nitella_local_proto_msgTypes[162]
+	mi := &file_local_nitella_local_proto_msgTypes[168]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11971,7 +12413,7 @@ func (x *ListLocalProxyConfigsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListLocalProxyConfigsResponse.ProtoReflect.Descriptor instead.
 func (*ListLocalProxyConfigsResponse) Descriptor() ([]byte, []int) {
-	return file_local_nitella_local_proto_rawDescGZIP(), []int{162}
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{168}
 }
 
 func (x *ListLocalProxyConfigsResponse) GetProxies() []*LocalProxyConfig {
@@ -11990,7 +12432,7 @@ type GetLocalProxyConfigRequest struct {
 
 func (x *GetLocalProxyConfigRequest) Reset() {
 	*x = GetLocalProxyConfigRequest{}
-	mi := &file_local_nitella_local_proto_msgTypes[163]
+	mi := &file_local_nitella_local_proto_msgTypes[169]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -12002,7 +12444,7 @@ func (x *GetLocalProxyConfigRequest) String() string {
 func (*GetLocalProxyConfigRequest) ProtoMessage() {}
 
 func (x *GetLocalProxyConfigRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_local_nitella_local_proto_msgTypes[163]
+	mi := &file_local_nitella_local_proto_msgTypes[169]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12015,7 +12457,7 @@ func (x *GetLocalProxyConfigRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetLocalProxyConfigRequest.ProtoReflect.Descriptor instead.
 func (*GetLocalProxyConfigRequest) Descriptor() ([]byte, []int) {
-	return file_local_nitella_local_proto_rawDescGZIP(), []int{163}
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{169}
 }
 
 func (x *GetLocalProxyConfigRequest) GetProxyId() string {
@@ -12037,7 +12479,7 @@ type GetLocalProxyConfigResponse struct {
 
 func (x *GetLocalProxyConfigResponse) Reset() {
 	*x = GetLocalProxyConfigResponse{}
-	mi := &file_local_nitella_local_proto_msgTypes[164]
+	mi := &file_local_nitella_local_proto_msgTypes[170]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -12049,7 +12491,7 @@ func (x *GetLocalProxyConfigResponse) String() string {
 func (*GetLocalProxyConfigResponse) ProtoMessage() {}
 
 func (x *GetLocalProxyConfigResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_local_nitella_local_proto_msgTypes[164]
+	mi := &file_local_nitella_local_proto_msgTypes[170]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12062,7 +12504,7 @@ func (x *GetLocalProxyConfigResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetLocalProxyConfigResponse.ProtoReflect.Descriptor instead.
 func (*GetLocalProxyConfigResponse) Descriptor() ([]byte, []int) {
-	return file_local_nitella_local_proto_rawDescGZIP(), []int{164}
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{170}
 }
 
 func (x *GetLocalProxyConfigResponse) GetSuccess() bool {
@@ -12104,7 +12546,7 @@ type ImportLocalProxyConfigRequest struct {
 
 func (x *ImportLocalProxyConfigRequest) Reset() {
 	*x = ImportLocalProxyConfigRequest{}
-	mi := &file_local_nitella_local_proto_msgTypes[165]
+	mi := &file_local_nitella_local_proto_msgTypes[171]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -12116,7 +12558,7 @@ func (x *ImportLocalProxyConfigRequest) String() string {
 func (*ImportLocalProxyConfigRequest) ProtoMessage() {}
 
 func (x *ImportLocalProxyConfigRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_local_nitella_local_proto_msgTypes[165]
+	mi := &file_local_nitella_local_proto_msgTypes[171]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12129,7 +12571,7 @@ func (x *ImportLocalProxyConfigRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ImportLocalProxyConfigRequest.ProtoReflect.Descriptor instead.
 func (*ImportLocalProxyConfigRequest) Descriptor() ([]byte, []int) {
-	return file_local_nitella_local_proto_rawDescGZIP(), []int{165}
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{171}
 }
 
 func (x *ImportLocalProxyConfigRequest) GetConfigData() []byte {
@@ -12165,7 +12607,7 @@ type ImportLocalProxyConfigResponse struct {
 
 func (x *ImportLocalProxyConfigResponse) Reset() {
 	*x = ImportLocalProxyConfigResponse{}
-	mi := &file_local_nitella_local_proto_msgTypes[166]
+	mi := &file_local_nitella_local_proto_msgTypes[172]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -12177,7 +12619,7 @@ func (x *ImportLocalProxyConfigResponse) String() string {
 func (*ImportLocalProxyConfigResponse) ProtoMessage() {}
 
 func (x *ImportLocalProxyConfigResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_local_nitella_local_proto_msgTypes[166]
+	mi := &file_local_nitella_local_proto_msgTypes[172]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12190,7 +12632,7 @@ func (x *ImportLocalProxyConfigResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ImportLocalProxyConfigResponse.ProtoReflect.Descriptor instead.
 func (*ImportLocalProxyConfigResponse) Descriptor() ([]byte, []int) {
-	return file_local_nitella_local_proto_rawDescGZIP(), []int{166}
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{172}
 }
 
 func (x *ImportLocalProxyConfigResponse) GetSuccess() bool {
@@ -12236,7 +12678,7 @@ type SaveLocalProxyConfigRequest struct {
 
 func (x *SaveLocalProxyConfigRequest) Reset() {
 	*x = SaveLocalProxyConfigRequest{}
-	mi := &file_local_nitella_local_proto_msgTypes[167]
+	mi := &file_local_nitella_local_proto_msgTypes[173]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -12248,7 +12690,7 @@ func (x *SaveLocalProxyConfigRequest) String() string {
 func (*SaveLocalProxyConfigRequest) ProtoMessage() {}
 
 func (x *SaveLocalProxyConfigRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_local_nitella_local_proto_msgTypes[167]
+	mi := &file_local_nitella_local_proto_msgTypes[173]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12261,7 +12703,7 @@ func (x *SaveLocalProxyConfigRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SaveLocalProxyConfigRequest.ProtoReflect.Descriptor instead.
 func (*SaveLocalProxyConfigRequest) Descriptor() ([]byte, []int) {
-	return file_local_nitella_local_proto_rawDescGZIP(), []int{167}
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{173}
 }
 
 func (x *SaveLocalProxyConfigRequest) GetProxyId() string {
@@ -12324,7 +12766,7 @@ type SaveLocalProxyConfigResponse struct {
 
 func (x *SaveLocalProxyConfigResponse) Reset() {
 	*x = SaveLocalProxyConfigResponse{}
-	mi := &file_local_nitella_local_proto_msgTypes[168]
+	mi := &file_local_nitella_local_proto_msgTypes[174]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -12336,7 +12778,7 @@ func (x *SaveLocalProxyConfigResponse) String() string {
 func (*SaveLocalProxyConfigResponse) ProtoMessage() {}
 
 func (x *SaveLocalProxyConfigResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_local_nitella_local_proto_msgTypes[168]
+	mi := &file_local_nitella_local_proto_msgTypes[174]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12349,7 +12791,7 @@ func (x *SaveLocalProxyConfigResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SaveLocalProxyConfigResponse.ProtoReflect.Descriptor instead.
 func (*SaveLocalProxyConfigResponse) Descriptor() ([]byte, []int) {
-	return file_local_nitella_local_proto_rawDescGZIP(), []int{168}
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{174}
 }
 
 func (x *SaveLocalProxyConfigResponse) GetSuccess() bool {
@@ -12382,7 +12824,7 @@ type DeleteLocalProxyConfigRequest struct {
 
 func (x *DeleteLocalProxyConfigRequest) Reset() {
 	*x = DeleteLocalProxyConfigRequest{}
-	mi := &file_local_nitella_local_proto_msgTypes[169]
+	mi := &file_local_nitella_local_proto_msgTypes[175]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -12394,7 +12836,7 @@ func (x *DeleteLocalProxyConfigRequest) String() string {
 func (*DeleteLocalProxyConfigRequest) ProtoMessage() {}
 
 func (x *DeleteLocalProxyConfigRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_local_nitella_local_proto_msgTypes[169]
+	mi := &file_local_nitella_local_proto_msgTypes[175]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12407,7 +12849,7 @@ func (x *DeleteLocalProxyConfigRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteLocalProxyConfigRequest.ProtoReflect.Descriptor instead.
 func (*DeleteLocalProxyConfigRequest) Descriptor() ([]byte, []int) {
-	return file_local_nitella_local_proto_rawDescGZIP(), []int{169}
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{175}
 }
 
 func (x *DeleteLocalProxyConfigRequest) GetProxyId() string {
@@ -12427,7 +12869,7 @@ type DeleteLocalProxyConfigResponse struct {
 
 func (x *DeleteLocalProxyConfigResponse) Reset() {
 	*x = DeleteLocalProxyConfigResponse{}
-	mi := &file_local_nitella_local_proto_msgTypes[170]
+	mi := &file_local_nitella_local_proto_msgTypes[176]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -12439,7 +12881,7 @@ func (x *DeleteLocalProxyConfigResponse) String() string {
 func (*DeleteLocalProxyConfigResponse) ProtoMessage() {}
 
 func (x *DeleteLocalProxyConfigResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_local_nitella_local_proto_msgTypes[170]
+	mi := &file_local_nitella_local_proto_msgTypes[176]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12452,7 +12894,7 @@ func (x *DeleteLocalProxyConfigResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteLocalProxyConfigResponse.ProtoReflect.Descriptor instead.
 func (*DeleteLocalProxyConfigResponse) Descriptor() ([]byte, []int) {
-	return file_local_nitella_local_proto_rawDescGZIP(), []int{170}
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{176}
 }
 
 func (x *DeleteLocalProxyConfigResponse) GetSuccess() bool {
@@ -12478,7 +12920,7 @@ type ValidateLocalProxyConfigRequest struct {
 
 func (x *ValidateLocalProxyConfigRequest) Reset() {
 	*x = ValidateLocalProxyConfigRequest{}
-	mi := &file_local_nitella_local_proto_msgTypes[171]
+	mi := &file_local_nitella_local_proto_msgTypes[177]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -12490,7 +12932,7 @@ func (x *ValidateLocalProxyConfigRequest) String() string {
 func (*ValidateLocalProxyConfigRequest) ProtoMessage() {}
 
 func (x *ValidateLocalProxyConfigRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_local_nitella_local_proto_msgTypes[171]
+	mi := &file_local_nitella_local_proto_msgTypes[177]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12503,7 +12945,7 @@ func (x *ValidateLocalProxyConfigRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ValidateLocalProxyConfigRequest.ProtoReflect.Descriptor instead.
 func (*ValidateLocalProxyConfigRequest) Descriptor() ([]byte, []int) {
-	return file_local_nitella_local_proto_rawDescGZIP(), []int{171}
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{177}
 }
 
 func (x *ValidateLocalProxyConfigRequest) GetProxyId() string {
@@ -12533,7 +12975,7 @@ type ValidateLocalProxyConfigResponse struct {
 
 func (x *ValidateLocalProxyConfigResponse) Reset() {
 	*x = ValidateLocalProxyConfigResponse{}
-	mi := &file_local_nitella_local_proto_msgTypes[172]
+	mi := &file_local_nitella_local_proto_msgTypes[178]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -12545,7 +12987,7 @@ func (x *ValidateLocalProxyConfigResponse) String() string {
 func (*ValidateLocalProxyConfigResponse) ProtoMessage() {}
 
 func (x *ValidateLocalProxyConfigResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_local_nitella_local_proto_msgTypes[172]
+	mi := &file_local_nitella_local_proto_msgTypes[178]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12558,7 +13000,7 @@ func (x *ValidateLocalProxyConfigResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ValidateLocalProxyConfigResponse.ProtoReflect.Descriptor instead.
 func (*ValidateLocalProxyConfigResponse) Descriptor() ([]byte, []int) {
-	return file_local_nitella_local_proto_rawDescGZIP(), []int{172}
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{178}
 }
 
 func (x *ValidateLocalProxyConfigResponse) GetSuccess() bool {
@@ -12659,7 +13101,7 @@ type PushProxyRevisionRequest struct {
 
 func (x *PushProxyRevisionRequest) Reset() {
 	*x = PushProxyRevisionRequest{}
-	mi := &file_local_nitella_local_proto_msgTypes[173]
+	mi := &file_local_nitella_local_proto_msgTypes[179]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -12671,7 +13113,7 @@ func (x *PushProxyRevisionRequest) String() string {
 func (*PushProxyRevisionRequest) ProtoMessage() {}
 
 func (x *PushProxyRevisionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_local_nitella_local_proto_msgTypes[173]
+	mi := &file_local_nitella_local_proto_msgTypes[179]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12684,7 +13126,7 @@ func (x *PushProxyRevisionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PushProxyRevisionRequest.ProtoReflect.Descriptor instead.
 func (*PushProxyRevisionRequest) Descriptor() ([]byte, []int) {
-	return file_local_nitella_local_proto_rawDescGZIP(), []int{173}
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{179}
 }
 
 func (x *PushProxyRevisionRequest) GetProxyId() string {
@@ -12744,7 +13186,7 @@ type PushProxyRevisionResponse struct {
 
 func (x *PushProxyRevisionResponse) Reset() {
 	*x = PushProxyRevisionResponse{}
-	mi := &file_local_nitella_local_proto_msgTypes[174]
+	mi := &file_local_nitella_local_proto_msgTypes[180]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -12756,7 +13198,7 @@ func (x *PushProxyRevisionResponse) String() string {
 func (*PushProxyRevisionResponse) ProtoMessage() {}
 
 func (x *PushProxyRevisionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_local_nitella_local_proto_msgTypes[174]
+	mi := &file_local_nitella_local_proto_msgTypes[180]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12769,7 +13211,7 @@ func (x *PushProxyRevisionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PushProxyRevisionResponse.ProtoReflect.Descriptor instead.
 func (*PushProxyRevisionResponse) Descriptor() ([]byte, []int) {
-	return file_local_nitella_local_proto_rawDescGZIP(), []int{174}
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{180}
 }
 
 func (x *PushProxyRevisionResponse) GetSuccess() bool {
@@ -12832,7 +13274,7 @@ type PushLocalProxyRevisionRequest struct {
 
 func (x *PushLocalProxyRevisionRequest) Reset() {
 	*x = PushLocalProxyRevisionRequest{}
-	mi := &file_local_nitella_local_proto_msgTypes[175]
+	mi := &file_local_nitella_local_proto_msgTypes[181]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -12844,7 +13286,7 @@ func (x *PushLocalProxyRevisionRequest) String() string {
 func (*PushLocalProxyRevisionRequest) ProtoMessage() {}
 
 func (x *PushLocalProxyRevisionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_local_nitella_local_proto_msgTypes[175]
+	mi := &file_local_nitella_local_proto_msgTypes[181]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12857,7 +13299,7 @@ func (x *PushLocalProxyRevisionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PushLocalProxyRevisionRequest.ProtoReflect.Descriptor instead.
 func (*PushLocalProxyRevisionRequest) Descriptor() ([]byte, []int) {
-	return file_local_nitella_local_proto_rawDescGZIP(), []int{175}
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{181}
 }
 
 func (x *PushLocalProxyRevisionRequest) GetProxyId() string {
@@ -12901,7 +13343,7 @@ type PushLocalProxyRevisionResponse struct {
 
 func (x *PushLocalProxyRevisionResponse) Reset() {
 	*x = PushLocalProxyRevisionResponse{}
-	mi := &file_local_nitella_local_proto_msgTypes[176]
+	mi := &file_local_nitella_local_proto_msgTypes[182]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -12913,7 +13355,7 @@ func (x *PushLocalProxyRevisionResponse) String() string {
 func (*PushLocalProxyRevisionResponse) ProtoMessage() {}
 
 func (x *PushLocalProxyRevisionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_local_nitella_local_proto_msgTypes[176]
+	mi := &file_local_nitella_local_proto_msgTypes[182]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -12926,7 +13368,7 @@ func (x *PushLocalProxyRevisionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PushLocalProxyRevisionResponse.ProtoReflect.Descriptor instead.
 func (*PushLocalProxyRevisionResponse) Descriptor() ([]byte, []int) {
-	return file_local_nitella_local_proto_rawDescGZIP(), []int{176}
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{182}
 }
 
 func (x *PushLocalProxyRevisionResponse) GetSuccess() bool {
@@ -13024,7 +13466,7 @@ type PullProxyRevisionRequest struct {
 
 func (x *PullProxyRevisionRequest) Reset() {
 	*x = PullProxyRevisionRequest{}
-	mi := &file_local_nitella_local_proto_msgTypes[177]
+	mi := &file_local_nitella_local_proto_msgTypes[183]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -13036,7 +13478,7 @@ func (x *PullProxyRevisionRequest) String() string {
 func (*PullProxyRevisionRequest) ProtoMessage() {}
 
 func (x *PullProxyRevisionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_local_nitella_local_proto_msgTypes[177]
+	mi := &file_local_nitella_local_proto_msgTypes[183]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13049,7 +13491,7 @@ func (x *PullProxyRevisionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PullProxyRevisionRequest.ProtoReflect.Descriptor instead.
 func (*PullProxyRevisionRequest) Descriptor() ([]byte, []int) {
-	return file_local_nitella_local_proto_rawDescGZIP(), []int{177}
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{183}
 }
 
 func (x *PullProxyRevisionRequest) GetProxyId() string {
@@ -13091,7 +13533,7 @@ type PullProxyRevisionResponse struct {
 
 func (x *PullProxyRevisionResponse) Reset() {
 	*x = PullProxyRevisionResponse{}
-	mi := &file_local_nitella_local_proto_msgTypes[178]
+	mi := &file_local_nitella_local_proto_msgTypes[184]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -13103,7 +13545,7 @@ func (x *PullProxyRevisionResponse) String() string {
 func (*PullProxyRevisionResponse) ProtoMessage() {}
 
 func (x *PullProxyRevisionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_local_nitella_local_proto_msgTypes[178]
+	mi := &file_local_nitella_local_proto_msgTypes[184]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13116,7 +13558,7 @@ func (x *PullProxyRevisionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PullProxyRevisionResponse.ProtoReflect.Descriptor instead.
 func (*PullProxyRevisionResponse) Descriptor() ([]byte, []int) {
-	return file_local_nitella_local_proto_rawDescGZIP(), []int{178}
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{184}
 }
 
 func (x *PullProxyRevisionResponse) GetSuccess() bool {
@@ -13201,7 +13643,7 @@ type DiffProxyRevisionsRequest struct {
 
 func (x *DiffProxyRevisionsRequest) Reset() {
 	*x = DiffProxyRevisionsRequest{}
-	mi := &file_local_nitella_local_proto_msgTypes[179]
+	mi := &file_local_nitella_local_proto_msgTypes[185]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -13213,7 +13655,7 @@ func (x *DiffProxyRevisionsRequest) String() string {
 func (*DiffProxyRevisionsRequest) ProtoMessage() {}
 
 func (x *DiffProxyRevisionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_local_nitella_local_proto_msgTypes[179]
+	mi := &file_local_nitella_local_proto_msgTypes[185]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13226,7 +13668,7 @@ func (x *DiffProxyRevisionsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DiffProxyRevisionsRequest.ProtoReflect.Descriptor instead.
 func (*DiffProxyRevisionsRequest) Descriptor() ([]byte, []int) {
-	return file_local_nitella_local_proto_rawDescGZIP(), []int{179}
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{185}
 }
 
 func (x *DiffProxyRevisionsRequest) GetProxyId() string {
@@ -13271,7 +13713,7 @@ type DiffProxyRevisionsResponse struct {
 
 func (x *DiffProxyRevisionsResponse) Reset() {
 	*x = DiffProxyRevisionsResponse{}
-	mi := &file_local_nitella_local_proto_msgTypes[180]
+	mi := &file_local_nitella_local_proto_msgTypes[186]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -13283,7 +13725,7 @@ func (x *DiffProxyRevisionsResponse) String() string {
 func (*DiffProxyRevisionsResponse) ProtoMessage() {}
 
 func (x *DiffProxyRevisionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_local_nitella_local_proto_msgTypes[180]
+	mi := &file_local_nitella_local_proto_msgTypes[186]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13296,7 +13738,7 @@ func (x *DiffProxyRevisionsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DiffProxyRevisionsResponse.ProtoReflect.Descriptor instead.
 func (*DiffProxyRevisionsResponse) Descriptor() ([]byte, []int) {
-	return file_local_nitella_local_proto_rawDescGZIP(), []int{180}
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{186}
 }
 
 func (x *DiffProxyRevisionsResponse) GetSuccess() bool {
@@ -13350,7 +13792,7 @@ type ListProxyRevisionsRequest struct {
 
 func (x *ListProxyRevisionsRequest) Reset() {
 	*x = ListProxyRevisionsRequest{}
-	mi := &file_local_nitella_local_proto_msgTypes[181]
+	mi := &file_local_nitella_local_proto_msgTypes[187]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -13362,7 +13804,7 @@ func (x *ListProxyRevisionsRequest) String() string {
 func (*ListProxyRevisionsRequest) ProtoMessage() {}
 
 func (x *ListProxyRevisionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_local_nitella_local_proto_msgTypes[181]
+	mi := &file_local_nitella_local_proto_msgTypes[187]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13375,7 +13817,7 @@ func (x *ListProxyRevisionsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListProxyRevisionsRequest.ProtoReflect.Descriptor instead.
 func (*ListProxyRevisionsRequest) Descriptor() ([]byte, []int) {
-	return file_local_nitella_local_proto_rawDescGZIP(), []int{181}
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{187}
 }
 
 func (x *ListProxyRevisionsRequest) GetProxyId() string {
@@ -13394,7 +13836,7 @@ type ListProxyRevisionsResponse struct {
 
 func (x *ListProxyRevisionsResponse) Reset() {
 	*x = ListProxyRevisionsResponse{}
-	mi := &file_local_nitella_local_proto_msgTypes[182]
+	mi := &file_local_nitella_local_proto_msgTypes[188]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -13406,7 +13848,7 @@ func (x *ListProxyRevisionsResponse) String() string {
 func (*ListProxyRevisionsResponse) ProtoMessage() {}
 
 func (x *ListProxyRevisionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_local_nitella_local_proto_msgTypes[182]
+	mi := &file_local_nitella_local_proto_msgTypes[188]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13419,7 +13861,7 @@ func (x *ListProxyRevisionsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListProxyRevisionsResponse.ProtoReflect.Descriptor instead.
 func (*ListProxyRevisionsResponse) Descriptor() ([]byte, []int) {
-	return file_local_nitella_local_proto_rawDescGZIP(), []int{182}
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{188}
 }
 
 func (x *ListProxyRevisionsResponse) GetRevisions() []*ProxyRevisionMeta {
@@ -13440,7 +13882,7 @@ type ProxyRevisionMeta struct {
 
 func (x *ProxyRevisionMeta) Reset() {
 	*x = ProxyRevisionMeta{}
-	mi := &file_local_nitella_local_proto_msgTypes[183]
+	mi := &file_local_nitella_local_proto_msgTypes[189]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -13452,7 +13894,7 @@ func (x *ProxyRevisionMeta) String() string {
 func (*ProxyRevisionMeta) ProtoMessage() {}
 
 func (x *ProxyRevisionMeta) ProtoReflect() protoreflect.Message {
-	mi := &file_local_nitella_local_proto_msgTypes[183]
+	mi := &file_local_nitella_local_proto_msgTypes[189]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13465,7 +13907,7 @@ func (x *ProxyRevisionMeta) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProxyRevisionMeta.ProtoReflect.Descriptor instead.
 func (*ProxyRevisionMeta) Descriptor() ([]byte, []int) {
-	return file_local_nitella_local_proto_rawDescGZIP(), []int{183}
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{189}
 }
 
 func (x *ProxyRevisionMeta) GetRevisionNum() int64 {
@@ -13499,7 +13941,7 @@ type FlushProxyRevisionsRequest struct {
 
 func (x *FlushProxyRevisionsRequest) Reset() {
 	*x = FlushProxyRevisionsRequest{}
-	mi := &file_local_nitella_local_proto_msgTypes[184]
+	mi := &file_local_nitella_local_proto_msgTypes[190]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -13511,7 +13953,7 @@ func (x *FlushProxyRevisionsRequest) String() string {
 func (*FlushProxyRevisionsRequest) ProtoMessage() {}
 
 func (x *FlushProxyRevisionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_local_nitella_local_proto_msgTypes[184]
+	mi := &file_local_nitella_local_proto_msgTypes[190]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13524,7 +13966,7 @@ func (x *FlushProxyRevisionsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FlushProxyRevisionsRequest.ProtoReflect.Descriptor instead.
 func (*FlushProxyRevisionsRequest) Descriptor() ([]byte, []int) {
-	return file_local_nitella_local_proto_rawDescGZIP(), []int{184}
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{190}
 }
 
 func (x *FlushProxyRevisionsRequest) GetProxyId() string {
@@ -13553,7 +13995,7 @@ type FlushProxyRevisionsResponse struct {
 
 func (x *FlushProxyRevisionsResponse) Reset() {
 	*x = FlushProxyRevisionsResponse{}
-	mi := &file_local_nitella_local_proto_msgTypes[185]
+	mi := &file_local_nitella_local_proto_msgTypes[191]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -13565,7 +14007,7 @@ func (x *FlushProxyRevisionsResponse) String() string {
 func (*FlushProxyRevisionsResponse) ProtoMessage() {}
 
 func (x *FlushProxyRevisionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_local_nitella_local_proto_msgTypes[185]
+	mi := &file_local_nitella_local_proto_msgTypes[191]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13578,7 +14020,7 @@ func (x *FlushProxyRevisionsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FlushProxyRevisionsResponse.ProtoReflect.Descriptor instead.
 func (*FlushProxyRevisionsResponse) Descriptor() ([]byte, []int) {
-	return file_local_nitella_local_proto_rawDescGZIP(), []int{185}
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{191}
 }
 
 func (x *FlushProxyRevisionsResponse) GetSuccess() bool {
@@ -13617,7 +14059,7 @@ type ListProxyConfigsRequest struct {
 
 func (x *ListProxyConfigsRequest) Reset() {
 	*x = ListProxyConfigsRequest{}
-	mi := &file_local_nitella_local_proto_msgTypes[186]
+	mi := &file_local_nitella_local_proto_msgTypes[192]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -13629,7 +14071,7 @@ func (x *ListProxyConfigsRequest) String() string {
 func (*ListProxyConfigsRequest) ProtoMessage() {}
 
 func (x *ListProxyConfigsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_local_nitella_local_proto_msgTypes[186]
+	mi := &file_local_nitella_local_proto_msgTypes[192]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13642,7 +14084,7 @@ func (x *ListProxyConfigsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListProxyConfigsRequest.ProtoReflect.Descriptor instead.
 func (*ListProxyConfigsRequest) Descriptor() ([]byte, []int) {
-	return file_local_nitella_local_proto_rawDescGZIP(), []int{186}
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{192}
 }
 
 type ListProxyConfigsResponse struct {
@@ -13654,7 +14096,7 @@ type ListProxyConfigsResponse struct {
 
 func (x *ListProxyConfigsResponse) Reset() {
 	*x = ListProxyConfigsResponse{}
-	mi := &file_local_nitella_local_proto_msgTypes[187]
+	mi := &file_local_nitella_local_proto_msgTypes[193]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -13666,7 +14108,7 @@ func (x *ListProxyConfigsResponse) String() string {
 func (*ListProxyConfigsResponse) ProtoMessage() {}
 
 func (x *ListProxyConfigsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_local_nitella_local_proto_msgTypes[187]
+	mi := &file_local_nitella_local_proto_msgTypes[193]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13679,7 +14121,7 @@ func (x *ListProxyConfigsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListProxyConfigsResponse.ProtoReflect.Descriptor instead.
 func (*ListProxyConfigsResponse) Descriptor() ([]byte, []int) {
-	return file_local_nitella_local_proto_rawDescGZIP(), []int{187}
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{193}
 }
 
 func (x *ListProxyConfigsResponse) GetProxies() []*ProxyConfigInfo {
@@ -13701,7 +14143,7 @@ type ProxyConfigInfo struct {
 
 func (x *ProxyConfigInfo) Reset() {
 	*x = ProxyConfigInfo{}
-	mi := &file_local_nitella_local_proto_msgTypes[188]
+	mi := &file_local_nitella_local_proto_msgTypes[194]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -13713,7 +14155,7 @@ func (x *ProxyConfigInfo) String() string {
 func (*ProxyConfigInfo) ProtoMessage() {}
 
 func (x *ProxyConfigInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_local_nitella_local_proto_msgTypes[188]
+	mi := &file_local_nitella_local_proto_msgTypes[194]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13726,7 +14168,7 @@ func (x *ProxyConfigInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProxyConfigInfo.ProtoReflect.Descriptor instead.
 func (*ProxyConfigInfo) Descriptor() ([]byte, []int) {
-	return file_local_nitella_local_proto_rawDescGZIP(), []int{188}
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{194}
 }
 
 func (x *ProxyConfigInfo) GetProxyId() string {
@@ -13766,7 +14208,7 @@ type CreateProxyConfigRequest struct {
 
 func (x *CreateProxyConfigRequest) Reset() {
 	*x = CreateProxyConfigRequest{}
-	mi := &file_local_nitella_local_proto_msgTypes[189]
+	mi := &file_local_nitella_local_proto_msgTypes[195]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -13778,7 +14220,7 @@ func (x *CreateProxyConfigRequest) String() string {
 func (*CreateProxyConfigRequest) ProtoMessage() {}
 
 func (x *CreateProxyConfigRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_local_nitella_local_proto_msgTypes[189]
+	mi := &file_local_nitella_local_proto_msgTypes[195]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13791,7 +14233,7 @@ func (x *CreateProxyConfigRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateProxyConfigRequest.ProtoReflect.Descriptor instead.
 func (*CreateProxyConfigRequest) Descriptor() ([]byte, []int) {
-	return file_local_nitella_local_proto_rawDescGZIP(), []int{189}
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{195}
 }
 
 func (x *CreateProxyConfigRequest) GetProxyId() string {
@@ -13811,7 +14253,7 @@ type CreateProxyConfigResponse struct {
 
 func (x *CreateProxyConfigResponse) Reset() {
 	*x = CreateProxyConfigResponse{}
-	mi := &file_local_nitella_local_proto_msgTypes[190]
+	mi := &file_local_nitella_local_proto_msgTypes[196]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -13823,7 +14265,7 @@ func (x *CreateProxyConfigResponse) String() string {
 func (*CreateProxyConfigResponse) ProtoMessage() {}
 
 func (x *CreateProxyConfigResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_local_nitella_local_proto_msgTypes[190]
+	mi := &file_local_nitella_local_proto_msgTypes[196]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13836,7 +14278,7 @@ func (x *CreateProxyConfigResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateProxyConfigResponse.ProtoReflect.Descriptor instead.
 func (*CreateProxyConfigResponse) Descriptor() ([]byte, []int) {
-	return file_local_nitella_local_proto_rawDescGZIP(), []int{190}
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{196}
 }
 
 func (x *CreateProxyConfigResponse) GetSuccess() bool {
@@ -13862,7 +14304,7 @@ type DeleteProxyConfigRequest struct {
 
 func (x *DeleteProxyConfigRequest) Reset() {
 	*x = DeleteProxyConfigRequest{}
-	mi := &file_local_nitella_local_proto_msgTypes[191]
+	mi := &file_local_nitella_local_proto_msgTypes[197]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -13874,7 +14316,7 @@ func (x *DeleteProxyConfigRequest) String() string {
 func (*DeleteProxyConfigRequest) ProtoMessage() {}
 
 func (x *DeleteProxyConfigRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_local_nitella_local_proto_msgTypes[191]
+	mi := &file_local_nitella_local_proto_msgTypes[197]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13887,7 +14329,7 @@ func (x *DeleteProxyConfigRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteProxyConfigRequest.ProtoReflect.Descriptor instead.
 func (*DeleteProxyConfigRequest) Descriptor() ([]byte, []int) {
-	return file_local_nitella_local_proto_rawDescGZIP(), []int{191}
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{197}
 }
 
 func (x *DeleteProxyConfigRequest) GetProxyId() string {
@@ -13907,7 +14349,7 @@ type DeleteProxyConfigResponse struct {
 
 func (x *DeleteProxyConfigResponse) Reset() {
 	*x = DeleteProxyConfigResponse{}
-	mi := &file_local_nitella_local_proto_msgTypes[192]
+	mi := &file_local_nitella_local_proto_msgTypes[198]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -13919,7 +14361,7 @@ func (x *DeleteProxyConfigResponse) String() string {
 func (*DeleteProxyConfigResponse) ProtoMessage() {}
 
 func (x *DeleteProxyConfigResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_local_nitella_local_proto_msgTypes[192]
+	mi := &file_local_nitella_local_proto_msgTypes[198]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13932,7 +14374,7 @@ func (x *DeleteProxyConfigResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteProxyConfigResponse.ProtoReflect.Descriptor instead.
 func (*DeleteProxyConfigResponse) Descriptor() ([]byte, []int) {
-	return file_local_nitella_local_proto_rawDescGZIP(), []int{192}
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{198}
 }
 
 func (x *DeleteProxyConfigResponse) GetSuccess() bool {
@@ -13962,7 +14404,7 @@ type ApplyProxyToNodeRequest struct {
 
 func (x *ApplyProxyToNodeRequest) Reset() {
 	*x = ApplyProxyToNodeRequest{}
-	mi := &file_local_nitella_local_proto_msgTypes[193]
+	mi := &file_local_nitella_local_proto_msgTypes[199]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -13974,7 +14416,7 @@ func (x *ApplyProxyToNodeRequest) String() string {
 func (*ApplyProxyToNodeRequest) ProtoMessage() {}
 
 func (x *ApplyProxyToNodeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_local_nitella_local_proto_msgTypes[193]
+	mi := &file_local_nitella_local_proto_msgTypes[199]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -13987,7 +14429,7 @@ func (x *ApplyProxyToNodeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ApplyProxyToNodeRequest.ProtoReflect.Descriptor instead.
 func (*ApplyProxyToNodeRequest) Descriptor() ([]byte, []int) {
-	return file_local_nitella_local_proto_rawDescGZIP(), []int{193}
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{199}
 }
 
 func (x *ApplyProxyToNodeRequest) GetProxyId() string {
@@ -14035,7 +14477,7 @@ type ApplyProxyToNodeResponse struct {
 
 func (x *ApplyProxyToNodeResponse) Reset() {
 	*x = ApplyProxyToNodeResponse{}
-	mi := &file_local_nitella_local_proto_msgTypes[194]
+	mi := &file_local_nitella_local_proto_msgTypes[200]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -14047,7 +14489,7 @@ func (x *ApplyProxyToNodeResponse) String() string {
 func (*ApplyProxyToNodeResponse) ProtoMessage() {}
 
 func (x *ApplyProxyToNodeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_local_nitella_local_proto_msgTypes[194]
+	mi := &file_local_nitella_local_proto_msgTypes[200]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14060,7 +14502,7 @@ func (x *ApplyProxyToNodeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ApplyProxyToNodeResponse.ProtoReflect.Descriptor instead.
 func (*ApplyProxyToNodeResponse) Descriptor() ([]byte, []int) {
-	return file_local_nitella_local_proto_rawDescGZIP(), []int{194}
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{200}
 }
 
 func (x *ApplyProxyToNodeResponse) GetSuccess() bool {
@@ -14087,7 +14529,7 @@ type UnapplyProxyFromNodeRequest struct {
 
 func (x *UnapplyProxyFromNodeRequest) Reset() {
 	*x = UnapplyProxyFromNodeRequest{}
-	mi := &file_local_nitella_local_proto_msgTypes[195]
+	mi := &file_local_nitella_local_proto_msgTypes[201]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -14099,7 +14541,7 @@ func (x *UnapplyProxyFromNodeRequest) String() string {
 func (*UnapplyProxyFromNodeRequest) ProtoMessage() {}
 
 func (x *UnapplyProxyFromNodeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_local_nitella_local_proto_msgTypes[195]
+	mi := &file_local_nitella_local_proto_msgTypes[201]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14112,7 +14554,7 @@ func (x *UnapplyProxyFromNodeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UnapplyProxyFromNodeRequest.ProtoReflect.Descriptor instead.
 func (*UnapplyProxyFromNodeRequest) Descriptor() ([]byte, []int) {
-	return file_local_nitella_local_proto_rawDescGZIP(), []int{195}
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{201}
 }
 
 func (x *UnapplyProxyFromNodeRequest) GetProxyId() string {
@@ -14139,7 +14581,7 @@ type UnapplyProxyFromNodeResponse struct {
 
 func (x *UnapplyProxyFromNodeResponse) Reset() {
 	*x = UnapplyProxyFromNodeResponse{}
-	mi := &file_local_nitella_local_proto_msgTypes[196]
+	mi := &file_local_nitella_local_proto_msgTypes[202]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -14151,7 +14593,7 @@ func (x *UnapplyProxyFromNodeResponse) String() string {
 func (*UnapplyProxyFromNodeResponse) ProtoMessage() {}
 
 func (x *UnapplyProxyFromNodeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_local_nitella_local_proto_msgTypes[196]
+	mi := &file_local_nitella_local_proto_msgTypes[202]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14164,7 +14606,7 @@ func (x *UnapplyProxyFromNodeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UnapplyProxyFromNodeResponse.ProtoReflect.Descriptor instead.
 func (*UnapplyProxyFromNodeResponse) Descriptor() ([]byte, []int) {
-	return file_local_nitella_local_proto_rawDescGZIP(), []int{196}
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{202}
 }
 
 func (x *UnapplyProxyFromNodeResponse) GetSuccess() bool {
@@ -14190,7 +14632,7 @@ type GetAppliedProxiesRequest struct {
 
 func (x *GetAppliedProxiesRequest) Reset() {
 	*x = GetAppliedProxiesRequest{}
-	mi := &file_local_nitella_local_proto_msgTypes[197]
+	mi := &file_local_nitella_local_proto_msgTypes[203]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -14202,7 +14644,7 @@ func (x *GetAppliedProxiesRequest) String() string {
 func (*GetAppliedProxiesRequest) ProtoMessage() {}
 
 func (x *GetAppliedProxiesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_local_nitella_local_proto_msgTypes[197]
+	mi := &file_local_nitella_local_proto_msgTypes[203]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14215,7 +14657,7 @@ func (x *GetAppliedProxiesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAppliedProxiesRequest.ProtoReflect.Descriptor instead.
 func (*GetAppliedProxiesRequest) Descriptor() ([]byte, []int) {
-	return file_local_nitella_local_proto_rawDescGZIP(), []int{197}
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{203}
 }
 
 func (x *GetAppliedProxiesRequest) GetNodeId() string {
@@ -14234,7 +14676,7 @@ type GetAppliedProxiesResponse struct {
 
 func (x *GetAppliedProxiesResponse) Reset() {
 	*x = GetAppliedProxiesResponse{}
-	mi := &file_local_nitella_local_proto_msgTypes[198]
+	mi := &file_local_nitella_local_proto_msgTypes[204]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -14246,7 +14688,7 @@ func (x *GetAppliedProxiesResponse) String() string {
 func (*GetAppliedProxiesResponse) ProtoMessage() {}
 
 func (x *GetAppliedProxiesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_local_nitella_local_proto_msgTypes[198]
+	mi := &file_local_nitella_local_proto_msgTypes[204]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14259,7 +14701,7 @@ func (x *GetAppliedProxiesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAppliedProxiesResponse.ProtoReflect.Descriptor instead.
 func (*GetAppliedProxiesResponse) Descriptor() ([]byte, []int) {
-	return file_local_nitella_local_proto_rawDescGZIP(), []int{198}
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{204}
 }
 
 func (x *GetAppliedProxiesResponse) GetProxies() []*AppliedProxy {
@@ -14281,7 +14723,7 @@ type AppliedProxy struct {
 
 func (x *AppliedProxy) Reset() {
 	*x = AppliedProxy{}
-	mi := &file_local_nitella_local_proto_msgTypes[199]
+	mi := &file_local_nitella_local_proto_msgTypes[205]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -14293,7 +14735,7 @@ func (x *AppliedProxy) String() string {
 func (*AppliedProxy) ProtoMessage() {}
 
 func (x *AppliedProxy) ProtoReflect() protoreflect.Message {
-	mi := &file_local_nitella_local_proto_msgTypes[199]
+	mi := &file_local_nitella_local_proto_msgTypes[205]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14306,7 +14748,7 @@ func (x *AppliedProxy) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AppliedProxy.ProtoReflect.Descriptor instead.
 func (*AppliedProxy) Descriptor() ([]byte, []int) {
-	return file_local_nitella_local_proto_rawDescGZIP(), []int{199}
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{205}
 }
 
 func (x *AppliedProxy) GetProxyId() string {
@@ -14349,7 +14791,7 @@ type AllowIPRequest struct {
 
 func (x *AllowIPRequest) Reset() {
 	*x = AllowIPRequest{}
-	mi := &file_local_nitella_local_proto_msgTypes[200]
+	mi := &file_local_nitella_local_proto_msgTypes[206]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -14361,7 +14803,7 @@ func (x *AllowIPRequest) String() string {
 func (*AllowIPRequest) ProtoMessage() {}
 
 func (x *AllowIPRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_local_nitella_local_proto_msgTypes[200]
+	mi := &file_local_nitella_local_proto_msgTypes[206]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14374,7 +14816,7 @@ func (x *AllowIPRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AllowIPRequest.ProtoReflect.Descriptor instead.
 func (*AllowIPRequest) Descriptor() ([]byte, []int) {
-	return file_local_nitella_local_proto_rawDescGZIP(), []int{200}
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{206}
 }
 
 func (x *AllowIPRequest) GetNodeId() string {
@@ -14416,7 +14858,7 @@ type AllowIPResponse struct {
 
 func (x *AllowIPResponse) Reset() {
 	*x = AllowIPResponse{}
-	mi := &file_local_nitella_local_proto_msgTypes[201]
+	mi := &file_local_nitella_local_proto_msgTypes[207]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -14428,7 +14870,7 @@ func (x *AllowIPResponse) String() string {
 func (*AllowIPResponse) ProtoMessage() {}
 
 func (x *AllowIPResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_local_nitella_local_proto_msgTypes[201]
+	mi := &file_local_nitella_local_proto_msgTypes[207]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14441,7 +14883,7 @@ func (x *AllowIPResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AllowIPResponse.ProtoReflect.Descriptor instead.
 func (*AllowIPResponse) Descriptor() ([]byte, []int) {
-	return file_local_nitella_local_proto_rawDescGZIP(), []int{201}
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{207}
 }
 
 func (x *AllowIPResponse) GetSuccess() bool {
@@ -14475,7 +14917,7 @@ type StreamMetricsRequest struct {
 
 func (x *StreamMetricsRequest) Reset() {
 	*x = StreamMetricsRequest{}
-	mi := &file_local_nitella_local_proto_msgTypes[202]
+	mi := &file_local_nitella_local_proto_msgTypes[208]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -14487,7 +14929,7 @@ func (x *StreamMetricsRequest) String() string {
 func (*StreamMetricsRequest) ProtoMessage() {}
 
 func (x *StreamMetricsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_local_nitella_local_proto_msgTypes[202]
+	mi := &file_local_nitella_local_proto_msgTypes[208]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14500,7 +14942,7 @@ func (x *StreamMetricsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StreamMetricsRequest.ProtoReflect.Descriptor instead.
 func (*StreamMetricsRequest) Descriptor() ([]byte, []int) {
-	return file_local_nitella_local_proto_rawDescGZIP(), []int{202}
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{208}
 }
 
 func (x *StreamMetricsRequest) GetNodeId() string {
@@ -14525,7 +14967,7 @@ type GetDebugRuntimeStatsRequest struct {
 
 func (x *GetDebugRuntimeStatsRequest) Reset() {
 	*x = GetDebugRuntimeStatsRequest{}
-	mi := &file_local_nitella_local_proto_msgTypes[203]
+	mi := &file_local_nitella_local_proto_msgTypes[209]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -14537,7 +14979,7 @@ func (x *GetDebugRuntimeStatsRequest) String() string {
 func (*GetDebugRuntimeStatsRequest) ProtoMessage() {}
 
 func (x *GetDebugRuntimeStatsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_local_nitella_local_proto_msgTypes[203]
+	mi := &file_local_nitella_local_proto_msgTypes[209]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14550,7 +14992,7 @@ func (x *GetDebugRuntimeStatsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetDebugRuntimeStatsRequest.ProtoReflect.Descriptor instead.
 func (*GetDebugRuntimeStatsRequest) Descriptor() ([]byte, []int) {
-	return file_local_nitella_local_proto_rawDescGZIP(), []int{203}
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{209}
 }
 
 type DebugRuntimeStats struct {
@@ -14590,7 +15032,7 @@ type DebugRuntimeStats struct {
 
 func (x *DebugRuntimeStats) Reset() {
 	*x = DebugRuntimeStats{}
-	mi := &file_local_nitella_local_proto_msgTypes[204]
+	mi := &file_local_nitella_local_proto_msgTypes[210]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -14602,7 +15044,7 @@ func (x *DebugRuntimeStats) String() string {
 func (*DebugRuntimeStats) ProtoMessage() {}
 
 func (x *DebugRuntimeStats) ProtoReflect() protoreflect.Message {
-	mi := &file_local_nitella_local_proto_msgTypes[204]
+	mi := &file_local_nitella_local_proto_msgTypes[210]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14615,7 +15057,7 @@ func (x *DebugRuntimeStats) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DebugRuntimeStats.ProtoReflect.Descriptor instead.
 func (*DebugRuntimeStats) Descriptor() ([]byte, []int) {
-	return file_local_nitella_local_proto_rawDescGZIP(), []int{204}
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{210}
 }
 
 func (x *DebugRuntimeStats) GetRssBytes() int64 {
@@ -14834,7 +15276,7 @@ type DebugGrpcConnection struct {
 
 func (x *DebugGrpcConnection) Reset() {
 	*x = DebugGrpcConnection{}
-	mi := &file_local_nitella_local_proto_msgTypes[205]
+	mi := &file_local_nitella_local_proto_msgTypes[211]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -14846,7 +15288,7 @@ func (x *DebugGrpcConnection) String() string {
 func (*DebugGrpcConnection) ProtoMessage() {}
 
 func (x *DebugGrpcConnection) ProtoReflect() protoreflect.Message {
-	mi := &file_local_nitella_local_proto_msgTypes[205]
+	mi := &file_local_nitella_local_proto_msgTypes[211]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14859,7 +15301,7 @@ func (x *DebugGrpcConnection) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DebugGrpcConnection.ProtoReflect.Descriptor instead.
 func (*DebugGrpcConnection) Descriptor() ([]byte, []int) {
-	return file_local_nitella_local_proto_rawDescGZIP(), []int{205}
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{211}
 }
 
 func (x *DebugGrpcConnection) GetScope() string {
@@ -14909,7 +15351,7 @@ type DebugGoroutineDiffEntry struct {
 
 func (x *DebugGoroutineDiffEntry) Reset() {
 	*x = DebugGoroutineDiffEntry{}
-	mi := &file_local_nitella_local_proto_msgTypes[206]
+	mi := &file_local_nitella_local_proto_msgTypes[212]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -14921,7 +15363,7 @@ func (x *DebugGoroutineDiffEntry) String() string {
 func (*DebugGoroutineDiffEntry) ProtoMessage() {}
 
 func (x *DebugGoroutineDiffEntry) ProtoReflect() protoreflect.Message {
-	mi := &file_local_nitella_local_proto_msgTypes[206]
+	mi := &file_local_nitella_local_proto_msgTypes[212]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14934,7 +15376,7 @@ func (x *DebugGoroutineDiffEntry) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DebugGoroutineDiffEntry.ProtoReflect.Descriptor instead.
 func (*DebugGoroutineDiffEntry) Descriptor() ([]byte, []int) {
-	return file_local_nitella_local_proto_rawDescGZIP(), []int{206}
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{212}
 }
 
 func (x *DebugGoroutineDiffEntry) GetSignature() string {
@@ -14973,7 +15415,7 @@ type GetLogsStatsRequest struct {
 
 func (x *GetLogsStatsRequest) Reset() {
 	*x = GetLogsStatsRequest{}
-	mi := &file_local_nitella_local_proto_msgTypes[207]
+	mi := &file_local_nitella_local_proto_msgTypes[213]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -14985,7 +15427,7 @@ func (x *GetLogsStatsRequest) String() string {
 func (*GetLogsStatsRequest) ProtoMessage() {}
 
 func (x *GetLogsStatsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_local_nitella_local_proto_msgTypes[207]
+	mi := &file_local_nitella_local_proto_msgTypes[213]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -14998,7 +15440,7 @@ func (x *GetLogsStatsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetLogsStatsRequest.ProtoReflect.Descriptor instead.
 func (*GetLogsStatsRequest) Descriptor() ([]byte, []int) {
-	return file_local_nitella_local_proto_rawDescGZIP(), []int{207}
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{213}
 }
 
 type GetLogsStatsResponse struct {
@@ -15015,7 +15457,7 @@ type GetLogsStatsResponse struct {
 
 func (x *GetLogsStatsResponse) Reset() {
 	*x = GetLogsStatsResponse{}
-	mi := &file_local_nitella_local_proto_msgTypes[208]
+	mi := &file_local_nitella_local_proto_msgTypes[214]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -15027,7 +15469,7 @@ func (x *GetLogsStatsResponse) String() string {
 func (*GetLogsStatsResponse) ProtoMessage() {}
 
 func (x *GetLogsStatsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_local_nitella_local_proto_msgTypes[208]
+	mi := &file_local_nitella_local_proto_msgTypes[214]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -15040,7 +15482,7 @@ func (x *GetLogsStatsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetLogsStatsResponse.ProtoReflect.Descriptor instead.
 func (*GetLogsStatsResponse) Descriptor() ([]byte, []int) {
-	return file_local_nitella_local_proto_rawDescGZIP(), []int{208}
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{214}
 }
 
 func (x *GetLogsStatsResponse) GetTotalLogs() int64 {
@@ -15097,7 +15539,7 @@ type ListLogsRequest struct {
 
 func (x *ListLogsRequest) Reset() {
 	*x = ListLogsRequest{}
-	mi := &file_local_nitella_local_proto_msgTypes[209]
+	mi := &file_local_nitella_local_proto_msgTypes[215]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -15109,7 +15551,7 @@ func (x *ListLogsRequest) String() string {
 func (*ListLogsRequest) ProtoMessage() {}
 
 func (x *ListLogsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_local_nitella_local_proto_msgTypes[209]
+	mi := &file_local_nitella_local_proto_msgTypes[215]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -15122,7 +15564,7 @@ func (x *ListLogsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListLogsRequest.ProtoReflect.Descriptor instead.
 func (*ListLogsRequest) Descriptor() ([]byte, []int) {
-	return file_local_nitella_local_proto_rawDescGZIP(), []int{209}
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{215}
 }
 
 func (x *ListLogsRequest) GetRoutingToken() string {
@@ -15164,7 +15606,7 @@ type ListLogsResponse struct {
 
 func (x *ListLogsResponse) Reset() {
 	*x = ListLogsResponse{}
-	mi := &file_local_nitella_local_proto_msgTypes[210]
+	mi := &file_local_nitella_local_proto_msgTypes[216]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -15176,7 +15618,7 @@ func (x *ListLogsResponse) String() string {
 func (*ListLogsResponse) ProtoMessage() {}
 
 func (x *ListLogsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_local_nitella_local_proto_msgTypes[210]
+	mi := &file_local_nitella_local_proto_msgTypes[216]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -15189,7 +15631,7 @@ func (x *ListLogsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListLogsResponse.ProtoReflect.Descriptor instead.
 func (*ListLogsResponse) Descriptor() ([]byte, []int) {
-	return file_local_nitella_local_proto_rawDescGZIP(), []int{210}
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{216}
 }
 
 func (x *ListLogsResponse) GetLogs() []*LogEntry {
@@ -15226,7 +15668,7 @@ type LogEntry struct {
 
 func (x *LogEntry) Reset() {
 	*x = LogEntry{}
-	mi := &file_local_nitella_local_proto_msgTypes[211]
+	mi := &file_local_nitella_local_proto_msgTypes[217]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -15238,7 +15680,7 @@ func (x *LogEntry) String() string {
 func (*LogEntry) ProtoMessage() {}
 
 func (x *LogEntry) ProtoReflect() protoreflect.Message {
-	mi := &file_local_nitella_local_proto_msgTypes[211]
+	mi := &file_local_nitella_local_proto_msgTypes[217]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -15251,7 +15693,7 @@ func (x *LogEntry) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LogEntry.ProtoReflect.Descriptor instead.
 func (*LogEntry) Descriptor() ([]byte, []int) {
-	return file_local_nitella_local_proto_rawDescGZIP(), []int{211}
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{217}
 }
 
 func (x *LogEntry) GetId() int64 {
@@ -15301,7 +15743,7 @@ type DeleteLogsRequest struct {
 
 func (x *DeleteLogsRequest) Reset() {
 	*x = DeleteLogsRequest{}
-	mi := &file_local_nitella_local_proto_msgTypes[212]
+	mi := &file_local_nitella_local_proto_msgTypes[218]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -15313,7 +15755,7 @@ func (x *DeleteLogsRequest) String() string {
 func (*DeleteLogsRequest) ProtoMessage() {}
 
 func (x *DeleteLogsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_local_nitella_local_proto_msgTypes[212]
+	mi := &file_local_nitella_local_proto_msgTypes[218]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -15326,7 +15768,7 @@ func (x *DeleteLogsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteLogsRequest.ProtoReflect.Descriptor instead.
 func (*DeleteLogsRequest) Descriptor() ([]byte, []int) {
-	return file_local_nitella_local_proto_rawDescGZIP(), []int{212}
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{218}
 }
 
 func (x *DeleteLogsRequest) GetRoutingToken() string {
@@ -15367,7 +15809,7 @@ type DeleteLogsResponse struct {
 
 func (x *DeleteLogsResponse) Reset() {
 	*x = DeleteLogsResponse{}
-	mi := &file_local_nitella_local_proto_msgTypes[213]
+	mi := &file_local_nitella_local_proto_msgTypes[219]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -15379,7 +15821,7 @@ func (x *DeleteLogsResponse) String() string {
 func (*DeleteLogsResponse) ProtoMessage() {}
 
 func (x *DeleteLogsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_local_nitella_local_proto_msgTypes[213]
+	mi := &file_local_nitella_local_proto_msgTypes[219]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -15392,7 +15834,7 @@ func (x *DeleteLogsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteLogsResponse.ProtoReflect.Descriptor instead.
 func (*DeleteLogsResponse) Descriptor() ([]byte, []int) {
-	return file_local_nitella_local_proto_rawDescGZIP(), []int{213}
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{219}
 }
 
 func (x *DeleteLogsResponse) GetDeletedCount() int64 {
@@ -15419,7 +15861,7 @@ type CleanupOldLogsRequest struct {
 
 func (x *CleanupOldLogsRequest) Reset() {
 	*x = CleanupOldLogsRequest{}
-	mi := &file_local_nitella_local_proto_msgTypes[214]
+	mi := &file_local_nitella_local_proto_msgTypes[220]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -15431,7 +15873,7 @@ func (x *CleanupOldLogsRequest) String() string {
 func (*CleanupOldLogsRequest) ProtoMessage() {}
 
 func (x *CleanupOldLogsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_local_nitella_local_proto_msgTypes[214]
+	mi := &file_local_nitella_local_proto_msgTypes[220]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -15444,7 +15886,7 @@ func (x *CleanupOldLogsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CleanupOldLogsRequest.ProtoReflect.Descriptor instead.
 func (*CleanupOldLogsRequest) Descriptor() ([]byte, []int) {
-	return file_local_nitella_local_proto_rawDescGZIP(), []int{214}
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{220}
 }
 
 func (x *CleanupOldLogsRequest) GetOlderThanDays() int32 {
@@ -15472,7 +15914,7 @@ type CleanupOldLogsResponse struct {
 
 func (x *CleanupOldLogsResponse) Reset() {
 	*x = CleanupOldLogsResponse{}
-	mi := &file_local_nitella_local_proto_msgTypes[215]
+	mi := &file_local_nitella_local_proto_msgTypes[221]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -15484,7 +15926,7 @@ func (x *CleanupOldLogsResponse) String() string {
 func (*CleanupOldLogsResponse) ProtoMessage() {}
 
 func (x *CleanupOldLogsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_local_nitella_local_proto_msgTypes[215]
+	mi := &file_local_nitella_local_proto_msgTypes[221]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -15497,7 +15939,7 @@ func (x *CleanupOldLogsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CleanupOldLogsResponse.ProtoReflect.Descriptor instead.
 func (*CleanupOldLogsResponse) Descriptor() ([]byte, []int) {
-	return file_local_nitella_local_proto_rawDescGZIP(), []int{215}
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{221}
 }
 
 func (x *CleanupOldLogsResponse) GetDeletedCount() int64 {
@@ -15530,7 +15972,7 @@ type GetNodeFromHubRequest struct {
 
 func (x *GetNodeFromHubRequest) Reset() {
 	*x = GetNodeFromHubRequest{}
-	mi := &file_local_nitella_local_proto_msgTypes[216]
+	mi := &file_local_nitella_local_proto_msgTypes[222]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -15542,7 +15984,7 @@ func (x *GetNodeFromHubRequest) String() string {
 func (*GetNodeFromHubRequest) ProtoMessage() {}
 
 func (x *GetNodeFromHubRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_local_nitella_local_proto_msgTypes[216]
+	mi := &file_local_nitella_local_proto_msgTypes[222]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -15555,7 +15997,7 @@ func (x *GetNodeFromHubRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetNodeFromHubRequest.ProtoReflect.Descriptor instead.
 func (*GetNodeFromHubRequest) Descriptor() ([]byte, []int) {
-	return file_local_nitella_local_proto_rawDescGZIP(), []int{216}
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{222}
 }
 
 func (x *GetNodeFromHubRequest) GetNodeId() string {
@@ -15579,7 +16021,7 @@ type GetNodeFromHubResponse struct {
 
 func (x *GetNodeFromHubResponse) Reset() {
 	*x = GetNodeFromHubResponse{}
-	mi := &file_local_nitella_local_proto_msgTypes[217]
+	mi := &file_local_nitella_local_proto_msgTypes[223]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -15591,7 +16033,7 @@ func (x *GetNodeFromHubResponse) String() string {
 func (*GetNodeFromHubResponse) ProtoMessage() {}
 
 func (x *GetNodeFromHubResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_local_nitella_local_proto_msgTypes[217]
+	mi := &file_local_nitella_local_proto_msgTypes[223]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -15604,7 +16046,7 @@ func (x *GetNodeFromHubResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetNodeFromHubResponse.ProtoReflect.Descriptor instead.
 func (*GetNodeFromHubResponse) Descriptor() ([]byte, []int) {
-	return file_local_nitella_local_proto_rawDescGZIP(), []int{217}
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{223}
 }
 
 func (x *GetNodeFromHubResponse) GetNodeId() string {
@@ -15660,7 +16102,7 @@ type RegisterNodeWithHubRequest struct {
 
 func (x *RegisterNodeWithHubRequest) Reset() {
 	*x = RegisterNodeWithHubRequest{}
-	mi := &file_local_nitella_local_proto_msgTypes[218]
+	mi := &file_local_nitella_local_proto_msgTypes[224]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -15672,7 +16114,7 @@ func (x *RegisterNodeWithHubRequest) String() string {
 func (*RegisterNodeWithHubRequest) ProtoMessage() {}
 
 func (x *RegisterNodeWithHubRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_local_nitella_local_proto_msgTypes[218]
+	mi := &file_local_nitella_local_proto_msgTypes[224]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -15685,7 +16127,7 @@ func (x *RegisterNodeWithHubRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RegisterNodeWithHubRequest.ProtoReflect.Descriptor instead.
 func (*RegisterNodeWithHubRequest) Descriptor() ([]byte, []int) {
-	return file_local_nitella_local_proto_rawDescGZIP(), []int{218}
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{224}
 }
 
 func (x *RegisterNodeWithHubRequest) GetNodeId() string {
@@ -15720,7 +16162,7 @@ type RegisterNodeWithHubResponse struct {
 
 func (x *RegisterNodeWithHubResponse) Reset() {
 	*x = RegisterNodeWithHubResponse{}
-	mi := &file_local_nitella_local_proto_msgTypes[219]
+	mi := &file_local_nitella_local_proto_msgTypes[225]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -15732,7 +16174,7 @@ func (x *RegisterNodeWithHubResponse) String() string {
 func (*RegisterNodeWithHubResponse) ProtoMessage() {}
 
 func (x *RegisterNodeWithHubResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_local_nitella_local_proto_msgTypes[219]
+	mi := &file_local_nitella_local_proto_msgTypes[225]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -15745,7 +16187,7 @@ func (x *RegisterNodeWithHubResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RegisterNodeWithHubResponse.ProtoReflect.Descriptor instead.
 func (*RegisterNodeWithHubResponse) Descriptor() ([]byte, []int) {
-	return file_local_nitella_local_proto_rawDescGZIP(), []int{219}
+	return file_local_nitella_local_proto_rawDescGZIP(), []int{225}
 }
 
 func (x *RegisterNodeWithHubResponse) GetSuccess() bool {
@@ -15790,7 +16232,7 @@ const file_local_nitella_local_proto_rawDesc = "" +
 	"\x05stage\x18\x01 \x01(\x0e2\x1d.nitella.local.BootstrapStageR\x05stage\x12'\n" +
 	"\x0fidentity_exists\x18\x02 \x01(\bR\x0eidentityExists\x12'\n" +
 	"\x0fidentity_locked\x18\x03 \x01(\bR\x0eidentityLocked\x12+\n" +
-	"\x11require_biometric\x18\x04 \x01(\bR\x10requireBiometric\"\x81\x02\n" +
+	"\x11require_biometric\x18\x04 \x01(\bR\x10requireBiometric\"\xb8\x02\n" +
 	"\fIdentityInfo\x12\x16\n" +
 	"\x06exists\x18\x01 \x01(\bR\x06exists\x12\x16\n" +
 	"\x06locked\x18\x02 \x01(\bR\x06locked\x12 \n" +
@@ -15800,7 +16242,8 @@ const file_local_nitella_local_proto_rawDesc = "" +
 	"\rroot_cert_pem\x18\x05 \x01(\tR\vrootCertPem\x129\n" +
 	"\n" +
 	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12!\n" +
-	"\fpaired_nodes\x18\a \x01(\x05R\vpairedNodes\"\xb0\x01\n" +
+	"\fpaired_nodes\x18\a \x01(\x05R\vpairedNodes\x125\n" +
+	"\x17approver_public_key_pem\x18\b \x01(\tR\x14approverPublicKeyPem\"\xb0\x01\n" +
 	"\x15CreateIdentityRequest\x12\x1e\n" +
 	"\n" +
 	"passphrase\x18\x01 \x01(\tR\n" +
@@ -16127,7 +16570,7 @@ const file_local_nitella_local_proto_rawDesc = "" +
 	"\arule_id\x18\x02 \x01(\tR\x06ruleId\"J\n" +
 	"\x18RemoveGlobalRuleResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x14\n" +
-	"\x05error\x18\x02 \x01(\tR\x05error\"\xcf\x03\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\"\xf5\x04\n" +
 	"\x0fApprovalRequest\x12\x1d\n" +
 	"\n" +
 	"request_id\x18\x01 \x01(\tR\trequestId\x12\x17\n" +
@@ -16146,7 +16589,14 @@ const file_local_nitella_local_proto_rawDesc = "" +
 	"\x03geo\x18\v \x01(\v2\x10.nitella.GeoInfoR\x03geo\x128\n" +
 	"\ttimestamp\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x12\x15\n" +
 	"\x06tls_cn\x18\r \x01(\tR\x05tlsCn\x12'\n" +
-	"\x0ftls_fingerprint\x18\x0e \x01(\tR\x0etlsFingerprint\"6\n" +
+	"\x0ftls_fingerprint\x18\x0e \x01(\tR\x0etlsFingerprint\x12\x1c\n" +
+	"\tapprovals\x18\x0f \x01(\x05R\tapprovals\x12\x16\n" +
+	"\x06quorum\x18\x10 \x01(\x05R\x06quorum\x122\n" +
+	"\acontext\x18\x11 \x01(\v2\x18.nitella.ApprovalContextR\acontext\x12\x1a\n" +
+	"\bresolved\x18\x12 \x01(\bR\bresolved\x12\x1e\n" +
+	"\n" +
+	"resolution\x18\x13 \x01(\tR\n" +
+	"resolution\"6\n" +
 	"\x1bListPendingApprovalsRequest\x12\x17\n" +
 	"\anode_id\x18\x01 \x01(\tR\x06nodeId\"{\n" +
 	"\x1cListPendingApprovalsResponse\x12:\n" +
@@ -16210,7 +16660,7 @@ const file_local_nitella_local_proto_rawDesc = "" +
 	"\x11history_persisted\x18\x05 \x01(\bR\x10historyPersisted\x12#\n" +
 	"\rhistory_error\x18\x06 \x01(\tR\fhistoryError\"1\n" +
 	"\x16StreamApprovalsRequest\x12\x17\n" +
-	"\anode_id\x18\x01 \x01(\tR\x06nodeId\"\xfd\x03\n" +
+	"\anode_id\x18\x01 \x01(\tR\x06nodeId\"\x94\x04\n" +
 	"\x14ApprovalHistoryEntry\x12\x1d\n" +
 	"\n" +
 	"request_id\x18\x01 \x01(\tR\trequestId\x12\x17\n" +
@@ -16229,7 +16679,8 @@ const file_local_nitella_local_proto_rawDesc = "" +
 	"block_type\x18\v \x01(\x0e2\x1c.nitella.local.DenyBlockTypeR\tblockType\x12\x17\n" +
 	"\arule_id\x18\f \x01(\tR\x06ruleId\x129\n" +
 	"\n" +
-	"decided_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tdecidedAt\"c\n" +
+	"decided_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tdecidedAt\x12\x15\n" +
+	"\x06tls_cn\x18\x0e \x01(\tR\x05tlsCn\"c\n" +
 	"\x1aListApprovalHistoryRequest\x12\x17\n" +
 	"\anode_id\x18\x01 \x01(\tR\x06nodeId\x12\x14\n" +
 	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x16\n" +
@@ -16242,7 +16693,31 @@ const file_local_nitella_local_proto_rawDesc = "" +
 	"\x1cClearApprovalHistoryResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x14\n" +
 	"\x05error\x18\x02 \x01(\tR\x05error\x12#\n" +
-	"\rdeleted_count\x18\x03 \x01(\x05R\fdeletedCount\"\xb1\x03\n" +
+	"\rdeleted_count\x18\x03 \x01(\x05R\fdeletedCount\"X\n" +
+	"\x18ListRuleProposalsRequest\x12\x17\n" +
+	"\anode_id\x18\x01 \x01(\tR\x06nodeId\x12#\n" +
+	"\rmin_decisions\x18\x02 \x01(\x05R\fminDecisions\"\x8c\x02\n" +
+	"\fRuleProposal\x12\x1f\n" +
+	"\vproposal_id\x18\x01 \x01(\tR\n" +
+	"proposalId\x12\x17\n" +
+	"\anode_id\x18\x02 \x01(\tR\x06nodeId\x12\x19\n" +
+	"\bproxy_id\x18\x03 \x01(\tR\aproxyId\x12'\n" +
+	"\x04rule\x18\x04 \x01(\v2\x13.nitella.proxy.RuleR\x04rule\x12\x18\n" +
+	"\asummary\x18\x05 \x01(\tR\asummary\x12%\n" +
+	"\x0edecision_count\x18\x06 \x01(\x05R\rdecisionCount\x12=\n" +
+	"\amatched\x18\a \x03(\v2#.nitella.local.ApprovalHistoryEntryR\amatched\"V\n" +
+	"\x19ListRuleProposalsResponse\x129\n" +
+	"\tproposals\x18\x01 \x03(\v2\x1b.nitella.local.RuleProposalR\tproposals\"z\n" +
+	"\x19AcceptRuleProposalRequest\x12\x1f\n" +
+	"\vproposal_id\x18\x01 \x01(\tR\n" +
+	"proposalId\x12\x17\n" +
+	"\anode_id\x18\x02 \x01(\tR\x06nodeId\x12#\n" +
+	"\rmin_decisions\x18\x03 \x01(\x05R\fminDecisions\"\x8a\x01\n" +
+	"\x1aAcceptRuleProposalResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\x12\x17\n" +
+	"\arule_id\x18\x03 \x01(\tR\x06ruleId\x12#\n" +
+	"\rrules_created\x18\x04 \x01(\x05R\frulesCreated\"\xb1\x03\n" +
 	"\x0fConnectionStats\x12-\n" +
 	"\x12active_connections\x18\x01 \x01(\x03R\x11activeConnections\x12+\n" +
 	"\x11total_connections\x18\x02 \x01(\x03R\x10totalConnections\x12\x19\n" +
@@ -16703,7 +17178,12 @@ const file_local_nitella_local_proto_rawDesc = "" +
 	"\x19GetGeoIPStatusNodeRequest\x12\x17\n" +
 	"\anode_id\x18\x01 \x01(\tR\x06nodeId\"6\n" +
 	"\x1bRestartListenersNodeRequest\x12\x17\n" +
-	"\anode_id\x18\x01 \x01(\tR\x06nodeId\"\x91\x01\n" +
+	"\anode_id\x18\x01 \x01(\tR\x06nodeId\"\x84\x01\n" +
+	"\x1dGetMockTranscriptsNodeRequest\x12\x17\n" +
+	"\anode_id\x18\x01 \x01(\tR\x06nodeId\x12\x17\n" +
+	"\aconn_id\x18\x02 \x01(\tR\x06connId\x12\x1b\n" +
+	"\tsource_ip\x18\x03 \x01(\tR\bsourceIp\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\"\x91\x01\n" +
 	"\x10NodeStatusChange\x12\x17\n" +
 	"\anode_id\x18\x01 \x01(\tR\x06nodeId\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
@@ -17130,7 +17610,7 @@ const file_local_nitella_local_proto_rawDesc = "" +
 	"#APPROVAL_HISTORY_ACTION_UNSPECIFIED\x10\x00\x12$\n" +
 	" APPROVAL_HISTORY_ACTION_APPROVED\x10\x01\x12\"\n" +
 	"\x1eAPPROVAL_HISTORY_ACTION_DENIED\x10\x02\x12#\n" +
-	"\x1fAPPROVAL_HISTORY_ACTION_EXPIRED\x10\x032\xa8W\n" +
+	"\x1fAPPROVAL_HISTORY_ACTION_EXPIRED\x10\x032\xeaY\n" +
 	"\x12MobileLogicService\x12Q\n" +
 	"\n" +
 	"Initialize\x12 .nitella.local.InitializeRequest\x1a!.nitella.local.InitializeResponse\x12:\n" +
@@ -17182,7 +17662,9 @@ const file_local_nitella_local_proto_rawDesc = "" +
 	"\x17ResolveApprovalDecision\x12-.nitella.local.ResolveApprovalDecisionRequest\x1a..nitella.local.ResolveApprovalDecisionResponse\x12Z\n" +
 	"\x0fStreamApprovals\x12%.nitella.local.StreamApprovalsRequest\x1a\x1e.nitella.local.ApprovalRequest0\x01\x12l\n" +
 	"\x13ListApprovalHistory\x12).nitella.local.ListApprovalHistoryRequest\x1a*.nitella.local.ListApprovalHistoryResponse\x12o\n" +
-	"\x14ClearApprovalHistory\x12*.nitella.local.ClearApprovalHistoryRequest\x1a+.nitella.local.ClearApprovalHistoryResponse\x12^\n" +
+	"\x14ClearApprovalHistory\x12*.nitella.local.ClearApprovalHistoryRequest\x1a+.nitella.local.ClearApprovalHistoryResponse\x12f\n" +
+	"\x11ListRuleProposals\x12'.nitella.local.ListRuleProposalsRequest\x1a(.nitella.local.ListRuleProposalsResponse\x12i\n" +
+	"\x12AcceptRuleProposal\x12(.nitella.local.AcceptRuleProposalRequest\x1a).nitella.local.AcceptRuleProposalResponse\x12^\n" +
 	"\x12GetConnectionStats\x12(.nitella.local.GetConnectionStatsRequest\x1a\x1e.nitella.local.ConnectionStats\x12`\n" +
 	"\x0fListConnections\x12%.nitella.local.ListConnectionsRequest\x1a&.nitella.local.ListConnectionsResponse\x12Q\n" +
 	"\n" +
@@ -17236,7 +17718,8 @@ const file_local_nitella_local_proto_rawDesc = "" +
 	"\bLookupIP\x12\x1e.nitella.local.LookupIPRequest\x1a\x1f.nitella.local.LookupIPResponse\x12a\n" +
 	"\x0eConfigureGeoIP\x12(.nitella.local.ConfigureGeoIPNodeRequest\x1a%.nitella.proxy.ConfigureGeoIPResponse\x12a\n" +
 	"\x0eGetGeoIPStatus\x12(.nitella.local.GetGeoIPStatusNodeRequest\x1a%.nitella.proxy.GetGeoIPStatusResponse\x12g\n" +
-	"\x10RestartListeners\x12*.nitella.local.RestartListenersNodeRequest\x1a'.nitella.proxy.RestartListenersResponse\x12r\n" +
+	"\x10RestartListeners\x12*.nitella.local.RestartListenersNodeRequest\x1a'.nitella.proxy.RestartListenersResponse\x12m\n" +
+	"\x12GetMockTranscripts\x12,.nitella.local.GetMockTranscriptsNodeRequest\x1a).nitella.proxy.GetMockTranscriptsResponse\x12r\n" +
 	"\x15ListLocalProxyConfigs\x12+.nitella.local.ListLocalProxyConfigsRequest\x1a,.nitella.local.ListLocalProxyConfigsResponse\x12l\n" +
 	"\x13GetLocalProxyConfig\x12).nitella.local.GetLocalProxyConfigRequest\x1a*.nitella.local.GetLocalProxyConfigResponse\x12u\n" +
 	"\x16ImportLocalProxyConfig\x12,.nitella.local.ImportLocalProxyConfigRequest\x1a-.nitella.local.ImportLocalProxyConfigResponse\x12o\n" +
@@ -17285,7 +17768,7 @@ func file_local_nitella_local_proto_rawDescGZIP() []byte {
 }
 
 var file_local_nitella_local_proto_enumTypes = make([]protoimpl.EnumInfo, 13)
-var file_local_nitella_local_proto_msgTypes = make([]protoimpl.MessageInfo, 224)
+var file_local_nitella_local_proto_msgTypes = make([]protoimpl.MessageInfo, 230)
 var file_local_nitella_local_proto_goTypes = []any{
 	(GeoStatsType)(0),                        // 0: nitella.local.GeoStatsType
 	(Theme)(0),                               // 1: nitella.local.Theme
@@ -17380,577 +17863,595 @@ var file_local_nitella_local_proto_goTypes = []any{
 	(*ListApprovalHistoryResponse)(nil),      // 90: nitella.local.ListApprovalHistoryResponse
 	(*ClearApprovalHistoryRequest)(nil),      // 91: nitella.local.ClearApprovalHistoryRequest
 	(*ClearApprovalHistoryResponse)(nil),     // 92: nitella.local.ClearApprovalHistoryResponse
-	(*ConnectionStats)(nil),                  // 93: nitella.local.ConnectionStats
-	(*GetConnectionStatsRequest)(nil),        // 94: nitella.local.GetConnectionStatsRequest
-	(*ConnectionInfo)(nil),                   // 95: nitella.local.ConnectionInfo
-	(*ListConnectionsRequest)(nil),           // 96: nitella.local.ListConnectionsRequest
-	(*ListConnectionsResponse)(nil),          // 97: nitella.local.ListConnectionsResponse
-	(*GetIPStatsRequest)(nil),                // 98: nitella.local.GetIPStatsRequest
-	(*IPStats)(nil),                          // 99: nitella.local.IPStats
-	(*GetIPStatsResponse)(nil),               // 100: nitella.local.GetIPStatsResponse
-	(*GetGeoStatsRequest)(nil),               // 101: nitella.local.GetGeoStatsRequest
-	(*GeoStats)(nil),                         // 102: nitella.local.GeoStats
-	(*GetGeoStatsResponse)(nil),              // 103: nitella.local.GetGeoStatsResponse
-	(*StreamConnectionsRequest)(nil),         // 104: nitella.local.StreamConnectionsRequest
-	(*ConnectionEvent)(nil),                  // 105: nitella.local.ConnectionEvent
-	(*CloseConnectionRequest)(nil),           // 106: nitella.local.CloseConnectionRequest
-	(*CloseConnectionResponse)(nil),          // 107: nitella.local.CloseConnectionResponse
-	(*CloseAllConnectionsRequest)(nil),       // 108: nitella.local.CloseAllConnectionsRequest
-	(*CloseAllConnectionsResponse)(nil),      // 109: nitella.local.CloseAllConnectionsResponse
-	(*CloseAllNodeConnectionsRequest)(nil),   // 110: nitella.local.CloseAllNodeConnectionsRequest
-	(*CloseAllNodeConnectionsResponse)(nil),  // 111: nitella.local.CloseAllNodeConnectionsResponse
-	(*StartPairingRequest)(nil),              // 112: nitella.local.StartPairingRequest
-	(*StartPairingResponse)(nil),             // 113: nitella.local.StartPairingResponse
-	(*JoinPairingRequest)(nil),               // 114: nitella.local.JoinPairingRequest
-	(*JoinPairingResponse)(nil),              // 115: nitella.local.JoinPairingResponse
-	(*CompletePairingRequest)(nil),           // 116: nitella.local.CompletePairingRequest
-	(*CompletePairingResponse)(nil),          // 117: nitella.local.CompletePairingResponse
-	(*FinalizePairingRequest)(nil),           // 118: nitella.local.FinalizePairingRequest
-	(*FinalizePairingResponse)(nil),          // 119: nitella.local.FinalizePairingResponse
-	(*CancelPairingRequest)(nil),             // 120: nitella.local.CancelPairingRequest
-	(*GenerateQRCodeRequest)(nil),            // 121: nitella.local.GenerateQRCodeRequest
-	(*GenerateQRCodeResponse)(nil),           // 122: nitella.local.GenerateQRCodeResponse
-	(*ScanQRCodeRequest)(nil),                // 123: nitella.local.ScanQRCodeRequest
-	(*ScanQRCodeResponse)(nil),               // 124: nitella.local.ScanQRCodeResponse
-	(*GenerateQRReplyRequest)(nil),           // 125: nitella.local.GenerateQRReplyRequest
-	(*GenerateQRReplyResponse)(nil),          // 126: nitella.local.GenerateQRReplyResponse
-	(*Template)(nil),                         // 127: nitella.local.Template
-	(*ProxyTemplate)(nil),                    // 128: nitella.local.ProxyTemplate
-	(*ListTemplatesRequest)(nil),             // 129: nitella.local.ListTemplatesRequest
-	(*ListTemplatesResponse)(nil),            // 130: nitella.local.ListTemplatesResponse
-	(*GetTemplateRequest)(nil),               // 131: nitella.local.GetTemplateRequest
-	(*CreateTemplateRequest)(nil),            // 132: nitella.local.CreateTemplateRequest
-	(*ApplyTemplateRequest)(nil),             // 133: nitella.local.ApplyTemplateRequest
-	(*ApplyTemplateResponse)(nil),            // 134: nitella.local.ApplyTemplateResponse
-	(*DeleteTemplateRequest)(nil),            // 135: nitella.local.DeleteTemplateRequest
-	(*SyncTemplatesResponse)(nil),            // 136: nitella.local.SyncTemplatesResponse
-	(*ExportTemplateYamlRequest)(nil),        // 137: nitella.local.ExportTemplateYamlRequest
-	(*ExportTemplateYamlResponse)(nil),       // 138: nitella.local.ExportTemplateYamlResponse
-	(*ImportTemplateYamlRequest)(nil),        // 139: nitella.local.ImportTemplateYamlRequest
-	(*ImportTemplateYamlResponse)(nil),       // 140: nitella.local.ImportTemplateYamlResponse
-	(*Settings)(nil),                         // 141: nitella.local.Settings
-	(*UpdateSettingsRequest)(nil),            // 142: nitella.local.UpdateSettingsRequest
-	(*SettingsOverviewSnapshot)(nil),         // 143: nitella.local.SettingsOverviewSnapshot
-	(*RegisterFCMTokenRequest)(nil),          // 144: nitella.local.RegisterFCMTokenRequest
-	(*ConnectToHubRequest)(nil),              // 145: nitella.local.ConnectToHubRequest
-	(*FetchHubCARequest)(nil),                // 146: nitella.local.FetchHubCARequest
-	(*FetchHubCAResponse)(nil),               // 147: nitella.local.FetchHubCAResponse
-	(*ConnectToHubResponse)(nil),             // 148: nitella.local.ConnectToHubResponse
-	(*HubStatus)(nil),                        // 149: nitella.local.HubStatus
-	(*HubSettingsSnapshot)(nil),              // 150: nitella.local.HubSettingsSnapshot
-	(*HubOverview)(nil),                      // 151: nitella.local.HubOverview
-	(*GetHubDashboardSnapshotRequest)(nil),   // 152: nitella.local.GetHubDashboardSnapshotRequest
-	(*HubDashboardSnapshot)(nil),             // 153: nitella.local.HubDashboardSnapshot
-	(*RegisterUserRequest)(nil),              // 154: nitella.local.RegisterUserRequest
-	(*RegisterUserResponse)(nil),             // 155: nitella.local.RegisterUserResponse
-	(*OnboardHubRequest)(nil),                // 156: nitella.local.OnboardHubRequest
-	(*EnsureHubRegisteredRequest)(nil),       // 157: nitella.local.EnsureHubRegisteredRequest
-	(*EnsureHubConnectedRequest)(nil),        // 158: nitella.local.EnsureHubConnectedRequest
-	(*HubTrustChallenge)(nil),                // 159: nitella.local.HubTrustChallenge
-	(*ResolveHubTrustChallengeRequest)(nil),  // 160: nitella.local.ResolveHubTrustChallengeRequest
-	(*OnboardHubResponse)(nil),               // 161: nitella.local.OnboardHubResponse
-	(*LookupIPRequest)(nil),                  // 162: nitella.local.LookupIPRequest
-	(*LookupIPResponse)(nil),                 // 163: nitella.local.LookupIPResponse
-	(*ConfigureGeoIPNodeRequest)(nil),        // 164: nitella.local.ConfigureGeoIPNodeRequest
-	(*GetGeoIPStatusNodeRequest)(nil),        // 165: nitella.local.GetGeoIPStatusNodeRequest
-	(*RestartListenersNodeRequest)(nil),      // 166: nitella.local.RestartListenersNodeRequest
-	(*NodeStatusChange)(nil),                 // 167: nitella.local.NodeStatusChange
-	(*Alert)(nil),                            // 168: nitella.local.Alert
-	(*ToastMessage)(nil),                     // 169: nitella.local.ToastMessage
-	(*P2PStatus)(nil),                        // 170: nitella.local.P2PStatus
-	(*P2PSettingsSnapshot)(nil),              // 171: nitella.local.P2PSettingsSnapshot
-	(*SetP2PModeRequest)(nil),                // 172: nitella.local.SetP2PModeRequest
-	(*LocalProxyConfig)(nil),                 // 173: nitella.local.LocalProxyConfig
-	(*ListLocalProxyConfigsRequest)(nil),     // 174: nitella.local.ListLocalProxyConfigsRequest
-	(*ListLocalProxyConfigsResponse)(nil),    // 175: nitella.local.ListLocalProxyConfigsResponse
-	(*GetLocalProxyConfigRequest)(nil),       // 176: nitella.local.GetLocalProxyConfigRequest
-	(*GetLocalProxyConfigResponse)(nil),      // 177: nitella.local.GetLocalProxyConfigResponse
-	(*ImportLocalProxyConfigRequest)(nil),    // 178: nitella.local.ImportLocalProxyConfigRequest
-	(*ImportLocalProxyConfigResponse)(nil),   // 179: nitella.local.ImportLocalProxyConfigResponse
-	(*SaveLocalProxyConfigRequest)(nil),      // 180: nitella.local.SaveLocalProxyConfigRequest
-	(*SaveLocalProxyConfigResponse)(nil),     // 181: nitella.local.SaveLocalProxyConfigResponse
-	(*DeleteLocalProxyConfigRequest)(nil),    // 182: nitella.local.DeleteLocalProxyConfigRequest
-	(*DeleteLocalProxyConfigResponse)(nil),   // 183: nitella.local.DeleteLocalProxyConfigResponse
-	(*ValidateLocalProxyConfigRequest)(nil),  // 184: nitella.local.ValidateLocalProxyConfigRequest
-	(*ValidateLocalProxyConfigResponse)(nil), // 185: nitella.local.ValidateLocalProxyConfigResponse
-	(*PushProxyRevisionRequest)(nil),         // 186: nitella.local.PushProxyRevisionRequest
-	(*PushProxyRevisionResponse)(nil),        // 187: nitella.local.PushProxyRevisionResponse
-	(*PushLocalProxyRevisionRequest)(nil),    // 188: nitella.local.PushLocalProxyRevisionRequest
-	(*PushLocalProxyRevisionResponse)(nil),   // 189: nitella.local.PushLocalProxyRevisionResponse
-	(*PullProxyRevisionRequest)(nil),         // 190: nitella.local.PullProxyRevisionRequest
-	(*PullProxyRevisionResponse)(nil),        // 191: nitella.local.PullProxyRevisionResponse
-	(*DiffProxyRevisionsRequest)(nil),        // 192: nitella.local.DiffProxyRevisionsRequest
-	(*DiffProxyRevisionsResponse)(nil),       // 193: nitella.local.DiffProxyRevisionsResponse
-	(*ListProxyRevisionsRequest)(nil),        // 194: nitella.local.ListProxyRevisionsRequest
-	(*ListProxyRevisionsResponse)(nil),       // 195: nitella.local.ListProxyRevisionsResponse
-	(*ProxyRevisionMeta)(nil),                // 196: nitella.local.ProxyRevisionMeta
-	(*FlushProxyRevisionsRequest)(nil),       // 197: nitella.local.FlushProxyRevisionsRequest
-	(*FlushProxyRevisionsResponse)(nil),      // 198: nitella.local.FlushProxyRevisionsResponse
-	(*ListProxyConfigsRequest)(nil),          // 199: nitella.local.ListProxyConfigsRequest
-	(*ListProxyConfigsResponse)(nil),         // 200: nitella.local.ListProxyConfigsResponse
-	(*ProxyConfigInfo)(nil),                  // 201: nitella.local.ProxyConfigInfo
-	(*CreateProxyConfigRequest)(nil),         // 202: nitella.local.CreateProxyConfigRequest
-	(*CreateProxyConfigResponse)(nil),        // 203: nitella.local.CreateProxyConfigResponse
-	(*DeleteProxyConfigRequest)(nil),         // 204: nitella.local.DeleteProxyConfigRequest
-	(*DeleteProxyConfigResponse)(nil),        // 205: nitella.local.DeleteProxyConfigResponse
-	(*ApplyProxyToNodeRequest)(nil),          // 206: nitella.local.ApplyProxyToNodeRequest
-	(*ApplyProxyToNodeResponse)(nil),         // 207: nitella.local.ApplyProxyToNodeResponse
-	(*UnapplyProxyFromNodeRequest)(nil),      // 208: nitella.local.UnapplyProxyFromNodeRequest
-	(*UnapplyProxyFromNodeResponse)(nil),     // 209: nitella.local.UnapplyProxyFromNodeResponse
-	(*GetAppliedProxiesRequest)(nil),         // 210: nitella.local.GetAppliedProxiesRequest
-	(*GetAppliedProxiesResponse)(nil),        // 211: nitella.local.GetAppliedProxiesResponse
-	(*AppliedProxy)(nil),                     // 212: nitella.local.AppliedProxy
-	(*AllowIPRequest)(nil),                   // 213: nitella.local.AllowIPRequest
-	(*AllowIPResponse)(nil),                  // 214: nitella.local.AllowIPResponse
-	(*StreamMetricsRequest)(nil),             // 215: nitella.local.StreamMetricsRequest
-	(*GetDebugRuntimeStatsRequest)(nil),      // 216: nitella.local.GetDebugRuntimeStatsRequest
-	(*DebugRuntimeStats)(nil),                // 217: nitella.local.DebugRuntimeStats
-	(*DebugGrpcConnection)(nil),              // 218: nitella.local.DebugGrpcConnection
-	(*DebugGoroutineDiffEntry)(nil),          // 219: nitella.local.DebugGoroutineDiffEntry
-	(*GetLogsStatsRequest)(nil),              // 220: nitella.local.GetLogsStatsRequest
-	(*GetLogsStatsResponse)(nil),             // 221: nitella.local.GetLogsStatsResponse
-	(*ListLogsRequest)(nil),                  // 222: nitella.local.ListLogsRequest
-	(*ListLogsResponse)(nil),                 // 223: nitella.local.ListLogsResponse
-	(*LogEntry)(nil),                         // 224: nitella.local.LogEntry
-	(*DeleteLogsRequest)(nil),                // 225: nitella.local.DeleteLogsRequest
-	(*DeleteLogsResponse)(nil),               // 226: nitella.local.DeleteLogsResponse
-	(*CleanupOldLogsRequest)(nil),            // 227: nitella.local.CleanupOldLogsRequest
-	(*CleanupOldLogsResponse)(nil),           // 228: nitella.local.CleanupOldLogsResponse
-	(*GetNodeFromHubRequest)(nil),            // 229: nitella.local.GetNodeFromHubRequest
-	(*GetNodeFromHubResponse)(nil),           // 230: nitella.local.GetNodeFromHubResponse
-	(*RegisterNodeWithHubRequest)(nil),       // 231: nitella.local.RegisterNodeWithHubRequest
-	(*RegisterNodeWithHubResponse)(nil),      // 232: nitella.local.RegisterNodeWithHubResponse
-	nil,                                      // 233: nitella.local.Alert.MetadataEntry
-	nil,                                      // 234: nitella.local.GetLogsStatsResponse.LogsByRoutingTokenEntry
-	nil,                                      // 235: nitella.local.GetLogsStatsResponse.StorageByRoutingTokenEntry
-	nil,                                      // 236: nitella.local.CleanupOldLogsResponse.DeletedByRoutingTokenEntry
-	(*timestamp.Timestamp)(nil),              // 237: google.protobuf.Timestamp
-	(*proxy.Rule)(nil),                       // 238: nitella.proxy.Rule
-	(*field_mask.FieldMask)(nil),             // 239: google.protobuf.FieldMask
-	(common.ActionType)(0),                   // 240: nitella.ActionType
-	(common.FallbackAction)(0),               // 241: nitella.FallbackAction
-	(common.MockPreset)(0),                   // 242: nitella.MockPreset
-	(common.ConditionType)(0),                // 243: nitella.ConditionType
-	(common.Operator)(0),                     // 244: nitella.Operator
-	(*proxy.GlobalRule)(nil),                 // 245: nitella.proxy.GlobalRule
-	(*common.GeoInfo)(nil),                   // 246: nitella.GeoInfo
-	(common.ApprovalRetentionMode)(0),        // 247: nitella.ApprovalRetentionMode
-	(common.SortOrder)(0),                    // 248: nitella.SortOrder
-	(common.P2PMode)(0),                      // 249: nitella.P2PMode
-	(*proxy.ConfigureGeoIPRequest)(nil),      // 250: nitella.proxy.ConfigureGeoIPRequest
-	(*empty.Empty)(nil),                      // 251: google.protobuf.Empty
-	(*proxy.ConfigureGeoIPResponse)(nil),     // 252: nitella.proxy.ConfigureGeoIPResponse
-	(*proxy.GetGeoIPStatusResponse)(nil),     // 253: nitella.proxy.GetGeoIPStatusResponse
-	(*proxy.RestartListenersResponse)(nil),   // 254: nitella.proxy.RestartListenersResponse
+	(*ListRuleProposalsRequest)(nil),         // 93: nitella.local.ListRuleProposalsRequest
+	(*RuleProposal)(nil),                     // 94: nitella.local.RuleProposal
+	(*ListRuleProposalsResponse)(nil),        // 95: nitella.local.ListRuleProposalsResponse
+	(*AcceptRuleProposalRequest)(nil),        // 96: nitella.local.AcceptRuleProposalRequest
+	(*AcceptRuleProposalResponse)(nil),       // 97: nitella.local.AcceptRuleProposalResponse
+	(*ConnectionStats)(nil),                  // 98: nitella.local.ConnectionStats
+	(*GetConnectionStatsRequest)(nil),        // 99: nitella.local.GetConnectionStatsRequest
+	(*ConnectionInfo)(nil),                   // 100: nitella.local.ConnectionInfo
+	(*ListConnectionsRequest)(nil),           // 101: nitella.local.ListConnectionsRequest
+	(*ListConnectionsResponse)(nil),          // 102: nitella.local.ListConnectionsResponse
+	(*GetIPStatsRequest)(nil),                // 103: nitella.local.GetIPStatsRequest
+	(*IPStats)(nil),                          // 104: nitella.local.IPStats
+	(*GetIPStatsResponse)(nil),               // 105: nitella.local.GetIPStatsResponse
+	(*GetGeoStatsRequest)(nil),               // 106: nitella.local.GetGeoStatsRequest
+	(*GeoStats)(nil),                         // 107: nitella.local.GeoStats
+	(*GetGeoStatsResponse)(nil),              // 108: nitella.local.GetGeoStatsResponse
+	(*StreamConnectionsRequest)(nil),         // 109: nitella.local.StreamConnectionsRequest
+	(*ConnectionEvent)(nil),                  // 110: nitella.local.ConnectionEvent
+	(*CloseConnectionRequest)(nil),           // 111: nitella.local.CloseConnectionRequest
+	(*CloseConnectionResponse)(nil),          // 112: nitella.local.CloseConnectionResponse
+	(*CloseAllConnectionsRequest)(nil),       // 113: nitella.local.CloseAllConnectionsRequest
+	(*CloseAllConnectionsResponse)(nil),      // 114: nitella.local.CloseAllConnectionsResponse
+	(*CloseAllNodeConnectionsRequest)(nil),   // 115: nitella.local.CloseAllNodeConnectionsRequest
+	(*CloseAllNodeConnectionsResponse)(nil),  // 116: nitella.local.CloseAllNodeConnectionsResponse
+	(*StartPairingRequest)(nil),              // 117: nitella.local.StartPairingRequest
+	(*StartPairingResponse)(nil),             // 118: nitella.local.StartPairingResponse
+	(*JoinPairingRequest)(nil),               // 119: nitella.local.JoinPairingRequest
+	(*JoinPairingResponse)(nil),              // 120: nitella.local.JoinPairingResponse
+	(*CompletePairingRequest)(nil),           // 121: nitella.local.CompletePairingRequest
+	(*CompletePairingResponse)(nil),          // 122: nitella.local.CompletePairingResponse
+	(*FinalizePairingRequest)(nil),           // 123: nitella.local.FinalizePairingRequest
+	(*FinalizePairingResponse)(nil),          // 124: nitella.local.FinalizePairingResponse
+	(*CancelPairingRequest)(nil),             // 125: nitella.local.CancelPairingRequest
+	(*GenerateQRCodeRequest)(nil),            // 126: nitella.local.GenerateQRCodeRequest
+	(*GenerateQRCodeResponse)(nil),           // 127: nitella.local.GenerateQRCodeResponse
+	(*ScanQRCodeRequest)(nil),                // 128: nitella.local.ScanQRCodeRequest
+	(*ScanQRCodeResponse)(nil),               // 129: nitella.local.ScanQRCodeResponse
+	(*GenerateQRReplyRequest)(nil),           // 130: nitella.local.GenerateQRReplyRequest
+	(*GenerateQRReplyResponse)(nil),          // 131: nitella.local.GenerateQRReplyResponse
+	(*Template)(nil),                         // 132: nitella.local.Template
+	(*ProxyTemplate)(nil),                    // 133: nitella.local.ProxyTemplate
+	(*ListTemplatesRequest)(nil),             // 134: nitella.local.ListTemplatesRequest
+	(*ListTemplatesResponse)(nil),            // 135: nitella.local.ListTemplatesResponse
+	(*GetTemplateRequest)(nil),               // 136: nitella.local.GetTemplateRequest
+	(*CreateTemplateRequest)(nil),            // 137: nitella.local.CreateTemplateRequest
+	(*ApplyTemplateRequest)(nil),             // 138: nitella.local.ApplyTemplateRequest
+	(*ApplyTemplateResponse)(nil),            // 139: nitella.local.ApplyTemplateResponse
+	(*DeleteTemplateRequest)(nil),            // 140: nitella.local.DeleteTemplateRequest
+	(*SyncTemplatesResponse)(nil),            // 141: nitella.local.SyncTemplatesResponse
+	(*ExportTemplateYamlRequest)(nil),        // 142: nitella.local.ExportTemplateYamlRequest
+	(*ExportTemplateYamlResponse)(nil),       // 143: nitella.local.ExportTemplateYamlResponse
+	(*ImportTemplateYamlRequest)(nil),        // 144: nitella.local.ImportTemplateYamlRequest
+	(*ImportTemplateYamlResponse)(nil),       // 145: nitella.local.ImportTemplateYamlResponse
+	(*Settings)(nil),                         // 146: nitella.local.Settings
+	(*UpdateSettingsRequest)(nil),            // 147: nitella.local.UpdateSettingsRequest
+	(*SettingsOverviewSnapshot)(nil),         // 148: nitella.local.SettingsOverviewSnapshot
+	(*RegisterFCMTokenRequest)(nil),          // 149: nitella.local.RegisterFCMTokenRequest
+	(*ConnectToHubRequest)(nil),              // 150: nitella.local.ConnectToHubRequest
+	(*FetchHubCARequest)(nil),                // 151: nitella.local.FetchHubCARequest
+	(*FetchHubCAResponse)(nil),               // 152: nitella.local.FetchHubCAResponse
+	(*ConnectToHubResponse)(nil),             // 153: nitella.local.ConnectToHubResponse
+	(*HubStatus)(nil),                        // 154: nitella.local.HubStatus
+	(*HubSettingsSnapshot)(nil),              // 155: nitella.local.HubSettingsSnapshot
+	(*HubOverview)(nil),                      // 156: nitella.local.HubOverview
+	(*GetHubDashboardSnapshotRequest)(nil),   // 157: nitella.local.GetHubDashboardSnapshotRequest
+	(*HubDashboardSnapshot)(nil),             // 158: nitella.local.HubDashboardSnapshot
+	(*RegisterUserRequest)(nil),              // 159: nitella.local.RegisterUserRequest
+	(*RegisterUserResponse)(nil),             // 160: nitella.local.RegisterUserResponse
+	(*OnboardHubRequest)(nil),                // 161: nitella.local.OnboardHubRequest
+	(*EnsureHubRegisteredRequest)(nil),       // 162: nitella.local.EnsureHubRegisteredRequest
+	(*EnsureHubConnectedRequest)(nil),        // 163: nitella.local.EnsureHubConnectedRequest
+	(*HubTrustChallenge)(nil),                // 164: nitella.local.HubTrustChallenge
+	(*ResolveHubTrustChallengeRequest)(nil),  // 165: nitella.local.ResolveHubTrustChallengeRequest
+	(*OnboardHubResponse)(nil),               // 166: nitella.local.OnboardHubResponse
+	(*LookupIPRequest)(nil),                  // 167: nitella.local.LookupIPRequest
+	(*LookupIPResponse)(nil),                 // 168: nitella.local.LookupIPResponse
+	(*ConfigureGeoIPNodeRequest)(nil),        // 169: nitella.local.ConfigureGeoIPNodeRequest
+	(*GetGeoIPStatusNodeRequest)(nil),        // 170: nitella.local.GetGeoIPStatusNodeRequest
+	(*RestartListenersNodeRequest)(nil),      // 171: nitella.local.RestartListenersNodeRequest
+	(*GetMockTranscriptsNodeRequest)(nil),    // 172: nitella.local.GetMockTranscriptsNodeRequest
+	(*NodeStatusChange)(nil),                 // 173: nitella.local.NodeStatusChange
+	(*Alert)(nil),                            // 174: nitella.local.Alert
+	(*ToastMessage)(nil),                     // 175: nitella.local.ToastMessage
+	(*P2PStatus)(nil),                        // 176: nitella.local.P2PStatus
+	(*P2PSettingsSnapshot)(nil),              // 177: nitella.local.P2PSettingsSnapshot
+	(*SetP2PModeRequest)(nil),                // 178: nitella.local.SetP2PModeRequest
+	(*LocalProxyConfig)(nil),                 // 179: nitella.local.LocalProxyConfig
+	(*ListLocalProxyConfigsRequest)(nil),     // 180: nitella.local.ListLocalProxyConfigsRequest
+	(*ListLocalProxyConfigsResponse)(nil),    // 181: nitella.local.ListLocalProxyConfigsResponse
+	(*GetLocalProxyConfigRequest)(nil),       // 182: nitella.local.GetLocalProxyConfigRequest
+	(*GetLocalProxyConfigResponse)(nil),      // 183: nitella.local.GetLocalProxyConfigResponse
+	(*ImportLocalProxyConfigRequest)(nil),    // 184: nitella.local.ImportLocalProxyConfigRequest
+	(*ImportLocalProxyConfigResponse)(nil),   // 185: nitella.local.ImportLocalProxyConfigResponse
+	(*SaveLocalProxyConfigRequest)(nil),      // 186: nitella.local.SaveLocalProxyConfigRequest
+	(*SaveLocalProxyConfigResponse)(nil),     // 187: nitella.local.SaveLocalProxyConfigResponse
+	(*DeleteLocalProxyConfigRequest)(nil),    // 188: nitella.local.DeleteLocalProxyConfigRequest
+	(*DeleteLocalProxyConfigResponse)(nil),   // 189: nitella.local.DeleteLocalProxyConfigResponse
+	(*ValidateLocalProxyConfigRequest)(nil),  // 190: nitella.local.ValidateLocalProxyConfigRequest
+	(*ValidateLocalProxyConfigResponse)(nil), // 191: nitella.local.ValidateLocalProxyConfigResponse
+	(*PushProxyRevisionRequest)(nil),         // 192: nitella.local.PushProxyRevisionRequest
+	(*PushProxyRevisionResponse)(nil),        // 193: nitella.local.PushProxyRevisionResponse
+	(*PushLocalProxyRevisionRequest)(nil),    // 194: nitella.local.PushLocalProxyRevisionRequest
+	(*PushLocalProxyRevisionResponse)(nil),   // 195: nitella.local.PushLocalProxyRevisionResponse
+	(*PullProxyRevisionRequest)(nil),         // 196: nitella.local.PullProxyRevisionRequest
+	(*PullProxyRevisionResponse)(nil),        // 197: nitella.local.PullProxyRevisionResponse
+	(*DiffProxyRevisionsRequest)(nil),        // 198: nitella.local.DiffProxyRevisionsRequest
+	(*DiffProxyRevisionsResponse)(nil),       // 199: nitella.local.DiffProxyRevisionsResponse
+	(*ListProxyRevisionsRequest)(nil),        // 200: nitella.local.ListProxyRevisionsRequest
+	(*ListProxyRevisionsResponse)(nil),       // 201: nitella.local.ListProxyRevisionsResponse
+	(*ProxyRevisionMeta)(nil),                // 202: nitella.local.ProxyRevisionMeta
+	(*FlushProxyRevisionsRequest)(nil),       // 203: nitella.local.FlushProxyRevisionsRequest
+	(*FlushProxyRevisionsResponse)(nil),      // 204: nitella.local.FlushProxyRevisionsResponse
+	(*ListProxyConfigsRequest)(nil),          // 205: nitella.local.ListProxyConfigsRequest
+	(*ListProxyConfigsResponse)(nil),         // 206: nitella.local.ListProxyConfigsResponse
+	(*ProxyConfigInfo)(nil),                  // 207: nitella.local.ProxyConfigInfo
+	(*CreateProxyConfigRequest)(nil),         // 208: nitella.local.CreateProxyConfigRequest
+	(*CreateProxyConfigResponse)(nil),        // 209: nitella.local.CreateProxyConfigResponse
+	(*DeleteProxyConfigRequest)(nil),         // 210: nitella.local.DeleteProxyConfigRequest
+	(*DeleteProxyConfigResponse)(nil),        // 211: nitella.local.DeleteProxyConfigResponse
+	(*ApplyProxyToNodeRequest)(nil),          // 212: nitella.local.ApplyProxyToNodeRequest
+	(*ApplyProxyToNodeResponse)(nil),         // 213: nitella.local.ApplyProxyToNodeResponse
+	(*UnapplyProxyFromNodeRequest)(nil),      // 214: nitella.local.UnapplyProxyFromNodeRequest
+	(*UnapplyProxyFromNodeResponse)(nil),     // 215: nitella.local.UnapplyProxyFromNodeResponse
+	(*GetAppliedProxiesRequest)(nil),         // 216: nitella.local.GetAppliedProxiesRequest
+	(*GetAppliedProxiesResponse)(nil),        // 217: nitella.local.GetAppliedProxiesResponse
+	(*AppliedProxy)(nil),                     // 218: nitella.local.AppliedProxy
+	(*AllowIPRequest)(nil),                   // 219: nitella.local.AllowIPRequest
+	(*AllowIPResponse)(nil),                  // 220: nitella.local.AllowIPResponse
+	(*StreamMetricsRequest)(nil),             // 221: nitella.local.StreamMetricsRequest
+	(*GetDebugRuntimeStatsRequest)(nil),      // 222: nitella.local.GetDebugRuntimeStatsRequest
+	(*DebugRuntimeStats)(nil),                // 223: nitella.local.DebugRuntimeStats
+	(*DebugGrpcConnection)(nil),              // 224: nitella.local.DebugGrpcConnection
+	(*DebugGoroutineDiffEntry)(nil),          // 225: nitella.local.DebugGoroutineDiffEntry
+	(*GetLogsStatsRequest)(nil),              // 226: nitella.local.GetLogsStatsRequest
+	(*GetLogsStatsResponse)(nil),             // 227: nitella.local.GetLogsStatsResponse
+	(*ListLogsRequest)(nil),                  // 228: nitella.local.ListLogsRequest
+	(*ListLogsResponse)(nil),                 // 229: nitella.local.ListLogsResponse
+	(*LogEntry)(nil),                         // 230: nitella.local.LogEntry
+	(*DeleteLogsRequest)(nil),                // 231: nitella.local.DeleteLogsRequest
+	(*DeleteLogsResponse)(nil),               // 232: nitella.local.DeleteLogsResponse
+	(*CleanupOldLogsRequest)(nil),            // 233: nitella.local.CleanupOldLogsRequest
+	(*CleanupOldLogsResponse)(nil),           // 234: nitella.local.CleanupOldLogsResponse
+	(*GetNodeFromHubRequest)(nil),            // 235: nitella.local.GetNodeFromHubRequest
+	(*GetNodeFromHubResponse)(nil),           // 236: nitella.local.GetNodeFromHubResponse
+	(*RegisterNodeWithHubRequest)(nil),       // 237: nitella.local.RegisterNodeWithHubRequest
+	(*RegisterNodeWithHubResponse)(nil),      // 238: nitella.local.RegisterNodeWithHubResponse
+	nil,                                      // 239: nitella.local.Alert.MetadataEntry
+	nil,                                      // 240: nitella.local.GetLogsStatsResponse.LogsByRoutingTokenEntry
+	nil,                                      // 241: nitella.local.GetLogsStatsResponse.StorageByRoutingTokenEntry
+	nil,                                      // 242: nitella.local.CleanupOldLogsResponse.DeletedByRoutingTokenEntry
+	(*timestamp.Timestamp)(nil),              // 243: google.protobuf.Timestamp
+	(*proxy.Rule)(nil),                       // 244: nitella.proxy.Rule
+	(*field_mask.FieldMask)(nil),             // 245: google.protobuf.FieldMask
+	(common.ActionType)(0),                   // 246: nitella.ActionType
+	(common.FallbackAction)(0),               // 247: nitella.FallbackAction
+	(common.MockPreset)(0),                   // 248: nitella.MockPreset
+	(common.ConditionType)(0),                // 249: nitella.ConditionType
+	(common.Operator)(0),                     // 250: nitella.Operator
+	(*proxy.GlobalRule)(nil),                 // 251: nitella.proxy.GlobalRule
+	(*common.GeoInfo)(nil),                   // 252: nitella.GeoInfo
+	(*common.ApprovalContext)(nil),           // 253: nitella.ApprovalContext
+	(common.ApprovalRetentionMode)(0),        // 254: nitella.ApprovalRetentionMode
+	(common.SortOrder)(0),                    // 255: nitella.SortOrder
+	(common.P2PMode)(0),                      // 256: nitella.P2PMode
+	(*proxy.ConfigureGeoIPRequest)(nil),      // 257: nitella.proxy.ConfigureGeoIPRequest
+	(*empty.Empty)(nil),                      // 258: google.protobuf.Empty
+	(*proxy.ConfigureGeoIPResponse)(nil),     // 259: nitella.proxy.ConfigureGeoIPResponse
+	(*proxy.GetGeoIPStatusResponse)(nil),     // 260: nitella.proxy.GetGeoIPStatusResponse
+	(*proxy.RestartListenersResponse)(nil),   // 261: nitella.proxy.RestartListenersResponse
+	(*proxy.GetMockTranscriptsResponse)(nil), // 262: nitella.proxy.GetMockTranscriptsResponse
 }
 var file_local_nitella_local_proto_depIdxs = []int32{
 	5,   // 0: nitella.local.BootstrapStateResponse.stage:type_name -> nitella.local.BootstrapStage
-	237, // 1: nitella.local.IdentityInfo.created_at:type_name -> google.protobuf.Timestamp
+	243, // 1: nitella.local.IdentityInfo.created_at:type_name -> google.protobuf.Timestamp
 	16,  // 2: nitella.local.CreateIdentityResponse.identity:type_name -> nitella.local.IdentityInfo
 	16,  // 3: nitella.local.RestoreIdentityResponse.identity:type_name -> nitella.local.IdentityInfo
 	16,  // 4: nitella.local.ImportIdentityResponse.identity:type_name -> nitella.local.IdentityInfo
 	16,  // 5: nitella.local.UnlockIdentityResponse.identity:type_name -> nitella.local.IdentityInfo
 	6,   // 6: nitella.local.EvaluatePassphraseResponse.strength:type_name -> nitella.local.PassphraseStrength
-	237, // 7: nitella.local.NodeInfo.last_seen:type_name -> google.protobuf.Timestamp
-	237, // 8: nitella.local.NodeInfo.paired_at:type_name -> google.protobuf.Timestamp
+	243, // 7: nitella.local.NodeInfo.last_seen:type_name -> google.protobuf.Timestamp
+	243, // 8: nitella.local.NodeInfo.paired_at:type_name -> google.protobuf.Timestamp
 	29,  // 9: nitella.local.NodeInfo.metrics:type_name -> nitella.local.NodeMetrics
 	7,   // 10: nitella.local.NodeInfo.conn_type:type_name -> nitella.local.NodeConnectionType
 	28,  // 11: nitella.local.ListNodesResponse.nodes:type_name -> nitella.local.NodeInfo
-	237, // 12: nitella.local.NodeRuntimeStatus.last_seen:type_name -> google.protobuf.Timestamp
+	243, // 12: nitella.local.NodeRuntimeStatus.last_seen:type_name -> google.protobuf.Timestamp
 	28,  // 13: nitella.local.NodeDetailSnapshot.node:type_name -> nitella.local.NodeInfo
 	34,  // 14: nitella.local.NodeDetailSnapshot.runtime_status:type_name -> nitella.local.NodeRuntimeStatus
 	42,  // 15: nitella.local.NodeDetailSnapshot.proxies:type_name -> nitella.local.ProxyInfo
-	238, // 16: nitella.local.NodeDetailSnapshot.rules:type_name -> nitella.proxy.Rule
-	93,  // 17: nitella.local.NodeDetailSnapshot.connection_stats:type_name -> nitella.local.ConnectionStats
-	239, // 18: nitella.local.UpdateNodeRequest.update_mask:type_name -> google.protobuf.FieldMask
+	244, // 16: nitella.local.NodeDetailSnapshot.rules:type_name -> nitella.proxy.Rule
+	98,  // 17: nitella.local.NodeDetailSnapshot.connection_stats:type_name -> nitella.local.ConnectionStats
+	245, // 18: nitella.local.UpdateNodeRequest.update_mask:type_name -> google.protobuf.FieldMask
 	28,  // 19: nitella.local.AddNodeDirectResponse.node:type_name -> nitella.local.NodeInfo
-	240, // 20: nitella.local.ProxyInfo.default_action:type_name -> nitella.ActionType
-	241, // 21: nitella.local.ProxyInfo.fallback_action:type_name -> nitella.FallbackAction
+	246, // 20: nitella.local.ProxyInfo.default_action:type_name -> nitella.ActionType
+	247, // 21: nitella.local.ProxyInfo.fallback_action:type_name -> nitella.FallbackAction
 	42,  // 22: nitella.local.ListProxiesResponse.proxies:type_name -> nitella.local.ProxyInfo
 	28,  // 23: nitella.local.NodeProxiesSnapshot.node:type_name -> nitella.local.NodeInfo
 	42,  // 24: nitella.local.NodeProxiesSnapshot.proxies:type_name -> nitella.local.ProxyInfo
 	46,  // 25: nitella.local.GetProxiesSnapshotResponse.node_snapshots:type_name -> nitella.local.NodeProxiesSnapshot
-	240, // 26: nitella.local.AddProxyRequest.default_action:type_name -> nitella.ActionType
-	241, // 27: nitella.local.AddProxyRequest.fallback_action:type_name -> nitella.FallbackAction
-	242, // 28: nitella.local.AddProxyRequest.default_mock:type_name -> nitella.MockPreset
-	242, // 29: nitella.local.AddProxyRequest.fallback_mock:type_name -> nitella.MockPreset
-	240, // 30: nitella.local.UpdateProxyRequest.default_action:type_name -> nitella.ActionType
-	241, // 31: nitella.local.UpdateProxyRequest.fallback_action:type_name -> nitella.FallbackAction
-	242, // 32: nitella.local.UpdateProxyRequest.default_mock:type_name -> nitella.MockPreset
-	242, // 33: nitella.local.UpdateProxyRequest.fallback_mock:type_name -> nitella.MockPreset
-	239, // 34: nitella.local.UpdateProxyRequest.update_mask:type_name -> google.protobuf.FieldMask
-	238, // 35: nitella.local.ListRulesResponse.rules:type_name -> nitella.proxy.Rule
+	246, // 26: nitella.local.AddProxyRequest.default_action:type_name -> nitella.ActionType
+	247, // 27: nitella.local.AddProxyRequest.fallback_action:type_name -> nitella.FallbackAction
+	248, // 28: nitella.local.AddProxyRequest.default_mock:type_name -> nitella.MockPreset
+	248, // 29: nitella.local.AddProxyRequest.fallback_mock:type_name -> nitella.MockPreset
+	246, // 30: nitella.local.UpdateProxyRequest.default_action:type_name -> nitella.ActionType
+	247, // 31: nitella.local.UpdateProxyRequest.fallback_action:type_name -> nitella.FallbackAction
+	248, // 32: nitella.local.UpdateProxyRequest.default_mock:type_name -> nitella.MockPreset
+	248, // 33: nitella.local.UpdateProxyRequest.fallback_mock:type_name -> nitella.MockPreset
+	245, // 34: nitella.local.UpdateProxyRequest.update_mask:type_name -> google.protobuf.FieldMask
+	244, // 35: nitella.local.ListRulesResponse.rules:type_name -> nitella.proxy.Rule
 	57,  // 36: nitella.local.ListRulesResponse.composer_policy:type_name -> nitella.local.RuleComposerPolicy
-	243, // 37: nitella.local.RuleComposerConditionPolicy.condition_type:type_name -> nitella.ConditionType
-	244, // 38: nitella.local.RuleComposerConditionPolicy.operators:type_name -> nitella.Operator
-	244, // 39: nitella.local.RuleComposerConditionPolicy.default_operator:type_name -> nitella.Operator
+	249, // 37: nitella.local.RuleComposerConditionPolicy.condition_type:type_name -> nitella.ConditionType
+	250, // 38: nitella.local.RuleComposerConditionPolicy.operators:type_name -> nitella.Operator
+	250, // 39: nitella.local.RuleComposerConditionPolicy.default_operator:type_name -> nitella.Operator
 	56,  // 40: nitella.local.RuleComposerPolicy.condition_policies:type_name -> nitella.local.RuleComposerConditionPolicy
-	240, // 41: nitella.local.RuleComposerPolicy.allowed_actions:type_name -> nitella.ActionType
-	238, // 42: nitella.local.AddRuleRequest.rule:type_name -> nitella.proxy.Rule
-	240, // 43: nitella.local.AddQuickRuleRequest.action:type_name -> nitella.ActionType
-	243, // 44: nitella.local.AddQuickRuleRequest.condition_type:type_name -> nitella.ConditionType
-	238, // 45: nitella.local.UpdateRuleRequest.rule:type_name -> nitella.proxy.Rule
-	239, // 46: nitella.local.UpdateRuleRequest.update_mask:type_name -> google.protobuf.FieldMask
-	240, // 47: nitella.local.AddGlobalRuleRequest.action:type_name -> nitella.ActionType
-	245, // 48: nitella.local.ListGlobalRulesResponse.rules:type_name -> nitella.proxy.GlobalRule
-	246, // 49: nitella.local.ApprovalRequest.geo:type_name -> nitella.GeoInfo
-	237, // 50: nitella.local.ApprovalRequest.timestamp:type_name -> google.protobuf.Timestamp
-	76,  // 51: nitella.local.ListPendingApprovalsResponse.requests:type_name -> nitella.local.ApprovalRequest
-	76,  // 52: nitella.local.GetApprovalsSnapshotResponse.pending_requests:type_name -> nitella.local.ApprovalRequest
-	88,  // 53: nitella.local.GetApprovalsSnapshotResponse.history_entries:type_name -> nitella.local.ApprovalHistoryEntry
-	8,   // 54: nitella.local.GetApprovalsSnapshotResponse.deny_block_options:type_name -> nitella.local.DenyBlockType
-	247, // 55: nitella.local.ApproveRequestRequest.retention_mode:type_name -> nitella.ApprovalRetentionMode
-	247, // 56: nitella.local.DenyRequestRequest.retention_mode:type_name -> nitella.ApprovalRetentionMode
-	8,   // 57: nitella.local.DenyRequestRequest.block_type:type_name -> nitella.local.DenyBlockType
-	9,   // 58: nitella.local.ResolveApprovalDecisionRequest.decision:type_name -> nitella.local.ApprovalDecision
-	247, // 59: nitella.local.ResolveApprovalDecisionRequest.retention_mode:type_name -> nitella.ApprovalRetentionMode
-	8,   // 60: nitella.local.ResolveApprovalDecisionRequest.deny_block_type:type_name -> nitella.local.DenyBlockType
-	246, // 61: nitella.local.ApprovalHistoryEntry.geo:type_name -> nitella.GeoInfo
-	10,  // 62: nitella.local.ApprovalHistoryEntry.action:type_name -> nitella.local.ApprovalHistoryAction
-	8,   // 63: nitella.local.ApprovalHistoryEntry.block_type:type_name -> nitella.local.DenyBlockType
-	237, // 64: nitella.local.ApprovalHistoryEntry.decided_at:type_name -> google.protobuf.Timestamp
-	88,  // 65: nitella.local.ListApprovalHistoryResponse.entries:type_name -> nitella.local.ApprovalHistoryEntry
-	237, // 66: nitella.local.ConnectionInfo.start_time:type_name -> google.protobuf.Timestamp
-	246, // 67: nitella.local.ConnectionInfo.geo:type_name -> nitella.GeoInfo
-	240, // 68: nitella.local.ConnectionInfo.action:type_name -> nitella.ActionType
-	95,  // 69: nitella.local.ListConnectionsResponse.connections:type_name -> nitella.local.ConnectionInfo
-	248, // 70: nitella.local.GetIPStatsRequest.sort_by:type_name -> nitella.SortOrder
-	237, // 71: nitella.local.IPStats.first_seen:type_name -> google.protobuf.Timestamp
-	237, // 72: nitella.local.IPStats.last_seen:type_name -> google.protobuf.Timestamp
-	99,  // 73: nitella.local.GetIPStatsResponse.stats:type_name -> nitella.local.IPStats
-	0,   // 74: nitella.local.GetGeoStatsRequest.type:type_name -> nitella.local.GeoStatsType
-	0,   // 75: nitella.local.GeoStats.type:type_name -> nitella.local.GeoStatsType
-	102, // 76: nitella.local.GetGeoStatsResponse.stats:type_name -> nitella.local.GeoStats
-	11,  // 77: nitella.local.ConnectionEvent.event_type:type_name -> nitella.local.ConnectionEvent.EventType
-	237, // 78: nitella.local.ConnectionEvent.timestamp:type_name -> google.protobuf.Timestamp
-	240, // 79: nitella.local.ConnectionEvent.action_taken:type_name -> nitella.ActionType
-	246, // 80: nitella.local.ConnectionEvent.geo:type_name -> nitella.GeoInfo
-	28,  // 81: nitella.local.CompletePairingResponse.node:type_name -> nitella.local.NodeInfo
-	28,  // 82: nitella.local.FinalizePairingResponse.node:type_name -> nitella.local.NodeInfo
-	28,  // 83: nitella.local.GenerateQRReplyResponse.node:type_name -> nitella.local.NodeInfo
-	237, // 84: nitella.local.Template.created_at:type_name -> google.protobuf.Timestamp
-	237, // 85: nitella.local.Template.updated_at:type_name -> google.protobuf.Timestamp
-	128, // 86: nitella.local.Template.proxies:type_name -> nitella.local.ProxyTemplate
-	240, // 87: nitella.local.ProxyTemplate.default_action:type_name -> nitella.ActionType
-	241, // 88: nitella.local.ProxyTemplate.fallback_action:type_name -> nitella.FallbackAction
-	238, // 89: nitella.local.ProxyTemplate.rules:type_name -> nitella.proxy.Rule
-	127, // 90: nitella.local.ListTemplatesResponse.templates:type_name -> nitella.local.Template
-	127, // 91: nitella.local.ExportTemplateYamlResponse.template:type_name -> nitella.local.Template
-	127, // 92: nitella.local.ImportTemplateYamlResponse.template:type_name -> nitella.local.Template
-	249, // 93: nitella.local.Settings.p2p_mode:type_name -> nitella.P2PMode
-	1,   // 94: nitella.local.Settings.theme:type_name -> nitella.local.Theme
-	141, // 95: nitella.local.UpdateSettingsRequest.settings:type_name -> nitella.local.Settings
-	239, // 96: nitella.local.UpdateSettingsRequest.update_mask:type_name -> google.protobuf.FieldMask
-	16,  // 97: nitella.local.SettingsOverviewSnapshot.identity:type_name -> nitella.local.IdentityInfo
-	150, // 98: nitella.local.SettingsOverviewSnapshot.hub:type_name -> nitella.local.HubSettingsSnapshot
-	171, // 99: nitella.local.SettingsOverviewSnapshot.p2p:type_name -> nitella.local.P2PSettingsSnapshot
-	4,   // 100: nitella.local.RegisterFCMTokenRequest.device_type:type_name -> nitella.local.DeviceType
-	237, // 101: nitella.local.HubStatus.connected_since:type_name -> google.protobuf.Timestamp
-	149, // 102: nitella.local.HubSettingsSnapshot.status:type_name -> nitella.local.HubStatus
-	141, // 103: nitella.local.HubSettingsSnapshot.settings:type_name -> nitella.local.Settings
-	159, // 104: nitella.local.HubSettingsSnapshot.pending_trust_challenge:type_name -> nitella.local.HubTrustChallenge
-	151, // 105: nitella.local.HubDashboardSnapshot.overview:type_name -> nitella.local.HubOverview
-	28,  // 106: nitella.local.HubDashboardSnapshot.nodes:type_name -> nitella.local.NodeInfo
-	28,  // 107: nitella.local.HubDashboardSnapshot.pinned_nodes:type_name -> nitella.local.NodeInfo
-	12,  // 108: nitella.local.OnboardHubResponse.stage:type_name -> nitella.local.OnboardHubResponse.Stage
-	159, // 109: nitella.local.OnboardHubResponse.trust_challenge:type_name -> nitella.local.HubTrustChallenge
-	246, // 110: nitella.local.LookupIPResponse.geo:type_name -> nitella.GeoInfo
-	250, // 111: nitella.local.ConfigureGeoIPNodeRequest.config:type_name -> nitella.proxy.ConfigureGeoIPRequest
-	237, // 112: nitella.local.NodeStatusChange.timestamp:type_name -> google.protobuf.Timestamp
-	2,   // 113: nitella.local.Alert.severity:type_name -> nitella.local.AlertSeverity
-	237, // 114: nitella.local.Alert.timestamp:type_name -> google.protobuf.Timestamp
-	233, // 115: nitella.local.Alert.metadata:type_name -> nitella.local.Alert.MetadataEntry
-	3,   // 116: nitella.local.ToastMessage.type:type_name -> nitella.local.ToastType
-	249, // 117: nitella.local.P2PStatus.mode:type_name -> nitella.P2PMode
-	170, // 118: nitella.local.P2PSettingsSnapshot.status:type_name -> nitella.local.P2PStatus
-	141, // 119: nitella.local.P2PSettingsSnapshot.settings:type_name -> nitella.local.Settings
-	249, // 120: nitella.local.SetP2PModeRequest.mode:type_name -> nitella.P2PMode
-	237, // 121: nitella.local.LocalProxyConfig.created_at:type_name -> google.protobuf.Timestamp
-	237, // 122: nitella.local.LocalProxyConfig.updated_at:type_name -> google.protobuf.Timestamp
-	237, // 123: nitella.local.LocalProxyConfig.synced_at:type_name -> google.protobuf.Timestamp
-	173, // 124: nitella.local.ListLocalProxyConfigsResponse.proxies:type_name -> nitella.local.LocalProxyConfig
-	173, // 125: nitella.local.GetLocalProxyConfigResponse.proxy:type_name -> nitella.local.LocalProxyConfig
-	173, // 126: nitella.local.ImportLocalProxyConfigResponse.proxy:type_name -> nitella.local.LocalProxyConfig
-	173, // 127: nitella.local.SaveLocalProxyConfigResponse.proxy:type_name -> nitella.local.LocalProxyConfig
-	173, // 128: nitella.local.PushLocalProxyRevisionResponse.local_proxy:type_name -> nitella.local.LocalProxyConfig
-	173, // 129: nitella.local.PullProxyRevisionResponse.local_proxy:type_name -> nitella.local.LocalProxyConfig
-	196, // 130: nitella.local.ListProxyRevisionsResponse.revisions:type_name -> nitella.local.ProxyRevisionMeta
-	237, // 131: nitella.local.ProxyRevisionMeta.created_at:type_name -> google.protobuf.Timestamp
-	201, // 132: nitella.local.ListProxyConfigsResponse.proxies:type_name -> nitella.local.ProxyConfigInfo
-	237, // 133: nitella.local.ProxyConfigInfo.updated_at:type_name -> google.protobuf.Timestamp
-	212, // 134: nitella.local.GetAppliedProxiesResponse.proxies:type_name -> nitella.local.AppliedProxy
-	218, // 135: nitella.local.DebugRuntimeStats.grpc_connections:type_name -> nitella.local.DebugGrpcConnection
-	219, // 136: nitella.local.DebugRuntimeStats.goroutine_diff_entries:type_name -> nitella.local.DebugGoroutineDiffEntry
-	237, // 137: nitella.local.DebugRuntimeStats.goroutine_diff_prev_at:type_name -> google.protobuf.Timestamp
-	237, // 138: nitella.local.DebugRuntimeStats.goroutine_diff_curr_at:type_name -> google.protobuf.Timestamp
-	237, // 139: nitella.local.GetLogsStatsResponse.oldest_log:type_name -> google.protobuf.Timestamp
-	237, // 140: nitella.local.GetLogsStatsResponse.newest_log:type_name -> google.protobuf.Timestamp
-	234, // 141: nitella.local.GetLogsStatsResponse.logs_by_routing_token:type_name -> nitella.local.GetLogsStatsResponse.LogsByRoutingTokenEntry
-	235, // 142: nitella.local.GetLogsStatsResponse.storage_by_routing_token:type_name -> nitella.local.GetLogsStatsResponse.StorageByRoutingTokenEntry
-	224, // 143: nitella.local.ListLogsResponse.logs:type_name -> nitella.local.LogEntry
-	237, // 144: nitella.local.LogEntry.timestamp:type_name -> google.protobuf.Timestamp
-	237, // 145: nitella.local.DeleteLogsRequest.before:type_name -> google.protobuf.Timestamp
-	236, // 146: nitella.local.CleanupOldLogsResponse.deleted_by_routing_token:type_name -> nitella.local.CleanupOldLogsResponse.DeletedByRoutingTokenEntry
-	237, // 147: nitella.local.GetNodeFromHubResponse.last_seen:type_name -> google.protobuf.Timestamp
-	13,  // 148: nitella.local.MobileLogicService.Initialize:input_type -> nitella.local.InitializeRequest
-	251, // 149: nitella.local.MobileLogicService.Shutdown:input_type -> google.protobuf.Empty
-	251, // 150: nitella.local.MobileLogicService.GetBootstrapState:input_type -> google.protobuf.Empty
-	251, // 151: nitella.local.MobileLogicService.GetIdentity:input_type -> google.protobuf.Empty
-	17,  // 152: nitella.local.MobileLogicService.CreateIdentity:input_type -> nitella.local.CreateIdentityRequest
-	19,  // 153: nitella.local.MobileLogicService.RestoreIdentity:input_type -> nitella.local.RestoreIdentityRequest
-	21,  // 154: nitella.local.MobileLogicService.ImportIdentity:input_type -> nitella.local.ImportIdentityRequest
-	23,  // 155: nitella.local.MobileLogicService.UnlockIdentity:input_type -> nitella.local.UnlockIdentityRequest
-	251, // 156: nitella.local.MobileLogicService.LockIdentity:input_type -> google.protobuf.Empty
-	25,  // 157: nitella.local.MobileLogicService.ChangePassphrase:input_type -> nitella.local.ChangePassphraseRequest
-	26,  // 158: nitella.local.MobileLogicService.EvaluatePassphrase:input_type -> nitella.local.EvaluatePassphraseRequest
-	251, // 159: nitella.local.MobileLogicService.ResetIdentity:input_type -> google.protobuf.Empty
-	30,  // 160: nitella.local.MobileLogicService.ListNodes:input_type -> nitella.local.ListNodesRequest
-	32,  // 161: nitella.local.MobileLogicService.GetNode:input_type -> nitella.local.GetNodeRequest
-	33,  // 162: nitella.local.MobileLogicService.GetNodeDetailSnapshot:input_type -> nitella.local.GetNodeDetailSnapshotRequest
-	36,  // 163: nitella.local.MobileLogicService.UpdateNode:input_type -> nitella.local.UpdateNodeRequest
-	37,  // 164: nitella.local.MobileLogicService.RemoveNode:input_type -> nitella.local.RemoveNodeRequest
-	38,  // 165: nitella.local.MobileLogicService.AddNodeDirect:input_type -> nitella.local.AddNodeDirectRequest
-	40,  // 166: nitella.local.MobileLogicService.TestDirectConnection:input_type -> nitella.local.TestDirectConnectionRequest
-	43,  // 167: nitella.local.MobileLogicService.ListProxies:input_type -> nitella.local.ListProxiesRequest
-	45,  // 168: nitella.local.MobileLogicService.GetProxiesSnapshot:input_type -> nitella.local.GetProxiesSnapshotRequest
-	48,  // 169: nitella.local.MobileLogicService.GetProxy:input_type -> nitella.local.GetProxyRequest
-	49,  // 170: nitella.local.MobileLogicService.AddProxy:input_type -> nitella.local.AddProxyRequest
-	50,  // 171: nitella.local.MobileLogicService.UpdateProxy:input_type -> nitella.local.UpdateProxyRequest
-	52,  // 172: nitella.local.MobileLogicService.SetNodeProxiesRunning:input_type -> nitella.local.SetNodeProxiesRunningRequest
-	51,  // 173: nitella.local.MobileLogicService.RemoveProxy:input_type -> nitella.local.RemoveProxyRequest
-	54,  // 174: nitella.local.MobileLogicService.ListRules:input_type -> nitella.local.ListRulesRequest
-	58,  // 175: nitella.local.MobileLogicService.GetRule:input_type -> nitella.local.GetRuleRequest
-	59,  // 176: nitella.local.MobileLogicService.AddRule:input_type -> nitella.local.AddRuleRequest
-	60,  // 177: nitella.local.MobileLogicService.AddQuickRule:input_type -> nitella.local.AddQuickRuleRequest
-	62,  // 178: nitella.local.MobileLogicService.UpdateRule:input_type -> nitella.local.UpdateRuleRequest
-	63,  // 179: nitella.local.MobileLogicService.RemoveRule:input_type -> nitella.local.RemoveRuleRequest
-	64,  // 180: nitella.local.MobileLogicService.BlockIP:input_type -> nitella.local.BlockIPRequest
-	66,  // 181: nitella.local.MobileLogicService.BlockISP:input_type -> nitella.local.BlockISPRequest
-	68,  // 182: nitella.local.MobileLogicService.BlockCountry:input_type -> nitella.local.BlockCountryRequest
-	70,  // 183: nitella.local.MobileLogicService.AddGlobalRule:input_type -> nitella.local.AddGlobalRuleRequest
-	72,  // 184: nitella.local.MobileLogicService.ListGlobalRules:input_type -> nitella.local.ListGlobalRulesRequest
-	74,  // 185: nitella.local.MobileLogicService.RemoveGlobalRule:input_type -> nitella.local.RemoveGlobalRuleRequest
-	77,  // 186: nitella.local.MobileLogicService.ListPendingApprovals:input_type -> nitella.local.ListPendingApprovalsRequest
-	79,  // 187: nitella.local.MobileLogicService.GetApprovalsSnapshot:input_type -> nitella.local.GetApprovalsSnapshotRequest
-	81,  // 188: nitella.local.MobileLogicService.ApproveRequest:input_type -> nitella.local.ApproveRequestRequest
-	83,  // 189: nitella.local.MobileLogicService.DenyRequest:input_type -> nitella.local.DenyRequestRequest
-	85,  // 190: nitella.local.MobileLogicService.ResolveApprovalDecision:input_type -> nitella.local.ResolveApprovalDecisionRequest
-	87,  // 191: nitella.local.MobileLogicService.StreamApprovals:input_type -> nitella.local.StreamApprovalsRequest
-	89,  // 192: nitella.local.MobileLogicService.ListApprovalHistory:input_type -> nitella.local.ListApprovalHistoryRequest
-	91,  // 193: nitella.local.MobileLogicService.ClearApprovalHistory:input_type -> nitella.local.ClearApprovalHistoryRequest
-	94,  // 194: nitella.local.MobileLogicService.GetConnectionStats:input_type -> nitella.local.GetConnectionStatsRequest
-	96,  // 195: nitella.local.MobileLogicService.ListConnections:input_type -> nitella.local.ListConnectionsRequest
-	98,  // 196: nitella.local.MobileLogicService.GetIPStats:input_type -> nitella.local.GetIPStatsRequest
-	101, // 197: nitella.local.MobileLogicService.GetGeoStats:input_type -> nitella.local.GetGeoStatsRequest
-	104, // 198: nitella.local.MobileLogicService.StreamConnections:input_type -> nitella.local.StreamConnectionsRequest
-	106, // 199: nitella.local.MobileLogicService.CloseConnection:input_type -> nitella.local.CloseConnectionRequest
-	108, // 200: nitella.local.MobileLogicService.CloseAllConnections:input_type -> nitella.local.CloseAllConnectionsRequest
-	110, // 201: nitella.local.MobileLogicService.CloseAllNodeConnections:input_type -> nitella.local.CloseAllNodeConnectionsRequest
-	112, // 202: nitella.local.MobileLogicService.StartPairing:input_type -> nitella.local.StartPairingRequest
-	114, // 203: nitella.local.MobileLogicService.JoinPairing:input_type -> nitella.local.JoinPairingRequest
-	116, // 204: nitella.local.MobileLogicService.CompletePairing:input_type -> nitella.local.CompletePairingRequest
-	118, // 205: nitella.local.MobileLogicService.FinalizePairing:input_type -> nitella.local.FinalizePairingRequest
-	120, // 206: nitella.local.MobileLogicService.CancelPairing:input_type -> nitella.local.CancelPairingRequest
-	121, // 207: nitella.local.MobileLogicService.GenerateQRCode:input_type -> nitella.local.GenerateQRCodeRequest
-	123, // 208: nitella.local.MobileLogicService.ScanQRCode:input_type -> nitella.local.ScanQRCodeRequest
-	125, // 209: nitella.local.MobileLogicService.GenerateQRResponse:input_type -> nitella.local.GenerateQRReplyRequest
-	129, // 210: nitella.local.MobileLogicService.ListTemplates:input_type -> nitella.local.ListTemplatesRequest
-	131, // 211: nitella.local.MobileLogicService.GetTemplate:input_type -> nitella.local.GetTemplateRequest
-	132, // 212: nitella.local.MobileLogicService.CreateTemplate:input_type -> nitella.local.CreateTemplateRequest
-	133, // 213: nitella.local.MobileLogicService.ApplyTemplate:input_type -> nitella.local.ApplyTemplateRequest
-	135, // 214: nitella.local.MobileLogicService.DeleteTemplate:input_type -> nitella.local.DeleteTemplateRequest
-	251, // 215: nitella.local.MobileLogicService.SyncTemplates:input_type -> google.protobuf.Empty
-	137, // 216: nitella.local.MobileLogicService.ExportTemplateYaml:input_type -> nitella.local.ExportTemplateYamlRequest
-	139, // 217: nitella.local.MobileLogicService.ImportTemplateYaml:input_type -> nitella.local.ImportTemplateYamlRequest
-	251, // 218: nitella.local.MobileLogicService.GetSettings:input_type -> google.protobuf.Empty
-	251, // 219: nitella.local.MobileLogicService.GetSettingsOverviewSnapshot:input_type -> google.protobuf.Empty
-	142, // 220: nitella.local.MobileLogicService.UpdateSettings:input_type -> nitella.local.UpdateSettingsRequest
-	144, // 221: nitella.local.MobileLogicService.RegisterFCMToken:input_type -> nitella.local.RegisterFCMTokenRequest
-	251, // 222: nitella.local.MobileLogicService.UnregisterFCMToken:input_type -> google.protobuf.Empty
-	145, // 223: nitella.local.MobileLogicService.ConnectToHub:input_type -> nitella.local.ConnectToHubRequest
-	251, // 224: nitella.local.MobileLogicService.DisconnectFromHub:input_type -> google.protobuf.Empty
-	251, // 225: nitella.local.MobileLogicService.GetHubStatus:input_type -> google.protobuf.Empty
-	251, // 226: nitella.local.MobileLogicService.GetHubSettingsSnapshot:input_type -> google.protobuf.Empty
-	251, // 227: nitella.local.MobileLogicService.GetHubOverview:input_type -> google.protobuf.Empty
-	152, // 228: nitella.local.MobileLogicService.GetHubDashboardSnapshot:input_type -> nitella.local.GetHubDashboardSnapshotRequest
-	154, // 229: nitella.local.MobileLogicService.RegisterUser:input_type -> nitella.local.RegisterUserRequest
-	146, // 230: nitella.local.MobileLogicService.FetchHubCA:input_type -> nitella.local.FetchHubCARequest
-	156, // 231: nitella.local.MobileLogicService.OnboardHub:input_type -> nitella.local.OnboardHubRequest
-	158, // 232: nitella.local.MobileLogicService.EnsureHubConnected:input_type -> nitella.local.EnsureHubConnectedRequest
-	157, // 233: nitella.local.MobileLogicService.EnsureHubRegistered:input_type -> nitella.local.EnsureHubRegisteredRequest
-	160, // 234: nitella.local.MobileLogicService.ResolveHubTrustChallenge:input_type -> nitella.local.ResolveHubTrustChallengeRequest
-	251, // 235: nitella.local.MobileLogicService.GetP2PStatus:input_type -> google.protobuf.Empty
-	251, // 236: nitella.local.MobileLogicService.GetP2PSettingsSnapshot:input_type -> google.protobuf.Empty
-	251, // 237: nitella.local.MobileLogicService.StreamP2PStatus:input_type -> google.protobuf.Empty
-	172, // 238: nitella.local.MobileLogicService.SetP2PMode:input_type -> nitella.local.SetP2PModeRequest
-	162, // 239: nitella.local.MobileLogicService.LookupIP:input_type -> nitella.local.LookupIPRequest
-	164, // 240: nitella.local.MobileLogicService.ConfigureGeoIP:input_type -> nitella.local.ConfigureGeoIPNodeRequest
-	165, // 241: nitella.local.MobileLogicService.GetGeoIPStatus:input_type -> nitella.local.GetGeoIPStatusNodeRequest
-	166, // 242: nitella.local.MobileLogicService.RestartListeners:input_type -> nitella.local.RestartListenersNodeRequest
-	174, // 243: nitella.local.MobileLogicService.ListLocalProxyConfigs:input_type -> nitella.local.ListLocalProxyConfigsRequest
-	176, // 244: nitella.local.MobileLogicService.GetLocalProxyConfig:input_type -> nitella.local.GetLocalProxyConfigRequest
-	178, // 245: nitella.local.MobileLogicService.ImportLocalProxyConfig:input_type -> nitella.local.ImportLocalProxyConfigRequest
-	180, // 246: nitella.local.MobileLogicService.SaveLocalProxyConfig:input_type -> nitella.local.SaveLocalProxyConfigRequest
-	182, // 247: nitella.local.MobileLogicService.DeleteLocalProxyConfig:input_type -> nitella.local.DeleteLocalProxyConfigRequest
-	184, // 248: nitella.local.MobileLogicService.ValidateLocalProxyConfig:input_type -> nitella.local.ValidateLocalProxyConfigRequest
-	186, // 249: nitella.local.MobileLogicService.PushProxyRevision:input_type -> nitella.local.PushProxyRevisionRequest
-	188, // 250: nitella.local.MobileLogicService.PushLocalProxyRevision:input_type -> nitella.local.PushLocalProxyRevisionRequest
-	190, // 251: nitella.local.MobileLogicService.PullProxyRevision:input_type -> nitella.local.PullProxyRevisionRequest
-	192, // 252: nitella.local.MobileLogicService.DiffProxyRevisions:input_type -> nitella.local.DiffProxyRevisionsRequest
-	194, // 253: nitella.local.MobileLogicService.ListProxyRevisions:input_type -> nitella.local.ListProxyRevisionsRequest
-	197, // 254: nitella.local.MobileLogicService.FlushProxyRevisions:input_type -> nitella.local.FlushProxyRevisionsRequest
-	199, // 255: nitella.local.MobileLogicService.ListProxyConfigs:input_type -> nitella.local.ListProxyConfigsRequest
-	202, // 256: nitella.local.MobileLogicService.CreateProxyConfig:input_type -> nitella.local.CreateProxyConfigRequest
-	204, // 257: nitella.local.MobileLogicService.DeleteProxyConfig:input_type -> nitella.local.DeleteProxyConfigRequest
-	206, // 258: nitella.local.MobileLogicService.ApplyProxyToNode:input_type -> nitella.local.ApplyProxyToNodeRequest
-	208, // 259: nitella.local.MobileLogicService.UnapplyProxyFromNode:input_type -> nitella.local.UnapplyProxyFromNodeRequest
-	210, // 260: nitella.local.MobileLogicService.GetAppliedProxies:input_type -> nitella.local.GetAppliedProxiesRequest
-	213, // 261: nitella.local.MobileLogicService.AllowIP:input_type -> nitella.local.AllowIPRequest
-	215, // 262: nitella.local.MobileLogicService.StreamMetrics:input_type -> nitella.local.StreamMetricsRequest
-	216, // 263: nitella.local.MobileLogicService.GetDebugRuntimeStats:input_type -> nitella.local.GetDebugRuntimeStatsRequest
-	220, // 264: nitella.local.MobileLogicService.GetLogsStats:input_type -> nitella.local.GetLogsStatsRequest
-	222, // 265: nitella.local.MobileLogicService.ListLogs:input_type -> nitella.local.ListLogsRequest
-	225, // 266: nitella.local.MobileLogicService.DeleteLogs:input_type -> nitella.local.DeleteLogsRequest
-	227, // 267: nitella.local.MobileLogicService.CleanupOldLogs:input_type -> nitella.local.CleanupOldLogsRequest
-	229, // 268: nitella.local.MobileLogicService.GetNodeFromHub:input_type -> nitella.local.GetNodeFromHubRequest
-	231, // 269: nitella.local.MobileLogicService.RegisterNodeWithHub:input_type -> nitella.local.RegisterNodeWithHubRequest
-	76,  // 270: nitella.local.MobileUIService.OnApprovalRequest:input_type -> nitella.local.ApprovalRequest
-	167, // 271: nitella.local.MobileUIService.OnNodeStatusChange:input_type -> nitella.local.NodeStatusChange
-	105, // 272: nitella.local.MobileUIService.OnConnectionEvent:input_type -> nitella.local.ConnectionEvent
-	168, // 273: nitella.local.MobileUIService.OnAlert:input_type -> nitella.local.Alert
-	169, // 274: nitella.local.MobileUIService.OnToast:input_type -> nitella.local.ToastMessage
-	14,  // 275: nitella.local.MobileLogicService.Initialize:output_type -> nitella.local.InitializeResponse
-	251, // 276: nitella.local.MobileLogicService.Shutdown:output_type -> google.protobuf.Empty
-	15,  // 277: nitella.local.MobileLogicService.GetBootstrapState:output_type -> nitella.local.BootstrapStateResponse
-	16,  // 278: nitella.local.MobileLogicService.GetIdentity:output_type -> nitella.local.IdentityInfo
-	18,  // 279: nitella.local.MobileLogicService.CreateIdentity:output_type -> nitella.local.CreateIdentityResponse
-	20,  // 280: nitella.local.MobileLogicService.RestoreIdentity:output_type -> nitella.local.RestoreIdentityResponse
-	22,  // 281: nitella.local.MobileLogicService.ImportIdentity:output_type -> nitella.local.ImportIdentityResponse
-	24,  // 282: nitella.local.MobileLogicService.UnlockIdentity:output_type -> nitella.local.UnlockIdentityResponse
-	251, // 283: nitella.local.MobileLogicService.LockIdentity:output_type -> google.protobuf.Empty
-	251, // 284: nitella.local.MobileLogicService.ChangePassphrase:output_type -> google.protobuf.Empty
-	27,  // 285: nitella.local.MobileLogicService.EvaluatePassphrase:output_type -> nitella.local.EvaluatePassphraseResponse
-	251, // 286: nitella.local.MobileLogicService.ResetIdentity:output_type -> google.protobuf.Empty
-	31,  // 287: nitella.local.MobileLogicService.ListNodes:output_type -> nitella.local.ListNodesResponse
-	28,  // 288: nitella.local.MobileLogicService.GetNode:output_type -> nitella.local.NodeInfo
-	35,  // 289: nitella.local.MobileLogicService.GetNodeDetailSnapshot:output_type -> nitella.local.NodeDetailSnapshot
-	28,  // 290: nitella.local.MobileLogicService.UpdateNode:output_type -> nitella.local.NodeInfo
-	251, // 291: nitella.local.MobileLogicService.RemoveNode:output_type -> google.protobuf.Empty
-	39,  // 292: nitella.local.MobileLogicService.AddNodeDirect:output_type -> nitella.local.AddNodeDirectResponse
-	41,  // 293: nitella.local.MobileLogicService.TestDirectConnection:output_type -> nitella.local.TestDirectConnectionResponse
-	44,  // 294: nitella.local.MobileLogicService.ListProxies:output_type -> nitella.local.ListProxiesResponse
-	47,  // 295: nitella.local.MobileLogicService.GetProxiesSnapshot:output_type -> nitella.local.GetProxiesSnapshotResponse
-	42,  // 296: nitella.local.MobileLogicService.GetProxy:output_type -> nitella.local.ProxyInfo
-	42,  // 297: nitella.local.MobileLogicService.AddProxy:output_type -> nitella.local.ProxyInfo
-	42,  // 298: nitella.local.MobileLogicService.UpdateProxy:output_type -> nitella.local.ProxyInfo
-	53,  // 299: nitella.local.MobileLogicService.SetNodeProxiesRunning:output_type -> nitella.local.SetNodeProxiesRunningResponse
-	251, // 300: nitella.local.MobileLogicService.RemoveProxy:output_type -> google.protobuf.Empty
-	55,  // 301: nitella.local.MobileLogicService.ListRules:output_type -> nitella.local.ListRulesResponse
-	238, // 302: nitella.local.MobileLogicService.GetRule:output_type -> nitella.proxy.Rule
-	238, // 303: nitella.local.MobileLogicService.AddRule:output_type -> nitella.proxy.Rule
-	61,  // 304: nitella.local.MobileLogicService.AddQuickRule:output_type -> nitella.local.AddQuickRuleResponse
-	238, // 305: nitella.local.MobileLogicService.UpdateRule:output_type -> nitella.proxy.Rule
-	251, // 306: nitella.local.MobileLogicService.RemoveRule:output_type -> google.protobuf.Empty
-	65,  // 307: nitella.local.MobileLogicService.BlockIP:output_type -> nitella.local.BlockIPResponse
-	67,  // 308: nitella.local.MobileLogicService.BlockISP:output_type -> nitella.local.BlockISPResponse
-	69,  // 309: nitella.local.MobileLogicService.BlockCountry:output_type -> nitella.local.BlockCountryResponse
-	71,  // 310: nitella.local.MobileLogicService.AddGlobalRule:output_type -> nitella.local.AddGlobalRuleResponse
-	73,  // 311: nitella.local.MobileLogicService.ListGlobalRules:output_type -> nitella.local.ListGlobalRulesResponse
-	75,  // 312: nitella.local.MobileLogicService.RemoveGlobalRule:output_type -> nitella.local.RemoveGlobalRuleResponse
-	78,  // 313: nitella.local.MobileLogicService.ListPendingApprovals:output_type -> nitella.local.ListPendingApprovalsResponse
-	80,  // 314: nitella.local.MobileLogicService.GetApprovalsSnapshot:output_type -> nitella.local.GetApprovalsSnapshotResponse
-	82,  // 315: nitella.local.MobileLogicService.ApproveRequest:output_type -> nitella.local.ApproveRequestResponse
-	84,  // 316: nitella.local.MobileLogicService.DenyRequest:output_type -> nitella.local.DenyRequestResponse
-	86,  // 317: nitella.local.MobileLogicService.ResolveApprovalDecision:output_type -> nitella.local.ResolveApprovalDecisionResponse
-	76,  // 318: nitella.local.MobileLogicService.StreamApprovals:output_type -> nitella.local.ApprovalRequest
-	90,  // 319: nitella.local.MobileLogicService.ListApprovalHistory:output_type -> nitella.local.ListApprovalHistoryResponse
-	92,  // 320: nitella.local.MobileLogicService.ClearApprovalHistory:output_type -> nitella.local.ClearApprovalHistoryResponse
-	93,  // 321: nitella.local.MobileLogicService.GetConnectionStats:output_type -> nitella.local.ConnectionStats
-	97,  // 322: nitella.local.MobileLogicService.ListConnections:output_type -> nitella.local.ListConnectionsResponse
-	100, // 323: nitella.local.MobileLogicService.GetIPStats:output_type -> nitella.local.GetIPStatsResponse
-	103, // 324: nitella.local.MobileLogicService.GetGeoStats:output_type -> nitella.local.GetGeoStatsResponse
-	105, // 325: nitella.local.MobileLogicService.StreamConnections:output_type -> nitella.local.ConnectionEvent
-	107, // 326: nitella.local.MobileLogicService.CloseConnection:output_type -> nitella.local.CloseConnectionResponse
-	109, // 327: nitella.local.MobileLogicService.CloseAllConnections:output_type -> nitella.local.CloseAllConnectionsResponse
-	111, // 328: nitella.local.MobileLogicService.CloseAllNodeConnections:output_type -> nitella.local.CloseAllNodeConnectionsResponse
-	113, // 329: nitella.local.MobileLogicService.StartPairing:output_type -> nitella.local.StartPairingResponse
-	115, // 330: nitella.local.MobileLogicService.JoinPairing:output_type -> nitella.local.JoinPairingResponse
-	117, // 331: nitella.local.MobileLogicService.CompletePairing:output_type -> nitella.local.CompletePairingResponse
-	119, // 332: nitella.local.MobileLogicService.FinalizePairing:output_type -> nitella.local.FinalizePairingResponse
-	251, // 333: nitella.local.MobileLogicService.CancelPairing:output_type -> google.protobuf.Empty
-	122, // 334: nitella.local.MobileLogicService.GenerateQRCode:output_type -> nitella.local.GenerateQRCodeResponse
-	124, // 335: nitella.local.MobileLogicService.ScanQRCode:output_type -> nitella.local.ScanQRCodeResponse
-	126, // 336: nitella.local.MobileLogicService.GenerateQRResponse:output_type -> nitella.local.GenerateQRReplyResponse
-	130, // 337: nitella.local.MobileLogicService.ListTemplates:output_type -> nitella.local.ListTemplatesResponse
-	127, // 338: nitella.local.MobileLogicService.GetTemplate:output_type -> nitella.local.Template
-	127, // 339: nitella.local.MobileLogicService.CreateTemplate:output_type -> nitella.local.Template
-	134, // 340: nitella.local.MobileLogicService.ApplyTemplate:output_type -> nitella.local.ApplyTemplateResponse
-	251, // 341: nitella.local.MobileLogicService.DeleteTemplate:output_type -> google.protobuf.Empty
-	136, // 342: nitella.local.MobileLogicService.SyncTemplates:output_type -> nitella.local.SyncTemplatesResponse
-	138, // 343: nitella.local.MobileLogicService.ExportTemplateYaml:output_type -> nitella.local.ExportTemplateYamlResponse
-	140, // 344: nitella.local.MobileLogicService.ImportTemplateYaml:output_type -> nitella.local.ImportTemplateYamlResponse
-	141, // 345: nitella.local.MobileLogicService.GetSettings:output_type -> nitella.local.Settings
-	143, // 346: nitella.local.MobileLogicService.GetSettingsOverviewSnapshot:output_type -> nitella.local.SettingsOverviewSnapshot
-	141, // 347: nitella.local.MobileLogicService.UpdateSettings:output_type -> nitella.local.Settings
-	251, // 348: nitella.local.MobileLogicService.RegisterFCMToken:output_type -> google.protobuf.Empty
-	251, // 349: nitella.local.MobileLogicService.UnregisterFCMToken:output_type -> google.protobuf.Empty
-	148, // 350: nitella.local.MobileLogicService.ConnectToHub:output_type -> nitella.local.ConnectToHubResponse
-	251, // 351: nitella.local.MobileLogicService.DisconnectFromHub:output_type -> google.protobuf.Empty
-	149, // 352: nitella.local.MobileLogicService.GetHubStatus:output_type -> nitella.local.HubStatus
-	150, // 353: nitella.local.MobileLogicService.GetHubSettingsSnapshot:output_type -> nitella.local.HubSettingsSnapshot
-	151, // 354: nitella.local.MobileLogicService.GetHubOverview:output_type -> nitella.local.HubOverview
-	153, // 355: nitella.local.MobileLogicService.GetHubDashboardSnapshot:output_type -> nitella.local.HubDashboardSnapshot
-	155, // 356: nitella.local.MobileLogicService.RegisterUser:output_type -> nitella.local.RegisterUserResponse
-	147, // 357: nitella.local.MobileLogicService.FetchHubCA:output_type -> nitella.local.FetchHubCAResponse
-	161, // 358: nitella.local.MobileLogicService.OnboardHub:output_type -> nitella.local.OnboardHubResponse
-	161, // 359: nitella.local.MobileLogicService.EnsureHubConnected:output_type -> nitella.local.OnboardHubResponse
-	161, // 360: nitella.local.MobileLogicService.EnsureHubRegistered:output_type -> nitella.local.OnboardHubResponse
-	161, // 361: nitella.local.MobileLogicService.ResolveHubTrustChallenge:output_type -> nitella.local.OnboardHubResponse
-	170, // 362: nitella.local.MobileLogicService.GetP2PStatus:output_type -> nitella.local.P2PStatus
-	171, // 363: nitella.local.MobileLogicService.GetP2PSettingsSnapshot:output_type -> nitella.local.P2PSettingsSnapshot
-	170, // 364: nitella.local.MobileLogicService.StreamP2PStatus:output_type -> nitella.local.P2PStatus
-	251, // 365: nitella.local.MobileLogicService.SetP2PMode:output_type -> google.protobuf.Empty
-	163, // 366: nitella.local.MobileLogicService.LookupIP:output_type -> nitella.local.LookupIPResponse
-	252, // 367: nitella.local.MobileLogicService.ConfigureGeoIP:output_type -> nitella.proxy.ConfigureGeoIPResponse
-	253, // 368: nitella.local.MobileLogicService.GetGeoIPStatus:output_type -> nitella.proxy.GetGeoIPStatusResponse
-	254, // 369: nitella.local.MobileLogicService.RestartListeners:output_type -> nitella.proxy.RestartListenersResponse
-	175, // 370: nitella.local.MobileLogicService.ListLocalProxyConfigs:output_type -> nitella.local.ListLocalProxyConfigsResponse
-	177, // 371: nitella.local.MobileLogicService.GetLocalProxyConfig:output_type -> nitella.local.GetLocalProxyConfigResponse
-	179, // 372: nitella.local.MobileLogicService.ImportLocalProxyConfig:output_type -> nitella.local.ImportLocalProxyConfigResponse
-	181, // 373: nitella.local.MobileLogicService.SaveLocalProxyConfig:output_type -> nitella.local.SaveLocalProxyConfigResponse
-	183, // 374: nitella.local.MobileLogicService.DeleteLocalProxyConfig:output_type -> nitella.local.DeleteLocalProxyConfigResponse
-	185, // 375: nitella.local.MobileLogicService.ValidateLocalProxyConfig:output_type -> nitella.local.ValidateLocalProxyConfigResponse
-	187, // 376: nitella.local.MobileLogicService.PushProxyRevision:output_type -> nitella.local.PushProxyRevisionResponse
-	189, // 377: nitella.local.MobileLogicService.PushLocalProxyRevision:output_type -> nitella.local.PushLocalProxyRevisionResponse
-	191, // 378: nitella.local.MobileLogicService.PullProxyRevision:output_type -> nitella.local.PullProxyRevisionResponse
-	193, // 379: nitella.local.MobileLogicService.DiffProxyRevisions:output_type -> nitella.local.DiffProxyRevisionsResponse
-	195, // 380: nitella.local.MobileLogicService.ListProxyRevisions:output_type -> nitella.local.ListProxyRevisionsResponse
-	198, // 381: nitella.local.MobileLogicService.FlushProxyRevisions:output_type -> nitella.local.FlushProxyRevisionsResponse
-	200, // 382: nitella.local.MobileLogicService.ListProxyConfigs:output_type -> nitella.local.ListProxyConfigsResponse
-	203, // 383: nitella.local.MobileLogicService.CreateProxyConfig:output_type -> nitella.local.CreateProxyConfigResponse
-	205, // 384: nitella.local.MobileLogicService.DeleteProxyConfig:output_type -> nitella.local.DeleteProxyConfigResponse
-	207, // 385: nitella.local.MobileLogicService.ApplyProxyToNode:output_type -> nitella.local.ApplyProxyToNodeResponse
-	209, // 386: nitella.local.MobileLogicService.UnapplyProxyFromNode:output_type -> nitella.local.UnapplyProxyFromNodeResponse
-	211, // 387: nitella.local.MobileLogicService.GetAppliedProxies:output_type -> nitella.local.GetAppliedProxiesResponse
-	214, // 388: nitella.local.MobileLogicService.AllowIP:output_type -> nitella.local.AllowIPResponse
-	29,  // 389: nitella.local.MobileLogicService.StreamMetrics:output_type -> nitella.local.NodeMetrics
-	217, // 390: nitella.local.MobileLogicService.GetDebugRuntimeStats:output_type -> nitella.local.DebugRuntimeStats
-	221, // 391: nitella.local.MobileLogicService.GetLogsStats:output_type -> nitella.local.GetLogsStatsResponse
-	223, // 392: nitella.local.MobileLogicService.ListLogs:output_type -> nitella.local.ListLogsResponse
-	226, // 393: nitella.local.MobileLogicService.DeleteLogs:output_type -> nitella.local.DeleteLogsResponse
-	228, // 394: nitella.local.MobileLogicService.CleanupOldLogs:output_type -> nitella.local.CleanupOldLogsResponse
-	230, // 395: nitella.local.MobileLogicService.GetNodeFromHub:output_type -> nitella.local.GetNodeFromHubResponse
-	232, // 396: nitella.local.MobileLogicService.RegisterNodeWithHub:output_type -> nitella.local.RegisterNodeWithHubResponse
-	251, // 397: nitella.local.MobileUIService.OnApprovalRequest:output_type -> google.protobuf.Empty
-	251, // 398: nitella.local.MobileUIService.OnNodeStatusChange:output_type -> google.protobuf.Empty
-	251, // 399: nitella.local.MobileUIService.OnConnectionEvent:output_type -> google.protobuf.Empty
-	251, // 400: nitella.local.MobileUIService.OnAlert:output_type -> google.protobuf.Empty
-	251, // 401: nitella.local.MobileUIService.OnToast:output_type -> google.protobuf.Empty
-	275, // [275:402] is the sub-list for method output_type
-	148, // [148:275] is the sub-list for method input_type
-	148, // [148:148] is the sub-list for extension type_name
-	148, // [148:148] is the sub-list for extension extendee
-	0,   // [0:148] is the sub-list for field type_name
+	246, // 41: nitella.local.RuleComposerPolicy.allowed_actions:type_name -> nitella.ActionType
+	244, // 42: nitella.local.AddRuleRequest.rule:type_name -> nitella.proxy.Rule
+	246, // 43: nitella.local.AddQuickRuleRequest.action:type_name -> nitella.ActionType
+	249, // 44: nitella.local.AddQuickRuleRequest.condition_type:type_name -> nitella.ConditionType
+	244, // 45: nitella.local.UpdateRuleRequest.rule:type_name -> nitella.proxy.Rule
+	245, // 46: nitella.local.UpdateRuleRequest.update_mask:type_name -> google.protobuf.FieldMask
+	246, // 47: nitella.local.AddGlobalRuleRequest.action:type_name -> nitella.ActionType
+	251, // 48: nitella.local.ListGlobalRulesResponse.rules:type_name -> nitella.proxy.GlobalRule
+	252, // 49: nitella.local.ApprovalRequest.geo:type_name -> nitella.GeoInfo
+	243, // 50: nitella.local.ApprovalRequest.timestamp:type_name -> google.protobuf.Timestamp
+	253, // 51: nitella.local.ApprovalRequest.context:type_name -> nitella.ApprovalContext
+	76,  // 52: nitella.local.ListPendingApprovalsResponse.requests:type_name -> nitella.local.ApprovalRequest
+	76,  // 53: nitella.local.GetApprovalsSnapshotResponse.pending_requests:type_name -> nitella.local.ApprovalRequest
+	88,  // 54: nitella.local.GetApprovalsSnapshotResponse.history_entries:type_name -> nitella.local.ApprovalHistoryEntry
+	8,   // 55: nitella.local.GetApprovalsSnapshotResponse.deny_block_options:type_name -> nitella.local.DenyBlockType
+	254, // 56: nitella.local.ApproveRequestRequest.retention_mode:type_name -> nitella.ApprovalRetentionMode
+	254, // 57: nitella.local.DenyRequestRequest.retention_mode:type_name -> nitella.ApprovalRetentionMode
+	8,   // 58: nitella.local.DenyRequestRequest.block_type:type_name -> nitella.local.DenyBlockType
+	9,   // 59: nitella.local.ResolveApprovalDecisionRequest.decision:type_name -> nitella.local.ApprovalDecision
+	254, // 60: nitella.local.ResolveApprovalDecisionRequest.retention_mode:type_name -> nitella.ApprovalRetentionMode
+	8,   // 61: nitella.local.ResolveApprovalDecisionRequest.deny_block_type:type_name -> nitella.local.DenyBlockType
+	252, // 62: nitella.local.ApprovalHistoryEntry.geo:type_name -> nitella.GeoInfo
+	10,  // 63: nitella.local.ApprovalHistoryEntry.action:type_name -> nitella.local.ApprovalHistoryAction
+	8,   // 64: nitella.local.ApprovalHistoryEntry.block_type:type_name -> nitella.local.DenyBlockType
+	243, // 65: nitella.local.ApprovalHistoryEntry.decided_at:type_name -> google.protobuf.Timestamp
+	88,  // 66: nitella.local.ListApprovalHistoryResponse.entries:type_name -> nitella.local.ApprovalHistoryEntry
+	244, // 67: nitella.local.RuleProposal.rule:type_name -> nitella.proxy.Rule
+	88,  // 68: nitella.local.RuleProposal.matched:type_name -> nitella.local.ApprovalHistoryEntry
+	94,  // 69: nitella.local.ListRuleProposalsResponse.proposals:type_name -> nitella.local.RuleProposal
+	243, // 70: nitella.local.ConnectionInfo.start_time:type_name -> google.protobuf.Timestamp
+	252, // 71: nitella.local.ConnectionInfo.geo:type_name -> nitella.GeoInfo
+	246, // 72: nitella.local.ConnectionInfo.action:type_name -> nitella.ActionType
+	100, // 73: nitella.local.ListConnectionsResponse.connections:type_name -> nitella.local.ConnectionInfo
+	255, // 74: nitella.local.GetIPStatsRequest.sort_by:type_name -> nitella.SortOrder
+	243, // 75: nitella.local.IPStats.first_seen:type_name -> google.protobuf.Timestamp
+	243, // 76: nitella.local.IPStats.last_seen:type_name -> google.protobuf.Timestamp
+	104, // 77: nitella.local.GetIPStatsResponse.stats:type_name -> nitella.local.IPStats
+	0,   // 78: nitella.local.GetGeoStatsRequest.type:type_name -> nitella.local.GeoStatsType
+	0,   // 79: nitella.local.GeoStats.type:type_name -> nitella.local.GeoStatsType
+	107, // 80: nitella.local.GetGeoStatsResponse.stats:type_name -> nitella.local.GeoStats
+	11,  // 81: nitella.local.ConnectionEvent.event_type:type_name -> nitella.local.ConnectionEvent.EventType
+	243, // 82: nitella.local.ConnectionEvent.timestamp:type_name -> google.protobuf.Timestamp
+	246, // 83: nitella.local.ConnectionEvent.action_taken:type_name -> nitella.ActionType
+	252, // 84: nitella.local.ConnectionEvent.geo:type_name -> nitella.GeoInfo
+	28,  // 85: nitella.local.CompletePairingResponse.node:type_name -> nitella.local.NodeInfo
+	28,  // 86: nitella.local.FinalizePairingResponse.node:type_name -> nitella.local.NodeInfo
+	28,  // 87: nitella.local.GenerateQRReplyResponse.node:type_name -> nitella.local.NodeInfo
+	243, // 88: nitella.local.Template.created_at:type_name -> google.protobuf.Timestamp
+	243, // 89: nitella.local.Template.updated_at:type_name -> google.protobuf.Timestamp
+	133, // 90: nitella.local.Template.proxies:type_name -> nitella.local.ProxyTemplate
+	246, // 91: nitella.local.ProxyTemplate.default_action:type_name -> nitella.ActionType
+	247, // 92: nitella.local.ProxyTemplate.fallback_action:type_name -> nitella.FallbackAction
+	244, // 93: nitella.local.ProxyTemplate.rules:type_name -> nitella.proxy.Rule
+	132, // 94: nitella.local.ListTemplatesResponse.templates:type_name -> nitella.local.Template
+	132, // 95: nitella.local.ExportTemplateYamlResponse.template:type_name -> nitella.local.Template
+	132, // 96: nitella.local.ImportTemplateYamlResponse.template:type_name -> nitella.local.Template
+	256, // 97: nitella.local.Settings.p2p_mode:type_name -> nitella.P2PMode
+	1,   // 98: nitella.local.Settings.theme:type_name -> nitella.local.Theme
+	146, // 99: nitella.local.UpdateSettingsRequest.settings:type_name -> nitella.local.Settings
+	245, // 100: nitella.local.UpdateSettingsRequest.update_mask:type_name -> google.protobuf.FieldMask
+	16,  // 101: nitella.local.SettingsOverviewSnapshot.identity:type_name -> nitella.local.IdentityInfo
+	155, // 102: nitella.local.SettingsOverviewSnapshot.hub:type_name -> nitella.local.HubSettingsSnapshot
+	177, // 103: nitella.local.SettingsOverviewSnapshot.p2p:type_name -> nitella.local.P2PSettingsSnapshot
+	4,   // 104: nitella.local.RegisterFCMTokenRequest.device_type:type_name -> nitella.local.DeviceType
+	243, // 105: nitella.local.HubStatus.connected_since:type_name -> google.protobuf.Timestamp
+	154, // 106: nitella.local.HubSettingsSnapshot.status:type_name -> nitella.local.HubStatus
+	146, // 107: nitella.local.HubSettingsSnapshot.settings:type_name -> nitella.local.Settings
+	164, // 108: nitella.local.HubSettingsSnapshot.pending_trust_challenge:type_name -> nitella.local.HubTrustChallenge
+	156, // 109: nitella.local.HubDashboardSnapshot.overview:type_name -> nitella.local.HubOverview
+	28,  // 110: nitella.local.HubDashboardSnapshot.nodes:type_name -> nitella.local.NodeInfo
+	28,  // 111: nitella.local.HubDashboardSnapshot.pinned_nodes:type_name -> nitella.local.NodeInfo
+	12,  // 112: nitella.local.OnboardHubResponse.stage:type_name -> nitella.local.OnboardHubResponse.Stage
+	164, // 113: nitella.local.OnboardHubResponse.trust_challenge:type_name -> nitella.local.HubTrustChallenge
+	252, // 114: nitella.local.LookupIPResponse.geo:type_name -> nitella.GeoInfo
+	257, // 115: nitella.local.ConfigureGeoIPNodeRequest.config:type_name -> nitella.proxy.ConfigureGeoIPRequest
+	243, // 116: nitella.local.NodeStatusChange.timestamp:type_name -> google.protobuf.Timestamp
+	2,   // 117: nitella.local.Alert.severity:type_name -> nitella.local.AlertSeverity
+	243, // 118: nitella.local.Alert.timestamp:type_name -> google.protobuf.Timestamp
+	239, // 119: nitella.local.Alert.metadata:type_name -> nitella.local.Alert.MetadataEntry
+	3,   // 120: nitella.local.ToastMessage.type:type_name -> nitella.local.ToastType
+	256, // 121: nitella.local.P2PStatus.mode:type_name -> nitella.P2PMode
+	176, // 122: nitella.local.P2PSettingsSnapshot.status:type_name -> nitella.local.P2PStatus
+	146, // 123: nitella.local.P2PSettingsSnapshot.settings:type_name -> nitella.local.Settings
+	256, // 124: nitella.local.SetP2PModeRequest.mode:type_name -> nitella.P2PMode
+	243, // 125: nitella.local.LocalProxyConfig.created_at:type_name -> google.protobuf.Timestamp
+	243, // 126: nitella.local.LocalProxyConfig.updated_at:type_name -> google.protobuf.Timestamp
+	243, // 127: nitella.local.LocalProxyConfig.synced_at:type_name -> google.protobuf.Timestamp
+	179, // 128: nitella.local.ListLocalProxyConfigsResponse.proxies:type_name -> nitella.local.LocalProxyConfig
+	179, // 129: nitella.local.GetLocalProxyConfigResponse.proxy:type_name -> nitella.local.LocalProxyConfig
+	179, // 130: nitella.local.ImportLocalProxyConfigResponse.proxy:type_name -> nitella.local.LocalProxyConfig
+	179, // 131: nitella.local.SaveLocalProxyConfigResponse.proxy:type_name -> nitella.local.LocalProxyConfig
+	179, // 132: nitella.local.PushLocalProxyRevisionResponse.local_proxy:type_name -> nitella.local.LocalProxyConfig
+	179, // 133: nitella.local.PullProxyRevisionResponse.local_proxy:type_name -> nitella.local.LocalProxyConfig
+	202, // 134: nitella.local.ListProxyRevisionsResponse.revisions:type_name -> nitella.local.ProxyRevisionMeta
+	243, // 135: nitella.local.ProxyRevisionMeta.created_at:type_name -> google.protobuf.Timestamp
+	207, // 136: nitella.local.ListProxyConfigsResponse.proxies:type_name -> nitella.local.ProxyConfigInfo
+	243, // 137: nitella.local.ProxyConfigInfo.updated_at:type_name -> google.protobuf.Timestamp
+	218, // 138: nitella.local.GetAppliedProxiesResponse.proxies:type_name -> nitella.local.AppliedProxy
+	224, // 139: nitella.local.DebugRuntimeStats.grpc_connections:type_name -> nitella.local.DebugGrpcConnection
+	225, // 140: nitella.local.DebugRuntimeStats.goroutine_diff_entries:type_name -> nitella.local.DebugGoroutineDiffEntry
+	243, // 141: nitella.local.DebugRuntimeStats.goroutine_diff_prev_at:type_name -> google.protobuf.Timestamp
+	243, // 142: nitella.local.DebugRuntimeStats.goroutine_diff_curr_at:type_name -> google.protobuf.Timestamp
+	243, // 143: nitella.local.GetLogsStatsResponse.oldest_log:type_name -> google.protobuf.Timestamp
+	243, // 144: nitella.local.GetLogsStatsResponse.newest_log:type_name -> google.protobuf.Timestamp
+	240, // 145: nitella.local.GetLogsStatsResponse.logs_by_routing_token:type_name -> nitella.local.GetLogsStatsResponse.LogsByRoutingTokenEntry
+	241, // 146: nitella.local.GetLogsStatsResponse.storage_by_routing_token:type_name -> nitella.local.GetLogsStatsResponse.StorageByRoutingTokenEntry
+	230, // 147: nitella.local.ListLogsResponse.logs:type_name -> nitella.local.LogEntry
+	243, // 148: nitella.local.LogEntry.timestamp:type_name -> google.protobuf.Timestamp
+	243, // 149: nitella.local.DeleteLogsRequest.before:type_name -> google.protobuf.Timestamp
+	242, // 150: nitella.local.CleanupOldLogsResponse.deleted_by_routing_token:type_name -> nitella.local.CleanupOldLogsResponse.DeletedByRoutingTokenEntry
+	243, // 151: nitella.local.GetNodeFromHubResponse.last_seen:type_name -> google.protobuf.Timestamp
+	13,  // 152: nitella.local.MobileLogicService.Initialize:input_type -> nitella.local.InitializeRequest
+	258, // 153: nitella.local.MobileLogicService.Shutdown:input_type -> google.protobuf.Empty
+	258, // 154: nitella.local.MobileLogicService.GetBootstrapState:input_type -> google.protobuf.Empty
+	258, // 155: nitella.local.MobileLogicService.GetIdentity:input_type -> google.protobuf.Empty
+	17,  // 156: nitella.local.MobileLogicService.CreateIdentity:input_type -> nitella.local.CreateIdentityRequest
+	19,  // 157: nitella.local.MobileLogicService.RestoreIdentity:input_type -> nitella.local.RestoreIdentityRequest
+	21,  // 158: nitella.local.MobileLogicService.ImportIdentity:input_type -> nitella.local.ImportIdentityRequest
+	23,  // 159: nitella.local.MobileLogicService.UnlockIdentity:input_type -> nitella.local.UnlockIdentityRequest
+	258, // 160: nitella.local.MobileLogicService.LockIdentity:input_type -> google.protobuf.Empty
+	25,  // 161: nitella.local.MobileLogicService.ChangePassphrase:input_type -> nitella.local.ChangePassphraseRequest
+	26,  // 162: nitella.local.MobileLogicService.EvaluatePassphrase:input_type -> nitella.local.EvaluatePassphraseRequest
+	258, // 163: nitella.local.MobileLogicService.ResetIdentity:input_type -> google.protobuf.Empty
+	30,  // 164: nitella.local.MobileLogicService.ListNodes:input_type -> nitella.local.ListNodesRequest
+	32,  // 165: nitella.local.MobileLogicService.GetNode:input_type -> nitella.local.GetNodeRequest
+	33,  // 166: nitella.local.MobileLogicService.GetNodeDetailSnapshot:input_type -> nitella.local.GetNodeDetailSnapshotRequest
+	36,  // 167: nitella.local.MobileLogicService.UpdateNode:input_type -> nitella.local.UpdateNodeRequest
+	37,  // 168: nitella.local.MobileLogicService.RemoveNode:input_type -> nitella.local.RemoveNodeRequest
+	38,  // 169: nitella.local.MobileLogicService.AddNodeDirect:input_type -> nitella.local.AddNodeDirectRequest
+	40,  // 170: nitella.local.MobileLogicService.TestDirectConnection:input_type -> nitella.local.TestDirectConnectionRequest
+	43,  // 171: nitella.local.MobileLogicService.ListProxies:input_type -> nitella.local.ListProxiesRequest
+	45,  // 172: nitella.local.MobileLogicService.GetProxiesSnapshot:input_type -> nitella.local.GetProxiesSnapshotRequest
+	48,  // 173: nitella.local.MobileLogicService.GetProxy:input_type -> nitella.local.GetProxyRequest
+	49,  // 174: nitella.local.MobileLogicService.AddProxy:input_type -> nitella.local.AddProxyRequest
+	50,  // 175: nitella.local.MobileLogicService.UpdateProxy:input_type -> nitella.local.UpdateProxyRequest
+	52,  // 176: nitella.local.MobileLogicService.SetNodeProxiesRunning:input_type -> nitella.local.SetNodeProxiesRunningRequest
+	51,  // 177: nitella.local.MobileLogicService.RemoveProxy:input_type -> nitella.local.RemoveProxyRequest
+	54,  // 178: nitella.local.MobileLogicService.ListRules:input_type -> nitella.local.ListRulesRequest
+	58,  // 179: nitella.local.MobileLogicService.GetRule:input_type -> nitella.local.GetRuleRequest
+	59,  // 180: nitella.local.MobileLogicService.AddRule:input_type -> nitella.local.AddRuleRequest
+	60,  // 181: nitella.local.MobileLogicService.AddQuickRule:input_type -> nitella.local.AddQuickRuleRequest
+	62,  // 182: nitella.local.MobileLogicService.UpdateRule:input_type -> nitella.local.UpdateRuleRequest
+	63,  // 183: nitella.local.MobileLogicService.RemoveRule:input_type -> nitella.local.RemoveRuleRequest
+	64,  // 184: nitella.local.MobileLogicService.BlockIP:input_type -> nitella.local.BlockIPRequest
+	66,  // 185: nitella.local.MobileLogicService.BlockISP:input_type -> nitella.local.BlockISPRequest
+	68,  // 186: nitella.local.MobileLogicService.BlockCountry:input_type -> nitella.local.BlockCountryRequest
+	70,  // 187: nitella.local.MobileLogicService.AddGlobalRule:input_type -> nitella.local.AddGlobalRuleRequest
+	72,  // 188: nitella.local.MobileLogicService.ListGlobalRules:input_type -> nitella.local.ListGlobalRulesRequest
+	74,  // 189: nitella.local.MobileLogicService.RemoveGlobalRule:input_type -> nitella.local.RemoveGlobalRuleRequest
+	77,  // 190: nitella.local.MobileLogicService.ListPendingApprovals:input_type -> nitella.local.ListPendingApprovalsRequest
+	79,  // 191: nitella.local.MobileLogicService.GetApprovalsSnapshot:input_type -> nitella.local.GetApprovalsSnapshotRequest
+	81,  // 192: nitella.local.MobileLogicService.ApproveRequest:input_type -> nitella.local.ApproveRequestRequest
+	83,  // 193: nitella.local.MobileLogicService.DenyRequest:input_type -> nitella.local.DenyRequestRequest
+	85,  // 194: nitella.local.MobileLogicService.ResolveApprovalDecision:input_type -> nitella.local.ResolveApprovalDecisionRequest
+	87,  // 195: nitella.local.MobileLogicService.StreamApprovals:input_type -> nitella.local.StreamApprovalsRequest
+	89,  // 196: nitella.local.MobileLogicService.ListApprovalHistory:input_type -> nitella.local.ListApprovalHistoryRequest
+	91,  // 197: nitella.local.MobileLogicService.ClearApprovalHistory:input_type -> nitella.local.ClearApprovalHistoryRequest
+	93,  // 198: nitella.local.MobileLogicService.ListRuleProposals:input_type -> nitella.local.ListRuleProposalsRequest
+	96,  // 199: nitella.local.MobileLogicService.AcceptRuleProposal:input_type -> nitella.local.AcceptRuleProposalRequest
+	99,  // 200: nitella.local.MobileLogicService.GetConnectionStats:input_type -> nitella.local.GetConnectionStatsRequest
+	101, // 201: nitella.local.MobileLogicService.ListConnections:input_type -> nitella.local.ListConnectionsRequest
+	103, // 202: nitella.local.MobileLogicService.GetIPStats:input_type -> nitella.local.GetIPStatsRequest
+	106, // 203: nitella.local.MobileLogicService.GetGeoStats:input_type -> nitella.local.GetGeoStatsRequest
+	109, // 204: nitella.local.MobileLogicService.StreamConnections:input_type -> nitella.local.StreamConnectionsRequest
+	111, // 205: nitella.local.MobileLogicService.CloseConnection:input_type -> nitella.local.CloseConnectionRequest
+	113, // 206: nitella.local.MobileLogicService.CloseAllConnections:input_type -> nitella.local.CloseAllConnectionsRequest
+	115, // 207: nitella.local.MobileLogicService.CloseAllNodeConnections:input_type -> nitella.local.CloseAllNodeConnectionsRequest
+	117, // 208: nitella.local.MobileLogicService.StartPairing:input_type -> nitella.local.StartPairingRequest
+	119, // 209: nitella.local.MobileLogicService.JoinPairing:input_type -> nitella.local.JoinPairingRequest
+	121, // 210: nitella.local.MobileLogicService.CompletePairing:input_type -> nitella.local.CompletePairingRequest
+	123, // 211: nitella.local.MobileLogicService.FinalizePairing:input_type -> nitella.local.FinalizePairingRequest
+	125, // 212: nitella.local.MobileLogicService.CancelPairing:input_type -> nitella.local.CancelPairingRequest
+	126, // 213: nitella.local.MobileLogicService.GenerateQRCode:input_type -> nitella.local.GenerateQRCodeRequest
+	128, // 214: nitella.local.MobileLogicService.ScanQRCode:input_type -> nitella.local.ScanQRCodeRequest
+	130, // 215: nitella.local.MobileLogicService.GenerateQRResponse:input_type -> nitella.local.GenerateQRReplyRequest
+	134, // 216: nitella.local.MobileLogicService.ListTemplates:input_type -> nitella.local.ListTemplatesRequest
+	136, // 217: nitella.local.MobileLogicService.GetTemplate:input_type -> nitella.local.GetTemplateRequest
+	137, // 218: nitella.local.MobileLogicService.CreateTemplate:input_type -> nitella.local.CreateTemplateRequest
+	138, // 219: nitella.local.MobileLogicService.ApplyTemplate:input_type -> nitella.local.ApplyTemplateRequest
+	140, // 220: nitella.local.MobileLogicService.DeleteTemplate:input_type -> nitella.local.DeleteTemplateRequest
+	258, // 221: nitella.local.MobileLogicService.SyncTemplates:input_type -> google.protobuf.Empty
+	142, // 222: nitella.local.MobileLogicService.ExportTemplateYaml:input_type -> nitella.local.ExportTemplateYamlRequest
+	144, // 223: nitella.local.MobileLogicService.ImportTemplateYaml:input_type -> nitella.local.ImportTemplateYamlRequest
+	258, // 224: nitella.local.MobileLogicService.GetSettings:input_type -> google.protobuf.Empty
+	258, // 225: nitella.local.MobileLogicService.GetSettingsOverviewSnapshot:input_type -> google.protobuf.Empty
+	147, // 226: nitella.local.MobileLogicService.UpdateSettings:input_type -> nitella.local.UpdateSettingsRequest
+	149, // 227: nitella.local.MobileLogicService.RegisterFCMToken:input_type -> nitella.local.RegisterFCMTokenRequest
+	258, // 228: nitella.local.MobileLogicService.UnregisterFCMToken:input_type -> google.protobuf.Empty
+	150, // 229: nitella.local.MobileLogicService.ConnectToHub:input_type -> nitella.local.ConnectToHubRequest
+	258, // 230: nitella.local.MobileLogicService.DisconnectFromHub:input_type -> google.protobuf.Empty
+	258, // 231: nitella.local.MobileLogicService.GetHubStatus:input_type -> google.protobuf.Empty
+	258, // 232: nitella.local.MobileLogicService.GetHubSettingsSnapshot:input_type -> google.protobuf.Empty
+	258, // 233: nitella.local.MobileLogicService.GetHubOverview:input_type -> google.protobuf.Empty
+	157, // 234: nitella.local.MobileLogicService.GetHubDashboardSnapshot:input_type -> nitella.local.GetHubDashboardSnapshotRequest
+	159, // 235: nitella.local.MobileLogicService.RegisterUser:input_type -> nitella.local.RegisterUserRequest
+	151, // 236: nitella.local.MobileLogicService.FetchHubCA:input_type -> nitella.local.FetchHubCARequest
+	161, // 237: nitella.local.MobileLogicService.OnboardHub:input_type -> nitella.local.OnboardHubRequest
+	163, // 238: nitella.local.MobileLogicService.EnsureHubConnected:input_type -> nitella.local.EnsureHubConnectedRequest
+	162, // 239: nitella.local.MobileLogicService.EnsureHubRegistered:input_type -> nitella.local.EnsureHubRegisteredRequest
+	165, // 240: nitella.local.MobileLogicService.ResolveHubTrustChallenge:input_type -> nitella.local.ResolveHubTrustChallengeRequest
+	258, // 241: nitella.local.MobileLogicService.GetP2PStatus:input_type -> google.protobuf.Empty
+	258, // 242: nitella.local.MobileLogicService.GetP2PSettingsSnapshot:input_type -> google.protobuf.Empty
+	258, // 243: nitella.local.MobileLogicService.StreamP2PStatus:input_type -> google.protobuf.Empty
+	178, // 244: nitella.local.MobileLogicService.SetP2PMode:input_type -> nitella.local.SetP2PModeRequest
+	167, // 245: nitella.local.MobileLogicService.LookupIP:input_type -> nitella.local.LookupIPRequest
+	169, // 246: nitella.local.MobileLogicService.ConfigureGeoIP:input_type -> nitella.local.ConfigureGeoIPNodeRequest
+	170, // 247: nitella.local.MobileLogicService.GetGeoIPStatus:input_type -> nitella.local.GetGeoIPStatusNodeRequest
+	171, // 248: nitella.local.MobileLogicService.RestartListeners:input_type -> nitella.local.RestartListenersNodeRequest
+	172, // 249: nitella.local.MobileLogicService.GetMockTranscripts:input_type -> nitella.local.GetMockTranscriptsNodeRequest
+	180, // 250: nitella.local.MobileLogicService.ListLocalProxyConfigs:input_type -> nitella.local.ListLocalProxyConfigsRequest
+	182, // 251: nitella.local.MobileLogicService.GetLocalProxyConfig:input_type -> nitella.local.GetLocalProxyConfigRequest
+	184, // 252: nitella.local.MobileLogicService.ImportLocalProxyConfig:input_type -> nitella.local.ImportLocalProxyConfigRequest
+	186, // 253: nitella.local.MobileLogicService.SaveLocalProxyConfig:input_type -> nitella.local.SaveLocalProxyConfigRequest
+	188, // 254: nitella.local.MobileLogicService.DeleteLocalProxyConfig:input_type -> nitella.local.DeleteLocalProxyConfigRequest
+	190, // 255: nitella.local.MobileLogicService.ValidateLocalProxyConfig:input_type -> nitella.local.ValidateLocalProxyConfigRequest
+	192, // 256: nitella.local.MobileLogicService.PushProxyRevision:input_type -> nitella.local.PushProxyRevisionRequest
+	194, // 257: nitella.local.MobileLogicService.PushLocalProxyRevision:input_type -> nitella.local.PushLocalProxyRevisionRequest
+	196, // 258: nitella.local.MobileLogicService.PullProxyRevision:input_type -> nitella.local.PullProxyRevisionRequest
+	198, // 259: nitella.local.MobileLogicService.DiffProxyRevisions:input_type -> nitella.local.DiffProxyRevisionsRequest
+	200, // 260: nitella.local.MobileLogicService.ListProxyRevisions:input_type -> nitella.local.ListProxyRevisionsRequest
+	203, // 261: nitella.local.MobileLogicService.FlushProxyRevisions:input_type -> nitella.local.FlushProxyRevisionsRequest
+	205, // 262: nitella.local.MobileLogicService.ListProxyConfigs:input_type -> nitella.local.ListProxyConfigsRequest
+	208, // 263: nitella.local.MobileLogicService.CreateProxyConfig:input_type -> nitella.local.CreateProxyConfigRequest
+	210, // 264: nitella.local.MobileLogicService.DeleteProxyConfig:input_type -> nitella.local.DeleteProxyConfigRequest
+	212, // 265: nitella.local.MobileLogicService.ApplyProxyToNode:input_type -> nitella.local.ApplyProxyToNodeRequest
+	214, // 266: nitella.local.MobileLogicService.UnapplyProxyFromNode:input_type -> nitella.local.UnapplyProxyFromNodeRequest
+	216, // 267: nitella.local.MobileLogicService.GetAppliedProxies:input_type -> nitella.local.GetAppliedProxiesRequest
+	219, // 268: nitella.local.MobileLogicService.AllowIP:input_type -> nitella.local.AllowIPRequest
+	221, // 269: nitella.local.MobileLogicService.StreamMetrics:input_type -> nitella.local.StreamMetricsRequest
+	222, // 270: nitella.local.MobileLogicService.GetDebugRuntimeStats:input_type -> nitella.local.GetDebugRuntimeStatsRequest
+	226, // 271: nitella.local.MobileLogicService.GetLogsStats:input_type -> nitella.local.GetLogsStatsRequest
+	228, // 272: nitella.local.MobileLogicService.ListLogs:input_type -> nitella.local.ListLogsRequest
+	231, // 273: nitella.local.MobileLogicService.DeleteLogs:input_type -> nitella.local.DeleteLogsRequest
+	233, // 274: nitella.local.MobileLogicService.CleanupOldLogs:input_type -> nitella.local.CleanupOldLogsRequest
+	235, // 275: nitella.local.MobileLogicService.GetNodeFromHub:input_type -> nitella.local.GetNodeFromHubRequest
+	237, // 276: nitella.local.MobileLogicService.RegisterNodeWithHub:input_type -> nitella.local.RegisterNodeWithHubRequest
+	76,  // 277: nitella.local.MobileUIService.OnApprovalRequest:input_type -> nitella.local.ApprovalRequest
+	173, // 278: nitella.local.MobileUIService.OnNodeStatusChange:input_type -> nitella.local.NodeStatusChange
+	110, // 279: nitella.local.MobileUIService.OnConnectionEvent:input_type -> nitella.local.ConnectionEvent
+	174, // 280: nitella.local.MobileUIService.OnAlert:input_type -> nitella.local.Alert
+	175, // 281: nitella.local.MobileUIService.OnToast:input_type -> nitella.local.ToastMessage
+	14,  // 282: nitella.local.MobileLogicService.Initialize:output_type -> nitella.local.InitializeResponse
+	258, // 283: nitella.local.MobileLogicService.Shutdown:output_type -> google.protobuf.Empty
+	15,  // 284: nitella.local.MobileLogicService.GetBootstrapState:output_type -> nitella.local.BootstrapStateResponse
+	16,  // 285: nitella.local.MobileLogicService.GetIdentity:output_type -> nitella.local.IdentityInfo
+	18,  // 286: nitella.local.MobileLogicService.CreateIdentity:output_type -> nitella.local.CreateIdentityResponse
+	20,  // 287: nitella.local.MobileLogicService.RestoreIdentity:output_type -> nitella.local.RestoreIdentityResponse
+	22,  // 288: nitella.local.MobileLogicService.ImportIdentity:output_type -> nitella.local.ImportIdentityResponse
+	24,  // 289: nitella.local.MobileLogicService.UnlockIdentity:output_type -> nitella.local.UnlockIdentityResponse
+	258, // 290: nitella.local.MobileLogicService.LockIdentity:output_type -> google.protobuf.Empty
+	258, // 291: nitella.local.MobileLogicService.ChangePassphrase:output_type -> google.protobuf.Empty
+	27,  // 292: nitella.local.MobileLogicService.EvaluatePassphrase:output_type -> nitella.local.EvaluatePassphraseResponse
+	258, // 293: nitella.local.MobileLogicService.ResetIdentity:output_type -> google.protobuf.Empty
+	31,  // 294: nitella.local.MobileLogicService.ListNodes:output_type -> nitella.local.ListNodesResponse
+	28,  // 295: nitella.local.MobileLogicService.GetNode:output_type -> nitella.local.NodeInfo
+	35,  // 296: nitella.local.MobileLogicService.GetNodeDetailSnapshot:output_type -> nitella.local.NodeDetailSnapshot
+	28,  // 297: nitella.local.MobileLogicService.UpdateNode:output_type -> nitella.local.NodeInfo
+	258, // 298: nitella.local.MobileLogicService.RemoveNode:output_type -> google.protobuf.Empty
+	39,  // 299: nitella.local.MobileLogicService.AddNodeDirect:output_type -> nitella.local.AddNodeDirectResponse
+	41,  // 300: nitella.local.MobileLogicService.TestDirectConnection:output_type -> nitella.local.TestDirectConnectionResponse
+	44,  // 301: nitella.local.MobileLogicService.ListProxies:output_type -> nitella.local.ListProxiesResponse
+	47,  // 302: nitella.local.MobileLogicService.GetProxiesSnapshot:output_type -> nitella.local.GetProxiesSnapshotResponse
+	42,  // 303: nitella.local.MobileLogicService.GetProxy:output_type -> nitella.local.ProxyInfo
+	42,  // 304: nitella.local.MobileLogicService.AddProxy:output_type -> nitella.local.ProxyInfo
+	42,  // 305: nitella.local.MobileLogicService.UpdateProxy:output_type -> nitella.local.ProxyInfo
+	53,  // 306: nitella.local.MobileLogicService.SetNodeProxiesRunning:output_type -> nitella.local.SetNodeProxiesRunningResponse
+	258, // 307: nitella.local.MobileLogicService.RemoveProxy:output_type -> google.protobuf.Empty
+	55,  // 308: nitella.local.MobileLogicService.ListRules:output_type -> nitella.local.ListRulesResponse
+	244, // 309: nitella.local.MobileLogicService.GetRule:output_type -> nitella.proxy.Rule
+	244, // 310: nitella.local.MobileLogicService.AddRule:output_type -> nitella.proxy.Rule
+	61,  // 311: nitella.local.MobileLogicService.AddQuickRule:output_type -> nitella.local.AddQuickRuleResponse
+	244, // 312: nitella.local.MobileLogicService.UpdateRule:output_type -> nitella.proxy.Rule
+	258, // 313: nitella.local.MobileLogicService.RemoveRule:output_type -> google.protobuf.Empty
+	65,  // 314: nitella.local.MobileLogicService.BlockIP:output_type -> nitella.local.BlockIPResponse
+	67,  // 315: nitella.local.MobileLogicService.BlockISP:output_type -> nitella.local.BlockISPResponse
+	69,  // 316: nitella.local.MobileLogicService.BlockCountry:output_type -> nitella.local.BlockCountryResponse
+	71,  // 317: nitella.local.MobileLogicService.AddGlobalRule:output_type -> nitella.local.AddGlobalRuleResponse
+	73,  // 318: nitella.local.MobileLogicService.ListGlobalRules:output_type -> nitella.local.ListGlobalRulesResponse
+	75,  // 319: nitella.local.MobileLogicService.RemoveGlobalRule:output_type -> nitella.local.RemoveGlobalRuleResponse
+	78,  // 320: nitella.local.MobileLogicService.ListPendingApprovals:output_type -> nitella.local.ListPendingApprovalsResponse
+	80,  // 321: nitella.local.MobileLogicService.GetApprovalsSnapshot:output_type -> nitella.local.GetApprovalsSnapshotResponse
+	82,  // 322: nitella.local.MobileLogicService.ApproveRequest:output_type -> nitella.local.ApproveRequestResponse
+	84,  // 323: nitella.local.MobileLogicService.DenyRequest:output_type -> nitella.local.DenyRequestResponse
+	86,  // 324: nitella.local.MobileLogicService.ResolveApprovalDecision:output_type -> nitella.local.ResolveApprovalDecisionResponse
+	76,  // 325: nitella.local.MobileLogicService.StreamApprovals:output_type -> nitella.local.ApprovalRequest
+	90,  // 326: nitella.local.MobileLogicService.ListApprovalHistory:output_type -> nitella.local.ListApprovalHistoryResponse
+	92,  // 327: nitella.local.MobileLogicService.ClearApprovalHistory:output_type -> nitella.local.ClearApprovalHistoryResponse
+	95,  // 328: nitella.local.MobileLogicService.ListRuleProposals:output_type -> nitella.local.ListRuleProposalsResponse
+	97,  // 329: nitella.local.MobileLogicService.AcceptRuleProposal:output_type -> nitella.local.AcceptRuleProposalResponse
+	98,  // 330: nitella.local.MobileLogicService.GetConnectionStats:output_type -> nitella.local.ConnectionStats
+	102, // 331: nitella.local.MobileLogicService.ListConnections:output_type -> nitella.local.ListConnectionsResponse
+	105, // 332: nitella.local.MobileLogicService.GetIPStats:output_type -> nitella.local.GetIPStatsResponse
+	108, // 333: nitella.local.MobileLogicService.GetGeoStats:output_type -> nitella.local.GetGeoStatsResponse
+	110, // 334: nitella.local.MobileLogicService.StreamConnections:output_type -> nitella.local.ConnectionEvent
+	112, // 335: nitella.local.MobileLogicService.CloseConnection:output_type -> nitella.local.CloseConnectionResponse
+	114, // 336: nitella.local.MobileLogicService.CloseAllConnections:output_type -> nitella.local.CloseAllConnectionsResponse
+	116, // 337: nitella.local.MobileLogicService.CloseAllNodeConnections:output_type -> nitella.local.CloseAllNodeConnectionsResponse
+	118, // 338: nitella.local.MobileLogicService.StartPairing:output_type -> nitella.local.StartPairingResponse
+	120, // 339: nitella.local.MobileLogicService.JoinPairing:output_type -> nitella.local.JoinPairingResponse
+	122, // 340: nitella.local.MobileLogicService.CompletePairing:output_type -> nitella.local.CompletePairingResponse
+	124, // 341: nitella.local.MobileLogicService.FinalizePairing:output_type -> nitella.local.FinalizePairingResponse
+	258, // 342: nitella.local.MobileLogicService.CancelPairing:output_type -> google.protobuf.Empty
+	127, // 343: nitella.local.MobileLogicService.GenerateQRCode:output_type -> nitella.local.GenerateQRCodeResponse
+	129, // 344: nitella.local.MobileLogicService.ScanQRCode:output_type -> nitella.local.ScanQRCodeResponse
+	131, // 345: nitella.local.MobileLogicService.GenerateQRResponse:output_type -> nitella.local.GenerateQRReplyResponse
+	135, // 346: nitella.local.MobileLogicService.ListTemplates:output_type -> nitella.local.ListTemplatesResponse
+	132, // 347: nitella.local.MobileLogicService.GetTemplate:output_type -> nitella.local.Template
+	132, // 348: nitella.local.MobileLogicService.CreateTemplate:output_type -> nitella.local.Template
+	139, // 349: nitella.local.MobileLogicService.ApplyTemplate:output_type -> nitella.local.ApplyTemplateResponse
+	258, // 350: nitella.local.MobileLogicService.DeleteTemplate:output_type -> google.protobuf.Empty
+	141, // 351: nitella.local.MobileLogicService.SyncTemplates:output_type -> nitella.local.SyncTemplatesResponse
+	143, // 352: nitella.local.MobileLogicService.ExportTemplateYaml:output_type -> nitella.local.ExportTemplateYamlResponse
+	145, // 353: nitella.local.MobileLogicService.ImportTemplateYaml:output_type -> nitella.local.ImportTemplateYamlResponse
+	146, // 354: nitella.local.MobileLogicService.GetSettings:output_type -> nitella.local.Settings
+	148, // 355: nitella.local.MobileLogicService.GetSettingsOverviewSnapshot:output_type -> nitella.local.SettingsOverviewSnapshot
+	146, // 356: nitella.local.MobileLogicService.UpdateSettings:output_type -> nitella.local.Settings
+	258, // 357: nitella.local.MobileLogicService.RegisterFCMToken:output_type -> google.protobuf.Empty
+	258, // 358: nitella.local.MobileLogicService.UnregisterFCMToken:output_type -> google.protobuf.Empty
+	153, // 359: nitella.local.MobileLogicService.ConnectToHub:output_type -> nitella.local.ConnectToHubResponse
+	258, // 360: nitella.local.MobileLogicService.DisconnectFromHub:output_type -> google.protobuf.Empty
+	154, // 361: nitella.local.MobileLogicService.GetHubStatus:output_type -> nitella.local.HubStatus
+	155, // 362: nitella.local.MobileLogicService.GetHubSettingsSnapshot:output_type -> nitella.local.HubSettingsSnapshot
+	156, // 363: nitella.local.MobileLogicService.GetHubOverview:output_type -> nitella.local.HubOverview
+	158, // 364: nitella.local.MobileLogicService.GetHubDashboardSnapshot:output_type -> nitella.local.HubDashboardSnapshot
+	160, // 365: nitella.local.MobileLogicService.RegisterUser:output_type -> nitella.local.RegisterUserResponse
+	152, // 366: nitella.local.MobileLogicService.FetchHubCA:output_type -> nitella.local.FetchHubCAResponse
+	166, // 367: nitella.local.MobileLogicService.OnboardHub:output_type -> nitella.local.OnboardHubResponse
+	166, // 368: nitella.local.MobileLogicService.EnsureHubConnected:output_type -> nitella.local.OnboardHubResponse
+	166, // 369: nitella.local.MobileLogicService.EnsureHubRegistered:output_type -> nitella.local.OnboardHubResponse
+	166, // 370: nitella.local.MobileLogicService.ResolveHubTrustChallenge:output_type -> nitella.local.OnboardHubResponse
+	176, // 371: nitella.local.MobileLogicService.GetP2PStatus:output_type -> nitella.local.P2PStatus
+	177, // 372: nitella.local.MobileLogicService.GetP2PSettingsSnapshot:output_type -> nitella.local.P2PSettingsSnapshot
+	176, // 373: nitella.local.MobileLogicService.StreamP2PStatus:output_type -> nitella.local.P2PStatus
+	258, // 374: nitella.local.MobileLogicService.SetP2PMode:output_type -> google.protobuf.Empty
+	168, // 375: nitella.local.MobileLogicService.LookupIP:output_type -> nitella.local.LookupIPResponse
+	259, // 376: nitella.local.MobileLogicService.ConfigureGeoIP:output_type -> nitella.proxy.ConfigureGeoIPResponse
+	260, // 377: nitella.local.MobileLogicService.GetGeoIPStatus:output_type -> nitella.proxy.GetGeoIPStatusResponse
+	261, // 378: nitella.local.MobileLogicService.RestartListeners:output_type -> nitella.proxy.RestartListenersResponse
+	262, // 379: nitella.local.MobileLogicService.GetMockTranscripts:output_type -> nitella.proxy.GetMockTranscriptsResponse
+	181, // 380: nitella.local.MobileLogicService.ListLocalProxyConfigs:output_type -> nitella.local.ListLocalProxyConfigsResponse
+	183, // 381: nitella.local.MobileLogicService.GetLocalProxyConfig:output_type -> nitella.local.GetLocalProxyConfigResponse
+	185, // 382: nitella.local.MobileLogicService.ImportLocalProxyConfig:output_type -> nitella.local.ImportLocalProxyConfigResponse
+	187, // 383: nitella.local.MobileLogicService.SaveLocalProxyConfig:output_type -> nitella.local.SaveLocalProxyConfigResponse
+	189, // 384: nitella.local.MobileLogicService.DeleteLocalProxyConfig:output_type -> nitella.local.DeleteLocalProxyConfigResponse
+	191, // 385: nitella.local.MobileLogicService.ValidateLocalProxyConfig:output_type -> nitella.local.ValidateLocalProxyConfigResponse
+	193, // 386: nitella.local.MobileLogicService.PushProxyRevision:output_type -> nitella.local.PushProxyRevisionResponse
+	195, // 387: nitella.local.MobileLogicService.PushLocalProxyRevision:output_type -> nitella.local.PushLocalProxyRevisionResponse
+	197, // 388: nitella.local.MobileLogicService.PullProxyRevision:output_type -> nitella.local.PullProxyRevisionResponse
+	199, // 389: nitella.local.MobileLogicService.DiffProxyRevisions:output_type -> nitella.local.DiffProxyRevisionsResponse
+	201, // 390: nitella.local.MobileLogicService.ListProxyRevisions:output_type -> nitella.local.ListProxyRevisionsResponse
+	204, // 391: nitella.local.MobileLogicService.FlushProxyRevisions:output_type -> nitella.local.FlushProxyRevisionsResponse
+	206, // 392: nitella.local.MobileLogicService.ListProxyConfigs:output_type -> nitella.local.ListProxyConfigsResponse
+	209, // 393: nitella.local.MobileLogicService.CreateProxyConfig:output_type -> nitella.local.CreateProxyConfigResponse
+	211, // 394: nitella.local.MobileLogicService.DeleteProxyConfig:output_type -> nitella.local.DeleteProxyConfigResponse
+	213, // 395: nitella.local.MobileLogicService.ApplyProxyToNode:output_type -> nitella.local.ApplyProxyToNodeResponse
+	215, // 396: nitella.local.MobileLogicService.UnapplyProxyFromNode:output_type -> nitella.local.UnapplyProxyFromNodeResponse
+	217, // 397: nitella.local.MobileLogicService.GetAppliedProxies:output_type -> nitella.local.GetAppliedProxiesResponse
+	220, // 398: nitella.local.MobileLogicService.AllowIP:output_type -> nitella.local.AllowIPResponse
+	29,  // 399: nitella.local.MobileLogicService.StreamMetrics:output_type -> nitella.local.NodeMetrics
+	223, // 400: nitella.local.MobileLogicService.GetDebugRuntimeStats:output_type -> nitella.local.DebugRuntimeStats
+	227, // 401: nitella.local.MobileLogicService.GetLogsStats:output_type -> nitella.local.GetLogsStatsResponse
+	229, // 402: nitella.local.MobileLogicService.ListLogs:output_type -> nitella.local.ListLogsResponse
+	232, // 403: nitella.local.MobileLogicService.DeleteLogs:output_type -> nitella.local.DeleteLogsResponse
+	234, // 404: nitella.local.MobileLogicService.CleanupOldLogs:output_type -> nitella.local.CleanupOldLogsResponse
+	236, // 405: nitella.local.MobileLogicService.GetNodeFromHub:output_type -> nitella.local.GetNodeFromHubResponse
+	238, // 406: nitella.local.MobileLogicService.RegisterNodeWithHub:output_type -> nitella.local.RegisterNodeWithHubResponse
+	258, // 407: nitella.local.MobileUIService.OnApprovalRequest:output_type -> google.protobuf.Empty
+	258, // 408: nitella.local.MobileUIService.OnNodeStatusChange:output_type -> google.protobuf.Empty
+	258, // 409: nitella.local.MobileUIService.OnConnectionEvent:output_type -> google.protobuf.Empty
+	258, // 410: nitella.local.MobileUIService.OnAlert:output_type -> google.protobuf.Empty
+	258, // 411: nitella.local.MobileUIService.OnToast:output_type -> google.protobuf.Empty
+	282, // [282:412] is the sub-list for method output_type
+	152, // [152:282] is the sub-list for method input_type
+	152, // [152:152] is the sub-list for extension type_name
+	152, // [152:152] is the sub-list for extension extendee
+	0,   // [0:152] is the sub-list for field type_name
 }
 
 func init() { file_local_nitella_local_proto_init() }
@@ -17958,7 +18459,7 @@ func file_local_nitella_local_proto_init() {
 	if File_local_nitella_local_proto != nil {
 		return
 	}
-	file_local_nitella_local_proto_msgTypes[93].OneofWrappers = []any{
+	file_local_nitella_local_proto_msgTypes[98].OneofWrappers = []any{
 		(*CloseConnectionRequest_ConnId)(nil),
 		(*CloseConnectionRequest_SourceIp)(nil),
 	}
@@ -17968,7 +18469,7 @@ func file_local_nitella_local_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_local_nitella_local_proto_rawDesc), len(file_local_nitella_local_proto_rawDesc)),
 			NumEnums:      13,
-			NumMessages:   224,
+			NumMessages:   230,
 			NumExtensions: 0,
 			NumServices:   2,
 		},
@@ -496,6 +496,26 @@ func Invoke(s FfiServer, ctx context.Context, method string, data []byte) ([]byt
 			return nil, err
 		}
 		return proto.Marshal(resp)
+	case "/nitella.local.MobileLogicService/ListRuleProposals":
+		req := &ListRuleProposalsRequest{}
+		if err := proto.Unmarshal(data, req); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal request: %w", err)
+		}
+		resp, err := s.ListRuleProposals(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		return proto.Marshal(resp)
+	case "/nitella.local.MobileLogicService/AcceptRuleProposal":
+		req := &AcceptRuleProposalRequest{}
+		if err := proto.Unmarshal(data, req); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal request: %w", err)
+		}
+		resp, err := s.AcceptRuleProposal(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		return proto.Marshal(resp)
 	case "/nitella.local.MobileLogicService/GetConnectionStats":
 		req := &GetConnectionStatsRequest{}
 		if err := proto.Unmarshal(data, req); err != nil {
@@ -986,6 +1006,16 @@ func Invoke(s FfiServer, ctx context.Context, method string, data []byte) ([]byt
 			return nil, err
 		}
 		return proto.Marshal(resp)
+	case "/nitella.local.MobileLogicService/GetMockTranscripts":
+		req := &GetMockTranscriptsNodeRequest{}
+		if err := proto.Unmarshal(data, req); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal request: %w", err)
+		}
+		resp, err := s.GetMockTranscripts(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		return proto.Marshal(resp)
 	case "/nitella.local.MobileLogicService/ListLocalProxyConfigs":
 		req := &ListLocalProxyConfigsRequest{}
 		if err := proto.Unmarshal(data, req); err != nil {
@@ -2374,6 +2404,54 @@ func InvokeFfi(s FfiServer, ctx context.Context, method string, data []byte) (un
 			return nil, 0, err
 		}
 		return cPtr, int64(size), nil
+	case "/nitella.local.MobileLogicService/ListRuleProposals":
+		req := &ListRuleProposalsRequest{}
+		if err := proto.Unmarshal(data, req); err != nil {
+			return nil, 0, fmt.Errorf("failed to unmarshal request: %w", err)
+		}
+		resp, err := s.ListRuleProposals(ctx, req)
+		if err != nil {
+			return nil, 0, err
+		}
+		// Zero-copy: allocate C memory and serialize directly
+		size := proto.Size(resp)
+		if size == 0 {
+			return nil, 0, nil
+		}
+		cPtr := C.malloc(C.size_t(size))
+		if cPtr == nil {
+			return nil, 0, fmt.Errorf("failed to allocate memory for response")
+		}
+		buf := unsafe.Slice((*byte)(cPtr), size)
+		if _, err := (proto.MarshalOptions{}).MarshalAppend(buf[:0], resp); err != nil {
+			C.free(cPtr)
+			return nil, 0, err
+		}
+		return cPtr, int64(size), nil
+	case "/nitella.local.MobileLogicService/AcceptRuleProposal":
+		req := &AcceptRuleProposalRequest{}
+		if err := proto.Unmarshal(data, req); err != nil {
+			return nil, 0, fmt.Errorf("failed to unmarshal request: %w", err)
+		}
+		resp, err := s.AcceptRuleProposal(ctx, req)
+		if err != nil {
+			return nil, 0, err
+		}
+		// Zero-copy: allocate C memory and serialize directly
+		size := proto.Size(resp)
+		if size == 0 {
+			return nil, 0, nil
+		}
+		cPtr := C.malloc(C.size_t(size))
+		if cPtr == nil {
+			return nil, 0, fmt.Errorf("failed to allocate memory for response")
+		}
+		buf := unsafe.Slice((*byte)(cPtr), size)
+		if _, err := (proto.MarshalOptions{}).MarshalAppend(buf[:0], resp); err != nil {
+			C.free(cPtr)
+			return nil, 0, err
+		}
+		return cPtr, int64(size), nil
 	case "/nitella.local.MobileLogicService/GetConnectionStats":
 		req := &GetConnectionStatsRequest{}
 		if err := proto.Unmarshal(data, req); err != nil {
@@ -3550,6 +3628,30 @@ func InvokeFfi(s FfiServer, ctx context.Context, method string, data []byte) (un
 			return nil, 0, err
 		}
 		return cPtr, int64(size), nil
+	case "/nitella.local.MobileLogicService/GetMockTranscripts":
+		req := &GetMockTranscriptsNodeRequest{}
+		if err := proto.Unmarshal(data, req); err != nil {
+			return nil, 0, fmt.Errorf("failed to unmarshal request: %w", err)
+		}
+		resp, err := s.GetMockTranscripts(ctx, req)
+		if err != nil {
+			return nil, 0, err
+		}
+		// Zero-copy: allocate C memory and serialize directly
+		size := proto.Size(resp)
+		if size == 0 {
+			return nil, 0, nil
+		}
+		cPtr := C.malloc(C.size_t(size))
+		if cPtr == nil {
+			return nil, 0, fmt.Errorf("failed to allocate memory for response")
+		}
+		buf := unsafe.Slice((*byte)(cPtr), size)
+		if _, err := (proto.MarshalOptions{}).MarshalAppend(buf[:0], resp); err != nil {
+			C.free(cPtr)
+			return nil, 0, err
+		}
+		return cPtr, int64(size), nil
 	case "/nitella.local.MobileLogicService/ListLocalProxyConfigs":
 		req := &ListLocalProxyConfigsRequest{}
 		if err := proto.Unmarshal(data, req); err != nil {
@@ -4664,6 +4766,22 @@ func (i *ffiInvoker) Invoke(ctx context.Context, method string, req, reply proto
 		// Use proto.Merge to avoid copying mutex in MessageState
 		proto.Merge(reply.(proto.Message), resp)
 		return nil
+	case "/nitella.local.MobileLogicService/ListRuleProposals":
+		resp, err := i.server.ListRuleProposals(ctx, req.(*ListRuleProposalsRequest))
+		if err != nil {
+			return err
+		}
+		// Use proto.Merge to avoid copying mutex in MessageState
+		proto.Merge(reply.(proto.Message), resp)
+		return nil
+	case "/nitella.local.MobileLogicService/AcceptRuleProposal":
+		resp, err := i.server.AcceptRuleProposal(ctx, req.(*AcceptRuleProposalRequest))
+		if err != nil {
+			return err
+		}
+		// Use proto.Merge to avoid copying mutex in MessageState
+		proto.Merge(reply.(proto.Message), resp)
+		return nil
 	case "/nitella.local.MobileLogicService/GetConnectionStats":
 		resp, err := i.server.GetConnectionStats(ctx, req.(*GetConnectionStatsRequest))
 		if err != nil {
@@ -5056,6 +5174,14 @@ func (i *ffiInvoker) Invoke(ctx context.Context, method string, req, reply proto
 		// Use proto.Merge to avoid copying mutex in MessageState
 		proto.Merge(reply.(proto.Message), resp)
 		return nil
+	case "/nitella.local.MobileLogicService/GetMockTranscripts":
+		resp, err := i.server.GetMockTranscripts(ctx, req.(*GetMockTranscriptsNodeRequest))
+		if err != nil {
+			return err
+		}
+		// Use proto.Merge to avoid copying mutex in MessageState
+		proto.Merge(reply.(proto.Message), resp)
+		return nil
 	case "/nitella.local.MobileLogicService/ListLocalProxyConfigs":
 		resp, err := i.server.ListLocalProxyConfigs(ctx, req.(*ListLocalProxyConfigsRequest))
 		if err != nil {
@@ -67,6 +67,8 @@ const (
 	MobileLogicService_StreamApprovals_FullMethodName             = "/nitella.local.MobileLogicService/StreamApprovals"
 	MobileLogicService_ListApprovalHistory_FullMethodName         = "/nitella.local.MobileLogicService/ListApprovalHistory"
 	MobileLogicService_ClearApprovalHistory_FullMethodName        = "/nitella.local.MobileLogicService/ClearApprovalHistory"
+	MobileLogicService_ListRuleProposals_FullMethodName           = "/nitella.local.MobileLogicService/ListRuleProposals"
+	MobileLogicService_AcceptRuleProposal_FullMethodName          = "/nitella.local.MobileLogicService/AcceptRuleProposal"
 	MobileLogicService_GetConnectionStats_FullMethodName          = "/nitella.local.MobileLogicService/GetConnectionStats"
 	MobileLogicService_ListConnections_FullMethodName             = "/nitella.local.MobileLogicService/ListConnections"
 	MobileLogicService_GetIPStats_FullMethodName                  = "/nitella.local.MobileLogicService/GetIPStats"
@@ -116,6 +118,7 @@ const (
 	MobileLogicService_ConfigureGeoIP_FullMethodName              = "/nitella.local.MobileLogicService/ConfigureGeoIP"
 	MobileLogicService_GetGeoIPStatus_FullMethodName              = "/nitella.local.MobileLogicService/GetGeoIPStatus"
 	MobileLogicService_RestartListeners_FullMethodName            = "/nitella.local.MobileLogicService/RestartListeners"
+	MobileLogicService_GetMockTranscripts_FullMethodName          = "/nitella.local.MobileLogicService/GetMockTranscripts"
 	MobileLogicService_ListLocalProxyConfigs_FullMethodName       = "/nitella.local.MobileLogicService/ListLocalProxyConfigs"
 	MobileLogicService_GetLocalProxyConfig_FullMethodName         = "/nitella.local.MobileLogicService/GetLocalProxyConfig"
 	MobileLogicService_ImportLocalProxyConfig_FullMethodName      = "/nitella.local.MobileLogicService/ImportLocalProxyConfig"
@@ -240,6 +243,10 @@ type MobileLogicServiceClient interface {
 	ListApprovalHistory(ctx context.Context, in *ListApprovalHistoryRequest, opts ...grpc.CallOption) (*ListApprovalHistoryResponse, error)
 	// Clear approval decision history
 	ClearApprovalHistory(ctx context.Context, in *ClearApprovalHistoryRequest, opts ...grpc.CallOption) (*ClearApprovalHistoryResponse, error)
+	// Propose permanent rules for decisions repeated in the approval history
+	ListRuleProposals(ctx context.Context, in *ListRuleProposalsRequest, opts ...grpc.CallOption) (*ListRuleProposalsResponse, error)
+	// Create the rule a proposal describes
+	AcceptRuleProposal(ctx context.Context, in *AcceptRuleProposalRequest, opts ...grpc.CallOption) (*AcceptRuleProposalResponse, error)
 	// Get connection statistics summary
 	GetConnectionStats(ctx context.Context, in *GetConnectionStatsRequest, opts ...grpc.CallOption) (*ConnectionStats, error)
 	// List active connections on a node/proxy
@@ -338,6 +345,8 @@ type MobileLogicServiceClient interface {
 	GetGeoIPStatus(ctx context.Context, in *GetGeoIPStatusNodeRequest, opts ...grpc.CallOption) (*proxy.GetGeoIPStatusResponse, error)
 	// Restart proxy listeners on a node
 	RestartListeners(ctx context.Context, in *RestartListenersNodeRequest, opts ...grpc.CallOption) (*proxy.RestartListenersResponse, error)
+	// Get fake shell transcripts recorded by honeypot (mock) listeners on a node
+	GetMockTranscripts(ctx context.Context, in *GetMockTranscriptsNodeRequest, opts ...grpc.CallOption) (*proxy.GetMockTranscriptsResponse, error)
 	// List proxy configs stored locally on this device
 	ListLocalProxyConfigs(ctx context.Context, in *ListLocalProxyConfigsRequest, opts ...grpc.CallOption) (*ListLocalProxyConfigsResponse, error)
 	// Get local proxy config content + metadata
@@ -871,6 +880,26 @@ func (c *mobileLogicServiceClient) ClearApprovalHistory(ctx context.Context, in
 	return out, nil
 }
 
+func (c *mobileLogicServiceClient) ListRuleProposals(ctx context.Context, in *ListRuleProposalsRequest, opts ...grpc.CallOption) (*ListRuleProposalsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRuleProposalsResponse)
+	err := c.cc.Invoke(ctx, MobileLogicService_ListRuleProposals_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mobileLogicServiceClient) AcceptRuleProposal(ctx context.Context, in *AcceptRuleProposalRequest, opts ...grpc.CallOption) (*AcceptRuleProposalResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AcceptRuleProposalResponse)
+	err := c.cc.Invoke(ctx, MobileLogicService_AcceptRuleProposal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *mobileLogicServiceClient) GetConnectionStats(ctx context.Context, in *GetConnectionStatsRequest, opts ...grpc.CallOption) (*ConnectionStats, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ConnectionStats)
@@ -1379,6 +1408,16 @@ func (c *mobileLogicServiceClient) RestartListeners(ctx context.Context, in *Res
 	return out, nil
 }
 
+func (c *mobileLogicServiceClient) GetMockTranscripts(ctx context.Context, in *GetMockTranscriptsNodeRequest, opts ...grpc.CallOption) (*proxy.GetMockTranscriptsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(proxy.GetMockTranscriptsResponse)
+	err := c.cc.Invoke(ctx, MobileLogicService_GetMockTranscripts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *mobileLogicServiceClient) ListLocalProxyConfigs(ctx context.Context, in *ListLocalProxyConfigsRequest, opts ...grpc.CallOption) (*ListLocalProxyConfigsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListLocalProxyConfigsResponse)
@@ -1753,6 +1792,10 @@ type MobileLogicServiceServer interface {
 	ListApprovalHistory(context.Context, *ListApprovalHistoryRequest) (*ListApprovalHistoryResponse, error)
 	// Clear approval decision history
 	ClearApprovalHistory(context.Context, *ClearApprovalHistoryRequest) (*ClearApprovalHistoryResponse, error)
+	// Propose permanent rules for decisions repeated in the approval history
+	ListRuleProposals(context.Context, *ListRuleProposalsRequest) (*ListRuleProposalsResponse, error)
+	// Create the rule a proposal describes
+	AcceptRuleProposal(context.Context, *AcceptRuleProposalRequest) (*AcceptRuleProposalResponse, error)
 	// Get connection statistics summary
 	GetConnectionStats(context.Context, *GetConnectionStatsRequest) (*ConnectionStats, error)
 	// List active connections on a node/proxy
@@ -1851,6 +1894,8 @@ type MobileLogicServiceServer interface {
 	GetGeoIPStatus(context.Context, *GetGeoIPStatusNodeRequest) (*proxy.GetGeoIPStatusResponse, error)
 	// Restart proxy listeners on a node
 	RestartListeners(context.Context, *RestartListenersNodeRequest) (*proxy.RestartListenersResponse, error)
+	// Get fake shell transcripts recorded by honeypot (mock) listeners on a node
+	GetMockTranscripts(context.Context, *GetMockTranscriptsNodeRequest) (*proxy.GetMockTranscriptsResponse, error)
 	// List proxy configs stored locally on this device
 	ListLocalProxyConfigs(context.Context, *ListLocalProxyConfigsRequest) (*ListLocalProxyConfigsResponse, error)
 	// Get local proxy config content + metadata
@@ -2053,6 +2098,12 @@ func (UnimplementedMobileLogicServiceServer) ListApprovalHistory(context.Context
 func (UnimplementedMobileLogicServiceServer) ClearApprovalHistory(context.Context, *ClearApprovalHistoryRequest) (*ClearApprovalHistoryResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ClearApprovalHistory not implemented")
 }
+func (UnimplementedMobileLogicServiceServer) ListRuleProposals(context.Context, *ListRuleProposalsRequest) (*ListRuleProposalsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListRuleProposals not implemented")
+}
+func (UnimplementedMobileLogicServiceServer) AcceptRuleProposal(context.Context, *AcceptRuleProposalRequest) (*AcceptRuleProposalResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AcceptRuleProposal not implemented")
+}
 func (UnimplementedMobileLogicServiceServer) GetConnectionStats(context.Context, *GetConnectionStatsRequest) (*ConnectionStats, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetConnectionStats not implemented")
 }
@@ -2200,6 +2251,9 @@ func (UnimplementedMobileLogicServiceServer) GetGeoIPStatus(context.Context, *Ge
 func (UnimplementedMobileLogicServiceServer) RestartListeners(context.Context, *RestartListenersNodeRequest) (*proxy.RestartListenersResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method RestartListeners not implemented")
 }
+func (UnimplementedMobileLogicServiceServer) GetMockTranscripts(context.Context, *GetMockTranscriptsNodeRequest) (*proxy.GetMockTranscriptsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetMockTranscripts not implemented")
+}
 func (UnimplementedMobileLogicServiceServer) ListLocalProxyConfigs(context.Context, *ListLocalProxyConfigsRequest) (*ListLocalProxyConfigsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ListLocalProxyConfigs not implemented")
 }
@@ -3123,6 +3177,42 @@ func _MobileLogicService_ClearApprovalHistory_Handler(srv interface{}, ctx conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MobileLogicService_ListRuleProposals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRuleProposalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MobileLogicServiceServer).ListRuleProposals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MobileLogicService_ListRuleProposals_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MobileLogicServiceServer).ListRuleProposals(ctx, req.(*ListRuleProposalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MobileLogicService_AcceptRuleProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptRuleProposalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MobileLogicServiceServer).AcceptRuleProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MobileLogicService_AcceptRuleProposal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MobileLogicServiceServer).AcceptRuleProposal(ctx, req.(*AcceptRuleProposalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MobileLogicService_GetConnectionStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetConnectionStatsRequest)
 	if err := dec(in); err != nil {
@@ -3991,6 +4081,24 @@ func _MobileLogicService_RestartListeners_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MobileLogicService_GetMockTranscripts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMockTranscriptsNodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MobileLogicServiceServer).GetMockTranscripts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MobileLogicService_GetMockTranscripts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MobileLogicServiceServer).GetMockTranscripts(ctx, req.(*GetMockTranscriptsNodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MobileLogicService_ListLocalProxyConfigs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListLocalProxyConfigsRequest)
 	if err := dec(in); err != nil {
@@ -4657,6 +4765,14 @@ var MobileLogicService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ClearApprovalHistory",
 			Handler:    _MobileLogicService_ClearApprovalHistory_Handler,
 		},
+		{
+			MethodName: "ListRuleProposals",
+			Handler:    _MobileLogicService_ListRuleProposals_Handler,
+		},
+		{
+			MethodName: "AcceptRuleProposal",
+			Handler:    _MobileLogicService_AcceptRuleProposal_Handler,
+		},
 		{
 			MethodName: "GetConnectionStats",
 			Handler:    _MobileLogicService_GetConnectionStats_Handler,
@@ -4845,6 +4961,10 @@ var MobileLogicService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RestartListeners",
 			Handler:    _MobileLogicService_RestartListeners_Handler,
 		},
+		{
+			MethodName: "GetMockTranscripts",
+			Handler:    _MobileLogicService_GetMockTranscripts_Handler,
+		},
 		{
 			MethodName: "ListLocalProxyConfigs",
 			Handler:    _MobileLogicService_ListLocalProxyConfigs_Handler,
@@ -25,19 +25,23 @@ const (
 )
 
 type StartListenerRequest struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	ListenAddr     string                 `protobuf:"bytes,3,opt,name=listen_addr,json=listenAddr,proto3" json:"listen_addr,omitempty"`
-	DefaultBackend string                 `protobuf:"bytes,4,opt,name=default_backend,json=defaultBackend,proto3" json:"default_backend,omitempty"`
-	DefaultAction  common.ActionType      `protobuf:"varint,5,opt,name=default_action,json=defaultAction,proto3,enum=nitella.ActionType" json:"default_action,omitempty"`
-	DefaultMock    *proxy.MockConfig      `protobuf:"bytes,6,opt,name=default_mock,json=defaultMock,proto3" json:"default_mock,omitempty"`
-	CertPem        string                 `protobuf:"bytes,7,opt,name=cert_pem,json=certPem,proto3" json:"cert_pem,omitempty"`
-	KeyPem         string                 `protobuf:"bytes,8,opt,name=key_pem,json=keyPem,proto3" json:"key_pem,omitempty"`
-	CaPem          string                 `protobuf:"bytes,9,opt,name=ca_pem,json=caPem,proto3" json:"ca_pem,omitempty"`
-	ClientAuthType proxy.ClientAuthType   `protobuf:"varint,10,opt,name=client_auth_type,json=clientAuthType,proto3,enum=nitella.proxy.ClientAuthType" json:"client_auth_type,omitempty"`
-	FallbackAction common.FallbackAction  `protobuf:"varint,11,opt,name=fallback_action,json=fallbackAction,proto3,enum=nitella.FallbackAction" json:"fallback_action,omitempty"`
-	FallbackMock   common.MockPreset      `protobuf:"varint,12,opt,name=fallback_mock,json=fallbackMock,proto3,enum=nitella.MockPreset" json:"fallback_mock,omitempty"`
+	state          protoimpl.MessageState      `protogen:"open.v1"`
+	Id             string                      `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name           string                      `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ListenAddr     string                      `protobuf:"bytes,3,opt,name=listen_addr,json=listenAddr,proto3" json:"listen_addr,omitempty"`
+	DefaultBackend string                      `protobuf:"bytes,4,opt,name=default_backend,json=defaultBackend,proto3" json:"default_backend,omitempty"`
+	DefaultAction  common.ActionType           `protobuf:"varint,5,opt,name=default_action,json=defaultAction,proto3,enum=nitella.ActionType" json:"default_action,omitempty"`
+	DefaultMock    *proxy.MockConfig           `protobuf:"bytes,6,opt,name=default_mock,json=defaultMock,proto3" json:"default_mock,omitempty"`
+	CertPem        string                      `protobuf:"bytes,7,opt,name=cert_pem,json=certPem,proto3" json:"cert_pem,omitempty"`
+	KeyPem         string                      `protobuf:"bytes,8,opt,name=key_pem,json=keyPem,proto3" json:"key_pem,omitempty"`
+	CaPem          string                      `protobuf:"bytes,9,opt,name=ca_pem,json=caPem,proto3" json:"ca_pem,omitempty"`
+	ClientAuthType proxy.ClientAuthType        `protobuf:"varint,10,opt,name=client_auth_type,json=clientAuthType,proto3,enum=nitella.proxy.ClientAuthType" json:"client_auth_type,omitempty"`
+	FallbackAction common.FallbackAction       `protobuf:"varint,11,opt,name=fallback_action,json=fallbackAction,proto3,enum=nitella.FallbackAction" json:"fallback_action,omitempty"`
+	FallbackMock   common.MockPreset           `protobuf:"varint,12,opt,name=fallback_mock,json=fallbackMock,proto3,enum=nitella.MockPreset" json:"fallback_mock,omitempty"`
+	Thresholds     *proxy.ConnectionThresholds `protobuf:"bytes,13,opt,name=thresholds,proto3" json:"thresholds,omitempty"`
+	TarpitBudget   *TarpitBudget               `protobuf:"bytes,14,opt,name=tarpit_budget,json=tarpitBudget,proto3" json:"tarpit_budget,omitempty"`    // Share of the node's tarpit budget (unset = unlimited)
+	ClonedPresets  []*proxy.ClonedPreset       `protobuf:"bytes,15,rep,name=cloned_presets,json=clonedPresets,proto3" json:"cloned_presets,omitempty"` // Banners cloned by the parent
+	AccessLog      bool                        `protobuf:"varint,16,opt,name=access_log,json=accessLog,proto3" json:"access_log,omitempty"`            // Keep access log records for StreamAccessLog
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -156,6 +160,34 @@ func (x *StartListenerRequest) GetFallbackMock() common.MockPreset {
 	return common.MockPreset(0)
 }
 
+func (x *StartListenerRequest) GetThresholds() *proxy.ConnectionThresholds {
+	if x != nil {
+		return x.Thresholds
+	}
+	return nil
+}
+
+func (x *StartListenerRequest) GetTarpitBudget() *TarpitBudget {
+	if x != nil {
+		return x.TarpitBudget
+	}
+	return nil
+}
+
+func (x *StartListenerRequest) GetClonedPresets() []*proxy.ClonedPreset {
+	if x != nil {
+		return x.ClonedPresets
+	}
+	return nil
+}
+
+func (x *StartListenerRequest) GetAccessLog() bool {
+	if x != nil {
+		return x.AccessLog
+	}
+	return false
+}
+
 type StartListenerResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
@@ -984,6 +1016,252 @@ func (x *CloseAllConnectionsResponse) GetErrorMessage() string {
 	return ""
 }
 
+// TarpitBudget is a child process's share of the node-wide tarpit budget.
+type TarpitBudget struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	MaxConns          int64                  `protobuf:"varint,1,opt,name=max_conns,json=maxConns,proto3" json:"max_conns,omitempty"`                     // 0 = unlimited, negative = none
+	MaxMemoryBytes    int64                  `protobuf:"varint,2,opt,name=max_memory_bytes,json=maxMemoryBytes,proto3" json:"max_memory_bytes,omitempty"` // 0 = unlimited, negative = none
+	Adaptive          bool                   `protobuf:"varint,3,opt,name=adaptive,proto3" json:"adaptive,omitempty"`
+	MaxDripIntervalMs int64                  `protobuf:"varint,4,opt,name=max_drip_interval_ms,json=maxDripIntervalMs,proto3" json:"max_drip_interval_ms,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *TarpitBudget) Reset() {
+	*x = TarpitBudget{}
+	mi := &file_process_process_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TarpitBudget) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TarpitBudget) ProtoMessage() {}
+
+func (x *TarpitBudget) ProtoReflect() protoreflect.Message {
+	mi := &file_process_process_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TarpitBudget.ProtoReflect.Descriptor instead.
+func (*TarpitBudget) Descriptor() ([]byte, []int) {
+	return file_process_process_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *TarpitBudget) GetMaxConns() int64 {
+	if x != nil {
+		return x.MaxConns
+	}
+	return 0
+}
+
+func (x *TarpitBudget) GetMaxMemoryBytes() int64 {
+	if x != nil {
+		return x.MaxMemoryBytes
+	}
+	return 0
+}
+
+func (x *TarpitBudget) GetAdaptive() bool {
+	if x != nil {
+		return x.Adaptive
+	}
+	return false
+}
+
+func (x *TarpitBudget) GetMaxDripIntervalMs() int64 {
+	if x != nil {
+		return x.MaxDripIntervalMs
+	}
+	return 0
+}
+
+type ConfigureTarpitRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Budget        *TarpitBudget          `protobuf:"bytes,1,opt,name=budget,proto3" json:"budget,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfigureTarpitRequest) Reset() {
+	*x = ConfigureTarpitRequest{}
+	mi := &file_process_process_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfigureTarpitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigureTarpitRequest) ProtoMessage() {}
+
+func (x *ConfigureTarpitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_process_process_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigureTarpitRequest.ProtoReflect.Descriptor instead.
+func (*ConfigureTarpitRequest) Descriptor() ([]byte, []int) {
+	return file_process_process_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ConfigureTarpitRequest) GetBudget() *TarpitBudget {
+	if x != nil {
+		return x.Budget
+	}
+	return nil
+}
+
+type ConfigureTarpitResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfigureTarpitResponse) Reset() {
+	*x = ConfigureTarpitResponse{}
+	mi := &file_process_process_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfigureTarpitResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigureTarpitResponse) ProtoMessage() {}
+
+func (x *ConfigureTarpitResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_process_process_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigureTarpitResponse.ProtoReflect.Descriptor instead.
+func (*ConfigureTarpitResponse) Descriptor() ([]byte, []int) {
+	return file_process_process_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ConfigureTarpitResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// ConfigureClonedPresetsRequest replaces the child's cloned presets.
+type ConfigureClonedPresetsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Presets       []*proxy.ClonedPreset  `protobuf:"bytes,1,rep,name=presets,proto3" json:"presets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfigureClonedPresetsRequest) Reset() {
+	*x = ConfigureClonedPresetsRequest{}
+	mi := &file_process_process_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfigureClonedPresetsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigureClonedPresetsRequest) ProtoMessage() {}
+
+func (x *ConfigureClonedPresetsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_process_process_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigureClonedPresetsRequest.ProtoReflect.Descriptor instead.
+func (*ConfigureClonedPresetsRequest) Descriptor() ([]byte, []int) {
+	return file_process_process_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ConfigureClonedPresetsRequest) GetPresets() []*proxy.ClonedPreset {
+	if x != nil {
+		return x.Presets
+	}
+	return nil
+}
+
+type ConfigureClonedPresetsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfigureClonedPresetsResponse) Reset() {
+	*x = ConfigureClonedPresetsResponse{}
+	mi := &file_process_process_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfigureClonedPresetsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigureClonedPresetsResponse) ProtoMessage() {}
+
+func (x *ConfigureClonedPresetsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_process_process_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigureClonedPresetsResponse.ProtoReflect.Descriptor instead.
+func (*ConfigureClonedPresetsResponse) Descriptor() ([]byte, []int) {
+	return file_process_process_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ConfigureClonedPresetsResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
 type StreamEventsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -992,7 +1270,7 @@ type StreamEventsRequest struct {
 
 func (x *StreamEventsRequest) Reset() {
 	*x = StreamEventsRequest{}
-	mi := &file_process_process_proto_msgTypes[20]
+	mi := &file_process_process_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1004,7 +1282,7 @@ func (x *StreamEventsRequest) String() string {
 func (*StreamEventsRequest) ProtoMessage() {}
 
 func (x *StreamEventsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_process_process_proto_msgTypes[20]
+	mi := &file_process_process_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1017,7 +1295,7 @@ func (x *StreamEventsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StreamEventsRequest.ProtoReflect.Descriptor instead.
 func (*StreamEventsRequest) Descriptor() ([]byte, []int) {
-	return file_process_process_proto_rawDescGZIP(), []int{20}
+	return file_process_process_proto_rawDescGZIP(), []int{25}
 }
 
 type Event struct {
@@ -1034,7 +1312,7 @@ type Event struct {
 
 func (x *Event) Reset() {
 	*x = Event{}
-	mi := &file_process_process_proto_msgTypes[21]
+	mi := &file_process_process_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1046,7 +1324,7 @@ func (x *Event) String() string {
 func (*Event) ProtoMessage() {}
 
 func (x *Event) ProtoReflect() protoreflect.Message {
-	mi := &file_process_process_proto_msgTypes[21]
+	mi := &file_process_process_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1059,7 +1337,7 @@ func (x *Event) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Event.ProtoReflect.Descriptor instead.
 func (*Event) Descriptor() ([]byte, []int) {
-	return file_process_process_proto_rawDescGZIP(), []int{21}
+	return file_process_process_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *Event) GetType() isEvent_Type {
@@ -1129,7 +1407,7 @@ type LogEvent struct {
 
 func (x *LogEvent) Reset() {
 	*x = LogEvent{}
-	mi := &file_process_process_proto_msgTypes[22]
+	mi := &file_process_process_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1141,7 +1419,7 @@ func (x *LogEvent) String() string {
 func (*LogEvent) ProtoMessage() {}
 
 func (x *LogEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_process_process_proto_msgTypes[22]
+	mi := &file_process_process_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1154,7 +1432,7 @@ func (x *LogEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LogEvent.ProtoReflect.Descriptor instead.
 func (*LogEvent) Descriptor() ([]byte, []int) {
-	return file_process_process_proto_rawDescGZIP(), []int{22}
+	return file_process_process_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *LogEvent) GetLevel() string {
@@ -1191,7 +1469,7 @@ type MetricsEvent struct {
 
 func (x *MetricsEvent) Reset() {
 	*x = MetricsEvent{}
-	mi := &file_process_process_proto_msgTypes[23]
+	mi := &file_process_process_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1203,7 +1481,7 @@ func (x *MetricsEvent) String() string {
 func (*MetricsEvent) ProtoMessage() {}
 
 func (x *MetricsEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_process_process_proto_msgTypes[23]
+	mi := &file_process_process_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1216,7 +1494,7 @@ func (x *MetricsEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MetricsEvent.ProtoReflect.Descriptor instead.
 func (*MetricsEvent) Descriptor() ([]byte, []int) {
-	return file_process_process_proto_rawDescGZIP(), []int{23}
+	return file_process_process_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *MetricsEvent) GetActiveConnections() int64 {
@@ -1254,11 +1532,91 @@ func (x *MetricsEvent) GetTimestamp() *timestamp.Timestamp {
 	return nil
 }
 
+type StreamAccessLogRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamAccessLogRequest) Reset() {
+	*x = StreamAccessLogRequest{}
+	mi := &file_process_process_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamAccessLogRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamAccessLogRequest) ProtoMessage() {}
+
+func (x *StreamAccessLogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_process_process_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamAccessLogRequest.ProtoReflect.Descriptor instead.
+func (*StreamAccessLogRequest) Descriptor() ([]byte, []int) {
+	return file_process_process_proto_rawDescGZIP(), []int{29}
+}
+
+type AccessLogRecord struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Json          []byte                 `protobuf:"bytes,1,opt,name=json,proto3" json:"json,omitempty"` // accesslog.Record, formatted by the parent
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AccessLogRecord) Reset() {
+	*x = AccessLogRecord{}
+	mi := &file_process_process_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccessLogRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccessLogRecord) ProtoMessage() {}
+
+func (x *AccessLogRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_process_process_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccessLogRecord.ProtoReflect.Descriptor instead.
+func (*AccessLogRecord) Descriptor() ([]byte, []int) {
+	return file_process_process_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *AccessLogRecord) GetJson() []byte {
+	if x != nil {
+		return x.Json
+	}
+	return nil
+}
+
 var File_process_process_proto protoreflect.FileDescriptor
 
 const file_process_process_proto_rawDesc = "" +
 	"\n" +
-	"\x15process/process.proto\x12\x0fnitella.process\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x11proxy/proxy.proto\x1a\x13common/common.proto\"\x8e\x04\n" +
+	"\x15process/process.proto\x12\x0fnitella.process\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x11proxy/proxy.proto\x1a\x13common/common.proto\"\xfa\x05\n" +
 	"\x14StartListenerRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1f\n" +
@@ -1273,7 +1631,14 @@ const file_process_process_proto_rawDesc = "" +
 	"\x10client_auth_type\x18\n" +
 	" \x01(\x0e2\x1d.nitella.proxy.ClientAuthTypeR\x0eclientAuthType\x12@\n" +
 	"\x0ffallback_action\x18\v \x01(\x0e2\x17.nitella.FallbackActionR\x0efallbackAction\x128\n" +
-	"\rfallback_mock\x18\f \x01(\x0e2\x13.nitella.MockPresetR\ffallbackMock\"V\n" +
+	"\rfallback_mock\x18\f \x01(\x0e2\x13.nitella.MockPresetR\ffallbackMock\x12C\n" +
+	"\n" +
+	"thresholds\x18\r \x01(\v2#.nitella.proxy.ConnectionThresholdsR\n" +
+	"thresholds\x12B\n" +
+	"\rtarpit_budget\x18\x0e \x01(\v2\x1d.nitella.process.TarpitBudgetR\ftarpitBudget\x12B\n" +
+	"\x0ecloned_presets\x18\x0f \x03(\v2\x1b.nitella.proxy.ClonedPresetR\rclonedPresets\x12\x1d\n" +
+	"\n" +
+	"access_log\x18\x10 \x01(\bR\taccessLog\"V\n" +
 	"\x15StartListenerResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12#\n" +
 	"\rerror_message\x18\x02 \x01(\tR\ferrorMessage\"\x15\n" +
@@ -1310,7 +1675,20 @@ const file_process_process_proto_rawDesc = "" +
 	"\x1aCloseAllConnectionsRequest\"\\\n" +
 	"\x1bCloseAllConnectionsResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12#\n" +
-	"\rerror_message\x18\x02 \x01(\tR\ferrorMessage\"\x15\n" +
+	"\rerror_message\x18\x02 \x01(\tR\ferrorMessage\"\xa2\x01\n" +
+	"\fTarpitBudget\x12\x1b\n" +
+	"\tmax_conns\x18\x01 \x01(\x03R\bmaxConns\x12(\n" +
+	"\x10max_memory_bytes\x18\x02 \x01(\x03R\x0emaxMemoryBytes\x12\x1a\n" +
+	"\badaptive\x18\x03 \x01(\bR\badaptive\x12/\n" +
+	"\x14max_drip_interval_ms\x18\x04 \x01(\x03R\x11maxDripIntervalMs\"O\n" +
+	"\x16ConfigureTarpitRequest\x125\n" +
+	"\x06budget\x18\x01 \x01(\v2\x1d.nitella.process.TarpitBudgetR\x06budget\"3\n" +
+	"\x17ConfigureTarpitResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"V\n" +
+	"\x1dConfigureClonedPresetsRequest\x125\n" +
+	"\apresets\x18\x01 \x03(\v2\x1b.nitella.proxy.ClonedPresetR\apresets\":\n" +
+	"\x1eConfigureClonedPresetsResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x15\n" +
 	"\x13StreamEventsRequest\"\xbb\x01\n" +
 	"\x05Event\x12@\n" +
 	"\n" +
@@ -1328,7 +1706,11 @@ const file_process_process_proto_rawDesc = "" +
 	"\x11total_connections\x18\x02 \x01(\x03R\x10totalConnections\x12\x19\n" +
 	"\bbytes_in\x18\x03 \x01(\x03R\abytesIn\x12\x1b\n" +
 	"\tbytes_out\x18\x04 \x01(\x03R\bbytesOut\x128\n" +
-	"\ttimestamp\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp2\x94\b\n" +
+	"\ttimestamp\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\"\x18\n" +
+	"\x16StreamAccessLogRequest\"%\n" +
+	"\x0fAccessLogRecord\x12\x12\n" +
+	"\x04json\x18\x01 \x01(\fR\x04json2\xd5\n" +
+	"\n" +
 	"\x0eProcessControl\x12^\n" +
 	"\rStartListener\x12%.nitella.process.StartListenerRequest\x1a&.nitella.process.StartListenerResponse\x12[\n" +
 	"\fStopListener\x12$.nitella.process.StopListenerRequest\x1a%.nitella.process.StopListenerResponse\x12X\n" +
@@ -1341,8 +1723,11 @@ const file_process_process_proto_rawDesc = "" +
 	"\tListRules\x12!.nitella.process.ListRulesRequest\x1a\".nitella.process.ListRulesResponse\x12s\n" +
 	"\x14GetActiveConnections\x12,.nitella.process.GetActiveConnectionsRequest\x1a-.nitella.process.GetActiveConnectionsResponse\x12d\n" +
 	"\x0fCloseConnection\x12'.nitella.process.CloseConnectionRequest\x1a(.nitella.process.CloseConnectionResponse\x12p\n" +
-	"\x13CloseAllConnections\x12+.nitella.process.CloseAllConnectionsRequest\x1a,.nitella.process.CloseAllConnectionsResponse\x12N\n" +
-	"\fStreamEvents\x12$.nitella.process.StreamEventsRequest\x1a\x16.nitella.process.Event0\x01B,Z*github.com/ivere27/nitella/pkg/api/processb\x06proto3"
+	"\x13CloseAllConnections\x12+.nitella.process.CloseAllConnectionsRequest\x1a,.nitella.process.CloseAllConnectionsResponse\x12d\n" +
+	"\x0fConfigureTarpit\x12'.nitella.process.ConfigureTarpitRequest\x1a(.nitella.process.ConfigureTarpitResponse\x12y\n" +
+	"\x16ConfigureClonedPresets\x12..nitella.process.ConfigureClonedPresetsRequest\x1a/.nitella.process.ConfigureClonedPresetsResponse\x12N\n" +
+	"\fStreamEvents\x12$.nitella.process.StreamEventsRequest\x1a\x16.nitella.process.Event0\x01\x12^\n" +
+	"\x0fStreamAccessLog\x12'.nitella.process.StreamAccessLogRequest\x1a .nitella.process.AccessLogRecord0\x01B,Z*github.com/ivere27/nitella/pkg/api/processb\x06proto3"
 
 var (
 	file_process_process_proto_rawDescOnce sync.Once
@@ -1356,85 +1741,105 @@ func file_process_process_proto_rawDescGZIP() []byte {
 	return file_process_process_proto_rawDescData
 }
 
-var file_process_process_proto_msgTypes = make([]protoimpl.MessageInfo, 24)
+var file_process_process_proto_msgTypes = make([]protoimpl.MessageInfo, 31)
 var file_process_process_proto_goTypes = []any{
-	(*StartListenerRequest)(nil),         // 0: nitella.process.StartListenerRequest
-	(*StartListenerResponse)(nil),        // 1: nitella.process.StartListenerResponse
-	(*StopListenerRequest)(nil),          // 2: nitella.process.StopListenerRequest
-	(*StopListenerResponse)(nil),         // 3: nitella.process.StopListenerResponse
-	(*HealthCheckRequest)(nil),           // 4: nitella.process.HealthCheckRequest
-	(*HealthCheckResponse)(nil),          // 5: nitella.process.HealthCheckResponse
-	(*GetMetricsRequest)(nil),            // 6: nitella.process.GetMetricsRequest
-	(*GetMetricsResponse)(nil),           // 7: nitella.process.GetMetricsResponse
-	(*AddRuleRequest)(nil),               // 8: nitella.process.AddRuleRequest
-	(*AddRuleResponse)(nil),              // 9: nitella.process.AddRuleResponse
-	(*RemoveRuleRequest)(nil),            // 10: nitella.process.RemoveRuleRequest
-	(*RemoveRuleResponse)(nil),           // 11: nitella.process.RemoveRuleResponse
-	(*ListRulesRequest)(nil),             // 12: nitella.process.ListRulesRequest
-	(*ListRulesResponse)(nil),            // 13: nitella.process.ListRulesResponse
-	(*GetActiveConnectionsRequest)(nil),  // 14: nitella.process.GetActiveConnectionsRequest
-	(*GetActiveConnectionsResponse)(nil), // 15: nitella.process.GetActiveConnectionsResponse
-	(*CloseConnectionRequest)(nil),       // 16: nitella.process.CloseConnectionRequest
-	(*CloseConnectionResponse)(nil),      // 17: nitella.process.CloseConnectionResponse
-	(*CloseAllConnectionsRequest)(nil),   // 18: nitella.process.CloseAllConnectionsRequest
-	(*CloseAllConnectionsResponse)(nil),  // 19: nitella.process.CloseAllConnectionsResponse
-	(*StreamEventsRequest)(nil),          // 20: nitella.process.StreamEventsRequest
-	(*Event)(nil),                        // 21: nitella.process.Event
-	(*LogEvent)(nil),                     // 22: nitella.process.LogEvent
-	(*MetricsEvent)(nil),                 // 23: nitella.process.MetricsEvent
-	(common.ActionType)(0),               // 24: nitella.ActionType
-	(*proxy.MockConfig)(nil),             // 25: nitella.proxy.MockConfig
-	(proxy.ClientAuthType)(0),            // 26: nitella.proxy.ClientAuthType
-	(common.FallbackAction)(0),           // 27: nitella.FallbackAction
-	(common.MockPreset)(0),               // 28: nitella.MockPreset
-	(*proxy.ProxyStatus)(nil),            // 29: nitella.proxy.ProxyStatus
-	(*proxy.Rule)(nil),                   // 30: nitella.proxy.Rule
-	(*proxy.ActiveConnection)(nil),       // 31: nitella.proxy.ActiveConnection
-	(*proxy.ConnectionEvent)(nil),        // 32: nitella.proxy.ConnectionEvent
-	(*timestamp.Timestamp)(nil),          // 33: google.protobuf.Timestamp
+	(*StartListenerRequest)(nil),           // 0: nitella.process.StartListenerRequest
+	(*StartListenerResponse)(nil),          // 1: nitella.process.StartListenerResponse
+	(*StopListenerRequest)(nil),            // 2: nitella.process.StopListenerRequest
+	(*StopListenerResponse)(nil),           // 3: nitella.process.StopListenerResponse
+	(*HealthCheckRequest)(nil),             // 4: nitella.process.HealthCheckRequest
+	(*HealthCheckResponse)(nil),            // 5: nitella.process.HealthCheckResponse
+	(*GetMetricsRequest)(nil),              // 6: nitella.process.GetMetricsRequest
+	(*GetMetricsResponse)(nil),             // 7: nitella.process.GetMetricsResponse
+	(*AddRuleRequest)(nil),                 // 8: nitella.process.AddRuleRequest
+	(*AddRuleResponse)(nil),                // 9: nitella.process.AddRuleResponse
+	(*RemoveRuleRequest)(nil),              // 10: nitella.process.RemoveRuleRequest
+	(*RemoveRuleResponse)(nil),             // 11: nitella.process.RemoveRuleResponse
+	(*ListRulesRequest)(nil),               // 12: nitella.process.ListRulesRequest
+	(*ListRulesResponse)(nil),              // 13: nitella.process.ListRulesResponse
+	(*GetActiveConnectionsRequest)(nil),    // 14: nitella.process.GetActiveConnectionsRequest
+	(*GetActiveConnectionsResponse)(nil),   // 15: nitella.process.GetActiveConnectionsResponse
+	(*CloseConnectionRequest)(nil),         // 16: nitella.process.CloseConnectionRequest
+	(*CloseConnectionResponse)(nil),        // 17: nitella.process.CloseConnectionResponse
+	(*CloseAllConnectionsRequest)(nil),     // 18: nitella.process.CloseAllConnectionsRequest
+	(*CloseAllConnectionsResponse)(nil),    // 19: nitella.process.CloseAllConnectionsResponse
+	(*TarpitBudget)(nil),                   // 20: nitella.process.TarpitBudget
+	(*ConfigureTarpitRequest)(nil),         // 21: nitella.process.ConfigureTarpitRequest
+	(*ConfigureTarpitResponse)(nil),        // 22: nitella.process.ConfigureTarpitResponse
+	(*ConfigureClonedPresetsRequest)(nil),  // 23: nitella.process.ConfigureClonedPresetsRequest
+	(*ConfigureClonedPresetsResponse)(nil), // 24: nitella.process.ConfigureClonedPresetsResponse
+	(*StreamEventsRequest)(nil),            // 25: nitella.process.StreamEventsRequest
+	(*Event)(nil),                          // 26: nitella.process.Event
+	(*LogEvent)(nil),                       // 27: nitella.process.LogEvent
+	(*MetricsEvent)(nil),                   // 28: nitella.process.MetricsEvent
+	(*StreamAccessLogRequest)(nil),         // 29: nitella.process.StreamAccessLogRequest
+	(*AccessLogRecord)(nil),                // 30: nitella.process.AccessLogRecord
+	(common.ActionType)(0),                 // 31: nitella.ActionType
+	(*proxy.MockConfig)(nil),               // 32: nitella.proxy.MockConfig
+	(proxy.ClientAuthType)(0),              // 33: nitella.proxy.ClientAuthType
+	(common.FallbackAction)(0),             // 34: nitella.FallbackAction
+	(common.MockPreset)(0),                 // 35: nitella.MockPreset
+	(*proxy.ConnectionThresholds)(nil),     // 36: nitella.proxy.ConnectionThresholds
+	(*proxy.ClonedPreset)(nil),             // 37: nitella.proxy.ClonedPreset
+	(*proxy.ProxyStatus)(nil),              // 38: nitella.proxy.ProxyStatus
+	(*proxy.Rule)(nil),                     // 39: nitella.proxy.Rule
+	(*proxy.ActiveConnection)(nil),         // 40: nitella.proxy.ActiveConnection
+	(*proxy.ConnectionEvent)(nil),          // 41: nitella.proxy.ConnectionEvent
+	(*timestamp.Timestamp)(nil),            // 42: google.protobuf.Timestamp
 }
 var file_process_process_proto_depIdxs = []int32{
-	24, // 0: nitella.process.StartListenerRequest.default_action:type_name -> nitella.ActionType
-	25, // 1: nitella.process.StartListenerRequest.default_mock:type_name -> nitella.proxy.MockConfig
-	26, // 2: nitella.process.StartListenerRequest.client_auth_type:type_name -> nitella.proxy.ClientAuthType
-	27, // 3: nitella.process.StartListenerRequest.fallback_action:type_name -> nitella.FallbackAction
-	28, // 4: nitella.process.StartListenerRequest.fallback_mock:type_name -> nitella.MockPreset
-	29, // 5: nitella.process.GetMetricsResponse.status:type_name -> nitella.proxy.ProxyStatus
-	30, // 6: nitella.process.AddRuleRequest.rule:type_name -> nitella.proxy.Rule
-	30, // 7: nitella.process.ListRulesResponse.rules:type_name -> nitella.proxy.Rule
-	31, // 8: nitella.process.GetActiveConnectionsResponse.connections:type_name -> nitella.proxy.ActiveConnection
-	32, // 9: nitella.process.Event.connection:type_name -> nitella.proxy.ConnectionEvent
-	22, // 10: nitella.process.Event.log:type_name -> nitella.process.LogEvent
-	23, // 11: nitella.process.Event.metrics:type_name -> nitella.process.MetricsEvent
-	33, // 12: nitella.process.LogEvent.timestamp:type_name -> google.protobuf.Timestamp
-	33, // 13: nitella.process.MetricsEvent.timestamp:type_name -> google.protobuf.Timestamp
-	0,  // 14: nitella.process.ProcessControl.StartListener:input_type -> nitella.process.StartListenerRequest
-	2,  // 15: nitella.process.ProcessControl.StopListener:input_type -> nitella.process.StopListenerRequest
-	4,  // 16: nitella.process.ProcessControl.HealthCheck:input_type -> nitella.process.HealthCheckRequest
-	6,  // 17: nitella.process.ProcessControl.GetMetrics:input_type -> nitella.process.GetMetricsRequest
-	8,  // 18: nitella.process.ProcessControl.AddRule:input_type -> nitella.process.AddRuleRequest
-	10, // 19: nitella.process.ProcessControl.RemoveRule:input_type -> nitella.process.RemoveRuleRequest
-	12, // 20: nitella.process.ProcessControl.ListRules:input_type -> nitella.process.ListRulesRequest
-	14, // 21: nitella.process.ProcessControl.GetActiveConnections:input_type -> nitella.process.GetActiveConnectionsRequest
-	16, // 22: nitella.process.ProcessControl.CloseConnection:input_type -> nitella.process.CloseConnectionRequest
-	18, // 23: nitella.process.ProcessControl.CloseAllConnections:input_type -> nitella.process.CloseAllConnectionsRequest
-	20, // 24: nitella.process.ProcessControl.StreamEvents:input_type -> nitella.process.StreamEventsRequest
-	1,  // 25: nitella.process.ProcessControl.StartListener:output_type -> nitella.process.StartListenerResponse
-	3,  // 26: nitella.process.ProcessControl.StopListener:output_type -> nitella.process.StopListenerResponse
-	5,  // 27: nitella.process.ProcessControl.HealthCheck:output_type -> nitella.process.HealthCheckResponse
-	7,  // 28: nitella.process.ProcessControl.GetMetrics:output_type -> nitella.process.GetMetricsResponse
-	9,  // 29: nitella.process.ProcessControl.AddRule:output_type -> nitella.process.AddRuleResponse
-	11, // 30: nitella.process.ProcessControl.RemoveRule:output_type -> nitella.process.RemoveRuleResponse
-	13, // 31: nitella.process.ProcessControl.ListRules:output_type -> nitella.process.ListRulesResponse
-	15, // 32: nitella.process.ProcessControl.GetActiveConnections:output_type -> nitella.process.GetActiveConnectionsResponse
-	17, // 33: nitella.process.ProcessControl.CloseConnection:output_type -> nitella.process.CloseConnectionResponse
-	19, // 34: nitella.process.ProcessControl.CloseAllConnections:output_type -> nitella.process.CloseAllConnectionsResponse
-	21, // 35: nitella.process.ProcessControl.StreamEvents:output_type -> nitella.process.Event
-	25, // [25:36] is the sub-list for method output_type
-	14, // [14:25] is the sub-list for method input_type
-	14, // [14:14] is the sub-list for extension type_name
-	14, // [14:14] is the sub-list for extension extendee
-	0,  // [0:14] is the sub-list for field type_name
+	31, // 0: nitella.process.StartListenerRequest.default_action:type_name -> nitella.ActionType
+	32, // 1: nitella.process.StartListenerRequest.default_mock:type_name -> nitella.proxy.MockConfig
+	33, // 2: nitella.process.StartListenerRequest.client_auth_type:type_name -> nitella.proxy.ClientAuthType
+	34, // 3: nitella.process.StartListenerRequest.fallback_action:type_name -> nitella.FallbackAction
+	35, // 4: nitella.process.StartListenerRequest.fallback_mock:type_name -> nitella.MockPreset
+	36, // 5: nitella.process.StartListenerRequest.thresholds:type_name -> nitella.proxy.ConnectionThresholds
+	20, // 6: nitella.process.StartListenerRequest.tarpit_budget:type_name -> nitella.process.TarpitBudget
+	37, // 7: nitella.process.StartListenerRequest.cloned_presets:type_name -> nitella.proxy.ClonedPreset
+	38, // 8: nitella.process.GetMetricsResponse.status:type_name -> nitella.proxy.ProxyStatus
+	39, // 9: nitella.process.AddRuleRequest.rule:type_name -> nitella.proxy.Rule
+	39, // 10: nitella.process.ListRulesResponse.rules:type_name -> nitella.proxy.Rule
+	40, // 11: nitella.process.GetActiveConnectionsResponse.connections:type_name -> nitella.proxy.ActiveConnection
+	20, // 12: nitella.process.ConfigureTarpitRequest.budget:type_name -> nitella.process.TarpitBudget
+	37, // 13: nitella.process.ConfigureClonedPresetsRequest.presets:type_name -> nitella.proxy.ClonedPreset
+	41, // 14: nitella.process.Event.connection:type_name -> nitella.proxy.ConnectionEvent
+	27, // 15: nitella.process.Event.log:type_name -> nitella.process.LogEvent
+	28, // 16: nitella.process.Event.metrics:type_name -> nitella.process.MetricsEvent
+	42, // 17: nitella.process.LogEvent.timestamp:type_name -> google.protobuf.Timestamp
+	42, // 18: nitella.process.MetricsEvent.timestamp:type_name -> google.protobuf.Timestamp
+	0,  // 19: nitella.process.ProcessControl.StartListener:input_type -> nitella.process.StartListenerRequest
+	2,  // 20: nitella.process.ProcessControl.StopListener:input_type -> nitella.process.StopListenerRequest
+	4,  // 21: nitella.process.ProcessControl.HealthCheck:input_type -> nitella.process.HealthCheckRequest
+	6,  // 22: nitella.process.ProcessControl.GetMetrics:input_type -> nitella.process.GetMetricsRequest
+	8,  // 23: nitella.process.ProcessControl.AddRule:input_type -> nitella.process.AddRuleRequest
+	10, // 24: nitella.process.ProcessControl.RemoveRule:input_type -> nitella.process.RemoveRuleRequest
+	12, // 25: nitella.process.ProcessControl.ListRules:input_type -> nitella.process.ListRulesRequest
+	14, // 26: nitella.process.ProcessControl.GetActiveConnections:input_type -> nitella.process.GetActiveConnectionsRequest
+	16, // 27: nitella.process.ProcessControl.CloseConnection:input_type -> nitella.process.CloseConnectionRequest
+	18, // 28: nitella.process.ProcessControl.CloseAllConnections:input_type -> nitella.process.CloseAllConnectionsRequest
+	21, // 29: nitella.process.ProcessControl.ConfigureTarpit:input_type -> nitella.process.ConfigureTarpitRequest
+	23, // 30: nitella.process.ProcessControl.ConfigureClonedPresets:input_type -> nitella.process.ConfigureClonedPresetsRequest
+	25, // 31: nitella.process.ProcessControl.StreamEvents:input_type -> nitella.process.StreamEventsRequest
+	29, // 32: nitella.process.ProcessControl.StreamAccessLog:input_type -> nitella.process.StreamAccessLogRequest
+	1,  // 33: nitella.process.ProcessControl.StartListener:output_type -> nitella.process.StartListenerResponse
+	3,  // 34: nitella.process.ProcessControl.StopListener:output_type -> nitella.process.StopListenerResponse
+	5,  // 35: nitella.process.ProcessControl.HealthCheck:output_type -> nitella.process.HealthCheckResponse
+	7,  // 36: nitella.process.ProcessControl.GetMetrics:output_type -> nitella.process.GetMetricsResponse
+	9,  // 37: nitella.process.ProcessControl.AddRule:output_type -> nitella.process.AddRuleResponse
+	11, // 38: nitella.process.ProcessControl.RemoveRule:output_type -> nitella.process.RemoveRuleResponse
+	13, // 39: nitella.process.ProcessControl.ListRules:output_type -> nitella.process.ListRulesResponse
+	15, // 40: nitella.process.ProcessControl.GetActiveConnections:output_type -> nitella.process.GetActiveConnectionsResponse
+	17, // 41: nitella.process.ProcessControl.CloseConnection:output_type -> nitella.process.CloseConnectionResponse
+	19, // 42: nitella.process.ProcessControl.CloseAllConnections:output_type -> nitella.process.CloseAllConnectionsResponse
+	22, // 43: nitella.process.ProcessControl.ConfigureTarpit:output_type -> nitella.process.ConfigureTarpitResponse
+	24, // 44: nitella.process.ProcessControl.ConfigureClonedPresets:output_type -> nitella.process.ConfigureClonedPresetsResponse
+	26, // 45: nitella.process.ProcessControl.StreamEvents:output_type -> nitella.process.Event
+	30, // 46: nitella.process.ProcessControl.StreamAccessLog:output_type -> nitella.process.AccessLogRecord
+	33, // [33:47] is the sub-list for method output_type
+	19, // [19:33] is the sub-list for method input_type
+	19, // [19:19] is the sub-list for extension type_name
+	19, // [19:19] is the sub-list for extension extendee
+	0,  // [0:19] is the sub-list for field type_name
 }
 
 func init() { file_process_process_proto_init() }
@@ -1442,7 +1847,7 @@ func file_process_process_proto_init() {
 	if File_process_process_proto != nil {
 		return
 	}
-	file_process_process_proto_msgTypes[21].OneofWrappers = []any{
+	file_process_process_proto_msgTypes[26].OneofWrappers = []any{
 		(*Event_Connection)(nil),
 		(*Event_Log)(nil),
 		(*Event_Metrics)(nil),
@@ -1453,7 +1858,7 @@ func file_process_process_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_process_process_proto_rawDesc), len(file_process_process_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   24,
+			NumMessages:   31,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -66,11 +66,29 @@ class ProcessControlFfi {
     return CloseAllConnectionsResponse.fromBuffer(resultBytes);
   }
 
+  static Future<ConfigureTarpitResponse> ConfigureTarpit(ConfigureTarpitRequest request) async {
+    final bytes = request.writeToBuffer();
+    final resultBytes = await synurang.invokeBackendAsync('/nitella.process.ProcessControl/ConfigureTarpit', bytes);
+    return ConfigureTarpitResponse.fromBuffer(resultBytes);
+  }
+
+  static Future<ConfigureClonedPresetsResponse> ConfigureClonedPresets(ConfigureClonedPresetsRequest request) async {
+    final bytes = request.writeToBuffer();
+    final resultBytes = await synurang.invokeBackendAsync('/nitella.process.ProcessControl/ConfigureClonedPresets', bytes);
+    return ConfigureClonedPresetsResponse.fromBuffer(resultBytes);
+  }
+
   static Stream<Event> StreamEvents(StreamEventsRequest request) {
     final bytes = request.writeToBuffer();
     return synurang.invokeBackendServerStream('/nitella.process.ProcessControl/StreamEvents', bytes)
         .map((data) => Event.fromBuffer(data));
   }
 
+  static Stream<AccessLogRecord> StreamAccessLog(StreamAccessLogRequest request) {
+    final bytes = request.writeToBuffer();
+    return synurang.invokeBackendServerStream('/nitella.process.ProcessControl/StreamAccessLog', bytes)
+        .map((data) => AccessLogRecord.fromBuffer(data));
+  }
+
 }
 
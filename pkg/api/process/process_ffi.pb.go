@@ -24,6 +24,7 @@ import (
 type FfiServer interface {
 	ProcessControlServer
 	StreamEventsInternal(context.Context, *StreamEventsRequest) (*Event, error)
+	StreamAccessLogInternal(context.Context, *StreamAccessLogRequest) (*AccessLogRecord, error)
 }
 
 // =============================================================================
@@ -132,6 +133,26 @@ func Invoke(s FfiServer, ctx context.Context, method string, data []byte) ([]byt
 			return nil, err
 		}
 		return proto.Marshal(resp)
+	case "/nitella.process.ProcessControl/ConfigureTarpit":
+		req := &ConfigureTarpitRequest{}
+		if err := proto.Unmarshal(data, req); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal request: %w", err)
+		}
+		resp, err := s.ConfigureTarpit(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		return proto.Marshal(resp)
+	case "/nitella.process.ProcessControl/ConfigureClonedPresets":
+		req := &ConfigureClonedPresetsRequest{}
+		if err := proto.Unmarshal(data, req); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal request: %w", err)
+		}
+		resp, err := s.ConfigureClonedPresets(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		return proto.Marshal(resp)
 	case "/nitella.process.ProcessControl/StreamEvents":
 		req := &StreamEventsRequest{}
 		if err := proto.Unmarshal(data, req); err != nil {
@@ -142,6 +163,16 @@ func Invoke(s FfiServer, ctx context.Context, method string, data []byte) ([]byt
 			return nil, err
 		}
 		return proto.Marshal(resp)
+	case "/nitella.process.ProcessControl/StreamAccessLog":
+		req := &StreamAccessLogRequest{}
+		if err := proto.Unmarshal(data, req); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal request: %w", err)
+		}
+		resp, err := s.StreamAccessLogInternal(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		return proto.Marshal(resp)
 	default:
 		return nil, fmt.Errorf("unknown method: %s", method)
 	}
@@ -396,6 +427,54 @@ func InvokeFfi(s FfiServer, ctx context.Context, method string, data []byte) (un
 			return nil, 0, err
 		}
 		return cPtr, int64(size), nil
+	case "/nitella.process.ProcessControl/ConfigureTarpit":
+		req := &ConfigureTarpitRequest{}
+		if err := proto.Unmarshal(data, req); err != nil {
+			return nil, 0, fmt.Errorf("failed to unmarshal request: %w", err)
+		}
+		resp, err := s.ConfigureTarpit(ctx, req)
+		if err != nil {
+			return nil, 0, err
+		}
+		// Zero-copy: allocate C memory and serialize directly
+		size := proto.Size(resp)
+		if size == 0 {
+			return nil, 0, nil
+		}
+		cPtr := C.malloc(C.size_t(size))
+		if cPtr == nil {
+			return nil, 0, fmt.Errorf("failed to allocate memory for response")
+		}
+		buf := unsafe.Slice((*byte)(cPtr), size)
+		if _, err := (proto.MarshalOptions{}).MarshalAppend(buf[:0], resp); err != nil {
+			C.free(cPtr)
+			return nil, 0, err
+		}
+		return cPtr, int64(size), nil
+	case "/nitella.process.ProcessControl/ConfigureClonedPresets":
+		req := &ConfigureClonedPresetsRequest{}
+		if err := proto.Unmarshal(data, req); err != nil {
+			return nil, 0, fmt.Errorf("failed to unmarshal request: %w", err)
+		}
+		resp, err := s.ConfigureClonedPresets(ctx, req)
+		if err != nil {
+			return nil, 0, err
+		}
+		// Zero-copy: allocate C memory and serialize directly
+		size := proto.Size(resp)
+		if size == 0 {
+			return nil, 0, nil
+		}
+		cPtr := C.malloc(C.size_t(size))
+		if cPtr == nil {
+			return nil, 0, fmt.Errorf("failed to allocate memory for response")
+		}
+		buf := unsafe.Slice((*byte)(cPtr), size)
+		if _, err := (proto.MarshalOptions{}).MarshalAppend(buf[:0], resp); err != nil {
+			C.free(cPtr)
+			return nil, 0, err
+		}
+		return cPtr, int64(size), nil
 	case "/nitella.process.ProcessControl/StreamEvents":
 		req := &StreamEventsRequest{}
 		if err := proto.Unmarshal(data, req); err != nil {
@@ -420,6 +499,30 @@ func InvokeFfi(s FfiServer, ctx context.Context, method string, data []byte) (un
 			return nil, 0, err
 		}
 		return cPtr, int64(size), nil
+	case "/nitella.process.ProcessControl/StreamAccessLog":
+		req := &StreamAccessLogRequest{}
+		if err := proto.Unmarshal(data, req); err != nil {
+			return nil, 0, fmt.Errorf("failed to unmarshal request: %w", err)
+		}
+		resp, err := s.StreamAccessLogInternal(ctx, req)
+		if err != nil {
+			return nil, 0, err
+		}
+		// Zero-copy: allocate C memory and serialize directly
+		size := proto.Size(resp)
+		if size == 0 {
+			return nil, 0, nil
+		}
+		cPtr := C.malloc(C.size_t(size))
+		if cPtr == nil {
+			return nil, 0, fmt.Errorf("failed to allocate memory for response")
+		}
+		buf := unsafe.Slice((*byte)(cPtr), size)
+		if _, err := (proto.MarshalOptions{}).MarshalAppend(buf[:0], resp); err != nil {
+			C.free(cPtr)
+			return nil, 0, err
+		}
+		return cPtr, int64(size), nil
 	default:
 		return nil, 0, fmt.Errorf("unknown method: %s", method)
 	}
@@ -437,6 +540,12 @@ func InvokeStream(s FfiServer, ctx context.Context, method string, stream grpc.S
 			return err
 		}
 		return s.StreamEvents(req, &grpcProcessControlStreamEventsStream{stream})
+	case "/nitella.process.ProcessControl/StreamAccessLog":
+		req := &StreamAccessLogRequest{}
+		if err := stream.RecvMsg(req); err != nil {
+			return err
+		}
+		return s.StreamAccessLog(req, &grpcProcessControlStreamAccessLogStream{stream})
 	default:
 		return fmt.Errorf("unknown streaming method: %s", method)
 	}
@@ -456,6 +565,16 @@ func (s *grpcProcessControlStreamEventsStream) Send(m *Event) error {
 
 var _ ProcessControl_StreamEventsServer = (*grpcProcessControlStreamEventsStream)(nil)
 
+type grpcProcessControlStreamAccessLogStream struct {
+	grpc.ServerStream
+}
+
+func (s *grpcProcessControlStreamAccessLogStream) Send(m *AccessLogRecord) error {
+	return s.ServerStream.SendMsg(m)
+}
+
+var _ ProcessControl_StreamAccessLogServer = (*grpcProcessControlStreamAccessLogStream)(nil)
+
 // =============================================================================
 // FFI Invoker - wraps FfiServer to implement synurang.Invoker interface
 // =============================================================================
@@ -550,6 +669,22 @@ func (i *ffiInvoker) Invoke(ctx context.Context, method string, req, reply proto
 		// Use proto.Merge to avoid copying mutex in MessageState
 		proto.Merge(reply.(proto.Message), resp)
 		return nil
+	case "/nitella.process.ProcessControl/ConfigureTarpit":
+		resp, err := i.server.ConfigureTarpit(ctx, req.(*ConfigureTarpitRequest))
+		if err != nil {
+			return err
+		}
+		// Use proto.Merge to avoid copying mutex in MessageState
+		proto.Merge(reply.(proto.Message), resp)
+		return nil
+	case "/nitella.process.ProcessControl/ConfigureClonedPresets":
+		resp, err := i.server.ConfigureClonedPresets(ctx, req.(*ConfigureClonedPresetsRequest))
+		if err != nil {
+			return err
+		}
+		// Use proto.Merge to avoid copying mutex in MessageState
+		proto.Merge(reply.(proto.Message), resp)
+		return nil
 	case "/nitella.process.ProcessControl/StreamEvents":
 		resp, err := i.server.StreamEventsInternal(ctx, req.(*StreamEventsRequest))
 		if err != nil {
@@ -558,6 +693,14 @@ func (i *ffiInvoker) Invoke(ctx context.Context, method string, req, reply proto
 		// Use proto.Merge to avoid copying mutex in MessageState
 		proto.Merge(reply.(proto.Message), resp)
 		return nil
+	case "/nitella.process.ProcessControl/StreamAccessLog":
+		resp, err := i.server.StreamAccessLogInternal(ctx, req.(*StreamAccessLogRequest))
+		if err != nil {
+			return err
+		}
+		// Use proto.Merge to avoid copying mutex in MessageState
+		proto.Merge(reply.(proto.Message), resp)
+		return nil
 	default:
 		return fmt.Errorf("unknown method: %s", method)
 	}
@@ -574,6 +717,14 @@ func (i *ffiInvoker) InvokeStream(ctx context.Context, method string, stream syn
 		}
 		req := reqMsg.(*StreamEventsRequest)
 		return i.server.StreamEvents(req, &ffiProcessControlStreamEventsStream{stream})
+	case "/nitella.process.ProcessControl/StreamAccessLog":
+		// Server streaming (zero-copy)
+		reqMsg, err := stream.RecvMsgDirect()
+		if err != nil {
+			return err
+		}
+		req := reqMsg.(*StreamAccessLogRequest)
+		return i.server.StreamAccessLog(req, &ffiProcessControlStreamAccessLogStream{stream})
 	default:
 		return fmt.Errorf("unknown streaming method: %s", method)
 	}
@@ -598,6 +749,21 @@ func (s *ffiProcessControlStreamEventsStream) Send(m *Event) error {
 
 var _ ProcessControl_StreamEventsServer = (*ffiProcessControlStreamEventsStream)(nil)
 
+// ffiProcessControlStreamAccessLogStream wraps ServerStream for zero-copy ProcessControl.StreamAccessLog
+type ffiProcessControlStreamAccessLogStream struct {
+	synurang.ServerStream
+}
+
+func (s *ffiProcessControlStreamAccessLogStream) Context() context.Context {
+	return s.ServerStream.Context()
+}
+
+func (s *ffiProcessControlStreamAccessLogStream) Send(m *AccessLogRecord) error {
+	return s.ServerStream.SendMsg(m)
+}
+
+var _ ProcessControl_StreamAccessLogServer = (*ffiProcessControlStreamAccessLogStream)(nil)
+
 var _ synurang.Invoker = (*ffiInvoker)(nil)
 
 // =============================================================================
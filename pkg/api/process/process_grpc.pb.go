@@ -19,17 +19,20 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	ProcessControl_StartListener_FullMethodName        = "/nitella.process.ProcessControl/StartListener"
-	ProcessControl_StopListener_FullMethodName         = "/nitella.process.ProcessControl/StopListener"
-	ProcessControl_HealthCheck_FullMethodName          = "/nitella.process.ProcessControl/HealthCheck"
-	ProcessControl_GetMetrics_FullMethodName           = "/nitella.process.ProcessControl/GetMetrics"
-	ProcessControl_AddRule_FullMethodName              = "/nitella.process.ProcessControl/AddRule"
-	ProcessControl_RemoveRule_FullMethodName           = "/nitella.process.ProcessControl/RemoveRule"
-	ProcessControl_ListRules_FullMethodName            = "/nitella.process.ProcessControl/ListRules"
-	ProcessControl_GetActiveConnections_FullMethodName = "/nitella.process.ProcessControl/GetActiveConnections"
-	ProcessControl_CloseConnection_FullMethodName      = "/nitella.process.ProcessControl/CloseConnection"
-	ProcessControl_CloseAllConnections_FullMethodName  = "/nitella.process.ProcessControl/CloseAllConnections"
-	ProcessControl_StreamEvents_FullMethodName         = "/nitella.process.ProcessControl/StreamEvents"
+	ProcessControl_StartListener_FullMethodName          = "/nitella.process.ProcessControl/StartListener"
+	ProcessControl_StopListener_FullMethodName           = "/nitella.process.ProcessControl/StopListener"
+	ProcessControl_HealthCheck_FullMethodName            = "/nitella.process.ProcessControl/HealthCheck"
+	ProcessControl_GetMetrics_FullMethodName             = "/nitella.process.ProcessControl/GetMetrics"
+	ProcessControl_AddRule_FullMethodName                = "/nitella.process.ProcessControl/AddRule"
+	ProcessControl_RemoveRule_FullMethodName             = "/nitella.process.ProcessControl/RemoveRule"
+	ProcessControl_ListRules_FullMethodName              = "/nitella.process.ProcessControl/ListRules"
+	ProcessControl_GetActiveConnections_FullMethodName   = "/nitella.process.ProcessControl/GetActiveConnections"
+	ProcessControl_CloseConnection_FullMethodName        = "/nitella.process.ProcessControl/CloseConnection"
+	ProcessControl_CloseAllConnections_FullMethodName    = "/nitella.process.ProcessControl/CloseAllConnections"
+	ProcessControl_ConfigureTarpit_FullMethodName        = "/nitella.process.ProcessControl/ConfigureTarpit"
+	ProcessControl_ConfigureClonedPresets_FullMethodName = "/nitella.process.ProcessControl/ConfigureClonedPresets"
+	ProcessControl_StreamEvents_FullMethodName           = "/nitella.process.ProcessControl/StreamEvents"
+	ProcessControl_StreamAccessLog_FullMethodName        = "/nitella.process.ProcessControl/StreamAccessLog"
 )
 
 // ProcessControlClient is the client API for ProcessControl service.
@@ -53,8 +56,13 @@ type ProcessControlClient interface {
 	GetActiveConnections(ctx context.Context, in *GetActiveConnectionsRequest, opts ...grpc.CallOption) (*GetActiveConnectionsResponse, error)
 	CloseConnection(ctx context.Context, in *CloseConnectionRequest, opts ...grpc.CallOption) (*CloseConnectionResponse, error)
 	CloseAllConnections(ctx context.Context, in *CloseAllConnectionsRequest, opts ...grpc.CallOption) (*CloseAllConnectionsResponse, error)
+	// Node-wide settings
+	ConfigureTarpit(ctx context.Context, in *ConfigureTarpitRequest, opts ...grpc.CallOption) (*ConfigureTarpitResponse, error)
+	ConfigureClonedPresets(ctx context.Context, in *ConfigureClonedPresetsRequest, opts ...grpc.CallOption) (*ConfigureClonedPresetsResponse, error)
 	// Streaming events from Child -> Parent (connections, logs, metrics)
 	StreamEvents(ctx context.Context, in *StreamEventsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Event], error)
+	// Access log records from Child -> Parent, which writes the log
+	StreamAccessLog(ctx context.Context, in *StreamAccessLogRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[AccessLogRecord], error)
 }
 
 type processControlClient struct {
@@ -165,6 +173,26 @@ func (c *processControlClient) CloseAllConnections(ctx context.Context, in *Clos
 	return out, nil
 }
 
+func (c *processControlClient) ConfigureTarpit(ctx context.Context, in *ConfigureTarpitRequest, opts ...grpc.CallOption) (*ConfigureTarpitResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConfigureTarpitResponse)
+	err := c.cc.Invoke(ctx, ProcessControl_ConfigureTarpit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *processControlClient) ConfigureClonedPresets(ctx context.Context, in *ConfigureClonedPresetsRequest, opts ...grpc.CallOption) (*ConfigureClonedPresetsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConfigureClonedPresetsResponse)
+	err := c.cc.Invoke(ctx, ProcessControl_ConfigureClonedPresets_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *processControlClient) StreamEvents(ctx context.Context, in *StreamEventsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Event], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	stream, err := c.cc.NewStream(ctx, &ProcessControl_ServiceDesc.Streams[0], ProcessControl_StreamEvents_FullMethodName, cOpts...)
@@ -184,6 +212,25 @@ func (c *processControlClient) StreamEvents(ctx context.Context, in *StreamEvent
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type ProcessControl_StreamEventsClient = grpc.ServerStreamingClient[Event]
 
+func (c *processControlClient) StreamAccessLog(ctx context.Context, in *StreamAccessLogRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[AccessLogRecord], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ProcessControl_ServiceDesc.Streams[1], ProcessControl_StreamAccessLog_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StreamAccessLogRequest, AccessLogRecord]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ProcessControl_StreamAccessLogClient = grpc.ServerStreamingClient[AccessLogRecord]
+
 // ProcessControlServer is the server API for ProcessControl service.
 // All implementations must embed UnimplementedProcessControlServer
 // for forward compatibility.
@@ -205,8 +252,13 @@ type ProcessControlServer interface {
 	GetActiveConnections(context.Context, *GetActiveConnectionsRequest) (*GetActiveConnectionsResponse, error)
 	CloseConnection(context.Context, *CloseConnectionRequest) (*CloseConnectionResponse, error)
 	CloseAllConnections(context.Context, *CloseAllConnectionsRequest) (*CloseAllConnectionsResponse, error)
+	// Node-wide settings
+	ConfigureTarpit(context.Context, *ConfigureTarpitRequest) (*ConfigureTarpitResponse, error)
+	ConfigureClonedPresets(context.Context, *ConfigureClonedPresetsRequest) (*ConfigureClonedPresetsResponse, error)
 	// Streaming events from Child -> Parent (connections, logs, metrics)
 	StreamEvents(*StreamEventsRequest, grpc.ServerStreamingServer[Event]) error
+	// Access log records from Child -> Parent, which writes the log
+	StreamAccessLog(*StreamAccessLogRequest, grpc.ServerStreamingServer[AccessLogRecord]) error
 	mustEmbedUnimplementedProcessControlServer()
 }
 
@@ -247,9 +299,18 @@ func (UnimplementedProcessControlServer) CloseConnection(context.Context, *Close
 func (UnimplementedProcessControlServer) CloseAllConnections(context.Context, *CloseAllConnectionsRequest) (*CloseAllConnectionsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method CloseAllConnections not implemented")
 }
+func (UnimplementedProcessControlServer) ConfigureTarpit(context.Context, *ConfigureTarpitRequest) (*ConfigureTarpitResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ConfigureTarpit not implemented")
+}
+func (UnimplementedProcessControlServer) ConfigureClonedPresets(context.Context, *ConfigureClonedPresetsRequest) (*ConfigureClonedPresetsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ConfigureClonedPresets not implemented")
+}
 func (UnimplementedProcessControlServer) StreamEvents(*StreamEventsRequest, grpc.ServerStreamingServer[Event]) error {
 	return status.Error(codes.Unimplemented, "method StreamEvents not implemented")
 }
+func (UnimplementedProcessControlServer) StreamAccessLog(*StreamAccessLogRequest, grpc.ServerStreamingServer[AccessLogRecord]) error {
+	return status.Error(codes.Unimplemented, "method StreamAccessLog not implemented")
+}
 func (UnimplementedProcessControlServer) mustEmbedUnimplementedProcessControlServer() {}
 func (UnimplementedProcessControlServer) testEmbeddedByValue()                        {}
 
@@ -451,6 +512,42 @@ func _ProcessControl_CloseAllConnections_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProcessControl_ConfigureTarpit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfigureTarpitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProcessControlServer).ConfigureTarpit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProcessControl_ConfigureTarpit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProcessControlServer).ConfigureTarpit(ctx, req.(*ConfigureTarpitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProcessControl_ConfigureClonedPresets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfigureClonedPresetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProcessControlServer).ConfigureClonedPresets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProcessControl_ConfigureClonedPresets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProcessControlServer).ConfigureClonedPresets(ctx, req.(*ConfigureClonedPresetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ProcessControl_StreamEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(StreamEventsRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -462,6 +559,17 @@ func _ProcessControl_StreamEvents_Handler(srv interface{}, stream grpc.ServerStr
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type ProcessControl_StreamEventsServer = grpc.ServerStreamingServer[Event]
 
+func _ProcessControl_StreamAccessLog_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamAccessLogRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ProcessControlServer).StreamAccessLog(m, &grpc.GenericServerStream[StreamAccessLogRequest, AccessLogRecord]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ProcessControl_StreamAccessLogServer = grpc.ServerStreamingServer[AccessLogRecord]
+
 // ProcessControl_ServiceDesc is the grpc.ServiceDesc for ProcessControl service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -509,6 +617,14 @@ var ProcessControl_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CloseAllConnections",
 			Handler:    _ProcessControl_CloseAllConnections_Handler,
 		},
+		{
+			MethodName: "ConfigureTarpit",
+			Handler:    _ProcessControl_ConfigureTarpit_Handler,
+		},
+		{
+			MethodName: "ConfigureClonedPresets",
+			Handler:    _ProcessControl_ConfigureClonedPresets_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -516,6 +632,11 @@ var ProcessControl_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _ProcessControl_StreamEvents_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "StreamAccessLog",
+			Handler:       _ProcessControl_StreamAccessLog_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "process/process.proto",
 }
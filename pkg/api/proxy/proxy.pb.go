@@ -179,34 +179,208 @@ func (HealthStatus) EnumDescriptor() ([]byte, []int) {
 	return file_proxy_proxy_proto_rawDescGZIP(), []int{2}
 }
 
+type ApprovalTimeoutAction int32
+
+const (
+	ApprovalTimeoutAction_APPROVAL_TIMEOUT_UNSPECIFIED ApprovalTimeoutAction = 0 // Deny
+	ApprovalTimeoutAction_APPROVAL_TIMEOUT_DENY        ApprovalTimeoutAction = 1 // Block (and run the listener's fallback)
+	ApprovalTimeoutAction_APPROVAL_TIMEOUT_ALLOW       ApprovalTimeoutAction = 2 // Forward this connection
+	ApprovalTimeoutAction_APPROVAL_TIMEOUT_MOCK        ApprovalTimeoutAction = 3 // Hand the connection to timeout_mock
+)
+
+// Enum value maps for ApprovalTimeoutAction.
+var (
+	ApprovalTimeoutAction_name = map[int32]string{
+		0: "APPROVAL_TIMEOUT_UNSPECIFIED",
+		1: "APPROVAL_TIMEOUT_DENY",
+		2: "APPROVAL_TIMEOUT_ALLOW",
+		3: "APPROVAL_TIMEOUT_MOCK",
+	}
+	ApprovalTimeoutAction_value = map[string]int32{
+		"APPROVAL_TIMEOUT_UNSPECIFIED": 0,
+		"APPROVAL_TIMEOUT_DENY":        1,
+		"APPROVAL_TIMEOUT_ALLOW":       2,
+		"APPROVAL_TIMEOUT_MOCK":        3,
+	}
+)
+
+func (x ApprovalTimeoutAction) Enum() *ApprovalTimeoutAction {
+	p := new(ApprovalTimeoutAction)
+	*p = x
+	return p
+}
+
+func (x ApprovalTimeoutAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ApprovalTimeoutAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_proxy_proxy_proto_enumTypes[3].Descriptor()
+}
+
+func (ApprovalTimeoutAction) Type() protoreflect.EnumType {
+	return &file_proxy_proxy_proto_enumTypes[3]
+}
+
+func (x ApprovalTimeoutAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ApprovalTimeoutAction.Descriptor instead.
+func (ApprovalTimeoutAction) EnumDescriptor() ([]byte, []int) {
+	return file_proxy_proxy_proto_rawDescGZIP(), []int{3}
+}
+
+type ApprovalHold int32
+
+const (
+	ApprovalHold_APPROVAL_HOLD_UNSPECIFIED  ApprovalHold = 0 // Silent
+	ApprovalHold_APPROVAL_HOLD_SILENT       ApprovalHold = 1 // Hold the connection without sending anything
+	ApprovalHold_APPROVAL_HOLD_TARPIT       ApprovalHold = 2 // Drip junk lines to SSH clients, hold others silently
+	ApprovalHold_APPROVAL_HOLD_HTTP_PENDING ApprovalHold = 3 // Answer with a "pending approval" page and close; the decision applies to retries
+)
+
+// Enum value maps for ApprovalHold.
+var (
+	ApprovalHold_name = map[int32]string{
+		0: "APPROVAL_HOLD_UNSPECIFIED",
+		1: "APPROVAL_HOLD_SILENT",
+		2: "APPROVAL_HOLD_TARPIT",
+		3: "APPROVAL_HOLD_HTTP_PENDING",
+	}
+	ApprovalHold_value = map[string]int32{
+		"APPROVAL_HOLD_UNSPECIFIED":  0,
+		"APPROVAL_HOLD_SILENT":       1,
+		"APPROVAL_HOLD_TARPIT":       2,
+		"APPROVAL_HOLD_HTTP_PENDING": 3,
+	}
+)
+
+func (x ApprovalHold) Enum() *ApprovalHold {
+	p := new(ApprovalHold)
+	*p = x
+	return p
+}
+
+func (x ApprovalHold) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ApprovalHold) Descriptor() protoreflect.EnumDescriptor {
+	return file_proxy_proxy_proto_enumTypes[4].Descriptor()
+}
+
+func (ApprovalHold) Type() protoreflect.EnumType {
+	return &file_proxy_proxy_proto_enumTypes[4]
+}
+
+func (x ApprovalHold) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ApprovalHold.Descriptor instead.
+func (ApprovalHold) EnumDescriptor() ([]byte, []int) {
+	return file_proxy_proxy_proto_rawDescGZIP(), []int{4}
+}
+
+type ThresholdAction int32
+
+const (
+	ThresholdAction_THRESHOLD_ACTION_UNSPECIFIED ThresholdAction = 0 // Alert
+	ThresholdAction_THRESHOLD_ACTION_ALERT       ThresholdAction = 1 // Alert, keep forwarding
+	ThresholdAction_THRESHOLD_ACTION_CLOSE       ThresholdAction = 2 // Alert and close the connection
+	ThresholdAction_THRESHOLD_ACTION_THROTTLE    ThresholdAction = 3 // Alert and limit the connection's throughput
+)
+
+// Enum value maps for ThresholdAction.
+var (
+	ThresholdAction_name = map[int32]string{
+		0: "THRESHOLD_ACTION_UNSPECIFIED",
+		1: "THRESHOLD_ACTION_ALERT",
+		2: "THRESHOLD_ACTION_CLOSE",
+		3: "THRESHOLD_ACTION_THROTTLE",
+	}
+	ThresholdAction_value = map[string]int32{
+		"THRESHOLD_ACTION_UNSPECIFIED": 0,
+		"THRESHOLD_ACTION_ALERT":       1,
+		"THRESHOLD_ACTION_CLOSE":       2,
+		"THRESHOLD_ACTION_THROTTLE":    3,
+	}
+)
+
+func (x ThresholdAction) Enum() *ThresholdAction {
+	p := new(ThresholdAction)
+	*p = x
+	return p
+}
+
+func (x ThresholdAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ThresholdAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_proxy_proxy_proto_enumTypes[5].Descriptor()
+}
+
+func (ThresholdAction) Type() protoreflect.EnumType {
+	return &file_proxy_proxy_proto_enumTypes[5]
+}
+
+func (x ThresholdAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ThresholdAction.Descriptor instead.
+func (ThresholdAction) EnumDescriptor() ([]byte, []int) {
+	return file_proxy_proxy_proto_rawDescGZIP(), []int{5}
+}
+
 type EventType int32
 
 const (
-	EventType_EVENT_TYPE_UNSPECIFIED      EventType = 0
-	EventType_EVENT_TYPE_CONNECTED        EventType = 1
-	EventType_EVENT_TYPE_CLOSED           EventType = 2
-	EventType_EVENT_TYPE_BLOCKED          EventType = 3
-	EventType_EVENT_TYPE_PENDING_APPROVAL EventType = 4 // Connection waiting for user approval
-	EventType_EVENT_TYPE_APPROVED         EventType = 5 // Connection approved by user
+	EventType_EVENT_TYPE_UNSPECIFIED         EventType = 0
+	EventType_EVENT_TYPE_CONNECTED           EventType = 1
+	EventType_EVENT_TYPE_CLOSED              EventType = 2
+	EventType_EVENT_TYPE_BLOCKED             EventType = 3
+	EventType_EVENT_TYPE_PENDING_APPROVAL    EventType = 4  // Connection waiting for user approval
+	EventType_EVENT_TYPE_APPROVED            EventType = 5  // Connection approved by user
+	EventType_EVENT_TYPE_MOCK_CAPTURE        EventType = 6  // Mock captured credentials or a client fingerprint
+	EventType_EVENT_TYPE_MOCK_INTERACTION    EventType = 7  // Connection handed to a mock or tarpit
+	EventType_EVENT_TYPE_ALERT               EventType = 8  // Connection forwarded by an ALLOW_ALERT action
+	EventType_EVENT_TYPE_THRESHOLD_ALERT     EventType = 9  // Open connection crossed a threshold
+	EventType_EVENT_TYPE_THRESHOLD_CLOSED    EventType = 10 // Connection closed for crossing a threshold
+	EventType_EVENT_TYPE_THRESHOLD_THROTTLED EventType = 11 // Connection throttled for crossing a threshold
 )
 
 // Enum value maps for EventType.
 var (
 	EventType_name = map[int32]string{
-		0: "EVENT_TYPE_UNSPECIFIED",
-		1: "EVENT_TYPE_CONNECTED",
-		2: "EVENT_TYPE_CLOSED",
-		3: "EVENT_TYPE_BLOCKED",
-		4: "EVENT_TYPE_PENDING_APPROVAL",
-		5: "EVENT_TYPE_APPROVED",
+		0:  "EVENT_TYPE_UNSPECIFIED",
+		1:  "EVENT_TYPE_CONNECTED",
+		2:  "EVENT_TYPE_CLOSED",
+		3:  "EVENT_TYPE_BLOCKED",
+		4:  "EVENT_TYPE_PENDING_APPROVAL",
+		5:  "EVENT_TYPE_APPROVED",
+		6:  "EVENT_TYPE_MOCK_CAPTURE",
+		7:  "EVENT_TYPE_MOCK_INTERACTION",
+		8:  "EVENT_TYPE_ALERT",
+		9:  "EVENT_TYPE_THRESHOLD_ALERT",
+		10: "EVENT_TYPE_THRESHOLD_CLOSED",
+		11: "EVENT_TYPE_THRESHOLD_THROTTLED",
 	}
 	EventType_value = map[string]int32{
-		"EVENT_TYPE_UNSPECIFIED":      0,
-		"EVENT_TYPE_CONNECTED":        1,
-		"EVENT_TYPE_CLOSED":           2,
-		"EVENT_TYPE_BLOCKED":          3,
-		"EVENT_TYPE_PENDING_APPROVAL": 4,
-		"EVENT_TYPE_APPROVED":         5,
+		"EVENT_TYPE_UNSPECIFIED":         0,
+		"EVENT_TYPE_CONNECTED":           1,
+		"EVENT_TYPE_CLOSED":              2,
+		"EVENT_TYPE_BLOCKED":             3,
+		"EVENT_TYPE_PENDING_APPROVAL":    4,
+		"EVENT_TYPE_APPROVED":            5,
+		"EVENT_TYPE_MOCK_CAPTURE":        6,
+		"EVENT_TYPE_MOCK_INTERACTION":    7,
+		"EVENT_TYPE_ALERT":               8,
+		"EVENT_TYPE_THRESHOLD_ALERT":     9,
+		"EVENT_TYPE_THRESHOLD_CLOSED":    10,
+		"EVENT_TYPE_THRESHOLD_THROTTLED": 11,
 	}
 )
 
@@ -221,11 +395,11 @@ func (x EventType) String() string {
 }
 
 func (EventType) Descriptor() protoreflect.EnumDescriptor {
-	return file_proxy_proxy_proto_enumTypes[3].Descriptor()
+	return file_proxy_proxy_proto_enumTypes[6].Descriptor()
 }
 
 func (EventType) Type() protoreflect.EnumType {
-	return &file_proxy_proxy_proto_enumTypes[3]
+	return &file_proxy_proxy_proto_enumTypes[6]
 }
 
 func (x EventType) Number() protoreflect.EnumNumber {
@@ -234,7 +408,7 @@ func (x EventType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use EventType.Descriptor instead.
 func (EventType) EnumDescriptor() ([]byte, []int) {
-	return file_proxy_proxy_proto_rawDescGZIP(), []int{3}
+	return file_proxy_proxy_proto_rawDescGZIP(), []int{6}
 }
 
 type ConfigureGeoIPRequest_Mode int32
@@ -267,11 +441,11 @@ func (x ConfigureGeoIPRequest_Mode) String() string {
 }
 
 func (ConfigureGeoIPRequest_Mode) Descriptor() protoreflect.EnumDescriptor {
-	return file_proxy_proxy_proto_enumTypes[4].Descriptor()
+	return file_proxy_proxy_proto_enumTypes[7].Descriptor()
 }
 
 func (ConfigureGeoIPRequest_Mode) Type() protoreflect.EnumType {
-	return &file_proxy_proxy_proto_enumTypes[4]
+	return &file_proxy_proxy_proto_enumTypes[7]
 }
 
 func (x ConfigureGeoIPRequest_Mode) Number() protoreflect.EnumNumber {
@@ -668,6 +842,7 @@ type CreateProxyRequest struct {
 	ClientAuthType ClientAuthType         `protobuf:"varint,11,opt,name=client_auth_type,json=clientAuthType,proto3,enum=nitella.proxy.ClientAuthType" json:"client_auth_type,omitempty"`
 	Tags           []string               `protobuf:"bytes,12,rep,name=tags,proto3" json:"tags,omitempty"` // Tags (e.g. "production", "aws")
 	HealthCheck    *HealthCheckConfig     `protobuf:"bytes,13,opt,name=health_check,json=healthCheck,proto3" json:"health_check,omitempty"`
+	Thresholds     *ConnectionThresholds  `protobuf:"bytes,14,opt,name=thresholds,proto3" json:"thresholds,omitempty"` // Default limits for forwarded connections
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -793,6 +968,13 @@ func (x *CreateProxyRequest) GetHealthCheck() *HealthCheckConfig {
 	return nil
 }
 
+func (x *CreateProxyRequest) GetThresholds() *ConnectionThresholds {
+	if x != nil {
+		return x.Thresholds
+	}
+	return nil
+}
+
 type HealthCheckConfig struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	Interval       string                 `protobuf:"bytes,1,opt,name=interval,proto3" json:"interval,omitempty"` // e.g. "10s"
@@ -1233,6 +1415,7 @@ type UpdateProxyRequest struct {
 	ClientAuthType ClientAuthType         `protobuf:"varint,12,opt,name=client_auth_type,json=clientAuthType,proto3,enum=nitella.proxy.ClientAuthType" json:"client_auth_type,omitempty"`
 	Tags           []string               `protobuf:"bytes,13,rep,name=tags,proto3" json:"tags,omitempty"`
 	HealthCheck    *HealthCheckConfig     `protobuf:"bytes,14,opt,name=health_check,json=healthCheck,proto3" json:"health_check,omitempty"`
+	Thresholds     *ConnectionThresholds  `protobuf:"bytes,15,opt,name=thresholds,proto3" json:"thresholds,omitempty"` // Replaces the listener's limits (empty = none)
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -1365,6 +1548,13 @@ func (x *UpdateProxyRequest) GetHealthCheck() *HealthCheckConfig {
 	return nil
 }
 
+func (x *UpdateProxyRequest) GetThresholds() *ConnectionThresholds {
+	if x != nil {
+		return x.Thresholds
+	}
+	return nil
+}
+
 type UpdateProxyResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
@@ -1541,8 +1731,15 @@ type ProxyStatus struct {
 	Tags              []string               `protobuf:"bytes,16,rep,name=tags,proto3" json:"tags,omitempty"`
 	HealthCheck       *HealthCheckConfig     `protobuf:"bytes,17,opt,name=health_check,json=healthCheck,proto3" json:"health_check,omitempty"`
 	HealthStatus      HealthStatus           `protobuf:"varint,18,opt,name=health_status,json=healthStatus,proto3,enum=nitella.proxy.HealthStatus" json:"health_status,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+	// Time from accept to the forwarding decision (approval waits excluded)
+	Decisions              int64 `protobuf:"varint,19,opt,name=decisions,proto3" json:"decisions,omitempty"`
+	DecisionLatencyTotalUs int64 `protobuf:"varint,20,opt,name=decision_latency_total_us,json=decisionLatencyTotalUs,proto3" json:"decision_latency_total_us,omitempty"`
+	DecisionLatencyMaxUs   int64 `protobuf:"varint,21,opt,name=decision_latency_max_us,json=decisionLatencyMaxUs,proto3" json:"decision_latency_max_us,omitempty"`
+	// Connections matched per rule ID, and those blocked by rule rate limits
+	RuleHits      map[string]int64 `protobuf:"bytes,22,rep,name=rule_hits,json=ruleHits,proto3" json:"rule_hits,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	RateLimited   int64            `protobuf:"varint,23,opt,name=rate_limited,json=rateLimited,proto3" json:"rate_limited,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ProxyStatus) Reset() {
@@ -1701,6 +1898,41 @@ func (x *ProxyStatus) GetHealthStatus() HealthStatus {
 	return HealthStatus_HEALTH_STATUS_UNKNOWN
 }
 
+func (x *ProxyStatus) GetDecisions() int64 {
+	if x != nil {
+		return x.Decisions
+	}
+	return 0
+}
+
+func (x *ProxyStatus) GetDecisionLatencyTotalUs() int64 {
+	if x != nil {
+		return x.DecisionLatencyTotalUs
+	}
+	return 0
+}
+
+func (x *ProxyStatus) GetDecisionLatencyMaxUs() int64 {
+	if x != nil {
+		return x.DecisionLatencyMaxUs
+	}
+	return 0
+}
+
+func (x *ProxyStatus) GetRuleHits() map[string]int64 {
+	if x != nil {
+		return x.RuleHits
+	}
+	return nil
+}
+
+func (x *ProxyStatus) GetRateLimited() int64 {
+	if x != nil {
+		return x.RateLimited
+	}
+	return 0
+}
+
 type ReloadRulesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Rules         []*Rule                `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
@@ -2057,11 +2289,23 @@ type Rule struct {
 	// Can be a specific "IP:Port" or a named group.
 	TargetBackend string `protobuf:"bytes,7,opt,name=target_backend,json=targetBackend,proto3" json:"target_backend,omitempty"`
 	// Additional configuration for specific actions
-	RateLimit     *RateLimitConfig `protobuf:"bytes,8,opt,name=rate_limit,json=rateLimit,proto3" json:"rate_limit,omitempty"`
-	MockResponse  *MockConfig      `protobuf:"bytes,9,opt,name=mock_response,json=mockResponse,proto3" json:"mock_response,omitempty"`
-	Expression    string           `protobuf:"bytes,10,opt,name=expression,proto3" json:"expression,omitempty"` // Traefik-style rule expression
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	RateLimit    *RateLimitConfig `protobuf:"bytes,8,opt,name=rate_limit,json=rateLimit,proto3" json:"rate_limit,omitempty"`
+	MockResponse *MockConfig      `protobuf:"bytes,9,opt,name=mock_response,json=mockResponse,proto3" json:"mock_response,omitempty"`
+	Expression   string           `protobuf:"bytes,10,opt,name=expression,proto3" json:"expression,omitempty"` // Traefik-style rule expression
+	// Lifetime: the rule only matches from not_before until expires_at (unset
+	// = no bound). Expired rules are removed or disabled by the node.
+	NotBefore *timestamp.Timestamp `protobuf:"bytes,11,opt,name=not_before,json=notBefore,proto3" json:"not_before,omitempty"`
+	ExpiresAt *timestamp.Timestamp `protobuf:"bytes,12,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	// Recurring active window in the node's local time, e.g.
+	// "Mon-Fri 09:00-17:00", "Sat,Sun" or "22:00-06:00" (empty = always).
+	Schedule string `protobuf:"bytes,13,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	// Limits for connections this rule forwards (overrides the listener's)
+	Thresholds *ConnectionThresholds `protobuf:"bytes,14,opt,name=thresholds,proto3" json:"thresholds,omitempty"`
+	// How a REQUIRE_APPROVAL rule waits for a decision (unset = hold the
+	// client silently for 2 minutes, then deny)
+	ApprovalPolicy *ApprovalPolicy `protobuf:"bytes,15,opt,name=approval_policy,json=approvalPolicy,proto3" json:"approval_policy,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *Rule) Reset() {
@@ -2164,107 +2408,70 @@ func (x *Rule) GetExpression() string {
 	return ""
 }
 
-type Condition struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Type          common.ConditionType   `protobuf:"varint,1,opt,name=type,proto3,enum=nitella.ConditionType" json:"type,omitempty"`
-	Op            common.Operator        `protobuf:"varint,2,opt,name=op,proto3,enum=nitella.Operator" json:"op,omitempty"`
-	Value         string                 `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`    // The value to match against (IP, Country Code, etc.)
-	Negate        bool                   `protobuf:"varint,4,opt,name=negate,proto3" json:"negate,omitempty"` // Invert the match result
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *Condition) Reset() {
-	*x = Condition{}
-	mi := &file_proxy_proxy_proto_msgTypes[27]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *Condition) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*Condition) ProtoMessage() {}
-
-func (x *Condition) ProtoReflect() protoreflect.Message {
-	mi := &file_proxy_proxy_proto_msgTypes[27]
+func (x *Rule) GetNotBefore() *timestamp.Timestamp {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.NotBefore
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use Condition.ProtoReflect.Descriptor instead.
-func (*Condition) Descriptor() ([]byte, []int) {
-	return file_proxy_proxy_proto_rawDescGZIP(), []int{27}
+	return nil
 }
 
-func (x *Condition) GetType() common.ConditionType {
+func (x *Rule) GetExpiresAt() *timestamp.Timestamp {
 	if x != nil {
-		return x.Type
+		return x.ExpiresAt
 	}
-	return common.ConditionType(0)
+	return nil
 }
 
-func (x *Condition) GetOp() common.Operator {
+func (x *Rule) GetSchedule() string {
 	if x != nil {
-		return x.Op
+		return x.Schedule
 	}
-	return common.Operator(0)
+	return ""
 }
 
-func (x *Condition) GetValue() string {
+func (x *Rule) GetThresholds() *ConnectionThresholds {
 	if x != nil {
-		return x.Value
+		return x.Thresholds
 	}
-	return ""
+	return nil
 }
 
-func (x *Condition) GetNegate() bool {
+func (x *Rule) GetApprovalPolicy() *ApprovalPolicy {
 	if x != nil {
-		return x.Negate
+		return x.ApprovalPolicy
 	}
-	return false
+	return nil
 }
 
-type RateLimitConfig struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	MaxConnections  int32                  `protobuf:"varint,1,opt,name=max_connections,json=maxConnections,proto3" json:"max_connections,omitempty"`
-	IntervalSeconds int32                  `protobuf:"varint,2,opt,name=interval_seconds,json=intervalSeconds,proto3" json:"interval_seconds,omitempty"`
-	// Auto-Block / Fail2Ban Logic
-	AutoBlock            bool  `protobuf:"varint,3,opt,name=auto_block,json=autoBlock,proto3" json:"auto_block,omitempty"`
-	BlockDurationSeconds int32 `protobuf:"varint,4,opt,name=block_duration_seconds,json=blockDurationSeconds,proto3" json:"block_duration_seconds,omitempty"` // Initial block time (e.g. 600)
-	// Escalation policy: [600, 3600, 86400] -> 10m, 1h, 24h.
-	// If empty, uses block_duration_seconds constantly.
-	// If last step is reached, subsequent blocks use the last step value.
-	BlockStepsSeconds []int32 `protobuf:"varint,5,rep,packed,name=block_steps_seconds,json=blockStepsSeconds,proto3" json:"block_steps_seconds,omitempty"`
-	// Heuristic for "Failure"
-	CountOnlyFailures        bool  `protobuf:"varint,6,opt,name=count_only_failures,json=countOnlyFailures,proto3" json:"count_only_failures,omitempty"`                      // If true, only count if duration < min_duration
-	FailureDurationThreshold int32 `protobuf:"varint,7,opt,name=failure_duration_threshold,json=failureDurationThreshold,proto3" json:"failure_duration_threshold,omitempty"` // Seconds. If conn lasts less than this, it's a failure.
-	unknownFields            protoimpl.UnknownFields
-	sizeCache                protoimpl.SizeCache
+// ApprovalPolicy is how long a connection waits for an approval decision,
+// what happens when none arrives, and what the client sees meanwhile.
+type ApprovalPolicy struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	TimeoutSeconds int64                  `protobuf:"varint,1,opt,name=timeout_seconds,json=timeoutSeconds,proto3" json:"timeout_seconds,omitempty"` // 0 = 120
+	OnTimeout      ApprovalTimeoutAction  `protobuf:"varint,2,opt,name=on_timeout,json=onTimeout,proto3,enum=nitella.proxy.ApprovalTimeoutAction" json:"on_timeout,omitempty"`
+	TimeoutMock    common.MockPreset      `protobuf:"varint,3,opt,name=timeout_mock,json=timeoutMock,proto3,enum=nitella.MockPreset" json:"timeout_mock,omitempty"` // For APPROVAL_TIMEOUT_MOCK (default: the listener's default mock)
+	Hold           ApprovalHold           `protobuf:"varint,4,opt,name=hold,proto3,enum=nitella.proxy.ApprovalHold" json:"hold,omitempty"`
+	Quorum         int32                  `protobuf:"varint,5,opt,name=quorum,proto3" json:"quorum,omitempty"` // Distinct approver keys that must allow (0/1 = any single decision)
+	Local          *LocalApprover         `protobuf:"bytes,6,opt,name=local,proto3" json:"local,omitempty"`    // Decide on the node; falls back to the Hub when unavailable
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *RateLimitConfig) Reset() {
-	*x = RateLimitConfig{}
-	mi := &file_proxy_proxy_proto_msgTypes[28]
+func (x *ApprovalPolicy) Reset() {
+	*x = ApprovalPolicy{}
+	mi := &file_proxy_proxy_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RateLimitConfig) String() string {
+func (x *ApprovalPolicy) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RateLimitConfig) ProtoMessage() {}
+func (*ApprovalPolicy) ProtoMessage() {}
 
-func (x *RateLimitConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_proxy_proxy_proto_msgTypes[28]
+func (x *ApprovalPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_proxy_proxy_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2275,85 +2482,80 @@ func (x *RateLimitConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RateLimitConfig.ProtoReflect.Descriptor instead.
-func (*RateLimitConfig) Descriptor() ([]byte, []int) {
-	return file_proxy_proxy_proto_rawDescGZIP(), []int{28}
-}
-
-func (x *RateLimitConfig) GetMaxConnections() int32 {
-	if x != nil {
-		return x.MaxConnections
-	}
-	return 0
+// Deprecated: Use ApprovalPolicy.ProtoReflect.Descriptor instead.
+func (*ApprovalPolicy) Descriptor() ([]byte, []int) {
+	return file_proxy_proxy_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *RateLimitConfig) GetIntervalSeconds() int32 {
+func (x *ApprovalPolicy) GetTimeoutSeconds() int64 {
 	if x != nil {
-		return x.IntervalSeconds
+		return x.TimeoutSeconds
 	}
 	return 0
 }
 
-func (x *RateLimitConfig) GetAutoBlock() bool {
+func (x *ApprovalPolicy) GetOnTimeout() ApprovalTimeoutAction {
 	if x != nil {
-		return x.AutoBlock
+		return x.OnTimeout
 	}
-	return false
+	return ApprovalTimeoutAction_APPROVAL_TIMEOUT_UNSPECIFIED
 }
 
-func (x *RateLimitConfig) GetBlockDurationSeconds() int32 {
+func (x *ApprovalPolicy) GetTimeoutMock() common.MockPreset {
 	if x != nil {
-		return x.BlockDurationSeconds
+		return x.TimeoutMock
 	}
-	return 0
+	return common.MockPreset(0)
 }
 
-func (x *RateLimitConfig) GetBlockStepsSeconds() []int32 {
+func (x *ApprovalPolicy) GetHold() ApprovalHold {
 	if x != nil {
-		return x.BlockStepsSeconds
+		return x.Hold
 	}
-	return nil
+	return ApprovalHold_APPROVAL_HOLD_UNSPECIFIED
 }
 
-func (x *RateLimitConfig) GetCountOnlyFailures() bool {
+func (x *ApprovalPolicy) GetQuorum() int32 {
 	if x != nil {
-		return x.CountOnlyFailures
+		return x.Quorum
 	}
-	return false
+	return 0
 }
 
-func (x *RateLimitConfig) GetFailureDurationThreshold() int32 {
+func (x *ApprovalPolicy) GetLocal() *LocalApprover {
 	if x != nil {
-		return x.FailureDurationThreshold
+		return x.Local
 	}
-	return 0
+	return nil
 }
 
-type MockConfig struct {
+// LocalApprover resolves approval requests without the Hub. It names an
+// approver defined on the node: one of the YAML config's approvers, or
+// "queue" for the node's --approval-socket. What runs or is called is node
+// configuration, never part of a rule. Requests and decisions are JSON
+// (see docs/APPROVAL_SYSTEM.md).
+type LocalApprover struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Preset        common.MockPreset      `protobuf:"varint,1,opt,name=preset,proto3,enum=nitella.MockPreset" json:"preset,omitempty"` // Named preset: ssh-secure, ssh-tarpit, http-403, etc.
-	Protocol      string                 `protobuf:"bytes,2,opt,name=protocol,proto3" json:"protocol,omitempty"`                      // "http", "ssh", "raw"
-	Payload       []byte                 `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`
-	DelayMs       int32                  `protobuf:"varint,4,opt,name=delay_ms,json=delayMs,proto3" json:"delay_ms,omitempty"`
+	Name          string                 `protobuf:"bytes,6,opt,name=name,proto3" json:"name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MockConfig) Reset() {
-	*x = MockConfig{}
-	mi := &file_proxy_proxy_proto_msgTypes[29]
+func (x *LocalApprover) Reset() {
+	*x = LocalApprover{}
+	mi := &file_proxy_proxy_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MockConfig) String() string {
+func (x *LocalApprover) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MockConfig) ProtoMessage() {}
+func (*LocalApprover) ProtoMessage() {}
 
-func (x *MockConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_proxy_proxy_proto_msgTypes[29]
+func (x *LocalApprover) ProtoReflect() protoreflect.Message {
+	mi := &file_proxy_proxy_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2364,62 +2566,46 @@ func (x *MockConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MockConfig.ProtoReflect.Descriptor instead.
-func (*MockConfig) Descriptor() ([]byte, []int) {
-	return file_proxy_proxy_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use LocalApprover.ProtoReflect.Descriptor instead.
+func (*LocalApprover) Descriptor() ([]byte, []int) {
+	return file_proxy_proxy_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *MockConfig) GetPreset() common.MockPreset {
+func (x *LocalApprover) GetName() string {
 	if x != nil {
-		return x.Preset
-	}
-	return common.MockPreset(0)
-}
-
-func (x *MockConfig) GetProtocol() string {
-	if x != nil {
-		return x.Protocol
+		return x.Name
 	}
 	return ""
 }
 
-func (x *MockConfig) GetPayload() []byte {
-	if x != nil {
-		return x.Payload
-	}
-	return nil
-}
-
-func (x *MockConfig) GetDelayMs() int32 {
-	if x != nil {
-		return x.DelayMs
-	}
-	return 0
-}
-
-type AddRuleRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProxyId       string                 `protobuf:"bytes,1,opt,name=proxy_id,json=proxyId,proto3" json:"proxy_id,omitempty"`
-	Rule          *Rule                  `protobuf:"bytes,2,opt,name=rule,proto3" json:"rule,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// ConnectionThresholds are limits checked while a forwarded connection is
+// open. A zero limit is not checked.
+type ConnectionThresholds struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	MaxBytesOut            int64                  `protobuf:"varint,1,opt,name=max_bytes_out,json=maxBytesOut,proto3" json:"max_bytes_out,omitempty"`                                    // Bytes sent to the client by one connection
+	MaxSourceBytesOutHour  int64                  `protobuf:"varint,2,opt,name=max_source_bytes_out_hour,json=maxSourceBytesOutHour,proto3" json:"max_source_bytes_out_hour,omitempty"`  // Bytes sent to one source IP over the last hour
+	MaxDurationSeconds     int64                  `protobuf:"varint,3,opt,name=max_duration_seconds,json=maxDurationSeconds,proto3" json:"max_duration_seconds,omitempty"`               // Connection lifetime
+	Action                 ThresholdAction        `protobuf:"varint,4,opt,name=action,proto3,enum=nitella.proxy.ThresholdAction" json:"action,omitempty"`                                // What crossing a limit does (default: alert)
+	ThrottleBytesPerSecond int64                  `protobuf:"varint,5,opt,name=throttle_bytes_per_second,json=throttleBytesPerSecond,proto3" json:"throttle_bytes_per_second,omitempty"` // Rate for THRESHOLD_ACTION_THROTTLE (default 64 KiB/s)
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
 }
 
-func (x *AddRuleRequest) Reset() {
-	*x = AddRuleRequest{}
-	mi := &file_proxy_proxy_proto_msgTypes[30]
+func (x *ConnectionThresholds) Reset() {
+	*x = ConnectionThresholds{}
+	mi := &file_proxy_proxy_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddRuleRequest) String() string {
+func (x *ConnectionThresholds) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddRuleRequest) ProtoMessage() {}
+func (*ConnectionThresholds) ProtoMessage() {}
 
-func (x *AddRuleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proxy_proxy_proto_msgTypes[30]
+func (x *ConnectionThresholds) ProtoReflect() protoreflect.Message {
+	mi := &file_proxy_proxy_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2430,48 +2616,71 @@ func (x *AddRuleRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddRuleRequest.ProtoReflect.Descriptor instead.
-func (*AddRuleRequest) Descriptor() ([]byte, []int) {
-	return file_proxy_proxy_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use ConnectionThresholds.ProtoReflect.Descriptor instead.
+func (*ConnectionThresholds) Descriptor() ([]byte, []int) {
+	return file_proxy_proxy_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *AddRuleRequest) GetProxyId() string {
+func (x *ConnectionThresholds) GetMaxBytesOut() int64 {
 	if x != nil {
-		return x.ProxyId
+		return x.MaxBytesOut
 	}
-	return ""
+	return 0
 }
 
-func (x *AddRuleRequest) GetRule() *Rule {
+func (x *ConnectionThresholds) GetMaxSourceBytesOutHour() int64 {
 	if x != nil {
-		return x.Rule
+		return x.MaxSourceBytesOutHour
 	}
-	return nil
+	return 0
 }
 
-type RemoveRuleRequest struct {
+func (x *ConnectionThresholds) GetMaxDurationSeconds() int64 {
+	if x != nil {
+		return x.MaxDurationSeconds
+	}
+	return 0
+}
+
+func (x *ConnectionThresholds) GetAction() ThresholdAction {
+	if x != nil {
+		return x.Action
+	}
+	return ThresholdAction_THRESHOLD_ACTION_UNSPECIFIED
+}
+
+func (x *ConnectionThresholds) GetThrottleBytesPerSecond() int64 {
+	if x != nil {
+		return x.ThrottleBytesPerSecond
+	}
+	return 0
+}
+
+type Condition struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProxyId       string                 `protobuf:"bytes,1,opt,name=proxy_id,json=proxyId,proto3" json:"proxy_id,omitempty"`
-	RuleId        string                 `protobuf:"bytes,2,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	Type          common.ConditionType   `protobuf:"varint,1,opt,name=type,proto3,enum=nitella.ConditionType" json:"type,omitempty"`
+	Op            common.Operator        `protobuf:"varint,2,opt,name=op,proto3,enum=nitella.Operator" json:"op,omitempty"`
+	Value         string                 `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`    // The value to match against (IP, Country Code, etc.)
+	Negate        bool                   `protobuf:"varint,4,opt,name=negate,proto3" json:"negate,omitempty"` // Invert the match result
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RemoveRuleRequest) Reset() {
-	*x = RemoveRuleRequest{}
-	mi := &file_proxy_proxy_proto_msgTypes[31]
+func (x *Condition) Reset() {
+	*x = Condition{}
+	mi := &file_proxy_proxy_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RemoveRuleRequest) String() string {
+func (x *Condition) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveRuleRequest) ProtoMessage() {}
+func (*Condition) ProtoMessage() {}
 
-func (x *RemoveRuleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proxy_proxy_proto_msgTypes[31]
+func (x *Condition) ProtoReflect() protoreflect.Message {
+	mi := &file_proxy_proxy_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2482,47 +2691,72 @@ func (x *RemoveRuleRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveRuleRequest.ProtoReflect.Descriptor instead.
-func (*RemoveRuleRequest) Descriptor() ([]byte, []int) {
-	return file_proxy_proxy_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use Condition.ProtoReflect.Descriptor instead.
+func (*Condition) Descriptor() ([]byte, []int) {
+	return file_proxy_proxy_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *RemoveRuleRequest) GetProxyId() string {
+func (x *Condition) GetType() common.ConditionType {
 	if x != nil {
-		return x.ProxyId
+		return x.Type
 	}
-	return ""
+	return common.ConditionType(0)
 }
 
-func (x *RemoveRuleRequest) GetRuleId() string {
+func (x *Condition) GetOp() common.Operator {
 	if x != nil {
-		return x.RuleId
+		return x.Op
+	}
+	return common.Operator(0)
+}
+
+func (x *Condition) GetValue() string {
+	if x != nil {
+		return x.Value
 	}
 	return ""
 }
 
-type ListRulesRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProxyId       string                 `protobuf:"bytes,1,opt,name=proxy_id,json=proxyId,proto3" json:"proxy_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *Condition) GetNegate() bool {
+	if x != nil {
+		return x.Negate
+	}
+	return false
 }
 
-func (x *ListRulesRequest) Reset() {
-	*x = ListRulesRequest{}
-	mi := &file_proxy_proxy_proto_msgTypes[32]
+type RateLimitConfig struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	MaxConnections  int32                  `protobuf:"varint,1,opt,name=max_connections,json=maxConnections,proto3" json:"max_connections,omitempty"`
+	IntervalSeconds int32                  `protobuf:"varint,2,opt,name=interval_seconds,json=intervalSeconds,proto3" json:"interval_seconds,omitempty"`
+	// Auto-Block / Fail2Ban Logic
+	AutoBlock            bool  `protobuf:"varint,3,opt,name=auto_block,json=autoBlock,proto3" json:"auto_block,omitempty"`
+	BlockDurationSeconds int32 `protobuf:"varint,4,opt,name=block_duration_seconds,json=blockDurationSeconds,proto3" json:"block_duration_seconds,omitempty"` // Initial block time (e.g. 600)
+	// Escalation policy: [600, 3600, 86400] -> 10m, 1h, 24h.
+	// If empty, uses block_duration_seconds constantly.
+	// If last step is reached, subsequent blocks use the last step value.
+	BlockStepsSeconds []int32 `protobuf:"varint,5,rep,packed,name=block_steps_seconds,json=blockStepsSeconds,proto3" json:"block_steps_seconds,omitempty"`
+	// Heuristic for "Failure"
+	CountOnlyFailures        bool  `protobuf:"varint,6,opt,name=count_only_failures,json=countOnlyFailures,proto3" json:"count_only_failures,omitempty"`                      // If true, only count if duration < min_duration
+	FailureDurationThreshold int32 `protobuf:"varint,7,opt,name=failure_duration_threshold,json=failureDurationThreshold,proto3" json:"failure_duration_threshold,omitempty"` // Seconds. If conn lasts less than this, it's a failure.
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *RateLimitConfig) Reset() {
+	*x = RateLimitConfig{}
+	mi := &file_proxy_proxy_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListRulesRequest) String() string {
+func (x *RateLimitConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListRulesRequest) ProtoMessage() {}
+func (*RateLimitConfig) ProtoMessage() {}
 
-func (x *ListRulesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proxy_proxy_proto_msgTypes[32]
+func (x *RateLimitConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_proxy_proxy_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2533,83 +2767,106 @@ func (x *ListRulesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListRulesRequest.ProtoReflect.Descriptor instead.
-func (*ListRulesRequest) Descriptor() ([]byte, []int) {
-	return file_proxy_proxy_proto_rawDescGZIP(), []int{32}
+// Deprecated: Use RateLimitConfig.ProtoReflect.Descriptor instead.
+func (*RateLimitConfig) Descriptor() ([]byte, []int) {
+	return file_proxy_proxy_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *ListRulesRequest) GetProxyId() string {
+func (x *RateLimitConfig) GetMaxConnections() int32 {
 	if x != nil {
-		return x.ProxyId
+		return x.MaxConnections
 	}
-	return ""
+	return 0
 }
 
-type ListRulesResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Rules         []*Rule                `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *RateLimitConfig) GetIntervalSeconds() int32 {
+	if x != nil {
+		return x.IntervalSeconds
+	}
+	return 0
 }
 
-func (x *ListRulesResponse) Reset() {
-	*x = ListRulesResponse{}
-	mi := &file_proxy_proxy_proto_msgTypes[33]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *RateLimitConfig) GetAutoBlock() bool {
+	if x != nil {
+		return x.AutoBlock
+	}
+	return false
 }
 
-func (x *ListRulesResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *RateLimitConfig) GetBlockDurationSeconds() int32 {
+	if x != nil {
+		return x.BlockDurationSeconds
+	}
+	return 0
 }
 
-func (*ListRulesResponse) ProtoMessage() {}
-
-func (x *ListRulesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proxy_proxy_proto_msgTypes[33]
+func (x *RateLimitConfig) GetBlockStepsSeconds() []int32 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.BlockStepsSeconds
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use ListRulesResponse.ProtoReflect.Descriptor instead.
-func (*ListRulesResponse) Descriptor() ([]byte, []int) {
-	return file_proxy_proxy_proto_rawDescGZIP(), []int{33}
+func (x *RateLimitConfig) GetCountOnlyFailures() bool {
+	if x != nil {
+		return x.CountOnlyFailures
+	}
+	return false
 }
 
-func (x *ListRulesResponse) GetRules() []*Rule {
+func (x *RateLimitConfig) GetFailureDurationThreshold() int32 {
 	if x != nil {
-		return x.Rules
+		return x.FailureDurationThreshold
 	}
-	return nil
+	return 0
 }
 
-type ListProxiesRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type MockConfig struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Preset      common.MockPreset      `protobuf:"varint,1,opt,name=preset,proto3,enum=nitella.MockPreset" json:"preset,omitempty"` // Named preset: ssh-secure, ssh-tarpit, http-403, etc.
+	Protocol    string                 `protobuf:"bytes,2,opt,name=protocol,proto3" json:"protocol,omitempty"`                      // "http", "ssh", "raw"
+	Payload     []byte                 `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`
+	DelayMs     int32                  `protobuf:"varint,4,opt,name=delay_ms,json=delayMs,proto3" json:"delay_ms,omitempty"`
+	AcceptLogin bool                   `protobuf:"varint,5,opt,name=accept_login,json=acceptLogin,proto3" json:"accept_login,omitempty"` // SSH/Telnet: accept captured credentials into a fake shell instead of denying
+	// HTTP honeypot (protocol "http"): serve a route table instead of a fixed page
+	HttpRoutePacks     []string `protobuf:"bytes,6,rep,name=http_route_packs,json=httpRoutePacks,proto3" json:"http_route_packs,omitempty"`              // Built-in packs: wordpress, phpmyadmin, jenkins, admin-panel
+	HttpRoutesFile     string   `protobuf:"bytes,7,opt,name=http_routes_file,json=httpRoutesFile,proto3" json:"http_routes_file,omitempty"`              // YAML route table on the node; takes precedence over packs
+	CanaryPaths        []string `protobuf:"bytes,8,rep,name=canary_paths,json=canaryPaths,proto3" json:"canary_paths,omitempty"`                         // Paths ("prefix*" allowed) that globally block the source IP
+	CanaryBlockSeconds int32    `protobuf:"varint,9,opt,name=canary_block_seconds,json=canaryBlockSeconds,proto3" json:"canary_block_seconds,omitempty"` // Block duration for canary hits (0 = honeypot block duration)
+	// SMB (protocol "smb"): identity revealed to scanners
+	SmbDialect   string `protobuf:"bytes,10,opt,name=smb_dialect,json=smbDialect,proto3" json:"smb_dialect,omitempty"`         // "2.0.2", "2.1", "3.0", "3.0.2", "3.1.1" (default "3.1.1")
+	SmbOsVersion string `protobuf:"bytes,11,opt,name=smb_os_version,json=smbOsVersion,proto3" json:"smb_os_version,omitempty"` // Windows version "major.minor.build" (default "10.0.17763")
+	SmbHostname  string `protobuf:"bytes,12,opt,name=smb_hostname,json=smbHostname,proto3" json:"smb_hostname,omitempty"`      // NetBIOS computer name (default "FILESRV01")
+	// Scripted mock (protocol "script"): expect/send conversation, see docs/MOCK.md
+	ScriptFile   string `protobuf:"bytes,13,opt,name=script_file,json=scriptFile,proto3" json:"script_file,omitempty"`       // YAML script on the node, reloaded when it changes
+	Script       string `protobuf:"bytes,14,opt,name=script,proto3" json:"script,omitempty"`                                 // Inline YAML script, used when script_file is empty
+	ClonedPreset string `protobuf:"bytes,15,opt,name=cloned_preset,json=clonedPreset,proto3" json:"cloned_preset,omitempty"` // Serve a banner cloned from a real backend (see CloneBannerRequest)
+	// TLS-wrapped mock (HTTPS, SMTPS, ...): handshake with a generated
+	// self-signed certificate, record the client's JA3/JA4 fingerprint, then
+	// run the protocol mock inside the TLS session
+	Tls             bool     `protobuf:"varint,16,opt,name=tls,proto3" json:"tls,omitempty"`
+	TlsCommonName   string   `protobuf:"bytes,17,opt,name=tls_common_name,json=tlsCommonName,proto3" json:"tls_common_name,omitempty"`     // Certificate subject CN (default: client SNI, else "localhost")
+	TlsOrganization string   `protobuf:"bytes,18,opt,name=tls_organization,json=tlsOrganization,proto3" json:"tls_organization,omitempty"` // Certificate subject O (optional)
+	TlsSans         []string `protobuf:"bytes,19,rep,name=tls_sans,json=tlsSans,proto3" json:"tls_sans,omitempty"`                         // DNS names and IPs (default: the CN)
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *ListProxiesRequest) Reset() {
-	*x = ListProxiesRequest{}
-	mi := &file_proxy_proxy_proto_msgTypes[34]
+func (x *MockConfig) Reset() {
+	*x = MockConfig{}
+	mi := &file_proxy_proxy_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListProxiesRequest) String() string {
+func (x *MockConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListProxiesRequest) ProtoMessage() {}
+func (*MockConfig) ProtoMessage() {}
 
-func (x *ListProxiesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proxy_proxy_proto_msgTypes[34]
+func (x *MockConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_proxy_proxy_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2620,33 +2877,1144 @@ func (x *ListProxiesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListProxiesRequest.ProtoReflect.Descriptor instead.
-func (*ListProxiesRequest) Descriptor() ([]byte, []int) {
-	return file_proxy_proxy_proto_rawDescGZIP(), []int{34}
+// Deprecated: Use MockConfig.ProtoReflect.Descriptor instead.
+func (*MockConfig) Descriptor() ([]byte, []int) {
+	return file_proxy_proxy_proto_rawDescGZIP(), []int{32}
 }
 
-type ListProxiesResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Proxies       []*ProxyStatus         `protobuf:"bytes,1,rep,name=proxies,proto3" json:"proxies,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *MockConfig) GetPreset() common.MockPreset {
+	if x != nil {
+		return x.Preset
+	}
+	return common.MockPreset(0)
 }
 
-func (x *ListProxiesResponse) Reset() {
-	*x = ListProxiesResponse{}
+func (x *MockConfig) GetProtocol() string {
+	if x != nil {
+		return x.Protocol
+	}
+	return ""
+}
+
+func (x *MockConfig) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *MockConfig) GetDelayMs() int32 {
+	if x != nil {
+		return x.DelayMs
+	}
+	return 0
+}
+
+func (x *MockConfig) GetAcceptLogin() bool {
+	if x != nil {
+		return x.AcceptLogin
+	}
+	return false
+}
+
+func (x *MockConfig) GetHttpRoutePacks() []string {
+	if x != nil {
+		return x.HttpRoutePacks
+	}
+	return nil
+}
+
+func (x *MockConfig) GetHttpRoutesFile() string {
+	if x != nil {
+		return x.HttpRoutesFile
+	}
+	return ""
+}
+
+func (x *MockConfig) GetCanaryPaths() []string {
+	if x != nil {
+		return x.CanaryPaths
+	}
+	return nil
+}
+
+func (x *MockConfig) GetCanaryBlockSeconds() int32 {
+	if x != nil {
+		return x.CanaryBlockSeconds
+	}
+	return 0
+}
+
+func (x *MockConfig) GetSmbDialect() string {
+	if x != nil {
+		return x.SmbDialect
+	}
+	return ""
+}
+
+func (x *MockConfig) GetSmbOsVersion() string {
+	if x != nil {
+		return x.SmbOsVersion
+	}
+	return ""
+}
+
+func (x *MockConfig) GetSmbHostname() string {
+	if x != nil {
+		return x.SmbHostname
+	}
+	return ""
+}
+
+func (x *MockConfig) GetScriptFile() string {
+	if x != nil {
+		return x.ScriptFile
+	}
+	return ""
+}
+
+func (x *MockConfig) GetScript() string {
+	if x != nil {
+		return x.Script
+	}
+	return ""
+}
+
+func (x *MockConfig) GetClonedPreset() string {
+	if x != nil {
+		return x.ClonedPreset
+	}
+	return ""
+}
+
+func (x *MockConfig) GetTls() bool {
+	if x != nil {
+		return x.Tls
+	}
+	return false
+}
+
+func (x *MockConfig) GetTlsCommonName() string {
+	if x != nil {
+		return x.TlsCommonName
+	}
+	return ""
+}
+
+func (x *MockConfig) GetTlsOrganization() string {
+	if x != nil {
+		return x.TlsOrganization
+	}
+	return ""
+}
+
+func (x *MockConfig) GetTlsSans() []string {
+	if x != nil {
+		return x.TlsSans
+	}
+	return nil
+}
+
+type AddRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProxyId       string                 `protobuf:"bytes,1,opt,name=proxy_id,json=proxyId,proto3" json:"proxy_id,omitempty"`
+	Rule          *Rule                  `protobuf:"bytes,2,opt,name=rule,proto3" json:"rule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddRuleRequest) Reset() {
+	*x = AddRuleRequest{}
+	mi := &file_proxy_proxy_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddRuleRequest) ProtoMessage() {}
+
+func (x *AddRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proxy_proxy_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddRuleRequest.ProtoReflect.Descriptor instead.
+func (*AddRuleRequest) Descriptor() ([]byte, []int) {
+	return file_proxy_proxy_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *AddRuleRequest) GetProxyId() string {
+	if x != nil {
+		return x.ProxyId
+	}
+	return ""
+}
+
+func (x *AddRuleRequest) GetRule() *Rule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+type RemoveRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProxyId       string                 `protobuf:"bytes,1,opt,name=proxy_id,json=proxyId,proto3" json:"proxy_id,omitempty"`
+	RuleId        string                 `protobuf:"bytes,2,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveRuleRequest) Reset() {
+	*x = RemoveRuleRequest{}
+	mi := &file_proxy_proxy_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveRuleRequest) ProtoMessage() {}
+
+func (x *RemoveRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proxy_proxy_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveRuleRequest.ProtoReflect.Descriptor instead.
+func (*RemoveRuleRequest) Descriptor() ([]byte, []int) {
+	return file_proxy_proxy_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *RemoveRuleRequest) GetProxyId() string {
+	if x != nil {
+		return x.ProxyId
+	}
+	return ""
+}
+
+func (x *RemoveRuleRequest) GetRuleId() string {
+	if x != nil {
+		return x.RuleId
+	}
+	return ""
+}
+
+type ListRulesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProxyId       string                 `protobuf:"bytes,1,opt,name=proxy_id,json=proxyId,proto3" json:"proxy_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRulesRequest) Reset() {
+	*x = ListRulesRequest{}
+	mi := &file_proxy_proxy_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRulesRequest) ProtoMessage() {}
+
+func (x *ListRulesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proxy_proxy_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRulesRequest.ProtoReflect.Descriptor instead.
+func (*ListRulesRequest) Descriptor() ([]byte, []int) {
+	return file_proxy_proxy_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ListRulesRequest) GetProxyId() string {
+	if x != nil {
+		return x.ProxyId
+	}
+	return ""
+}
+
+type ListRulesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rules         []*Rule                `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRulesResponse) Reset() {
+	*x = ListRulesResponse{}
+	mi := &file_proxy_proxy_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRulesResponse) ProtoMessage() {}
+
+func (x *ListRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proxy_proxy_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRulesResponse.ProtoReflect.Descriptor instead.
+func (*ListRulesResponse) Descriptor() ([]byte, []int) {
+	return file_proxy_proxy_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ListRulesResponse) GetRules() []*Rule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+type ListProxiesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProxiesRequest) Reset() {
+	*x = ListProxiesRequest{}
+	mi := &file_proxy_proxy_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProxiesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProxiesRequest) ProtoMessage() {}
+
+func (x *ListProxiesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proxy_proxy_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProxiesRequest.ProtoReflect.Descriptor instead.
+func (*ListProxiesRequest) Descriptor() ([]byte, []int) {
+	return file_proxy_proxy_proto_rawDescGZIP(), []int{37}
+}
+
+type ListProxiesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Proxies       []*ProxyStatus         `protobuf:"bytes,1,rep,name=proxies,proto3" json:"proxies,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProxiesResponse) Reset() {
+	*x = ListProxiesResponse{}
+	mi := &file_proxy_proxy_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProxiesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProxiesResponse) ProtoMessage() {}
+
+func (x *ListProxiesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proxy_proxy_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProxiesResponse.ProtoReflect.Descriptor instead.
+func (*ListProxiesResponse) Descriptor() ([]byte, []int) {
+	return file_proxy_proxy_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ListProxiesResponse) GetProxies() []*ProxyStatus {
+	if x != nil {
+		return x.Proxies
+	}
+	return nil
+}
+
+type BlockIPRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Ip              string                 `protobuf:"bytes,1,opt,name=ip,proto3" json:"ip,omitempty"`
+	DurationSeconds int64                  `protobuf:"varint,2,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"` // 0 = indefinite
+	Reason          string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *BlockIPRequest) Reset() {
+	*x = BlockIPRequest{}
+	mi := &file_proxy_proxy_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockIPRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockIPRequest) ProtoMessage() {}
+
+func (x *BlockIPRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proxy_proxy_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockIPRequest.ProtoReflect.Descriptor instead.
+func (*BlockIPRequest) Descriptor() ([]byte, []int) {
+	return file_proxy_proxy_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *BlockIPRequest) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+func (x *BlockIPRequest) GetDurationSeconds() int64 {
+	if x != nil {
+		return x.DurationSeconds
+	}
+	return 0
+}
+
+func (x *BlockIPRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type AllowIPRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Ip              string                 `protobuf:"bytes,1,opt,name=ip,proto3" json:"ip,omitempty"`
+	DurationSeconds int64                  `protobuf:"varint,2,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *AllowIPRequest) Reset() {
+	*x = AllowIPRequest{}
+	mi := &file_proxy_proxy_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AllowIPRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AllowIPRequest) ProtoMessage() {}
+
+func (x *AllowIPRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proxy_proxy_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AllowIPRequest.ProtoReflect.Descriptor instead.
+func (*AllowIPRequest) Descriptor() ([]byte, []int) {
+	return file_proxy_proxy_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *AllowIPRequest) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+func (x *AllowIPRequest) GetDurationSeconds() int64 {
+	if x != nil {
+		return x.DurationSeconds
+	}
+	return 0
+}
+
+type GlobalRule struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                              // Human-readable description
+	SourceIp      string                 `protobuf:"bytes,3,opt,name=source_ip,json=sourceIp,proto3" json:"source_ip,omitempty"`      // IP or CIDR
+	Action        common.ActionType      `protobuf:"varint,4,opt,name=action,proto3,enum=nitella.ActionType" json:"action,omitempty"` // ALLOW or BLOCK
+	ExpiresAt     *timestamp.Timestamp   `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`   // Zero means permanent
+	CreatedAt     *timestamp.Timestamp   `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GlobalRule) Reset() {
+	*x = GlobalRule{}
+	mi := &file_proxy_proxy_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GlobalRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GlobalRule) ProtoMessage() {}
+
+func (x *GlobalRule) ProtoReflect() protoreflect.Message {
+	mi := &file_proxy_proxy_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GlobalRule.ProtoReflect.Descriptor instead.
+func (*GlobalRule) Descriptor() ([]byte, []int) {
+	return file_proxy_proxy_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *GlobalRule) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GlobalRule) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GlobalRule) GetSourceIp() string {
+	if x != nil {
+		return x.SourceIp
+	}
+	return ""
+}
+
+func (x *GlobalRule) GetAction() common.ActionType {
+	if x != nil {
+		return x.Action
+	}
+	return common.ActionType(0)
+}
+
+func (x *GlobalRule) GetExpiresAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *GlobalRule) GetCreatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ListGlobalRulesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListGlobalRulesRequest) Reset() {
+	*x = ListGlobalRulesRequest{}
+	mi := &file_proxy_proxy_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGlobalRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGlobalRulesRequest) ProtoMessage() {}
+
+func (x *ListGlobalRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proxy_proxy_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGlobalRulesRequest.ProtoReflect.Descriptor instead.
+func (*ListGlobalRulesRequest) Descriptor() ([]byte, []int) {
+	return file_proxy_proxy_proto_rawDescGZIP(), []int{42}
+}
+
+type ListGlobalRulesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rules         []*GlobalRule          `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListGlobalRulesResponse) Reset() {
+	*x = ListGlobalRulesResponse{}
+	mi := &file_proxy_proxy_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGlobalRulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGlobalRulesResponse) ProtoMessage() {}
+
+func (x *ListGlobalRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proxy_proxy_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGlobalRulesResponse.ProtoReflect.Descriptor instead.
+func (*ListGlobalRulesResponse) Descriptor() ([]byte, []int) {
+	return file_proxy_proxy_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ListGlobalRulesResponse) GetRules() []*GlobalRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+type RemoveGlobalRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RuleId        string                 `protobuf:"bytes,1,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveGlobalRuleRequest) Reset() {
+	*x = RemoveGlobalRuleRequest{}
+	mi := &file_proxy_proxy_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveGlobalRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveGlobalRuleRequest) ProtoMessage() {}
+
+func (x *RemoveGlobalRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proxy_proxy_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveGlobalRuleRequest.ProtoReflect.Descriptor instead.
+func (*RemoveGlobalRuleRequest) Descriptor() ([]byte, []int) {
+	return file_proxy_proxy_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *RemoveGlobalRuleRequest) GetRuleId() string {
+	if x != nil {
+		return x.RuleId
+	}
+	return ""
+}
+
+type RemoveGlobalRuleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	ErrorMessage  string                 `protobuf:"bytes,2,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveGlobalRuleResponse) Reset() {
+	*x = RemoveGlobalRuleResponse{}
+	mi := &file_proxy_proxy_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveGlobalRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveGlobalRuleResponse) ProtoMessage() {}
+
+func (x *RemoveGlobalRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proxy_proxy_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveGlobalRuleResponse.ProtoReflect.Descriptor instead.
+func (*RemoveGlobalRuleResponse) Descriptor() ([]byte, []int) {
+	return file_proxy_proxy_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *RemoveGlobalRuleResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *RemoveGlobalRuleResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+type StreamConnectionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ActiveOnly    bool                   `protobuf:"varint,1,opt,name=active_only,json=activeOnly,proto3" json:"active_only,omitempty"`      // If true, only streams currently active events
+	ViewerPubkey  []byte                 `protobuf:"bytes,2,opt,name=viewer_pubkey,json=viewerPubkey,proto3" json:"viewer_pubkey,omitempty"` // Viewer's Ed25519 public key for E2E encryption
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamConnectionsRequest) Reset() {
+	*x = StreamConnectionsRequest{}
+	mi := &file_proxy_proxy_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamConnectionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamConnectionsRequest) ProtoMessage() {}
+
+func (x *StreamConnectionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proxy_proxy_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamConnectionsRequest.ProtoReflect.Descriptor instead.
+func (*StreamConnectionsRequest) Descriptor() ([]byte, []int) {
+	return file_proxy_proxy_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *StreamConnectionsRequest) GetActiveOnly() bool {
+	if x != nil {
+		return x.ActiveOnly
+	}
+	return false
+}
+
+func (x *StreamConnectionsRequest) GetViewerPubkey() []byte {
+	if x != nil {
+		return x.ViewerPubkey
+	}
+	return nil
+}
+
+type ConnectionEvent struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	ConnId     string                 `protobuf:"bytes,1,opt,name=conn_id,json=connId,proto3" json:"conn_id,omitempty"`
+	SourceIp   string                 `protobuf:"bytes,2,opt,name=source_ip,json=sourceIp,proto3" json:"source_ip,omitempty"`
+	SourcePort int32                  `protobuf:"varint,3,opt,name=source_port,json=sourcePort,proto3" json:"source_port,omitempty"`
+	TargetAddr string                 `protobuf:"bytes,4,opt,name=target_addr,json=targetAddr,proto3" json:"target_addr,omitempty"`
+	EventType  EventType              `protobuf:"varint,5,opt,name=event_type,json=eventType,proto3,enum=nitella.proxy.EventType" json:"event_type,omitempty"`
+	Timestamp  int64                  `protobuf:"varint,6,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// Context
+	RuleMatched string            `protobuf:"bytes,7,opt,name=rule_matched,json=ruleMatched,proto3" json:"rule_matched,omitempty"` // Rule ID or Name
+	ActionTaken common.ActionType `protobuf:"varint,8,opt,name=action_taken,json=actionTaken,proto3,enum=nitella.ActionType" json:"action_taken,omitempty"`
+	// Stats (for CLOSED events)
+	BytesIn  int64           `protobuf:"varint,9,opt,name=bytes_in,json=bytesIn,proto3" json:"bytes_in,omitempty"`
+	BytesOut int64           `protobuf:"varint,10,opt,name=bytes_out,json=bytesOut,proto3" json:"bytes_out,omitempty"`
+	Geo      *common.GeoInfo `protobuf:"bytes,11,opt,name=geo,proto3" json:"geo,omitempty"`
+	// Attacker input captured by a mock (EVENT_TYPE_MOCK_CAPTURE only)
+	Capture *MockCapture `protobuf:"bytes,12,opt,name=capture,proto3" json:"capture,omitempty"`
+	// Limit crossed (EVENT_TYPE_THRESHOLD_* only): "bytes_out",
+	// "source_bytes_out_hour" or "duration"
+	Threshold     string `protobuf:"bytes,13,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConnectionEvent) Reset() {
+	*x = ConnectionEvent{}
+	mi := &file_proxy_proxy_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConnectionEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConnectionEvent) ProtoMessage() {}
+
+func (x *ConnectionEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proxy_proxy_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConnectionEvent.ProtoReflect.Descriptor instead.
+func (*ConnectionEvent) Descriptor() ([]byte, []int) {
+	return file_proxy_proxy_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ConnectionEvent) GetConnId() string {
+	if x != nil {
+		return x.ConnId
+	}
+	return ""
+}
+
+func (x *ConnectionEvent) GetSourceIp() string {
+	if x != nil {
+		return x.SourceIp
+	}
+	return ""
+}
+
+func (x *ConnectionEvent) GetSourcePort() int32 {
+	if x != nil {
+		return x.SourcePort
+	}
+	return 0
+}
+
+func (x *ConnectionEvent) GetTargetAddr() string {
+	if x != nil {
+		return x.TargetAddr
+	}
+	return ""
+}
+
+func (x *ConnectionEvent) GetEventType() EventType {
+	if x != nil {
+		return x.EventType
+	}
+	return EventType_EVENT_TYPE_UNSPECIFIED
+}
+
+func (x *ConnectionEvent) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *ConnectionEvent) GetRuleMatched() string {
+	if x != nil {
+		return x.RuleMatched
+	}
+	return ""
+}
+
+func (x *ConnectionEvent) GetActionTaken() common.ActionType {
+	if x != nil {
+		return x.ActionTaken
+	}
+	return common.ActionType(0)
+}
+
+func (x *ConnectionEvent) GetBytesIn() int64 {
+	if x != nil {
+		return x.BytesIn
+	}
+	return 0
+}
+
+func (x *ConnectionEvent) GetBytesOut() int64 {
+	if x != nil {
+		return x.BytesOut
+	}
+	return 0
+}
+
+func (x *ConnectionEvent) GetGeo() *common.GeoInfo {
+	if x != nil {
+		return x.Geo
+	}
+	return nil
+}
+
+func (x *ConnectionEvent) GetCapture() *MockCapture {
+	if x != nil {
+		return x.Capture
+	}
+	return nil
+}
+
+func (x *ConnectionEvent) GetThreshold() string {
+	if x != nil {
+		return x.Threshold
+	}
+	return ""
+}
+
+// MockCapture is data an attacker sent to a mock (honeypot) listener.
+// It is never forwarded to a backend.
+type MockCapture struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Protocol      string                 `protobuf:"bytes,1,opt,name=protocol,proto3" json:"protocol,omitempty"` // "ssh", "http", ...
+	Kind          string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`         // "client_info", "password", "publickey", "download", "http_request"
+	Username      string                 `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
+	Password      string                 `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"`
+	Fields        map[string]string      `protobuf:"bytes,5,rep,name=fields,proto3" json:"fields,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // Protocol specific: client_version, hassh, key_fingerprint, ...
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MockCapture) Reset() {
+	*x = MockCapture{}
+	mi := &file_proxy_proxy_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MockCapture) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MockCapture) ProtoMessage() {}
+
+func (x *MockCapture) ProtoReflect() protoreflect.Message {
+	mi := &file_proxy_proxy_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MockCapture.ProtoReflect.Descriptor instead.
+func (*MockCapture) Descriptor() ([]byte, []int) {
+	return file_proxy_proxy_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *MockCapture) GetProtocol() string {
+	if x != nil {
+		return x.Protocol
+	}
+	return ""
+}
+
+func (x *MockCapture) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *MockCapture) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *MockCapture) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *MockCapture) GetFields() map[string]string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+type StreamMetricsRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	IntervalSeconds int32                  `protobuf:"varint,1,opt,name=interval_seconds,json=intervalSeconds,proto3" json:"interval_seconds,omitempty"`
+	ViewerPubkey    []byte                 `protobuf:"bytes,2,opt,name=viewer_pubkey,json=viewerPubkey,proto3" json:"viewer_pubkey,omitempty"` // Viewer's Ed25519 public key for E2E encryption
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *StreamMetricsRequest) Reset() {
+	*x = StreamMetricsRequest{}
+	mi := &file_proxy_proxy_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamMetricsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamMetricsRequest) ProtoMessage() {}
+
+func (x *StreamMetricsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proxy_proxy_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamMetricsRequest.ProtoReflect.Descriptor instead.
+func (*StreamMetricsRequest) Descriptor() ([]byte, []int) {
+	return file_proxy_proxy_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *StreamMetricsRequest) GetIntervalSeconds() int32 {
+	if x != nil {
+		return x.IntervalSeconds
+	}
+	return 0
+}
+
+func (x *StreamMetricsRequest) GetViewerPubkey() []byte {
+	if x != nil {
+		return x.ViewerPubkey
+	}
+	return nil
+}
+
+type MetricsSample struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Timestamp         int64                  `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	ActiveConns       int64                  `protobuf:"varint,2,opt,name=active_conns,json=activeConns,proto3" json:"active_conns,omitempty"`
+	TotalConns        int64                  `protobuf:"varint,3,opt,name=total_conns,json=totalConns,proto3" json:"total_conns,omitempty"`
+	BytesInRate       int64                  `protobuf:"varint,4,opt,name=bytes_in_rate,json=bytesInRate,proto3" json:"bytes_in_rate,omitempty"`    // bytes per second
+	BytesOutRate      int64                  `protobuf:"varint,5,opt,name=bytes_out_rate,json=bytesOutRate,proto3" json:"bytes_out_rate,omitempty"` // bytes per second
+	BlockedTotal      int64                  `protobuf:"varint,6,opt,name=blocked_total,json=blockedTotal,proto3" json:"blocked_total,omitempty"`
+	TarpitActive      int64                  `protobuf:"varint,7,opt,name=tarpit_active,json=tarpitActive,proto3" json:"tarpit_active,omitempty"`                  // connections held by tarpits
+	TarpitMemoryBytes int64                  `protobuf:"varint,8,opt,name=tarpit_memory_bytes,json=tarpitMemoryBytes,proto3" json:"tarpit_memory_bytes,omitempty"` // estimated memory held by tarpits
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *MetricsSample) Reset() {
+	*x = MetricsSample{}
+	mi := &file_proxy_proxy_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListProxiesResponse) String() string {
+func (x *MetricsSample) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListProxiesResponse) ProtoMessage() {}
+func (*MetricsSample) ProtoMessage() {}
 
-func (x *ListProxiesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proxy_proxy_proto_msgTypes[35]
+func (x *MetricsSample) ProtoReflect() protoreflect.Message {
+	mi := &file_proxy_proxy_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2657,42 +4025,93 @@ func (x *ListProxiesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListProxiesResponse.ProtoReflect.Descriptor instead.
-func (*ListProxiesResponse) Descriptor() ([]byte, []int) {
-	return file_proxy_proxy_proto_rawDescGZIP(), []int{35}
+// Deprecated: Use MetricsSample.ProtoReflect.Descriptor instead.
+func (*MetricsSample) Descriptor() ([]byte, []int) {
+	return file_proxy_proxy_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *ListProxiesResponse) GetProxies() []*ProxyStatus {
+func (x *MetricsSample) GetTimestamp() int64 {
 	if x != nil {
-		return x.Proxies
+		return x.Timestamp
 	}
-	return nil
+	return 0
 }
 
-type BlockIPRequest struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Ip              string                 `protobuf:"bytes,1,opt,name=ip,proto3" json:"ip,omitempty"`
-	DurationSeconds int64                  `protobuf:"varint,2,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"` // 0 = indefinite
-	Reason          string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+func (x *MetricsSample) GetActiveConns() int64 {
+	if x != nil {
+		return x.ActiveConns
+	}
+	return 0
 }
 
-func (x *BlockIPRequest) Reset() {
-	*x = BlockIPRequest{}
-	mi := &file_proxy_proxy_proto_msgTypes[36]
+func (x *MetricsSample) GetTotalConns() int64 {
+	if x != nil {
+		return x.TotalConns
+	}
+	return 0
+}
+
+func (x *MetricsSample) GetBytesInRate() int64 {
+	if x != nil {
+		return x.BytesInRate
+	}
+	return 0
+}
+
+func (x *MetricsSample) GetBytesOutRate() int64 {
+	if x != nil {
+		return x.BytesOutRate
+	}
+	return 0
+}
+
+func (x *MetricsSample) GetBlockedTotal() int64 {
+	if x != nil {
+		return x.BlockedTotal
+	}
+	return 0
+}
+
+func (x *MetricsSample) GetTarpitActive() int64 {
+	if x != nil {
+		return x.TarpitActive
+	}
+	return 0
+}
+
+func (x *MetricsSample) GetTarpitMemoryBytes() int64 {
+	if x != nil {
+		return x.TarpitMemoryBytes
+	}
+	return 0
+}
+
+// EncryptedStreamPayload wraps E2E encrypted streaming data.
+// Used by StreamMetrics and StreamConnections to encrypt payloads
+// with the viewer's public key before sending over direct gRPC.
+type EncryptedStreamPayload struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Encrypted     *common.EncryptedPayload `protobuf:"bytes,1,opt,name=encrypted,proto3" json:"encrypted,omitempty"`
+	PayloadType   string                   `protobuf:"bytes,2,opt,name=payload_type,json=payloadType,proto3" json:"payload_type,omitempty"` // "MetricsSample" or "ConnectionEvent"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EncryptedStreamPayload) Reset() {
+	*x = EncryptedStreamPayload{}
+	mi := &file_proxy_proxy_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BlockIPRequest) String() string {
+func (x *EncryptedStreamPayload) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BlockIPRequest) ProtoMessage() {}
+func (*EncryptedStreamPayload) ProtoMessage() {}
 
-func (x *BlockIPRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proxy_proxy_proto_msgTypes[36]
+func (x *EncryptedStreamPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_proxy_proxy_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2703,55 +4122,54 @@ func (x *BlockIPRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BlockIPRequest.ProtoReflect.Descriptor instead.
-func (*BlockIPRequest) Descriptor() ([]byte, []int) {
-	return file_proxy_proxy_proto_rawDescGZIP(), []int{36}
-}
-
-func (x *BlockIPRequest) GetIp() string {
-	if x != nil {
-		return x.Ip
-	}
-	return ""
+// Deprecated: Use EncryptedStreamPayload.ProtoReflect.Descriptor instead.
+func (*EncryptedStreamPayload) Descriptor() ([]byte, []int) {
+	return file_proxy_proxy_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *BlockIPRequest) GetDurationSeconds() int64 {
+func (x *EncryptedStreamPayload) GetEncrypted() *common.EncryptedPayload {
 	if x != nil {
-		return x.DurationSeconds
+		return x.Encrypted
 	}
-	return 0
+	return nil
 }
 
-func (x *BlockIPRequest) GetReason() string {
+func (x *EncryptedStreamPayload) GetPayloadType() string {
 	if x != nil {
-		return x.Reason
+		return x.PayloadType
 	}
 	return ""
 }
 
-type AllowIPRequest struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Ip              string                 `protobuf:"bytes,1,opt,name=ip,proto3" json:"ip,omitempty"`
-	DurationSeconds int64                  `protobuf:"varint,2,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type ActiveConnection struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	SourceIp      string                 `protobuf:"bytes,2,opt,name=source_ip,json=sourceIp,proto3" json:"source_ip,omitempty"`
+	SourcePort    int32                  `protobuf:"varint,3,opt,name=source_port,json=sourcePort,proto3" json:"source_port,omitempty"`
+	DestAddr      string                 `protobuf:"bytes,4,opt,name=dest_addr,json=destAddr,proto3" json:"dest_addr,omitempty"`
+	StartTime     *timestamp.Timestamp   `protobuf:"bytes,5,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	BytesIn       int64                  `protobuf:"varint,6,opt,name=bytes_in,json=bytesIn,proto3" json:"bytes_in,omitempty"`
+	BytesOut      int64                  `protobuf:"varint,7,opt,name=bytes_out,json=bytesOut,proto3" json:"bytes_out,omitempty"`
+	Geo           *common.GeoInfo        `protobuf:"bytes,8,opt,name=geo,proto3" json:"geo,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AllowIPRequest) Reset() {
-	*x = AllowIPRequest{}
-	mi := &file_proxy_proxy_proto_msgTypes[37]
+func (x *ActiveConnection) Reset() {
+	*x = ActiveConnection{}
+	mi := &file_proxy_proxy_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AllowIPRequest) String() string {
+func (x *ActiveConnection) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AllowIPRequest) ProtoMessage() {}
+func (*ActiveConnection) ProtoMessage() {}
 
-func (x *AllowIPRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proxy_proxy_proto_msgTypes[37]
+func (x *ActiveConnection) ProtoReflect() protoreflect.Message {
+	mi := &file_proxy_proxy_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2762,52 +4180,90 @@ func (x *AllowIPRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AllowIPRequest.ProtoReflect.Descriptor instead.
-func (*AllowIPRequest) Descriptor() ([]byte, []int) {
-	return file_proxy_proxy_proto_rawDescGZIP(), []int{37}
+// Deprecated: Use ActiveConnection.ProtoReflect.Descriptor instead.
+func (*ActiveConnection) Descriptor() ([]byte, []int) {
+	return file_proxy_proxy_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *AllowIPRequest) GetIp() string {
+func (x *ActiveConnection) GetId() string {
 	if x != nil {
-		return x.Ip
+		return x.Id
 	}
 	return ""
 }
 
-func (x *AllowIPRequest) GetDurationSeconds() int64 {
+func (x *ActiveConnection) GetSourceIp() string {
 	if x != nil {
-		return x.DurationSeconds
+		return x.SourceIp
+	}
+	return ""
+}
+
+func (x *ActiveConnection) GetSourcePort() int32 {
+	if x != nil {
+		return x.SourcePort
 	}
 	return 0
 }
 
-type GlobalRule struct {
+func (x *ActiveConnection) GetDestAddr() string {
+	if x != nil {
+		return x.DestAddr
+	}
+	return ""
+}
+
+func (x *ActiveConnection) GetStartTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *ActiveConnection) GetBytesIn() int64 {
+	if x != nil {
+		return x.BytesIn
+	}
+	return 0
+}
+
+func (x *ActiveConnection) GetBytesOut() int64 {
+	if x != nil {
+		return x.BytesOut
+	}
+	return 0
+}
+
+func (x *ActiveConnection) GetGeo() *common.GeoInfo {
+	if x != nil {
+		return x.Geo
+	}
+	return nil
+}
+
+type GetActiveConnectionsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                              // Human-readable description
-	SourceIp      string                 `protobuf:"bytes,3,opt,name=source_ip,json=sourceIp,proto3" json:"source_ip,omitempty"`      // IP or CIDR
-	Action        common.ActionType      `protobuf:"varint,4,opt,name=action,proto3,enum=nitella.ActionType" json:"action,omitempty"` // ALLOW or BLOCK
-	ExpiresAt     *timestamp.Timestamp   `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`   // Zero means permanent
-	CreatedAt     *timestamp.Timestamp   `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	NodeId        string                 `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	ProxyId       string                 `protobuf:"bytes,2,opt,name=proxy_id,json=proxyId,proto3" json:"proxy_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GlobalRule) Reset() {
-	*x = GlobalRule{}
-	mi := &file_proxy_proxy_proto_msgTypes[38]
+func (x *GetActiveConnectionsRequest) Reset() {
+	*x = GetActiveConnectionsRequest{}
+	mi := &file_proxy_proxy_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GlobalRule) String() string {
+func (x *GetActiveConnectionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GlobalRule) ProtoMessage() {}
+func (*GetActiveConnectionsRequest) ProtoMessage() {}
 
-func (x *GlobalRule) ProtoReflect() protoreflect.Message {
-	mi := &file_proxy_proxy_proto_msgTypes[38]
+func (x *GetActiveConnectionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proxy_proxy_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2818,74 +4274,92 @@ func (x *GlobalRule) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GlobalRule.ProtoReflect.Descriptor instead.
-func (*GlobalRule) Descriptor() ([]byte, []int) {
-	return file_proxy_proxy_proto_rawDescGZIP(), []int{38}
+// Deprecated: Use GetActiveConnectionsRequest.ProtoReflect.Descriptor instead.
+func (*GetActiveConnectionsRequest) Descriptor() ([]byte, []int) {
+	return file_proxy_proxy_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *GlobalRule) GetId() string {
+func (x *GetActiveConnectionsRequest) GetNodeId() string {
 	if x != nil {
-		return x.Id
+		return x.NodeId
 	}
 	return ""
 }
 
-func (x *GlobalRule) GetName() string {
+func (x *GetActiveConnectionsRequest) GetProxyId() string {
 	if x != nil {
-		return x.Name
+		return x.ProxyId
 	}
 	return ""
 }
 
-func (x *GlobalRule) GetSourceIp() string {
-	if x != nil {
-		return x.SourceIp
-	}
-	return ""
+type GetActiveConnectionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Connections   []*ActiveConnection    `protobuf:"bytes,1,rep,name=connections,proto3" json:"connections,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GlobalRule) GetAction() common.ActionType {
-	if x != nil {
-		return x.Action
-	}
-	return common.ActionType(0)
+func (x *GetActiveConnectionsResponse) Reset() {
+	*x = GetActiveConnectionsResponse{}
+	mi := &file_proxy_proxy_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *GlobalRule) GetExpiresAt() *timestamp.Timestamp {
+func (x *GetActiveConnectionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetActiveConnectionsResponse) ProtoMessage() {}
+
+func (x *GetActiveConnectionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proxy_proxy_proto_msgTypes[54]
 	if x != nil {
-		return x.ExpiresAt
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *GlobalRule) GetCreatedAt() *timestamp.Timestamp {
+// Deprecated: Use GetActiveConnectionsResponse.ProtoReflect.Descriptor instead.
+func (*GetActiveConnectionsResponse) Descriptor() ([]byte, []int) {
+	return file_proxy_proxy_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *GetActiveConnectionsResponse) GetConnections() []*ActiveConnection {
 	if x != nil {
-		return x.CreatedAt
+		return x.Connections
 	}
 	return nil
 }
 
-type ListGlobalRulesRequest struct {
+type CloseConnectionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProxyId       string                 `protobuf:"bytes,1,opt,name=proxy_id,json=proxyId,proto3" json:"proxy_id,omitempty"`
+	ConnId        string                 `protobuf:"bytes,2,opt,name=conn_id,json=connId,proto3" json:"conn_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListGlobalRulesRequest) Reset() {
-	*x = ListGlobalRulesRequest{}
-	mi := &file_proxy_proxy_proto_msgTypes[39]
+func (x *CloseConnectionRequest) Reset() {
+	*x = CloseConnectionRequest{}
+	mi := &file_proxy_proxy_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListGlobalRulesRequest) String() string {
+func (x *CloseConnectionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListGlobalRulesRequest) ProtoMessage() {}
+func (*CloseConnectionRequest) ProtoMessage() {}
 
-func (x *ListGlobalRulesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proxy_proxy_proto_msgTypes[39]
+func (x *CloseConnectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proxy_proxy_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2896,33 +4370,48 @@ func (x *ListGlobalRulesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListGlobalRulesRequest.ProtoReflect.Descriptor instead.
-func (*ListGlobalRulesRequest) Descriptor() ([]byte, []int) {
-	return file_proxy_proxy_proto_rawDescGZIP(), []int{39}
+// Deprecated: Use CloseConnectionRequest.ProtoReflect.Descriptor instead.
+func (*CloseConnectionRequest) Descriptor() ([]byte, []int) {
+	return file_proxy_proxy_proto_rawDescGZIP(), []int{55}
 }
 
-type ListGlobalRulesResponse struct {
+func (x *CloseConnectionRequest) GetProxyId() string {
+	if x != nil {
+		return x.ProxyId
+	}
+	return ""
+}
+
+func (x *CloseConnectionRequest) GetConnId() string {
+	if x != nil {
+		return x.ConnId
+	}
+	return ""
+}
+
+type CloseConnectionResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Rules         []*GlobalRule          `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	ErrorMessage  string                 `protobuf:"bytes,2,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListGlobalRulesResponse) Reset() {
-	*x = ListGlobalRulesResponse{}
-	mi := &file_proxy_proxy_proto_msgTypes[40]
+func (x *CloseConnectionResponse) Reset() {
+	*x = CloseConnectionResponse{}
+	mi := &file_proxy_proxy_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListGlobalRulesResponse) String() string {
+func (x *CloseConnectionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListGlobalRulesResponse) ProtoMessage() {}
+func (*CloseConnectionResponse) ProtoMessage() {}
 
-func (x *ListGlobalRulesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proxy_proxy_proto_msgTypes[40]
+func (x *CloseConnectionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proxy_proxy_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2933,40 +4422,47 @@ func (x *ListGlobalRulesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListGlobalRulesResponse.ProtoReflect.Descriptor instead.
-func (*ListGlobalRulesResponse) Descriptor() ([]byte, []int) {
-	return file_proxy_proxy_proto_rawDescGZIP(), []int{40}
+// Deprecated: Use CloseConnectionResponse.ProtoReflect.Descriptor instead.
+func (*CloseConnectionResponse) Descriptor() ([]byte, []int) {
+	return file_proxy_proxy_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *ListGlobalRulesResponse) GetRules() []*GlobalRule {
+func (x *CloseConnectionResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Rules
+		return x.Success
 	}
-	return nil
+	return false
 }
 
-type RemoveGlobalRuleRequest struct {
+func (x *CloseConnectionResponse) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+type CloseAllConnectionsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	RuleId        string                 `protobuf:"bytes,1,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	ProxyId       string                 `protobuf:"bytes,1,opt,name=proxy_id,json=proxyId,proto3" json:"proxy_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RemoveGlobalRuleRequest) Reset() {
-	*x = RemoveGlobalRuleRequest{}
-	mi := &file_proxy_proxy_proto_msgTypes[41]
+func (x *CloseAllConnectionsRequest) Reset() {
+	*x = CloseAllConnectionsRequest{}
+	mi := &file_proxy_proxy_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RemoveGlobalRuleRequest) String() string {
+func (x *CloseAllConnectionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveGlobalRuleRequest) ProtoMessage() {}
+func (*CloseAllConnectionsRequest) ProtoMessage() {}
 
-func (x *RemoveGlobalRuleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proxy_proxy_proto_msgTypes[41]
+func (x *CloseAllConnectionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proxy_proxy_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2977,19 +4473,19 @@ func (x *RemoveGlobalRuleRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveGlobalRuleRequest.ProtoReflect.Descriptor instead.
-func (*RemoveGlobalRuleRequest) Descriptor() ([]byte, []int) {
-	return file_proxy_proxy_proto_rawDescGZIP(), []int{41}
+// Deprecated: Use CloseAllConnectionsRequest.ProtoReflect.Descriptor instead.
+func (*CloseAllConnectionsRequest) Descriptor() ([]byte, []int) {
+	return file_proxy_proxy_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *RemoveGlobalRuleRequest) GetRuleId() string {
+func (x *CloseAllConnectionsRequest) GetProxyId() string {
 	if x != nil {
-		return x.RuleId
+		return x.ProxyId
 	}
 	return ""
 }
 
-type RemoveGlobalRuleResponse struct {
+type CloseAllConnectionsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	ErrorMessage  string                 `protobuf:"bytes,2,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
@@ -2997,21 +4493,21 @@ type RemoveGlobalRuleResponse struct {
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RemoveGlobalRuleResponse) Reset() {
-	*x = RemoveGlobalRuleResponse{}
-	mi := &file_proxy_proxy_proto_msgTypes[42]
+func (x *CloseAllConnectionsResponse) Reset() {
+	*x = CloseAllConnectionsResponse{}
+	mi := &file_proxy_proxy_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RemoveGlobalRuleResponse) String() string {
+func (x *CloseAllConnectionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveGlobalRuleResponse) ProtoMessage() {}
+func (*CloseAllConnectionsResponse) ProtoMessage() {}
 
-func (x *RemoveGlobalRuleResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proxy_proxy_proto_msgTypes[42]
+func (x *CloseAllConnectionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proxy_proxy_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3022,48 +4518,51 @@ func (x *RemoveGlobalRuleResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveGlobalRuleResponse.ProtoReflect.Descriptor instead.
-func (*RemoveGlobalRuleResponse) Descriptor() ([]byte, []int) {
-	return file_proxy_proxy_proto_rawDescGZIP(), []int{42}
+// Deprecated: Use CloseAllConnectionsResponse.ProtoReflect.Descriptor instead.
+func (*CloseAllConnectionsResponse) Descriptor() ([]byte, []int) {
+	return file_proxy_proxy_proto_rawDescGZIP(), []int{58}
 }
 
-func (x *RemoveGlobalRuleResponse) GetSuccess() bool {
+func (x *CloseAllConnectionsResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *RemoveGlobalRuleResponse) GetErrorMessage() string {
+func (x *CloseAllConnectionsResponse) GetErrorMessage() string {
 	if x != nil {
 		return x.ErrorMessage
 	}
 	return ""
 }
 
-type StreamConnectionsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ActiveOnly    bool                   `protobuf:"varint,1,opt,name=active_only,json=activeOnly,proto3" json:"active_only,omitempty"`      // If true, only streams currently active events
-	ViewerPubkey  []byte                 `protobuf:"bytes,2,opt,name=viewer_pubkey,json=viewerPubkey,proto3" json:"viewer_pubkey,omitempty"` // Viewer's Ed25519 public key for E2E encryption
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type GetIPStatsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Limit          int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset         int32                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	SourceIpFilter string                 `protobuf:"bytes,3,opt,name=source_ip_filter,json=sourceIpFilter,proto3" json:"source_ip_filter,omitempty"` // Prefix match
+	CountryFilter  string                 `protobuf:"bytes,4,opt,name=country_filter,json=countryFilter,proto3" json:"country_filter,omitempty"`
+	SortBy         string                 `protobuf:"bytes,5,opt,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"` // "recency", "connection_count", "bytes_total", "last_seen"
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *StreamConnectionsRequest) Reset() {
-	*x = StreamConnectionsRequest{}
-	mi := &file_proxy_proxy_proto_msgTypes[43]
+func (x *GetIPStatsRequest) Reset() {
+	*x = GetIPStatsRequest{}
+	mi := &file_proxy_proxy_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StreamConnectionsRequest) String() string {
+func (x *GetIPStatsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StreamConnectionsRequest) ProtoMessage() {}
+func (*GetIPStatsRequest) ProtoMessage() {}
 
-func (x *StreamConnectionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proxy_proxy_proto_msgTypes[43]
+func (x *GetIPStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proxy_proxy_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3074,59 +4573,81 @@ func (x *StreamConnectionsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StreamConnectionsRequest.ProtoReflect.Descriptor instead.
-func (*StreamConnectionsRequest) Descriptor() ([]byte, []int) {
-	return file_proxy_proxy_proto_rawDescGZIP(), []int{43}
+// Deprecated: Use GetIPStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetIPStatsRequest) Descriptor() ([]byte, []int) {
+	return file_proxy_proxy_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *StreamConnectionsRequest) GetActiveOnly() bool {
+func (x *GetIPStatsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetIPStatsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *GetIPStatsRequest) GetSourceIpFilter() string {
+	if x != nil {
+		return x.SourceIpFilter
+	}
+	return ""
+}
+
+func (x *GetIPStatsRequest) GetCountryFilter() string {
 	if x != nil {
-		return x.ActiveOnly
+		return x.CountryFilter
 	}
-	return false
+	return ""
 }
 
-func (x *StreamConnectionsRequest) GetViewerPubkey() []byte {
+func (x *GetIPStatsRequest) GetSortBy() string {
 	if x != nil {
-		return x.ViewerPubkey
+		return x.SortBy
 	}
-	return nil
+	return ""
 }
 
-type ConnectionEvent struct {
-	state      protoimpl.MessageState `protogen:"open.v1"`
-	ConnId     string                 `protobuf:"bytes,1,opt,name=conn_id,json=connId,proto3" json:"conn_id,omitempty"`
-	SourceIp   string                 `protobuf:"bytes,2,opt,name=source_ip,json=sourceIp,proto3" json:"source_ip,omitempty"`
-	SourcePort int32                  `protobuf:"varint,3,opt,name=source_port,json=sourcePort,proto3" json:"source_port,omitempty"`
-	TargetAddr string                 `protobuf:"bytes,4,opt,name=target_addr,json=targetAddr,proto3" json:"target_addr,omitempty"`
-	EventType  EventType              `protobuf:"varint,5,opt,name=event_type,json=eventType,proto3,enum=nitella.proxy.EventType" json:"event_type,omitempty"`
-	Timestamp  int64                  `protobuf:"varint,6,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	// Context
-	RuleMatched string            `protobuf:"bytes,7,opt,name=rule_matched,json=ruleMatched,proto3" json:"rule_matched,omitempty"` // Rule ID or Name
-	ActionTaken common.ActionType `protobuf:"varint,8,opt,name=action_taken,json=actionTaken,proto3,enum=nitella.ActionType" json:"action_taken,omitempty"`
-	// Stats (for CLOSED events)
-	BytesIn       int64           `protobuf:"varint,9,opt,name=bytes_in,json=bytesIn,proto3" json:"bytes_in,omitempty"`
-	BytesOut      int64           `protobuf:"varint,10,opt,name=bytes_out,json=bytesOut,proto3" json:"bytes_out,omitempty"`
-	Geo           *common.GeoInfo `protobuf:"bytes,11,opt,name=geo,proto3" json:"geo,omitempty"`
+type IPStatsResult struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	SourceIp        string                 `protobuf:"bytes,1,opt,name=source_ip,json=sourceIp,proto3" json:"source_ip,omitempty"`
+	FirstSeen       *timestamp.Timestamp   `protobuf:"bytes,2,opt,name=first_seen,json=firstSeen,proto3" json:"first_seen,omitempty"`
+	LastSeen        *timestamp.Timestamp   `protobuf:"bytes,3,opt,name=last_seen,json=lastSeen,proto3" json:"last_seen,omitempty"`
+	ConnectionCount int64                  `protobuf:"varint,4,opt,name=connection_count,json=connectionCount,proto3" json:"connection_count,omitempty"`
+	TotalBytesIn    int64                  `protobuf:"varint,5,opt,name=total_bytes_in,json=totalBytesIn,proto3" json:"total_bytes_in,omitempty"`
+	TotalBytesOut   int64                  `protobuf:"varint,6,opt,name=total_bytes_out,json=totalBytesOut,proto3" json:"total_bytes_out,omitempty"`
+	TotalDurationMs int64                  `protobuf:"varint,7,opt,name=total_duration_ms,json=totalDurationMs,proto3" json:"total_duration_ms,omitempty"`
+	BlockedCount    int64                  `protobuf:"varint,8,opt,name=blocked_count,json=blockedCount,proto3" json:"blocked_count,omitempty"`
+	AllowedCount    int64                  `protobuf:"varint,9,opt,name=allowed_count,json=allowedCount,proto3" json:"allowed_count,omitempty"`
+	// Geo Info
+	GeoCountry    string  `protobuf:"bytes,10,opt,name=geo_country,json=geoCountry,proto3" json:"geo_country,omitempty"`
+	GeoCity       string  `protobuf:"bytes,11,opt,name=geo_city,json=geoCity,proto3" json:"geo_city,omitempty"`
+	GeoIsp        string  `protobuf:"bytes,12,opt,name=geo_isp,json=geoIsp,proto3" json:"geo_isp,omitempty"`
+	RecencyWeight float64 `protobuf:"fixed64,13,opt,name=recency_weight,json=recencyWeight,proto3" json:"recency_weight,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ConnectionEvent) Reset() {
-	*x = ConnectionEvent{}
-	mi := &file_proxy_proxy_proto_msgTypes[44]
+func (x *IPStatsResult) Reset() {
+	*x = IPStatsResult{}
+	mi := &file_proxy_proxy_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ConnectionEvent) String() string {
+func (x *IPStatsResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ConnectionEvent) ProtoMessage() {}
+func (*IPStatsResult) ProtoMessage() {}
 
-func (x *ConnectionEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_proxy_proxy_proto_msgTypes[44]
+func (x *IPStatsResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proxy_proxy_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3137,167 +4658,125 @@ func (x *ConnectionEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ConnectionEvent.ProtoReflect.Descriptor instead.
-func (*ConnectionEvent) Descriptor() ([]byte, []int) {
-	return file_proxy_proxy_proto_rawDescGZIP(), []int{44}
-}
-
-func (x *ConnectionEvent) GetConnId() string {
-	if x != nil {
-		return x.ConnId
-	}
-	return ""
+// Deprecated: Use IPStatsResult.ProtoReflect.Descriptor instead.
+func (*IPStatsResult) Descriptor() ([]byte, []int) {
+	return file_proxy_proxy_proto_rawDescGZIP(), []int{60}
 }
 
-func (x *ConnectionEvent) GetSourceIp() string {
+func (x *IPStatsResult) GetSourceIp() string {
 	if x != nil {
 		return x.SourceIp
 	}
 	return ""
 }
 
-func (x *ConnectionEvent) GetSourcePort() int32 {
+func (x *IPStatsResult) GetFirstSeen() *timestamp.Timestamp {
 	if x != nil {
-		return x.SourcePort
+		return x.FirstSeen
 	}
-	return 0
+	return nil
 }
 
-func (x *ConnectionEvent) GetTargetAddr() string {
+func (x *IPStatsResult) GetLastSeen() *timestamp.Timestamp {
 	if x != nil {
-		return x.TargetAddr
+		return x.LastSeen
 	}
-	return ""
+	return nil
 }
 
-func (x *ConnectionEvent) GetEventType() EventType {
+func (x *IPStatsResult) GetConnectionCount() int64 {
 	if x != nil {
-		return x.EventType
+		return x.ConnectionCount
 	}
-	return EventType_EVENT_TYPE_UNSPECIFIED
+	return 0
 }
 
-func (x *ConnectionEvent) GetTimestamp() int64 {
+func (x *IPStatsResult) GetTotalBytesIn() int64 {
 	if x != nil {
-		return x.Timestamp
+		return x.TotalBytesIn
 	}
 	return 0
 }
 
-func (x *ConnectionEvent) GetRuleMatched() string {
+func (x *IPStatsResult) GetTotalBytesOut() int64 {
 	if x != nil {
-		return x.RuleMatched
+		return x.TotalBytesOut
 	}
-	return ""
+	return 0
 }
 
-func (x *ConnectionEvent) GetActionTaken() common.ActionType {
+func (x *IPStatsResult) GetTotalDurationMs() int64 {
 	if x != nil {
-		return x.ActionTaken
+		return x.TotalDurationMs
 	}
-	return common.ActionType(0)
+	return 0
 }
 
-func (x *ConnectionEvent) GetBytesIn() int64 {
+func (x *IPStatsResult) GetBlockedCount() int64 {
 	if x != nil {
-		return x.BytesIn
+		return x.BlockedCount
 	}
 	return 0
 }
 
-func (x *ConnectionEvent) GetBytesOut() int64 {
+func (x *IPStatsResult) GetAllowedCount() int64 {
 	if x != nil {
-		return x.BytesOut
+		return x.AllowedCount
 	}
 	return 0
 }
 
-func (x *ConnectionEvent) GetGeo() *common.GeoInfo {
+func (x *IPStatsResult) GetGeoCountry() string {
 	if x != nil {
-		return x.Geo
+		return x.GeoCountry
 	}
-	return nil
-}
-
-type StreamMetricsRequest struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	IntervalSeconds int32                  `protobuf:"varint,1,opt,name=interval_seconds,json=intervalSeconds,proto3" json:"interval_seconds,omitempty"`
-	ViewerPubkey    []byte                 `protobuf:"bytes,2,opt,name=viewer_pubkey,json=viewerPubkey,proto3" json:"viewer_pubkey,omitempty"` // Viewer's Ed25519 public key for E2E encryption
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
-}
-
-func (x *StreamMetricsRequest) Reset() {
-	*x = StreamMetricsRequest{}
-	mi := &file_proxy_proxy_proto_msgTypes[45]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *StreamMetricsRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+	return ""
 }
 
-func (*StreamMetricsRequest) ProtoMessage() {}
-
-func (x *StreamMetricsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proxy_proxy_proto_msgTypes[45]
+func (x *IPStatsResult) GetGeoCity() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.GeoCity
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use StreamMetricsRequest.ProtoReflect.Descriptor instead.
-func (*StreamMetricsRequest) Descriptor() ([]byte, []int) {
-	return file_proxy_proxy_proto_rawDescGZIP(), []int{45}
+	return ""
 }
 
-func (x *StreamMetricsRequest) GetIntervalSeconds() int32 {
+func (x *IPStatsResult) GetGeoIsp() string {
 	if x != nil {
-		return x.IntervalSeconds
+		return x.GeoIsp
 	}
-	return 0
+	return ""
 }
 
-func (x *StreamMetricsRequest) GetViewerPubkey() []byte {
+func (x *IPStatsResult) GetRecencyWeight() float64 {
 	if x != nil {
-		return x.ViewerPubkey
+		return x.RecencyWeight
 	}
-	return nil
+	return 0
 }
 
-type MetricsSample struct {
+type GetIPStatsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Timestamp     int64                  `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	ActiveConns   int64                  `protobuf:"varint,2,opt,name=active_conns,json=activeConns,proto3" json:"active_conns,omitempty"`
-	TotalConns    int64                  `protobuf:"varint,3,opt,name=total_conns,json=totalConns,proto3" json:"total_conns,omitempty"`
-	BytesInRate   int64                  `protobuf:"varint,4,opt,name=bytes_in_rate,json=bytesInRate,proto3" json:"bytes_in_rate,omitempty"`    // bytes per second
-	BytesOutRate  int64                  `protobuf:"varint,5,opt,name=bytes_out_rate,json=bytesOutRate,proto3" json:"bytes_out_rate,omitempty"` // bytes per second
-	BlockedTotal  int64                  `protobuf:"varint,6,opt,name=blocked_total,json=blockedTotal,proto3" json:"blocked_total,omitempty"`
+	Stats         []*IPStatsResult       `protobuf:"bytes,1,rep,name=stats,proto3" json:"stats,omitempty"`
+	TotalCount    int64                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MetricsSample) Reset() {
-	*x = MetricsSample{}
-	mi := &file_proxy_proxy_proto_msgTypes[46]
+func (x *GetIPStatsResponse) Reset() {
+	*x = GetIPStatsResponse{}
+	mi := &file_proxy_proxy_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MetricsSample) String() string {
+func (x *GetIPStatsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MetricsSample) ProtoMessage() {}
+func (*GetIPStatsResponse) ProtoMessage() {}
 
-func (x *MetricsSample) ProtoReflect() protoreflect.Message {
-	mi := &file_proxy_proxy_proto_msgTypes[46]
+func (x *GetIPStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proxy_proxy_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3308,79 +4787,49 @@ func (x *MetricsSample) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MetricsSample.ProtoReflect.Descriptor instead.
-func (*MetricsSample) Descriptor() ([]byte, []int) {
-	return file_proxy_proxy_proto_rawDescGZIP(), []int{46}
-}
-
-func (x *MetricsSample) GetTimestamp() int64 {
-	if x != nil {
-		return x.Timestamp
-	}
-	return 0
-}
-
-func (x *MetricsSample) GetActiveConns() int64 {
-	if x != nil {
-		return x.ActiveConns
-	}
-	return 0
-}
-
-func (x *MetricsSample) GetTotalConns() int64 {
-	if x != nil {
-		return x.TotalConns
-	}
-	return 0
-}
-
-func (x *MetricsSample) GetBytesInRate() int64 {
-	if x != nil {
-		return x.BytesInRate
-	}
-	return 0
+// Deprecated: Use GetIPStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetIPStatsResponse) Descriptor() ([]byte, []int) {
+	return file_proxy_proxy_proto_rawDescGZIP(), []int{61}
 }
 
-func (x *MetricsSample) GetBytesOutRate() int64 {
+func (x *GetIPStatsResponse) GetStats() []*IPStatsResult {
 	if x != nil {
-		return x.BytesOutRate
+		return x.Stats
 	}
-	return 0
+	return nil
 }
 
-func (x *MetricsSample) GetBlockedTotal() int64 {
+func (x *GetIPStatsResponse) GetTotalCount() int64 {
 	if x != nil {
-		return x.BlockedTotal
+		return x.TotalCount
 	}
 	return 0
 }
 
-// EncryptedStreamPayload wraps E2E encrypted streaming data.
-// Used by StreamMetrics and StreamConnections to encrypt payloads
-// with the viewer's public key before sending over direct gRPC.
-type EncryptedStreamPayload struct {
-	state         protoimpl.MessageState   `protogen:"open.v1"`
-	Encrypted     *common.EncryptedPayload `protobuf:"bytes,1,opt,name=encrypted,proto3" json:"encrypted,omitempty"`
-	PayloadType   string                   `protobuf:"bytes,2,opt,name=payload_type,json=payloadType,proto3" json:"payload_type,omitempty"` // "MetricsSample" or "ConnectionEvent"
+type GetGeoStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"` // "country", "city", "isp"
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Offset        int32                  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *EncryptedStreamPayload) Reset() {
-	*x = EncryptedStreamPayload{}
-	mi := &file_proxy_proxy_proto_msgTypes[47]
+func (x *GetGeoStatsRequest) Reset() {
+	*x = GetGeoStatsRequest{}
+	mi := &file_proxy_proxy_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EncryptedStreamPayload) String() string {
+func (x *GetGeoStatsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EncryptedStreamPayload) ProtoMessage() {}
+func (*GetGeoStatsRequest) ProtoMessage() {}
 
-func (x *EncryptedStreamPayload) ProtoReflect() protoreflect.Message {
-	mi := &file_proxy_proxy_proto_msgTypes[47]
+func (x *GetGeoStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proxy_proxy_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3391,54 +4840,60 @@ func (x *EncryptedStreamPayload) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EncryptedStreamPayload.ProtoReflect.Descriptor instead.
-func (*EncryptedStreamPayload) Descriptor() ([]byte, []int) {
-	return file_proxy_proxy_proto_rawDescGZIP(), []int{47}
+// Deprecated: Use GetGeoStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetGeoStatsRequest) Descriptor() ([]byte, []int) {
+	return file_proxy_proxy_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *GetGeoStatsRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
 }
 
-func (x *EncryptedStreamPayload) GetEncrypted() *common.EncryptedPayload {
+func (x *GetGeoStatsRequest) GetLimit() int32 {
 	if x != nil {
-		return x.Encrypted
+		return x.Limit
 	}
-	return nil
+	return 0
 }
 
-func (x *EncryptedStreamPayload) GetPayloadType() string {
+func (x *GetGeoStatsRequest) GetOffset() int32 {
 	if x != nil {
-		return x.PayloadType
+		return x.Offset
 	}
-	return ""
+	return 0
 }
 
-type ActiveConnection struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	SourceIp      string                 `protobuf:"bytes,2,opt,name=source_ip,json=sourceIp,proto3" json:"source_ip,omitempty"`
-	SourcePort    int32                  `protobuf:"varint,3,opt,name=source_port,json=sourcePort,proto3" json:"source_port,omitempty"`
-	DestAddr      string                 `protobuf:"bytes,4,opt,name=dest_addr,json=destAddr,proto3" json:"dest_addr,omitempty"`
-	StartTime     *timestamp.Timestamp   `protobuf:"bytes,5,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
-	BytesIn       int64                  `protobuf:"varint,6,opt,name=bytes_in,json=bytesIn,proto3" json:"bytes_in,omitempty"`
-	BytesOut      int64                  `protobuf:"varint,7,opt,name=bytes_out,json=bytesOut,proto3" json:"bytes_out,omitempty"`
-	Geo           *common.GeoInfo        `protobuf:"bytes,8,opt,name=geo,proto3" json:"geo,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type GeoStatsResult struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Type            string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Value           string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	ConnectionCount int64                  `protobuf:"varint,3,opt,name=connection_count,json=connectionCount,proto3" json:"connection_count,omitempty"`
+	UniqueIps       int64                  `protobuf:"varint,4,opt,name=unique_ips,json=uniqueIps,proto3" json:"unique_ips,omitempty"`
+	TotalBytesIn    int64                  `protobuf:"varint,5,opt,name=total_bytes_in,json=totalBytesIn,proto3" json:"total_bytes_in,omitempty"`
+	TotalBytesOut   int64                  `protobuf:"varint,6,opt,name=total_bytes_out,json=totalBytesOut,proto3" json:"total_bytes_out,omitempty"`
+	BlockedCount    int64                  `protobuf:"varint,7,opt,name=blocked_count,json=blockedCount,proto3" json:"blocked_count,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *ActiveConnection) Reset() {
-	*x = ActiveConnection{}
-	mi := &file_proxy_proxy_proto_msgTypes[48]
+func (x *GeoStatsResult) Reset() {
+	*x = GeoStatsResult{}
+	mi := &file_proxy_proxy_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ActiveConnection) String() string {
+func (x *GeoStatsResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ActiveConnection) ProtoMessage() {}
+func (*GeoStatsResult) ProtoMessage() {}
 
-func (x *ActiveConnection) ProtoReflect() protoreflect.Message {
-	mi := &file_proxy_proxy_proto_msgTypes[48]
+func (x *GeoStatsResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proxy_proxy_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3449,90 +4904,82 @@ func (x *ActiveConnection) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ActiveConnection.ProtoReflect.Descriptor instead.
-func (*ActiveConnection) Descriptor() ([]byte, []int) {
-	return file_proxy_proxy_proto_rawDescGZIP(), []int{48}
+// Deprecated: Use GeoStatsResult.ProtoReflect.Descriptor instead.
+func (*GeoStatsResult) Descriptor() ([]byte, []int) {
+	return file_proxy_proxy_proto_rawDescGZIP(), []int{63}
 }
 
-func (x *ActiveConnection) GetId() string {
+func (x *GeoStatsResult) GetType() string {
 	if x != nil {
-		return x.Id
+		return x.Type
 	}
 	return ""
 }
 
-func (x *ActiveConnection) GetSourceIp() string {
+func (x *GeoStatsResult) GetValue() string {
 	if x != nil {
-		return x.SourceIp
+		return x.Value
 	}
 	return ""
 }
 
-func (x *ActiveConnection) GetSourcePort() int32 {
+func (x *GeoStatsResult) GetConnectionCount() int64 {
 	if x != nil {
-		return x.SourcePort
+		return x.ConnectionCount
 	}
 	return 0
 }
 
-func (x *ActiveConnection) GetDestAddr() string {
-	if x != nil {
-		return x.DestAddr
-	}
-	return ""
-}
-
-func (x *ActiveConnection) GetStartTime() *timestamp.Timestamp {
+func (x *GeoStatsResult) GetUniqueIps() int64 {
 	if x != nil {
-		return x.StartTime
+		return x.UniqueIps
 	}
-	return nil
+	return 0
 }
 
-func (x *ActiveConnection) GetBytesIn() int64 {
+func (x *GeoStatsResult) GetTotalBytesIn() int64 {
 	if x != nil {
-		return x.BytesIn
+		return x.TotalBytesIn
 	}
 	return 0
 }
 
-func (x *ActiveConnection) GetBytesOut() int64 {
+func (x *GeoStatsResult) GetTotalBytesOut() int64 {
 	if x != nil {
-		return x.BytesOut
+		return x.TotalBytesOut
 	}
 	return 0
 }
 
-func (x *ActiveConnection) GetGeo() *common.GeoInfo {
+func (x *GeoStatsResult) GetBlockedCount() int64 {
 	if x != nil {
-		return x.Geo
+		return x.BlockedCount
 	}
-	return nil
+	return 0
 }
 
-type GetActiveConnectionsRequest struct {
+type GetGeoStatsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	NodeId        string                 `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
-	ProxyId       string                 `protobuf:"bytes,2,opt,name=proxy_id,json=proxyId,proto3" json:"proxy_id,omitempty"`
+	Stats         []*GeoStatsResult      `protobuf:"bytes,1,rep,name=stats,proto3" json:"stats,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetActiveConnectionsRequest) Reset() {
-	*x = GetActiveConnectionsRequest{}
-	mi := &file_proxy_proxy_proto_msgTypes[49]
+func (x *GetGeoStatsResponse) Reset() {
+	*x = GetGeoStatsResponse{}
+	mi := &file_proxy_proxy_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetActiveConnectionsRequest) String() string {
+func (x *GetGeoStatsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetActiveConnectionsRequest) ProtoMessage() {}
+func (*GetGeoStatsResponse) ProtoMessage() {}
 
-func (x *GetActiveConnectionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proxy_proxy_proto_msgTypes[49]
+func (x *GetGeoStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proxy_proxy_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3543,47 +4990,39 @@ func (x *GetActiveConnectionsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetActiveConnectionsRequest.ProtoReflect.Descriptor instead.
-func (*GetActiveConnectionsRequest) Descriptor() ([]byte, []int) {
-	return file_proxy_proxy_proto_rawDescGZIP(), []int{49}
-}
-
-func (x *GetActiveConnectionsRequest) GetNodeId() string {
-	if x != nil {
-		return x.NodeId
-	}
-	return ""
+// Deprecated: Use GetGeoStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetGeoStatsResponse) Descriptor() ([]byte, []int) {
+	return file_proxy_proxy_proto_rawDescGZIP(), []int{64}
 }
 
-func (x *GetActiveConnectionsRequest) GetProxyId() string {
+func (x *GetGeoStatsResponse) GetStats() []*GeoStatsResult {
 	if x != nil {
-		return x.ProxyId
+		return x.Stats
 	}
-	return ""
+	return nil
 }
 
-type GetActiveConnectionsResponse struct {
+type GetStatsSummaryRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Connections   []*ActiveConnection    `protobuf:"bytes,1,rep,name=connections,proto3" json:"connections,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetActiveConnectionsResponse) Reset() {
-	*x = GetActiveConnectionsResponse{}
-	mi := &file_proxy_proxy_proto_msgTypes[50]
+func (x *GetStatsSummaryRequest) Reset() {
+	*x = GetStatsSummaryRequest{}
+	mi := &file_proxy_proxy_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetActiveConnectionsResponse) String() string {
+func (x *GetStatsSummaryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetActiveConnectionsResponse) ProtoMessage() {}
+func (*GetStatsSummaryRequest) ProtoMessage() {}
 
-func (x *GetActiveConnectionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proxy_proxy_proto_msgTypes[50]
+func (x *GetStatsSummaryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proxy_proxy_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3594,41 +5033,44 @@ func (x *GetActiveConnectionsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetActiveConnectionsResponse.ProtoReflect.Descriptor instead.
-func (*GetActiveConnectionsResponse) Descriptor() ([]byte, []int) {
-	return file_proxy_proxy_proto_rawDescGZIP(), []int{50}
-}
-
-func (x *GetActiveConnectionsResponse) GetConnections() []*ActiveConnection {
-	if x != nil {
-		return x.Connections
-	}
-	return nil
+// Deprecated: Use GetStatsSummaryRequest.ProtoReflect.Descriptor instead.
+func (*GetStatsSummaryRequest) Descriptor() ([]byte, []int) {
+	return file_proxy_proxy_proto_rawDescGZIP(), []int{65}
 }
 
-type CloseConnectionRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProxyId       string                 `protobuf:"bytes,1,opt,name=proxy_id,json=proxyId,proto3" json:"proxy_id,omitempty"`
-	ConnId        string                 `protobuf:"bytes,2,opt,name=conn_id,json=connId,proto3" json:"conn_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type StatsSummaryResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	TotalConnections  int64                  `protobuf:"varint,1,opt,name=total_connections,json=totalConnections,proto3" json:"total_connections,omitempty"`
+	TotalBytesIn      int64                  `protobuf:"varint,2,opt,name=total_bytes_in,json=totalBytesIn,proto3" json:"total_bytes_in,omitempty"`
+	TotalBytesOut     int64                  `protobuf:"varint,3,opt,name=total_bytes_out,json=totalBytesOut,proto3" json:"total_bytes_out,omitempty"`
+	UniqueIps         int64                  `protobuf:"varint,4,opt,name=unique_ips,json=uniqueIps,proto3" json:"unique_ips,omitempty"`
+	UniqueCountries   int64                  `protobuf:"varint,5,opt,name=unique_countries,json=uniqueCountries,proto3" json:"unique_countries,omitempty"`
+	BlockedTotal      int64                  `protobuf:"varint,6,opt,name=blocked_total,json=blockedTotal,proto3" json:"blocked_total,omitempty"`
+	AllowedTotal      int64                  `protobuf:"varint,7,opt,name=allowed_total,json=allowedTotal,proto3" json:"allowed_total,omitempty"`
+	ActiveConnections int64                  `protobuf:"varint,8,opt,name=active_connections,json=activeConnections,proto3" json:"active_connections,omitempty"`
+	ProxyCount        int32                  `protobuf:"varint,9,opt,name=proxy_count,json=proxyCount,proto3" json:"proxy_count,omitempty"`
+	Timestamp         *timestamp.Timestamp   `protobuf:"bytes,10,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Tarpit            *TarpitStats           `protobuf:"bytes,11,opt,name=tarpit,proto3" json:"tarpit,omitempty"`
+	AlertSinks        []*AlertSinkStats      `protobuf:"bytes,12,rep,name=alert_sinks,json=alertSinks,proto3" json:"alert_sinks,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *CloseConnectionRequest) Reset() {
-	*x = CloseConnectionRequest{}
-	mi := &file_proxy_proxy_proto_msgTypes[51]
+func (x *StatsSummaryResponse) Reset() {
+	*x = StatsSummaryResponse{}
+	mi := &file_proxy_proxy_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CloseConnectionRequest) String() string {
+func (x *StatsSummaryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CloseConnectionRequest) ProtoMessage() {}
+func (*StatsSummaryResponse) ProtoMessage() {}
 
-func (x *CloseConnectionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proxy_proxy_proto_msgTypes[51]
+func (x *StatsSummaryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proxy_proxy_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3639,99 +5081,125 @@ func (x *CloseConnectionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CloseConnectionRequest.ProtoReflect.Descriptor instead.
-func (*CloseConnectionRequest) Descriptor() ([]byte, []int) {
-	return file_proxy_proxy_proto_rawDescGZIP(), []int{51}
+// Deprecated: Use StatsSummaryResponse.ProtoReflect.Descriptor instead.
+func (*StatsSummaryResponse) Descriptor() ([]byte, []int) {
+	return file_proxy_proxy_proto_rawDescGZIP(), []int{66}
 }
 
-func (x *CloseConnectionRequest) GetProxyId() string {
+func (x *StatsSummaryResponse) GetTotalConnections() int64 {
 	if x != nil {
-		return x.ProxyId
+		return x.TotalConnections
 	}
-	return ""
+	return 0
 }
 
-func (x *CloseConnectionRequest) GetConnId() string {
+func (x *StatsSummaryResponse) GetTotalBytesIn() int64 {
 	if x != nil {
-		return x.ConnId
+		return x.TotalBytesIn
 	}
-	return ""
+	return 0
 }
 
-type CloseConnectionResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	ErrorMessage  string                 `protobuf:"bytes,2,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *StatsSummaryResponse) GetTotalBytesOut() int64 {
+	if x != nil {
+		return x.TotalBytesOut
+	}
+	return 0
+}
+
+func (x *StatsSummaryResponse) GetUniqueIps() int64 {
+	if x != nil {
+		return x.UniqueIps
+	}
+	return 0
+}
+
+func (x *StatsSummaryResponse) GetUniqueCountries() int64 {
+	if x != nil {
+		return x.UniqueCountries
+	}
+	return 0
+}
+
+func (x *StatsSummaryResponse) GetBlockedTotal() int64 {
+	if x != nil {
+		return x.BlockedTotal
+	}
+	return 0
 }
 
-func (x *CloseConnectionResponse) Reset() {
-	*x = CloseConnectionResponse{}
-	mi := &file_proxy_proxy_proto_msgTypes[52]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *StatsSummaryResponse) GetAllowedTotal() int64 {
+	if x != nil {
+		return x.AllowedTotal
+	}
+	return 0
 }
 
-func (x *CloseConnectionResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *StatsSummaryResponse) GetActiveConnections() int64 {
+	if x != nil {
+		return x.ActiveConnections
+	}
+	return 0
 }
 
-func (*CloseConnectionResponse) ProtoMessage() {}
-
-func (x *CloseConnectionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proxy_proxy_proto_msgTypes[52]
+func (x *StatsSummaryResponse) GetProxyCount() int32 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.ProxyCount
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use CloseConnectionResponse.ProtoReflect.Descriptor instead.
-func (*CloseConnectionResponse) Descriptor() ([]byte, []int) {
-	return file_proxy_proxy_proto_rawDescGZIP(), []int{52}
+func (x *StatsSummaryResponse) GetTimestamp() *timestamp.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
 }
 
-func (x *CloseConnectionResponse) GetSuccess() bool {
+func (x *StatsSummaryResponse) GetTarpit() *TarpitStats {
 	if x != nil {
-		return x.Success
+		return x.Tarpit
 	}
-	return false
+	return nil
 }
 
-func (x *CloseConnectionResponse) GetErrorMessage() string {
+func (x *StatsSummaryResponse) GetAlertSinks() []*AlertSinkStats {
 	if x != nil {
-		return x.ErrorMessage
+		return x.AlertSinks
 	}
-	return ""
+	return nil
 }
 
-type CloseAllConnectionsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProxyId       string                 `protobuf:"bytes,1,opt,name=proxy_id,json=proxyId,proto3" json:"proxy_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// AlertSinkStats reports delivery to one node-local alert sink (webhook,
+// syslog or smtp).
+type AlertSinkStats struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Name            string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type            string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	SentTotal       int64                  `protobuf:"varint,3,opt,name=sent_total,json=sentTotal,proto3" json:"sent_total,omitempty"`
+	RetriesTotal    int64                  `protobuf:"varint,4,opt,name=retries_total,json=retriesTotal,proto3" json:"retries_total,omitempty"`            // failed delivery attempts that were retried
+	DeadLetterTotal int64                  `protobuf:"varint,5,opt,name=dead_letter_total,json=deadLetterTotal,proto3" json:"dead_letter_total,omitempty"` // alerts given up on, or dropped with the queue full
+	Queued          int64                  `protobuf:"varint,6,opt,name=queued,proto3" json:"queued,omitempty"`
+	LastError       string                 `protobuf:"bytes,7,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *CloseAllConnectionsRequest) Reset() {
-	*x = CloseAllConnectionsRequest{}
-	mi := &file_proxy_proxy_proto_msgTypes[53]
+func (x *AlertSinkStats) Reset() {
+	*x = AlertSinkStats{}
+	mi := &file_proxy_proxy_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CloseAllConnectionsRequest) String() string {
+func (x *AlertSinkStats) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CloseAllConnectionsRequest) ProtoMessage() {}
+func (*AlertSinkStats) ProtoMessage() {}
 
-func (x *CloseAllConnectionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proxy_proxy_proto_msgTypes[53]
+func (x *AlertSinkStats) ProtoReflect() protoreflect.Message {
+	mi := &file_proxy_proxy_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3742,96 +5210,90 @@ func (x *CloseAllConnectionsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CloseAllConnectionsRequest.ProtoReflect.Descriptor instead.
-func (*CloseAllConnectionsRequest) Descriptor() ([]byte, []int) {
-	return file_proxy_proxy_proto_rawDescGZIP(), []int{53}
+// Deprecated: Use AlertSinkStats.ProtoReflect.Descriptor instead.
+func (*AlertSinkStats) Descriptor() ([]byte, []int) {
+	return file_proxy_proxy_proto_rawDescGZIP(), []int{67}
 }
 
-func (x *CloseAllConnectionsRequest) GetProxyId() string {
+func (x *AlertSinkStats) GetName() string {
 	if x != nil {
-		return x.ProxyId
+		return x.Name
 	}
 	return ""
 }
 
-type CloseAllConnectionsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	ErrorMessage  string                 `protobuf:"bytes,2,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *CloseAllConnectionsResponse) Reset() {
-	*x = CloseAllConnectionsResponse{}
-	mi := &file_proxy_proxy_proto_msgTypes[54]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *AlertSinkStats) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
 }
 
-func (x *CloseAllConnectionsResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *AlertSinkStats) GetSentTotal() int64 {
+	if x != nil {
+		return x.SentTotal
+	}
+	return 0
 }
 
-func (*CloseAllConnectionsResponse) ProtoMessage() {}
-
-func (x *CloseAllConnectionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proxy_proxy_proto_msgTypes[54]
+func (x *AlertSinkStats) GetRetriesTotal() int64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.RetriesTotal
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use CloseAllConnectionsResponse.ProtoReflect.Descriptor instead.
-func (*CloseAllConnectionsResponse) Descriptor() ([]byte, []int) {
-	return file_proxy_proxy_proto_rawDescGZIP(), []int{54}
+func (x *AlertSinkStats) GetDeadLetterTotal() int64 {
+	if x != nil {
+		return x.DeadLetterTotal
+	}
+	return 0
 }
 
-func (x *CloseAllConnectionsResponse) GetSuccess() bool {
+func (x *AlertSinkStats) GetQueued() int64 {
 	if x != nil {
-		return x.Success
+		return x.Queued
 	}
-	return false
+	return 0
 }
 
-func (x *CloseAllConnectionsResponse) GetErrorMessage() string {
+func (x *AlertSinkStats) GetLastError() string {
 	if x != nil {
-		return x.ErrorMessage
+		return x.LastError
 	}
 	return ""
 }
 
-type GetIPStatsRequest struct {
+// TarpitStats reports node-wide tarpit budget usage. Limits of 0 mean
+// unlimited.
+type TarpitStats struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
-	Limit          int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
-	Offset         int32                  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
-	SourceIpFilter string                 `protobuf:"bytes,3,opt,name=source_ip_filter,json=sourceIpFilter,proto3" json:"source_ip_filter,omitempty"` // Prefix match
-	CountryFilter  string                 `protobuf:"bytes,4,opt,name=country_filter,json=countryFilter,proto3" json:"country_filter,omitempty"`
-	SortBy         string                 `protobuf:"bytes,5,opt,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"` // "recency", "connection_count", "bytes_total", "last_seen"
+	ActiveConns    int64                  `protobuf:"varint,1,opt,name=active_conns,json=activeConns,proto3" json:"active_conns,omitempty"`
+	MaxConns       int64                  `protobuf:"varint,2,opt,name=max_conns,json=maxConns,proto3" json:"max_conns,omitempty"`
+	MemoryBytes    int64                  `protobuf:"varint,3,opt,name=memory_bytes,json=memoryBytes,proto3" json:"memory_bytes,omitempty"` // estimated
+	MaxMemoryBytes int64                  `protobuf:"varint,4,opt,name=max_memory_bytes,json=maxMemoryBytes,proto3" json:"max_memory_bytes,omitempty"`
+	AdmittedTotal  int64                  `protobuf:"varint,5,opt,name=admitted_total,json=admittedTotal,proto3" json:"admitted_total,omitempty"`
+	RejectedTotal  int64                  `protobuf:"varint,6,opt,name=rejected_total,json=rejectedTotal,proto3" json:"rejected_total,omitempty"` // fast-closed because the budget was exhausted
+	Adaptive       bool                   `protobuf:"varint,7,opt,name=adaptive,proto3" json:"adaptive,omitempty"`                                // drip interval raised for repeat offenders
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
 
-func (x *GetIPStatsRequest) Reset() {
-	*x = GetIPStatsRequest{}
-	mi := &file_proxy_proxy_proto_msgTypes[55]
+func (x *TarpitStats) Reset() {
+	*x = TarpitStats{}
+	mi := &file_proxy_proxy_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetIPStatsRequest) String() string {
+func (x *TarpitStats) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetIPStatsRequest) ProtoMessage() {}
+func (*TarpitStats) ProtoMessage() {}
 
-func (x *GetIPStatsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proxy_proxy_proto_msgTypes[55]
+func (x *TarpitStats) ProtoReflect() protoreflect.Message {
+	mi := &file_proxy_proxy_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3842,81 +5304,88 @@ func (x *GetIPStatsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetIPStatsRequest.ProtoReflect.Descriptor instead.
-func (*GetIPStatsRequest) Descriptor() ([]byte, []int) {
-	return file_proxy_proxy_proto_rawDescGZIP(), []int{55}
+// Deprecated: Use TarpitStats.ProtoReflect.Descriptor instead.
+func (*TarpitStats) Descriptor() ([]byte, []int) {
+	return file_proxy_proxy_proto_rawDescGZIP(), []int{68}
 }
 
-func (x *GetIPStatsRequest) GetLimit() int32 {
+func (x *TarpitStats) GetActiveConns() int64 {
 	if x != nil {
-		return x.Limit
+		return x.ActiveConns
 	}
 	return 0
 }
 
-func (x *GetIPStatsRequest) GetOffset() int32 {
+func (x *TarpitStats) GetMaxConns() int64 {
 	if x != nil {
-		return x.Offset
+		return x.MaxConns
 	}
 	return 0
 }
 
-func (x *GetIPStatsRequest) GetSourceIpFilter() string {
+func (x *TarpitStats) GetMemoryBytes() int64 {
 	if x != nil {
-		return x.SourceIpFilter
+		return x.MemoryBytes
 	}
-	return ""
+	return 0
 }
 
-func (x *GetIPStatsRequest) GetCountryFilter() string {
+func (x *TarpitStats) GetMaxMemoryBytes() int64 {
 	if x != nil {
-		return x.CountryFilter
+		return x.MaxMemoryBytes
 	}
-	return ""
+	return 0
 }
 
-func (x *GetIPStatsRequest) GetSortBy() string {
+func (x *TarpitStats) GetAdmittedTotal() int64 {
 	if x != nil {
-		return x.SortBy
+		return x.AdmittedTotal
 	}
-	return ""
+	return 0
 }
 
-type IPStatsResult struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	SourceIp        string                 `protobuf:"bytes,1,opt,name=source_ip,json=sourceIp,proto3" json:"source_ip,omitempty"`
-	FirstSeen       *timestamp.Timestamp   `protobuf:"bytes,2,opt,name=first_seen,json=firstSeen,proto3" json:"first_seen,omitempty"`
-	LastSeen        *timestamp.Timestamp   `protobuf:"bytes,3,opt,name=last_seen,json=lastSeen,proto3" json:"last_seen,omitempty"`
-	ConnectionCount int64                  `protobuf:"varint,4,opt,name=connection_count,json=connectionCount,proto3" json:"connection_count,omitempty"`
-	TotalBytesIn    int64                  `protobuf:"varint,5,opt,name=total_bytes_in,json=totalBytesIn,proto3" json:"total_bytes_in,omitempty"`
-	TotalBytesOut   int64                  `protobuf:"varint,6,opt,name=total_bytes_out,json=totalBytesOut,proto3" json:"total_bytes_out,omitempty"`
-	TotalDurationMs int64                  `protobuf:"varint,7,opt,name=total_duration_ms,json=totalDurationMs,proto3" json:"total_duration_ms,omitempty"`
-	BlockedCount    int64                  `protobuf:"varint,8,opt,name=blocked_count,json=blockedCount,proto3" json:"blocked_count,omitempty"`
-	AllowedCount    int64                  `protobuf:"varint,9,opt,name=allowed_count,json=allowedCount,proto3" json:"allowed_count,omitempty"`
-	// Geo Info
-	GeoCountry    string  `protobuf:"bytes,10,opt,name=geo_country,json=geoCountry,proto3" json:"geo_country,omitempty"`
-	GeoCity       string  `protobuf:"bytes,11,opt,name=geo_city,json=geoCity,proto3" json:"geo_city,omitempty"`
-	GeoIsp        string  `protobuf:"bytes,12,opt,name=geo_isp,json=geoIsp,proto3" json:"geo_isp,omitempty"`
-	RecencyWeight float64 `protobuf:"fixed64,13,opt,name=recency_weight,json=recencyWeight,proto3" json:"recency_weight,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *TarpitStats) GetRejectedTotal() int64 {
+	if x != nil {
+		return x.RejectedTotal
+	}
+	return 0
 }
 
-func (x *IPStatsResult) Reset() {
-	*x = IPStatsResult{}
-	mi := &file_proxy_proxy_proto_msgTypes[56]
+func (x *TarpitStats) GetAdaptive() bool {
+	if x != nil {
+		return x.Adaptive
+	}
+	return false
+}
+
+type ResolveApprovalRequest struct {
+	state           protoimpl.MessageState       `protogen:"open.v1"`
+	ReqId           string                       `protobuf:"bytes,1,opt,name=req_id,json=reqId,proto3" json:"req_id,omitempty"`
+	Action          common.ApprovalActionType    `protobuf:"varint,2,opt,name=action,proto3,enum=nitella.ApprovalActionType" json:"action,omitempty"`
+	RetentionMode   common.ApprovalRetentionMode `protobuf:"varint,3,opt,name=retention_mode,json=retentionMode,proto3,enum=nitella.ApprovalRetentionMode" json:"retention_mode,omitempty"`
+	DurationSeconds int64                        `protobuf:"varint,4,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`
+	Reason          string                       `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	ApproverKey     []byte                       `protobuf:"bytes,6,opt,name=approver_key,json=approverKey,proto3" json:"approver_key,omitempty"` // Ed25519 public key of the approver signing this vote
+	Signature       []byte                       `protobuf:"bytes,7,opt,name=signature,proto3" json:"signature,omitempty"`                        // Approver's signature over the vote (quorum rules)
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ResolveApprovalRequest) Reset() {
+	*x = ResolveApprovalRequest{}
+	mi := &file_proxy_proxy_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *IPStatsResult) String() string {
+func (x *ResolveApprovalRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IPStatsResult) ProtoMessage() {}
+func (*ResolveApprovalRequest) ProtoMessage() {}
 
-func (x *IPStatsResult) ProtoReflect() protoreflect.Message {
-	mi := &file_proxy_proxy_proto_msgTypes[56]
+func (x *ResolveApprovalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proxy_proxy_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3927,125 +5396,85 @@ func (x *IPStatsResult) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IPStatsResult.ProtoReflect.Descriptor instead.
-func (*IPStatsResult) Descriptor() ([]byte, []int) {
-	return file_proxy_proxy_proto_rawDescGZIP(), []int{56}
+// Deprecated: Use ResolveApprovalRequest.ProtoReflect.Descriptor instead.
+func (*ResolveApprovalRequest) Descriptor() ([]byte, []int) {
+	return file_proxy_proxy_proto_rawDescGZIP(), []int{69}
 }
 
-func (x *IPStatsResult) GetSourceIp() string {
+func (x *ResolveApprovalRequest) GetReqId() string {
 	if x != nil {
-		return x.SourceIp
+		return x.ReqId
 	}
 	return ""
 }
 
-func (x *IPStatsResult) GetFirstSeen() *timestamp.Timestamp {
-	if x != nil {
-		return x.FirstSeen
-	}
-	return nil
-}
-
-func (x *IPStatsResult) GetLastSeen() *timestamp.Timestamp {
-	if x != nil {
-		return x.LastSeen
-	}
-	return nil
-}
-
-func (x *IPStatsResult) GetConnectionCount() int64 {
-	if x != nil {
-		return x.ConnectionCount
-	}
-	return 0
-}
-
-func (x *IPStatsResult) GetTotalBytesIn() int64 {
-	if x != nil {
-		return x.TotalBytesIn
-	}
-	return 0
-}
-
-func (x *IPStatsResult) GetTotalBytesOut() int64 {
-	if x != nil {
-		return x.TotalBytesOut
-	}
-	return 0
-}
-
-func (x *IPStatsResult) GetTotalDurationMs() int64 {
-	if x != nil {
-		return x.TotalDurationMs
-	}
-	return 0
-}
-
-func (x *IPStatsResult) GetBlockedCount() int64 {
+func (x *ResolveApprovalRequest) GetAction() common.ApprovalActionType {
 	if x != nil {
-		return x.BlockedCount
+		return x.Action
 	}
-	return 0
+	return common.ApprovalActionType(0)
 }
 
-func (x *IPStatsResult) GetAllowedCount() int64 {
+func (x *ResolveApprovalRequest) GetRetentionMode() common.ApprovalRetentionMode {
 	if x != nil {
-		return x.AllowedCount
+		return x.RetentionMode
 	}
-	return 0
+	return common.ApprovalRetentionMode(0)
 }
 
-func (x *IPStatsResult) GetGeoCountry() string {
+func (x *ResolveApprovalRequest) GetDurationSeconds() int64 {
 	if x != nil {
-		return x.GeoCountry
+		return x.DurationSeconds
 	}
-	return ""
+	return 0
 }
 
-func (x *IPStatsResult) GetGeoCity() string {
+func (x *ResolveApprovalRequest) GetReason() string {
 	if x != nil {
-		return x.GeoCity
+		return x.Reason
 	}
 	return ""
 }
 
-func (x *IPStatsResult) GetGeoIsp() string {
+func (x *ResolveApprovalRequest) GetApproverKey() []byte {
 	if x != nil {
-		return x.GeoIsp
+		return x.ApproverKey
 	}
-	return ""
+	return nil
 }
 
-func (x *IPStatsResult) GetRecencyWeight() float64 {
+func (x *ResolveApprovalRequest) GetSignature() []byte {
 	if x != nil {
-		return x.RecencyWeight
+		return x.Signature
 	}
-	return 0
+	return nil
 }
 
-type GetIPStatsResponse struct {
+type ResolveApprovalResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Stats         []*IPStatsResult       `protobuf:"bytes,1,rep,name=stats,proto3" json:"stats,omitempty"`
-	TotalCount    int64                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	ErrorMessage  string                 `protobuf:"bytes,2,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	Approvals     int32                  `protobuf:"varint,3,opt,name=approvals,proto3" json:"approvals,omitempty"` // Allow votes so far (quorum rules)
+	Quorum        int32                  `protobuf:"varint,4,opt,name=quorum,proto3" json:"quorum,omitempty"`       // Allow votes needed; pending until approvals reach it
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetIPStatsResponse) Reset() {
-	*x = GetIPStatsResponse{}
-	mi := &file_proxy_proxy_proto_msgTypes[57]
+func (x *ResolveApprovalResponse) Reset() {
+	*x = ResolveApprovalResponse{}
+	mi := &file_proxy_proxy_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetIPStatsResponse) String() string {
+func (x *ResolveApprovalResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetIPStatsResponse) ProtoMessage() {}
+func (*ResolveApprovalResponse) ProtoMessage() {}
 
-func (x *GetIPStatsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proxy_proxy_proto_msgTypes[57]
+func (x *ResolveApprovalResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proxy_proxy_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4056,49 +5485,79 @@ func (x *GetIPStatsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetIPStatsResponse.ProtoReflect.Descriptor instead.
-func (*GetIPStatsResponse) Descriptor() ([]byte, []int) {
-	return file_proxy_proxy_proto_rawDescGZIP(), []int{57}
+// Deprecated: Use ResolveApprovalResponse.ProtoReflect.Descriptor instead.
+func (*ResolveApprovalResponse) Descriptor() ([]byte, []int) {
+	return file_proxy_proxy_proto_rawDescGZIP(), []int{70}
 }
 
-func (x *GetIPStatsResponse) GetStats() []*IPStatsResult {
+func (x *ResolveApprovalResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Stats
+		return x.Success
 	}
-	return nil
+	return false
 }
 
-func (x *GetIPStatsResponse) GetTotalCount() int64 {
+func (x *ResolveApprovalResponse) GetErrorMessage() string {
 	if x != nil {
-		return x.TotalCount
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *ResolveApprovalResponse) GetApprovals() int32 {
+	if x != nil {
+		return x.Approvals
 	}
 	return 0
 }
 
-type GetGeoStatsRequest struct {
+func (x *ResolveApprovalResponse) GetQuorum() int32 {
+	if x != nil {
+		return x.Quorum
+	}
+	return 0
+}
+
+type ActiveApproval struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"` // "country", "city", "isp"
-	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
-	Offset        int32                  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"` // Unique key (ip:rule_id:tls_session)
+	SourceIp      string                 `protobuf:"bytes,2,opt,name=source_ip,json=sourceIp,proto3" json:"source_ip,omitempty"`
+	RuleId        string                 `protobuf:"bytes,3,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	ProxyId       string                 `protobuf:"bytes,4,opt,name=proxy_id,json=proxyId,proto3" json:"proxy_id,omitempty"`
+	TlsSessionId  string                 `protobuf:"bytes,5,opt,name=tls_session_id,json=tlsSessionId,proto3" json:"tls_session_id,omitempty"` // Empty if non-TLS
+	Allowed       bool                   `protobuf:"varint,6,opt,name=allowed,proto3" json:"allowed,omitempty"`                                // true=allow, false=block
+	CreatedAt     *timestamp.Timestamp   `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ExpiresAt     *timestamp.Timestamp   `protobuf:"bytes,8,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	BytesIn       int64                  `protobuf:"varint,9,opt,name=bytes_in,json=bytesIn,proto3" json:"bytes_in,omitempty"`
+	BytesOut      int64                  `protobuf:"varint,10,opt,name=bytes_out,json=bytesOut,proto3" json:"bytes_out,omitempty"`
+	BlockedCount  int64                  `protobuf:"varint,11,opt,name=blocked_count,json=blockedCount,proto3" json:"blocked_count,omitempty"` // For block decisions
+	ConnIds       []string               `protobuf:"bytes,12,rep,name=conn_ids,json=connIds,proto3" json:"conn_ids,omitempty"`                 // Active connection IDs
+	GeoCountry    string                 `protobuf:"bytes,13,opt,name=geo_country,json=geoCountry,proto3" json:"geo_country,omitempty"`
+	GeoCity       string                 `protobuf:"bytes,14,opt,name=geo_city,json=geoCity,proto3" json:"geo_city,omitempty"`
+	GeoIsp        string                 `protobuf:"bytes,15,opt,name=geo_isp,json=geoIsp,proto3" json:"geo_isp,omitempty"`
+	Pending       bool                   `protobuf:"varint,16,opt,name=pending,proto3" json:"pending,omitempty"`     // Quorum request still collecting votes (key = request ID)
+	Approvals     int32                  `protobuf:"varint,17,opt,name=approvals,proto3" json:"approvals,omitempty"` // Allow votes so far (pending only)
+	Quorum        int32                  `protobuf:"varint,18,opt,name=quorum,proto3" json:"quorum,omitempty"`       // Allow votes needed (pending only)
+	Approvers     []string               `protobuf:"bytes,19,rep,name=approvers,proto3" json:"approvers,omitempty"`  // Fingerprints of approvers that voted to allow (pending only)
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetGeoStatsRequest) Reset() {
-	*x = GetGeoStatsRequest{}
-	mi := &file_proxy_proxy_proto_msgTypes[58]
+func (x *ActiveApproval) Reset() {
+	*x = ActiveApproval{}
+	mi := &file_proxy_proxy_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetGeoStatsRequest) String() string {
+func (x *ActiveApproval) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetGeoStatsRequest) ProtoMessage() {}
+func (*ActiveApproval) ProtoMessage() {}
 
-func (x *GetGeoStatsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proxy_proxy_proto_msgTypes[58]
+func (x *ActiveApproval) ProtoReflect() protoreflect.Message {
+	mi := &file_proxy_proxy_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4109,189 +5568,167 @@ func (x *GetGeoStatsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetGeoStatsRequest.ProtoReflect.Descriptor instead.
-func (*GetGeoStatsRequest) Descriptor() ([]byte, []int) {
-	return file_proxy_proxy_proto_rawDescGZIP(), []int{58}
+// Deprecated: Use ActiveApproval.ProtoReflect.Descriptor instead.
+func (*ActiveApproval) Descriptor() ([]byte, []int) {
+	return file_proxy_proxy_proto_rawDescGZIP(), []int{71}
 }
 
-func (x *GetGeoStatsRequest) GetType() string {
+func (x *ActiveApproval) GetKey() string {
 	if x != nil {
-		return x.Type
+		return x.Key
 	}
 	return ""
 }
 
-func (x *GetGeoStatsRequest) GetLimit() int32 {
+func (x *ActiveApproval) GetSourceIp() string {
 	if x != nil {
-		return x.Limit
+		return x.SourceIp
 	}
-	return 0
+	return ""
 }
 
-func (x *GetGeoStatsRequest) GetOffset() int32 {
+func (x *ActiveApproval) GetRuleId() string {
 	if x != nil {
-		return x.Offset
+		return x.RuleId
 	}
-	return 0
-}
-
-type GeoStatsResult struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Type            string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
-	Value           string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
-	ConnectionCount int64                  `protobuf:"varint,3,opt,name=connection_count,json=connectionCount,proto3" json:"connection_count,omitempty"`
-	UniqueIps       int64                  `protobuf:"varint,4,opt,name=unique_ips,json=uniqueIps,proto3" json:"unique_ips,omitempty"`
-	TotalBytesIn    int64                  `protobuf:"varint,5,opt,name=total_bytes_in,json=totalBytesIn,proto3" json:"total_bytes_in,omitempty"`
-	TotalBytesOut   int64                  `protobuf:"varint,6,opt,name=total_bytes_out,json=totalBytesOut,proto3" json:"total_bytes_out,omitempty"`
-	BlockedCount    int64                  `protobuf:"varint,7,opt,name=blocked_count,json=blockedCount,proto3" json:"blocked_count,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
-}
-
-func (x *GeoStatsResult) Reset() {
-	*x = GeoStatsResult{}
-	mi := &file_proxy_proxy_proto_msgTypes[59]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+	return ""
 }
 
-func (x *GeoStatsResult) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ActiveApproval) GetProxyId() string {
+	if x != nil {
+		return x.ProxyId
+	}
+	return ""
 }
 
-func (*GeoStatsResult) ProtoMessage() {}
-
-func (x *GeoStatsResult) ProtoReflect() protoreflect.Message {
-	mi := &file_proxy_proxy_proto_msgTypes[59]
+func (x *ActiveApproval) GetTlsSessionId() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.TlsSessionId
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use GeoStatsResult.ProtoReflect.Descriptor instead.
-func (*GeoStatsResult) Descriptor() ([]byte, []int) {
-	return file_proxy_proxy_proto_rawDescGZIP(), []int{59}
+func (x *ActiveApproval) GetAllowed() bool {
+	if x != nil {
+		return x.Allowed
+	}
+	return false
 }
 
-func (x *GeoStatsResult) GetType() string {
+func (x *ActiveApproval) GetCreatedAt() *timestamp.Timestamp {
 	if x != nil {
-		return x.Type
+		return x.CreatedAt
 	}
-	return ""
+	return nil
 }
 
-func (x *GeoStatsResult) GetValue() string {
+func (x *ActiveApproval) GetExpiresAt() *timestamp.Timestamp {
 	if x != nil {
-		return x.Value
+		return x.ExpiresAt
 	}
-	return ""
+	return nil
 }
 
-func (x *GeoStatsResult) GetConnectionCount() int64 {
+func (x *ActiveApproval) GetBytesIn() int64 {
 	if x != nil {
-		return x.ConnectionCount
+		return x.BytesIn
 	}
 	return 0
 }
 
-func (x *GeoStatsResult) GetUniqueIps() int64 {
+func (x *ActiveApproval) GetBytesOut() int64 {
 	if x != nil {
-		return x.UniqueIps
+		return x.BytesOut
 	}
 	return 0
 }
 
-func (x *GeoStatsResult) GetTotalBytesIn() int64 {
+func (x *ActiveApproval) GetBlockedCount() int64 {
 	if x != nil {
-		return x.TotalBytesIn
+		return x.BlockedCount
 	}
 	return 0
 }
 
-func (x *GeoStatsResult) GetTotalBytesOut() int64 {
+func (x *ActiveApproval) GetConnIds() []string {
 	if x != nil {
-		return x.TotalBytesOut
+		return x.ConnIds
 	}
-	return 0
+	return nil
 }
 
-func (x *GeoStatsResult) GetBlockedCount() int64 {
+func (x *ActiveApproval) GetGeoCountry() string {
 	if x != nil {
-		return x.BlockedCount
+		return x.GeoCountry
 	}
-	return 0
+	return ""
 }
 
-type GetGeoStatsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Stats         []*GeoStatsResult      `protobuf:"bytes,1,rep,name=stats,proto3" json:"stats,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *ActiveApproval) GetGeoCity() string {
+	if x != nil {
+		return x.GeoCity
+	}
+	return ""
 }
 
-func (x *GetGeoStatsResponse) Reset() {
-	*x = GetGeoStatsResponse{}
-	mi := &file_proxy_proxy_proto_msgTypes[60]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *ActiveApproval) GetGeoIsp() string {
+	if x != nil {
+		return x.GeoIsp
+	}
+	return ""
 }
 
-func (x *GetGeoStatsResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ActiveApproval) GetPending() bool {
+	if x != nil {
+		return x.Pending
+	}
+	return false
 }
 
-func (*GetGeoStatsResponse) ProtoMessage() {}
-
-func (x *GetGeoStatsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proxy_proxy_proto_msgTypes[60]
+func (x *ActiveApproval) GetApprovals() int32 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Approvals
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use GetGeoStatsResponse.ProtoReflect.Descriptor instead.
-func (*GetGeoStatsResponse) Descriptor() ([]byte, []int) {
-	return file_proxy_proxy_proto_rawDescGZIP(), []int{60}
+func (x *ActiveApproval) GetQuorum() int32 {
+	if x != nil {
+		return x.Quorum
+	}
+	return 0
 }
 
-func (x *GetGeoStatsResponse) GetStats() []*GeoStatsResult {
+func (x *ActiveApproval) GetApprovers() []string {
 	if x != nil {
-		return x.Stats
+		return x.Approvers
 	}
 	return nil
 }
 
-type GetStatsSummaryRequest struct {
+type ListActiveApprovalsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProxyId       string                 `protobuf:"bytes,1,opt,name=proxy_id,json=proxyId,proto3" json:"proxy_id,omitempty"`    // Optional: filter by proxy
+	SourceIp      string                 `protobuf:"bytes,2,opt,name=source_ip,json=sourceIp,proto3" json:"source_ip,omitempty"` // Optional: filter by IP
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetStatsSummaryRequest) Reset() {
-	*x = GetStatsSummaryRequest{}
-	mi := &file_proxy_proxy_proto_msgTypes[61]
+func (x *ListActiveApprovalsRequest) Reset() {
+	*x = ListActiveApprovalsRequest{}
+	mi := &file_proxy_proxy_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetStatsSummaryRequest) String() string {
+func (x *ListActiveApprovalsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetStatsSummaryRequest) ProtoMessage() {}
+func (*ListActiveApprovalsRequest) ProtoMessage() {}
 
-func (x *GetStatsSummaryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proxy_proxy_proto_msgTypes[61]
+func (x *ListActiveApprovalsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proxy_proxy_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4302,42 +5739,47 @@ func (x *GetStatsSummaryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetStatsSummaryRequest.ProtoReflect.Descriptor instead.
-func (*GetStatsSummaryRequest) Descriptor() ([]byte, []int) {
-	return file_proxy_proxy_proto_rawDescGZIP(), []int{61}
+// Deprecated: Use ListActiveApprovalsRequest.ProtoReflect.Descriptor instead.
+func (*ListActiveApprovalsRequest) Descriptor() ([]byte, []int) {
+	return file_proxy_proxy_proto_rawDescGZIP(), []int{72}
 }
 
-type StatsSummaryResponse struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	TotalConnections  int64                  `protobuf:"varint,1,opt,name=total_connections,json=totalConnections,proto3" json:"total_connections,omitempty"`
-	TotalBytesIn      int64                  `protobuf:"varint,2,opt,name=total_bytes_in,json=totalBytesIn,proto3" json:"total_bytes_in,omitempty"`
-	TotalBytesOut     int64                  `protobuf:"varint,3,opt,name=total_bytes_out,json=totalBytesOut,proto3" json:"total_bytes_out,omitempty"`
-	UniqueIps         int64                  `protobuf:"varint,4,opt,name=unique_ips,json=uniqueIps,proto3" json:"unique_ips,omitempty"`
-	UniqueCountries   int64                  `protobuf:"varint,5,opt,name=unique_countries,json=uniqueCountries,proto3" json:"unique_countries,omitempty"`
-	BlockedTotal      int64                  `protobuf:"varint,6,opt,name=blocked_total,json=blockedTotal,proto3" json:"blocked_total,omitempty"`
-	AllowedTotal      int64                  `protobuf:"varint,7,opt,name=allowed_total,json=allowedTotal,proto3" json:"allowed_total,omitempty"`
-	ActiveConnections int64                  `protobuf:"varint,8,opt,name=active_connections,json=activeConnections,proto3" json:"active_connections,omitempty"`
-	ProxyCount        int32                  `protobuf:"varint,9,opt,name=proxy_count,json=proxyCount,proto3" json:"proxy_count,omitempty"`
-	Timestamp         *timestamp.Timestamp   `protobuf:"bytes,10,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+func (x *ListActiveApprovalsRequest) GetProxyId() string {
+	if x != nil {
+		return x.ProxyId
+	}
+	return ""
 }
 
-func (x *StatsSummaryResponse) Reset() {
-	*x = StatsSummaryResponse{}
-	mi := &file_proxy_proxy_proto_msgTypes[62]
+func (x *ListActiveApprovalsRequest) GetSourceIp() string {
+	if x != nil {
+		return x.SourceIp
+	}
+	return ""
+}
+
+type ListActiveApprovalsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Approvals     []*ActiveApproval      `protobuf:"bytes,1,rep,name=approvals,proto3" json:"approvals,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListActiveApprovalsResponse) Reset() {
+	*x = ListActiveApprovalsResponse{}
+	mi := &file_proxy_proxy_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StatsSummaryResponse) String() string {
+func (x *ListActiveApprovalsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StatsSummaryResponse) ProtoMessage() {}
+func (*ListActiveApprovalsResponse) ProtoMessage() {}
 
-func (x *StatsSummaryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proxy_proxy_proto_msgTypes[62]
+func (x *ListActiveApprovalsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proxy_proxy_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4348,107 +5790,94 @@ func (x *StatsSummaryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StatsSummaryResponse.ProtoReflect.Descriptor instead.
-func (*StatsSummaryResponse) Descriptor() ([]byte, []int) {
-	return file_proxy_proxy_proto_rawDescGZIP(), []int{62}
-}
-
-func (x *StatsSummaryResponse) GetTotalConnections() int64 {
-	if x != nil {
-		return x.TotalConnections
-	}
-	return 0
+// Deprecated: Use ListActiveApprovalsResponse.ProtoReflect.Descriptor instead.
+func (*ListActiveApprovalsResponse) Descriptor() ([]byte, []int) {
+	return file_proxy_proxy_proto_rawDescGZIP(), []int{73}
 }
 
-func (x *StatsSummaryResponse) GetTotalBytesIn() int64 {
+func (x *ListActiveApprovalsResponse) GetApprovals() []*ActiveApproval {
 	if x != nil {
-		return x.TotalBytesIn
+		return x.Approvals
 	}
-	return 0
+	return nil
 }
 
-func (x *StatsSummaryResponse) GetTotalBytesOut() int64 {
-	if x != nil {
-		return x.TotalBytesOut
-	}
-	return 0
+type CancelApprovalRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Key              string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`                                                    // Approval key to cancel
+	CloseConnections bool                   `protobuf:"varint,2,opt,name=close_connections,json=closeConnections,proto3" json:"close_connections,omitempty"` // Also close active connections
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *StatsSummaryResponse) GetUniqueIps() int64 {
-	if x != nil {
-		return x.UniqueIps
-	}
-	return 0
+func (x *CancelApprovalRequest) Reset() {
+	*x = CancelApprovalRequest{}
+	mi := &file_proxy_proxy_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *StatsSummaryResponse) GetUniqueCountries() int64 {
-	if x != nil {
-		return x.UniqueCountries
-	}
-	return 0
+func (x *CancelApprovalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *StatsSummaryResponse) GetBlockedTotal() int64 {
-	if x != nil {
-		return x.BlockedTotal
-	}
-	return 0
-}
+func (*CancelApprovalRequest) ProtoMessage() {}
 
-func (x *StatsSummaryResponse) GetAllowedTotal() int64 {
+func (x *CancelApprovalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proxy_proxy_proto_msgTypes[74]
 	if x != nil {
-		return x.AllowedTotal
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *StatsSummaryResponse) GetActiveConnections() int64 {
-	if x != nil {
-		return x.ActiveConnections
-	}
-	return 0
+// Deprecated: Use CancelApprovalRequest.ProtoReflect.Descriptor instead.
+func (*CancelApprovalRequest) Descriptor() ([]byte, []int) {
+	return file_proxy_proxy_proto_rawDescGZIP(), []int{74}
 }
 
-func (x *StatsSummaryResponse) GetProxyCount() int32 {
+func (x *CancelApprovalRequest) GetKey() string {
 	if x != nil {
-		return x.ProxyCount
+		return x.Key
 	}
-	return 0
+	return ""
 }
 
-func (x *StatsSummaryResponse) GetTimestamp() *timestamp.Timestamp {
+func (x *CancelApprovalRequest) GetCloseConnections() bool {
 	if x != nil {
-		return x.Timestamp
+		return x.CloseConnections
 	}
-	return nil
+	return false
 }
 
-type ResolveApprovalRequest struct {
-	state           protoimpl.MessageState       `protogen:"open.v1"`
-	ReqId           string                       `protobuf:"bytes,1,opt,name=req_id,json=reqId,proto3" json:"req_id,omitempty"`
-	Action          common.ApprovalActionType    `protobuf:"varint,2,opt,name=action,proto3,enum=nitella.ApprovalActionType" json:"action,omitempty"`
-	RetentionMode   common.ApprovalRetentionMode `protobuf:"varint,3,opt,name=retention_mode,json=retentionMode,proto3,enum=nitella.ApprovalRetentionMode" json:"retention_mode,omitempty"`
-	DurationSeconds int64                        `protobuf:"varint,4,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`
-	Reason          string                       `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type CancelApprovalResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Success           bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	ErrorMessage      string                 `protobuf:"bytes,2,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	ConnectionsClosed int32                  `protobuf:"varint,3,opt,name=connections_closed,json=connectionsClosed,proto3" json:"connections_closed,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *ResolveApprovalRequest) Reset() {
-	*x = ResolveApprovalRequest{}
-	mi := &file_proxy_proxy_proto_msgTypes[63]
+func (x *CancelApprovalResponse) Reset() {
+	*x = CancelApprovalResponse{}
+	mi := &file_proxy_proxy_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ResolveApprovalRequest) String() string {
+func (x *CancelApprovalResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResolveApprovalRequest) ProtoMessage() {}
+func (*CancelApprovalResponse) ProtoMessage() {}
 
-func (x *ResolveApprovalRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proxy_proxy_proto_msgTypes[63]
+func (x *CancelApprovalResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proxy_proxy_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4459,69 +5888,56 @@ func (x *ResolveApprovalRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResolveApprovalRequest.ProtoReflect.Descriptor instead.
-func (*ResolveApprovalRequest) Descriptor() ([]byte, []int) {
-	return file_proxy_proxy_proto_rawDescGZIP(), []int{63}
-}
-
-func (x *ResolveApprovalRequest) GetReqId() string {
-	if x != nil {
-		return x.ReqId
-	}
-	return ""
+// Deprecated: Use CancelApprovalResponse.ProtoReflect.Descriptor instead.
+func (*CancelApprovalResponse) Descriptor() ([]byte, []int) {
+	return file_proxy_proxy_proto_rawDescGZIP(), []int{75}
 }
 
-func (x *ResolveApprovalRequest) GetAction() common.ApprovalActionType {
+func (x *CancelApprovalResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Action
+		return x.Success
 	}
-	return common.ApprovalActionType(0)
+	return false
 }
 
-func (x *ResolveApprovalRequest) GetRetentionMode() common.ApprovalRetentionMode {
+func (x *CancelApprovalResponse) GetErrorMessage() string {
 	if x != nil {
-		return x.RetentionMode
+		return x.ErrorMessage
 	}
-	return common.ApprovalRetentionMode(0)
+	return ""
 }
 
-func (x *ResolveApprovalRequest) GetDurationSeconds() int64 {
+func (x *CancelApprovalResponse) GetConnectionsClosed() int32 {
 	if x != nil {
-		return x.DurationSeconds
+		return x.ConnectionsClosed
 	}
 	return 0
 }
 
-func (x *ResolveApprovalRequest) GetReason() string {
-	if x != nil {
-		return x.Reason
-	}
-	return ""
-}
-
-type ResolveApprovalResponse struct {
+type GetMockTranscriptsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	ErrorMessage  string                 `protobuf:"bytes,2,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	ConnId        string                 `protobuf:"bytes,1,opt,name=conn_id,json=connId,proto3" json:"conn_id,omitempty"`       // Optional: a single session
+	SourceIp      string                 `protobuf:"bytes,2,opt,name=source_ip,json=sourceIp,proto3" json:"source_ip,omitempty"` // Optional: filter by IP
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`                      // Default 20
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ResolveApprovalResponse) Reset() {
-	*x = ResolveApprovalResponse{}
-	mi := &file_proxy_proxy_proto_msgTypes[64]
+func (x *GetMockTranscriptsRequest) Reset() {
+	*x = GetMockTranscriptsRequest{}
+	mi := &file_proxy_proxy_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ResolveApprovalResponse) String() string {
+func (x *GetMockTranscriptsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResolveApprovalResponse) ProtoMessage() {}
+func (*GetMockTranscriptsRequest) ProtoMessage() {}
 
-func (x *ResolveApprovalResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proxy_proxy_proto_msgTypes[64]
+func (x *GetMockTranscriptsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proxy_proxy_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4532,61 +5948,62 @@ func (x *ResolveApprovalResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResolveApprovalResponse.ProtoReflect.Descriptor instead.
-func (*ResolveApprovalResponse) Descriptor() ([]byte, []int) {
-	return file_proxy_proxy_proto_rawDescGZIP(), []int{64}
+// Deprecated: Use GetMockTranscriptsRequest.ProtoReflect.Descriptor instead.
+func (*GetMockTranscriptsRequest) Descriptor() ([]byte, []int) {
+	return file_proxy_proxy_proto_rawDescGZIP(), []int{76}
 }
 
-func (x *ResolveApprovalResponse) GetSuccess() bool {
+func (x *GetMockTranscriptsRequest) GetConnId() string {
 	if x != nil {
-		return x.Success
+		return x.ConnId
 	}
-	return false
+	return ""
 }
 
-func (x *ResolveApprovalResponse) GetErrorMessage() string {
+func (x *GetMockTranscriptsRequest) GetSourceIp() string {
 	if x != nil {
-		return x.ErrorMessage
+		return x.SourceIp
 	}
 	return ""
 }
 
-type ActiveApproval struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"` // Unique key (ip:rule_id:tls_session)
-	SourceIp      string                 `protobuf:"bytes,2,opt,name=source_ip,json=sourceIp,proto3" json:"source_ip,omitempty"`
-	RuleId        string                 `protobuf:"bytes,3,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
-	ProxyId       string                 `protobuf:"bytes,4,opt,name=proxy_id,json=proxyId,proto3" json:"proxy_id,omitempty"`
-	TlsSessionId  string                 `protobuf:"bytes,5,opt,name=tls_session_id,json=tlsSessionId,proto3" json:"tls_session_id,omitempty"` // Empty if non-TLS
-	Allowed       bool                   `protobuf:"varint,6,opt,name=allowed,proto3" json:"allowed,omitempty"`                                // true=allow, false=block
-	CreatedAt     *timestamp.Timestamp   `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	ExpiresAt     *timestamp.Timestamp   `protobuf:"bytes,8,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
-	BytesIn       int64                  `protobuf:"varint,9,opt,name=bytes_in,json=bytesIn,proto3" json:"bytes_in,omitempty"`
-	BytesOut      int64                  `protobuf:"varint,10,opt,name=bytes_out,json=bytesOut,proto3" json:"bytes_out,omitempty"`
-	BlockedCount  int64                  `protobuf:"varint,11,opt,name=blocked_count,json=blockedCount,proto3" json:"blocked_count,omitempty"` // For block decisions
-	ConnIds       []string               `protobuf:"bytes,12,rep,name=conn_ids,json=connIds,proto3" json:"conn_ids,omitempty"`                 // Active connection IDs
-	GeoCountry    string                 `protobuf:"bytes,13,opt,name=geo_country,json=geoCountry,proto3" json:"geo_country,omitempty"`
-	GeoCity       string                 `protobuf:"bytes,14,opt,name=geo_city,json=geoCity,proto3" json:"geo_city,omitempty"`
-	GeoIsp        string                 `protobuf:"bytes,15,opt,name=geo_isp,json=geoIsp,proto3" json:"geo_isp,omitempty"`
+func (x *GetMockTranscriptsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// MockTranscript is a fake shell session recorded by a mock listener.
+type MockTranscript struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ConnId        string                 `protobuf:"bytes,1,opt,name=conn_id,json=connId,proto3" json:"conn_id,omitempty"`
+	SourceIp      string                 `protobuf:"bytes,2,opt,name=source_ip,json=sourceIp,proto3" json:"source_ip,omitempty"`
+	SourcePort    int32                  `protobuf:"varint,3,opt,name=source_port,json=sourcePort,proto3" json:"source_port,omitempty"`
+	RuleId        string                 `protobuf:"bytes,4,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	StartTime     *timestamp.Timestamp   `protobuf:"bytes,5,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	DurationMs    int64                  `protobuf:"varint,6,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
+	GeoCountry    string                 `protobuf:"bytes,7,opt,name=geo_country,json=geoCountry,proto3" json:"geo_country,omitempty"`
+	Transcript    string                 `protobuf:"bytes,8,opt,name=transcript,proto3" json:"transcript,omitempty"` // Prompts, attacker input and fake output
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ActiveApproval) Reset() {
-	*x = ActiveApproval{}
-	mi := &file_proxy_proxy_proto_msgTypes[65]
+func (x *MockTranscript) Reset() {
+	*x = MockTranscript{}
+	mi := &file_proxy_proxy_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ActiveApproval) String() string {
+func (x *MockTranscript) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ActiveApproval) ProtoMessage() {}
+func (*MockTranscript) ProtoMessage() {}
 
-func (x *ActiveApproval) ProtoReflect() protoreflect.Message {
-	mi := &file_proxy_proxy_proto_msgTypes[65]
+func (x *MockTranscript) ProtoReflect() protoreflect.Message {
+	mi := &file_proxy_proxy_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4597,139 +6014,209 @@ func (x *ActiveApproval) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ActiveApproval.ProtoReflect.Descriptor instead.
-func (*ActiveApproval) Descriptor() ([]byte, []int) {
-	return file_proxy_proxy_proto_rawDescGZIP(), []int{65}
+// Deprecated: Use MockTranscript.ProtoReflect.Descriptor instead.
+func (*MockTranscript) Descriptor() ([]byte, []int) {
+	return file_proxy_proxy_proto_rawDescGZIP(), []int{77}
 }
 
-func (x *ActiveApproval) GetKey() string {
+func (x *MockTranscript) GetConnId() string {
 	if x != nil {
-		return x.Key
+		return x.ConnId
 	}
 	return ""
 }
 
-func (x *ActiveApproval) GetSourceIp() string {
+func (x *MockTranscript) GetSourceIp() string {
 	if x != nil {
 		return x.SourceIp
 	}
 	return ""
 }
 
-func (x *ActiveApproval) GetRuleId() string {
+func (x *MockTranscript) GetSourcePort() int32 {
 	if x != nil {
-		return x.RuleId
+		return x.SourcePort
 	}
-	return ""
+	return 0
 }
 
-func (x *ActiveApproval) GetProxyId() string {
+func (x *MockTranscript) GetRuleId() string {
 	if x != nil {
-		return x.ProxyId
+		return x.RuleId
 	}
 	return ""
 }
 
-func (x *ActiveApproval) GetTlsSessionId() string {
+func (x *MockTranscript) GetStartTime() *timestamp.Timestamp {
 	if x != nil {
-		return x.TlsSessionId
+		return x.StartTime
 	}
-	return ""
+	return nil
 }
 
-func (x *ActiveApproval) GetAllowed() bool {
+func (x *MockTranscript) GetDurationMs() int64 {
 	if x != nil {
-		return x.Allowed
+		return x.DurationMs
 	}
-	return false
+	return 0
 }
 
-func (x *ActiveApproval) GetCreatedAt() *timestamp.Timestamp {
+func (x *MockTranscript) GetGeoCountry() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.GeoCountry
 	}
-	return nil
+	return ""
 }
 
-func (x *ActiveApproval) GetExpiresAt() *timestamp.Timestamp {
+func (x *MockTranscript) GetTranscript() string {
 	if x != nil {
-		return x.ExpiresAt
+		return x.Transcript
 	}
-	return nil
+	return ""
 }
 
-func (x *ActiveApproval) GetBytesIn() int64 {
+type GetMockTranscriptsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transcripts   []*MockTranscript      `protobuf:"bytes,1,rep,name=transcripts,proto3" json:"transcripts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMockTranscriptsResponse) Reset() {
+	*x = GetMockTranscriptsResponse{}
+	mi := &file_proxy_proxy_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMockTranscriptsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMockTranscriptsResponse) ProtoMessage() {}
+
+func (x *GetMockTranscriptsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proxy_proxy_proto_msgTypes[78]
 	if x != nil {
-		return x.BytesIn
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *ActiveApproval) GetBytesOut() int64 {
+// Deprecated: Use GetMockTranscriptsResponse.ProtoReflect.Descriptor instead.
+func (*GetMockTranscriptsResponse) Descriptor() ([]byte, []int) {
+	return file_proxy_proxy_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *GetMockTranscriptsResponse) GetTranscripts() []*MockTranscript {
 	if x != nil {
-		return x.BytesOut
+		return x.Transcripts
 	}
-	return 0
+	return nil
 }
 
-func (x *ActiveApproval) GetBlockedCount() int64 {
+// CloneBannerRequest records the banner of a real backend as a cloned preset.
+// Cloning an existing name refreshes it; protocol and backend_addr may then
+// be omitted to reuse the stored ones.
+type CloneBannerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                  // Preset name, referenced by MockConfig.cloned_preset
+	Protocol      string                 `protobuf:"bytes,2,opt,name=protocol,proto3" json:"protocol,omitempty"`                          // "ssh", "smtp", "mysql", "http" or "raw"
+	BackendAddr   string                 `protobuf:"bytes,3,opt,name=backend_addr,json=backendAddr,proto3" json:"backend_addr,omitempty"` // host:port of the real service
+	TimeoutMs     int32                  `protobuf:"varint,4,opt,name=timeout_ms,json=timeoutMs,proto3" json:"timeout_ms,omitempty"`      // Connect and read timeout (default 5000)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CloneBannerRequest) Reset() {
+	*x = CloneBannerRequest{}
+	mi := &file_proxy_proxy_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloneBannerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloneBannerRequest) ProtoMessage() {}
+
+func (x *CloneBannerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proxy_proxy_proto_msgTypes[79]
 	if x != nil {
-		return x.BlockedCount
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *ActiveApproval) GetConnIds() []string {
+// Deprecated: Use CloneBannerRequest.ProtoReflect.Descriptor instead.
+func (*CloneBannerRequest) Descriptor() ([]byte, []int) {
+	return file_proxy_proxy_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *CloneBannerRequest) GetName() string {
 	if x != nil {
-		return x.ConnIds
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *ActiveApproval) GetGeoCountry() string {
+func (x *CloneBannerRequest) GetProtocol() string {
 	if x != nil {
-		return x.GeoCountry
+		return x.Protocol
 	}
 	return ""
 }
 
-func (x *ActiveApproval) GetGeoCity() string {
+func (x *CloneBannerRequest) GetBackendAddr() string {
 	if x != nil {
-		return x.GeoCity
+		return x.BackendAddr
 	}
 	return ""
 }
 
-func (x *ActiveApproval) GetGeoIsp() string {
+func (x *CloneBannerRequest) GetTimeoutMs() int32 {
 	if x != nil {
-		return x.GeoIsp
+		return x.TimeoutMs
 	}
-	return ""
+	return 0
 }
 
-type ListActiveApprovalsRequest struct {
+// ClonedPreset is a banner recorded from a real backend.
+type ClonedPreset struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProxyId       string                 `protobuf:"bytes,1,opt,name=proxy_id,json=proxyId,proto3" json:"proxy_id,omitempty"`    // Optional: filter by proxy
-	SourceIp      string                 `protobuf:"bytes,2,opt,name=source_ip,json=sourceIp,proto3" json:"source_ip,omitempty"` // Optional: filter by IP
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Protocol      string                 `protobuf:"bytes,2,opt,name=protocol,proto3" json:"protocol,omitempty"`
+	BackendAddr   string                 `protobuf:"bytes,3,opt,name=backend_addr,json=backendAddr,proto3" json:"backend_addr,omitempty"`
+	Banner        string                 `protobuf:"bytes,4,opt,name=banner,proto3" json:"banner,omitempty"` // SSH identification line, SMTP greeting, MySQL version, HTTP Server header or raw preamble
+	ClonedAt      *timestamp.Timestamp   `protobuf:"bytes,5,opt,name=cloned_at,json=clonedAt,proto3" json:"cloned_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListActiveApprovalsRequest) Reset() {
-	*x = ListActiveApprovalsRequest{}
-	mi := &file_proxy_proxy_proto_msgTypes[66]
+func (x *ClonedPreset) Reset() {
+	*x = ClonedPreset{}
+	mi := &file_proxy_proxy_proto_msgTypes[80]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListActiveApprovalsRequest) String() string {
+func (x *ClonedPreset) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListActiveApprovalsRequest) ProtoMessage() {}
+func (*ClonedPreset) ProtoMessage() {}
 
-func (x *ListActiveApprovalsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proxy_proxy_proto_msgTypes[66]
+func (x *ClonedPreset) ProtoReflect() protoreflect.Message {
+	mi := &file_proxy_proxy_proto_msgTypes[80]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4740,47 +6227,68 @@ func (x *ListActiveApprovalsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListActiveApprovalsRequest.ProtoReflect.Descriptor instead.
-func (*ListActiveApprovalsRequest) Descriptor() ([]byte, []int) {
-	return file_proxy_proxy_proto_rawDescGZIP(), []int{66}
+// Deprecated: Use ClonedPreset.ProtoReflect.Descriptor instead.
+func (*ClonedPreset) Descriptor() ([]byte, []int) {
+	return file_proxy_proxy_proto_rawDescGZIP(), []int{80}
 }
 
-func (x *ListActiveApprovalsRequest) GetProxyId() string {
+func (x *ClonedPreset) GetName() string {
 	if x != nil {
-		return x.ProxyId
+		return x.Name
 	}
 	return ""
 }
 
-func (x *ListActiveApprovalsRequest) GetSourceIp() string {
+func (x *ClonedPreset) GetProtocol() string {
 	if x != nil {
-		return x.SourceIp
+		return x.Protocol
 	}
 	return ""
 }
 
-type ListActiveApprovalsResponse struct {
+func (x *ClonedPreset) GetBackendAddr() string {
+	if x != nil {
+		return x.BackendAddr
+	}
+	return ""
+}
+
+func (x *ClonedPreset) GetBanner() string {
+	if x != nil {
+		return x.Banner
+	}
+	return ""
+}
+
+func (x *ClonedPreset) GetClonedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.ClonedAt
+	}
+	return nil
+}
+
+type CloneBannerResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Approvals     []*ActiveApproval      `protobuf:"bytes,1,rep,name=approvals,proto3" json:"approvals,omitempty"`
+	Preset        *ClonedPreset          `protobuf:"bytes,1,opt,name=preset,proto3" json:"preset,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListActiveApprovalsResponse) Reset() {
-	*x = ListActiveApprovalsResponse{}
-	mi := &file_proxy_proxy_proto_msgTypes[67]
+func (x *CloneBannerResponse) Reset() {
+	*x = CloneBannerResponse{}
+	mi := &file_proxy_proxy_proto_msgTypes[81]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListActiveApprovalsResponse) String() string {
+func (x *CloneBannerResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListActiveApprovalsResponse) ProtoMessage() {}
+func (*CloneBannerResponse) ProtoMessage() {}
 
-func (x *ListActiveApprovalsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proxy_proxy_proto_msgTypes[67]
+func (x *CloneBannerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proxy_proxy_proto_msgTypes[81]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4791,41 +6299,39 @@ func (x *ListActiveApprovalsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListActiveApprovalsResponse.ProtoReflect.Descriptor instead.
-func (*ListActiveApprovalsResponse) Descriptor() ([]byte, []int) {
-	return file_proxy_proxy_proto_rawDescGZIP(), []int{67}
+// Deprecated: Use CloneBannerResponse.ProtoReflect.Descriptor instead.
+func (*CloneBannerResponse) Descriptor() ([]byte, []int) {
+	return file_proxy_proxy_proto_rawDescGZIP(), []int{81}
 }
 
-func (x *ListActiveApprovalsResponse) GetApprovals() []*ActiveApproval {
+func (x *CloneBannerResponse) GetPreset() *ClonedPreset {
 	if x != nil {
-		return x.Approvals
+		return x.Preset
 	}
 	return nil
 }
 
-type CancelApprovalRequest struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	Key              string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`                                                    // Approval key to cancel
-	CloseConnections bool                   `protobuf:"varint,2,opt,name=close_connections,json=closeConnections,proto3" json:"close_connections,omitempty"` // Also close active connections
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+type ListClonedPresetsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CancelApprovalRequest) Reset() {
-	*x = CancelApprovalRequest{}
-	mi := &file_proxy_proxy_proto_msgTypes[68]
+func (x *ListClonedPresetsRequest) Reset() {
+	*x = ListClonedPresetsRequest{}
+	mi := &file_proxy_proxy_proto_msgTypes[82]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CancelApprovalRequest) String() string {
+func (x *ListClonedPresetsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CancelApprovalRequest) ProtoMessage() {}
+func (*ListClonedPresetsRequest) ProtoMessage() {}
 
-func (x *CancelApprovalRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proxy_proxy_proto_msgTypes[68]
+func (x *ListClonedPresetsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proxy_proxy_proto_msgTypes[82]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4836,49 +6342,33 @@ func (x *CancelApprovalRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CancelApprovalRequest.ProtoReflect.Descriptor instead.
-func (*CancelApprovalRequest) Descriptor() ([]byte, []int) {
-	return file_proxy_proxy_proto_rawDescGZIP(), []int{68}
-}
-
-func (x *CancelApprovalRequest) GetKey() string {
-	if x != nil {
-		return x.Key
-	}
-	return ""
-}
-
-func (x *CancelApprovalRequest) GetCloseConnections() bool {
-	if x != nil {
-		return x.CloseConnections
-	}
-	return false
+// Deprecated: Use ListClonedPresetsRequest.ProtoReflect.Descriptor instead.
+func (*ListClonedPresetsRequest) Descriptor() ([]byte, []int) {
+	return file_proxy_proxy_proto_rawDescGZIP(), []int{82}
 }
 
-type CancelApprovalResponse struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	Success           bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	ErrorMessage      string                 `protobuf:"bytes,2,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
-	ConnectionsClosed int32                  `protobuf:"varint,3,opt,name=connections_closed,json=connectionsClosed,proto3" json:"connections_closed,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+type ListClonedPresetsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Presets       []*ClonedPreset        `protobuf:"bytes,1,rep,name=presets,proto3" json:"presets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CancelApprovalResponse) Reset() {
-	*x = CancelApprovalResponse{}
-	mi := &file_proxy_proxy_proto_msgTypes[69]
+func (x *ListClonedPresetsResponse) Reset() {
+	*x = ListClonedPresetsResponse{}
+	mi := &file_proxy_proxy_proto_msgTypes[83]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CancelApprovalResponse) String() string {
+func (x *ListClonedPresetsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CancelApprovalResponse) ProtoMessage() {}
+func (*ListClonedPresetsResponse) ProtoMessage() {}
 
-func (x *CancelApprovalResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proxy_proxy_proto_msgTypes[69]
+func (x *ListClonedPresetsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proxy_proxy_proto_msgTypes[83]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4889,30 +6379,16 @@ func (x *CancelApprovalResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CancelApprovalResponse.ProtoReflect.Descriptor instead.
-func (*CancelApprovalResponse) Descriptor() ([]byte, []int) {
-	return file_proxy_proxy_proto_rawDescGZIP(), []int{69}
-}
-
-func (x *CancelApprovalResponse) GetSuccess() bool {
-	if x != nil {
-		return x.Success
-	}
-	return false
-}
-
-func (x *CancelApprovalResponse) GetErrorMessage() string {
-	if x != nil {
-		return x.ErrorMessage
-	}
-	return ""
+// Deprecated: Use ListClonedPresetsResponse.ProtoReflect.Descriptor instead.
+func (*ListClonedPresetsResponse) Descriptor() ([]byte, []int) {
+	return file_proxy_proxy_proto_rawDescGZIP(), []int{83}
 }
 
-func (x *CancelApprovalResponse) GetConnectionsClosed() int32 {
+func (x *ListClonedPresetsResponse) GetPresets() []*ClonedPreset {
 	if x != nil {
-		return x.ConnectionsClosed
+		return x.Presets
 	}
-	return 0
+	return nil
 }
 
 type SendCommandRequest struct {
@@ -4925,7 +6401,7 @@ type SendCommandRequest struct {
 
 func (x *SendCommandRequest) Reset() {
 	*x = SendCommandRequest{}
-	mi := &file_proxy_proxy_proto_msgTypes[70]
+	mi := &file_proxy_proxy_proto_msgTypes[84]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4937,7 +6413,7 @@ func (x *SendCommandRequest) String() string {
 func (*SendCommandRequest) ProtoMessage() {}
 
 func (x *SendCommandRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proxy_proxy_proto_msgTypes[70]
+	mi := &file_proxy_proxy_proto_msgTypes[84]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4950,7 +6426,7 @@ func (x *SendCommandRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SendCommandRequest.ProtoReflect.Descriptor instead.
 func (*SendCommandRequest) Descriptor() ([]byte, []int) {
-	return file_proxy_proxy_proto_rawDescGZIP(), []int{70}
+	return file_proxy_proxy_proto_rawDescGZIP(), []int{84}
 }
 
 func (x *SendCommandRequest) GetEncrypted() *common.EncryptedPayload {
@@ -4978,7 +6454,7 @@ type SendCommandResponse struct {
 
 func (x *SendCommandResponse) Reset() {
 	*x = SendCommandResponse{}
-	mi := &file_proxy_proxy_proto_msgTypes[71]
+	mi := &file_proxy_proxy_proto_msgTypes[85]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4990,7 +6466,7 @@ func (x *SendCommandResponse) String() string {
 func (*SendCommandResponse) ProtoMessage() {}
 
 func (x *SendCommandResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proxy_proxy_proto_msgTypes[71]
+	mi := &file_proxy_proxy_proto_msgTypes[85]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5003,7 +6479,7 @@ func (x *SendCommandResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SendCommandResponse.ProtoReflect.Descriptor instead.
 func (*SendCommandResponse) Descriptor() ([]byte, []int) {
-	return file_proxy_proxy_proto_rawDescGZIP(), []int{71}
+	return file_proxy_proxy_proto_rawDescGZIP(), []int{85}
 }
 
 func (x *SendCommandResponse) GetEncrypted() *common.EncryptedPayload {
@@ -5062,7 +6538,7 @@ const file_proxy_proxy_proto_rawDesc = "" +
 	"\bstrategy\x18\x06 \x03(\tR\bstrategy\x12\x1d\n" +
 	"\n" +
 	"cache_hits\x18\a \x01(\x03R\tcacheHits\x12!\n" +
-	"\fcache_misses\x18\b \x01(\x03R\vcacheMisses\"\xcf\x04\n" +
+	"\fcache_misses\x18\b \x01(\x03R\vcacheMisses\"\x94\x05\n" +
 	"\x12CreateProxyRequest\x12\x1f\n" +
 	"\vlisten_addr\x18\x01 \x01(\tR\n" +
 	"listenAddr\x12'\n" +
@@ -5078,7 +6554,10 @@ const file_proxy_proxy_proto_rawDesc = "" +
 	" \x01(\x0e2\x13.nitella.MockPresetR\ffallbackMock\x12G\n" +
 	"\x10client_auth_type\x18\v \x01(\x0e2\x1d.nitella.proxy.ClientAuthTypeR\x0eclientAuthType\x12\x12\n" +
 	"\x04tags\x18\f \x03(\tR\x04tags\x12C\n" +
-	"\fhealth_check\x18\r \x01(\v2 .nitella.proxy.HealthCheckConfigR\vhealthCheck\"\xba\x01\n" +
+	"\fhealth_check\x18\r \x01(\v2 .nitella.proxy.HealthCheckConfigR\vhealthCheck\x12C\n" +
+	"\n" +
+	"thresholds\x18\x0e \x01(\v2#.nitella.proxy.ConnectionThresholdsR\n" +
+	"thresholds\"\xba\x01\n" +
 	"\x11HealthCheckConfig\x12\x1a\n" +
 	"\binterval\x18\x01 \x01(\tR\binterval\x12\x18\n" +
 	"\atimeout\x18\x02 \x01(\tR\atimeout\x122\n" +
@@ -5103,7 +6582,7 @@ const file_proxy_proxy_proto_rawDesc = "" +
 	"\bproxy_id\x18\x01 \x01(\tR\aproxyId\"T\n" +
 	"\x13DeleteProxyResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12#\n" +
-	"\rerror_message\x18\x02 \x01(\tR\ferrorMessage\"\xea\x04\n" +
+	"\rerror_message\x18\x02 \x01(\tR\ferrorMessage\"\xaf\x05\n" +
 	"\x12UpdateProxyRequest\x12\x19\n" +
 	"\bproxy_id\x18\x01 \x01(\tR\aproxyId\x12\x1f\n" +
 	"\vlisten_addr\x18\x02 \x01(\tR\n" +
@@ -5120,7 +6599,10 @@ const file_proxy_proxy_proto_rawDesc = "" +
 	"\rfallback_mock\x18\v \x01(\x0e2\x13.nitella.MockPresetR\ffallbackMock\x12G\n" +
 	"\x10client_auth_type\x18\f \x01(\x0e2\x1d.nitella.proxy.ClientAuthTypeR\x0eclientAuthType\x12\x12\n" +
 	"\x04tags\x18\r \x03(\tR\x04tags\x12C\n" +
-	"\fhealth_check\x18\x0e \x01(\v2 .nitella.proxy.HealthCheckConfigR\vhealthCheck\"T\n" +
+	"\fhealth_check\x18\x0e \x01(\v2 .nitella.proxy.HealthCheckConfigR\vhealthCheck\x12C\n" +
+	"\n" +
+	"thresholds\x18\x0f \x01(\v2#.nitella.proxy.ConnectionThresholdsR\n" +
+	"thresholds\"T\n" +
 	"\x13UpdateProxyResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12#\n" +
 	"\rerror_message\x18\x02 \x01(\tR\ferrorMessage\"\x82\x01\n" +
@@ -5129,7 +6611,7 @@ const file_proxy_proxy_proto_rawDesc = "" +
 	"\x0frestarted_count\x18\x02 \x01(\x05R\x0erestartedCount\x12#\n" +
 	"\rerror_message\x18\x03 \x01(\tR\ferrorMessage\"-\n" +
 	"\x10GetStatusRequest\x12\x19\n" +
-	"\bproxy_id\x18\x01 \x01(\tR\aproxyId\"\xba\x06\n" +
+	"\bproxy_id\x18\x01 \x01(\tR\aproxyId\"\xf1\b\n" +
 	"\vProxyStatus\x12\x19\n" +
 	"\bproxy_id\x18\x01 \x01(\tR\aproxyId\x12\x18\n" +
 	"\arunning\x18\x02 \x01(\bR\arunning\x12\x1f\n" +
@@ -5151,7 +6633,15 @@ const file_proxy_proxy_proto_rawDesc = "" +
 	"\x10client_auth_type\x18\x0f \x01(\x0e2\x1d.nitella.proxy.ClientAuthTypeR\x0eclientAuthType\x12\x12\n" +
 	"\x04tags\x18\x10 \x03(\tR\x04tags\x12C\n" +
 	"\fhealth_check\x18\x11 \x01(\v2 .nitella.proxy.HealthCheckConfigR\vhealthCheck\x12@\n" +
-	"\rhealth_status\x18\x12 \x01(\x0e2\x1b.nitella.proxy.HealthStatusR\fhealthStatus\"?\n" +
+	"\rhealth_status\x18\x12 \x01(\x0e2\x1b.nitella.proxy.HealthStatusR\fhealthStatus\x12\x1c\n" +
+	"\tdecisions\x18\x13 \x01(\x03R\tdecisions\x129\n" +
+	"\x19decision_latency_total_us\x18\x14 \x01(\x03R\x16decisionLatencyTotalUs\x125\n" +
+	"\x17decision_latency_max_us\x18\x15 \x01(\x03R\x14decisionLatencyMaxUs\x12E\n" +
+	"\trule_hits\x18\x16 \x03(\v2(.nitella.proxy.ProxyStatus.RuleHitsEntryR\bruleHits\x12!\n" +
+	"\frate_limited\x18\x17 \x01(\x03R\vrateLimited\x1a;\n" +
+	"\rRuleHitsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01\"?\n" +
 	"\x12ReloadRulesRequest\x12)\n" +
 	"\x05rules\x18\x01 \x03(\v2\x13.nitella.proxy.RuleR\x05rules\"w\n" +
 	"\x13ReloadRulesResponse\x12\x18\n" +
@@ -5176,7 +6666,7 @@ const file_proxy_proxy_proto_rawDesc = "" +
 	"\x06status\x18\x04 \x01(\tR\x06status\x12#\n" +
 	"\rerror_message\x18\x05 \x01(\tR\ferrorMessage\"X\n" +
 	"\x19GetAppliedProxiesResponse\x12;\n" +
-	"\aproxies\x18\x01 \x03(\v2!.nitella.proxy.AppliedProxyStatusR\aproxies\"\x8d\x03\n" +
+	"\aproxies\x18\x01 \x03(\v2!.nitella.proxy.AppliedProxyStatusR\aproxies\"\xac\x05\n" +
 	"\x04Rule\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1a\n" +
@@ -5193,7 +6683,32 @@ const file_proxy_proxy_proto_rawDesc = "" +
 	"\n" +
 	"expression\x18\n" +
 	" \x01(\tR\n" +
-	"expression\"\x88\x01\n" +
+	"expression\x129\n" +
+	"\n" +
+	"not_before\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tnotBefore\x129\n" +
+	"\n" +
+	"expires_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12\x1a\n" +
+	"\bschedule\x18\r \x01(\tR\bschedule\x12C\n" +
+	"\n" +
+	"thresholds\x18\x0e \x01(\v2#.nitella.proxy.ConnectionThresholdsR\n" +
+	"thresholds\x12F\n" +
+	"\x0fapproval_policy\x18\x0f \x01(\v2\x1d.nitella.proxy.ApprovalPolicyR\x0eapprovalPolicy\"\xb3\x02\n" +
+	"\x0eApprovalPolicy\x12'\n" +
+	"\x0ftimeout_seconds\x18\x01 \x01(\x03R\x0etimeoutSeconds\x12C\n" +
+	"\n" +
+	"on_timeout\x18\x02 \x01(\x0e2$.nitella.proxy.ApprovalTimeoutActionR\tonTimeout\x126\n" +
+	"\ftimeout_mock\x18\x03 \x01(\x0e2\x13.nitella.MockPresetR\vtimeoutMock\x12/\n" +
+	"\x04hold\x18\x04 \x01(\x0e2\x1b.nitella.proxy.ApprovalHoldR\x04hold\x12\x16\n" +
+	"\x06quorum\x18\x05 \x01(\x05R\x06quorum\x122\n" +
+	"\x05local\x18\x06 \x01(\v2\x1c.nitella.proxy.LocalApproverR\x05local\")\n" +
+	"\rLocalApprover\x12\x12\n" +
+	"\x04name\x18\x06 \x01(\tR\x04nameJ\x04\b\x01\x10\x06\"\x99\x02\n" +
+	"\x14ConnectionThresholds\x12\"\n" +
+	"\rmax_bytes_out\x18\x01 \x01(\x03R\vmaxBytesOut\x128\n" +
+	"\x19max_source_bytes_out_hour\x18\x02 \x01(\x03R\x15maxSourceBytesOutHour\x120\n" +
+	"\x14max_duration_seconds\x18\x03 \x01(\x03R\x12maxDurationSeconds\x126\n" +
+	"\x06action\x18\x04 \x01(\x0e2\x1e.nitella.proxy.ThresholdActionR\x06action\x129\n" +
+	"\x19throttle_bytes_per_second\x18\x05 \x01(\x03R\x16throttleBytesPerSecond\"\x88\x01\n" +
 	"\tCondition\x12*\n" +
 	"\x04type\x18\x01 \x01(\x0e2\x16.nitella.ConditionTypeR\x04type\x12!\n" +
 	"\x02op\x18\x02 \x01(\x0e2\x11.nitella.OperatorR\x02op\x12\x14\n" +
@@ -5207,13 +6722,31 @@ const file_proxy_proxy_proto_rawDesc = "" +
 	"\x16block_duration_seconds\x18\x04 \x01(\x05R\x14blockDurationSeconds\x12.\n" +
 	"\x13block_steps_seconds\x18\x05 \x03(\x05R\x11blockStepsSeconds\x12.\n" +
 	"\x13count_only_failures\x18\x06 \x01(\bR\x11countOnlyFailures\x12<\n" +
-	"\x1afailure_duration_threshold\x18\a \x01(\x05R\x18failureDurationThreshold\"\x8a\x01\n" +
+	"\x1afailure_duration_threshold\x18\a \x01(\x05R\x18failureDurationThreshold\"\x9e\x05\n" +
 	"\n" +
 	"MockConfig\x12+\n" +
 	"\x06preset\x18\x01 \x01(\x0e2\x13.nitella.MockPresetR\x06preset\x12\x1a\n" +
 	"\bprotocol\x18\x02 \x01(\tR\bprotocol\x12\x18\n" +
 	"\apayload\x18\x03 \x01(\fR\apayload\x12\x19\n" +
-	"\bdelay_ms\x18\x04 \x01(\x05R\adelayMs\"T\n" +
+	"\bdelay_ms\x18\x04 \x01(\x05R\adelayMs\x12!\n" +
+	"\faccept_login\x18\x05 \x01(\bR\vacceptLogin\x12(\n" +
+	"\x10http_route_packs\x18\x06 \x03(\tR\x0ehttpRoutePacks\x12(\n" +
+	"\x10http_routes_file\x18\a \x01(\tR\x0ehttpRoutesFile\x12!\n" +
+	"\fcanary_paths\x18\b \x03(\tR\vcanaryPaths\x120\n" +
+	"\x14canary_block_seconds\x18\t \x01(\x05R\x12canaryBlockSeconds\x12\x1f\n" +
+	"\vsmb_dialect\x18\n" +
+	" \x01(\tR\n" +
+	"smbDialect\x12$\n" +
+	"\x0esmb_os_version\x18\v \x01(\tR\fsmbOsVersion\x12!\n" +
+	"\fsmb_hostname\x18\f \x01(\tR\vsmbHostname\x12\x1f\n" +
+	"\vscript_file\x18\r \x01(\tR\n" +
+	"scriptFile\x12\x16\n" +
+	"\x06script\x18\x0e \x01(\tR\x06script\x12#\n" +
+	"\rcloned_preset\x18\x0f \x01(\tR\fclonedPreset\x12\x10\n" +
+	"\x03tls\x18\x10 \x01(\bR\x03tls\x12&\n" +
+	"\x0ftls_common_name\x18\x11 \x01(\tR\rtlsCommonName\x12)\n" +
+	"\x10tls_organization\x18\x12 \x01(\tR\x0ftlsOrganization\x12\x19\n" +
+	"\btls_sans\x18\x13 \x03(\tR\atlsSans\"T\n" +
 	"\x0eAddRuleRequest\x12\x19\n" +
 	"\bproxy_id\x18\x01 \x01(\tR\aproxyId\x12'\n" +
 	"\x04rule\x18\x02 \x01(\v2\x13.nitella.proxy.RuleR\x04rule\"G\n" +
@@ -5255,7 +6788,7 @@ const file_proxy_proxy_proto_rawDesc = "" +
 	"\x18StreamConnectionsRequest\x12\x1f\n" +
 	"\vactive_only\x18\x01 \x01(\bR\n" +
 	"activeOnly\x12#\n" +
-	"\rviewer_pubkey\x18\x02 \x01(\fR\fviewerPubkey\"\x97\x03\n" +
+	"\rviewer_pubkey\x18\x02 \x01(\fR\fviewerPubkey\"\xeb\x03\n" +
 	"\x0fConnectionEvent\x12\x17\n" +
 	"\aconn_id\x18\x01 \x01(\tR\x06connId\x12\x1b\n" +
 	"\tsource_ip\x18\x02 \x01(\tR\bsourceIp\x12\x1f\n" +
@@ -5271,10 +6804,21 @@ const file_proxy_proxy_proto_rawDesc = "" +
 	"\bbytes_in\x18\t \x01(\x03R\abytesIn\x12\x1b\n" +
 	"\tbytes_out\x18\n" +
 	" \x01(\x03R\bbytesOut\x12\"\n" +
-	"\x03geo\x18\v \x01(\v2\x10.nitella.GeoInfoR\x03geo\"f\n" +
+	"\x03geo\x18\v \x01(\v2\x10.nitella.GeoInfoR\x03geo\x124\n" +
+	"\acapture\x18\f \x01(\v2\x1a.nitella.proxy.MockCaptureR\acapture\x12\x1c\n" +
+	"\tthreshold\x18\r \x01(\tR\tthreshold\"\xf0\x01\n" +
+	"\vMockCapture\x12\x1a\n" +
+	"\bprotocol\x18\x01 \x01(\tR\bprotocol\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x1a\n" +
+	"\busername\x18\x03 \x01(\tR\busername\x12\x1a\n" +
+	"\bpassword\x18\x04 \x01(\tR\bpassword\x12>\n" +
+	"\x06fields\x18\x05 \x03(\v2&.nitella.proxy.MockCapture.FieldsEntryR\x06fields\x1a9\n" +
+	"\vFieldsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"f\n" +
 	"\x14StreamMetricsRequest\x12)\n" +
 	"\x10interval_seconds\x18\x01 \x01(\x05R\x0fintervalSeconds\x12#\n" +
-	"\rviewer_pubkey\x18\x02 \x01(\fR\fviewerPubkey\"\xe0\x01\n" +
+	"\rviewer_pubkey\x18\x02 \x01(\fR\fviewerPubkey\"\xb5\x02\n" +
 	"\rMetricsSample\x12\x1c\n" +
 	"\ttimestamp\x18\x01 \x01(\x03R\ttimestamp\x12!\n" +
 	"\factive_conns\x18\x02 \x01(\x03R\vactiveConns\x12\x1f\n" +
@@ -5282,7 +6826,9 @@ const file_proxy_proxy_proto_rawDesc = "" +
 	"totalConns\x12\"\n" +
 	"\rbytes_in_rate\x18\x04 \x01(\x03R\vbytesInRate\x12$\n" +
 	"\x0ebytes_out_rate\x18\x05 \x01(\x03R\fbytesOutRate\x12#\n" +
-	"\rblocked_total\x18\x06 \x01(\x03R\fblockedTotal\"t\n" +
+	"\rblocked_total\x18\x06 \x01(\x03R\fblockedTotal\x12#\n" +
+	"\rtarpit_active\x18\a \x01(\x03R\ftarpitActive\x12.\n" +
+	"\x13tarpit_memory_bytes\x18\b \x01(\x03R\x11tarpitMemoryBytes\"t\n" +
 	"\x16EncryptedStreamPayload\x127\n" +
 	"\tencrypted\x18\x01 \x01(\v2\x19.nitella.EncryptedPayloadR\tencrypted\x12!\n" +
 	"\fpayload_type\x18\x02 \x01(\tR\vpayloadType\"\x94\x02\n" +
@@ -5355,7 +6901,7 @@ const file_proxy_proxy_proto_rawDesc = "" +
 	"\rblocked_count\x18\a \x01(\x03R\fblockedCount\"J\n" +
 	"\x13GetGeoStatsResponse\x123\n" +
 	"\x05stats\x18\x01 \x03(\v2\x1d.nitella.proxy.GeoStatsResultR\x05stats\"\x18\n" +
-	"\x16GetStatsSummaryRequest\"\xaf\x03\n" +
+	"\x16GetStatsSummaryRequest\"\xa3\x04\n" +
 	"\x14StatsSummaryResponse\x12+\n" +
 	"\x11total_connections\x18\x01 \x01(\x03R\x10totalConnections\x12$\n" +
 	"\x0etotal_bytes_in\x18\x02 \x01(\x03R\ftotalBytesIn\x12&\n" +
@@ -5369,16 +6915,41 @@ const file_proxy_proxy_proto_rawDesc = "" +
 	"\vproxy_count\x18\t \x01(\x05R\n" +
 	"proxyCount\x128\n" +
 	"\ttimestamp\x18\n" +
-	" \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\"\xee\x01\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x122\n" +
+	"\x06tarpit\x18\v \x01(\v2\x1a.nitella.proxy.TarpitStatsR\x06tarpit\x12>\n" +
+	"\valert_sinks\x18\f \x03(\v2\x1d.nitella.proxy.AlertSinkStatsR\n" +
+	"alertSinks\"\xdf\x01\n" +
+	"\x0eAlertSinkStats\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12\x1d\n" +
+	"\n" +
+	"sent_total\x18\x03 \x01(\x03R\tsentTotal\x12#\n" +
+	"\rretries_total\x18\x04 \x01(\x03R\fretriesTotal\x12*\n" +
+	"\x11dead_letter_total\x18\x05 \x01(\x03R\x0fdeadLetterTotal\x12\x16\n" +
+	"\x06queued\x18\x06 \x01(\x03R\x06queued\x12\x1d\n" +
+	"\n" +
+	"last_error\x18\a \x01(\tR\tlastError\"\x84\x02\n" +
+	"\vTarpitStats\x12!\n" +
+	"\factive_conns\x18\x01 \x01(\x03R\vactiveConns\x12\x1b\n" +
+	"\tmax_conns\x18\x02 \x01(\x03R\bmaxConns\x12!\n" +
+	"\fmemory_bytes\x18\x03 \x01(\x03R\vmemoryBytes\x12(\n" +
+	"\x10max_memory_bytes\x18\x04 \x01(\x03R\x0emaxMemoryBytes\x12%\n" +
+	"\x0eadmitted_total\x18\x05 \x01(\x03R\radmittedTotal\x12%\n" +
+	"\x0erejected_total\x18\x06 \x01(\x03R\rrejectedTotal\x12\x1a\n" +
+	"\badaptive\x18\a \x01(\bR\badaptive\"\xaf\x02\n" +
 	"\x16ResolveApprovalRequest\x12\x15\n" +
 	"\x06req_id\x18\x01 \x01(\tR\x05reqId\x123\n" +
 	"\x06action\x18\x02 \x01(\x0e2\x1b.nitella.ApprovalActionTypeR\x06action\x12E\n" +
 	"\x0eretention_mode\x18\x03 \x01(\x0e2\x1e.nitella.ApprovalRetentionModeR\rretentionMode\x12)\n" +
 	"\x10duration_seconds\x18\x04 \x01(\x03R\x0fdurationSeconds\x12\x16\n" +
-	"\x06reason\x18\x05 \x01(\tR\x06reason\"X\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x12!\n" +
+	"\fapprover_key\x18\x06 \x01(\fR\vapproverKey\x12\x1c\n" +
+	"\tsignature\x18\a \x01(\fR\tsignature\"\x8e\x01\n" +
 	"\x17ResolveApprovalResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12#\n" +
-	"\rerror_message\x18\x02 \x01(\tR\ferrorMessage\"\xf6\x03\n" +
+	"\rerror_message\x18\x02 \x01(\tR\ferrorMessage\x12\x1c\n" +
+	"\tapprovals\x18\x03 \x01(\x05R\tapprovals\x12\x16\n" +
+	"\x06quorum\x18\x04 \x01(\x05R\x06quorum\"\xe4\x04\n" +
 	"\x0eActiveApproval\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x1b\n" +
 	"\tsource_ip\x18\x02 \x01(\tR\bsourceIp\x12\x17\n" +
@@ -5398,7 +6969,11 @@ const file_proxy_proxy_proto_rawDesc = "" +
 	"\vgeo_country\x18\r \x01(\tR\n" +
 	"geoCountry\x12\x19\n" +
 	"\bgeo_city\x18\x0e \x01(\tR\ageoCity\x12\x17\n" +
-	"\ageo_isp\x18\x0f \x01(\tR\x06geoIsp\"T\n" +
+	"\ageo_isp\x18\x0f \x01(\tR\x06geoIsp\x12\x18\n" +
+	"\apending\x18\x10 \x01(\bR\apending\x12\x1c\n" +
+	"\tapprovals\x18\x11 \x01(\x05R\tapprovals\x12\x16\n" +
+	"\x06quorum\x18\x12 \x01(\x05R\x06quorum\x12\x1c\n" +
+	"\tapprovers\x18\x13 \x03(\tR\tapprovers\"T\n" +
 	"\x1aListActiveApprovalsRequest\x12\x19\n" +
 	"\bproxy_id\x18\x01 \x01(\tR\aproxyId\x12\x1b\n" +
 	"\tsource_ip\x18\x02 \x01(\tR\bsourceIp\"Z\n" +
@@ -5410,7 +6985,45 @@ const file_proxy_proxy_proto_rawDesc = "" +
 	"\x16CancelApprovalResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12#\n" +
 	"\rerror_message\x18\x02 \x01(\tR\ferrorMessage\x12-\n" +
-	"\x12connections_closed\x18\x03 \x01(\x05R\x11connectionsClosed\"r\n" +
+	"\x12connections_closed\x18\x03 \x01(\x05R\x11connectionsClosed\"g\n" +
+	"\x19GetMockTranscriptsRequest\x12\x17\n" +
+	"\aconn_id\x18\x01 \x01(\tR\x06connId\x12\x1b\n" +
+	"\tsource_ip\x18\x02 \x01(\tR\bsourceIp\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"\x9d\x02\n" +
+	"\x0eMockTranscript\x12\x17\n" +
+	"\aconn_id\x18\x01 \x01(\tR\x06connId\x12\x1b\n" +
+	"\tsource_ip\x18\x02 \x01(\tR\bsourceIp\x12\x1f\n" +
+	"\vsource_port\x18\x03 \x01(\x05R\n" +
+	"sourcePort\x12\x17\n" +
+	"\arule_id\x18\x04 \x01(\tR\x06ruleId\x129\n" +
+	"\n" +
+	"start_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x12\x1f\n" +
+	"\vduration_ms\x18\x06 \x01(\x03R\n" +
+	"durationMs\x12\x1f\n" +
+	"\vgeo_country\x18\a \x01(\tR\n" +
+	"geoCountry\x12\x1e\n" +
+	"\n" +
+	"transcript\x18\b \x01(\tR\n" +
+	"transcript\"]\n" +
+	"\x1aGetMockTranscriptsResponse\x12?\n" +
+	"\vtranscripts\x18\x01 \x03(\v2\x1d.nitella.proxy.MockTranscriptR\vtranscripts\"\x86\x01\n" +
+	"\x12CloneBannerRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1a\n" +
+	"\bprotocol\x18\x02 \x01(\tR\bprotocol\x12!\n" +
+	"\fbackend_addr\x18\x03 \x01(\tR\vbackendAddr\x12\x1d\n" +
+	"\n" +
+	"timeout_ms\x18\x04 \x01(\x05R\ttimeoutMs\"\xb2\x01\n" +
+	"\fClonedPreset\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1a\n" +
+	"\bprotocol\x18\x02 \x01(\tR\bprotocol\x12!\n" +
+	"\fbackend_addr\x18\x03 \x01(\tR\vbackendAddr\x12\x16\n" +
+	"\x06banner\x18\x04 \x01(\tR\x06banner\x127\n" +
+	"\tcloned_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\bclonedAt\"J\n" +
+	"\x13CloneBannerResponse\x123\n" +
+	"\x06preset\x18\x01 \x01(\v2\x1b.nitella.proxy.ClonedPresetR\x06preset\"\x1a\n" +
+	"\x18ListClonedPresetsRequest\"R\n" +
+	"\x19ListClonedPresetsResponse\x125\n" +
+	"\apresets\x18\x01 \x03(\v2\x1b.nitella.proxy.ClonedPresetR\apresets\"r\n" +
 	"\x12SendCommandRequest\x127\n" +
 	"\tencrypted\x18\x01 \x01(\v2\x19.nitella.EncryptedPayloadR\tencrypted\x12#\n" +
 	"\rviewer_pubkey\x18\x02 \x01(\fR\fviewerPubkey\"\x8b\x01\n" +
@@ -5432,14 +7045,36 @@ const file_proxy_proxy_proto_rawDesc = "" +
 	"\x15HEALTH_STATUS_UNKNOWN\x10\x00\x12\x19\n" +
 	"\x15HEALTH_STATUS_HEALTHY\x10\x01\x12\x1b\n" +
 	"\x17HEALTH_STATUS_UNHEALTHY\x10\x02\x12\x1a\n" +
-	"\x16HEALTH_STATUS_STARTING\x10\x03*\xaa\x01\n" +
+	"\x16HEALTH_STATUS_STARTING\x10\x03*\x8b\x01\n" +
+	"\x15ApprovalTimeoutAction\x12 \n" +
+	"\x1cAPPROVAL_TIMEOUT_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15APPROVAL_TIMEOUT_DENY\x10\x01\x12\x1a\n" +
+	"\x16APPROVAL_TIMEOUT_ALLOW\x10\x02\x12\x19\n" +
+	"\x15APPROVAL_TIMEOUT_MOCK\x10\x03*\x81\x01\n" +
+	"\fApprovalHold\x12\x1d\n" +
+	"\x19APPROVAL_HOLD_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14APPROVAL_HOLD_SILENT\x10\x01\x12\x18\n" +
+	"\x14APPROVAL_HOLD_TARPIT\x10\x02\x12\x1e\n" +
+	"\x1aAPPROVAL_HOLD_HTTP_PENDING\x10\x03*\x8a\x01\n" +
+	"\x0fThresholdAction\x12 \n" +
+	"\x1cTHRESHOLD_ACTION_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16THRESHOLD_ACTION_ALERT\x10\x01\x12\x1a\n" +
+	"\x16THRESHOLD_ACTION_CLOSE\x10\x02\x12\x1d\n" +
+	"\x19THRESHOLD_ACTION_THROTTLE\x10\x03*\xe3\x02\n" +
 	"\tEventType\x12\x1a\n" +
 	"\x16EVENT_TYPE_UNSPECIFIED\x10\x00\x12\x18\n" +
 	"\x14EVENT_TYPE_CONNECTED\x10\x01\x12\x15\n" +
 	"\x11EVENT_TYPE_CLOSED\x10\x02\x12\x16\n" +
 	"\x12EVENT_TYPE_BLOCKED\x10\x03\x12\x1f\n" +
 	"\x1bEVENT_TYPE_PENDING_APPROVAL\x10\x04\x12\x17\n" +
-	"\x13EVENT_TYPE_APPROVED\x10\x052\xb1\x02\n" +
+	"\x13EVENT_TYPE_APPROVED\x10\x05\x12\x1b\n" +
+	"\x17EVENT_TYPE_MOCK_CAPTURE\x10\x06\x12\x1f\n" +
+	"\x1bEVENT_TYPE_MOCK_INTERACTION\x10\a\x12\x14\n" +
+	"\x10EVENT_TYPE_ALERT\x10\b\x12\x1e\n" +
+	"\x1aEVENT_TYPE_THRESHOLD_ALERT\x10\t\x12\x1f\n" +
+	"\x1bEVENT_TYPE_THRESHOLD_CLOSED\x10\n" +
+	"\x12\"\n" +
+	"\x1eEVENT_TYPE_THRESHOLD_THROTTLED\x10\v2\xb1\x02\n" +
 	"\x13ProxyControlService\x12T\n" +
 	"\vSendCommand\x12!.nitella.proxy.SendCommandRequest\x1a\".nitella.proxy.SendCommandResponse\x12e\n" +
 	"\x11StreamConnections\x12'.nitella.proxy.StreamConnectionsRequest\x1a%.nitella.proxy.EncryptedStreamPayload0\x01\x12]\n" +
@@ -5457,166 +7092,206 @@ func file_proxy_proxy_proto_rawDescGZIP() []byte {
 	return file_proxy_proxy_proto_rawDescData
 }
 
-var file_proxy_proxy_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
-var file_proxy_proxy_proto_msgTypes = make([]protoimpl.MessageInfo, 72)
+var file_proxy_proxy_proto_enumTypes = make([]protoimpl.EnumInfo, 8)
+var file_proxy_proxy_proto_msgTypes = make([]protoimpl.MessageInfo, 88)
 var file_proxy_proxy_proto_goTypes = []any{
 	(HealthCheckType)(0),                 // 0: nitella.proxy.HealthCheckType
 	(ClientAuthType)(0),                  // 1: nitella.proxy.ClientAuthType
 	(HealthStatus)(0),                    // 2: nitella.proxy.HealthStatus
-	(EventType)(0),                       // 3: nitella.proxy.EventType
-	(ConfigureGeoIPRequest_Mode)(0),      // 4: nitella.proxy.ConfigureGeoIPRequest.Mode
-	(*ConfigureGeoIPRequest)(nil),        // 5: nitella.proxy.ConfigureGeoIPRequest
-	(*ConfigureGeoIPResponse)(nil),       // 6: nitella.proxy.ConfigureGeoIPResponse
-	(*LookupIPRequest)(nil),              // 7: nitella.proxy.LookupIPRequest
-	(*LookupIPResponse)(nil),             // 8: nitella.proxy.LookupIPResponse
-	(*GetGeoIPStatusRequest)(nil),        // 9: nitella.proxy.GetGeoIPStatusRequest
-	(*GetGeoIPStatusResponse)(nil),       // 10: nitella.proxy.GetGeoIPStatusResponse
-	(*CreateProxyRequest)(nil),           // 11: nitella.proxy.CreateProxyRequest
-	(*HealthCheckConfig)(nil),            // 12: nitella.proxy.HealthCheckConfig
-	(*CreateProxyResponse)(nil),          // 13: nitella.proxy.CreateProxyResponse
-	(*DisableProxyRequest)(nil),          // 14: nitella.proxy.DisableProxyRequest
-	(*DisableProxyResponse)(nil),         // 15: nitella.proxy.DisableProxyResponse
-	(*EnableProxyRequest)(nil),           // 16: nitella.proxy.EnableProxyRequest
-	(*EnableProxyResponse)(nil),          // 17: nitella.proxy.EnableProxyResponse
-	(*DeleteProxyRequest)(nil),           // 18: nitella.proxy.DeleteProxyRequest
-	(*DeleteProxyResponse)(nil),          // 19: nitella.proxy.DeleteProxyResponse
-	(*UpdateProxyRequest)(nil),           // 20: nitella.proxy.UpdateProxyRequest
-	(*UpdateProxyResponse)(nil),          // 21: nitella.proxy.UpdateProxyResponse
-	(*RestartListenersResponse)(nil),     // 22: nitella.proxy.RestartListenersResponse
-	(*GetStatusRequest)(nil),             // 23: nitella.proxy.GetStatusRequest
-	(*ProxyStatus)(nil),                  // 24: nitella.proxy.ProxyStatus
-	(*ReloadRulesRequest)(nil),           // 25: nitella.proxy.ReloadRulesRequest
-	(*ReloadRulesResponse)(nil),          // 26: nitella.proxy.ReloadRulesResponse
-	(*ApplyProxyRequest)(nil),            // 27: nitella.proxy.ApplyProxyRequest
-	(*ApplyProxyResponse)(nil),           // 28: nitella.proxy.ApplyProxyResponse
-	(*AppliedProxyStatus)(nil),           // 29: nitella.proxy.AppliedProxyStatus
-	(*GetAppliedProxiesResponse)(nil),    // 30: nitella.proxy.GetAppliedProxiesResponse
-	(*Rule)(nil),                         // 31: nitella.proxy.Rule
-	(*Condition)(nil),                    // 32: nitella.proxy.Condition
-	(*RateLimitConfig)(nil),              // 33: nitella.proxy.RateLimitConfig
-	(*MockConfig)(nil),                   // 34: nitella.proxy.MockConfig
-	(*AddRuleRequest)(nil),               // 35: nitella.proxy.AddRuleRequest
-	(*RemoveRuleRequest)(nil),            // 36: nitella.proxy.RemoveRuleRequest
-	(*ListRulesRequest)(nil),             // 37: nitella.proxy.ListRulesRequest
-	(*ListRulesResponse)(nil),            // 38: nitella.proxy.ListRulesResponse
-	(*ListProxiesRequest)(nil),           // 39: nitella.proxy.ListProxiesRequest
-	(*ListProxiesResponse)(nil),          // 40: nitella.proxy.ListProxiesResponse
-	(*BlockIPRequest)(nil),               // 41: nitella.proxy.BlockIPRequest
-	(*AllowIPRequest)(nil),               // 42: nitella.proxy.AllowIPRequest
-	(*GlobalRule)(nil),                   // 43: nitella.proxy.GlobalRule
-	(*ListGlobalRulesRequest)(nil),       // 44: nitella.proxy.ListGlobalRulesRequest
-	(*ListGlobalRulesResponse)(nil),      // 45: nitella.proxy.ListGlobalRulesResponse
-	(*RemoveGlobalRuleRequest)(nil),      // 46: nitella.proxy.RemoveGlobalRuleRequest
-	(*RemoveGlobalRuleResponse)(nil),     // 47: nitella.proxy.RemoveGlobalRuleResponse
-	(*StreamConnectionsRequest)(nil),     // 48: nitella.proxy.StreamConnectionsRequest
-	(*ConnectionEvent)(nil),              // 49: nitella.proxy.ConnectionEvent
-	(*StreamMetricsRequest)(nil),         // 50: nitella.proxy.StreamMetricsRequest
-	(*MetricsSample)(nil),                // 51: nitella.proxy.MetricsSample
-	(*EncryptedStreamPayload)(nil),       // 52: nitella.proxy.EncryptedStreamPayload
-	(*ActiveConnection)(nil),             // 53: nitella.proxy.ActiveConnection
-	(*GetActiveConnectionsRequest)(nil),  // 54: nitella.proxy.GetActiveConnectionsRequest
-	(*GetActiveConnectionsResponse)(nil), // 55: nitella.proxy.GetActiveConnectionsResponse
-	(*CloseConnectionRequest)(nil),       // 56: nitella.proxy.CloseConnectionRequest
-	(*CloseConnectionResponse)(nil),      // 57: nitella.proxy.CloseConnectionResponse
-	(*CloseAllConnectionsRequest)(nil),   // 58: nitella.proxy.CloseAllConnectionsRequest
-	(*CloseAllConnectionsResponse)(nil),  // 59: nitella.proxy.CloseAllConnectionsResponse
-	(*GetIPStatsRequest)(nil),            // 60: nitella.proxy.GetIPStatsRequest
-	(*IPStatsResult)(nil),                // 61: nitella.proxy.IPStatsResult
-	(*GetIPStatsResponse)(nil),           // 62: nitella.proxy.GetIPStatsResponse
-	(*GetGeoStatsRequest)(nil),           // 63: nitella.proxy.GetGeoStatsRequest
-	(*GeoStatsResult)(nil),               // 64: nitella.proxy.GeoStatsResult
-	(*GetGeoStatsResponse)(nil),          // 65: nitella.proxy.GetGeoStatsResponse
-	(*GetStatsSummaryRequest)(nil),       // 66: nitella.proxy.GetStatsSummaryRequest
-	(*StatsSummaryResponse)(nil),         // 67: nitella.proxy.StatsSummaryResponse
-	(*ResolveApprovalRequest)(nil),       // 68: nitella.proxy.ResolveApprovalRequest
-	(*ResolveApprovalResponse)(nil),      // 69: nitella.proxy.ResolveApprovalResponse
-	(*ActiveApproval)(nil),               // 70: nitella.proxy.ActiveApproval
-	(*ListActiveApprovalsRequest)(nil),   // 71: nitella.proxy.ListActiveApprovalsRequest
-	(*ListActiveApprovalsResponse)(nil),  // 72: nitella.proxy.ListActiveApprovalsResponse
-	(*CancelApprovalRequest)(nil),        // 73: nitella.proxy.CancelApprovalRequest
-	(*CancelApprovalResponse)(nil),       // 74: nitella.proxy.CancelApprovalResponse
-	(*SendCommandRequest)(nil),           // 75: nitella.proxy.SendCommandRequest
-	(*SendCommandResponse)(nil),          // 76: nitella.proxy.SendCommandResponse
-	(*common.GeoInfo)(nil),               // 77: nitella.GeoInfo
-	(common.ActionType)(0),               // 78: nitella.ActionType
-	(common.MockPreset)(0),               // 79: nitella.MockPreset
-	(common.FallbackAction)(0),           // 80: nitella.FallbackAction
-	(common.ConditionType)(0),            // 81: nitella.ConditionType
-	(common.Operator)(0),                 // 82: nitella.Operator
-	(*timestamp.Timestamp)(nil),          // 83: google.protobuf.Timestamp
-	(*common.EncryptedPayload)(nil),      // 84: nitella.EncryptedPayload
-	(common.ApprovalActionType)(0),       // 85: nitella.ApprovalActionType
-	(common.ApprovalRetentionMode)(0),    // 86: nitella.ApprovalRetentionMode
+	(ApprovalTimeoutAction)(0),           // 3: nitella.proxy.ApprovalTimeoutAction
+	(ApprovalHold)(0),                    // 4: nitella.proxy.ApprovalHold
+	(ThresholdAction)(0),                 // 5: nitella.proxy.ThresholdAction
+	(EventType)(0),                       // 6: nitella.proxy.EventType
+	(ConfigureGeoIPRequest_Mode)(0),      // 7: nitella.proxy.ConfigureGeoIPRequest.Mode
+	(*ConfigureGeoIPRequest)(nil),        // 8: nitella.proxy.ConfigureGeoIPRequest
+	(*ConfigureGeoIPResponse)(nil),       // 9: nitella.proxy.ConfigureGeoIPResponse
+	(*LookupIPRequest)(nil),              // 10: nitella.proxy.LookupIPRequest
+	(*LookupIPResponse)(nil),             // 11: nitella.proxy.LookupIPResponse
+	(*GetGeoIPStatusRequest)(nil),        // 12: nitella.proxy.GetGeoIPStatusRequest
+	(*GetGeoIPStatusResponse)(nil),       // 13: nitella.proxy.GetGeoIPStatusResponse
+	(*CreateProxyRequest)(nil),           // 14: nitella.proxy.CreateProxyRequest
+	(*HealthCheckConfig)(nil),            // 15: nitella.proxy.HealthCheckConfig
+	(*CreateProxyResponse)(nil),          // 16: nitella.proxy.CreateProxyResponse
+	(*DisableProxyRequest)(nil),          // 17: nitella.proxy.DisableProxyRequest
+	(*DisableProxyResponse)(nil),         // 18: nitella.proxy.DisableProxyResponse
+	(*EnableProxyRequest)(nil),           // 19: nitella.proxy.EnableProxyRequest
+	(*EnableProxyResponse)(nil),          // 20: nitella.proxy.EnableProxyResponse
+	(*DeleteProxyRequest)(nil),           // 21: nitella.proxy.DeleteProxyRequest
+	(*DeleteProxyResponse)(nil),          // 22: nitella.proxy.DeleteProxyResponse
+	(*UpdateProxyRequest)(nil),           // 23: nitella.proxy.UpdateProxyRequest
+	(*UpdateProxyResponse)(nil),          // 24: nitella.proxy.UpdateProxyResponse
+	(*RestartListenersResponse)(nil),     // 25: nitella.proxy.RestartListenersResponse
+	(*GetStatusRequest)(nil),             // 26: nitella.proxy.GetStatusRequest
+	(*ProxyStatus)(nil),                  // 27: nitella.proxy.ProxyStatus
+	(*ReloadRulesRequest)(nil),           // 28: nitella.proxy.ReloadRulesRequest
+	(*ReloadRulesResponse)(nil),          // 29: nitella.proxy.ReloadRulesResponse
+	(*ApplyProxyRequest)(nil),            // 30: nitella.proxy.ApplyProxyRequest
+	(*ApplyProxyResponse)(nil),           // 31: nitella.proxy.ApplyProxyResponse
+	(*AppliedProxyStatus)(nil),           // 32: nitella.proxy.AppliedProxyStatus
+	(*GetAppliedProxiesResponse)(nil),    // 33: nitella.proxy.GetAppliedProxiesResponse
+	(*Rule)(nil),                         // 34: nitella.proxy.Rule
+	(*ApprovalPolicy)(nil),               // 35: nitella.proxy.ApprovalPolicy
+	(*LocalApprover)(nil),                // 36: nitella.proxy.LocalApprover
+	(*ConnectionThresholds)(nil),         // 37: nitella.proxy.ConnectionThresholds
+	(*Condition)(nil),                    // 38: nitella.proxy.Condition
+	(*RateLimitConfig)(nil),              // 39: nitella.proxy.RateLimitConfig
+	(*MockConfig)(nil),                   // 40: nitella.proxy.MockConfig
+	(*AddRuleRequest)(nil),               // 41: nitella.proxy.AddRuleRequest
+	(*RemoveRuleRequest)(nil),            // 42: nitella.proxy.RemoveRuleRequest
+	(*ListRulesRequest)(nil),             // 43: nitella.proxy.ListRulesRequest
+	(*ListRulesResponse)(nil),            // 44: nitella.proxy.ListRulesResponse
+	(*ListProxiesRequest)(nil),           // 45: nitella.proxy.ListProxiesRequest
+	(*ListProxiesResponse)(nil),          // 46: nitella.proxy.ListProxiesResponse
+	(*BlockIPRequest)(nil),               // 47: nitella.proxy.BlockIPRequest
+	(*AllowIPRequest)(nil),               // 48: nitella.proxy.AllowIPRequest
+	(*GlobalRule)(nil),                   // 49: nitella.proxy.GlobalRule
+	(*ListGlobalRulesRequest)(nil),       // 50: nitella.proxy.ListGlobalRulesRequest
+	(*ListGlobalRulesResponse)(nil),      // 51: nitella.proxy.ListGlobalRulesResponse
+	(*RemoveGlobalRuleRequest)(nil),      // 52: nitella.proxy.RemoveGlobalRuleRequest
+	(*RemoveGlobalRuleResponse)(nil),     // 53: nitella.proxy.RemoveGlobalRuleResponse
+	(*StreamConnectionsRequest)(nil),     // 54: nitella.proxy.StreamConnectionsRequest
+	(*ConnectionEvent)(nil),              // 55: nitella.proxy.ConnectionEvent
+	(*MockCapture)(nil),                  // 56: nitella.proxy.MockCapture
+	(*StreamMetricsRequest)(nil),         // 57: nitella.proxy.StreamMetricsRequest
+	(*MetricsSample)(nil),                // 58: nitella.proxy.MetricsSample
+	(*EncryptedStreamPayload)(nil),       // 59: nitella.proxy.EncryptedStreamPayload
+	(*ActiveConnection)(nil),             // 60: nitella.proxy.ActiveConnection
+	(*GetActiveConnectionsRequest)(nil),  // 61: nitella.proxy.GetActiveConnectionsRequest
+	(*GetActiveConnectionsResponse)(nil), // 62: nitella.proxy.GetActiveConnectionsResponse
+	(*CloseConnectionRequest)(nil),       // 63: nitella.proxy.CloseConnectionRequest
+	(*CloseConnectionResponse)(nil),      // 64: nitella.proxy.CloseConnectionResponse
+	(*CloseAllConnectionsRequest)(nil),   // 65: nitella.proxy.CloseAllConnectionsRequest
+	(*CloseAllConnectionsResponse)(nil),  // 66: nitella.proxy.CloseAllConnectionsResponse
+	(*GetIPStatsRequest)(nil),            // 67: nitella.proxy.GetIPStatsRequest
+	(*IPStatsResult)(nil),                // 68: nitella.proxy.IPStatsResult
+	(*GetIPStatsResponse)(nil),           // 69: nitella.proxy.GetIPStatsResponse
+	(*GetGeoStatsRequest)(nil),           // 70: nitella.proxy.GetGeoStatsRequest
+	(*GeoStatsResult)(nil),               // 71: nitella.proxy.GeoStatsResult
+	(*GetGeoStatsResponse)(nil),          // 72: nitella.proxy.GetGeoStatsResponse
+	(*GetStatsSummaryRequest)(nil),       // 73: nitella.proxy.GetStatsSummaryRequest
+	(*StatsSummaryResponse)(nil),         // 74: nitella.proxy.StatsSummaryResponse
+	(*AlertSinkStats)(nil),               // 75: nitella.proxy.AlertSinkStats
+	(*TarpitStats)(nil),                  // 76: nitella.proxy.TarpitStats
+	(*ResolveApprovalRequest)(nil),       // 77: nitella.proxy.ResolveApprovalRequest
+	(*ResolveApprovalResponse)(nil),      // 78: nitella.proxy.ResolveApprovalResponse
+	(*ActiveApproval)(nil),               // 79: nitella.proxy.ActiveApproval
+	(*ListActiveApprovalsRequest)(nil),   // 80: nitella.proxy.ListActiveApprovalsRequest
+	(*ListActiveApprovalsResponse)(nil),  // 81: nitella.proxy.ListActiveApprovalsResponse
+	(*CancelApprovalRequest)(nil),        // 82: nitella.proxy.CancelApprovalRequest
+	(*CancelApprovalResponse)(nil),       // 83: nitella.proxy.CancelApprovalResponse
+	(*GetMockTranscriptsRequest)(nil),    // 84: nitella.proxy.GetMockTranscriptsRequest
+	(*MockTranscript)(nil),               // 85: nitella.proxy.MockTranscript
+	(*GetMockTranscriptsResponse)(nil),   // 86: nitella.proxy.GetMockTranscriptsResponse
+	(*CloneBannerRequest)(nil),           // 87: nitella.proxy.CloneBannerRequest
+	(*ClonedPreset)(nil),                 // 88: nitella.proxy.ClonedPreset
+	(*CloneBannerResponse)(nil),          // 89: nitella.proxy.CloneBannerResponse
+	(*ListClonedPresetsRequest)(nil),     // 90: nitella.proxy.ListClonedPresetsRequest
+	(*ListClonedPresetsResponse)(nil),    // 91: nitella.proxy.ListClonedPresetsResponse
+	(*SendCommandRequest)(nil),           // 92: nitella.proxy.SendCommandRequest
+	(*SendCommandResponse)(nil),          // 93: nitella.proxy.SendCommandResponse
+	nil,                                  // 94: nitella.proxy.ProxyStatus.RuleHitsEntry
+	nil,                                  // 95: nitella.proxy.MockCapture.FieldsEntry
+	(*common.GeoInfo)(nil),               // 96: nitella.GeoInfo
+	(common.ActionType)(0),               // 97: nitella.ActionType
+	(common.MockPreset)(0),               // 98: nitella.MockPreset
+	(common.FallbackAction)(0),           // 99: nitella.FallbackAction
+	(*timestamp.Timestamp)(nil),          // 100: google.protobuf.Timestamp
+	(common.ConditionType)(0),            // 101: nitella.ConditionType
+	(common.Operator)(0),                 // 102: nitella.Operator
+	(*common.EncryptedPayload)(nil),      // 103: nitella.EncryptedPayload
+	(common.ApprovalActionType)(0),       // 104: nitella.ApprovalActionType
+	(common.ApprovalRetentionMode)(0),    // 105: nitella.ApprovalRetentionMode
 }
 var file_proxy_proxy_proto_depIdxs = []int32{
-	4,  // 0: nitella.proxy.ConfigureGeoIPRequest.mode:type_name -> nitella.proxy.ConfigureGeoIPRequest.Mode
-	77, // 1: nitella.proxy.LookupIPResponse.geo:type_name -> nitella.GeoInfo
-	78, // 2: nitella.proxy.CreateProxyRequest.default_action:type_name -> nitella.ActionType
-	79, // 3: nitella.proxy.CreateProxyRequest.default_mock:type_name -> nitella.MockPreset
-	80, // 4: nitella.proxy.CreateProxyRequest.fallback_action:type_name -> nitella.FallbackAction
-	79, // 5: nitella.proxy.CreateProxyRequest.fallback_mock:type_name -> nitella.MockPreset
-	1,  // 6: nitella.proxy.CreateProxyRequest.client_auth_type:type_name -> nitella.proxy.ClientAuthType
-	12, // 7: nitella.proxy.CreateProxyRequest.health_check:type_name -> nitella.proxy.HealthCheckConfig
-	0,  // 8: nitella.proxy.HealthCheckConfig.type:type_name -> nitella.proxy.HealthCheckType
-	78, // 9: nitella.proxy.UpdateProxyRequest.default_action:type_name -> nitella.ActionType
-	79, // 10: nitella.proxy.UpdateProxyRequest.default_mock:type_name -> nitella.MockPreset
-	80, // 11: nitella.proxy.UpdateProxyRequest.fallback_action:type_name -> nitella.FallbackAction
-	79, // 12: nitella.proxy.UpdateProxyRequest.fallback_mock:type_name -> nitella.MockPreset
-	1,  // 13: nitella.proxy.UpdateProxyRequest.client_auth_type:type_name -> nitella.proxy.ClientAuthType
-	12, // 14: nitella.proxy.UpdateProxyRequest.health_check:type_name -> nitella.proxy.HealthCheckConfig
-	78, // 15: nitella.proxy.ProxyStatus.default_action:type_name -> nitella.ActionType
-	79, // 16: nitella.proxy.ProxyStatus.default_mock:type_name -> nitella.MockPreset
-	80, // 17: nitella.proxy.ProxyStatus.fallback_action:type_name -> nitella.FallbackAction
-	79, // 18: nitella.proxy.ProxyStatus.fallback_mock:type_name -> nitella.MockPreset
-	1,  // 19: nitella.proxy.ProxyStatus.client_auth_type:type_name -> nitella.proxy.ClientAuthType
-	12, // 20: nitella.proxy.ProxyStatus.health_check:type_name -> nitella.proxy.HealthCheckConfig
-	2,  // 21: nitella.proxy.ProxyStatus.health_status:type_name -> nitella.proxy.HealthStatus
-	31, // 22: nitella.proxy.ReloadRulesRequest.rules:type_name -> nitella.proxy.Rule
-	29, // 23: nitella.proxy.GetAppliedProxiesResponse.proxies:type_name -> nitella.proxy.AppliedProxyStatus
-	32, // 24: nitella.proxy.Rule.conditions:type_name -> nitella.proxy.Condition
-	78, // 25: nitella.proxy.Rule.action:type_name -> nitella.ActionType
-	33, // 26: nitella.proxy.Rule.rate_limit:type_name -> nitella.proxy.RateLimitConfig
-	34, // 27: nitella.proxy.Rule.mock_response:type_name -> nitella.proxy.MockConfig
-	81, // 28: nitella.proxy.Condition.type:type_name -> nitella.ConditionType
-	82, // 29: nitella.proxy.Condition.op:type_name -> nitella.Operator
-	79, // 30: nitella.proxy.MockConfig.preset:type_name -> nitella.MockPreset
-	31, // 31: nitella.proxy.AddRuleRequest.rule:type_name -> nitella.proxy.Rule
-	31, // 32: nitella.proxy.ListRulesResponse.rules:type_name -> nitella.proxy.Rule
-	24, // 33: nitella.proxy.ListProxiesResponse.proxies:type_name -> nitella.proxy.ProxyStatus
-	78, // 34: nitella.proxy.GlobalRule.action:type_name -> nitella.ActionType
-	83, // 35: nitella.proxy.GlobalRule.expires_at:type_name -> google.protobuf.Timestamp
-	83, // 36: nitella.proxy.GlobalRule.created_at:type_name -> google.protobuf.Timestamp
-	43, // 37: nitella.proxy.ListGlobalRulesResponse.rules:type_name -> nitella.proxy.GlobalRule
-	3,  // 38: nitella.proxy.ConnectionEvent.event_type:type_name -> nitella.proxy.EventType
-	78, // 39: nitella.proxy.ConnectionEvent.action_taken:type_name -> nitella.ActionType
-	77, // 40: nitella.proxy.ConnectionEvent.geo:type_name -> nitella.GeoInfo
-	84, // 41: nitella.proxy.EncryptedStreamPayload.encrypted:type_name -> nitella.EncryptedPayload
-	83, // 42: nitella.proxy.ActiveConnection.start_time:type_name -> google.protobuf.Timestamp
-	77, // 43: nitella.proxy.ActiveConnection.geo:type_name -> nitella.GeoInfo
-	53, // 44: nitella.proxy.GetActiveConnectionsResponse.connections:type_name -> nitella.proxy.ActiveConnection
-	83, // 45: nitella.proxy.IPStatsResult.first_seen:type_name -> google.protobuf.Timestamp
-	83, // 46: nitella.proxy.IPStatsResult.last_seen:type_name -> google.protobuf.Timestamp
-	61, // 47: nitella.proxy.GetIPStatsResponse.stats:type_name -> nitella.proxy.IPStatsResult
-	64, // 48: nitella.proxy.GetGeoStatsResponse.stats:type_name -> nitella.proxy.GeoStatsResult
-	83, // 49: nitella.proxy.StatsSummaryResponse.timestamp:type_name -> google.protobuf.Timestamp
-	85, // 50: nitella.proxy.ResolveApprovalRequest.action:type_name -> nitella.ApprovalActionType
-	86, // 51: nitella.proxy.ResolveApprovalRequest.retention_mode:type_name -> nitella.ApprovalRetentionMode
-	83, // 52: nitella.proxy.ActiveApproval.created_at:type_name -> google.protobuf.Timestamp
-	83, // 53: nitella.proxy.ActiveApproval.expires_at:type_name -> google.protobuf.Timestamp
-	70, // 54: nitella.proxy.ListActiveApprovalsResponse.approvals:type_name -> nitella.proxy.ActiveApproval
-	84, // 55: nitella.proxy.SendCommandRequest.encrypted:type_name -> nitella.EncryptedPayload
-	84, // 56: nitella.proxy.SendCommandResponse.encrypted:type_name -> nitella.EncryptedPayload
-	75, // 57: nitella.proxy.ProxyControlService.SendCommand:input_type -> nitella.proxy.SendCommandRequest
-	48, // 58: nitella.proxy.ProxyControlService.StreamConnections:input_type -> nitella.proxy.StreamConnectionsRequest
-	50, // 59: nitella.proxy.ProxyControlService.StreamMetrics:input_type -> nitella.proxy.StreamMetricsRequest
-	76, // 60: nitella.proxy.ProxyControlService.SendCommand:output_type -> nitella.proxy.SendCommandResponse
-	52, // 61: nitella.proxy.ProxyControlService.StreamConnections:output_type -> nitella.proxy.EncryptedStreamPayload
-	52, // 62: nitella.proxy.ProxyControlService.StreamMetrics:output_type -> nitella.proxy.EncryptedStreamPayload
-	60, // [60:63] is the sub-list for method output_type
-	57, // [57:60] is the sub-list for method input_type
-	57, // [57:57] is the sub-list for extension type_name
-	57, // [57:57] is the sub-list for extension extendee
-	0,  // [0:57] is the sub-list for field type_name
+	7,   // 0: nitella.proxy.ConfigureGeoIPRequest.mode:type_name -> nitella.proxy.ConfigureGeoIPRequest.Mode
+	96,  // 1: nitella.proxy.LookupIPResponse.geo:type_name -> nitella.GeoInfo
+	97,  // 2: nitella.proxy.CreateProxyRequest.default_action:type_name -> nitella.ActionType
+	98,  // 3: nitella.proxy.CreateProxyRequest.default_mock:type_name -> nitella.MockPreset
+	99,  // 4: nitella.proxy.CreateProxyRequest.fallback_action:type_name -> nitella.FallbackAction
+	98,  // 5: nitella.proxy.CreateProxyRequest.fallback_mock:type_name -> nitella.MockPreset
+	1,   // 6: nitella.proxy.CreateProxyRequest.client_auth_type:type_name -> nitella.proxy.ClientAuthType
+	15,  // 7: nitella.proxy.CreateProxyRequest.health_check:type_name -> nitella.proxy.HealthCheckConfig
+	37,  // 8: nitella.proxy.CreateProxyRequest.thresholds:type_name -> nitella.proxy.ConnectionThresholds
+	0,   // 9: nitella.proxy.HealthCheckConfig.type:type_name -> nitella.proxy.HealthCheckType
+	97,  // 10: nitella.proxy.UpdateProxyRequest.default_action:type_name -> nitella.ActionType
+	98,  // 11: nitella.proxy.UpdateProxyRequest.default_mock:type_name -> nitella.MockPreset
+	99,  // 12: nitella.proxy.UpdateProxyRequest.fallback_action:type_name -> nitella.FallbackAction
+	98,  // 13: nitella.proxy.UpdateProxyRequest.fallback_mock:type_name -> nitella.MockPreset
+	1,   // 14: nitella.proxy.UpdateProxyRequest.client_auth_type:type_name -> nitella.proxy.ClientAuthType
+	15,  // 15: nitella.proxy.UpdateProxyRequest.health_check:type_name -> nitella.proxy.HealthCheckConfig
+	37,  // 16: nitella.proxy.UpdateProxyRequest.thresholds:type_name -> nitella.proxy.ConnectionThresholds
+	97,  // 17: nitella.proxy.ProxyStatus.default_action:type_name -> nitella.ActionType
+	98,  // 18: nitella.proxy.ProxyStatus.default_mock:type_name -> nitella.MockPreset
+	99,  // 19: nitella.proxy.ProxyStatus.fallback_action:type_name -> nitella.FallbackAction
+	98,  // 20: nitella.proxy.ProxyStatus.fallback_mock:type_name -> nitella.MockPreset
+	1,   // 21: nitella.proxy.ProxyStatus.client_auth_type:type_name -> nitella.proxy.ClientAuthType
+	15,  // 22: nitella.proxy.ProxyStatus.health_check:type_name -> nitella.proxy.HealthCheckConfig
+	2,   // 23: nitella.proxy.ProxyStatus.health_status:type_name -> nitella.proxy.HealthStatus
+	94,  // 24: nitella.proxy.ProxyStatus.rule_hits:type_name -> nitella.proxy.ProxyStatus.RuleHitsEntry
+	34,  // 25: nitella.proxy.ReloadRulesRequest.rules:type_name -> nitella.proxy.Rule
+	32,  // 26: nitella.proxy.GetAppliedProxiesResponse.proxies:type_name -> nitella.proxy.AppliedProxyStatus
+	38,  // 27: nitella.proxy.Rule.conditions:type_name -> nitella.proxy.Condition
+	97,  // 28: nitella.proxy.Rule.action:type_name -> nitella.ActionType
+	39,  // 29: nitella.proxy.Rule.rate_limit:type_name -> nitella.proxy.RateLimitConfig
+	40,  // 30: nitella.proxy.Rule.mock_response:type_name -> nitella.proxy.MockConfig
+	100, // 31: nitella.proxy.Rule.not_before:type_name -> google.protobuf.Timestamp
+	100, // 32: nitella.proxy.Rule.expires_at:type_name -> google.protobuf.Timestamp
+	37,  // 33: nitella.proxy.Rule.thresholds:type_name -> nitella.proxy.ConnectionThresholds
+	35,  // 34: nitella.proxy.Rule.approval_policy:type_name -> nitella.proxy.ApprovalPolicy
+	3,   // 35: nitella.proxy.ApprovalPolicy.on_timeout:type_name -> nitella.proxy.ApprovalTimeoutAction
+	98,  // 36: nitella.proxy.ApprovalPolicy.timeout_mock:type_name -> nitella.MockPreset
+	4,   // 37: nitella.proxy.ApprovalPolicy.hold:type_name -> nitella.proxy.ApprovalHold
+	36,  // 38: nitella.proxy.ApprovalPolicy.local:type_name -> nitella.proxy.LocalApprover
+	5,   // 39: nitella.proxy.ConnectionThresholds.action:type_name -> nitella.proxy.ThresholdAction
+	101, // 40: nitella.proxy.Condition.type:type_name -> nitella.ConditionType
+	102, // 41: nitella.proxy.Condition.op:type_name -> nitella.Operator
+	98,  // 42: nitella.proxy.MockConfig.preset:type_name -> nitella.MockPreset
+	34,  // 43: nitella.proxy.AddRuleRequest.rule:type_name -> nitella.proxy.Rule
+	34,  // 44: nitella.proxy.ListRulesResponse.rules:type_name -> nitella.proxy.Rule
+	27,  // 45: nitella.proxy.ListProxiesResponse.proxies:type_name -> nitella.proxy.ProxyStatus
+	97,  // 46: nitella.proxy.GlobalRule.action:type_name -> nitella.ActionType
+	100, // 47: nitella.proxy.GlobalRule.expires_at:type_name -> google.protobuf.Timestamp
+	100, // 48: nitella.proxy.GlobalRule.created_at:type_name -> google.protobuf.Timestamp
+	49,  // 49: nitella.proxy.ListGlobalRulesResponse.rules:type_name -> nitella.proxy.GlobalRule
+	6,   // 50: nitella.proxy.ConnectionEvent.event_type:type_name -> nitella.proxy.EventType
+	97,  // 51: nitella.proxy.ConnectionEvent.action_taken:type_name -> nitella.ActionType
+	96,  // 52: nitella.proxy.ConnectionEvent.geo:type_name -> nitella.GeoInfo
+	56,  // 53: nitella.proxy.ConnectionEvent.capture:type_name -> nitella.proxy.MockCapture
+	95,  // 54: nitella.proxy.MockCapture.fields:type_name -> nitella.proxy.MockCapture.FieldsEntry
+	103, // 55: nitella.proxy.EncryptedStreamPayload.encrypted:type_name -> nitella.EncryptedPayload
+	100, // 56: nitella.proxy.ActiveConnection.start_time:type_name -> google.protobuf.Timestamp
+	96,  // 57: nitella.proxy.ActiveConnection.geo:type_name -> nitella.GeoInfo
+	60,  // 58: nitella.proxy.GetActiveConnectionsResponse.connections:type_name -> nitella.proxy.ActiveConnection
+	100, // 59: nitella.proxy.IPStatsResult.first_seen:type_name -> google.protobuf.Timestamp
+	100, // 60: nitella.proxy.IPStatsResult.last_seen:type_name -> google.protobuf.Timestamp
+	68,  // 61: nitella.proxy.GetIPStatsResponse.stats:type_name -> nitella.proxy.IPStatsResult
+	71,  // 62: nitella.proxy.GetGeoStatsResponse.stats:type_name -> nitella.proxy.GeoStatsResult
+	100, // 63: nitella.proxy.StatsSummaryResponse.timestamp:type_name -> google.protobuf.Timestamp
+	76,  // 64: nitella.proxy.StatsSummaryResponse.tarpit:type_name -> nitella.proxy.TarpitStats
+	75,  // 65: nitella.proxy.StatsSummaryResponse.alert_sinks:type_name -> nitella.proxy.AlertSinkStats
+	104, // 66: nitella.proxy.ResolveApprovalRequest.action:type_name -> nitella.ApprovalActionType
+	105, // 67: nitella.proxy.ResolveApprovalRequest.retention_mode:type_name -> nitella.ApprovalRetentionMode
+	100, // 68: nitella.proxy.ActiveApproval.created_at:type_name -> google.protobuf.Timestamp
+	100, // 69: nitella.proxy.ActiveApproval.expires_at:type_name -> google.protobuf.Timestamp
+	79,  // 70: nitella.proxy.ListActiveApprovalsResponse.approvals:type_name -> nitella.proxy.ActiveApproval
+	100, // 71: nitella.proxy.MockTranscript.start_time:type_name -> google.protobuf.Timestamp
+	85,  // 72: nitella.proxy.GetMockTranscriptsResponse.transcripts:type_name -> nitella.proxy.MockTranscript
+	100, // 73: nitella.proxy.ClonedPreset.cloned_at:type_name -> google.protobuf.Timestamp
+	88,  // 74: nitella.proxy.CloneBannerResponse.preset:type_name -> nitella.proxy.ClonedPreset
+	88,  // 75: nitella.proxy.ListClonedPresetsResponse.presets:type_name -> nitella.proxy.ClonedPreset
+	103, // 76: nitella.proxy.SendCommandRequest.encrypted:type_name -> nitella.EncryptedPayload
+	103, // 77: nitella.proxy.SendCommandResponse.encrypted:type_name -> nitella.EncryptedPayload
+	92,  // 78: nitella.proxy.ProxyControlService.SendCommand:input_type -> nitella.proxy.SendCommandRequest
+	54,  // 79: nitella.proxy.ProxyControlService.StreamConnections:input_type -> nitella.proxy.StreamConnectionsRequest
+	57,  // 80: nitella.proxy.ProxyControlService.StreamMetrics:input_type -> nitella.proxy.StreamMetricsRequest
+	93,  // 81: nitella.proxy.ProxyControlService.SendCommand:output_type -> nitella.proxy.SendCommandResponse
+	59,  // 82: nitella.proxy.ProxyControlService.StreamConnections:output_type -> nitella.proxy.EncryptedStreamPayload
+	59,  // 83: nitella.proxy.ProxyControlService.StreamMetrics:output_type -> nitella.proxy.EncryptedStreamPayload
+	81,  // [81:84] is the sub-list for method output_type
+	78,  // [78:81] is the sub-list for method input_type
+	78,  // [78:78] is the sub-list for extension type_name
+	78,  // [78:78] is the sub-list for extension extendee
+	0,   // [0:78] is the sub-list for field type_name
 }
 
 func init() { file_proxy_proxy_proto_init() }
@@ -5629,8 +7304,8 @@ func file_proxy_proxy_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proxy_proxy_proto_rawDesc), len(file_proxy_proxy_proto_rawDesc)),
-			NumEnums:      5,
-			NumMessages:   72,
+			NumEnums:      8,
+			NumMessages:   88,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
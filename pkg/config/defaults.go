@@ -12,6 +12,11 @@ const (
 	// Used by: node
 	DefaultApprovalTimeoutSeconds = 120
 
+	// MaxApprovalTimeoutSeconds caps approval policy timeouts: the Hub drops
+	// pending approval alerts after this long (5 minutes).
+	// Used by: hub, node
+	MaxApprovalTimeoutSeconds = 300
+
 	// DefaultMaxPendingApprovals is the maximum concurrent pending approval requests
 	// to prevent memory exhaustion from DoS attacks.
 	// Used by: node
@@ -27,6 +32,31 @@ const (
 	// Used by: node
 	DefaultMaxPendingPerProxy = 200
 
+	// LocalApproverTimeout bounds an exec or webhook local approver; the
+	// request goes to the Hub when it has not answered by then.
+	// Used by: node
+	LocalApproverTimeout = 30 * time.Second
+
+	// ReverseDNSTimeout bounds a reverse DNS lookup for an approval request's
+	// source, including the forward lookup confirming the name.
+	// Used by: node
+	ReverseDNSTimeout = 2 * time.Second
+
+	// ReverseDNSCacheTTL is how long a reverse DNS answer, or its absence,
+	// is reused.
+	// Used by: node
+	ReverseDNSCacheTTL = 10 * time.Minute
+
+	// ReverseDNSCacheSize caps the cached reverse DNS answers; new sources
+	// are not resolved while the cache is full of live entries.
+	// Used by: node
+	ReverseDNSCacheSize = 10000
+
+	// ReverseDNSMaxInflight caps concurrent reverse DNS lookups so a flood
+	// of new sources cannot tie up the resolver.
+	// Used by: node
+	ReverseDNSMaxInflight = 16
+
 	// ApprovalCacheCleanupInterval is how often to check for expired approval entries.
 	// Used by: node
 	ApprovalCacheCleanupInterval = 10 * time.Second
@@ -37,6 +67,126 @@ const (
 	MaxConnIDsPerApproval = 1000
 )
 
+// Alert classification
+const (
+	// AlertMetadataType is the Alert.Metadata key naming the alert type.
+	// Alerts without it are approval requests (older nodes never set it).
+	// Used by: node, hub, cli, mobile
+	AlertMetadataType = "type"
+
+	// AlertMetadataApprovals and AlertMetadataQuorum carry a quorum approval
+	// request's allow votes so far and the votes it needs. The node re-sends
+	// the alert under the same ID as votes arrive.
+	// Used by: node, cli, mobile
+	AlertMetadataApprovals = "approvals"
+	AlertMetadataQuorum    = "quorum"
+
+	// AlertFieldDecision is the AlertDetails.Fields key of an
+	// AlertTypeApprovalResolved alert saying how the request ended:
+	// ApprovalDecisionAllow, ApprovalDecisionDeny, ApprovalDecisionExpired or
+	// ApprovalDecisionWithdrawn. It is in the encrypted payload, so the Hub
+	// does not learn the decision.
+	// Used by: node, cli, mobile
+	AlertFieldDecision = "decision"
+
+	// AlertTypeApproval marks an alert as a connection approval request.
+	AlertTypeApproval = "approval"
+
+	// AlertTypeApprovalResolved marks an alert telling approvers that the
+	// approval request with the same ID no longer needs a decision, e.g.
+	// because another device decided first. Clients dismiss its prompt.
+	AlertTypeApprovalResolved = "approval_resolved"
+
+	// AlertTypeHoneypot marks an alert raised by a mock capturing attacker input.
+	AlertTypeHoneypot = "honeypot"
+
+	// AlertTypeConnection marks an alert about a connection allowed by an
+	// ALLOW_ALERT rule or default action.
+	AlertTypeConnection = "connection"
+
+	// AlertTypeThreshold marks an alert about a connection crossing a
+	// bytes-out or duration threshold.
+	AlertTypeThreshold = "threshold"
+)
+
+// Approval request outcomes (AlertFieldDecision values)
+const (
+	ApprovalDecisionAllow     = "allow"
+	ApprovalDecisionDeny      = "deny"
+	ApprovalDecisionExpired   = "expired"   // Nobody decided before the policy timeout
+	ApprovalDecisionWithdrawn = "withdrawn" // The connection closed or the node stopped waiting
+)
+
+// Connection threshold defaults
+const (
+	// DefaultThresholdThrottleRate is the rate, in bytes per second, a
+	// connection is throttled to when a THROTTLE threshold does not set one.
+	// Used by: node
+	DefaultThresholdThrottleRate = 64 << 10
+
+	// ThresholdCheckInterval is how often open connections are checked
+	// against their thresholds.
+	// Used by: node
+	ThresholdCheckInterval = 1 * time.Second
+)
+
+// Connection alert defaults (ACTION_TYPE_ALLOW_ALERT)
+const (
+	// DefaultConnectionAlertDedup is how long repeat connections from the same
+	// source to the same proxy and rule are folded into one alert.
+	// Used by: node
+	DefaultConnectionAlertDedup = 10 * time.Minute
+
+	// DefaultConnectionAlertRate is the maximum number of connection alerts a
+	// node sends per minute. Alerts over the limit are dropped and counted.
+	// Used by: node
+	DefaultConnectionAlertRate = 30
+)
+
+// Alert sink defaults (node-local webhook, syslog and SMTP delivery)
+const (
+	// DefaultAlertSinkBatchSize is the most alerts delivered to a sink at once.
+	// Used by: node
+	DefaultAlertSinkBatchSize = 20
+
+	// DefaultAlertSinkBatchWait is how long a sink waits for more alerts
+	// before delivering a partial batch.
+	// Used by: node
+	DefaultAlertSinkBatchWait = 5 * time.Second
+
+	// DefaultAlertSinkMaxRetries is how many times a failed batch is retried
+	// before its alerts are counted as dead letters.
+	// Used by: node
+	DefaultAlertSinkMaxRetries = 5
+
+	// AlertSinkRetryBackoff is the wait before the first retry; it doubles
+	// with each retry up to AlertSinkMaxBackoff.
+	// Used by: node
+	AlertSinkRetryBackoff = 1 * time.Second
+	AlertSinkMaxBackoff   = 1 * time.Minute
+
+	// AlertSinkQueueSize caps the alerts waiting for a sink; alerts raised
+	// while it is full are counted as dead letters.
+	// Used by: node
+	AlertSinkQueueSize = 1000
+
+	// AlertSinkTimeout bounds one delivery attempt.
+	// Used by: node
+	AlertSinkTimeout = 10 * time.Second
+)
+
+// Access log defaults
+const (
+	// DefaultAccessLogMaxSizeMB is the size past which the access log is
+	// rotated.
+	// Used by: node
+	DefaultAccessLogMaxSizeMB = 100
+
+	// DefaultAccessLogMaxBackups is how many rotated access logs are kept.
+	// Used by: node
+	DefaultAccessLogMaxBackups = 10
+)
+
 // Cleanup system defaults
 const (
 	// DefaultTaskTimeout is the maximum time a cleanup task can run before logging a warning.
@@ -24,6 +24,7 @@ type MockBehavior struct {
 	DisconnectAfter int    // Disconnect after N attempts
 
 	// General
+	Tarpit           bool // Protocol-aware tarpit (database mocks): keep the client waiting on auth
 	NeverComplete    bool // Never complete the handshake
 	ReconnectPenalty bool // Increase delay on frequent reconnects
 	PenaltyDuration  int  // Duration window to track reconnections (seconds)
@@ -168,6 +169,142 @@ WWW-Authenticate: Basic realm="Restricted"
 			DelayMs: 500,
 		},
 	},
+	"postgres-secure": {
+		Name:     "postgres-secure",
+		Protocol: "postgres",
+		Behavior: MockBehavior{
+			DelayMs:      500,
+			FinalMessage: "password authentication failed for user",
+		},
+	},
+	"postgres-tarpit": {
+		Name:     "postgres-tarpit",
+		Protocol: "postgres",
+		Behavior: MockBehavior{
+			Tarpit:           true,
+			ReconnectPenalty: true,
+			PenaltyDuration:  60,
+		},
+	},
+	"mongodb-secure": {
+		Name:     "mongodb-secure",
+		Protocol: "mongodb",
+		Behavior: MockBehavior{
+			DelayMs:      500,
+			FinalMessage: "Authentication failed.",
+		},
+	},
+	"mongodb-tarpit": {
+		Name:     "mongodb-tarpit",
+		Protocol: "mongodb",
+		Behavior: MockBehavior{
+			Tarpit:           true,
+			ReconnectPenalty: true,
+			PenaltyDuration:  60,
+		},
+	},
+	"elasticsearch-secure": {
+		Name:     "elasticsearch-secure",
+		Protocol: "elasticsearch",
+		Behavior: MockBehavior{
+			DelayMs:      500,
+			FinalMessage: "security_exception",
+		},
+	},
+	"elasticsearch-tarpit": {
+		Name:     "elasticsearch-tarpit",
+		Protocol: "elasticsearch",
+		Behavior: MockBehavior{
+			Tarpit:           true,
+			DripIntervalMs:   500,
+			ReconnectPenalty: true,
+			PenaltyDuration:  60,
+		},
+	},
+	"memcached-secure": {
+		Name:     "memcached-secure",
+		Protocol: "memcached",
+		Behavior: MockBehavior{
+			DelayMs:      500,
+			FinalMessage: "CLIENT_ERROR authentication failure",
+		},
+	},
+	"memcached-tarpit": {
+		Name:     "memcached-tarpit",
+		Protocol: "memcached",
+		Behavior: MockBehavior{
+			Tarpit:           true,
+			ReconnectPenalty: true,
+			PenaltyDuration:  60,
+		},
+	},
+	"ftp-secure": {
+		Name:     "ftp-secure",
+		Protocol: "ftp",
+		Banner:   "220 (vsFTPd 3.0.3)\r\n",
+		Behavior: MockBehavior{
+			DelayMs:      300,
+			FinalMessage: "530 Login incorrect.",
+		},
+	},
+	"ftp-tarpit": {
+		Name:     "ftp-tarpit",
+		Protocol: "ftp",
+		Banner:   "220 (vsFTPd 3.0.3)\r\n",
+		Behavior: MockBehavior{
+			Tarpit:           true,
+			DripBanner:       true,
+			DripIntervalMs:   500,
+			ReconnectPenalty: true,
+			PenaltyDuration:  60,
+		},
+	},
+	"vnc-secure": {
+		Name:     "vnc-secure",
+		Protocol: "vnc",
+		Banner:   "RFB 003.008\n",
+		Behavior: MockBehavior{
+			DelayMs:      300,
+			FinalMessage: "Authentication failed",
+		},
+	},
+	"vnc-tarpit": {
+		Name:     "vnc-tarpit",
+		Protocol: "vnc",
+		Banner:   "RFB 003.008\n",
+		Behavior: MockBehavior{
+			Tarpit:           true,
+			DripBanner:       true,
+			DripIntervalMs:   1000,
+			ReconnectPenalty: true,
+			PenaltyDuration:  60,
+		},
+	},
+	"smb-secure": {
+		Name:     "smb-secure",
+		Protocol: "smb",
+		Behavior: MockBehavior{
+			DelayMs:      300,
+			FinalMessage: "STATUS_LOGON_FAILURE",
+		},
+	},
+	"ldap-secure": {
+		Name:     "ldap-secure",
+		Protocol: "ldap",
+		Behavior: MockBehavior{
+			DelayMs:      300,
+			FinalMessage: "invalidCredentials",
+		},
+	},
+	"ldap-tarpit": {
+		Name:     "ldap-tarpit",
+		Protocol: "ldap",
+		Behavior: MockBehavior{
+			Tarpit:           true,
+			ReconnectPenalty: true,
+			PenaltyDuration:  60,
+		},
+	},
 }
 
 // GetPreset returns a mock preset by name
@@ -4,17 +4,31 @@ package config
 type YAMLConfig struct {
 	EntryPoints map[string]EntryPoint `yaml:"entryPoints"`
 	TCP         TCPConfig             `yaml:"tcp"`
+	Alerts      AlertsConfig          `yaml:"alerts,omitempty"`
+	AccessLog   AccessLogConfig       `yaml:"accessLog,omitempty"`
+	Approvers   []LocalApproverConfig `yaml:"approvers,omitempty"`
 }
 
 // EntryPoint defines a listener
 type EntryPoint struct {
-	Address        string     `yaml:"address"`
-	DefaultAction  string     `yaml:"defaultAction"` // "allow", "block", or "mock"
-	DefaultBackend string     `yaml:"defaultBackend,omitempty"`
-	DefaultMock    string     `yaml:"defaultMock,omitempty"` // Mock preset for defaultAction=mock
-	FallbackAction string     `yaml:"fallbackAction,omitempty"` // "close" or "mock"
-	FallbackMock   string     `yaml:"fallbackMock,omitempty"`   // Mock preset for fallbackAction=mock
-	TLS            *TLSConfig `yaml:"tls,omitempty"`
+	Address        string            `yaml:"address"`
+	DefaultAction  string            `yaml:"defaultAction"` // "allow", "block", "mock", "approval" or "alert"
+	DefaultBackend string            `yaml:"defaultBackend,omitempty"`
+	DefaultMock    string            `yaml:"defaultMock,omitempty"`    // Mock preset for defaultAction=mock
+	FallbackAction string            `yaml:"fallbackAction,omitempty"` // "close" or "mock"
+	FallbackMock   string            `yaml:"fallbackMock,omitempty"`   // Mock preset for fallbackAction=mock
+	TLS            *TLSConfig        `yaml:"tls,omitempty"`
+	Thresholds     *ThresholdsConfig `yaml:"thresholds,omitempty"` // Limits for forwarded connections
+}
+
+// ThresholdsConfig limits connections while they are open. A zero limit is
+// not checked.
+type ThresholdsConfig struct {
+	MaxBytesOut            int64  `yaml:"maxBytesOut,omitempty"`            // Bytes sent to the client by one connection
+	MaxSourceBytesOutHour  int64  `yaml:"maxSourceBytesOutHour,omitempty"`  // Bytes sent to one source IP per hour
+	MaxDuration            string `yaml:"maxDuration,omitempty"`            // e.g. "2h"
+	Action                 string `yaml:"action,omitempty"`                 // "alert" (default), "close" or "throttle"
+	ThrottleBytesPerSecond int64  `yaml:"throttleBytesPerSecond,omitempty"` // Rate for action=throttle
 }
 
 // TLSConfig for mTLS settings
@@ -84,3 +98,70 @@ type MockConfig struct {
 	Banner   string `yaml:"banner,omitempty"`
 	Response string `yaml:"response,omitempty"`
 }
+
+// AlertsConfig configures where the node delivers alerts besides the Hub.
+type AlertsConfig struct {
+	Sinks []AlertSinkConfig `yaml:"sinks,omitempty"`
+}
+
+// AlertSinkConfig is a node-local alert sink.
+type AlertSinkConfig struct {
+	Name        string `yaml:"name"`
+	Type        string `yaml:"type"`                  // "webhook", "syslog" or "smtp"
+	MinSeverity string `yaml:"minSeverity,omitempty"` // "info" (default), "warning", "high" or "critical"
+
+	// Batching and retry
+	BatchSize  int    `yaml:"batchSize,omitempty"`
+	BatchWait  string `yaml:"batchWait,omitempty"` // e.g. "5s"
+	MaxRetries int    `yaml:"maxRetries,omitempty"`
+
+	// Webhook: alerts are POSTed as JSON, signed with HMAC-SHA256 of secret
+	URL    string `yaml:"url,omitempty"`
+	Secret string `yaml:"secret,omitempty"`
+
+	// Syslog and SMTP server (host:port)
+	Address string `yaml:"address,omitempty"`
+
+	// Syslog (RFC 5424)
+	Network  string `yaml:"network,omitempty"`  // "udp" (default), "tcp" or "tls"
+	Facility string `yaml:"facility,omitempty"` // e.g. "local0" (default), "daemon"
+	CAFile   string `yaml:"caFile,omitempty"`   // Verifies a tls server (system roots by default)
+
+	// SMTP; STARTTLS is used when the server offers it
+	From     string   `yaml:"from,omitempty"`
+	To       []string `yaml:"to,omitempty"`
+	Username string   `yaml:"username,omitempty"`
+	Password string   `yaml:"password,omitempty"`
+}
+
+// LocalApproverConfig is a node-local approver. Rules refer to it by name
+// in their approval policy, so only the node's config decides what runs or
+// is called.
+type LocalApproverConfig struct {
+	Name string `yaml:"name"`
+	Type string `yaml:"type"` // "exec" or "webhook"
+
+	// Exec: program reading the request on stdin, writing the decision to stdout
+	Command string   `yaml:"command,omitempty"`
+	Args    []string `yaml:"args,omitempty"`
+
+	// Webhook: endpoint receiving the request as a POST; secret is the
+	// HMAC-SHA256 key signing the request and the response
+	URL    string `yaml:"url,omitempty"`
+	Secret string `yaml:"secret,omitempty"`
+}
+
+// AccessLogConfig configures the access log: one structured record per
+// closed, blocked, mocked or approval-decided connection. An empty path
+// disables it.
+type AccessLogConfig struct {
+	Path   string `yaml:"path,omitempty"`
+	Format string `yaml:"format,omitempty"` // "json" (default, one object per line), "cef" or "leef"
+
+	// Rotation: the file is renamed with a timestamp suffix once it grows
+	// past maxSizeMB or has been written to for maxAge, keeping maxBackups
+	// old files
+	MaxSizeMB  int    `yaml:"maxSizeMB,omitempty"`
+	MaxAge     string `yaml:"maxAge,omitempty"` // e.g. "24h" (default: no age limit)
+	MaxBackups int    `yaml:"maxBackups,omitempty"`
+}
@@ -2,11 +2,16 @@ package crypto
 
 import (
 	"crypto/ed25519"
+	"crypto/hmac"
+	"crypto/sha256"
 	"crypto/x509"
 	"encoding/base64"
+	"encoding/hex"
 	"encoding/pem"
 	"errors"
 	"fmt"
+	"strconv"
+	"strings"
 	"time"
 )
 
@@ -127,3 +132,35 @@ func VerifyBase64(data []byte, signatureB64 string, pubKey ed25519.PublicKey) er
 	}
 	return Verify(data, signature, pubKey)
 }
+
+// ApprovalVotePayload is the message an approver signs to vote on a pending
+// connection approval. Nodes verify it against their registered approver
+// keys to count the vote towards a quorum.
+func ApprovalVotePayload(reqID string, action, retentionMode int32, durationSeconds int64, reason string) []byte {
+	return []byte(strings.Join([]string{
+		"nitella-approval-vote",
+		reqID,
+		strconv.Itoa(int(action)),
+		strconv.Itoa(int(retentionMode)),
+		strconv.FormatInt(durationSeconds, 10),
+		reason,
+	}, "\x00"))
+}
+
+// WebhookSignatureHeader carries the signature of a webhook body the node
+// sends or receives (alert sinks, local approvers), as made by SignWebhook.
+const WebhookSignatureHeader = "X-Nitella-Signature"
+
+// SignWebhook is the WebhookSignatureHeader value for body signed with
+// secret: "sha256=" and the hex HMAC-SHA256 of the body.
+func SignWebhook(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// VerifyWebhook reports whether sig is body's WebhookSignatureHeader value
+// for secret, comparing in constant time.
+func VerifyWebhook(secret string, body []byte, sig string) bool {
+	return hmac.Equal([]byte(sig), []byte(SignWebhook(secret, body)))
+}
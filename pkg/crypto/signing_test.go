@@ -0,0 +1,19 @@
+package crypto
+
+import "testing"
+
+func TestSignWebhook(t *testing.T) {
+	body := []byte(`{"alerts":[]}`)
+	// echo -n '{"alerts":[]}' | openssl dgst -sha256 -hmac s3cret
+	const want = "sha256=ebee03b5dfcb900cf23c43b292f0713ca2f3328f92de490ae50b178fb8a92c77"
+	sig := SignWebhook("s3cret", body)
+	if sig != want {
+		t.Errorf("SignWebhook = %q, want %q", sig, want)
+	}
+	if !VerifyWebhook("s3cret", body, sig) {
+		t.Error("Expected the signature verified")
+	}
+	if VerifyWebhook("other", body, sig) || VerifyWebhook("s3cret", []byte(`{}`), sig) || VerifyWebhook("s3cret", body, "") {
+		t.Error("Expected a wrong secret, body or missing signature rejected")
+	}
+}
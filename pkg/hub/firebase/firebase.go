@@ -105,12 +105,13 @@ func (s *Service) IsEnabled() bool {
 
 // PushTypes for data field
 const (
-	PushTypeApproval       = "approval"
-	PushTypeNodeOnline     = "node_online"
-	PushTypeNodeOffline    = "node_offline"
-	PushTypeAlert          = "alert"
-	PushTypeCommandResult  = "command_result"
-	PushTypeMetrics        = "metrics"
+	PushTypeApproval         = "approval"
+	PushTypeApprovalResolved = "approval_resolved"
+	PushTypeNodeOnline       = "node_online"
+	PushTypeNodeOffline      = "node_offline"
+	PushTypeAlert            = "alert"
+	PushTypeCommandResult    = "command_result"
+	PushTypeMetrics          = "metrics"
 )
 
 // SendApprovalRequest sends a push notification for connection approval
@@ -125,6 +126,29 @@ func (s *Service) SendApprovalRequest(token, reqID, nodeID, sourceIP string) err
 		})
 }
 
+// SendApprovalPending sends a push notification for an approval request the
+// Hub cannot read. It names only the request and node; the app shows the
+// details once it has decrypted the alert.
+func (s *Service) SendApprovalPending(token, reqID, nodeID string) error {
+	return s.SendPush(token, "Connection Approval Required",
+		"A connection is waiting for your approval",
+		map[string]string{
+			"type":    PushTypeApproval,
+			"req_id":  reqID,
+			"node_id": nodeID,
+		})
+}
+
+// SendApprovalResolved sends a silent push telling the app to dismiss the
+// notification of an approval request that no longer needs a decision.
+func (s *Service) SendApprovalResolved(token, reqID, nodeID string) error {
+	return s.SendDataPush(token, map[string]string{
+		"type":    PushTypeApprovalResolved,
+		"req_id":  reqID,
+		"node_id": nodeID,
+	})
+}
+
 // SendNodeStatus sends a push notification for node status change
 func (s *Service) SendNodeStatus(token, nodeID string, online bool) error {
 	status := "offline"
@@ -16,6 +16,7 @@ import (
 
 	"github.com/ivere27/nitella/pkg/api/common"
 	pb "github.com/ivere27/nitella/pkg/api/hub"
+	"github.com/ivere27/nitella/pkg/config"
 	"github.com/ivere27/nitella/pkg/hub/model"
 )
 
@@ -439,6 +440,27 @@ func (s *NodeServer) PushAlert(ctx context.Context, alert *common.Alert) (*pb.Em
 	// Fill in NodeId for the alert (node may not have included it)
 	alert.NodeId = nodeID
 
+	// The node settled an approval request, e.g. on the first device to
+	// decide: every device dismisses its prompt and notification
+	if alert.GetMetadata()[config.AlertMetadataType] == config.AlertTypeApprovalResolved {
+		s.hub.pendingAlertsMu.Lock()
+		if pending, ok := s.hub.pendingAlerts[alert.Id]; ok && pending.NodeID == nodeID {
+			delete(s.hub.pendingAlerts, alert.Id)
+		}
+		s.hub.pendingAlertsMu.Unlock()
+		s.hub.ForwardAlertToClients(node.RoutingToken, alert)
+		s.hub.pushToDevices(node.RoutingToken, func(token string) error {
+			return s.hub.firebase.SendApprovalResolved(token, alert.Id, nodeID)
+		})
+		return &pb.Empty{}, nil
+	}
+
+	// Informational alerts (e.g. honeypot captures) expect no decision
+	if t := alert.GetMetadata()[config.AlertMetadataType]; t != "" && t != config.AlertTypeApproval {
+		s.hub.ForwardAlertToClients(node.RoutingToken, alert)
+		return &pb.Empty{}, nil
+	}
+
 	// Store pending alert for routing approval decisions back to node
 	// This is needed because approval decisions reference the alert ID
 	s.hub.pendingAlertsMu.Lock()
@@ -449,6 +471,8 @@ func (s *NodeServer) PushAlert(ctx context.Context, alert *common.Alert) (*pb.Em
 			"hub overloaded: too many pending alerts globally (max: %d), try again later",
 			MaxGlobalPendingAlerts)
 	}
+	// Quorum requests are re-sent as votes arrive; devices are pushed once
+	_, resent := s.hub.pendingAlerts[alert.Id]
 	s.hub.pendingAlerts[alert.Id] = &PendingAlert{
 		NodeID:       nodeID,
 		RoutingToken: node.RoutingToken,
@@ -457,8 +481,15 @@ func (s *NodeServer) PushAlert(ctx context.Context, alert *common.Alert) (*pb.Em
 	}
 	s.hub.pendingAlertsMu.Unlock()
 
-	// Forward the original alert (with encrypted payload) to connected clients
+	// Forward the original alert (with encrypted payload) to every connected
+	// client and push it to every device; the first decision the node
+	// accepts wins
 	s.hub.ForwardAlertToClients(node.RoutingToken, alert)
+	if !resent {
+		s.hub.pushToDevices(node.RoutingToken, func(token string) error {
+			return s.hub.firebase.SendApprovalPending(token, alert.Id, nodeID)
+		})
+	}
 
 	return &pb.Empty{}, nil
 }
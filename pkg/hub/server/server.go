@@ -29,6 +29,7 @@ import (
 
 	"github.com/ivere27/nitella/pkg/api/common"
 	pb "github.com/ivere27/nitella/pkg/api/hub"
+	"github.com/ivere27/nitella/pkg/config"
 	nitellacrypto "github.com/ivere27/nitella/pkg/crypto"
 	"github.com/ivere27/nitella/pkg/hub/auth"
 	"github.com/ivere27/nitella/pkg/hub/certmanager"
@@ -45,7 +46,7 @@ const (
 	GlobalPairingRateLimit = 100
 
 	// PendingAlertExpiry is how long pending alerts are kept before expiring
-	PendingAlertExpiry = 5 * time.Minute
+	PendingAlertExpiry = config.MaxApprovalTimeoutSeconds * time.Second
 
 	// MaxGlobalPendingAlerts is the maximum pending alerts across all routing tokens.
 	// This prevents memory exhaustion from distributed DoS attacks (many users/tokens).
@@ -752,20 +753,29 @@ func (s *HubServer) NotifyByRoutingToken(routingToken string, title string, body
 	}
 
 	// Fallback to Firebase via FCM topic
-	if s.firebase != nil && s.firebase.IsEnabled() {
-		// Get FCM topic from routing token info
-		info, err := s.store.GetRoutingTokenInfo(routingToken)
-		if err != nil || info.FCMTopic == "" {
-			return
-		}
-		// Get device tokens subscribed to this topic
-		tokens, err := s.store.GetFCMTokensByTopic(info.FCMTopic)
-		if err != nil {
-			return
-		}
-		for _, t := range tokens {
-			go s.firebase.SendPush(t.Token, title, body, data)
-		}
+	s.pushToDevices(routingToken, func(token string) error {
+		return s.firebase.SendPush(token, title, body, data)
+	})
+}
+
+// pushToDevices calls send for every device subscribed to routingToken's
+// FCM topic, when push notifications are enabled.
+func (s *HubServer) pushToDevices(routingToken string, send func(token string) error) {
+	if s.firebase == nil || !s.firebase.IsEnabled() {
+		return
+	}
+	// Get FCM topic from routing token info
+	info, err := s.store.GetRoutingTokenInfo(routingToken)
+	if err != nil || info.FCMTopic == "" {
+		return
+	}
+	// Get device tokens subscribed to this topic
+	tokens, err := s.store.GetFCMTokensByTopic(info.FCMTopic)
+	if err != nil {
+		return
+	}
+	for _, t := range tokens {
+		go send(t.Token)
 	}
 }
 
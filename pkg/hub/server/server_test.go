@@ -18,6 +18,7 @@ import (
 
 	"github.com/ivere27/nitella/pkg/api/common"
 	pb "github.com/ivere27/nitella/pkg/api/hub"
+	"github.com/ivere27/nitella/pkg/config"
 	"github.com/ivere27/nitella/pkg/hub/auth"
 	"github.com/ivere27/nitella/pkg/hub/model"
 	"github.com/ivere27/nitella/pkg/hub/store"
@@ -895,3 +896,46 @@ func containsAny(s string, substrings ...string) bool {
 	}
 	return false
 }
+
+func TestPushAlertFansOutApprovalAndResolution(t *testing.T) {
+	ts := setupTestServer(t)
+	defer ts.cleanup()
+
+	node := &model.Node{ID: "fanout-node", RoutingToken: "fanout-token", Status: "online"}
+	if err := ts.store.SaveNode(node); err != nil {
+		t.Fatalf("Failed to save node: %v", err)
+	}
+	phone, laptop := make(chan *common.Alert, 4), make(chan *common.Alert, 4)
+	ts.hubServer.userStreamsMu.Lock()
+	ts.hubServer.userStreams[node.RoutingToken] = map[chan *common.Alert]bool{phone: true, laptop: true}
+	ts.hubServer.userStreamsMu.Unlock()
+
+	ctx := context.WithValue(context.Background(), ctxKeyNodeID, node.ID)
+	encrypted := &common.EncryptedPayload{Ciphertext: []byte("sealed")}
+	if _, err := ts.hubServer.Node.PushAlert(ctx, &common.Alert{Id: "req-1", Encrypted: encrypted}); err != nil {
+		t.Fatalf("PushAlert failed: %v", err)
+	}
+	if _, err := ts.hubServer.Node.PushAlert(ctx, &common.Alert{
+		Id:        "req-1",
+		Encrypted: encrypted,
+		Metadata:  map[string]string{config.AlertMetadataType: config.AlertTypeApprovalResolved},
+	}); err != nil {
+		t.Fatalf("PushAlert failed: %v", err)
+	}
+
+	for name, ch := range map[string]chan *common.Alert{"phone": phone, "laptop": laptop} {
+		if len(ch) != 2 {
+			t.Fatalf("Expected the request and its resolution on the %s, got %d alerts", name, len(ch))
+		}
+		<-ch
+		if got := <-ch; got.GetMetadata()[config.AlertMetadataType] != config.AlertTypeApprovalResolved {
+			t.Errorf("Expected the resolution second on the %s, got %v", name, got.GetMetadata())
+		}
+	}
+	ts.hubServer.pendingAlertsMu.Lock()
+	_, pending := ts.hubServer.pendingAlerts["req-1"]
+	ts.hubServer.pendingAlertsMu.Unlock()
+	if pending {
+		t.Error("Expected the resolved request removed from pending alerts")
+	}
+}
@@ -21,6 +21,7 @@ import (
 
 	"github.com/ivere27/nitella/pkg/api/common"
 	pb "github.com/ivere27/nitella/pkg/api/hub"
+	"github.com/ivere27/nitella/pkg/config"
 	nitellacrypto "github.com/ivere27/nitella/pkg/crypto"
 	"github.com/ivere27/nitella/pkg/p2p"
 	"github.com/mdp/qrterminal/v3"
@@ -100,7 +101,7 @@ type Client struct {
 	commandHandler  CommandHandler
 
 	// Approval resolution callback (called when P2P decision received)
-	onApprovalDecision func(reqID string, allowed bool, durationSeconds int64, reason string)
+	onApprovalDecision func(decision *p2p.ApprovalDecision)
 }
 
 // NewClient creates a Hub client with TLS enabled (production-ready).
@@ -224,7 +225,7 @@ func (c *Client) SetSTUNServer(url string) {
 }
 
 // SetApprovalDecisionHandler sets the callback for P2P approval decisions
-func (c *Client) SetApprovalDecisionHandler(handler func(reqID string, allowed bool, durationSeconds int64, reason string)) {
+func (c *Client) SetApprovalDecisionHandler(handler func(decision *p2p.ApprovalDecision)) {
 	c.onApprovalDecision = handler
 }
 
@@ -251,14 +252,30 @@ func (c *Client) SendAlert(alert *common.Alert, info string) error {
 		return fmt.Errorf("alert is nil")
 	}
 
-	// Try P2P first if enabled and connected
-	if c.useP2P && c.p2pManager != nil && c.p2pManager.HasConnectedSessions() {
-		if c.trySendAlertViaP2P(alert, info) {
+	// Try P2P first if enabled and connected (approval requests, their
+	// resolutions and connection alerts). Approval requests and resolutions
+	// also go through the Hub so every device of the owner sees them, not
+	// just the P2P peers; clients fold the copies by alert ID.
+	alertType := alert.GetMetadata()[config.AlertMetadataType]
+	isApproval := alertType == "" || alertType == config.AlertTypeApproval
+	fanOut := isApproval || alertType == config.AlertTypeApprovalResolved
+	viaP2P := fanOut || alertType == config.AlertTypeConnection
+	if viaP2P && c.useP2P && c.p2pManager != nil && c.p2pManager.HasConnectedSessions() {
+		var sent bool
+		if isApproval {
+			sent = c.trySendAlertViaP2P(alert, info)
+		} else {
+			sent = c.trySendInfoAlertViaP2P(alert, info)
+		}
+		if sent {
 			log.Printf("[HubClient] Alert %s sent via P2P", alert.Id)
-			return nil
+			if !fanOut {
+				return nil
+			}
+		} else {
+			// P2P failed, fall through to Hub
+			log.Printf("[HubClient] P2P send failed, falling back to Hub for alert %s", alert.Id)
 		}
-		// P2P failed, fall through to Hub
-		log.Printf("[HubClient] P2P send failed, falling back to Hub for alert %s", alert.Id)
 	}
 
 	// Zero-Trust: Encrypt and sign alert info with viewer's public key (owner's key)
@@ -313,12 +330,39 @@ func (c *Client) trySendAlertViaP2P(alert *common.Alert, infoBytes string) bool
 		GeoCountry: details.GeoCountry,
 		GeoCity:    details.GeoCity,
 		GeoISP:     details.GeoIsp,
+		Context:    details.Context,
 	}
 
 	// Send to all connected P2P sessions
 	return c.p2pManager.SendApprovalRequest(req) > 0
 }
 
+// trySendInfoAlertViaP2P attempts to send an informational alert via P2P
+// Returns true if successfully sent to at least one peer
+func (c *Client) trySendInfoAlertViaP2P(alert *common.Alert, infoBytes string) bool {
+	var details common.AlertDetails
+	if err := proto.Unmarshal([]byte(infoBytes), &details); err != nil {
+		log.Printf("[HubClient] Failed to parse alert info for P2P: %v", err)
+		return false
+	}
+
+	return c.p2pManager.SendAlert(&p2p.Alert{
+		AlertID:    alert.Id,
+		NodeID:     alert.NodeId,
+		Type:       alert.GetMetadata()[config.AlertMetadataType],
+		Severity:   alert.Severity,
+		ProxyID:    details.ProxyId,
+		SourceIP:   details.SourceIp,
+		DestAddr:   details.Destination,
+		RuleID:     details.RuleId,
+		GeoCountry: details.GeoCountry,
+		GeoCity:    details.GeoCity,
+		GeoISP:     details.GeoIsp,
+		Summary:    details.Summary,
+		Fields:     details.Fields,
+	}) > 0
+}
+
 // Start begins the Hub connection loop with automatic reconnection
 func (c *Client) Start() {
 	c.running = true
@@ -968,8 +1012,7 @@ func (c *Client) startSignalingLoop(ctx context.Context, client pb.NodeServiceCl
 			log.Printf("[P2P] Received approval decision for %s: action=%d, duration=%d",
 				decision.RequestID, decision.Action, decision.DurationSeconds)
 			if c.onApprovalDecision != nil {
-				allowed := decision.Action == 1 // 1=allow, 2=block
-				c.onApprovalDecision(decision.RequestID, allowed, decision.DurationSeconds, decision.Reason)
+				c.onApprovalDecision(decision)
 			}
 		}
 
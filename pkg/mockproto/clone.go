@@ -0,0 +1,169 @@
+package mockproto
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	// maxClonedBanner caps a recorded banner.
+	maxClonedBanner = 1024
+	// maxSSHPreambleLines bounds the lines a server may send before its
+	// identification string (RFC 4253 4.2).
+	maxSSHPreambleLines = 20
+)
+
+// CloneBanner connects once to a real server at addr and records the
+// identity it presents, in the form ApplyClonedBanner serves it:
+//
+//	ssh   identification line ("SSH-2.0-...\r\n")
+//	smtp  220 greeting, all lines
+//	mysql server version from the initial handshake
+//	http  Server header of a HEAD / response
+//	raw   first bytes the server sends
+//
+// Nothing is sent to the server beyond what the protocol needs to reveal it.
+func CloneBanner(protocol, addr string, timeout time.Duration) (string, error) {
+	switch protocol {
+	case "ssh", "smtp", "mysql", "http", "raw":
+	default:
+		return "", fmt.Errorf("banner cloning not supported for protocol %q", protocol)
+	}
+
+	conn, err := net.DialTimeout("tcp", addr, timeout)
+	if err != nil {
+		return "", err
+	}
+	defer conn.Close()
+	conn.SetDeadline(time.Now().Add(timeout))
+	br := bufio.NewReader(io.LimitReader(conn, 64*1024))
+
+	var banner string
+	switch protocol {
+	case "ssh":
+		banner, err = cloneSSH(br)
+	case "smtp":
+		banner, err = cloneSMTP(br)
+		conn.Write([]byte("QUIT\r\n"))
+	case "mysql":
+		banner, err = cloneMySQL(br)
+	case "http":
+		banner, err = cloneHTTP(conn, br, addr)
+	case "raw":
+		buf := make([]byte, maxClonedBanner)
+		var n int
+		n, err = br.Read(buf)
+		banner = string(buf[:n])
+		if err == nil && n == 0 {
+			err = errors.New("no data")
+		}
+	}
+	if err != nil {
+		return "", fmt.Errorf("clone %s banner from %s: %w", protocol, addr, err)
+	}
+	if len(banner) > maxClonedBanner {
+		return "", fmt.Errorf("clone %s banner from %s: banner too long", protocol, addr)
+	}
+	return banner, nil
+}
+
+// ApplyClonedBanner configures a mock to present a banner recorded by
+// CloneBanner for protocol.
+func (config *MockConfig) ApplyClonedBanner(protocol, banner string) {
+	switch protocol {
+	case "smtp":
+		config.SMTPGreeting = banner
+	case "mysql":
+		config.MySQLVersion = banner
+	case "http":
+		config.HTTPServer = banner
+	default:
+		config.Payload = []byte(banner)
+	}
+}
+
+func cloneSSH(br *bufio.Reader) (string, error) {
+	for i := 0; i < maxSSHPreambleLines; i++ {
+		line, err := br.ReadString('\n')
+		if err != nil {
+			return "", err
+		}
+		if strings.HasPrefix(line, "SSH-") {
+			return strings.TrimRight(line, "\r\n") + "\r\n", nil
+		}
+	}
+	return "", errors.New("no SSH identification string")
+}
+
+func cloneSMTP(br *bufio.Reader) (string, error) {
+	var sb strings.Builder
+	for sb.Len() < maxClonedBanner {
+		line, err := br.ReadString('\n')
+		if err != nil {
+			return "", err
+		}
+		line = strings.TrimRight(line, "\r\n")
+		if len(line) < 4 || !strings.HasPrefix(line, "220") {
+			return "", fmt.Errorf("unexpected greeting %q", line)
+		}
+		sb.WriteString(line + "\r\n")
+		if line[3] == ' ' {
+			return sb.String(), nil
+		}
+	}
+	return "", errors.New("greeting too long")
+}
+
+func cloneMySQL(br *bufio.Reader) (string, error) {
+	var header [4]byte
+	if _, err := io.ReadFull(br, header[:]); err != nil {
+		return "", err
+	}
+	n := int(binary.LittleEndian.Uint32(append(header[:3:3], 0)))
+	if n < 2 || n > maxClonedBanner*4 {
+		return "", errors.New("malformed handshake")
+	}
+	payload := make([]byte, n)
+	if _, err := io.ReadFull(br, payload); err != nil {
+		return "", err
+	}
+	if payload[0] == 0xff {
+		// ERR packet: code (2 bytes) then message, e.g. host not allowed
+		if len(payload) > 3 {
+			return "", fmt.Errorf("server refused: %s", payload[3:])
+		}
+		return "", errors.New("server refused")
+	}
+	if payload[0] != 0x0a {
+		return "", fmt.Errorf("unsupported handshake version %d", payload[0])
+	}
+	version, _, ok := strings.Cut(string(payload[1:]), "\x00")
+	if !ok || version == "" {
+		return "", errors.New("malformed handshake")
+	}
+	return version, nil
+}
+
+func cloneHTTP(conn net.Conn, br *bufio.Reader, addr string) (string, error) {
+	req := "HEAD / HTTP/1.1\r\nHost: " + addr + "\r\nUser-Agent: Mozilla/5.0\r\nAccept: */*\r\nConnection: close\r\n\r\n"
+	if _, err := conn.Write([]byte(req)); err != nil {
+		return "", err
+	}
+	resp, err := http.ReadResponse(br, &http.Request{Method: http.MethodHead})
+	if err != nil {
+		return "", err
+	}
+	resp.Body.Close()
+	server := resp.Header.Get("Server")
+	if server == "" {
+		return "", errors.New("no Server header")
+	}
+	return server, nil
+}
@@ -0,0 +1,102 @@
+package mockproto
+
+import (
+	"bytes"
+	"net"
+	"strings"
+	"testing"
+	"time"
+)
+
+// serveMock runs a mock on a local listener and returns its address.
+func serveMock(t *testing.T, config MockConfig) string {
+	t.Helper()
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { l.Close() })
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				HandleConnection(conn, config)
+			}()
+		}
+	}()
+	return l.Addr().String()
+}
+
+func TestCloneBanner(t *testing.T) {
+	tests := []struct {
+		name   string
+		config MockConfig
+		want   string
+	}{
+		{"ssh", MockConfig{Protocol: "ssh", Payload: []byte("SSH-2.0-OpenSSH_9.2p1 Debian-2+deb12u3\r\n")}, "SSH-2.0-OpenSSH_9.2p1 Debian-2+deb12u3\r\n"},
+		{"smtp", MockConfig{Protocol: "smtp", SMTPGreeting: "220-mx1.corp.example ESMTP\r\n220 ready\r\n"}, "220-mx1.corp.example ESMTP\r\n220 ready\r\n"},
+		{"mysql", MockConfig{Protocol: "mysql", MySQLVersion: "8.0.36-0ubuntu0.22.04.1"}, "8.0.36-0ubuntu0.22.04.1"},
+		{"http", MockConfig{Protocol: "http", HTTPServer: "nginx/1.24.0"}, "nginx/1.24.0"},
+		{"raw", MockConfig{Protocol: "raw", Payload: []byte("+OK POP3 ready\r\n")}, "+OK POP3 ready\r\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			addr := serveMock(t, tt.config)
+			got, err := CloneBanner(tt.name, addr, 2*time.Second)
+			if err != nil {
+				t.Fatalf("CloneBanner failed: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestCloneBanner_Errors(t *testing.T) {
+	if _, err := CloneBanner("rdp", "127.0.0.1:1", time.Second); err == nil || !strings.Contains(err.Error(), "not supported") {
+		t.Errorf("Expected unsupported protocol error, got %v", err)
+	}
+
+	// An SMTP clone of a server that is not speaking SMTP
+	addr := serveMock(t, MockConfig{Protocol: "raw", Payload: []byte("HTTP/1.1 400 Bad Request\r\n")})
+	if _, err := CloneBanner("smtp", addr, 2*time.Second); err == nil || !strings.Contains(err.Error(), "unexpected greeting") {
+		t.Errorf("Expected greeting error, got %v", err)
+	}
+}
+
+func TestApplyClonedBanner(t *testing.T) {
+	var config MockConfig
+	config.ApplyClonedBanner("smtp", "220 mx1.corp.example ESMTP Exim 4.96\r\n")
+	conn := newMockConn([]byte("QUIT\r\n"))
+	MockSMTP(conn, config)
+	if !bytes.HasPrefix(conn.writeData, []byte("220 mx1.corp.example ESMTP Exim 4.96\r\n")) {
+		t.Errorf("Expected cloned SMTP greeting, got %q", conn.writeData)
+	}
+
+	config = MockConfig{}
+	config.ApplyClonedBanner("mysql", "8.0.36")
+	conn = newMockConn(make([]byte, 64))
+	MockMySQL(conn, config)
+	if !bytes.Contains(conn.writeData, []byte("\x0a8.0.36\x00")) {
+		t.Errorf("Expected cloned MySQL version, got %q", conn.writeData)
+	}
+
+	config = MockConfig{StatusCode: 403}
+	config.ApplyClonedBanner("http", "Microsoft-IIS/10.0")
+	conn = newMockConn([]byte("GET / HTTP/1.1\r\n\r\n"))
+	MockHTTP(conn, config)
+	if !bytes.Contains(conn.writeData, []byte("Server: Microsoft-IIS/10.0\r\n")) {
+		t.Errorf("Expected cloned Server header, got %q", conn.writeData)
+	}
+
+	config = MockConfig{}
+	config.ApplyClonedBanner("ssh", "SSH-2.0-OpenSSH_8.9p1 Ubuntu-3ubuntu0.6\r\n")
+	if sshServerVersion(config) != "SSH-2.0-OpenSSH_8.9p1 Ubuntu-3ubuntu0.6" {
+		t.Errorf("Expected cloned SSH version, got %q", sshServerVersion(config))
+	}
+}
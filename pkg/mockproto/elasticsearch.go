@@ -0,0 +1,127 @@
+package mockproto
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// MockElasticsearch emulates an Elasticsearch node with security enabled.
+// Every request is captured and answered with the 401 security_exception a
+// real cluster returns; Basic and ApiKey credentials are captured. In tarpit
+// mode responses are delayed, increasingly, and dripped.
+func MockElasticsearch(conn net.Conn, config MockConfig) error {
+	var t *Tarpit
+	if config.Tarpit {
+		t = NewTarpit(1000, 1000, 15000)
+	}
+
+	br := bufio.NewReader(conn)
+	for i := 0; i < maxHTTPRequestsPerConn; i++ {
+		conn.SetReadDeadline(time.Now().Add(httpRouteIdleTimeout))
+		req, err := http.ReadRequest(br)
+		if err != nil {
+			return nil
+		}
+		body, _ := io.ReadAll(io.LimitReader(req.Body, maxHTTPCaptureBody+1))
+		io.Copy(io.Discard, io.LimitReader(req.Body, maxHTTPDrainBody))
+		req.Body.Close()
+		conn.SetReadDeadline(time.Time{})
+
+		captureHTTPRequest(&config, "elasticsearch", req, body, nil)
+
+		reason := fmt.Sprintf("missing authentication credentials for REST request [%s]", req.URL.RequestURI())
+		if user, _, ok := req.BasicAuth(); ok {
+			reason = fmt.Sprintf("unable to authenticate user [%s] for REST request [%s]", user, req.URL.RequestURI())
+		} else if key, ok := esAPIKey(req); ok {
+			id, secret, _ := strings.Cut(key, ":")
+			config.capture(Capture{
+				Protocol: "elasticsearch",
+				Kind:     CapturePassword,
+				Username: id,
+				Password: secret,
+				Fields:   map[string]string{"method": "api_key", "path": req.URL.Path},
+			})
+			reason = "unable to authenticate with provided credentials and anonymous access is not allowed for this request"
+		}
+
+		keepAlive := !req.Close && i < maxHTTPRequestsPerConn-1
+		resp := esUnauthorized(req, reason, keepAlive)
+		if t != nil {
+			t.Sleep()
+			if err := DripWrite(conn, resp, config.DripIntervalMs); err != nil {
+				return nil
+			}
+		} else {
+			if config.RandomDelay {
+				RandomDelay(50, 500)
+			}
+			if _, err := conn.Write(resp); err != nil {
+				return nil
+			}
+		}
+		if !keepAlive {
+			return nil
+		}
+	}
+	return nil
+}
+
+// esAPIKey decodes an "Authorization: ApiKey base64(id:key)" header.
+func esAPIKey(req *http.Request) (string, bool) {
+	scheme, value, ok := strings.Cut(req.Header.Get("Authorization"), " ")
+	if !ok || !strings.EqualFold(scheme, "ApiKey") {
+		return "", false
+	}
+	decoded, err := base64.StdEncoding.DecodeString(strings.TrimSpace(value))
+	if err != nil {
+		// Some clients send the encoded form verbatim
+		return value, true
+	}
+	return string(decoded), true
+}
+
+// esUnauthorized builds the 401 response of a secured cluster.
+func esUnauthorized(req *http.Request, reason string, keepAlive bool) []byte {
+	cause := map[string]any{
+		"type":   "security_exception",
+		"reason": reason,
+		"header": map[string]any{
+			"WWW-Authenticate": []string{`Basic realm="security" charset="UTF-8"`, `ApiKey`},
+		},
+	}
+	body, _ := json.Marshal(map[string]any{
+		"error": map[string]any{
+			"root_cause": []any{cause},
+			"type":       cause["type"],
+			"reason":     cause["reason"],
+			"header":     cause["header"],
+		},
+		"status": http.StatusUnauthorized,
+	})
+
+	connection := "close"
+	if keepAlive {
+		connection = "keep-alive"
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString("HTTP/1.1 401 Unauthorized\r\n")
+	buf.WriteString("WWW-Authenticate: Basic realm=\"security\" charset=\"UTF-8\"\r\n")
+	buf.WriteString("WWW-Authenticate: ApiKey\r\n")
+	buf.WriteString("X-elastic-product: Elasticsearch\r\n")
+	buf.WriteString("content-type: application/json\r\n")
+	fmt.Fprintf(&buf, "content-length: %d\r\n", len(body))
+	fmt.Fprintf(&buf, "Connection: %s\r\n\r\n", connection)
+	if req.Method != http.MethodHead {
+		buf.Write(body)
+	}
+	return buf.Bytes()
+}
@@ -0,0 +1,262 @@
+package mockproto
+
+import (
+	"bufio"
+	"fmt"
+	"net"
+	"path"
+	"strings"
+	"time"
+)
+
+const (
+	ftpBanner = "220 (vsFTPd 3.0.3)\r\n"
+
+	// maxFTPCommands bounds the commands served on one control connection.
+	maxFTPCommands = 200
+	// ftpDataTimeout bounds how long a passive data port waits for the client.
+	ftpDataTimeout = 10 * time.Second
+)
+
+// ftpDirs is the fake filesystem shown to logged-in clients.
+var ftpDirs = map[string][]string{
+	"/": {
+		"drwxr-xr-x    2 0        0            4096 Mar 11  2024 backup",
+		"-rw-r--r--    1 0        0             512 Jan 07  2024 readme.txt",
+		"drwxr-xr-x    2 0        0            4096 Feb 19  2024 pub",
+	},
+	"/backup": {
+		"-rw-r--r--    1 0        0        48211968 Mar 11  2024 db_2024-03-11.sql.gz",
+		"-rw-r--r--    1 0        0            1873 Mar 11  2024 wp-config.php.bak",
+	},
+	"/pub": {},
+}
+
+// MockFTP emulates a vsftpd server. USER/PASS pairs are captured.
+// Anonymous logins (and any login when AcceptLogin is set) succeed into a
+// read-only fake tree that can be listed over passive data connections;
+// downloads and uploads fail. In tarpit mode every reply is delayed,
+// increasingly.
+func MockFTP(conn net.Conn, config MockConfig) error {
+	if err := writePaced(conn, config, []byte(ftpBanner)); err != nil {
+		return nil
+	}
+
+	var t *Tarpit
+	if config.Tarpit {
+		t = NewTarpit(500, 500, 10000)
+	}
+	s := &ftpSession{conn: conn, config: &config, cwd: "/"}
+	defer s.closeData()
+
+	br := bufio.NewReader(conn)
+	for i := 0; i < maxFTPCommands; i++ {
+		conn.SetReadDeadline(time.Now().Add(60 * time.Second))
+		line, err := br.ReadString('\n')
+		if err != nil {
+			return nil
+		}
+		cmd, arg, _ := strings.Cut(strings.TrimRight(line, "\r\n"), " ")
+		cmd = strings.ToUpper(cmd)
+
+		if t != nil {
+			t.Sleep()
+		}
+		if cmd == "QUIT" {
+			s.reply("221 Goodbye.")
+			return nil
+		}
+		if !s.handle(cmd, arg) {
+			return nil
+		}
+	}
+	return nil
+}
+
+// ftpSession is the state of one MockFTP control connection.
+type ftpSession struct {
+	conn     net.Conn
+	config   *MockConfig
+	user     string
+	loggedIn bool
+	cwd      string
+	data     net.Listener // passive data listener, if any
+}
+
+func (s *ftpSession) reply(format string, args ...any) bool {
+	_, err := fmt.Fprintf(s.conn, format+"\r\n", args...)
+	return err == nil
+}
+
+// handle answers one command; it returns false once the connection is gone.
+func (s *ftpSession) handle(cmd, arg string) bool {
+	switch cmd {
+	case "USER":
+		s.user, s.loggedIn = arg, false
+		return s.reply("331 Please specify the password.")
+	case "PASS":
+		if s.user == "" {
+			return s.reply("503 Login with USER first.")
+		}
+		fields := map[string]string{}
+		if isFTPAnonymous(s.user) {
+			fields["anonymous"] = "true"
+		}
+		s.config.capture(Capture{Protocol: "ftp", Kind: CapturePassword, Username: s.user, Password: arg, Fields: fields})
+		if isFTPAnonymous(s.user) || s.config.AcceptLogin {
+			s.loggedIn = true
+			return s.reply("230 Login successful.")
+		}
+		if s.config.RandomDelay {
+			RandomDelay(1000, 3000)
+		}
+		s.user = ""
+		return s.reply("530 Login incorrect.")
+	case "AUTH":
+		return s.reply("530 Please login with USER and PASS.")
+	case "SYST":
+		return s.reply("215 UNIX Type: L8")
+	case "FEAT":
+		return s.reply("211-Features:\r\n EPSV\r\n MDTM\r\n PASV\r\n REST STREAM\r\n SIZE\r\n TVFS\r\n UTF8\r\n211 End")
+	case "NOOP":
+		return s.reply("200 NOOP ok.")
+	case "OPTS":
+		return s.reply("200 Always in UTF8 mode.")
+	}
+
+	if !s.loggedIn {
+		return s.reply("530 Please login with USER and PASS.")
+	}
+
+	switch cmd {
+	case "PWD", "XPWD":
+		return s.reply("257 \"%s\" is the current directory", s.cwd)
+	case "CWD", "XCWD", "CDUP":
+		dir := s.resolve(arg)
+		if cmd == "CDUP" {
+			dir = path.Dir(s.cwd)
+		}
+		if _, ok := ftpDirs[dir]; !ok {
+			return s.reply("550 Failed to change directory.")
+		}
+		s.cwd = dir
+		return s.reply("250 Directory successfully changed.")
+	case "TYPE":
+		if strings.EqualFold(arg, "I") {
+			return s.reply("200 Switching to Binary mode.")
+		}
+		return s.reply("200 Switching to ASCII mode.")
+	case "PASV":
+		return s.passive(false)
+	case "EPSV":
+		return s.passive(true)
+	case "PORT", "EPRT":
+		// Active mode would make the honeypot connect out
+		return s.reply("500 Illegal PORT command.")
+	case "LIST", "NLST":
+		dir := s.cwd
+		if arg != "" && !strings.HasPrefix(arg, "-") {
+			dir = s.resolve(arg)
+		}
+		return s.list(dir, cmd == "NLST")
+	case "SIZE", "MDTM", "RETR":
+		s.closeData()
+		return s.reply("550 Failed to open file.")
+	case "STOR", "STOU", "APPE", "DELE", "MKD", "RMD", "RNFR", "RNTO", "SITE":
+		s.closeData()
+		return s.reply("550 Permission denied.")
+	default:
+		return s.reply("500 Unknown command.")
+	}
+}
+
+// resolve returns the absolute, cleaned path of p relative to the cwd.
+func (s *ftpSession) resolve(p string) string {
+	if !strings.HasPrefix(p, "/") {
+		p = s.cwd + "/" + p
+	}
+	return path.Clean(p)
+}
+
+// passive opens a data listener on the control connection's local address.
+func (s *ftpSession) passive(extended bool) bool {
+	s.closeData()
+	local, ok := s.conn.LocalAddr().(*net.TCPAddr)
+	if !ok || local.IP == nil || local.IP.IsUnspecified() {
+		return s.reply("425 Can't open passive connection.")
+	}
+	ip4 := local.IP.To4()
+	if !extended && ip4 == nil {
+		return s.reply("425 Use EPSV for IPv6.")
+	}
+
+	l, err := net.Listen("tcp", net.JoinHostPort(local.IP.String(), "0"))
+	if err != nil {
+		return s.reply("425 Can't open passive connection.")
+	}
+	s.data = l
+	port := l.Addr().(*net.TCPAddr).Port
+	if extended {
+		return s.reply("229 Entering Extended Passive Mode (|||%d|)", port)
+	}
+	return s.reply("227 Entering Passive Mode (%d,%d,%d,%d,%d,%d).", ip4[0], ip4[1], ip4[2], ip4[3], port>>8, port&0xff)
+}
+
+// list sends a directory listing over the passive data connection.
+func (s *ftpSession) list(dir string, namesOnly bool) bool {
+	entries, ok := ftpDirs[dir]
+	if s.data == nil {
+		return s.reply("425 Use PORT or PASV first.")
+	}
+	defer s.closeData()
+
+	data, err := s.acceptData()
+	if err != nil {
+		return s.reply("425 Failed to establish connection.")
+	}
+	defer data.Close()
+
+	if !s.reply("150 Here comes the directory listing.") {
+		return false
+	}
+	if ok {
+		var sb strings.Builder
+		for _, e := range entries {
+			if namesOnly {
+				e = e[strings.LastIndexByte(e, ' ')+1:]
+			}
+			sb.WriteString(e + "\r\n")
+		}
+		data.Write([]byte(sb.String()))
+	}
+	return s.reply("226 Directory send OK.")
+}
+
+// acceptData accepts the data connection, only from the control client's IP.
+func (s *ftpSession) acceptData() (net.Conn, error) {
+	if tl, ok := s.data.(*net.TCPListener); ok {
+		tl.SetDeadline(time.Now().Add(ftpDataTimeout))
+	}
+	data, err := s.data.Accept()
+	if err != nil {
+		return nil, err
+	}
+	remote, _, _ := net.SplitHostPort(s.conn.RemoteAddr().String())
+	dataRemote, _, _ := net.SplitHostPort(data.RemoteAddr().String())
+	if remote != dataRemote {
+		data.Close()
+		return nil, net.ErrClosed
+	}
+	return data, nil
+}
+
+func (s *ftpSession) closeData() {
+	if s.data != nil {
+		s.data.Close()
+		s.data = nil
+	}
+}
+
+func isFTPAnonymous(user string) bool {
+	return strings.EqualFold(user, "anonymous") || strings.EqualFold(user, "ftp")
+}
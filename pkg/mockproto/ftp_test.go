@@ -0,0 +1,98 @@
+package mockproto
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"testing"
+)
+
+func TestMockFTP_LoginIncorrect(t *testing.T) {
+	conn := newMockConn([]byte("USER admin\r\nPASS hunter2\r\nLIST\r\nQUIT\r\n"))
+
+	var captures []Capture
+	err := MockFTP(conn, MockConfig{OnCapture: func(c Capture) { captures = append(captures, c) }})
+	if err != nil {
+		t.Fatalf("MockFTP failed: %v", err)
+	}
+
+	want := ftpBanner +
+		"331 Please specify the password.\r\n" +
+		"530 Login incorrect.\r\n" +
+		"530 Please login with USER and PASS.\r\n" +
+		"221 Goodbye.\r\n"
+	if got := string(conn.writeData); got != want {
+		t.Errorf("Unexpected responses:\ngot  %q\nwant %q", got, want)
+	}
+	if len(captures) != 1 || captures[0].Username != "admin" || captures[0].Password != "hunter2" {
+		t.Errorf("Unexpected captures: %+v", captures)
+	}
+}
+
+func TestMockFTP_AnonymousPassiveList(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+
+	var captures []Capture
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		c, err := l.Accept()
+		if err != nil {
+			return
+		}
+		defer c.Close()
+		MockFTP(c, MockConfig{OnCapture: func(cp Capture) { captures = append(captures, cp) }})
+	}()
+
+	conn, err := net.Dial("tcp", l.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	r := bufio.NewReader(conn)
+	expect := func(cmd, prefix string) string {
+		t.Helper()
+		if cmd != "" {
+			fmt.Fprintf(conn, "%s\r\n", cmd)
+		}
+		line, err := r.ReadString('\n')
+		if err != nil || !strings.HasPrefix(line, prefix) {
+			t.Fatalf("%q: expected %s, got %q (%v)", cmd, prefix, line, err)
+		}
+		return line
+	}
+
+	expect("", "220 ")
+	expect("USER anonymous", "331 ")
+	expect("PASS guest@example.com", "230 ")
+	expect("CWD backup", "250 ")
+	expect("PWD", `257 "/backup"`)
+	line := expect("EPSV", "229 ")
+	var port int
+	fmt.Sscanf(line[strings.Index(line, "|||")+3:], "%d", &port)
+
+	data, err := net.Dial("tcp", fmt.Sprintf("127.0.0.1:%d", port))
+	if err != nil {
+		t.Fatalf("Data connection failed: %v", err)
+	}
+	expect("NLST", "150 ")
+	listing, _ := io.ReadAll(data)
+	data.Close()
+	expect("", "226 ")
+	expect("RETR wp-config.php.bak", "550 ")
+	expect("QUIT", "221 ")
+	<-done
+
+	if string(listing) != "db_2024-03-11.sql.gz\r\nwp-config.php.bak\r\n" {
+		t.Errorf("Unexpected listing: %q", listing)
+	}
+	if len(captures) != 1 || captures[0].Fields["anonymous"] != "true" || captures[0].Password != "guest@example.com" {
+		t.Errorf("Unexpected captures: %+v", captures)
+	}
+}
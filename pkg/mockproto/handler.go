@@ -18,10 +18,74 @@ type MockConfig struct {
 
 	// Tarpit mode - waste attacker's time
 	Tarpit bool
+
+	// Interactive SSH - complete a real handshake and record auth attempts
+	CompleteKex  bool
+	AuthAttempts int  // Max auth attempts per connection (default 3)
+	AuthDelayMs  int  // Delay before answering each auth attempt
+	AcceptLogin  bool // Accept credentials into a fake shell instead of denying (SSH, Telnet)
+
+	// Transcript, if set, records fake shell sessions
+	Transcript *Transcript
+
+	// HTTP honeypot - serve requests from a route table instead of a fixed page
+	HTTPRoutes  []HTTPRoute
+	CanaryPaths []string // Paths ("prefix*" allowed) whose hits are flagged canary
+
+	// SMB - identity revealed by the negotiate response and NTLM challenge
+	SMBDialect   string // "2.0.2", "2.1", "3.0", "3.0.2" or "3.1.1" (default "3.1.1")
+	SMBOSVersion string // Windows version "major.minor.build" (default "10.0.17763")
+	SMBHostname  string // NetBIOS computer name (default "FILESRV01")
+
+	// Script drives the "script" protocol
+	Script *Script
+
+	// Server identity, e.g. cloned from a real backend (see CloneBanner).
+	// The SSH identification line is taken from Payload.
+	SMTPGreeting string // SMTP 220 greeting (default Postfix on Ubuntu)
+	MySQLVersion string // MySQL handshake server version (default "5.7.21-log")
+	HTTPServer   string // HTTP Server header
+
+	// TLS, if set, wraps the mock in a TLS session (HTTPS, SMTPS, ...) and
+	// reports the client's JA3/JA4 fingerprint as a client_info capture
+	TLS *TLSConfig
+
+	// OnCapture receives credentials and client fingerprints seen by the mock.
+	// Captured data is reported only; it is never passed to a backend.
+	OnCapture func(Capture)
+}
+
+// Capture kinds reported via MockConfig.OnCapture
+const (
+	CaptureClientInfo = "client_info" // Client version and handshake fingerprint
+	CapturePassword   = "password"    // Username and password
+	CapturePublicKey  = "publickey"   // Username and offered public key
+	CaptureDownload   = "download"    // URL a fake shell was asked to fetch (never fetched)
+	CaptureLogin      = "login"       // Login where no plaintext password is recoverable (database, hash or challenge in Fields)
+)
+
+// Capture is attacker-supplied data observed by a mock handler.
+type Capture struct {
+	Protocol string
+	Kind     string
+	Username string
+	Password string
+	Fields   map[string]string // Protocol specific (client_version, hassh, key_fingerprint, ...)
+}
+
+// capture reports c to the configured OnCapture callback, if any.
+func (config *MockConfig) capture(c Capture) {
+	if config.OnCapture != nil {
+		config.OnCapture(c)
+	}
 }
 
 // HandleConnection routes the connection to the appropriate mock handler based on protocol.
 func HandleConnection(conn net.Conn, config MockConfig) error {
+	if config.TLS != nil {
+		return serveTLS(conn, config)
+	}
+
 	// Simulate fixed delay if randomDelay is NOT set
 	if !config.RandomDelay && config.DelayMs > 0 {
 		time.Sleep(time.Duration(config.DelayMs) * time.Millisecond)
@@ -44,15 +108,33 @@ func HandleConnection(conn net.Conn, config MockConfig) error {
 	case "mysql":
 		return MockMySQL(conn, config)
 	case "mssql":
-		return MockMSSQL(conn)
+		return MockMSSQL(conn, config)
 	case "rdp":
-		return MockRDP(conn)
+		return MockRDP(conn, config)
 	case "telnet":
 		return MockTelnet(conn, config)
 	case "redis":
 		return MockRedis(conn, config)
 	case "smtp":
 		return MockSMTP(conn, config)
+	case "postgres":
+		return MockPostgres(conn, config)
+	case "mongodb":
+		return MockMongoDB(conn, config)
+	case "elasticsearch":
+		return MockElasticsearch(conn, config)
+	case "memcached":
+		return MockMemcached(conn, config)
+	case "ftp":
+		return MockFTP(conn, config)
+	case "vnc":
+		return MockVNC(conn, config)
+	case "smb":
+		return MockSMB(conn, config)
+	case "ldap":
+		return MockLDAP(conn, config)
+	case "script":
+		return MockScript(conn, config)
 	default:
 		// "raw" or unknown
 		if len(config.Payload) > 0 {
@@ -11,6 +11,10 @@ import (
 // MockHTTP emulates an HTTP server.
 // In tarpit mode, it sends responses extremely slowly.
 func MockHTTP(conn net.Conn, config MockConfig) error {
+	if len(config.HTTPRoutes) > 0 && !config.Tarpit {
+		return mockHTTPRoutes(conn, config)
+	}
+
 	// Drain input
 	conn.SetReadDeadline(time.Now().Add(500 * time.Millisecond))
 	buf := make([]byte, 4096)
@@ -21,7 +25,7 @@ func MockHTTP(conn net.Conn, config MockConfig) error {
 		return httpTarpit(conn, config)
 	}
 
-	return httpNormal(conn, config.StatusCode, config.Payload)
+	return httpNormal(conn, config.StatusCode, config.Payload, config.HTTPServer)
 }
 
 // httpTarpit sends HTTP response extremely slowly (slowloris style)
@@ -38,15 +42,19 @@ func httpTarpit(conn net.Conn, config MockConfig) error {
 		body = generateFakePage()
 	}
 
+	server := config.HTTPServer
+	if server == "" {
+		server = defaultHTTPServer
+	}
 	httpDate := strings.Replace(time.Now().UTC().Format(time.RFC1123), "UTC", "GMT", 1)
 	response := fmt.Sprintf("HTTP/1.1 200 OK\r\n"+
 		"Content-Type: text/html; charset=utf-8\r\n"+
 		"Content-Length: %d\r\n"+
-		"Server: Apache/2.4.41 (Ubuntu)\r\n"+
+		"Server: %s\r\n"+
 		"Date: %s\r\n"+
 		"Connection: keep-alive\r\n"+
 		"X-Powered-By: PHP/7.4.3\r\n"+
-		"\r\n", len(body), httpDate)
+		"\r\n", len(body), server, httpDate)
 
 	// Drip headers
 	if err := DripWrite(conn, []byte(response), interval); err != nil {
@@ -82,7 +90,7 @@ func generateFakePage() []byte {
 	return []byte(sb.String())
 }
 
-func httpNormal(conn net.Conn, statusCode int, payload []byte) error {
+func httpNormal(conn net.Conn, statusCode int, payload []byte, server string) error {
 	statusLine := "HTTP/1.1 200 OK"
 	body := payload
 
@@ -113,12 +121,15 @@ func httpNormal(conn net.Conn, statusCode int, payload []byte) error {
 		}
 	}
 
+	if server == "" {
+		server = "nginx"
+	}
 	httpDate := strings.Replace(time.Now().UTC().Format(time.RFC1123), "UTC", "GMT", 1)
 	headers := fmt.Sprintf("Content-Type: text/html\r\n"+
 		"Content-Length: %d\r\n"+
-		"Server: nginx\r\n"+
+		"Server: %s\r\n"+
 		"Date: %s\r\n"+
-		"Connection: close\r\n", len(body), httpDate)
+		"Connection: close\r\n", len(body), server, httpDate)
 
 	if statusCode == 401 {
 		headers += "WWW-Authenticate: Basic realm=\"Restricted\"\r\n"
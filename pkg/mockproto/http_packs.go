@@ -0,0 +1,222 @@
+package mockproto
+
+// Built-in HTTP honeypot route packs. Login pages post back to themselves
+// and always fail, so credentials are captured on every attempt.
+var httpRoutePacks = map[string][]HTTPRoute{
+	"wordpress":   wordpressRoutes,
+	"phpmyadmin":  phpMyAdminRoutes,
+	"jenkins":     jenkinsRoutes,
+	"admin-panel": adminPanelRoutes,
+}
+
+var wordpressHeaders = map[string]string{
+	"X-Powered-By": "PHP/7.4.3",
+	"Link":         `</wp-json/>; rel="https://api.w.org/"`,
+}
+
+const wordpressLogin = `<!DOCTYPE html>
+<html lang="en-US">
+<head>
+<meta charset="UTF-8">
+<title>Log In &lsaquo; My Blog &#8212; WordPress</title>
+<meta name="robots" content="max-image-preview:large, noindex, noarchive">
+<link rel="stylesheet" href="/wp-admin/css/login.min.css?ver=6.4.3">
+</head>
+<body class="login no-js login-action-login wp-core-ui locale-en-us">
+<div id="login">
+<h1><a href="https://wordpress.org/">Powered by WordPress</a></h1>
+{{if eq .Method "POST"}}<div id="login_error" class="notice notice-error"><strong>Error:</strong> The password you entered is incorrect. <a href="/wp-login.php?action=lostpassword">Lost your password?</a></div>
+{{end}}<form name="loginform" id="loginform" action="/wp-login.php" method="post">
+<p><label for="user_login">Username or Email Address</label>
+<input type="text" name="log" id="user_login" class="input" value="" size="20" autocapitalize="off" autocomplete="username" required="required"></p>
+<div class="user-pass-wrap"><label for="user_pass">Password</label>
+<input type="password" name="pwd" id="user_pass" class="input password-input" value="" size="20" autocomplete="current-password" required="required"></div>
+<p class="forgetmenot"><input name="rememberme" type="checkbox" id="rememberme" value="forever"> <label for="rememberme">Remember Me</label></p>
+<p class="submit"><input type="submit" name="wp-submit" id="wp-submit" class="button button-primary button-large" value="Log In">
+<input type="hidden" name="redirect_to" value="/wp-admin/">
+<input type="hidden" name="testcookie" value="1"></p>
+</form>
+</div>
+</body>
+</html>
+`
+
+var wordpressRoutes = []HTTPRoute{
+	{Path: "/wp-login.php", Headers: map[string]string{
+		"X-Powered-By":  "PHP/7.4.3",
+		"Set-Cookie":    "wordpress_test_cookie=WP%20Cookie%20check; path=/",
+		"Cache-Control": "no-cache, must-revalidate, max-age=0",
+	}, Body: wordpressLogin},
+	{Path: "/wp-admin*", Status: 302, Headers: map[string]string{
+		"X-Powered-By": "PHP/7.4.3",
+		"Location":     "/wp-login.php?redirect_to=%2Fwp-admin%2F&reauth=1",
+	}},
+	{Method: "POST", Path: "/xmlrpc.php", Headers: map[string]string{"Content-Type": "text/xml; charset=UTF-8"}, Body: `<?xml version="1.0" encoding="UTF-8"?>
+<methodResponse>
+  <fault>
+    <value>
+      <struct>
+        <member><name>faultCode</name><value><int>403</int></value></member>
+        <member><name>faultString</name><value><string>Incorrect username or password.</string></value></member>
+      </struct>
+    </value>
+  </fault>
+</methodResponse>
+`},
+	{Path: "/xmlrpc.php", Status: 405, Headers: map[string]string{"Content-Type": "text/plain;charset=UTF-8"}, Body: "XML-RPC server accepts POST requests only."},
+	{Path: "/wp-json/wp/v2/users*", Headers: map[string]string{"Content-Type": "application/json; charset=UTF-8"}, Body: `[{"id":1,"name":"admin","url":"","description":"","link":"/author/admin/","slug":"admin"}]`},
+	{Path: "/", Headers: wordpressHeaders, Body: `<!DOCTYPE html>
+<html lang="en-US">
+<head>
+<meta charset="UTF-8">
+<title>My Blog &#8211; Just another WordPress site</title>
+<meta name="generator" content="WordPress 6.4.3">
+<link rel="stylesheet" href="/wp-includes/css/dist/block-library/style.min.css?ver=6.4.3">
+</head>
+<body class="home blog">
+<header><h1><a href="/">My Blog</a></h1><p>Just another WordPress site</p></header>
+<main><article><h2><a href="/?p=1">Hello world!</a></h2>
+<p>Welcome to WordPress. This is your first post. Edit or delete it, then start writing!</p></article></main>
+<footer><a href="/wp-login.php">Log in</a></footer>
+</body>
+</html>
+`},
+}
+
+const phpMyAdminLogin = `<!DOCTYPE HTML>
+<html lang="en" dir="ltr">
+<head>
+<meta charset="utf-8">
+<meta name="robots" content="noindex,nofollow">
+<title>phpMyAdmin</title>
+<link rel="stylesheet" type="text/css" href="./themes/pmahomme/css/theme.css?v=5.1.1">
+</head>
+<body id="loginform">
+<div class="container">
+<h1>Welcome to <bdo dir="ltr" lang="en">phpMyAdmin</bdo></h1>
+{{if eq .Method "POST"}}<div class="alert alert-danger" role="alert">mysqli::real_connect(): (HY000/1045): Access denied for user</div>
+<div class="alert alert-danger" role="alert">Cannot log in to the MySQL server</div>
+{{end}}<form method="post" id="login_form" action="index.php" name="login_form" class="disableAjax hide js-show">
+<fieldset class="pma-fieldset">
+<legend>Log in</legend>
+<div class="item"><label for="input_username">Username:</label>
+<input type="text" name="pma_username" id="input_username" value="" size="24" class="textfield" autocomplete="username"></div>
+<div class="item"><label for="input_password">Password:</label>
+<input type="password" name="pma_password" id="input_password" value="" size="24" class="textfield" autocomplete="current-password"></div>
+<input type="hidden" name="server" value="1">
+</fieldset>
+<fieldset class="pma-fieldset tblFooters">
+<input class="btn btn-primary" value="Go" type="submit" id="input_go">
+<input type="hidden" name="route" value="/">
+<input type="hidden" name="lang" value="en">
+</fieldset>
+</form>
+</div>
+</body>
+</html>
+`
+
+var phpMyAdminHeaders = map[string]string{
+	"X-Powered-By":           "PHP/7.4.3",
+	"Set-Cookie":             "phpMyAdmin=8c1d5a6f0e2b4c3d9a7e1f0b2c4d6e8f; path=/phpmyadmin/; HttpOnly",
+	"X-Frame-Options":        "DENY",
+	"X-Robots-Tag":           "noindex, nofollow",
+	"X-Content-Type-Options": "nosniff",
+}
+
+var phpMyAdminRoutes = []HTTPRoute{
+	{Path: "/phpmyadmin*", Headers: phpMyAdminHeaders, Body: phpMyAdminLogin},
+	{Path: "/phpMyAdmin*", Headers: phpMyAdminHeaders, Body: phpMyAdminLogin},
+	{Path: "/pma*", Headers: phpMyAdminHeaders, Body: phpMyAdminLogin},
+}
+
+var jenkinsHeaders = map[string]string{
+	"Server":     "Jetty(10.0.18)",
+	"X-Jenkins":  "2.440.1",
+	"X-Hudson":   "1.395",
+	"Set-Cookie": "JSESSIONID.7f3a2b1c=node01q8w7e6r5t4y3u2i1o0p9a8s7d.node0; Path=/; HttpOnly",
+}
+
+const jenkinsLogin = `<!DOCTYPE html>
+<html lang="en-US">
+<head>
+<meta charset="utf-8">
+<title>Sign in [Jenkins]</title>
+<link rel="stylesheet" href="/static/7f3a2b1c/jsbundles/simple-page.css" type="text/css">
+</head>
+<body>
+<div class="simple-page" role="main">
+<div class="app-sign-in-register">
+<h1>Sign in to Jenkins</h1>
+{{if eq .Path "/loginError"}}<div class="app-sign-in-register__error">Invalid username or password</div>
+{{end}}<form method="post" name="login" action="j_spring_security_check">
+<div class="jenkins-form-item"><label class="jenkins-form-label" for="j_username">Username</label>
+<input autocorrect="off" autocomplete="off" name="j_username" id="j_username" type="text" class="jenkins-input" autocapitalize="off"></div>
+<div class="jenkins-form-item"><label class="jenkins-form-label" for="j_password">Password</label>
+<input name="j_password" id="j_password" type="password" class="jenkins-input"></div>
+<input name="from" type="hidden" value="/">
+<button type="submit" name="Submit" class="jenkins-button jenkins-button--primary">Sign in</button>
+</form>
+</div>
+</div>
+</body>
+</html>
+`
+
+var jenkinsRoutes = []HTTPRoute{
+	{Path: "/login", Headers: jenkinsHeaders, Body: jenkinsLogin},
+	{Path: "/loginError", Status: 401, Headers: jenkinsHeaders, Body: jenkinsLogin},
+	{Path: "/j_spring_security_check", Status: 302, Headers: map[string]string{
+		"Server":    "Jetty(10.0.18)",
+		"X-Jenkins": "2.440.1",
+		"Location":  "/loginError",
+	}},
+	{Path: "/j_acegi_security_check", Status: 302, Headers: map[string]string{
+		"Server":    "Jetty(10.0.18)",
+		"X-Jenkins": "2.440.1",
+		"Location":  "/loginError",
+	}},
+	{Path: "*", Status: 403, Headers: map[string]string{
+		"Server":    "Jetty(10.0.18)",
+		"X-Jenkins": "2.440.1",
+		"X-Hudson":  "1.395",
+	}, Body: `<html><head><meta http-equiv='refresh' content='1;url=/login?from=%2F'/><script>window.location.replace('/login?from=%2F');</script></head><body style='background-color:white; color:white;'>
+
+Authentication required
+</body></html>
+`},
+}
+
+const adminPanelLogin = `<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<title>Admin Panel - Sign In</title>
+<link rel="stylesheet" href="/assets/css/admin.min.css">
+</head>
+<body class="login-page">
+<div class="login-box">
+<div class="login-logo"><b>Admin</b>Panel</div>
+<div class="card"><div class="card-body login-card-body">
+<p class="login-box-msg">Sign in to start your session</p>
+{{if eq .Method "POST"}}<div class="alert alert-danger">Invalid username or password.</div>
+{{end}}<form action="{{.Path}}" method="post">
+<div class="input-group mb-3"><input type="text" name="username" class="form-control" placeholder="Username"></div>
+<div class="input-group mb-3"><input type="password" name="password" class="form-control" placeholder="Password"></div>
+<button type="submit" class="btn btn-primary btn-block">Sign In</button>
+</form>
+</div></div>
+</div>
+</body>
+</html>
+`
+
+var adminPanelRoutes = []HTTPRoute{
+	{Method: "POST", Path: "/admin*", Status: 401, Body: adminPanelLogin},
+	{Method: "POST", Path: "/login*", Status: 401, Body: adminPanelLogin},
+	{Path: "/admin*", Body: adminPanelLogin},
+	{Path: "/login*", Body: adminPanelLogin},
+	{Path: "/administrator*", Body: adminPanelLogin},
+	{Path: "/", Status: 302, Headers: map[string]string{"Location": "/admin/login"}},
+}
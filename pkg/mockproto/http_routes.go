@@ -0,0 +1,374 @@
+package mockproto
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"html/template"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// maxHTTPCaptureBody caps how much of each request body is captured.
+	maxHTTPCaptureBody = 8 * 1024
+	// maxHTTPDrainBody caps how much of a request body is read and discarded.
+	maxHTTPDrainBody = 1024 * 1024
+	// maxHTTPRequestsPerConn bounds keep-alive requests on one connection.
+	maxHTTPRequestsPerConn = 20
+	// httpRouteIdleTimeout closes keep-alive connections with no new request.
+	httpRouteIdleTimeout = 15 * time.Second
+
+	defaultHTTPServer = "Apache/2.4.41 (Ubuntu)"
+)
+
+// CaptureHTTPRequest is the capture kind for requests seen by a routed HTTP mock.
+const CaptureHTTPRequest = "http_request"
+
+// HTTPRoute maps a request to a canned response for the HTTP honeypot.
+type HTTPRoute struct {
+	Method   string            `yaml:"method,omitempty"`   // "" matches any method
+	Path     string            `yaml:"path"`               // Exact path, or prefix ending in "*"
+	Status   int               `yaml:"status,omitempty"`   // Default 200
+	Headers  map[string]string `yaml:"headers,omitempty"`  // Extra or overriding response headers
+	Body     string            `yaml:"body,omitempty"`     // html/template; see httpTemplateData
+	BodyFile string            `yaml:"bodyFile,omitempty"` // Loaded into Body by LoadHTTPRoutes
+	DelayMs  int               `yaml:"delayMs,omitempty"`  // Delay before responding
+	Canary   bool              `yaml:"canary,omitempty"`   // Hits are flagged as canary captures
+}
+
+// httpRouteFile is the on-disk format read by LoadHTTPRoutes.
+type httpRouteFile struct {
+	Routes []HTTPRoute `yaml:"routes"`
+}
+
+// httpTemplateData is available to route body templates.
+type httpTemplateData struct {
+	Method string
+	Path   string
+	Query  string
+	Host   string
+}
+
+// LoadHTTPRoutes reads a YAML route table. Relative BodyFile paths are
+// resolved against the directory of the route file.
+func LoadHTTPRoutes(path string) ([]HTTPRoute, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read routes file: %w", err)
+	}
+
+	var file httpRouteFile
+	if err := yaml.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("failed to parse routes file: %w", err)
+	}
+
+	dir := filepath.Dir(path)
+	for i := range file.Routes {
+		r := &file.Routes[i]
+		if r.Path == "" {
+			return nil, fmt.Errorf("route %d: path is required", i)
+		}
+		if r.BodyFile == "" {
+			continue
+		}
+		bodyPath := r.BodyFile
+		if !filepath.IsAbs(bodyPath) {
+			bodyPath = filepath.Join(dir, bodyPath)
+		}
+		body, err := os.ReadFile(bodyPath)
+		if err != nil {
+			return nil, fmt.Errorf("route %s: %w", r.Path, err)
+		}
+		r.Body = string(body)
+	}
+	return file.Routes, nil
+}
+
+// HTTPRoutePack returns the routes of a built-in pack, or nil if unknown.
+func HTTPRoutePack(name string) []HTTPRoute {
+	return httpRoutePacks[name]
+}
+
+// HTTPRoutePackNames returns the names of the built-in route packs.
+func HTTPRoutePackNames() []string {
+	names := make([]string, 0, len(httpRoutePacks))
+	for name := range httpRoutePacks {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// matchPath reports whether path matches an exact pattern or a "prefix*" pattern.
+func matchPath(pattern, path string) bool {
+	if prefix, ok := strings.CutSuffix(pattern, "*"); ok {
+		return strings.HasPrefix(path, prefix)
+	}
+	return pattern == path
+}
+
+// matchHTTPRoute returns the first route matching the request, or nil.
+func matchHTTPRoute(routes []HTTPRoute, method, path string) *HTTPRoute {
+	for i := range routes {
+		r := &routes[i]
+		if r.Method != "" && !strings.EqualFold(r.Method, method) {
+			continue
+		}
+		if matchPath(r.Path, path) {
+			return r
+		}
+	}
+	return nil
+}
+
+// mockHTTPRoutes serves requests from config.HTTPRoutes on a keep-alive
+// connection, capturing every request and any submitted credentials.
+func mockHTTPRoutes(conn net.Conn, config MockConfig) error {
+	br := bufio.NewReader(conn)
+	for i := 0; i < maxHTTPRequestsPerConn; i++ {
+		conn.SetReadDeadline(time.Now().Add(httpRouteIdleTimeout))
+		req, err := http.ReadRequest(br)
+		if err != nil {
+			// Closed, timed out or not HTTP
+			return nil
+		}
+		body, _ := io.ReadAll(io.LimitReader(req.Body, maxHTTPCaptureBody+1))
+		io.Copy(io.Discard, io.LimitReader(req.Body, maxHTTPDrainBody))
+		req.Body.Close()
+		conn.SetReadDeadline(time.Time{})
+
+		route := matchHTTPRoute(config.HTTPRoutes, req.Method, req.URL.Path)
+		captureHTTPRequest(&config, "http", req, body, route)
+
+		if route != nil && route.DelayMs > 0 {
+			time.Sleep(time.Duration(route.DelayMs) * time.Millisecond)
+		}
+
+		keepAlive := !req.Close && i < maxHTTPRequestsPerConn-1
+		if err := writeHTTPRouteResponse(conn, req, route, config.HTTPServer, keepAlive); err != nil || !keepAlive {
+			return nil
+		}
+	}
+	return nil
+}
+
+// captureHTTPRequest reports the request and any form or Basic credentials.
+func captureHTTPRequest(config *MockConfig, protocol string, req *http.Request, body []byte, route *HTTPRoute) {
+	fields := map[string]string{
+		"method":     req.Method,
+		"path":       req.URL.RequestURI(),
+		"host":       req.Host,
+		"user_agent": req.UserAgent(),
+		"headers":    formatHTTPHeaders(req.Header),
+	}
+	if len(body) > maxHTTPCaptureBody {
+		body = body[:maxHTTPCaptureBody]
+		fields["body_truncated"] = "true"
+	}
+	if len(body) > 0 {
+		fields["body"] = string(body)
+	}
+	if route != nil {
+		fields["route"] = route.Path
+	}
+	if (route != nil && route.Canary) || isCanaryPath(config.CanaryPaths, req.URL.Path) {
+		fields["canary"] = "true"
+	}
+	config.capture(Capture{Protocol: protocol, Kind: CaptureHTTPRequest, Fields: fields})
+
+	if user, pass, ok := req.BasicAuth(); ok {
+		config.capture(Capture{
+			Protocol: protocol,
+			Kind:     CapturePassword,
+			Username: user,
+			Password: pass,
+			Fields:   map[string]string{"method": "basic", "path": req.URL.Path},
+		})
+	}
+
+	form := req.URL.Query()
+	if strings.HasPrefix(req.Header.Get("Content-Type"), "application/x-www-form-urlencoded") {
+		if values, err := url.ParseQuery(string(body)); err == nil {
+			form = values
+		}
+	}
+	if user, pass, ok := formCredentials(form); ok {
+		config.capture(Capture{
+			Protocol: protocol,
+			Kind:     CapturePassword,
+			Username: user,
+			Password: pass,
+			Fields:   map[string]string{"method": "form", "path": req.URL.Path},
+		})
+	}
+}
+
+// Form field names used by common login pages.
+var (
+	httpUserFields = []string{"log", "username", "user", "login", "email", "uname", "pma_username", "j_username"}
+	httpPassFields = []string{"pwd", "password", "pass", "passwd", "pma_password", "j_password"}
+)
+
+// formCredentials extracts a password (and username, if any) from form values.
+func formCredentials(form url.Values) (user, pass string, ok bool) {
+	for _, k := range httpPassFields {
+		if v := form.Get(k); v != "" {
+			pass, ok = v, true
+			break
+		}
+	}
+	if !ok {
+		return "", "", false
+	}
+	for _, k := range httpUserFields {
+		if v := form.Get(k); v != "" {
+			user = v
+			break
+		}
+	}
+	return user, pass, true
+}
+
+func isCanaryPath(patterns []string, path string) bool {
+	for _, p := range patterns {
+		if matchPath(p, path) {
+			return true
+		}
+	}
+	return false
+}
+
+// formatHTTPHeaders renders headers as sorted "Name: value" lines.
+func formatHTTPHeaders(h http.Header) string {
+	names := make([]string, 0, len(h))
+	for name := range h {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var sb strings.Builder
+	for _, name := range names {
+		for _, v := range h[name] {
+			fmt.Fprintf(&sb, "%s: %s\n", name, v)
+		}
+	}
+	return sb.String()
+}
+
+// writeHTTPRouteResponse writes the route's response, or a 404 page when
+// no route matched.
+func writeHTTPRouteResponse(conn net.Conn, req *http.Request, route *HTTPRoute, server string, keepAlive bool) error {
+	status := http.StatusNotFound
+	body := []byte("<html>\n<head><title>404 Not Found</title></head>\n<body>\n<h1>Not Found</h1>\n<p>The requested URL was not found on this server.</p>\n</body>\n</html>\n")
+	headers := map[string]string{}
+
+	if route != nil {
+		status = route.Status
+		if status == 0 {
+			status = http.StatusOK
+		}
+		body = renderHTTPBody(route.Body, req)
+		for k, v := range route.Headers {
+			headers[http.CanonicalHeaderKey(k)] = v
+		}
+	}
+
+	if _, ok := headers["Server"]; !ok {
+		if server == "" {
+			server = defaultHTTPServer
+		}
+		headers["Server"] = server
+	}
+	if _, ok := headers["Content-Type"]; !ok {
+		headers["Content-Type"] = "text/html; charset=UTF-8"
+	}
+	headers["Date"] = strings.Replace(time.Now().UTC().Format(time.RFC1123), "UTC", "GMT", 1)
+	headers["Content-Length"] = fmt.Sprint(len(body))
+	if keepAlive {
+		headers["Connection"] = "keep-alive"
+	} else {
+		headers["Connection"] = "close"
+	}
+
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "HTTP/1.1 %d %s\r\n", status, http.StatusText(status))
+	for _, name := range names {
+		fmt.Fprintf(&buf, "%s: %s\r\n", name, headers[name])
+	}
+	buf.WriteString("\r\n")
+	if req.Method != http.MethodHead {
+		buf.Write(body)
+	}
+
+	_, err := conn.Write(buf.Bytes())
+	return err
+}
+
+// httpBodyTemplates holds parsed route body templates by their text, so
+// each body is parsed once; nil marks a body that does not parse.
+var httpBodyTemplates = struct {
+	sync.Mutex
+	entries map[string]*template.Template
+}{entries: make(map[string]*template.Template)}
+
+// maxHTTPBodyTemplates bounds httpBodyTemplates; it is reset when full.
+const maxHTTPBodyTemplates = 256
+
+// httpBodyTemplate returns the parsed template for body, or nil.
+func httpBodyTemplate(body string) *template.Template {
+	httpBodyTemplates.Lock()
+	defer httpBodyTemplates.Unlock()
+
+	if tmpl, ok := httpBodyTemplates.entries[body]; ok {
+		return tmpl
+	}
+	tmpl, err := template.New("body").Parse(body)
+	if err != nil {
+		tmpl = nil
+	}
+	if len(httpBodyTemplates.entries) >= maxHTTPBodyTemplates {
+		clear(httpBodyTemplates.entries)
+	}
+	httpBodyTemplates.entries[body] = tmpl
+	return tmpl
+}
+
+// renderHTTPBody executes body as an html/template, so reflected request
+// values are escaped. Bodies that fail to parse are sent verbatim.
+func renderHTTPBody(body string, req *http.Request) []byte {
+	if !strings.Contains(body, "{{") {
+		return []byte(body)
+	}
+	tmpl := httpBodyTemplate(body)
+	if tmpl == nil {
+		return []byte(body)
+	}
+	var buf bytes.Buffer
+	err := tmpl.Execute(&buf, httpTemplateData{
+		Method: req.Method,
+		Path:   req.URL.Path,
+		Query:  req.URL.RawQuery,
+		Host:   req.Host,
+	})
+	if err != nil {
+		return []byte(body)
+	}
+	return buf.Bytes()
+}
@@ -1,6 +1,9 @@
 package mockproto
 
 import (
+	"os"
+	"path/filepath"
+	"strconv"
 	"strings"
 	"testing"
 )
@@ -162,3 +165,126 @@ func TestMockHTTP_ConnectionClose(t *testing.T) {
 		t.Errorf("Expected Connection: close header")
 	}
 }
+
+func TestMockHTTP_RoutesWordPressLogin(t *testing.T) {
+	body := "log=admin&pwd=hunter2&wp-submit=Log+In"
+	conn := newMockConn([]byte("GET /wp-login.php HTTP/1.1\r\nHost: blog\r\nUser-Agent: scanner/1.0\r\n\r\n" +
+		"POST /wp-login.php HTTP/1.1\r\nHost: blog\r\nContent-Type: application/x-www-form-urlencoded\r\n" +
+		"Content-Length: " + strconv.Itoa(len(body)) + "\r\n\r\n" + body))
+
+	var captures []Capture
+	err := MockHTTP(conn, MockConfig{
+		HTTPRoutes: HTTPRoutePack("wordpress"),
+		OnCapture:  func(c Capture) { captures = append(captures, c) },
+	})
+	if err != nil {
+		t.Fatalf("MockHTTP failed: %v", err)
+	}
+
+	response := string(conn.writeData)
+	if strings.Count(response, "HTTP/1.1 200 OK") != 2 {
+		t.Errorf("Expected two 200 responses on keep-alive, got: %s", response)
+	}
+	if !strings.Contains(response, `name="pwd"`) || !strings.Contains(response, "The password you entered is incorrect") {
+		t.Error("Expected WordPress login form with error after POST")
+	}
+
+	var requests, passwords int
+	for _, c := range captures {
+		switch c.Kind {
+		case CaptureHTTPRequest:
+			requests++
+			if c.Fields["route"] != "/wp-login.php" {
+				t.Errorf("Expected route /wp-login.php, got %q", c.Fields["route"])
+			}
+		case CapturePassword:
+			passwords++
+			if c.Username != "admin" || c.Password != "hunter2" || c.Fields["method"] != "form" {
+				t.Errorf("Unexpected credential capture: %+v", c)
+			}
+		}
+	}
+	if requests != 2 || passwords != 1 {
+		t.Errorf("Expected 2 requests and 1 credential, got %d and %d", requests, passwords)
+	}
+	if captures[0].Fields["user_agent"] != "scanner/1.0" || !strings.Contains(captures[0].Fields["headers"], "User-Agent: scanner/1.0") {
+		t.Errorf("Expected request headers captured, got %v", captures[0].Fields)
+	}
+}
+
+func TestMockHTTP_RoutesCanaryAndBasicAuth(t *testing.T) {
+	conn := newMockConn([]byte("GET /.env HTTP/1.1\r\nHost: x\r\nAuthorization: Basic YWRtaW46c2VjcmV0\r\nConnection: close\r\n\r\n"))
+
+	var captures []Capture
+	MockHTTP(conn, MockConfig{
+		HTTPRoutes:  []HTTPRoute{{Path: "/admin", Body: "admin"}},
+		CanaryPaths: []string{"/.env", "/.git/*"},
+		OnCapture:   func(c Capture) { captures = append(captures, c) },
+	})
+
+	response := string(conn.writeData)
+	if !strings.HasPrefix(response, "HTTP/1.1 404 Not Found") || !strings.Contains(response, "Connection: close") {
+		t.Errorf("Expected 404 with Connection: close, got: %s", response)
+	}
+	if len(captures) != 2 {
+		t.Fatalf("Expected request and basic auth captures, got %d", len(captures))
+	}
+	if captures[0].Fields["canary"] != "true" {
+		t.Error("Expected /.env to be flagged canary")
+	}
+	if captures[1].Username != "admin" || captures[1].Password != "secret" || captures[1].Fields["method"] != "basic" {
+		t.Errorf("Unexpected basic auth capture: %+v", captures[1])
+	}
+}
+
+func TestMockHTTP_RouteTemplateEscapes(t *testing.T) {
+	conn := newMockConn([]byte("GET /admin/<script> HTTP/1.1\r\nHost: x\r\n\r\n"))
+	MockHTTP(conn, MockConfig{HTTPRoutes: HTTPRoutePack("admin-panel")})
+
+	response := string(conn.writeData)
+	if strings.Contains(response, "<script>") {
+		t.Error("Expected reflected path to be escaped")
+	}
+	if !strings.Contains(response, `name="password"`) {
+		t.Error("Expected admin login form")
+	}
+
+	body := "<p>{{.Path}}</p>"
+	if tmpl := httpBodyTemplate(body); tmpl == nil || httpBodyTemplate(body) != tmpl {
+		t.Error("Expected the parsed body template to be reused")
+	}
+}
+
+func TestLoadHTTPRoutes(t *testing.T) {
+	dir := t.TempDir()
+	os.WriteFile(filepath.Join(dir, "login.html"), []byte("<h1>{{.Host}}</h1>"), 0644)
+	os.WriteFile(filepath.Join(dir, "routes.yaml"), []byte(`routes:
+  - path: /login
+    method: GET
+    bodyFile: login.html
+    headers:
+      Server: nginx/1.18.0
+  - path: /backup/*
+    status: 403
+    canary: true
+`), 0644)
+
+	routes, err := LoadHTTPRoutes(filepath.Join(dir, "routes.yaml"))
+	if err != nil {
+		t.Fatalf("LoadHTTPRoutes failed: %v", err)
+	}
+	if len(routes) != 2 || routes[0].Body != "<h1>{{.Host}}</h1>" || !routes[1].Canary {
+		t.Fatalf("Unexpected routes: %+v", routes)
+	}
+
+	if r := matchHTTPRoute(routes, "GET", "/backup/db.sql"); r == nil || r.Status != 403 {
+		t.Errorf("Expected prefix match on /backup/*, got %+v", r)
+	}
+	if r := matchHTTPRoute(routes, "POST", "/login"); r != nil {
+		t.Errorf("Expected method mismatch, got %+v", r)
+	}
+
+	if _, err := LoadHTTPRoutes(filepath.Join(dir, "missing.yaml")); err == nil {
+		t.Error("Expected error for missing file")
+	}
+}
@@ -0,0 +1,269 @@
+package mockproto
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"net"
+	"strconv"
+	"time"
+)
+
+// LDAP protocol operation tags
+const (
+	ldapBindRequest      = 0x60
+	ldapBindResponse     = 0x61
+	ldapUnbindRequest    = 0x42
+	ldapSearchRequest    = 0x63
+	ldapSearchResEntry   = 0x64
+	ldapSearchResDone    = 0x65
+	ldapAbandonRequest   = 0x50
+	ldapExtendedRequest  = 0x77
+	ldapExtendedResponse = 0x78
+
+	ldapAuthSimple = 0x80
+	ldapAuthSASL   = 0xa3
+
+	ldapSuccess            = 0
+	ldapOperationsError    = 1
+	ldapProtocolError      = 2
+	ldapInvalidCredentials = 49
+	ldapUnavailable        = 52
+
+	// maxLDAPMessageSize caps a client message; binds and searches are small.
+	maxLDAPMessageSize = 64 * 1024
+	// maxLDAPMessages bounds the requests served on one connection.
+	maxLDAPMessages = 100
+
+	ldapDomain = "DC=corp,DC=local"
+	ldapHost   = "dc01.corp.local"
+)
+
+// Active Directory error messages, as returned by a Windows Server 2019 DC
+const (
+	ldapInvalidCredentialsMsg = "80090308: LdapErr: DSID-0C09041C, comment: AcceptSecurityContext error, data 52e, v4563\x00"
+	ldapBindRequiredMsg       = "000004DC: LdapErr: DSID-0C090A5C, comment: In order to perform this operation a successful bind must be completed on the connection., data 0, v4563\x00"
+)
+
+var errBER = errors.New("malformed ber")
+
+// MockLDAP emulates an Active Directory domain controller. Anonymous binds
+// succeed and may read the root DSE; simple binds are captured (DN and
+// password) and SASL binds are captured by mechanism, then fail with
+// invalidCredentials. Other operations require a bind. In tarpit mode every
+// reply is delayed, increasingly.
+func MockLDAP(conn net.Conn, config MockConfig) error {
+	var t *Tarpit
+	if config.Tarpit {
+		t = NewTarpit(1000, 1000, 15000)
+	}
+
+	br := bufio.NewReader(conn)
+	for i := 0; i < maxLDAPMessages; i++ {
+		conn.SetReadDeadline(time.Now().Add(60 * time.Second))
+		tag, msg, err := readBER(br)
+		if err != nil || tag != 0x30 {
+			return nil
+		}
+		_, id, rest, err := parseBER(msg)
+		if err != nil {
+			return nil
+		}
+		op, body, _, err := parseBER(rest)
+		if err != nil {
+			return nil
+		}
+
+		var reply []byte
+		switch op {
+		case ldapUnbindRequest:
+			return nil
+		case ldapAbandonRequest:
+			continue
+		case ldapBindRequest:
+			code, diag := ldapBind(&config, body)
+			reply = ldapMessage(id, derTLV(ldapBindResponse, ldapResult(code, diag)))
+		case ldapSearchRequest:
+			_, base, scope, _ := parseBER(body)
+			_, scopeVal, _, _ := parseBER(scope)
+			if len(base) == 0 && len(scopeVal) == 1 && scopeVal[0] == 0 {
+				reply = append(ldapMessage(id, ldapRootDSE()), ldapMessage(id, derTLV(ldapSearchResDone, ldapResult(ldapSuccess, "")))...)
+			} else {
+				reply = ldapMessage(id, derTLV(ldapSearchResDone, ldapResult(ldapOperationsError, ldapBindRequiredMsg)))
+			}
+		case ldapExtendedRequest:
+			reply = ldapMessage(id, derTLV(ldapExtendedResponse, ldapResult(ldapUnavailable, "00000000: LdapErr: DSID-0C09128E, comment: Error initializing SSL/TLS, data 0, v4563\x00")))
+		default:
+			// Responses are constructed and tagged one above their request
+			reply = ldapMessage(id, derTLV((op|0x20)+1, ldapResult(ldapOperationsError, ldapBindRequiredMsg)))
+		}
+
+		if t != nil {
+			t.Sleep()
+		} else if config.RandomDelay {
+			RandomDelay(100, 1000)
+		}
+		if _, err := conn.Write(reply); err != nil {
+			return nil
+		}
+	}
+	return nil
+}
+
+// ldapBind captures a BindRequest and returns its result code and message.
+func ldapBind(config *MockConfig, body []byte) (int, string) {
+	_, version, rest, err := parseBER(body)
+	if err != nil {
+		return ldapProtocolError, ""
+	}
+	_, name, rest, err := parseBER(rest)
+	if err != nil {
+		return ldapProtocolError, ""
+	}
+	authTag, auth, _, err := parseBER(rest)
+	if err != nil {
+		return ldapProtocolError, ""
+	}
+	fields := map[string]string{"version": strconv.Itoa(int(berInt(version)))}
+
+	switch authTag {
+	case ldapAuthSimple:
+		if len(name) == 0 && len(auth) == 0 {
+			// Anonymous bind
+			return ldapSuccess, ""
+		}
+		config.capture(Capture{Protocol: "ldap", Kind: CapturePassword, Username: string(name), Password: string(auth), Fields: fields})
+	case ldapAuthSASL:
+		_, mechanism, _, _ := parseBER(auth)
+		fields["mechanism"] = string(mechanism)
+		config.capture(Capture{Protocol: "ldap", Kind: CaptureLogin, Username: string(name), Fields: fields})
+	default:
+		return ldapProtocolError, ""
+	}
+
+	if config.RandomDelay {
+		RandomDelay(500, 2000)
+	}
+	return ldapInvalidCredentials, ldapInvalidCredentialsMsg
+}
+
+// ldapRootDSE builds the SearchResultEntry for the root DSE.
+func ldapRootDSE() []byte {
+	now := time.Now().UTC().Format("20060102150405.0Z")
+	var attrs []byte
+	for _, a := range []struct {
+		name   string
+		values []string
+	}{
+		{"currentTime", []string{now}},
+		{"subschemaSubentry", []string{"CN=Aggregate,CN=Schema,CN=Configuration," + ldapDomain}},
+		{"dsServiceName", []string{"CN=NTDS Settings,CN=DC01,CN=Servers,CN=Default-First-Site-Name,CN=Sites,CN=Configuration," + ldapDomain}},
+		{"namingContexts", []string{ldapDomain, "CN=Configuration," + ldapDomain, "CN=Schema,CN=Configuration," + ldapDomain}},
+		{"defaultNamingContext", []string{ldapDomain}},
+		{"rootDomainNamingContext", []string{ldapDomain}},
+		{"configurationNamingContext", []string{"CN=Configuration," + ldapDomain}},
+		{"schemaNamingContext", []string{"CN=Schema,CN=Configuration," + ldapDomain}},
+		{"supportedLDAPVersion", []string{"3", "2"}},
+		{"supportedSASLMechanisms", []string{"GSSAPI", "GSS-SPNEGO", "EXTERNAL", "DIGEST-MD5"}},
+		{"dnsHostName", []string{ldapHost}},
+		{"ldapServiceName", []string{"corp.local:dc01$@CORP.LOCAL"}},
+		{"serverName", []string{"CN=DC01,CN=Servers,CN=Default-First-Site-Name,CN=Sites,CN=Configuration," + ldapDomain}},
+		{"isSynchronized", []string{"TRUE"}},
+		{"isGlobalCatalogReady", []string{"TRUE"}},
+		{"domainFunctionality", []string{"7"}},
+		{"forestFunctionality", []string{"7"}},
+		{"domainControllerFunctionality", []string{"7"}},
+	} {
+		var vals []byte
+		for _, v := range a.values {
+			vals = append(vals, derTLV(0x04, []byte(v))...)
+		}
+		attrs = append(attrs, derTLV(0x30, append(derTLV(0x04, []byte(a.name)), derTLV(0x31, vals)...))...)
+	}
+	return derTLV(ldapSearchResEntry, append(derTLV(0x04, nil), derTLV(0x30, attrs)...))
+}
+
+// ldapResult encodes the LDAPResult fields of a response.
+func ldapResult(code int, diagnostic string) []byte {
+	var b []byte
+	b = append(b, derTLV(0x0a, []byte{byte(code)})...)
+	b = append(b, derTLV(0x04, nil)...)
+	return append(b, derTLV(0x04, []byte(diagnostic))...)
+}
+
+// ldapMessage wraps a protocol op in an LDAPMessage with the request's ID.
+func ldapMessage(id, op []byte) []byte {
+	return derTLV(0x30, append(derTLV(0x02, id), op...))
+}
+
+// readBER reads one BER element from r, returning its tag and content.
+func readBER(r *bufio.Reader) (byte, []byte, error) {
+	tag, err := r.ReadByte()
+	if err != nil {
+		return 0, nil, err
+	}
+	first, err := r.ReadByte()
+	if err != nil {
+		return 0, nil, err
+	}
+	n := int(first)
+	if first&0x80 != 0 {
+		k := int(first & 0x7f)
+		if k == 0 || k > 3 {
+			return 0, nil, errBER
+		}
+		n = 0
+		for i := 0; i < k; i++ {
+			b, err := r.ReadByte()
+			if err != nil {
+				return 0, nil, err
+			}
+			n = n<<8 | int(b)
+		}
+	}
+	if n > maxLDAPMessageSize {
+		return 0, nil, errBER
+	}
+	content := make([]byte, n)
+	if _, err := io.ReadFull(r, content); err != nil {
+		return 0, nil, err
+	}
+	return tag, content, nil
+}
+
+// parseBER splits the first BER element off data.
+func parseBER(data []byte) (tag byte, content, rest []byte, err error) {
+	if len(data) < 2 {
+		return 0, nil, nil, errBER
+	}
+	tag = data[0]
+	n := int(data[1])
+	i := 2
+	if data[1]&0x80 != 0 {
+		k := int(data[1] & 0x7f)
+		if k == 0 || k > 3 || len(data) < 2+k {
+			return 0, nil, nil, errBER
+		}
+		n = 0
+		for _, b := range data[2 : 2+k] {
+			n = n<<8 | int(b)
+		}
+		i += k
+	}
+	if len(data) < i+n {
+		return 0, nil, nil, errBER
+	}
+	return tag, data[i : i+n], data[i+n:], nil
+}
+
+// berInt decodes a BER INTEGER's content.
+func berInt(b []byte) int64 {
+	var v int64
+	for i, c := range b {
+		if i == 0 && c&0x80 != 0 {
+			v = -1
+		}
+		v = v<<8 | int64(c)
+	}
+	return v
+}
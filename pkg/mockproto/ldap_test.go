@@ -0,0 +1,86 @@
+package mockproto
+
+import (
+	"bufio"
+	"bytes"
+	"testing"
+)
+
+func ldapBindRequestMsg(id byte, dn, password string) []byte {
+	bind := append(derTLV(0x02, []byte{3}), derTLV(0x04, []byte(dn))...)
+	bind = append(bind, derTLV(ldapAuthSimple, []byte(password))...)
+	return ldapMessage([]byte{id}, derTLV(ldapBindRequest, bind))
+}
+
+func ldapSearchRequestMsg(id byte, base string, scope byte) []byte {
+	search := derTLV(0x04, []byte(base))
+	search = append(search, derTLV(0x0a, []byte{scope})...)
+	search = append(search, derTLV(0x0a, []byte{0})...)
+	search = append(search, derTLV(0x02, []byte{0})...)
+	search = append(search, derTLV(0x02, []byte{0})...)
+	search = append(search, derTLV(0x01, []byte{0})...)
+	search = append(search, derTLV(0x87, []byte("objectClass"))...)
+	search = append(search, derTLV(0x30, nil)...)
+	return ldapMessage([]byte{id}, derTLV(ldapSearchRequest, search))
+}
+
+// readLDAPResponses returns the protocol op tag and content of each response.
+func readLDAPResponses(t *testing.T, data []byte) (tags []byte, ops [][]byte) {
+	t.Helper()
+	r := bufio.NewReader(bytes.NewReader(data))
+	for {
+		_, msg, err := readBER(r)
+		if err != nil {
+			return tags, ops
+		}
+		_, _, rest, _ := parseBER(msg)
+		tag, op, _, err := parseBER(rest)
+		if err != nil {
+			t.Fatalf("Malformed response: %v", err)
+		}
+		tags = append(tags, tag)
+		ops = append(ops, op)
+	}
+}
+
+func TestMockLDAP_BindAndRootDSE(t *testing.T) {
+	var input []byte
+	input = append(input, ldapBindRequestMsg(1, "", "")...)
+	input = append(input, ldapSearchRequestMsg(2, "", 0)...)
+	input = append(input, ldapSearchRequestMsg(3, ldapDomain, 2)...)
+	input = append(input, ldapBindRequestMsg(4, "CN=Administrator,CN=Users,DC=corp,DC=local", "P@ssw0rd")...)
+	input = append(input, ldapMessage([]byte{5}, derTLV(ldapUnbindRequest, nil))...)
+	conn := newMockConn(input)
+
+	var captures []Capture
+	err := MockLDAP(conn, MockConfig{OnCapture: func(c Capture) { captures = append(captures, c) }})
+	if err != nil {
+		t.Fatalf("MockLDAP failed: %v", err)
+	}
+
+	tags, ops := readLDAPResponses(t, conn.writeData)
+	want := []byte{ldapBindResponse, ldapSearchResEntry, ldapSearchResDone, ldapSearchResDone, ldapBindResponse}
+	if !bytes.Equal(tags, want) {
+		t.Fatalf("Expected responses %x, got %x", want, tags)
+	}
+	resultCode := func(op []byte) byte {
+		_, code, _, _ := parseBER(op)
+		return code[0]
+	}
+	if resultCode(ops[0]) != ldapSuccess {
+		t.Error("Expected anonymous bind to succeed")
+	}
+	if !bytes.Contains(ops[1], []byte(ldapHost)) {
+		t.Error("Expected dnsHostName in root DSE")
+	}
+	if resultCode(ops[3]) != ldapOperationsError {
+		t.Error("Expected search without bind to fail")
+	}
+	if resultCode(ops[4]) != ldapInvalidCredentials || !bytes.Contains(ops[4], []byte("data 52e")) {
+		t.Error("Expected invalidCredentials with AD diagnostic")
+	}
+
+	if len(captures) != 1 || captures[0].Username != "CN=Administrator,CN=Users,DC=corp,DC=local" || captures[0].Password != "P@ssw0rd" {
+		t.Errorf("Unexpected captures: %+v", captures)
+	}
+}
@@ -0,0 +1,191 @@
+package mockproto
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	memcachedVersion = "1.6.21"
+
+	mcMagicRequest  = 0x80
+	mcMagicResponse = 0x81
+
+	mcOpQuit         = 0x07
+	mcOpVersion      = 0x0b
+	mcOpSASLListMech = 0x20
+	mcOpSASLAuth     = 0x21
+
+	mcStatusAuthError = 0x20
+
+	// maxMemcachedValue caps a data block read from the client.
+	maxMemcachedValue = 64 * 1024
+	// maxMemcachedLine caps an ASCII command line, as memcached's read
+	// buffer does.
+	maxMemcachedLine = 2048
+	// maxMemcachedCommands bounds the commands served on one connection.
+	maxMemcachedCommands = 100
+)
+
+// MockMemcached emulates a memcached server with SASL authentication
+// enabled, speaking both the ASCII and binary protocols. Credentials sent via
+// binary SASL PLAIN or the ASCII "set <key> <flags> <exptime> <bytes>" auth
+// convention are captured and rejected; everything else fails as
+// unauthenticated. In tarpit mode every reply is delayed, increasingly.
+func MockMemcached(conn net.Conn, config MockConfig) error {
+	var t *Tarpit
+	if config.Tarpit {
+		t = NewTarpit(500, 500, 10000)
+	}
+	br := bufio.NewReaderSize(conn, maxMemcachedLine)
+
+	conn.SetReadDeadline(time.Now().Add(60 * time.Second))
+	first, err := br.Peek(1)
+	if err != nil {
+		return nil
+	}
+	if first[0] == mcMagicRequest {
+		return memcachedBinary(conn, br, &config, t)
+	}
+	return memcachedASCII(conn, br, &config, t)
+}
+
+// memcachedPace delays a reply according to the tarpit or random delay settings.
+func memcachedPace(config *MockConfig, t *Tarpit) {
+	if t != nil {
+		t.Sleep()
+	} else if config.RandomDelay {
+		RandomDelay(50, 500)
+	}
+}
+
+func memcachedASCII(conn net.Conn, br *bufio.Reader, config *MockConfig, t *Tarpit) error {
+	for i := 0; i < maxMemcachedCommands; i++ {
+		conn.SetReadDeadline(time.Now().Add(60 * time.Second))
+		line, err := br.ReadSlice('\n')
+		if err == bufio.ErrBufferFull {
+			conn.Write([]byte("CLIENT_ERROR line too long\r\n"))
+			return nil
+		}
+		if err != nil {
+			return nil
+		}
+		fields := strings.Fields(string(line))
+		if len(fields) == 0 {
+			continue
+		}
+
+		reply := "CLIENT_ERROR unauthenticated\r\n"
+		switch cmd := strings.ToLower(fields[0]); cmd {
+		case "quit":
+			return nil
+		case "version":
+			reply = "VERSION " + memcachedVersion + "\r\n"
+		case "set", "add", "replace", "append", "prepend", "cas":
+			// Storage commands carry a data block: <cmd> <key> <flags> <exptime> <bytes>
+			if len(fields) < 5 {
+				reply = "ERROR\r\n"
+				break
+			}
+			n, err := strconv.Atoi(fields[4])
+			if err != nil || n < 0 || n > maxMemcachedValue {
+				reply = "CLIENT_ERROR bad data chunk\r\n"
+				break
+			}
+			data := make([]byte, n+2)
+			if _, err := io.ReadFull(br, data); err != nil {
+				return nil
+			}
+			// With SASL enabled the first "set" authenticates: data is "<user> <password>"
+			if cmd == "set" {
+				if user, pass, ok := strings.Cut(string(data[:n]), " "); ok {
+					config.capture(Capture{Protocol: "memcached", Kind: CapturePassword, Username: user, Password: pass})
+					reply = "CLIENT_ERROR authentication failure\r\n"
+				}
+			}
+		}
+
+		memcachedPace(config, t)
+		if _, err := conn.Write([]byte(reply)); err != nil {
+			return nil
+		}
+	}
+	return nil
+}
+
+func memcachedBinary(conn net.Conn, br *bufio.Reader, config *MockConfig, t *Tarpit) error {
+	for i := 0; i < maxMemcachedCommands; i++ {
+		conn.SetReadDeadline(time.Now().Add(60 * time.Second))
+		var hdr [24]byte
+		if _, err := io.ReadFull(br, hdr[:]); err != nil {
+			return nil
+		}
+		if hdr[0] != mcMagicRequest {
+			return nil
+		}
+		opcode := hdr[1]
+		keyLen := int(binary.BigEndian.Uint16(hdr[2:]))
+		extLen := int(hdr[4])
+		bodyLen := int(binary.BigEndian.Uint32(hdr[8:]))
+		opaque := binary.BigEndian.Uint32(hdr[12:])
+		if bodyLen > maxMemcachedValue || keyLen+extLen > bodyLen {
+			return nil
+		}
+		body := make([]byte, bodyLen)
+		if _, err := io.ReadFull(br, body); err != nil {
+			return nil
+		}
+		key := string(body[extLen : extLen+keyLen])
+		value := body[extLen+keyLen:]
+
+		var status uint16
+		var resp []byte
+		switch opcode {
+		case mcOpQuit:
+			return nil
+		case mcOpVersion:
+			resp = []byte(memcachedVersion)
+		case mcOpSASLListMech:
+			resp = []byte("PLAIN")
+		case mcOpSASLAuth:
+			status, resp = mcStatusAuthError, []byte("Auth failure.")
+			if key == "PLAIN" {
+				// authzid NUL authcid NUL passwd
+				if parts := bytes.SplitN(value, []byte{0}, 3); len(parts) == 3 {
+					config.capture(Capture{
+						Protocol: "memcached",
+						Kind:     CapturePassword,
+						Username: string(parts[1]),
+						Password: string(parts[2]),
+						Fields:   map[string]string{"mechanism": key},
+					})
+				}
+			}
+		default:
+			status, resp = mcStatusAuthError, []byte("Auth failure.")
+		}
+
+		memcachedPace(config, t)
+		if _, err := conn.Write(memcachedBinaryResponse(opcode, status, opaque, resp)); err != nil {
+			return nil
+		}
+	}
+	return nil
+}
+
+// memcachedBinaryResponse builds a binary protocol response with value as its body.
+func memcachedBinaryResponse(opcode byte, status uint16, opaque uint32, value []byte) []byte {
+	msg := make([]byte, 24, 24+len(value))
+	msg[0] = mcMagicResponse
+	msg[1] = opcode
+	binary.BigEndian.PutUint16(msg[6:], status)
+	binary.BigEndian.PutUint32(msg[8:], uint32(len(value)))
+	binary.BigEndian.PutUint32(msg[12:], opaque)
+	return append(msg, value...)
+}
@@ -0,0 +1,72 @@
+package mockproto
+
+import (
+	"bytes"
+	"encoding/binary"
+	"strings"
+	"testing"
+)
+
+func TestMockMemcached_ASCII(t *testing.T) {
+	conn := newMockConn([]byte("version\r\nget foo\r\nset auth 0 0 12\r\nadmin secret\r\nquit\r\n"))
+
+	var captures []Capture
+	err := MockMemcached(conn, MockConfig{OnCapture: func(c Capture) { captures = append(captures, c) }})
+	if err != nil {
+		t.Fatalf("MockMemcached failed: %v", err)
+	}
+
+	want := "VERSION " + memcachedVersion + "\r\nCLIENT_ERROR unauthenticated\r\nCLIENT_ERROR authentication failure\r\n"
+	if got := string(conn.writeData); got != want {
+		t.Errorf("Unexpected responses:\ngot  %q\nwant %q", got, want)
+	}
+	if len(captures) != 1 || captures[0].Username != "admin" || captures[0].Password != "secret" {
+		t.Errorf("Unexpected captures: %+v", captures)
+	}
+}
+
+func TestMockMemcached_LongLine(t *testing.T) {
+	conn := newMockConn([]byte("get " + strings.Repeat("k", 2*maxMemcachedLine) + "\r\nversion\r\n"))
+	if err := MockMemcached(conn, MockConfig{}); err != nil {
+		t.Fatalf("MockMemcached failed: %v", err)
+	}
+	if got := string(conn.writeData); got != "CLIENT_ERROR line too long\r\n" {
+		t.Errorf("Expected the connection closed after an overlong line, got %q", got)
+	}
+}
+
+// mcBinaryRequest builds a binary protocol request with key and value.
+func mcBinaryRequest(opcode byte, key, value string) []byte {
+	msg := make([]byte, 24)
+	msg[0] = mcMagicRequest
+	msg[1] = opcode
+	binary.BigEndian.PutUint16(msg[2:], uint16(len(key)))
+	binary.BigEndian.PutUint32(msg[8:], uint32(len(key)+len(value)))
+	binary.BigEndian.PutUint32(msg[12:], 0xdeadbeef)
+	msg = append(msg, key...)
+	return append(msg, value...)
+}
+
+func TestMockMemcached_BinarySASL(t *testing.T) {
+	var input []byte
+	input = append(input, mcBinaryRequest(mcOpSASLListMech, "", "")...)
+	input = append(input, mcBinaryRequest(mcOpSASLAuth, "PLAIN", "\x00cache\x00p@ss")...)
+	conn := newMockConn(input)
+
+	var captures []Capture
+	MockMemcached(conn, MockConfig{OnCapture: func(c Capture) { captures = append(captures, c) }})
+
+	resp := conn.writeData
+	if len(resp) < 24 || resp[0] != mcMagicResponse || !bytes.Contains(resp, []byte("PLAIN")) {
+		t.Fatalf("Expected SASL mechanism list, got %q", resp)
+	}
+	if binary.BigEndian.Uint32(resp[12:]) != 0xdeadbeef {
+		t.Error("Expected opaque to be echoed")
+	}
+	if !strings.Contains(string(resp), "Auth failure.") {
+		t.Errorf("Expected auth failure, got %q", resp)
+	}
+	if len(captures) != 1 || captures[0].Username != "cache" || captures[0].Password != "p@ss" {
+		t.Errorf("Unexpected captures: %+v", captures)
+	}
+}
@@ -0,0 +1,495 @@
+package mockproto
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"net"
+	"strings"
+	"time"
+)
+
+const (
+	mongoOpReply = 1
+	mongoOpQuery = 2004
+	mongoOpMsg   = 2013
+
+	// maxMongoMessageSize caps a client message; auth traffic is tiny.
+	maxMongoMessageSize = 1024 * 1024
+	// maxMongoMessages bounds the commands served on one connection.
+	maxMongoMessages = 100
+
+	mongoVersion = "6.0.12"
+)
+
+var errBSON = errors.New("malformed bson")
+
+// MockMongoDB emulates a MongoDB server with authentication enabled.
+// It answers the hello/isMaster handshake and buildInfo, captures the
+// driver metadata and every authentication attempt (user and database, and
+// the password for PLAIN), and fails authentication. Other commands return
+// Unauthorized. In tarpit mode every reply is delayed, increasingly.
+func MockMongoDB(conn net.Conn, config MockConfig) error {
+	var t *Tarpit
+	if config.Tarpit {
+		t = NewTarpit(500, 500, 10000)
+	}
+	s := &mongoSession{config: &config}
+
+	for i := 0; i < maxMongoMessages; i++ {
+		conn.SetReadDeadline(time.Now().Add(60 * time.Second))
+		var hdr [16]byte
+		if _, err := io.ReadFull(conn, hdr[:]); err != nil {
+			return nil
+		}
+		length := int(binary.LittleEndian.Uint32(hdr[0:]))
+		requestID := binary.LittleEndian.Uint32(hdr[4:])
+		opCode := binary.LittleEndian.Uint32(hdr[12:])
+		if length < 16 || length > maxMongoMessageSize {
+			return nil
+		}
+		body := make([]byte, length-16)
+		if _, err := io.ReadFull(conn, body); err != nil {
+			return nil
+		}
+
+		var cmd bsonDoc
+		var db string
+		var err error
+		switch opCode {
+		case mongoOpQuery:
+			cmd, db, err = parseMongoQuery(body)
+		case mongoOpMsg:
+			cmd, err = parseMongoMsg(body)
+			db, _ = cmd.get("$db").(string)
+		default:
+			return nil
+		}
+		if err != nil || len(cmd) == 0 {
+			return nil
+		}
+
+		reply := s.handle(cmd, db)
+		if t != nil {
+			t.Sleep()
+		} else if config.RandomDelay {
+			RandomDelay(100, 1000)
+		}
+		if _, err := conn.Write(mongoReply(opCode, requestID, reply)); err != nil {
+			return nil
+		}
+	}
+	return nil
+}
+
+// mongoSession tracks per-connection state for MockMongoDB.
+type mongoSession struct {
+	config       *MockConfig
+	reportedInfo bool
+	lastLogin    string
+}
+
+// handle answers one command document.
+func (s *mongoSession) handle(cmd bsonDoc, db string) bsonDoc {
+	name := strings.ToLower(cmd[0].Key)
+	switch name {
+	case "hello", "ismaster":
+		s.reportClientInfo(cmd)
+		if user, ok := cmd.get("saslSupportedMechs").(string); ok {
+			s.reportLogin(user, "", "", "")
+		}
+		if spec, ok := cmd.get("speculativeAuthenticate").(bsonDoc); ok {
+			s.authenticate(spec, db)
+		}
+		return bsonDoc{
+			{"helloOk", true},
+			{"ismaster", true},
+			{"topologyVersion", bsonDoc{{"processId", mongoObjectID()}, {"counter", int64(0)}}},
+			{"maxBsonObjectSize", int32(16777216)},
+			{"maxMessageSizeBytes", int32(48000000)},
+			{"maxWriteBatchSize", int32(100000)},
+			{"localTime", time.Now()},
+			{"logicalSessionTimeoutMinutes", int32(30)},
+			{"connectionId", int32(1 + time.Now().UnixNano()%100000)},
+			{"minWireVersion", int32(0)},
+			{"maxWireVersion", int32(17)},
+			{"readOnly", false},
+			{"saslSupportedMechs", []any{"SCRAM-SHA-1", "SCRAM-SHA-256"}},
+			{"ok", 1.0},
+		}
+	case "buildinfo":
+		return bsonDoc{
+			{"version", mongoVersion},
+			{"gitVersion", "21e5ed5f7e5d5e2e1b0a3b1f0b2a57e9fdd07c8c"},
+			{"modules", []any{}},
+			{"allocator", "tcmalloc"},
+			{"javascriptEngine", "mozjs"},
+			{"sysInfo", "deprecated"},
+			{"versionArray", []any{int32(6), int32(0), int32(12), int32(0)}},
+			{"bits", int32(64)},
+			{"debug", false},
+			{"maxBsonObjectSize", int32(16777216)},
+			{"ok", 1.0},
+		}
+	case "ping", "endsessions":
+		return bsonDoc{{"ok", 1.0}}
+	case "getnonce":
+		nonce := make([]byte, 8)
+		rand.Read(nonce)
+		return bsonDoc{{"nonce", hex.EncodeToString(nonce)}, {"ok", 1.0}}
+	case "saslstart", "authenticate":
+		s.authenticate(cmd, db)
+		return mongoError(18, "AuthenticationFailed", "Authentication failed.")
+	default:
+		return mongoError(13, "Unauthorized", fmt.Sprintf("command %s requires authentication", cmd[0].Key))
+	}
+}
+
+// reportClientInfo captures the driver metadata sent with the first hello.
+func (s *mongoSession) reportClientInfo(cmd bsonDoc) {
+	if s.reportedInfo {
+		return
+	}
+	s.reportedInfo = true
+
+	client, ok := cmd.get("client").(bsonDoc)
+	if !ok {
+		return
+	}
+	fields := map[string]string{}
+	if driver, ok := client.get("driver").(bsonDoc); ok {
+		fields["driver"], _ = driver.get("name").(string)
+		fields["driver_version"], _ = driver.get("version").(string)
+	}
+	if osInfo, ok := client.get("os").(bsonDoc); ok {
+		fields["os"], _ = osInfo.get("type").(string)
+	}
+	if app, ok := client.get("application").(bsonDoc); ok {
+		fields["application"], _ = app.get("name").(string)
+	}
+	if platform, ok := client.get("platform").(string); ok {
+		fields["platform"] = platform
+	}
+	s.config.capture(Capture{Protocol: "mongodb", Kind: CaptureClientInfo, Fields: fields})
+}
+
+// authenticate captures a saslStart or authenticate command.
+func (s *mongoSession) authenticate(cmd bsonDoc, db string) {
+	mechanism, _ := cmd.get("mechanism").(string)
+	if d, ok := cmd.get("db").(string); ok {
+		db = d
+	}
+
+	var user, password string
+	if payload, ok := cmd.get("payload").([]byte); ok {
+		switch mechanism {
+		case "PLAIN":
+			// authzid NUL authcid NUL passwd
+			if parts := bytes.SplitN(payload, []byte{0}, 3); len(parts) == 3 {
+				user, password = string(parts[1]), string(parts[2])
+			}
+		default:
+			// SCRAM client-first-message: n,,n=user,r=nonce
+			for _, attr := range strings.Split(string(payload), ",") {
+				if u, ok := strings.CutPrefix(attr, "n="); ok {
+					user = strings.NewReplacer("=2C", ",", "=3D", "=").Replace(u)
+					break
+				}
+			}
+		}
+	} else if u, ok := cmd.get("user").(string); ok {
+		user = u
+	}
+	s.reportLogin(user, password, db, mechanism)
+}
+
+// reportLogin captures an authentication attempt once per user/database.
+func (s *mongoSession) reportLogin(user, password, db, mechanism string) {
+	// saslSupportedMechs is "db.user"
+	if db == "" {
+		if d, u, ok := strings.Cut(user, "."); ok {
+			db, user = d, u
+		}
+	}
+	key := db + "\x00" + user + "\x00" + password
+	if user == "" || key == s.lastLogin {
+		return
+	}
+	s.lastLogin = key
+
+	fields := map[string]string{"database": db}
+	if mechanism != "" {
+		fields["mechanism"] = mechanism
+	}
+	kind := CaptureLogin
+	if password != "" {
+		kind = CapturePassword
+	}
+	s.config.capture(Capture{Protocol: "mongodb", Kind: kind, Username: user, Password: password, Fields: fields})
+}
+
+func mongoError(code int32, codeName, msg string) bsonDoc {
+	return bsonDoc{{"ok", 0.0}, {"errmsg", msg}, {"code", code}, {"codeName", codeName}}
+}
+
+func mongoObjectID() bsonObjectID {
+	var id bsonObjectID
+	binary.BigEndian.PutUint32(id[:], uint32(time.Now().Unix()))
+	rand.Read(id[4:])
+	return id
+}
+
+// parseMongoQuery parses an OP_QUERY body, returning the query document and
+// the database of "<db>.$cmd".
+func parseMongoQuery(body []byte) (bsonDoc, string, error) {
+	if len(body) < 4 {
+		return nil, "", errBSON
+	}
+	p := body[4:] // flags
+	i := bytes.IndexByte(p, 0)
+	if i < 0 || len(p) < i+9 {
+		return nil, "", errBSON
+	}
+	collection := string(p[:i])
+	p = p[i+9:] // NUL, numberToSkip, numberToReturn
+
+	doc, _, err := decodeBSON(p)
+	db, _, _ := strings.Cut(collection, ".")
+	return doc, db, err
+}
+
+// parseMongoMsg returns the body section (kind 0) of an OP_MSG.
+func parseMongoMsg(body []byte) (bsonDoc, error) {
+	if len(body) < 4 {
+		return nil, errBSON
+	}
+	p := body[4:] // flagBits
+	for len(p) > 0 {
+		kind := p[0]
+		p = p[1:]
+		switch kind {
+		case 0:
+			doc, _, err := decodeBSON(p)
+			return doc, err
+		case 1:
+			// Document sequence: int32 size (including itself), identifier, documents
+			if len(p) < 4 {
+				return nil, errBSON
+			}
+			size := int(binary.LittleEndian.Uint32(p))
+			if size < 4 || size > len(p) {
+				return nil, errBSON
+			}
+			p = p[size:]
+		default:
+			return nil, errBSON
+		}
+	}
+	return nil, errBSON
+}
+
+// mongoReply frames doc as a reply to requestID in the request's format.
+func mongoReply(requestOp, requestID uint32, doc bsonDoc) []byte {
+	var body []byte
+	op := uint32(mongoOpMsg)
+	if requestOp == mongoOpQuery {
+		op = mongoOpReply
+		// responseFlags, cursorID, startingFrom, numberReturned
+		body = make([]byte, 20)
+		binary.LittleEndian.PutUint32(body[16:], 1)
+	} else {
+		// flagBits, section kind 0
+		body = make([]byte, 5)
+	}
+	body = append(body, encodeBSON(doc)...)
+
+	msg := make([]byte, 16, 16+len(body))
+	binary.LittleEndian.PutUint32(msg[0:], uint32(16+len(body)))
+	binary.LittleEndian.PutUint32(msg[4:], requestID+1)
+	binary.LittleEndian.PutUint32(msg[8:], requestID)
+	binary.LittleEndian.PutUint32(msg[12:], op)
+	return append(msg, body...)
+}
+
+// bsonDoc is an ordered BSON document. Values are float64, string, bsonDoc,
+// []any (array), []byte (binary), bsonObjectID, bool, time.Time, nil, int32
+// or int64. Decoded arrays are returned as bsonDoc with index keys.
+type bsonDoc []bsonElem
+
+type bsonElem struct {
+	Key   string
+	Value any
+}
+
+type bsonObjectID [12]byte
+
+// get returns the value for key, or nil.
+func (d bsonDoc) get(key string) any {
+	for _, e := range d {
+		if e.Key == key {
+			return e.Value
+		}
+	}
+	return nil
+}
+
+func encodeBSON(doc bsonDoc) []byte {
+	buf := []byte{0, 0, 0, 0}
+	for _, e := range doc {
+		buf = appendBSONElem(buf, e.Key, e.Value)
+	}
+	buf = append(buf, 0)
+	binary.LittleEndian.PutUint32(buf, uint32(len(buf)))
+	return buf
+}
+
+func appendBSONElem(buf []byte, key string, v any) []byte {
+	header := func(typ byte) {
+		buf = append(buf, typ)
+		buf = append(buf, key...)
+		buf = append(buf, 0)
+	}
+	switch v := v.(type) {
+	case float64:
+		header(0x01)
+		buf = binary.LittleEndian.AppendUint64(buf, math.Float64bits(v))
+	case string:
+		header(0x02)
+		buf = binary.LittleEndian.AppendUint32(buf, uint32(len(v)+1))
+		buf = append(buf, v...)
+		buf = append(buf, 0)
+	case bsonDoc:
+		header(0x03)
+		buf = append(buf, encodeBSON(v)...)
+	case []any:
+		header(0x04)
+		arr := make(bsonDoc, len(v))
+		for i, item := range v {
+			arr[i] = bsonElem{fmt.Sprint(i), item}
+		}
+		buf = append(buf, encodeBSON(arr)...)
+	case []byte:
+		header(0x05)
+		buf = binary.LittleEndian.AppendUint32(buf, uint32(len(v)))
+		buf = append(buf, 0) // generic subtype
+		buf = append(buf, v...)
+	case bsonObjectID:
+		header(0x07)
+		buf = append(buf, v[:]...)
+	case bool:
+		header(0x08)
+		if v {
+			buf = append(buf, 1)
+		} else {
+			buf = append(buf, 0)
+		}
+	case time.Time:
+		header(0x09)
+		buf = binary.LittleEndian.AppendUint64(buf, uint64(v.UnixMilli()))
+	case nil:
+		header(0x0A)
+	case int32:
+		header(0x10)
+		buf = binary.LittleEndian.AppendUint32(buf, uint32(v))
+	case int64:
+		header(0x12)
+		buf = binary.LittleEndian.AppendUint64(buf, uint64(v))
+	}
+	return buf
+}
+
+// decodeBSON decodes one document from data, returning it and the bytes consumed.
+func decodeBSON(data []byte) (bsonDoc, int, error) {
+	if len(data) < 5 {
+		return nil, 0, errBSON
+	}
+	size := int(binary.LittleEndian.Uint32(data))
+	if size < 5 || size > len(data) || data[size-1] != 0 {
+		return nil, 0, errBSON
+	}
+
+	var doc bsonDoc
+	p := data[4 : size-1]
+	for len(p) > 0 {
+		typ := p[0]
+		i := bytes.IndexByte(p[1:], 0)
+		if i < 0 {
+			return nil, 0, errBSON
+		}
+		key := string(p[1 : 1+i])
+		p = p[2+i:]
+
+		var v any
+		n := 0
+		switch typ {
+		case 0x01:
+			n = 8
+			if len(p) >= n {
+				v = math.Float64frombits(binary.LittleEndian.Uint64(p))
+			}
+		case 0x02:
+			if len(p) < 4 {
+				return nil, 0, errBSON
+			}
+			l := int(binary.LittleEndian.Uint32(p))
+			n = 4 + l
+			if l < 1 || len(p) < n {
+				return nil, 0, errBSON
+			}
+			v = string(p[4 : n-1])
+		case 0x03, 0x04:
+			sub, used, err := decodeBSON(p)
+			if err != nil {
+				return nil, 0, err
+			}
+			v, n = sub, used
+		case 0x05:
+			if len(p) < 5 {
+				return nil, 0, errBSON
+			}
+			l := int(binary.LittleEndian.Uint32(p))
+			n = 5 + l
+			if l < 0 || len(p) < n {
+				return nil, 0, errBSON
+			}
+			v = append([]byte(nil), p[5:n]...)
+		case 0x07:
+			n = 12
+			if len(p) >= n {
+				var id bsonObjectID
+				copy(id[:], p)
+				v = id
+			}
+		case 0x08:
+			n = 1
+			if len(p) >= n {
+				v = p[0] != 0
+			}
+		case 0x09, 0x11, 0x12:
+			n = 8
+			if len(p) >= n {
+				v = int64(binary.LittleEndian.Uint64(p))
+			}
+		case 0x0A:
+			n = 0
+		case 0x10:
+			n = 4
+			if len(p) >= n {
+				v = int32(binary.LittleEndian.Uint32(p))
+			}
+		default:
+			return nil, 0, errBSON
+		}
+		if len(p) < n {
+			return nil, 0, errBSON
+		}
+		p = p[n:]
+		doc = append(doc, bsonElem{key, v})
+	}
+	return doc, size, nil
+}
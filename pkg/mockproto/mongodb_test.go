@@ -0,0 +1,145 @@
+package mockproto
+
+import (
+	"encoding/binary"
+	"testing"
+)
+
+// mongoMsg frames cmd as an OP_MSG request.
+func mongoMsg(requestID uint32, cmd bsonDoc) []byte {
+	body := append(make([]byte, 5), encodeBSON(cmd)...)
+	msg := make([]byte, 16)
+	binary.LittleEndian.PutUint32(msg[0:], uint32(16+len(body)))
+	binary.LittleEndian.PutUint32(msg[4:], requestID)
+	binary.LittleEndian.PutUint32(msg[12:], mongoOpMsg)
+	return append(msg, body...)
+}
+
+// mongoQuery frames cmd as a legacy OP_QUERY against db.$cmd.
+func mongoQuery(requestID uint32, db string, cmd bsonDoc) []byte {
+	body := make([]byte, 4)
+	body = append(body, db+".$cmd"...)
+	body = append(body, 0)
+	body = binary.LittleEndian.AppendUint32(body, 0)
+	body = binary.LittleEndian.AppendUint32(body, 0xffffffff)
+	body = append(body, encodeBSON(cmd)...)
+	msg := make([]byte, 16)
+	binary.LittleEndian.PutUint32(msg[0:], uint32(16+len(body)))
+	binary.LittleEndian.PutUint32(msg[4:], requestID)
+	binary.LittleEndian.PutUint32(msg[12:], mongoOpQuery)
+	return append(msg, body...)
+}
+
+// readMongoReplies splits written data into reply documents.
+func readMongoReplies(t *testing.T, data []byte) []bsonDoc {
+	t.Helper()
+	var docs []bsonDoc
+	for len(data) >= 16 {
+		length := int(binary.LittleEndian.Uint32(data))
+		op := binary.LittleEndian.Uint32(data[12:])
+		offset := 16 + 5
+		if op == mongoOpReply {
+			offset = 16 + 20
+		}
+		doc, _, err := decodeBSON(data[offset:length])
+		if err != nil {
+			t.Fatalf("Invalid reply: %v", err)
+		}
+		docs = append(docs, doc)
+		data = data[length:]
+	}
+	return docs
+}
+
+func TestBSON_RoundTrip(t *testing.T) {
+	doc := bsonDoc{
+		{"s", "value"},
+		{"i", int32(42)},
+		{"l", int64(1 << 40)},
+		{"f", 1.5},
+		{"b", true},
+		{"n", nil},
+		{"bin", []byte{1, 2, 3}},
+		{"sub", bsonDoc{{"k", "v"}}},
+	}
+	got, n, err := decodeBSON(encodeBSON(doc))
+	if err != nil {
+		t.Fatalf("decodeBSON failed: %v", err)
+	}
+	if n != len(encodeBSON(doc)) || len(got) != len(doc) {
+		t.Fatalf("Unexpected decode: %+v", got)
+	}
+	if got.get("s") != "value" || got.get("i") != int32(42) || got.get("l") != int64(1<<40) || got.get("f") != 1.5 || got.get("b") != true {
+		t.Errorf("Scalar mismatch: %+v", got)
+	}
+	if sub, ok := got.get("sub").(bsonDoc); !ok || sub.get("k") != "v" {
+		t.Errorf("Subdocument mismatch: %+v", got.get("sub"))
+	}
+
+	if _, _, err := decodeBSON([]byte{0xff, 0, 0, 0, 0}); err == nil {
+		t.Error("Expected error for truncated document")
+	}
+}
+
+func TestMockMongoDB_HandshakeAndAuth(t *testing.T) {
+	hello := bsonDoc{
+		{"isMaster", int32(1)},
+		{"client", bsonDoc{
+			{"driver", bsonDoc{{"name", "nodejs"}, {"version", "6.3.0"}}},
+			{"os", bsonDoc{{"type", "Linux"}}},
+		}},
+		{"saslSupportedMechs", "admin.root"},
+	}
+	var input []byte
+	input = append(input, mongoQuery(1, "admin", hello)...)
+	input = append(input, mongoMsg(2, bsonDoc{
+		{"saslStart", int32(1)},
+		{"mechanism", "SCRAM-SHA-256"},
+		{"payload", []byte("n,,n=root,r=abcdef")},
+		{"$db", "admin"},
+	})...)
+	input = append(input, mongoMsg(3, bsonDoc{
+		{"saslStart", int32(1)},
+		{"mechanism", "PLAIN"},
+		{"payload", []byte("\x00app\x00s3cret")},
+		{"$db", "orders"},
+	})...)
+	input = append(input, mongoMsg(4, bsonDoc{{"listDatabases", int32(1)}, {"$db", "admin"}})...)
+	conn := newMockConn(input)
+
+	var captures []Capture
+	err := MockMongoDB(conn, MockConfig{OnCapture: func(c Capture) { captures = append(captures, c) }})
+	if err != nil {
+		t.Fatalf("MockMongoDB failed: %v", err)
+	}
+
+	replies := readMongoReplies(t, conn.writeData)
+	if len(replies) != 4 {
+		t.Fatalf("Expected 4 replies, got %d", len(replies))
+	}
+	if replies[0].get("ismaster") != true || replies[0].get("maxWireVersion") != int32(17) {
+		t.Errorf("Unexpected hello reply: %+v", replies[0])
+	}
+	for _, r := range replies[1:3] {
+		if r.get("code") != int32(18) || r.get("errmsg") != "Authentication failed." {
+			t.Errorf("Expected AuthenticationFailed, got %+v", r)
+		}
+	}
+	if replies[3].get("codeName") != "Unauthorized" {
+		t.Errorf("Expected Unauthorized, got %+v", replies[3])
+	}
+
+	// client_info, login from saslSupportedMechs (deduped with saslStart), PLAIN password
+	if len(captures) != 3 {
+		t.Fatalf("Expected 3 captures, got %d: %+v", len(captures), captures)
+	}
+	if captures[0].Kind != CaptureClientInfo || captures[0].Fields["driver"] != "nodejs" || captures[0].Fields["os"] != "Linux" {
+		t.Errorf("Unexpected client info: %+v", captures[0])
+	}
+	if captures[1].Kind != CaptureLogin || captures[1].Username != "root" || captures[1].Fields["database"] != "admin" {
+		t.Errorf("Unexpected login capture: %+v", captures[1])
+	}
+	if captures[2].Kind != CapturePassword || captures[2].Username != "app" || captures[2].Password != "s3cret" || captures[2].Fields["database"] != "orders" {
+		t.Errorf("Unexpected password capture: %+v", captures[2])
+	}
+}
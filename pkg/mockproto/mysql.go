@@ -10,7 +10,7 @@ import (
 // In tarpit mode, it keeps asking for authentication forever.
 func MockMySQL(conn net.Conn, config MockConfig) error {
 	// Send initial handshake
-	if err := sendMySQLHandshake(conn); err != nil {
+	if err := sendMySQLHandshake(conn, config.MySQLVersion); err != nil {
 		return err
 	}
 
@@ -77,8 +77,11 @@ func mysqlTarpit(conn net.Conn) error {
 	}
 }
 
-func sendMySQLHandshake(conn net.Conn) error {
-	serverVersion := "5.7.21-log\x00"
+func sendMySQLHandshake(conn net.Conn, version string) error {
+	if version == "" {
+		version = "5.7.21-log"
+	}
+	serverVersion := version + "\x00"
 	threadID := []byte{0x2d, 0x00, 0x00, 0x00}
 	// Generate random salt (8 bytes + null terminator)
 	saltBytes := make([]byte, 8)
@@ -4,7 +4,9 @@ import (
 	"net"
 )
 
-func MockMSSQL(conn net.Conn) error {
+// MockMSSQL sends a TDS pre-login response. Delay, drip and tarpit settings
+// pace the response; in tarpit mode the connection is then held open.
+func MockMSSQL(conn net.Conn, config MockConfig) error {
 	// TDS Pre-Login Response
 	// Header: type(1) + status(1) + length(2, big-endian) + channel(2) + packet#(1) + window(1)
 	// Followed by pre-login option tokens
@@ -22,17 +24,29 @@ func MockMSSQL(conn net.Conn) error {
 		0x0e, 0x00, 0x00, 0x00, 0x00, 0x00, // VERSION data: 14.0.0.0
 		0x02, // ENCRYPTION data: NOT_SUP
 	}
-	_, err := conn.Write(response)
-	return err
+	return writeAndHold(conn, config, response)
 }
 
-func MockRDP(conn net.Conn) error {
+// MockRDP sends an X.224 Connection Confirm. Delay, drip and tarpit settings
+// pace the response; in tarpit mode the connection is then held open.
+func MockRDP(conn net.Conn, config MockConfig) error {
 	// TPKT Header + X.224 Connection Confirm
 	response := []byte{
 		0x03, 0x00, 0x00, 0x13, // TPKT Header (Len 19)
 		0x0e, 0xd0, 0x00, 0x00, 0x12, 0x34, 0x00, // X.224 CC
 		0x02, 0x01, 0x00, 0x01, 0x00, 0x00, 0x00, 0x00,
 	}
-	_, err := conn.Write(response)
-	return err
+	return writeAndHold(conn, config, response)
+}
+
+// writeAndHold writes a one-shot response with writePaced, then holds the
+// connection open in tarpit or never-complete mode.
+func writeAndHold(conn net.Conn, config MockConfig, response []byte) error {
+	if err := writePaced(conn, config, response); err != nil {
+		return err
+	}
+	if config.Tarpit || config.NeverComplete {
+		HoldOpen(conn)
+	}
+	return nil
 }
@@ -2,13 +2,14 @@ package mockproto
 
 import (
 	"bytes"
+	"strings"
 	"testing"
 )
 
 func TestMockMSSQL_TDSResponse(t *testing.T) {
 	conn := newMockConn([]byte{})
 
-	err := MockMSSQL(conn)
+	err := MockMSSQL(conn, MockConfig{})
 	if err != nil {
 		t.Fatalf("MockMSSQL failed: %v", err)
 	}
@@ -32,7 +33,7 @@ func TestMockMSSQL_TDSResponse(t *testing.T) {
 func TestMockMSSQL_PacketLength(t *testing.T) {
 	conn := newMockConn([]byte{})
 
-	err := MockMSSQL(conn)
+	err := MockMSSQL(conn, MockConfig{})
 	if err != nil {
 		t.Fatalf("MockMSSQL failed: %v", err)
 	}
@@ -54,7 +55,7 @@ func TestMockMSSQL_PacketLength(t *testing.T) {
 func TestMockRDP_X224Response(t *testing.T) {
 	conn := newMockConn([]byte{})
 
-	err := MockRDP(conn)
+	err := MockRDP(conn, MockConfig{})
 	if err != nil {
 		t.Fatalf("MockRDP failed: %v", err)
 	}
@@ -78,7 +79,7 @@ func TestMockRDP_X224Response(t *testing.T) {
 func TestMockRDP_TPKTLength(t *testing.T) {
 	conn := newMockConn([]byte{})
 
-	err := MockRDP(conn)
+	err := MockRDP(conn, MockConfig{})
 	if err != nil {
 		t.Fatalf("MockRDP failed: %v", err)
 	}
@@ -98,7 +99,7 @@ func TestMockRDP_TPKTLength(t *testing.T) {
 func TestMockRDP_X224ConnectionConfirm(t *testing.T) {
 	conn := newMockConn([]byte{})
 
-	err := MockRDP(conn)
+	err := MockRDP(conn, MockConfig{})
 	if err != nil {
 		t.Fatalf("MockRDP failed: %v", err)
 	}
@@ -172,6 +173,36 @@ func TestMockTelnet_LoginBanner(t *testing.T) {
 	}
 }
 
+func TestMockTelnet_AcceptLoginShell(t *testing.T) {
+	// Client answers negotiation, logs in, runs a command and exits
+	input := []byte{0xff, 0xfb, 0x18, 0xff, 0xfa, 0x18, 0x00, 'x', 't', 'e', 'r', 'm', 0xff, 0xf0}
+	input = append(input, []byte("admin\r\n1234\r\ncat /etc/hostname\r\nexit\r\n")...)
+	conn := newMockConn(input)
+
+	var captures []Capture
+	config := MockConfig{
+		AcceptLogin: true,
+		Transcript:  &Transcript{},
+		OnCapture:   func(c Capture) { captures = append(captures, c) },
+	}
+	if err := MockTelnet(conn, config); err != nil {
+		t.Fatalf("MockTelnet failed: %v", err)
+	}
+
+	if len(captures) != 1 || captures[0].Username != "admin" || captures[0].Password != "1234" {
+		t.Fatalf("Expected admin/1234 capture, got %+v", captures)
+	}
+	if !bytes.Contains(conn.writeData, []byte("admin@web01:~$ ")) {
+		t.Error("Expected shell prompt")
+	}
+	if !bytes.Contains(conn.writeData, []byte("web01\r\n")) {
+		t.Error("Expected CRLF-translated command output")
+	}
+	if !strings.Contains(config.Transcript.String(), "cat /etc/hostname\nweb01\n") {
+		t.Errorf("Unexpected transcript: %q", config.Transcript.String())
+	}
+}
+
 func TestMockTelnet_UserAccessVerification(t *testing.T) {
 	conn := newMockConn([]byte{})
 
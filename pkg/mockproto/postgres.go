@@ -0,0 +1,185 @@
+package mockproto
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"time"
+)
+
+const (
+	pgSSLRequestCode    = 80877103
+	pgGSSENCRequestCode = 80877104
+	pgCancelRequestCode = 80877102
+
+	// maxPGStartupSize caps the startup packet (the server limit is 10000).
+	maxPGStartupSize = 10000
+	// maxPGMessageSize caps a password message.
+	maxPGMessageSize = 8192
+
+	pgAuthCleartextPassword = 3
+)
+
+var errPGStartup = errors.New("invalid postgres startup")
+
+// MockPostgres emulates a PostgreSQL server that requires password
+// authentication. The startup parameters (user, database, application_name)
+// and the submitted password are captured, then authentication fails with
+// SQLSTATE 28P01. In tarpit mode the password is requested again, more
+// slowly each time, instead of failing.
+func MockPostgres(conn net.Conn, config MockConfig) error {
+	conn.SetReadDeadline(time.Now().Add(30 * time.Second))
+	params, err := readPGStartup(conn)
+	if err != nil {
+		return nil
+	}
+
+	user := params["user"]
+	database := params["database"]
+	if database == "" {
+		database = user
+	}
+	config.capture(Capture{Protocol: "postgres", Kind: CaptureClientInfo, Username: user, Fields: params})
+
+	var t *Tarpit
+	if config.Tarpit {
+		t = NewTarpit(1000, 1000, 15000)
+	}
+
+	for {
+		if t != nil {
+			t.Sleep()
+		}
+		if _, err := conn.Write(pgAuthRequest(pgAuthCleartextPassword)); err != nil {
+			return nil
+		}
+
+		conn.SetReadDeadline(time.Now().Add(60 * time.Second))
+		password, err := readPGPassword(conn)
+		if err != nil {
+			return nil
+		}
+		config.capture(Capture{
+			Protocol: "postgres",
+			Kind:     CapturePassword,
+			Username: user,
+			Password: password,
+			Fields:   map[string]string{"database": database},
+		})
+
+		if t == nil {
+			break
+		}
+	}
+
+	if config.RandomDelay {
+		RandomDelay(200, 2000)
+	}
+	_, err = conn.Write(pgErrorResponse("FATAL", "28P01", fmt.Sprintf("password authentication failed for user \"%s\"", user)))
+	return err
+}
+
+// readPGStartup reads the startup packet, declining SSL and GSSAPI
+// encryption requests, and returns the startup parameters.
+func readPGStartup(conn net.Conn) (map[string]string, error) {
+	// SSLRequest and GSSENCRequest may each precede the startup message
+	for i := 0; i < 3; i++ {
+		var hdr [4]byte
+		if _, err := io.ReadFull(conn, hdr[:]); err != nil {
+			return nil, err
+		}
+		length := int(binary.BigEndian.Uint32(hdr[:]))
+		if length < 8 || length > maxPGStartupSize {
+			return nil, errPGStartup
+		}
+		body := make([]byte, length-4)
+		if _, err := io.ReadFull(conn, body); err != nil {
+			return nil, err
+		}
+
+		code := binary.BigEndian.Uint32(body)
+		switch code {
+		case pgSSLRequestCode, pgGSSENCRequestCode:
+			if _, err := conn.Write([]byte{'N'}); err != nil {
+				return nil, err
+			}
+			continue
+		case pgCancelRequestCode:
+			return nil, errPGStartup
+		}
+		if code>>16 != 3 {
+			conn.Write(pgErrorResponse("FATAL", "0A000", fmt.Sprintf("unsupported frontend protocol %d.%d: server supports 3.0 to 3.0", code>>16, code&0xffff)))
+			return nil, errPGStartup
+		}
+		return parsePGParams(body[4:]), nil
+	}
+	return nil, errPGStartup
+}
+
+// parsePGParams parses the NUL-terminated name/value pairs of a startup message.
+func parsePGParams(data []byte) map[string]string {
+	params := make(map[string]string)
+	parts := bytes.Split(data, []byte{0})
+	for i := 0; i+1 < len(parts); i += 2 {
+		if len(parts[i]) == 0 {
+			break
+		}
+		params[string(parts[i])] = string(parts[i+1])
+	}
+	return params
+}
+
+// readPGPassword reads a PasswordMessage ('p') and returns the password.
+func readPGPassword(conn net.Conn) (string, error) {
+	var hdr [5]byte
+	if _, err := io.ReadFull(conn, hdr[:]); err != nil {
+		return "", err
+	}
+	length := int(binary.BigEndian.Uint32(hdr[1:]))
+	if hdr[0] != 'p' || length < 4 || length > maxPGMessageSize {
+		return "", errPGStartup
+	}
+	body := make([]byte, length-4)
+	if _, err := io.ReadFull(conn, body); err != nil {
+		return "", err
+	}
+	return strings.TrimRight(string(body), "\x00"), nil
+}
+
+// pgAuthRequest builds an AuthenticationRequest ('R') message.
+func pgAuthRequest(method uint32) []byte {
+	msg := []byte{'R', 0, 0, 0, 8, 0, 0, 0, 0}
+	binary.BigEndian.PutUint32(msg[5:], method)
+	return msg
+}
+
+// pgErrorResponse builds an ErrorResponse ('E') message.
+func pgErrorResponse(severity, code, message string) []byte {
+	var fields bytes.Buffer
+	for _, f := range []struct {
+		typ   byte
+		value string
+	}{
+		{'S', severity},
+		{'V', severity},
+		{'C', code},
+		{'M', message},
+		{'F', "auth.c"},
+		{'L', "326"},
+		{'R', "auth_failed"},
+	} {
+		fields.WriteByte(f.typ)
+		fields.WriteString(f.value)
+		fields.WriteByte(0)
+	}
+	fields.WriteByte(0)
+
+	msg := make([]byte, 5, 5+fields.Len())
+	msg[0] = 'E'
+	binary.BigEndian.PutUint32(msg[1:], uint32(4+fields.Len()))
+	return append(msg, fields.Bytes()...)
+}
@@ -0,0 +1,80 @@
+package mockproto
+
+import (
+	"bytes"
+	"encoding/binary"
+	"strings"
+	"testing"
+)
+
+// pgStartupMessage builds a protocol 3.0 StartupMessage.
+func pgStartupMessage(params ...string) []byte {
+	var body bytes.Buffer
+	binary.Write(&body, binary.BigEndian, uint32(3<<16))
+	for _, p := range params {
+		body.WriteString(p)
+		body.WriteByte(0)
+	}
+	body.WriteByte(0)
+	msg := binary.BigEndian.AppendUint32(nil, uint32(4+body.Len()))
+	return append(msg, body.Bytes()...)
+}
+
+func pgPasswordMessage(password string) []byte {
+	msg := []byte{'p'}
+	msg = binary.BigEndian.AppendUint32(msg, uint32(4+len(password)+1))
+	msg = append(msg, password...)
+	return append(msg, 0)
+}
+
+func TestMockPostgres_CapturesCredentials(t *testing.T) {
+	sslRequest := []byte{0, 0, 0, 8, 0x04, 0xd2, 0x16, 0x2f}
+	var input []byte
+	input = append(input, sslRequest...)
+	input = append(input, pgStartupMessage("user", "postgres", "database", "billing", "application_name", "psql")...)
+	input = append(input, pgPasswordMessage("hunter2")...)
+	conn := newMockConn(input)
+
+	var captures []Capture
+	err := MockPostgres(conn, MockConfig{OnCapture: func(c Capture) { captures = append(captures, c) }})
+	if err != nil {
+		t.Fatalf("MockPostgres failed: %v", err)
+	}
+
+	resp := conn.writeData
+	if len(resp) == 0 || resp[0] != 'N' {
+		t.Fatalf("Expected SSL request to be declined, got %q", resp)
+	}
+	if !bytes.Contains(resp, pgAuthRequest(pgAuthCleartextPassword)) {
+		t.Error("Expected AuthenticationCleartextPassword request")
+	}
+	if !bytes.Contains(resp, []byte("28P01")) || !strings.Contains(string(resp), `password authentication failed for user "postgres"`) {
+		t.Errorf("Expected 28P01 auth failure, got %q", resp)
+	}
+
+	if len(captures) != 2 {
+		t.Fatalf("Expected 2 captures, got %d: %+v", len(captures), captures)
+	}
+	if captures[0].Kind != CaptureClientInfo || captures[0].Fields["application_name"] != "psql" {
+		t.Errorf("Unexpected client info capture: %+v", captures[0])
+	}
+	pw := captures[1]
+	if pw.Kind != CapturePassword || pw.Username != "postgres" || pw.Password != "hunter2" || pw.Fields["database"] != "billing" {
+		t.Errorf("Unexpected password capture: %+v", pw)
+	}
+}
+
+func TestMockPostgres_RejectsOldProtocol(t *testing.T) {
+	msg := []byte{0, 0, 0, 8, 0, 2, 0, 0}
+	conn := newMockConn(msg)
+
+	called := false
+	MockPostgres(conn, MockConfig{OnCapture: func(Capture) { called = true }})
+
+	if called {
+		t.Error("Expected no capture for protocol 2.0 startup")
+	}
+	if !bytes.Contains(conn.writeData, []byte("0A000")) {
+		t.Errorf("Expected unsupported protocol error, got %q", conn.writeData)
+	}
+}
@@ -0,0 +1,413 @@
+package mockproto
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"regexp"
+	"strings"
+	"text/template"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// Per-connection budgets used when a script does not set its own
+	defaultScriptMaxSteps      = 200
+	defaultScriptMaxDurationMs = 120000
+	// defaultScriptExpectTimeoutMs bounds one expect when it sets no timeout.
+	defaultScriptExpectTimeoutMs = 30000
+	// scriptWriteTimeout bounds one send to a client that stops reading.
+	scriptWriteTimeout = 30 * time.Second
+
+	// maxScriptBuffer caps unmatched client data held by a running script.
+	maxScriptBuffer = 64 * 1024
+)
+
+// CaptureScript is the capture kind for variables recorded by a scripted mock.
+const CaptureScript = "script"
+
+// Script is a declarative expect/send conversation for protocols no preset
+// covers. Steps run in order; a step may delay, wait for client bytes, reply
+// and then jump or close, in that order. Running off the last step closes
+// the connection. Scripts are validated when parsed (ParseScript,
+// LoadScript or YAML decoding) and run by the "script" protocol.
+type Script struct {
+	Name          string       `yaml:"name,omitempty"`
+	MaxSteps      int          `yaml:"maxSteps,omitempty"`      // Steps executed per connection (default 200)
+	MaxDurationMs int          `yaml:"maxDurationMs,omitempty"` // Connection lifetime (default 120000)
+	Steps         []ScriptStep `yaml:"steps"`
+}
+
+// ScriptStep is one step of a Script.
+type ScriptStep struct {
+	Label   string        `yaml:"label,omitempty"`   // Target for goto
+	DelayMs int           `yaml:"delayMs,omitempty"` // Sleep before the step acts
+	Expect  *ScriptExpect `yaml:"expect,omitempty"`  // Wait for client bytes; no match closes
+	Send    string        `yaml:"send,omitempty"`    // text/template; see scriptTemplateData
+	SendHex string        `yaml:"sendHex,omitempty"` // Raw bytes, hex encoded (spaces allowed)
+	DripMs  int           `yaml:"dripMs,omitempty"`  // Send byte-by-byte at this interval
+	Goto    string        `yaml:"goto,omitempty"`    // Continue at the labelled step
+	Close   bool          `yaml:"close,omitempty"`   // End the connection
+
+	send   *template.Template
+	raw    []byte
+	target int
+}
+
+// ScriptExpect matches incoming bytes by exactly one of literal, regex or
+// length. Matched bytes, and any before them, are consumed.
+type ScriptExpect struct {
+	Literal   string `yaml:"literal,omitempty"`
+	Regex     string `yaml:"regex,omitempty"`     // Go RE2 syntax, matched against buffered bytes
+	Length    int    `yaml:"length,omitempty"`    // Exactly this many bytes
+	TimeoutMs int    `yaml:"timeoutMs,omitempty"` // Default 30000
+	Capture   string `yaml:"capture,omitempty"`   // Record the first group (or the match) as this variable
+
+	re *regexp.Regexp
+}
+
+// scriptTemplateData is available to send templates.
+type scriptTemplateData struct {
+	Match    string            // Bytes matched by the last expect
+	Groups   []string          // Regex submatches of the last expect; Groups[0] is Match
+	Vars     map[string]string // Variables recorded by expect capture
+	RemoteIP string
+}
+
+// LoadScript reads and validates a YAML script file.
+func LoadScript(path string) (*Script, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read script file: %w", err)
+	}
+	s, err := ParseScript(data)
+	if err != nil {
+		return nil, fmt.Errorf("script %s: %w", path, err)
+	}
+	return s, nil
+}
+
+// ParseScript parses and validates a YAML script.
+func ParseScript(data []byte) (*Script, error) {
+	var s Script
+	if err := yaml.Unmarshal(data, &s); err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
+
+// UnmarshalYAML decodes and validates a script.
+func (s *Script) UnmarshalYAML(value *yaml.Node) error {
+	type plain Script
+	if err := value.Decode((*plain)(s)); err != nil {
+		return err
+	}
+	return s.compile()
+}
+
+// compile validates the script and prepares its regexes, templates and jumps.
+func (s *Script) compile() error {
+	if len(s.Steps) == 0 {
+		return errors.New("script has no steps")
+	}
+	if s.MaxSteps < 0 || s.MaxDurationMs < 0 {
+		return errors.New("script budgets must not be negative")
+	}
+
+	labels := make(map[string]int)
+	for i, st := range s.Steps {
+		if st.Label == "" {
+			continue
+		}
+		if _, dup := labels[st.Label]; dup {
+			return fmt.Errorf("step %d: duplicate label %q", i, st.Label)
+		}
+		labels[st.Label] = i
+	}
+
+	for i := range s.Steps {
+		st := &s.Steps[i]
+		if st.DelayMs < 0 || st.DripMs < 0 {
+			return fmt.Errorf("step %d: delays must not be negative", i)
+		}
+		if st.Expect == nil && st.Send == "" && st.SendHex == "" && st.DelayMs == 0 && st.Goto == "" && !st.Close {
+			return fmt.Errorf("step %d: nothing to do", i)
+		}
+		if st.Send != "" && st.SendHex != "" {
+			return fmt.Errorf("step %d: send and sendHex are exclusive", i)
+		}
+		if st.DripMs > 0 && st.Send == "" && st.SendHex == "" {
+			return fmt.Errorf("step %d: dripMs needs send or sendHex", i)
+		}
+		if st.Goto != "" && st.Close {
+			return fmt.Errorf("step %d: goto and close are exclusive", i)
+		}
+
+		if e := st.Expect; e != nil {
+			n := 0
+			if e.Literal != "" {
+				n++
+			}
+			if e.Regex != "" {
+				n++
+			}
+			if e.Length != 0 {
+				n++
+			}
+			if n != 1 {
+				return fmt.Errorf("step %d: expect needs exactly one of literal, regex or length", i)
+			}
+			if e.Length < 0 || e.Length > maxScriptBuffer {
+				return fmt.Errorf("step %d: expect length must be 1-%d", i, maxScriptBuffer)
+			}
+			if e.TimeoutMs < 0 {
+				return fmt.Errorf("step %d: expect timeout must not be negative", i)
+			}
+			if e.Regex != "" {
+				re, err := regexp.Compile(e.Regex)
+				if err != nil {
+					return fmt.Errorf("step %d: %w", i, err)
+				}
+				e.re = re
+			}
+		}
+
+		if st.Send != "" {
+			tmpl, err := template.New(fmt.Sprintf("step%d", i)).Option("missingkey=zero").Parse(st.Send)
+			if err != nil {
+				return fmt.Errorf("step %d: %w", i, err)
+			}
+			st.send = tmpl
+		}
+		if st.SendHex != "" {
+			raw, err := hex.DecodeString(strings.Join(strings.Fields(st.SendHex), ""))
+			if err != nil {
+				return fmt.Errorf("step %d: sendHex: %w", i, err)
+			}
+			st.raw = raw
+		}
+
+		st.target = -1
+		if st.Goto != "" {
+			target, ok := labels[st.Goto]
+			if !ok {
+				return fmt.Errorf("step %d: goto unknown label %q", i, st.Goto)
+			}
+			st.target = target
+		}
+	}
+	return nil
+}
+
+// MockScript runs config.Script against the connection. Variables recorded
+// by expect captures are reported once the conversation ends, as a password
+// capture when "password" was recorded and as a script capture otherwise.
+func MockScript(conn net.Conn, config MockConfig) error {
+	s := config.Script
+	if s == nil {
+		_, err := conn.Write([]byte("Access Denied\n"))
+		return err
+	}
+
+	maxSteps := s.MaxSteps
+	if maxSteps == 0 {
+		maxSteps = defaultScriptMaxSteps
+	}
+	maxDuration := s.MaxDurationMs
+	if maxDuration == 0 {
+		maxDuration = defaultScriptMaxDurationMs
+	}
+
+	remoteIP, _, _ := net.SplitHostPort(conn.RemoteAddr().String())
+	r := &scriptRun{
+		conn:     conn,
+		deadline: time.Now().Add(time.Duration(maxDuration) * time.Millisecond),
+		data:     scriptTemplateData{Vars: make(map[string]string), RemoteIP: remoteIP},
+	}
+	defer r.report(&config, s.Name)
+
+	pc := 0
+	for executed := 0; pc < len(s.Steps) && executed < maxSteps; executed++ {
+		st := &s.Steps[pc]
+		if st.DelayMs > 0 && !r.sleep(time.Duration(st.DelayMs)*time.Millisecond) {
+			return nil
+		}
+		if st.Expect != nil && !r.expect(st.Expect) {
+			return nil
+		}
+		if !r.send(st) {
+			return nil
+		}
+		switch {
+		case st.Close:
+			return nil
+		case st.target >= 0:
+			pc = st.target
+		default:
+			pc++
+		}
+	}
+	return nil
+}
+
+// scriptRun is the state of one MockScript connection.
+type scriptRun struct {
+	conn     net.Conn
+	buf      []byte
+	deadline time.Time
+	data     scriptTemplateData
+}
+
+// sleep waits for d, or until the duration budget runs out; it reports
+// whether the script may continue.
+func (r *scriptRun) sleep(d time.Duration) bool {
+	remaining := time.Until(r.deadline)
+	if d >= remaining {
+		time.Sleep(max(remaining, 0))
+		return false
+	}
+	time.Sleep(d)
+	return true
+}
+
+// expect reads until e matches the buffered client data.
+func (r *scriptRun) expect(e *ScriptExpect) bool {
+	timeout := e.TimeoutMs
+	if timeout == 0 {
+		timeout = defaultScriptExpectTimeoutMs
+	}
+	readDeadline := time.Now().Add(time.Duration(timeout) * time.Millisecond)
+	if r.deadline.Before(readDeadline) {
+		readDeadline = r.deadline
+	}
+	r.conn.SetReadDeadline(readDeadline)
+
+	chunk := make([]byte, 4096)
+	for {
+		if r.match(e) {
+			return true
+		}
+		if len(r.buf) >= maxScriptBuffer {
+			return false
+		}
+		n, err := r.conn.Read(chunk)
+		r.buf = append(r.buf, chunk[:n]...)
+		if err != nil {
+			return r.match(e)
+		}
+	}
+}
+
+// match consumes the buffer through the first match of e, if any.
+func (r *scriptRun) match(e *ScriptExpect) bool {
+	var start, end int
+	var groups []string
+	switch {
+	case e.Length > 0:
+		if len(r.buf) < e.Length {
+			return false
+		}
+		start, end = 0, e.Length
+	case e.re != nil:
+		loc := e.re.FindSubmatchIndex(r.buf)
+		if loc == nil {
+			return false
+		}
+		start, end = loc[0], loc[1]
+		for i := 0; i < len(loc); i += 2 {
+			if loc[i] < 0 {
+				groups = append(groups, "")
+				continue
+			}
+			groups = append(groups, string(r.buf[loc[i]:loc[i+1]]))
+		}
+	default:
+		i := bytes.Index(r.buf, []byte(e.Literal))
+		if i < 0 {
+			return false
+		}
+		start, end = i, i+len(e.Literal)
+	}
+
+	r.data.Match = string(r.buf[start:end])
+	if groups == nil {
+		groups = []string{r.data.Match}
+	}
+	r.data.Groups = groups
+	if e.Capture != "" {
+		value := r.data.Match
+		if len(groups) > 1 {
+			value = groups[1]
+		}
+		r.data.Vars[e.Capture] = value
+	}
+	r.buf = r.buf[end:]
+	return true
+}
+
+// send writes the step's reply, if any.
+func (r *scriptRun) send(st *ScriptStep) bool {
+	data := st.raw
+	if st.send != nil {
+		var b bytes.Buffer
+		if err := st.send.Execute(&b, r.data); err != nil {
+			return false
+		}
+		data = b.Bytes()
+	}
+	if len(data) == 0 {
+		return true
+	}
+
+	if st.DripMs == 0 {
+		return r.write(data)
+	}
+	interval := time.Duration(st.DripMs) * time.Millisecond
+	for i := range data {
+		if !r.write(data[i : i+1]) {
+			return false
+		}
+		if !r.sleep(interval) {
+			return false
+		}
+	}
+	return true
+}
+
+// write sends data, giving up on a client that stops reading.
+func (r *scriptRun) write(data []byte) bool {
+	deadline := time.Now().Add(scriptWriteTimeout)
+	if r.deadline.Before(deadline) {
+		deadline = r.deadline
+	}
+	r.conn.SetWriteDeadline(deadline)
+	_, err := r.conn.Write(data)
+	return err == nil
+}
+
+// report sends the recorded variables to config.OnCapture.
+func (r *scriptRun) report(config *MockConfig, name string) {
+	if len(r.data.Vars) == 0 {
+		return
+	}
+	fields := make(map[string]string, len(r.data.Vars)+1)
+	for k, v := range r.data.Vars {
+		fields[k] = v
+	}
+	if name != "" {
+		fields["script"] = name
+	}
+
+	c := Capture{Protocol: "script", Kind: CaptureScript, Fields: fields}
+	if password, ok := fields["password"]; ok {
+		c.Kind = CapturePassword
+		c.Username = fields["username"]
+		c.Password = password
+	}
+	config.capture(c)
+}
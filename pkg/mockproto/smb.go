@@ -0,0 +1,499 @@
+package mockproto
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf16"
+)
+
+const (
+	// Defaults for the identity revealed by MockSMB
+	defaultSMBDialect   = "3.1.1"
+	defaultSMBOSVersion = "10.0.17763" // Windows Server 2019
+	defaultSMBHostname  = "FILESRV01"
+
+	// maxSMBMessageSize caps a NetBIOS session message.
+	maxSMBMessageSize = 64 * 1024
+	// maxSMBMessages bounds the requests served on one connection.
+	maxSMBMessages = 20
+
+	smb2HeaderSize = 64
+
+	smb2Negotiate    = 0x0000
+	smb2SessionSetup = 0x0001
+
+	smbStatusMoreProcessing   = 0xC0000016
+	smbStatusLogonFailure     = 0xC000006D
+	smbStatusNotSupported     = 0xC00000BB
+	smbStatusSessionDeleted   = 0xC0000203
+	smbStatusInvalidParameter = 0xC000000D
+
+	smb2DialectWildcard = 0x02FF
+)
+
+// smbDialects maps dialect names accepted in MockConfig.SMBDialect to revisions.
+var smbDialects = map[string]uint16{
+	"2.0.2": 0x0202,
+	"2.1":   0x0210,
+	"3.0":   0x0300,
+	"3.0.2": 0x0302,
+	"3.1.1": 0x0311,
+}
+
+var (
+	oidSPNEGO  = []byte{0x2b, 0x06, 0x01, 0x05, 0x05, 0x02}
+	oidNTLMSSP = []byte{0x2b, 0x06, 0x01, 0x04, 0x01, 0x82, 0x37, 0x02, 0x02, 0x0a}
+)
+
+// MockSMB emulates the SMB2 negotiate and NTLM session setup of a Windows
+// file server. The negotiate response reveals MockConfig.SMBDialect, and the
+// NTLM challenge reveals SMBOSVersion and SMBHostname, which is what
+// scanners fingerprint. The client's offered dialects and the NTLM
+// authenticate message (user, domain, workstation and a crackable NetNTLM
+// hash) are captured; logon always fails. SMB1-only clients get no common
+// dialect. In tarpit mode every reply is delayed, increasingly.
+func MockSMB(conn net.Conn, config MockConfig) error {
+	var t *Tarpit
+	if config.Tarpit {
+		t = NewTarpit(1000, 1000, 15000)
+	}
+	s := newSMBSession(&config)
+
+	for i := 0; i < maxSMBMessages; i++ {
+		conn.SetReadDeadline(time.Now().Add(30 * time.Second))
+		var hdr [4]byte
+		if _, err := io.ReadFull(conn, hdr[:]); err != nil {
+			return nil
+		}
+		length := int(hdr[1])<<16 | int(hdr[2])<<8 | int(hdr[3])
+		if length > maxSMBMessageSize {
+			return nil
+		}
+		msg := make([]byte, length)
+		if _, err := io.ReadFull(conn, msg); err != nil {
+			return nil
+		}
+
+		var reply []byte
+		switch {
+		case hdr[0] == 0x81:
+			// NetBIOS session request (port 139): positive response
+			reply = []byte{0x82, 0, 0, 0}
+		case hdr[0] != 0:
+			return nil
+		case len(msg) >= 4 && bytes.Equal(msg[:4], []byte("\xffSMB")):
+			reply = smbFrame(s.negotiateSMB1(msg))
+		case len(msg) >= smb2HeaderSize && bytes.Equal(msg[:4], []byte("\xfeSMB")):
+			reply = smbFrame(s.handleSMB2(msg))
+		default:
+			return nil
+		}
+
+		if t != nil {
+			t.Sleep()
+		} else if config.RandomDelay {
+			RandomDelay(100, 1000)
+		}
+		if _, err := conn.Write(reply); err != nil {
+			return nil
+		}
+	}
+	return nil
+}
+
+// smbSession is the state of one MockSMB connection.
+type smbSession struct {
+	config    *MockConfig
+	dialect   uint16
+	osMajor   byte
+	osMinor   byte
+	osBuild   uint16
+	hostname  string
+	serverID  [16]byte
+	sessionID uint64
+	challenge [8]byte
+}
+
+func newSMBSession(config *MockConfig) *smbSession {
+	s := &smbSession{config: config, hostname: strings.ToUpper(config.SMBHostname)}
+	if s.hostname == "" {
+		s.hostname = defaultSMBHostname
+	}
+	var ok bool
+	if s.dialect, ok = smbDialects[config.SMBDialect]; !ok {
+		s.dialect = smbDialects[defaultSMBDialect]
+	}
+	if !s.parseOSVersion(config.SMBOSVersion) {
+		s.parseOSVersion(defaultSMBOSVersion)
+	}
+	rand.Read(s.serverID[:])
+	rand.Read(s.challenge[:])
+	var id [8]byte
+	rand.Read(id[:])
+	s.sessionID = binary.LittleEndian.Uint64(id[:]) | 1
+	return s
+}
+
+// parseOSVersion parses "major.minor.build".
+func (s *smbSession) parseOSVersion(v string) bool {
+	parts := strings.Split(v, ".")
+	if len(parts) != 3 {
+		return false
+	}
+	major, err1 := strconv.ParseUint(parts[0], 10, 8)
+	minor, err2 := strconv.ParseUint(parts[1], 10, 8)
+	build, err3 := strconv.ParseUint(parts[2], 10, 16)
+	if err1 != nil || err2 != nil || err3 != nil {
+		return false
+	}
+	s.osMajor, s.osMinor, s.osBuild = byte(major), byte(minor), uint16(build)
+	return true
+}
+
+// negotiateSMB1 answers an SMB1 NEGOTIATE. Clients offering SMB2 get an SMB2
+// negotiate response (multi-protocol negotiate); others get no common dialect.
+func (s *smbSession) negotiateSMB1(msg []byte) []byte {
+	var dialects []string
+	if len(msg) > 35 {
+		for _, d := range bytes.Split(msg[35:], []byte{0}) {
+			if len(d) > 1 && d[0] == 0x02 {
+				dialects = append(dialects, string(d[1:]))
+			}
+		}
+	}
+	s.config.capture(Capture{Protocol: "smb", Kind: CaptureClientInfo, Fields: map[string]string{
+		"smb1_dialects": strings.Join(dialects, ","),
+	}})
+
+	for _, d := range dialects {
+		if d == "SMB 2.???" || d == "SMB 2.002" {
+			dialect := uint16(smb2DialectWildcard)
+			if d == "SMB 2.002" && !containsString(dialects, "SMB 2.???") {
+				dialect = 0x0202
+			}
+			hdr := make([]byte, smb2HeaderSize)
+			copy(hdr, "\xfeSMB")
+			binary.LittleEndian.PutUint16(hdr[4:], smb2HeaderSize)
+			return s.smb2Response(hdr, smb2Negotiate, 0, s.negotiateResponse(dialect))
+		}
+	}
+
+	// SMB1 header with the reply flag set, WordCount 1, DialectIndex 0xFFFF
+	reply := make([]byte, 32, 37)
+	copy(reply, msg[:min(len(msg), 32)])
+	reply[4] = 0x72
+	reply[9] |= 0x80
+	return append(reply, 1, 0xff, 0xff, 0, 0)
+}
+
+func containsString(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
+
+// handleSMB2 answers one SMB2 request.
+func (s *smbSession) handleSMB2(msg []byte) []byte {
+	command := binary.LittleEndian.Uint16(msg[12:])
+	body := msg[smb2HeaderSize:]
+
+	switch command {
+	case smb2Negotiate:
+		if len(body) < 36 {
+			return s.smb2Error(msg, smbStatusInvalidParameter)
+		}
+		count := int(binary.LittleEndian.Uint16(body[2:]))
+		offered := make([]string, 0, count)
+		var best uint16
+		for i := 0; i < count && 36+2*i+2 <= len(body); i++ {
+			d := binary.LittleEndian.Uint16(body[36+2*i:])
+			offered = append(offered, fmt.Sprintf("0x%04x", d))
+			if d <= s.dialect && d > best {
+				best = d
+			}
+		}
+		s.config.capture(Capture{Protocol: "smb", Kind: CaptureClientInfo, Fields: map[string]string{
+			"dialects":    strings.Join(offered, ","),
+			"client_guid": hex.EncodeToString(body[12:28]),
+		}})
+		if best == 0 {
+			return s.smb2Error(msg, smbStatusNotSupported)
+		}
+		return s.smb2Response(msg, smb2Negotiate, 0, s.negotiateResponse(best))
+
+	case smb2SessionSetup:
+		if len(body) < 24 {
+			return s.smb2Error(msg, smbStatusInvalidParameter)
+		}
+		off := int(binary.LittleEndian.Uint16(body[12:])) - smb2HeaderSize
+		n := int(binary.LittleEndian.Uint16(body[14:]))
+		if off < 0 || off+n > len(body) {
+			return s.smb2Error(msg, smbStatusInvalidParameter)
+		}
+		token := body[off : off+n]
+		i := bytes.Index(token, []byte("NTLMSSP\x00"))
+		if i < 0 || len(token) < i+12 {
+			return s.smb2Error(msg, smbStatusLogonFailure)
+		}
+		ntlm := token[i:]
+		switch binary.LittleEndian.Uint32(ntlm[8:]) {
+		case 1: // NEGOTIATE
+			challenge := s.ntlmChallenge()
+			if len(token) > 0 && (token[0] == 0x60 || token[0] == 0xa1) {
+				challenge = spnegoResponse(challenge)
+			}
+			resp := make([]byte, 8, 8+len(challenge))
+			binary.LittleEndian.PutUint16(resp[0:], 9)
+			binary.LittleEndian.PutUint16(resp[4:], smb2HeaderSize+8)
+			binary.LittleEndian.PutUint16(resp[6:], uint16(len(challenge)))
+			return s.smb2Response(msg, smb2SessionSetup, smbStatusMoreProcessing, append(resp, challenge...))
+		case 3: // AUTHENTICATE
+			s.captureNTLM(ntlm)
+		}
+		return s.smb2Error(msg, smbStatusLogonFailure)
+
+	default:
+		return s.smb2Error(msg, smbStatusSessionDeleted)
+	}
+}
+
+// negotiateResponse builds the body of an SMB2 NEGOTIATE response.
+func (s *smbSession) negotiateResponse(dialect uint16) []byte {
+	secBlob := spnegoInit()
+	now := filetime(time.Now())
+
+	body := make([]byte, 64)
+	binary.LittleEndian.PutUint16(body[0:], 65)
+	binary.LittleEndian.PutUint16(body[2:], 0x01) // signing enabled
+	binary.LittleEndian.PutUint16(body[4:], dialect)
+	copy(body[8:], s.serverID[:])
+	binary.LittleEndian.PutUint32(body[24:], 0x2f) // DFS, leasing, large MTU, multi channel, persistent handles
+	binary.LittleEndian.PutUint32(body[28:], 8*1024*1024)
+	binary.LittleEndian.PutUint32(body[32:], 8*1024*1024)
+	binary.LittleEndian.PutUint32(body[36:], 8*1024*1024)
+	binary.LittleEndian.PutUint64(body[40:], now)
+	binary.LittleEndian.PutUint16(body[56:], smb2HeaderSize+64)
+	binary.LittleEndian.PutUint16(body[58:], uint16(len(secBlob)))
+	body = append(body, secBlob...)
+
+	if dialect == 0x0311 {
+		// Preauth integrity (SHA-512) and encryption (AES-128-GCM) contexts
+		for len(body)%8 != 0 {
+			body = append(body, 0)
+		}
+		binary.LittleEndian.PutUint16(body[6:], 2)
+		binary.LittleEndian.PutUint32(body[60:], uint32(smb2HeaderSize+len(body)))
+
+		salt := make([]byte, 32)
+		rand.Read(salt)
+		preauth := []byte{1, 0, 32, 0, 1, 0}
+		body = appendNegotiateContext(body, 1, append(preauth, salt...))
+		for len(body)%8 != 0 {
+			body = append(body, 0)
+		}
+		body = appendNegotiateContext(body, 2, []byte{1, 0, 2, 0})
+	}
+	return body
+}
+
+func appendNegotiateContext(buf []byte, typ uint16, data []byte) []byte {
+	buf = binary.LittleEndian.AppendUint16(buf, typ)
+	buf = binary.LittleEndian.AppendUint16(buf, uint16(len(data)))
+	buf = append(buf, 0, 0, 0, 0)
+	return append(buf, data...)
+}
+
+// smb2Response builds an SMB2 response header for req followed by body.
+func (s *smbSession) smb2Response(req []byte, command uint16, status uint32, body []byte) []byte {
+	hdr := make([]byte, smb2HeaderSize)
+	copy(hdr, "\xfeSMB")
+	binary.LittleEndian.PutUint16(hdr[4:], smb2HeaderSize)
+	binary.LittleEndian.PutUint32(hdr[8:], status)
+	binary.LittleEndian.PutUint16(hdr[12:], command)
+	binary.LittleEndian.PutUint16(hdr[14:], 1)    // credits granted
+	binary.LittleEndian.PutUint32(hdr[16:], 0x01) // SERVER_TO_REDIR
+	copy(hdr[24:32], req[24:32])                  // MessageId
+	if command == smb2SessionSetup {
+		binary.LittleEndian.PutUint64(hdr[40:], s.sessionID)
+	}
+	return append(hdr, body...)
+}
+
+// smb2Error builds an SMB2 ERROR response.
+func (s *smbSession) smb2Error(req []byte, status uint32) []byte {
+	body := make([]byte, 9)
+	binary.LittleEndian.PutUint16(body, 9)
+	return s.smb2Response(req, binary.LittleEndian.Uint16(req[12:]), status, body)
+}
+
+// smbFrame prefixes msg with a NetBIOS session message header.
+func smbFrame(msg []byte) []byte {
+	n := len(msg)
+	return append([]byte{0, byte(n >> 16), byte(n >> 8), byte(n)}, msg...)
+}
+
+// NTLM negotiate flags used in the challenge
+const (
+	ntlmNegotiateUnicode  = 0x00000001
+	ntlmRequestTarget     = 0x00000004
+	ntlmNegotiateNTLM     = 0x00000200
+	ntlmAlwaysSign        = 0x00008000
+	ntlmTargetTypeServer  = 0x00020000
+	ntlmExtendedSecurity  = 0x00080000
+	ntlmNegotiateTarget   = 0x00800000
+	ntlmNegotiateVersion  = 0x02000000
+	ntlmNegotiate128      = 0x20000000
+	ntlmNegotiateKeyExch  = 0x40000000
+	ntlmNegotiate56       = 0x80000000
+	ntlmChallengeFlags    = ntlmNegotiateUnicode | ntlmRequestTarget | ntlmNegotiateNTLM | ntlmAlwaysSign | ntlmTargetTypeServer | ntlmExtendedSecurity | ntlmNegotiateTarget | ntlmNegotiateVersion | ntlmNegotiate128 | ntlmNegotiateKeyExch | ntlmNegotiate56
+	ntlmChallengeHeader   = 56
+	ntlmRevisionCurrent   = 15
+	ntlmAvEOL             = 0
+	ntlmAvNbComputerName  = 1
+	ntlmAvNbDomainName    = 2
+	ntlmAvDNSComputerName = 3
+	ntlmAvDNSDomainName   = 4
+	ntlmAvTimestamp       = 7
+)
+
+// ntlmChallenge builds an NTLM CHALLENGE message naming the fake host.
+func (s *smbSession) ntlmChallenge() []byte {
+	name := utf16le(s.hostname)
+	dnsName := utf16le(strings.ToLower(s.hostname))
+
+	var info []byte
+	for _, av := range []struct {
+		id    uint16
+		value []byte
+	}{
+		{ntlmAvNbDomainName, name},
+		{ntlmAvNbComputerName, name},
+		{ntlmAvDNSDomainName, dnsName},
+		{ntlmAvDNSComputerName, dnsName},
+		{ntlmAvTimestamp, binary.LittleEndian.AppendUint64(nil, filetime(time.Now()))},
+		{ntlmAvEOL, nil},
+	} {
+		info = binary.LittleEndian.AppendUint16(info, av.id)
+		info = binary.LittleEndian.AppendUint16(info, uint16(len(av.value)))
+		info = append(info, av.value...)
+	}
+
+	msg := make([]byte, ntlmChallengeHeader)
+	copy(msg, "NTLMSSP\x00")
+	binary.LittleEndian.PutUint32(msg[8:], 2)
+	putNTLMField(msg[12:], len(name), ntlmChallengeHeader)
+	binary.LittleEndian.PutUint32(msg[20:], ntlmChallengeFlags)
+	copy(msg[24:32], s.challenge[:])
+	putNTLMField(msg[40:], len(info), ntlmChallengeHeader+len(name))
+	msg[48], msg[49] = s.osMajor, s.osMinor
+	binary.LittleEndian.PutUint16(msg[50:], s.osBuild)
+	msg[55] = ntlmRevisionCurrent
+	msg = append(msg, name...)
+	return append(msg, info...)
+}
+
+// captureNTLM reports an NTLM AUTHENTICATE message, including the hash in
+// hashcat NetNTLMv1/v2 format.
+func (s *smbSession) captureNTLM(msg []byte) {
+	lm := ntlmField(msg, 12)
+	nt := ntlmField(msg, 20)
+	domain := utf16Decode(ntlmField(msg, 28))
+	user := utf16Decode(ntlmField(msg, 36))
+	workstation := utf16Decode(ntlmField(msg, 44))
+	if user == "" {
+		// Anonymous / null session
+		return
+	}
+
+	fields := map[string]string{"domain": domain, "workstation": workstation}
+	challenge := hex.EncodeToString(s.challenge[:])
+	switch {
+	case len(nt) > 24:
+		fields["netntlmv2"] = fmt.Sprintf("%s::%s:%s:%s:%s", user, domain, challenge, hex.EncodeToString(nt[:16]), hex.EncodeToString(nt[16:]))
+	case len(nt) == 24:
+		fields["netntlmv1"] = fmt.Sprintf("%s::%s:%s:%s:%s", user, domain, hex.EncodeToString(lm), hex.EncodeToString(nt), challenge)
+	}
+	s.config.capture(Capture{Protocol: "smb", Kind: CaptureLogin, Username: user, Fields: fields})
+}
+
+// ntlmField returns the payload referenced by the len/maxlen/offset field at i.
+func ntlmField(msg []byte, i int) []byte {
+	if len(msg) < i+8 {
+		return nil
+	}
+	n := int(binary.LittleEndian.Uint16(msg[i:]))
+	off := int(binary.LittleEndian.Uint32(msg[i+4:]))
+	if off < 0 || off+n > len(msg) {
+		return nil
+	}
+	return msg[off : off+n]
+}
+
+func putNTLMField(b []byte, n, offset int) {
+	binary.LittleEndian.PutUint16(b[0:], uint16(n))
+	binary.LittleEndian.PutUint16(b[2:], uint16(n))
+	binary.LittleEndian.PutUint32(b[4:], uint32(offset))
+}
+
+func utf16le(s string) []byte {
+	var b []byte
+	for _, r := range utf16.Encode([]rune(s)) {
+		b = binary.LittleEndian.AppendUint16(b, r)
+	}
+	return b
+}
+
+func utf16Decode(b []byte) string {
+	u := make([]uint16, len(b)/2)
+	for i := range u {
+		u[i] = binary.LittleEndian.Uint16(b[2*i:])
+	}
+	return string(utf16.Decode(u))
+}
+
+// filetime converts t to a Windows FILETIME (100ns intervals since 1601).
+func filetime(t time.Time) uint64 {
+	return uint64(t.UnixNano()/100) + 116444736000000000
+}
+
+// spnegoInit builds the SPNEGO negTokenInit advertising NTLMSSP.
+func spnegoInit() []byte {
+	mechTypes := derTLV(0x30, derTLV(0x06, oidNTLMSSP))
+	negTokenInit := derTLV(0xa0, derTLV(0x30, derTLV(0xa0, mechTypes)))
+	return derTLV(0x60, append(derTLV(0x06, oidSPNEGO), negTokenInit...))
+}
+
+// spnegoResponse wraps an NTLM challenge in an accept-incomplete negTokenResp.
+func spnegoResponse(ntlm []byte) []byte {
+	var seq []byte
+	seq = append(seq, derTLV(0xa0, derTLV(0x0a, []byte{1}))...)
+	seq = append(seq, derTLV(0xa1, derTLV(0x06, oidNTLMSSP))...)
+	seq = append(seq, derTLV(0xa2, derTLV(0x04, ntlm))...)
+	return derTLV(0xa1, derTLV(0x30, seq))
+}
+
+// derTLV encodes a DER tag-length-value with a definite length.
+func derTLV(tag byte, value []byte) []byte {
+	n := len(value)
+	out := []byte{tag}
+	switch {
+	case n < 0x80:
+		out = append(out, byte(n))
+	case n < 0x100:
+		out = append(out, 0x81, byte(n))
+	default:
+		out = append(out, 0x82, byte(n>>8), byte(n))
+	}
+	return append(out, value...)
+}
@@ -0,0 +1,157 @@
+package mockproto
+
+import (
+	"bytes"
+	"encoding/binary"
+	"strings"
+	"testing"
+)
+
+// smb2Request builds a NetBIOS-framed SMB2 request.
+func smb2Request(command uint16, messageID uint64, body []byte) []byte {
+	hdr := make([]byte, smb2HeaderSize)
+	copy(hdr, "\xfeSMB")
+	binary.LittleEndian.PutUint16(hdr[4:], smb2HeaderSize)
+	binary.LittleEndian.PutUint16(hdr[12:], command)
+	binary.LittleEndian.PutUint64(hdr[24:], messageID)
+	return smbFrame(append(hdr, body...))
+}
+
+func smb2SessionSetupRequest(token []byte) []byte {
+	body := make([]byte, 24)
+	binary.LittleEndian.PutUint16(body[0:], 25)
+	binary.LittleEndian.PutUint16(body[12:], smb2HeaderSize+24)
+	binary.LittleEndian.PutUint16(body[14:], uint16(len(token)))
+	return append(body, token...)
+}
+
+// ntlmAuthenticate builds an NTLM AUTHENTICATE message.
+func ntlmAuthenticate(domain, user, workstation string, nt []byte) []byte {
+	msg := make([]byte, 64)
+	copy(msg, "NTLMSSP\x00")
+	binary.LittleEndian.PutUint32(msg[8:], 3)
+	for _, f := range []struct {
+		at    int
+		value []byte
+	}{
+		{12, nil},
+		{20, nt},
+		{28, utf16le(domain)},
+		{36, utf16le(user)},
+		{44, utf16le(workstation)},
+	} {
+		putNTLMField(msg[f.at:], len(f.value), len(msg))
+		msg = append(msg, f.value...)
+	}
+	return msg
+}
+
+// readSMB2Responses splits written data into SMB2 messages.
+func readSMB2Responses(t *testing.T, data []byte) [][]byte {
+	t.Helper()
+	var msgs [][]byte
+	for len(data) >= 4 {
+		n := int(data[1])<<16 | int(data[2])<<8 | int(data[3])
+		if len(data) < 4+n || n < smb2HeaderSize {
+			t.Fatalf("Truncated SMB2 response")
+		}
+		msgs = append(msgs, data[4:4+n])
+		data = data[4+n:]
+	}
+	return msgs
+}
+
+func TestMockSMB_NegotiateAndNTLM(t *testing.T) {
+	negotiate := make([]byte, 36)
+	binary.LittleEndian.PutUint16(negotiate[0:], 36)
+	binary.LittleEndian.PutUint16(negotiate[2:], 5)
+	for _, d := range []uint16{0x0202, 0x0210, 0x0300, 0x0302, 0x0311} {
+		negotiate = binary.LittleEndian.AppendUint16(negotiate, d)
+	}
+	ntlmNegotiate := append([]byte("NTLMSSP\x00"), 1, 0, 0, 0, 0x07, 0x82, 0x08, 0xa2)
+	spnego := append([]byte{0x60, 0x28}, ntlmNegotiate...)
+	ntResponse := bytes.Repeat([]byte{0x11}, 48)
+
+	var input []byte
+	input = append(input, smb2Request(smb2Negotiate, 0, negotiate)...)
+	input = append(input, smb2Request(smb2SessionSetup, 1, smb2SessionSetupRequest(spnego))...)
+	input = append(input, smb2Request(smb2SessionSetup, 2, smb2SessionSetupRequest(ntlmAuthenticate("WORKGROUP", "administrator", "KALI", ntResponse)))...)
+	conn := newMockConn(input)
+
+	var captures []Capture
+	config := MockConfig{
+		SMBDialect:   "3.0.2",
+		SMBOSVersion: "6.1.7601",
+		SMBHostname:  "nas01",
+		OnCapture:    func(c Capture) { captures = append(captures, c) },
+	}
+	if err := MockSMB(conn, config); err != nil {
+		t.Fatalf("MockSMB failed: %v", err)
+	}
+
+	msgs := readSMB2Responses(t, conn.writeData)
+	if len(msgs) != 3 {
+		t.Fatalf("Expected 3 responses, got %d", len(msgs))
+	}
+
+	neg := msgs[0]
+	if dialect := binary.LittleEndian.Uint16(neg[smb2HeaderSize+4:]); dialect != 0x0302 {
+		t.Errorf("Expected dialect 0x0302, got 0x%04x", dialect)
+	}
+
+	setup := msgs[1]
+	if status := binary.LittleEndian.Uint32(setup[8:]); status != smbStatusMoreProcessing {
+		t.Fatalf("Expected STATUS_MORE_PROCESSING_REQUIRED, got 0x%08x", status)
+	}
+	if setup[smb2HeaderSize+8] != 0xa1 {
+		t.Error("Expected SPNEGO-wrapped challenge")
+	}
+	i := bytes.Index(setup, []byte("NTLMSSP\x00\x02\x00\x00\x00"))
+	if i < 0 {
+		t.Fatal("Expected NTLM challenge")
+	}
+	challenge := setup[i:]
+	if challenge[48] != 6 || challenge[49] != 1 || binary.LittleEndian.Uint16(challenge[50:]) != 7601 {
+		t.Errorf("Expected OS version 6.1.7601, got %v", challenge[48:52])
+	}
+	if !bytes.Contains(challenge, utf16le("NAS01")) {
+		t.Error("Expected hostname in challenge")
+	}
+
+	if status := binary.LittleEndian.Uint32(msgs[2][8:]); status != smbStatusLogonFailure {
+		t.Errorf("Expected STATUS_LOGON_FAILURE, got 0x%08x", status)
+	}
+
+	var login *Capture
+	for i := range captures {
+		if captures[i].Kind == CaptureLogin {
+			login = &captures[i]
+		}
+	}
+	if login == nil {
+		t.Fatalf("Expected login capture, got %+v", captures)
+	}
+	if login.Username != "administrator" || login.Fields["domain"] != "WORKGROUP" || login.Fields["workstation"] != "KALI" {
+		t.Errorf("Unexpected login capture: %+v", login)
+	}
+	if !strings.HasPrefix(login.Fields["netntlmv2"], "administrator::WORKGROUP:") {
+		t.Errorf("Unexpected NetNTLMv2 hash: %q", login.Fields["netntlmv2"])
+	}
+}
+
+func TestMockSMB_SMB1Only(t *testing.T) {
+	req := make([]byte, 35)
+	copy(req, "\xffSMB\x72")
+	req = append(req, "\x02NT LM 0.12\x00"...)
+	conn := newMockConn(smbFrame(req))
+
+	MockSMB(conn, MockConfig{})
+
+	resp := conn.writeData
+	if len(resp) < 4+37 || !bytes.Equal(resp[4:8], []byte("\xffSMB")) {
+		t.Fatalf("Expected SMB1 response, got %x", resp)
+	}
+	if binary.LittleEndian.Uint16(resp[4+33:]) != 0xffff {
+		t.Errorf("Expected no common dialect, got %x", resp[4+32:])
+	}
+}
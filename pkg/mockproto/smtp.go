@@ -6,6 +6,8 @@ import (
 	"time"
 )
 
+const defaultSMTPGreeting = "220 mail.example.com ESMTP Postfix (Ubuntu)\r\n"
+
 // sanitizeSMTPResponse removes CR/LF and other control characters from input
 // to prevent SMTP response injection attacks
 func sanitizeSMTPResponse(s string) string {
@@ -29,7 +31,10 @@ func sanitizeSMTPResponse(s string) string {
 // In tarpit mode, it pretends to accept everything but processes nothing.
 func MockSMTP(conn net.Conn, config MockConfig) error {
 	// Send banner (slow drip in tarpit mode)
-	banner := "220 mail.example.com ESMTP Postfix (Ubuntu)\r\n"
+	banner := defaultSMTPGreeting
+	if config.SMTPGreeting != "" {
+		banner = config.SMTPGreeting
+	}
 	if config.Tarpit || config.DripBanner {
 		interval := config.DripIntervalMs
 		if interval == 0 {
@@ -9,18 +9,24 @@ import (
 // MockSSH implements an SSH tarpit inspired by endlessh.
 // In tarpit mode, it sends an endless stream of random banner lines,
 // one byte at a time, keeping the connection open forever.
+// With CompleteKex, it runs a real handshake and captures auth attempts.
 func MockSSH(conn net.Conn, config MockConfig) error {
 	// Tarpit mode: endless random banner lines (endlessh style)
 	if config.Tarpit {
 		return sshTarpit(conn, config.DripIntervalMs)
 	}
 
+	// Interactive mode: real key exchange, credential capture
+	if config.CompleteKex {
+		return mockSSHInteractive(conn, config)
+	}
+
 	// Normal mode: send banner and optionally hold
 	var banner []byte
 	if len(config.Payload) > 0 {
 		banner = config.Payload
 	} else {
-		banner = []byte("SSH-2.0-OpenSSH_8.2p1 Ubuntu-4ubuntu0.5\r\n")
+		banner = []byte(defaultSSHBanner)
 	}
 
 	if config.DripBanner {
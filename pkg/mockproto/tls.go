@@ -0,0 +1,494 @@
+package mockproto
+
+import (
+	"bytes"
+	"crypto/md5"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/crypto/cryptobyte"
+)
+
+// TLSConfig makes a mock perform a TLS handshake before speaking its
+// protocol, e.g. an HTTPS or SMTPS honeypot. The certificate is a
+// generated self-signed one, as found on appliances and fresh installs.
+type TLSConfig struct {
+	CommonName   string   // Subject CN (default: the client's SNI, else "localhost")
+	Organization string   // Subject O (optional)
+	SANs         []string // DNS names and IP addresses (default: CommonName)
+}
+
+const (
+	tlsHandshakeTimeout = 10 * time.Second
+	maxClientHelloSize  = 64 * 1024
+	maxTLSCerts         = 256 // certificate cache size; reset when full
+
+	tlsRecordHandshake  = 22
+	tlsMsgClientHello   = 1
+	tlsExtServerName    = 0x0000
+	tlsExtCurves        = 0x000a
+	tlsExtPointFormats  = 0x000b
+	tlsExtSigAlgs       = 0x000d
+	tlsExtALPN          = 0x0010
+	tlsExtVersions      = 0x002b
+	maxTLSRecordPayload = 16384 + 2048
+)
+
+// serveTLS reads the client's ClientHello, reports its fingerprint,
+// completes the handshake and runs the protocol mock inside the session.
+func serveTLS(conn net.Conn, config MockConfig) error {
+	conn.SetReadDeadline(time.Now().Add(tlsHandshakeTimeout))
+	raw, hello, err := readClientHello(conn)
+	if err != nil {
+		return err
+	}
+	// Reported before the handshake: scanners often abort on the certificate
+	config.capture(Capture{Protocol: config.Protocol, Kind: CaptureClientInfo, Fields: hello.fields()})
+
+	cert, err := mockCertificate(config.TLS, hello.serverName)
+	if err != nil {
+		return err
+	}
+	tlsConfig := &tls.Config{
+		Certificates: []tls.Certificate{*cert},
+		MinVersion:   tls.VersionTLS10,
+	}
+	if config.Protocol == "http" {
+		tlsConfig.NextProtos = []string{"http/1.1"}
+	}
+	tlsConn := tls.Server(&replayConn{Conn: conn, r: io.MultiReader(bytes.NewReader(raw), conn)}, tlsConfig)
+	if err := tlsConn.Handshake(); err != nil {
+		return err
+	}
+	conn.SetReadDeadline(time.Time{})
+
+	config.TLS = nil
+	return HandleConnection(tlsConn, config)
+}
+
+// replayConn serves reads from r, which starts with bytes already read
+// from the connection.
+type replayConn struct {
+	net.Conn
+	r io.Reader
+}
+
+func (c *replayConn) Read(p []byte) (int, error) { return c.r.Read(p) }
+
+// clientHello holds the ClientHello parameters used for fingerprinting,
+// in the order the client sent them.
+type clientHello struct {
+	version      uint16 // legacy_version
+	ciphers      []uint16
+	extensions   []uint16
+	curves       []uint16
+	pointFormats []uint8
+	sigAlgs      []uint16
+	versions     []uint16 // supported_versions
+	serverName   string
+	alpn         []string
+}
+
+// readClientHello reads TLS records until a complete ClientHello has
+// arrived. It returns the raw records, to be replayed to the TLS server,
+// and the parsed message.
+func readClientHello(r io.Reader) ([]byte, *clientHello, error) {
+	var raw, msg []byte
+	for {
+		var header [5]byte
+		if _, err := io.ReadFull(r, header[:]); err != nil {
+			return nil, nil, err
+		}
+		if header[0] != tlsRecordHandshake || header[1] != 3 {
+			return nil, nil, errors.New("not a TLS handshake")
+		}
+		n := int(header[3])<<8 | int(header[4])
+		if n == 0 || n > maxTLSRecordPayload || len(raw)+n > maxClientHelloSize {
+			return nil, nil, errors.New("bad TLS record length")
+		}
+		body := make([]byte, n)
+		if _, err := io.ReadFull(r, body); err != nil {
+			return nil, nil, err
+		}
+		raw = append(append(raw, header[:]...), body...)
+		msg = append(msg, body...)
+
+		if len(msg) < 4 {
+			continue
+		}
+		if msg[0] != tlsMsgClientHello {
+			return nil, nil, errors.New("expected ClientHello")
+		}
+		if msgLen := int(msg[1])<<16 | int(msg[2])<<8 | int(msg[3]); len(msg) >= 4+msgLen {
+			hello, err := parseClientHello(msg[4 : 4+msgLen])
+			return raw, hello, err
+		}
+	}
+}
+
+func parseClientHello(body []byte) (*clientHello, error) {
+	hello := &clientHello{}
+	s := cryptobyte.String(body)
+	var random, sessionID, cipherSuites, compression cryptobyte.String
+	if !s.ReadUint16(&hello.version) || !s.ReadBytes((*[]byte)(&random), 32) ||
+		!s.ReadUint8LengthPrefixed(&sessionID) || !s.ReadUint16LengthPrefixed(&cipherSuites) ||
+		!s.ReadUint8LengthPrefixed(&compression) {
+		return nil, errors.New("malformed ClientHello")
+	}
+	for !cipherSuites.Empty() {
+		var c uint16
+		if !cipherSuites.ReadUint16(&c) {
+			return nil, errors.New("malformed cipher suites")
+		}
+		hello.ciphers = append(hello.ciphers, c)
+	}
+	if s.Empty() {
+		return hello, nil // no extensions (SSL 3.0 style)
+	}
+
+	var exts cryptobyte.String
+	if !s.ReadUint16LengthPrefixed(&exts) {
+		return nil, errors.New("malformed extensions")
+	}
+	for !exts.Empty() {
+		var typ uint16
+		var data cryptobyte.String
+		if !exts.ReadUint16(&typ) || !exts.ReadUint16LengthPrefixed(&data) {
+			return nil, errors.New("malformed extension")
+		}
+		hello.extensions = append(hello.extensions, typ)
+
+		switch typ {
+		case tlsExtServerName:
+			var names cryptobyte.String
+			if data.ReadUint16LengthPrefixed(&names) {
+				for !names.Empty() {
+					var nameType uint8
+					var name cryptobyte.String
+					if !names.ReadUint8(&nameType) || !names.ReadUint16LengthPrefixed(&name) {
+						break
+					}
+					if nameType == 0 {
+						hello.serverName = string(name)
+					}
+				}
+			}
+		case tlsExtCurves:
+			var list cryptobyte.String
+			if data.ReadUint16LengthPrefixed(&list) {
+				hello.curves = readUint16s(list)
+			}
+		case tlsExtPointFormats:
+			var list cryptobyte.String
+			if data.ReadUint8LengthPrefixed(&list) {
+				hello.pointFormats = []uint8(list)
+			}
+		case tlsExtSigAlgs:
+			var list cryptobyte.String
+			if data.ReadUint16LengthPrefixed(&list) {
+				hello.sigAlgs = readUint16s(list)
+			}
+		case tlsExtALPN:
+			var list cryptobyte.String
+			if data.ReadUint16LengthPrefixed(&list) {
+				for !list.Empty() {
+					var proto cryptobyte.String
+					if !list.ReadUint8LengthPrefixed(&proto) {
+						break
+					}
+					hello.alpn = append(hello.alpn, string(proto))
+				}
+			}
+		case tlsExtVersions:
+			var list cryptobyte.String
+			if data.ReadUint8LengthPrefixed(&list) {
+				hello.versions = readUint16s(list)
+			}
+		}
+	}
+	return hello, nil
+}
+
+func readUint16s(s cryptobyte.String) []uint16 {
+	var values []uint16
+	for !s.Empty() {
+		var v uint16
+		if !s.ReadUint16(&v) {
+			break
+		}
+		values = append(values, v)
+	}
+	return values
+}
+
+// isGREASE reports whether v is a GREASE value (RFC 8701), which
+// fingerprints ignore.
+func isGREASE(v uint16) bool {
+	return v&0x0f0f == 0x0a0a && v>>8 == v&0xff
+}
+
+func withoutGREASE(values []uint16) []uint16 {
+	out := make([]uint16, 0, len(values))
+	for _, v := range values {
+		if !isGREASE(v) {
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+// fields returns the client fingerprint as capture fields.
+func (h *clientHello) fields() map[string]string {
+	ja3 := h.ja3()
+	sum := md5.Sum([]byte(ja3))
+	fields := map[string]string{
+		"tls":         "true",
+		"tls_version": tlsVersionName(h.maxVersion()),
+		"ja3":         hex.EncodeToString(sum[:]),
+		"ja3_string":  ja3,
+		"ja4":         h.ja4(),
+	}
+	if h.serverName != "" {
+		fields["sni"] = h.serverName
+	}
+	if len(h.alpn) > 0 {
+		fields["alpn"] = strings.Join(h.alpn, ",")
+	}
+	return fields
+}
+
+// ja3 builds the JA3 string:
+// version,ciphers,extensions,curves,point_formats (GREASE removed).
+func (h *clientHello) ja3() string {
+	pointFormats := make([]uint16, len(h.pointFormats))
+	for i, f := range h.pointFormats {
+		pointFormats[i] = uint16(f)
+	}
+	return strings.Join([]string{
+		strconv.Itoa(int(h.version)),
+		joinDecimal(withoutGREASE(h.ciphers)),
+		joinDecimal(withoutGREASE(h.extensions)),
+		joinDecimal(withoutGREASE(h.curves)),
+		joinDecimal(pointFormats),
+	}, ",")
+}
+
+// ja4 builds the JA4 fingerprint: a readable prefix (transport, version,
+// SNI, cipher and extension counts, ALPN), a hash of the sorted cipher
+// suites and a hash of the sorted extensions plus signature algorithms.
+func (h *clientHello) ja4() string {
+	ciphers := withoutGREASE(h.ciphers)
+	extensions := withoutGREASE(h.extensions)
+
+	sni := "i"
+	if h.serverName != "" {
+		sni = "d"
+	}
+	alpn := "00"
+	if len(h.alpn) > 0 && h.alpn[0] != "" {
+		first := h.alpn[0]
+		alpn = ja4ALPNChars(first[0], first[len(first)-1])
+	}
+	prefix := fmt.Sprintf("t%s%s%02d%02d%s", ja4Version(h.maxVersion()), sni, min(len(ciphers), 99), min(len(extensions), 99), alpn)
+
+	var hashed []uint16
+	for _, e := range extensions {
+		if e != tlsExtServerName && e != tlsExtALPN {
+			hashed = append(hashed, e)
+		}
+	}
+	extPart := joinHex(sorted(hashed))
+	if len(h.sigAlgs) > 0 {
+		extPart += "_" + joinHex(withoutGREASE(h.sigAlgs))
+	}
+	cipherHash, extHash := "000000000000", "000000000000"
+	if len(ciphers) > 0 {
+		cipherHash = truncatedSHA256(joinHex(sorted(ciphers)))
+	}
+	if len(hashed) > 0 {
+		extHash = truncatedSHA256(extPart)
+	}
+	return prefix + "_" + cipherHash + "_" + extHash
+}
+
+// maxVersion returns the highest version offered in supported_versions,
+// or the legacy version.
+func (h *clientHello) maxVersion() uint16 {
+	v := h.version
+	for _, sv := range withoutGREASE(h.versions) {
+		if sv > v {
+			v = sv
+		}
+	}
+	return v
+}
+
+func ja4Version(v uint16) string {
+	switch v {
+	case tls.VersionTLS13:
+		return "13"
+	case tls.VersionTLS12:
+		return "12"
+	case tls.VersionTLS11:
+		return "11"
+	case tls.VersionTLS10:
+		return "10"
+	case 0x0300:
+		return "s3"
+	}
+	return "00"
+}
+
+// ja4ALPNChars returns the first and last characters of the first ALPN
+// value, or the first and last hex digits when they are not alphanumeric.
+func ja4ALPNChars(first, last byte) string {
+	isAlnum := func(c byte) bool {
+		return c >= '0' && c <= '9' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+	}
+	if isAlnum(first) && isAlnum(last) {
+		return string([]byte{first, last})
+	}
+	h := hex.EncodeToString([]byte{first, last})
+	return h[:1] + h[3:]
+}
+
+func tlsVersionName(v uint16) string {
+	switch v {
+	case 0x0300:
+		return "SSL 3.0"
+	case tls.VersionTLS10:
+		return "1.0"
+	case tls.VersionTLS11:
+		return "1.1"
+	case tls.VersionTLS12:
+		return "1.2"
+	case tls.VersionTLS13:
+		return "1.3"
+	}
+	return fmt.Sprintf("0x%04x", v)
+}
+
+func joinDecimal(values []uint16) string {
+	parts := make([]string, len(values))
+	for i, v := range values {
+		parts[i] = strconv.Itoa(int(v))
+	}
+	return strings.Join(parts, "-")
+}
+
+func joinHex(values []uint16) string {
+	parts := make([]string, len(values))
+	for i, v := range values {
+		parts[i] = fmt.Sprintf("%04x", v)
+	}
+	return strings.Join(parts, ",")
+}
+
+func sorted(values []uint16) []uint16 {
+	out := append([]uint16(nil), values...)
+	sort.Slice(out, func(i, j int) bool { return out[i] < out[j] })
+	return out
+}
+
+func truncatedSHA256(s string) string {
+	sum := sha256.Sum256([]byte(s))
+	return hex.EncodeToString(sum[:])[:12]
+}
+
+// tlsCerts caches generated certificates by subject and SANs. All of
+// them share one key, so a flood of distinct SNI names costs a signature
+// each rather than a key generation.
+var tlsCerts = struct {
+	sync.Mutex
+	key   *rsa.PrivateKey
+	certs map[string]*tls.Certificate
+}{certs: make(map[string]*tls.Certificate)}
+
+// mockCertificate returns a self-signed certificate for cfg. When no
+// CommonName is configured, the client's SNI name is used.
+func mockCertificate(cfg *TLSConfig, serverName string) (*tls.Certificate, error) {
+	cn := cfg.CommonName
+	if cn == "" {
+		cn = "localhost"
+		if serverName != "" && len(serverName) <= 253 && net.ParseIP(serverName) == nil {
+			cn = serverName
+		}
+	}
+	sans := cfg.SANs
+	if len(sans) == 0 {
+		sans = []string{cn}
+	}
+	cacheKey := cn + "\x00" + cfg.Organization + "\x00" + strings.Join(sans, ",")
+
+	tlsCerts.Lock()
+	defer tlsCerts.Unlock()
+
+	if cert, ok := tlsCerts.certs[cacheKey]; ok {
+		return cert, nil
+	}
+	if tlsCerts.key == nil {
+		key, err := rsa.GenerateKey(rand.Reader, 2048)
+		if err != nil {
+			return nil, err
+		}
+		tlsCerts.key = key
+	}
+
+	// Look like `openssl req -x509 -days 3650` run some time ago
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 159))
+	if err != nil {
+		return nil, err
+	}
+	age, err := rand.Int(rand.Reader, big.NewInt(365*24*3600))
+	if err != nil {
+		return nil, err
+	}
+	notBefore := time.Now().Add(-30 * 24 * time.Hour).Add(-time.Duration(age.Int64()) * time.Second).UTC().Truncate(time.Second)
+	subject := pkix.Name{CommonName: cn}
+	if cfg.Organization != "" {
+		subject.Organization = []string{cfg.Organization}
+	}
+	template := &x509.Certificate{
+		SerialNumber:          serial,
+		Subject:               subject,
+		NotBefore:             notBefore,
+		NotAfter:              notBefore.AddDate(10, 0, 0),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	for _, san := range sans {
+		if ip := net.ParseIP(san); ip != nil {
+			template.IPAddresses = append(template.IPAddresses, ip)
+		} else {
+			template.DNSNames = append(template.DNSNames, san)
+		}
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &tlsCerts.key.PublicKey, tlsCerts.key)
+	if err != nil {
+		return nil, fmt.Errorf("generate mock certificate: %w", err)
+	}
+
+	cert := &tls.Certificate{Certificate: [][]byte{der}, PrivateKey: tlsCerts.key}
+	if len(tlsCerts.certs) >= maxTLSCerts {
+		clear(tlsCerts.certs)
+	}
+	tlsCerts.certs[cacheKey] = cert
+	return cert, nil
+}
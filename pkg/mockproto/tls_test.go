@@ -0,0 +1,151 @@
+package mockproto
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"crypto/tls"
+	"encoding/hex"
+	"net"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"golang.org/x/crypto/cryptobyte"
+)
+
+// buildClientHello encodes a ClientHello record with the given cipher
+// suites and extensions (type -> body), in order.
+func buildClientHello(ciphers []uint16, exts [][2]any) []byte {
+	var b cryptobyte.Builder
+	b.AddUint8(tlsMsgClientHello)
+	b.AddUint24LengthPrefixed(func(b *cryptobyte.Builder) {
+		b.AddUint16(tls.VersionTLS12)
+		b.AddBytes(make([]byte, 32))
+		b.AddUint8(0) // session ID
+		b.AddUint16LengthPrefixed(func(b *cryptobyte.Builder) {
+			for _, c := range ciphers {
+				b.AddUint16(c)
+			}
+		})
+		b.AddUint8(1)
+		b.AddUint8(0) // null compression
+		b.AddUint16LengthPrefixed(func(b *cryptobyte.Builder) {
+			for _, e := range exts {
+				b.AddUint16(e[0].(uint16))
+				b.AddUint16LengthPrefixed(func(b *cryptobyte.Builder) { b.AddBytes(e[1].([]byte)) })
+			}
+		})
+	})
+	msg := b.BytesOrPanic()
+	return append([]byte{tlsRecordHandshake, 3, 1, byte(len(msg) >> 8), byte(len(msg))}, msg...)
+}
+
+func TestClientHelloFingerprint(t *testing.T) {
+	sni := []byte{0, 16, 0, 0, 13}
+	sni = append(sni, "www.test.corp"...)
+	record := buildClientHello(
+		[]uint16{0x0a0a, 0x1301, 0xc02b, 0x1302},
+		[][2]any{
+			{uint16(0x1a1a), []byte{}},      // GREASE
+			{uint16(tlsExtServerName), sni}, // SNI
+			{uint16(tlsExtCurves), []byte{0, 6, 0x2a, 0x2a, 0x00, 0x1d, 0x00, 0x17}}, // GREASE, x25519, P-256
+			{uint16(tlsExtPointFormats), []byte{1, 0}},
+			{uint16(tlsExtSigAlgs), []byte{0, 4, 0x04, 0x03, 0x08, 0x04}},
+			{uint16(tlsExtALPN), []byte{0, 3, 2, 'h', '2'}},
+			{uint16(tlsExtVersions), []byte{4, 0x03, 0x04, 0x03, 0x03}},
+		})
+
+	raw, hello, err := readClientHello(bytes.NewReader(record))
+	if err != nil {
+		t.Fatalf("readClientHello failed: %v", err)
+	}
+	if !bytes.Equal(raw, record) {
+		t.Error("Expected raw record returned for replay")
+	}
+
+	fields := hello.fields()
+	if want := "771,4865-49195-4866,0-10-11-13-16-43,29-23,0"; fields["ja3_string"] != want {
+		t.Errorf("JA3 string: expected %q, got %q", want, fields["ja3_string"])
+	}
+	if len(fields["ja3"]) != 32 {
+		t.Errorf("Expected MD5 JA3 hash, got %q", fields["ja3"])
+	}
+
+	cipherHash := sha256.Sum256([]byte("1301,1302,c02b"))
+	extHash := sha256.Sum256([]byte("000a,000b,000d,002b_0403,0804"))
+	want := "t13d0306h2_" + hex.EncodeToString(cipherHash[:])[:12] + "_" + hex.EncodeToString(extHash[:])[:12]
+	if fields["ja4"] != want {
+		t.Errorf("JA4: expected %q, got %q", want, fields["ja4"])
+	}
+	if fields["sni"] != "www.test.corp" || fields["alpn"] != "h2" || fields["tls_version"] != "1.3" {
+		t.Errorf("Unexpected fields: %v", fields)
+	}
+
+	if _, _, err := readClientHello(strings.NewReader("GET / HTTP/1.1\r\n\r\n")); err == nil {
+		t.Error("Expected error for plaintext client")
+	}
+}
+
+func TestTLSWrappedMock(t *testing.T) {
+	captures := make(chan Capture, 4)
+	addr := serveMock(t, MockConfig{
+		Protocol:   "http",
+		StatusCode: 401,
+		TLS:        &TLSConfig{Organization: "Corp IT"},
+		OnCapture:  func(c Capture) { captures <- c },
+	})
+
+	conn, err := tls.DialWithDialer(&net.Dialer{Timeout: 2 * time.Second}, "tcp", addr, &tls.Config{
+		ServerName:         "vpn.corp.example",
+		NextProtos:         []string{"http/1.1"},
+		InsecureSkipVerify: true,
+	})
+	if err != nil {
+		t.Fatalf("TLS handshake failed: %v", err)
+	}
+	defer conn.Close()
+
+	cert := conn.ConnectionState().PeerCertificates[0]
+	if cert.Subject.CommonName != "vpn.corp.example" || cert.Subject.Organization[0] != "Corp IT" || cert.DNSNames[0] != "vpn.corp.example" {
+		t.Errorf("Unexpected certificate subject %v, SANs %v", cert.Subject, cert.DNSNames)
+	}
+	if !cert.NotBefore.Before(time.Now().Add(-29 * 24 * time.Hour)) {
+		t.Errorf("Expected a backdated certificate, got NotBefore %v", cert.NotBefore)
+	}
+
+	conn.Write([]byte("GET / HTTP/1.1\r\nHost: vpn.corp.example\r\n\r\n"))
+	resp, err := http.ReadResponse(bufio.NewReader(conn), nil)
+	if err != nil {
+		t.Fatalf("ReadResponse failed: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != 401 {
+		t.Errorf("Expected 401 inside TLS, got %d", resp.StatusCode)
+	}
+
+	c := <-captures
+	if c.Kind != CaptureClientInfo || c.Protocol != "http" || c.Fields["sni"] != "vpn.corp.example" || c.Fields["alpn"] != "http/1.1" {
+		t.Errorf("Unexpected fingerprint capture: %+v", c)
+	}
+	if !strings.HasPrefix(c.Fields["ja4"], "t13d") {
+		t.Errorf("Expected TLS 1.3 JA4 with SNI, got %q", c.Fields["ja4"])
+	}
+}
+
+func TestMockCertificate(t *testing.T) {
+	cfg := &TLSConfig{CommonName: "mail.corp.example", SANs: []string{"mail.corp.example", "192.0.2.25"}}
+	a, err := mockCertificate(cfg, "ignored.example")
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, _ := mockCertificate(cfg, "other.example")
+	if a != b {
+		t.Error("Expected cached certificate for the same subject")
+	}
+	c, _ := mockCertificate(&TLSConfig{}, "")
+	if a == c || a.PrivateKey != c.PrivateKey {
+		t.Error("Expected a new certificate sharing the generated key")
+	}
+}
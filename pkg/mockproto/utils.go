@@ -54,6 +54,19 @@ func DripWrite(conn net.Conn, data []byte, intervalMs int) error {
 	return nil
 }
 
+// writePaced writes data after the configured random delay, dripping it
+// byte-by-byte when config.DripBanner is set.
+func writePaced(conn net.Conn, config MockConfig, data []byte) error {
+	if config.RandomDelay {
+		RandomDelay(200, 2000)
+	}
+	if config.DripBanner {
+		return DripWrite(conn, data, config.DripIntervalMs)
+	}
+	_, err := conn.Write(data)
+	return err
+}
+
 // HoldOpen keeps a connection open indefinitely but with proper timeouts
 // to prevent goroutine leaks from idle connections
 func HoldOpen(conn net.Conn) {
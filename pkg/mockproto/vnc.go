@@ -0,0 +1,90 @@
+package mockproto
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/hex"
+	"io"
+	"net"
+	"time"
+)
+
+const (
+	vncServerVersion = "RFB 003.008\n"
+
+	vncSecurityVNCAuth = 2
+)
+
+// MockVNC emulates a VNC server requiring VNC authentication. It completes
+// the RFB version and security handshake, captures the client version and
+// the DES challenge/response (crackable offline), and fails authentication.
+// In tarpit mode the challenge and the result are delayed.
+func MockVNC(conn net.Conn, config MockConfig) error {
+	if err := writePaced(conn, config, []byte(vncServerVersion)); err != nil {
+		return nil
+	}
+
+	conn.SetReadDeadline(time.Now().Add(30 * time.Second))
+	clientVersion := make([]byte, 12)
+	if _, err := io.ReadFull(conn, clientVersion); err != nil {
+		return nil
+	}
+	version := string(clientVersion[:11])
+	config.capture(Capture{Protocol: "vnc", Kind: CaptureClientInfo, Fields: map[string]string{"client_version": version}})
+
+	// RFB 3.3 has the server pick the security type; 3.7+ negotiates it
+	legacy := version < "RFB 003.007"
+	if legacy {
+		conn.Write(binary.BigEndian.AppendUint32(nil, vncSecurityVNCAuth))
+	} else {
+		conn.Write([]byte{1, vncSecurityVNCAuth})
+		var choice [1]byte
+		if _, err := io.ReadFull(conn, choice[:]); err != nil {
+			return nil
+		}
+		if choice[0] != vncSecurityVNCAuth {
+			return vncFail(conn, version, "Security type not supported")
+		}
+	}
+
+	if config.Tarpit {
+		RandomDelay(5000, 15000)
+	}
+	challenge := make([]byte, 16)
+	rand.Read(challenge)
+	if _, err := conn.Write(challenge); err != nil {
+		return nil
+	}
+	response := make([]byte, 16)
+	conn.SetReadDeadline(time.Now().Add(60 * time.Second))
+	if _, err := io.ReadFull(conn, response); err != nil {
+		return nil
+	}
+	config.capture(Capture{
+		Protocol: "vnc",
+		Kind:     CaptureLogin,
+		Fields: map[string]string{
+			"client_version": version,
+			"challenge":      hex.EncodeToString(challenge),
+			"response":       hex.EncodeToString(response),
+		},
+	})
+
+	if config.Tarpit {
+		RandomDelay(10000, 30000)
+	} else if config.RandomDelay {
+		RandomDelay(500, 2000)
+	}
+	return vncFail(conn, version, "Authentication failed")
+}
+
+// vncFail sends a failed SecurityResult, with a reason from RFB 3.8 on.
+func vncFail(conn net.Conn, version, reason string) error {
+	msg := binary.BigEndian.AppendUint32(nil, 1)
+	if version >= "RFB 003.008" {
+		msg = binary.BigEndian.AppendUint32(msg, uint32(len(reason)))
+		msg = append(msg, reason...)
+	}
+	conn.Write(msg)
+	return nil
+}
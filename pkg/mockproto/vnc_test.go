@@ -0,0 +1,59 @@
+package mockproto
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"testing"
+)
+
+func TestMockVNC_AuthFailed(t *testing.T) {
+	response := bytes.Repeat([]byte{0xab}, 16)
+	input := append([]byte("RFB 003.008\n"), vncSecurityVNCAuth)
+	input = append(input, response...)
+	conn := newMockConn(input)
+
+	var captures []Capture
+	err := MockVNC(conn, MockConfig{OnCapture: func(c Capture) { captures = append(captures, c) }})
+	if err != nil {
+		t.Fatalf("MockVNC failed: %v", err)
+	}
+
+	out := conn.writeData
+	if !bytes.HasPrefix(out, []byte(vncServerVersion)) {
+		t.Fatalf("Expected RFB version, got %q", out)
+	}
+	out = out[len(vncServerVersion):]
+	if !bytes.HasPrefix(out, []byte{1, vncSecurityVNCAuth}) {
+		t.Fatalf("Expected VNC auth security type, got %x", out)
+	}
+	challenge := out[2:18]
+	result := out[18:]
+	if binary.BigEndian.Uint32(result) != 1 || !bytes.Contains(result, []byte("Authentication failed")) {
+		t.Errorf("Expected failed SecurityResult with reason, got %x", result)
+	}
+
+	if len(captures) != 2 || captures[0].Fields["client_version"] != "RFB 003.008" {
+		t.Fatalf("Unexpected captures: %+v", captures)
+	}
+	auth := captures[1]
+	if auth.Kind != CaptureLogin || auth.Fields["challenge"] != hex.EncodeToString(challenge) || auth.Fields["response"] != hex.EncodeToString(response) {
+		t.Errorf("Unexpected auth capture: %+v", auth)
+	}
+}
+
+func TestMockVNC_Legacy33(t *testing.T) {
+	input := append([]byte("RFB 003.003\n"), make([]byte, 16)...)
+	conn := newMockConn(input)
+
+	MockVNC(conn, MockConfig{})
+
+	out := conn.writeData[len(vncServerVersion):]
+	if binary.BigEndian.Uint32(out) != vncSecurityVNCAuth {
+		t.Fatalf("Expected server-chosen VNC auth, got %x", out)
+	}
+	// 4 bytes security type, 16 bytes challenge, 4 bytes result without reason
+	if len(out) != 24 || binary.BigEndian.Uint32(out[20:]) != 1 {
+		t.Errorf("Unexpected RFB 3.3 exchange: %x", out)
+	}
+}
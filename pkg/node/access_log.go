@@ -0,0 +1,75 @@
+package node
+
+import (
+	"crypto/sha256"
+	"crypto/tls"
+	"encoding/hex"
+	"net"
+	"strings"
+
+	pbCommon "github.com/ivere27/nitella/pkg/api/common"
+	"github.com/ivere27/nitella/pkg/node/accesslog"
+	"github.com/ivere27/nitella/pkg/node/stats"
+)
+
+// SetAccessLog sets the structured access log for the listener (nil
+// disables it).
+func (p *EmbeddedListener) SetAccessLog(l *accesslog.Logger) {
+	p.accessLog.Store(l)
+}
+
+// accessRecord starts an access log record for conn, or returns nil when
+// there is no access log. The TLS identity is only known once the
+// handshake completed; it is not forced here.
+func (p *EmbeddedListener) accessRecord(conn net.Conn, event, destination, reason string) *accesslog.Record {
+	if p.accessLog.Load() == nil {
+		return nil
+	}
+	rec := &accesslog.Record{
+		Event:       event,
+		ProxyID:     p.ID,
+		ProxyName:   p.Name,
+		Destination: destination,
+		Reason:      reason,
+	}
+	if tc, ok := conn.(*tls.Conn); ok {
+		if state := tc.ConnectionState(); state.HandshakeComplete {
+			rec.TLSServerName = state.ServerName
+			if len(state.PeerCertificates) > 0 {
+				cert := state.PeerCertificates[0]
+				sum := sha256.Sum256(cert.Raw)
+				rec.ClientCN = cert.Subject.CommonName
+				rec.ClientFingerprint = hex.EncodeToString(sum[:])
+			}
+		}
+	}
+	return rec
+}
+
+// logAccess completes rec from the stats event ev and writes it.
+func (p *EmbeddedListener) logAccess(rec *accesslog.Record, ev *stats.ConnectionEvent) {
+	if rec == nil {
+		return
+	}
+	rec.Time = ev.EndTime
+	rec.ConnID = ev.ConnID
+	rec.SourceIP = ev.SourceIP
+	rec.SourcePort = ev.SourcePort
+	rec.Action = actionName(pbCommon.ActionType(ev.Action))
+	rec.RuleID = ev.RuleID
+	rec.BytesIn = ev.BytesIn
+	rec.BytesOut = ev.BytesOut
+	rec.DurationMs = ev.EndTime.Sub(ev.StartTime).Milliseconds()
+	rec.Thresholds = ev.Thresholds
+	if ev.Geo != nil {
+		rec.GeoCountry = ev.Geo.Country
+		rec.GeoCity = ev.Geo.City
+		rec.GeoISP = ev.Geo.Isp
+	}
+	p.accessLog.Load().Log(rec)
+}
+
+// actionName is the lower-case action name, e.g. "require_approval".
+func actionName(a pbCommon.ActionType) string {
+	return strings.ToLower(strings.TrimPrefix(a.String(), "ACTION_TYPE_"))
+}
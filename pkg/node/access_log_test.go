@@ -0,0 +1,148 @@
+package node
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ivere27/nitella/pkg/api/common"
+	pbGeo "github.com/ivere27/nitella/pkg/api/geoip"
+	pbProxy "github.com/ivere27/nitella/pkg/api/proxy"
+	"github.com/ivere27/nitella/pkg/config"
+	"github.com/ivere27/nitella/pkg/node/accesslog"
+)
+
+// readAccessLog waits for n records in the JSON access log at path.
+func readAccessLog(t *testing.T, path string, n int) []accesslog.Record {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		var recs []accesslog.Record
+		if f, err := os.Open(path); err == nil {
+			sc := bufio.NewScanner(f)
+			for sc.Scan() {
+				var rec accesslog.Record
+				if err := json.Unmarshal(sc.Bytes(), &rec); err != nil {
+					t.Fatalf("Invalid record %q: %v", sc.Text(), err)
+				}
+				recs = append(recs, rec)
+			}
+			f.Close()
+		}
+		if len(recs) >= n || time.Now().After(deadline) {
+			if len(recs) != n {
+				t.Fatalf("Expected %d records, got %+v", n, recs)
+			}
+			return recs
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+}
+
+func TestListenerAccessLog(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "access.log")
+	al, err := accesslog.New(config.AccessLogConfig{Path: path})
+	if err != nil {
+		t.Fatalf("New failed: %v", err)
+	}
+	defer al.Close()
+
+	backend := startEchoBackend(t)
+	l := NewEmbeddedListener("test-access", "Web", "127.0.0.1:0", backend.Addr().String(), common.ActionType_ACTION_TYPE_ALLOW, common.MockPreset_MOCK_PRESET_UNSPECIFIED, "", "", "", pbProxy.ClientAuthType_CLIENT_AUTH_AUTO, nil)
+	l.SetAccessLog(al)
+	if err := l.Start(); err != nil {
+		t.Fatalf("Start failed: %v", err)
+	}
+	defer l.Stop()
+
+	conn, err := net.Dial("tcp", l.ListenAddr)
+	if err != nil {
+		t.Fatalf("Dial failed: %v", err)
+	}
+	if err := echo(t, conn, 100); err != nil {
+		t.Fatalf("Echo failed: %v", err)
+	}
+	conn.Close()
+	readAccessLog(t, path, 1)
+
+	l.AddRule(&pbProxy.Rule{Id: "deny-all", Enabled: true, Action: common.ActionType_ACTION_TYPE_BLOCK})
+	conn, err = net.Dial("tcp", l.ListenAddr)
+	if err != nil {
+		t.Fatalf("Dial failed: %v", err)
+	}
+	conn.SetReadDeadline(time.Now().Add(2 * time.Second))
+	io.Copy(io.Discard, conn)
+	conn.Close()
+
+	recs := readAccessLog(t, path, 2)
+	closed, blocked := recs[0], recs[1]
+	if closed.Event != accesslog.EventClose || closed.Action != "allow" || closed.ProxyID != "test-access" ||
+		closed.Destination != backend.Addr().String() || closed.BytesIn != 100 || closed.BytesOut != 100 ||
+		closed.SourceIP != "127.0.0.1" || closed.SourcePort == 0 || closed.ConnID == "" {
+		t.Errorf("Unexpected close record: %+v", closed)
+	}
+	if blocked.Event != accesslog.EventBlock || blocked.Action != "block" || blocked.RuleID != "deny-all" || blocked.BytesIn != 0 {
+		t.Errorf("Unexpected block record: %+v", blocked)
+	}
+}
+
+// countingGeoIP is a GeoIP client that counts its lookups.
+type countingGeoIP struct {
+	lookups atomic.Int64
+}
+
+func (c *countingGeoIP) Lookup(ctx context.Context, ip string) (*common.GeoInfo, error) {
+	c.lookups.Add(1)
+	return &common.GeoInfo{Country: "KR"}, nil
+}
+
+func (c *countingGeoIP) GetStatus(ctx context.Context) (*pbGeo.ServiceStatus, error) {
+	return &pbGeo.ServiceStatus{}, nil
+}
+
+func (c *countingGeoIP) Close() error { return nil }
+
+func TestAccessLogGlobalBlockSkipsGeoLookup(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "access.log")
+	al, err := accesslog.New(config.AccessLogConfig{Path: path})
+	if err != nil {
+		t.Fatalf("New failed: %v", err)
+	}
+	defer al.Close()
+
+	geoClient := &countingGeoIP{}
+	gr := NewGlobalRulesStore()
+	defer gr.Stop()
+	gr.BlockIP("127.0.0.1", 0)
+
+	l := NewEmbeddedListener("test-access-global", "Web", "127.0.0.1:0", "", common.ActionType_ACTION_TYPE_ALLOW, common.MockPreset_MOCK_PRESET_UNSPECIFIED, "", "", "", pbProxy.ClientAuthType_CLIENT_AUTH_AUTO, NewGeoIPService(geoClient))
+	l.SetAccessLog(al)
+	l.SetGlobalRules(gr)
+	if err := l.Start(); err != nil {
+		t.Fatalf("Start failed: %v", err)
+	}
+	defer l.Stop()
+
+	conn, err := net.Dial("tcp", l.ListenAddr)
+	if err != nil {
+		t.Fatalf("Dial failed: %v", err)
+	}
+	conn.SetReadDeadline(time.Now().Add(2 * time.Second))
+	io.Copy(io.Discard, conn)
+	conn.Close()
+
+	recs := readAccessLog(t, path, 1)
+	if recs[0].Event != accesslog.EventBlock || recs[0].Reason != "global rule" {
+		t.Errorf("Unexpected block record: %+v", recs[0])
+	}
+	if n := geoClient.lookups.Load(); n != 0 {
+		t.Errorf("Expected no GeoIP lookup for a global block, got %d", n)
+	}
+}
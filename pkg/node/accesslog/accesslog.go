@@ -0,0 +1,141 @@
+// Package accesslog writes one structured record per connection outcome
+// (closed, blocked, mocked or decided by approval) to a rotating file, as
+// JSON lines, ArcSight CEF or QRadar LEEF, for log shippers and SIEMs.
+package accesslog
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ivere27/nitella/pkg/config"
+	"github.com/ivere27/nitella/pkg/log"
+)
+
+// Record events.
+const (
+	EventClose    = "close"    // A forwarded connection closed
+	EventBlock    = "block"    // A connection was blocked
+	EventMock     = "mock"     // A mock service answered a connection
+	EventApproval = "approval" // An approval request was decided, expired or withdrawn
+)
+
+// Record is one access log entry.
+type Record struct {
+	Time        time.Time `json:"time"`
+	Event       string    `json:"event"`
+	ProxyID     string    `json:"proxy_id"`
+	ProxyName   string    `json:"proxy_name,omitempty"`
+	ConnID      string    `json:"conn_id,omitempty"`
+	SourceIP    string    `json:"source_ip"`
+	SourcePort  int32     `json:"source_port,omitempty"`
+	Destination string    `json:"destination,omitempty"`
+	Action      string    `json:"action"`
+	RuleID      string    `json:"rule_id,omitempty"`
+	BytesIn     int64     `json:"bytes_in"`
+	BytesOut    int64     `json:"bytes_out"`
+	DurationMs  int64     `json:"duration_ms"`
+
+	GeoCountry string `json:"geo_country,omitempty"`
+	GeoCity    string `json:"geo_city,omitempty"`
+	GeoISP     string `json:"geo_isp,omitempty"`
+
+	// TLS identity: the SNI and the client certificate, if any
+	TLSServerName     string `json:"tls_server_name,omitempty"`
+	ClientCN          string `json:"tls_client_cn,omitempty"`
+	ClientFingerprint string `json:"tls_client_fingerprint,omitempty"` // SHA-256, hex
+
+	// Approval records: allow, deny, expired or withdrawn
+	Approval string `json:"approval,omitempty"`
+
+	Thresholds string `json:"thresholds,omitempty"` // Crossed while open, e.g. "bytes_out:close"
+	Reason     string `json:"reason,omitempty"`     // Why the connection ended this way, e.g. a fallback
+}
+
+// Logger formats records and appends them to a rotating file, or hands
+// them to a forwarder. A nil Logger discards records.
+type Logger struct {
+	format  func(*Record) []byte
+	forward func(*Record)
+
+	mu      sync.Mutex
+	out     *rotatingFile
+	failing bool // A write failed; logged once until writes succeed again
+}
+
+// New opens the access log described by cfg.
+func New(cfg config.AccessLogConfig) (*Logger, error) {
+	l := &Logger{}
+	switch strings.ToLower(cfg.Format) {
+	case "", "json":
+		l.format = formatJSON
+	case "cef":
+		l.format = formatCEF
+	case "leef":
+		l.format = formatLEEF
+	default:
+		return nil, fmt.Errorf("unknown access log format %q (want json, cef or leef)", cfg.Format)
+	}
+	if cfg.Path == "" {
+		return nil, fmt.Errorf("access log needs a path")
+	}
+
+	maxSize := cfg.MaxSizeMB
+	if maxSize <= 0 {
+		maxSize = config.DefaultAccessLogMaxSizeMB
+	}
+	maxBackups := cfg.MaxBackups
+	if maxBackups <= 0 {
+		maxBackups = config.DefaultAccessLogMaxBackups
+	}
+	var maxAge time.Duration
+	if cfg.MaxAge != "" {
+		var err error
+		if maxAge, err = time.ParseDuration(cfg.MaxAge); err != nil || maxAge < 0 {
+			return nil, fmt.Errorf("invalid access log maxAge %q", cfg.MaxAge)
+		}
+	}
+
+	out, err := openRotatingFile(cfg.Path, int64(maxSize)<<20, maxAge, maxBackups)
+	if err != nil {
+		return nil, err
+	}
+	l.out = out
+	return l, nil
+}
+
+// NewForwarder returns a Logger handing each record to forward instead of
+// writing it, e.g. for a process mode child whose parent writes the log.
+func NewForwarder(forward func(*Record)) *Logger {
+	return &Logger{forward: forward}
+}
+
+// Log appends rec to the access log.
+func (l *Logger) Log(rec *Record) {
+	if l == nil {
+		return
+	}
+	if l.forward != nil {
+		l.forward(rec)
+		return
+	}
+	line := l.format(rec)
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	_, err := l.out.Write(line)
+	if err != nil && !l.failing {
+		log.Printf("[AccessLog] Failed to write records: %v", err)
+	}
+	l.failing = err != nil
+}
+
+// Close closes the access log file.
+func (l *Logger) Close() error {
+	if l == nil || l.out == nil {
+		return nil
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.out.Close()
+}
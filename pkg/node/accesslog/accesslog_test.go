@@ -0,0 +1,264 @@
+package accesslog
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ivere27/nitella/pkg/config"
+)
+
+func testRecord() *Record {
+	return &Record{
+		Time:              time.Date(2026, 10, 19, 15, 4, 5, 0, time.UTC),
+		Event:             EventBlock,
+		ProxyID:           "web",
+		ProxyName:         "Web|Front",
+		ConnID:            "conn-1",
+		SourceIP:          "192.0.2.1",
+		SourcePort:        51000,
+		Destination:       "backend.internal:8080",
+		Action:            "block",
+		RuleID:            "geo=cn\tkr",
+		DurationMs:        1500,
+		GeoCountry:        "KR",
+		TLSServerName:     "www.example.com",
+		ClientCN:          "alice",
+		ClientFingerprint: "ab12",
+		Reason:            "line\nbreak",
+	}
+}
+
+func TestFormatJSON(t *testing.T) {
+	line := formatJSON(testRecord())
+	if !strings.HasSuffix(string(line), "}\n") {
+		t.Fatalf("Expected one line, got %q", line)
+	}
+	var got map[string]any
+	if err := json.Unmarshal(line, &got); err != nil {
+		t.Fatalf("Invalid JSON: %v", err)
+	}
+	if got["event"] != "block" || got["source_port"] != float64(51000) || got["tls_client_cn"] != "alice" || got["time"] != "2026-10-19T15:04:05Z" {
+		t.Errorf("Unexpected record: %v", got)
+	}
+	if _, ok := got["approval"]; ok {
+		t.Errorf("Expected empty fields omitted: %v", got)
+	}
+}
+
+func TestFormatCEF(t *testing.T) {
+	line := string(formatCEF(testRecord()))
+	prefix := "CEF:0|Nitella|nitellad|" + productVersion + "|block|Connection blocked|5|"
+	if !strings.HasPrefix(line, prefix) {
+		t.Fatalf("Unexpected header: %q", line)
+	}
+	if strings.Count(line, "\n") != 1 || !strings.HasSuffix(line, "\n") {
+		t.Errorf("Expected one line, got %q", line)
+	}
+	for _, want := range []string{
+		"rt=1792422245000 start=1792422243500 ",
+		" src=192.0.2.1 spt=51000 ",
+		" suser=alice ",
+		` reason=line\nbreak `,
+		` cs1Label=rule cs1=geo\=cn` + "\tkr ",
+		" cs2Label=proxy cs2=Web|Front ",
+		" cs3Label=geoCountry cs3=KR ",
+		" flexString2Label=tlsServerName flexString2=www.example.com ",
+		" dhost=backend.internal dpt=8080\n",
+	} {
+		if !strings.Contains(line, want) {
+			t.Errorf("Expected %q in %q", want, line)
+		}
+	}
+	if strings.Contains(line, "cs4Label") || strings.Contains(line, "flexString1") {
+		t.Errorf("Expected empty custom fields omitted: %q", line)
+	}
+
+	rec := testRecord()
+	rec.Event, rec.Approval, rec.Destination = EventApproval, "expired", "10.0.0.5:22"
+	line = string(formatCEF(rec))
+	if !strings.Contains(line, "|approval|Connection approval: expired|3|") || !strings.Contains(line, " dst=10.0.0.5 dpt=22") {
+		t.Errorf("Unexpected approval record: %q", line)
+	}
+}
+
+func TestFormatLEEF(t *testing.T) {
+	line := string(formatLEEF(testRecord()))
+	prefix := "LEEF:2.0|Nitella|nitellad|" + productVersion + "|block|x09|"
+	if !strings.HasPrefix(line, prefix) {
+		t.Fatalf("Unexpected header: %q", line)
+	}
+	attrs := map[string]string{}
+	for _, kv := range strings.Split(strings.TrimSuffix(strings.TrimPrefix(line, prefix), "\n"), "\t") {
+		k, v, _ := strings.Cut(kv, "=")
+		attrs[k] = v
+	}
+	for k, want := range map[string]string{
+		"devTime":   "2026-10-19T15:04:05.000Z",
+		"src":       "192.0.2.1",
+		"srcPort":   "51000",
+		"dstHost":   "backend.internal",
+		"dstPort":   "8080",
+		"usrName":   "alice",
+		"rule":      "geo=cn kr", // The tab would split the attribute
+		"proxyName": "Web|Front",
+		"reason":    "line break",
+		"sev":       "5",
+	} {
+		if attrs[k] != want {
+			t.Errorf("Expected %s=%q, got %q in %q", k, want, attrs[k], line)
+		}
+	}
+	if _, ok := attrs["approval"]; ok {
+		t.Errorf("Expected empty attributes omitted: %q", line)
+	}
+}
+
+func TestNew(t *testing.T) {
+	dir := t.TempDir()
+	for _, cfg := range []config.AccessLogConfig{
+		{Path: filepath.Join(dir, "a.log"), Format: "syslog"},
+		{Format: "json"},
+		{Path: filepath.Join(dir, "a.log"), MaxAge: "daily"},
+	} {
+		if _, err := New(cfg); err == nil {
+			t.Errorf("Expected %+v rejected", cfg)
+		}
+	}
+
+	path := filepath.Join(dir, "logs", "access.log")
+	l, err := New(config.AccessLogConfig{Path: path, Format: "CEF"})
+	if err != nil {
+		t.Fatalf("New failed: %v", err)
+	}
+	l.Log(testRecord())
+	if err := l.Close(); err != nil {
+		t.Fatalf("Close failed: %v", err)
+	}
+	l.Log(testRecord()) // Dropped once closed
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("ReadFile failed: %v", err)
+	}
+	if strings.Count(string(data), "CEF:0|") != 1 {
+		t.Errorf("Expected one CEF record, got %q", data)
+	}
+
+	var nilLogger *Logger
+	nilLogger.Log(testRecord())
+	if err := nilLogger.Close(); err != nil {
+		t.Errorf("Unexpected error closing a nil logger: %v", err)
+	}
+}
+
+func TestRotateBySize(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "access.log")
+	r, err := openRotatingFile(path, 10, 0, 2)
+	if err != nil {
+		t.Fatalf("openRotatingFile failed: %v", err)
+	}
+	defer r.Close()
+	now := time.Date(2026, 10, 19, 15, 4, 5, 0, time.UTC)
+	r.now = func() time.Time { return now }
+
+	for i := 0; i < 5; i++ {
+		now = now.Add(time.Second)
+		if _, err := r.Write([]byte("12345678\n")); err != nil {
+			t.Fatalf("Write failed: %v", err)
+		}
+	}
+
+	// Each write after the first rotates; only the 2 newest backups stay
+	backups := r.backups()
+	want := []string{
+		filepath.Join(dir, "access-20261019T150409.000.log"),
+		filepath.Join(dir, "access-20261019T150410.000.log"),
+	}
+	if strings.Join(backups, ",") != strings.Join(want, ",") {
+		t.Errorf("Unexpected backups: %v", backups)
+	}
+	if data, _ := os.ReadFile(path); string(data) != "12345678\n" {
+		t.Errorf("Unexpected current file: %q", data)
+	}
+}
+
+func TestRotateByAge(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "access.log")
+	os.WriteFile(filepath.Join(dir, "access-notes.log"), nil, 0644) // Not a backup
+
+	r, err := openRotatingFile(path, 1<<20, time.Hour, 5)
+	if err != nil {
+		t.Fatalf("openRotatingFile failed: %v", err)
+	}
+	defer r.Close()
+	start := time.Now()
+	now := start
+	r.now = func() time.Time { return now }
+	r.opened = start
+
+	r.Write([]byte("first\n"))
+	now = start.Add(59 * time.Minute)
+	r.Write([]byte("second\n"))
+	if len(r.backups()) != 0 {
+		t.Fatalf("Expected no rotation within maxAge, got %v", r.backups())
+	}
+	now = start.Add(time.Hour)
+	r.Write([]byte("third\n"))
+
+	backups := r.backups()
+	if len(backups) != 1 {
+		t.Fatalf("Expected one backup, got %v", backups)
+	}
+	if data, _ := os.ReadFile(backups[0]); string(data) != "first\nsecond\n" {
+		t.Errorf("Unexpected backup: %q", data)
+	}
+	if data, _ := os.ReadFile(path); string(data) != "third\n" {
+		t.Errorf("Unexpected current file: %q", data)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "access-notes.log")); err != nil {
+		t.Errorf("Expected unrelated files kept: %v", err)
+	}
+}
+
+func TestRotateByAgeAfterRestart(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "access.log")
+	os.WriteFile(path, []byte("before restart\n"), 0640)
+	written := time.Now().Add(-2 * time.Hour)
+	os.Chtimes(path, written, written)
+
+	r, err := openRotatingFile(path, 1<<20, time.Hour, 5)
+	if err != nil {
+		t.Fatalf("openRotatingFile failed: %v", err)
+	}
+	defer r.Close()
+
+	// The kept file is older than maxAge, so the next write rotates it
+	r.Write([]byte("after restart\n"))
+	backups := r.backups()
+	if len(backups) != 1 {
+		t.Fatalf("Expected one backup, got %v", backups)
+	}
+	if data, _ := os.ReadFile(backups[0]); string(data) != "before restart\n" {
+		t.Errorf("Unexpected backup: %q", data)
+	}
+}
+
+func TestForwarder(t *testing.T) {
+	var got []*Record
+	l := NewForwarder(func(rec *Record) { got = append(got, rec) })
+	rec := testRecord()
+	l.Log(rec)
+	if len(got) != 1 || got[0] != rec {
+		t.Fatalf("Expected the record forwarded, got %v", got)
+	}
+	if err := l.Close(); err != nil {
+		t.Errorf("Unexpected error closing a forwarder: %v", err)
+	}
+}
@@ -0,0 +1,219 @@
+package accesslog
+
+import (
+	"encoding/json"
+	"net"
+	"runtime/debug"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	vendor  = "Nitella"
+	product = "nitellad"
+)
+
+// productVersion is the module version nitellad was built from, "dev"
+// for source builds.
+var productVersion = func() string {
+	if info, ok := debug.ReadBuildInfo(); ok && info.Main.Version != "" && info.Main.Version != "(devel)" {
+		return info.Main.Version
+	}
+	return "dev"
+}()
+
+// eventNames and eventSeverities describe events in CEF and LEEF headers.
+var eventNames = map[string]string{
+	EventClose:    "Connection closed",
+	EventBlock:    "Connection blocked",
+	EventMock:     "Connection mocked",
+	EventApproval: "Connection approval",
+}
+
+var eventSeverities = map[string]int{
+	EventClose:    1,
+	EventApproval: 3,
+	EventBlock:    5,
+	EventMock:     7, // Mock services answer probes and honeypot visitors
+}
+
+func formatJSON(rec *Record) []byte {
+	b, err := json.Marshal(rec)
+	if err != nil {
+		// Records only hold strings and numbers
+		return nil
+	}
+	return append(b, '\n')
+}
+
+// formatCEF renders rec as an ArcSight Common Event Format line.
+func formatCEF(rec *Record) []byte {
+	var b strings.Builder
+	b.WriteString("CEF:0|")
+	for _, v := range []string{vendor, product, productVersion, rec.Event, eventName(rec), strconv.Itoa(eventSeverities[rec.Event])} {
+		b.WriteString(cefHeaderEscape(v) + "|")
+	}
+
+	dstHost, dstPort := splitDestination(rec.Destination)
+	start := rec.Time.Add(-time.Duration(rec.DurationMs) * time.Millisecond)
+	ext := []string{
+		"rt", strconv.FormatInt(rec.Time.UnixMilli(), 10),
+		"start", strconv.FormatInt(start.UnixMilli(), 10),
+		"cat", rec.Event,
+		"act", rec.Action,
+		"proto", "TCP",
+		"src", rec.SourceIP,
+		"spt", formatPort(rec.SourcePort),
+		"in", strconv.FormatInt(rec.BytesIn, 10),
+		"out", strconv.FormatInt(rec.BytesOut, 10),
+		"externalId", rec.ConnID,
+		"suser", rec.ClientCN,
+		"reason", rec.Reason,
+		"cn1Label", "durationMs", "cn1", strconv.FormatInt(rec.DurationMs, 10),
+		"cs1Label", "rule", "cs1", rec.RuleID,
+		"cs2Label", "proxy", "cs2", proxyName(rec),
+		"cs3Label", "geoCountry", "cs3", rec.GeoCountry,
+		"cs4Label", "geoCity", "cs4", rec.GeoCity,
+		"cs5Label", "geoIsp", "cs5", rec.GeoISP,
+		"cs6Label", "tlsClientFingerprint", "cs6", rec.ClientFingerprint,
+		"flexString1Label", "approval", "flexString1", rec.Approval,
+		"flexString2Label", "tlsServerName", "flexString2", rec.TLSServerName,
+		"msg", thresholdsMessage(rec.Thresholds),
+	}
+	if net.ParseIP(dstHost) != nil {
+		ext = append(ext, "dst", dstHost)
+	} else {
+		ext = append(ext, "dhost", dstHost)
+	}
+	ext = append(ext, "dpt", dstPort)
+
+	first := true
+	for i := 0; i+1 < len(ext); i += 2 {
+		key, value := ext[i], ext[i+1]
+		if value == "" || (strings.HasSuffix(key, "Label") && ext[i+3] == "") {
+			// Labels go with their values
+			continue
+		}
+		if !first {
+			b.WriteByte(' ')
+		}
+		first = false
+		b.WriteString(key + "=" + cefValueEscape(value))
+	}
+	b.WriteByte('\n')
+	return []byte(b.String())
+}
+
+// formatLEEF renders rec as a QRadar Log Event Extended Format 2.0 line
+// with tab-delimited attributes.
+func formatLEEF(rec *Record) []byte {
+	var b strings.Builder
+	b.WriteString("LEEF:2.0|")
+	for _, v := range []string{vendor, product, productVersion, rec.Event} {
+		b.WriteString(cefHeaderEscape(v) + "|")
+	}
+	b.WriteString("x09|")
+
+	dstHost, dstPort := splitDestination(rec.Destination)
+	attrs := []string{
+		"devTime", rec.Time.UTC().Format("2006-01-02T15:04:05.000Z07:00"),
+		"devTimeFormat", "yyyy-MM-dd'T'HH:mm:ss.SSSX",
+		"cat", rec.Event,
+		"sev", strconv.Itoa(eventSeverities[rec.Event]),
+		"action", rec.Action,
+		"proto", "TCP",
+		"src", rec.SourceIP,
+		"srcPort", formatPort(rec.SourcePort),
+		"srcBytes", strconv.FormatInt(rec.BytesIn, 10),
+		"dstBytes", strconv.FormatInt(rec.BytesOut, 10),
+		"usrName", rec.ClientCN,
+		"durationMs", strconv.FormatInt(rec.DurationMs, 10),
+		"connId", rec.ConnID,
+		"proxyId", rec.ProxyID,
+		"proxyName", rec.ProxyName,
+		"rule", rec.RuleID,
+		"geoCountry", rec.GeoCountry,
+		"geoCity", rec.GeoCity,
+		"geoIsp", rec.GeoISP,
+		"tlsServerName", rec.TLSServerName,
+		"tlsClientFingerprint", rec.ClientFingerprint,
+		"approval", rec.Approval,
+		"thresholds", rec.Thresholds,
+		"reason", rec.Reason,
+	}
+	if net.ParseIP(dstHost) != nil {
+		attrs = append(attrs, "dst", dstHost)
+	} else {
+		attrs = append(attrs, "dstHost", dstHost)
+	}
+	attrs = append(attrs, "dstPort", dstPort)
+
+	first := true
+	for i := 0; i+1 < len(attrs); i += 2 {
+		if attrs[i+1] == "" {
+			continue
+		}
+		if !first {
+			b.WriteByte('\t')
+		}
+		first = false
+		b.WriteString(attrs[i] + "=" + leefValueEscape(attrs[i+1]))
+	}
+	b.WriteByte('\n')
+	return []byte(b.String())
+}
+
+func eventName(rec *Record) string {
+	name := eventNames[rec.Event]
+	if name == "" {
+		name = rec.Event
+	}
+	if rec.Approval != "" {
+		name += ": " + rec.Approval
+	}
+	return name
+}
+
+func proxyName(rec *Record) string {
+	if rec.ProxyName != "" {
+		return rec.ProxyName
+	}
+	return rec.ProxyID
+}
+
+func formatPort(port int32) string {
+	if port <= 0 {
+		return ""
+	}
+	return strconv.Itoa(int(port))
+}
+
+func thresholdsMessage(thresholds string) string {
+	if thresholds == "" {
+		return ""
+	}
+	return "Thresholds crossed: " + thresholds
+}
+
+// splitDestination splits a backend address into host and port; an
+// address without a port is all host.
+func splitDestination(dest string) (host, port string) {
+	if h, p, err := net.SplitHostPort(dest); err == nil {
+		return h, p
+	}
+	return dest, ""
+}
+
+func cefHeaderEscape(v string) string {
+	return strings.NewReplacer(`\`, `\\`, `|`, `\|`, "\n", " ", "\r", " ").Replace(v)
+}
+
+func cefValueEscape(v string) string {
+	return strings.NewReplacer(`\`, `\\`, `=`, `\=`, "\n", `\n`, "\r", `\r`).Replace(v)
+}
+
+// leefValueEscape keeps a value from breaking the line or its attribute.
+func leefValueEscape(v string) string {
+	return strings.NewReplacer("\t", " ", "\n", " ", "\r", " ").Replace(v)
+}
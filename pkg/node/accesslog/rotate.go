@@ -0,0 +1,153 @@
+package accesslog
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// backupTimeFormat stamps rotated files; it sorts chronologically.
+const backupTimeFormat = "20060102T150405.000"
+
+// rotatingFile appends to path, moving it aside to a timestamped backup
+// (access.log -> access-20061019T150405.000.log) once it grows past
+// maxSize or has been written for longer than maxAge, and keeps at most
+// maxBackups backups. It is not safe for concurrent use.
+type rotatingFile struct {
+	path       string
+	maxSize    int64
+	maxAge     time.Duration // Zero rotates by size only
+	maxBackups int
+
+	f      *os.File
+	size   int64
+	opened time.Time
+	closed bool
+
+	now func() time.Time
+}
+
+func openRotatingFile(path string, maxSize int64, maxAge time.Duration, maxBackups int) (*rotatingFile, error) {
+	r := &rotatingFile{path: path, maxSize: maxSize, maxAge: maxAge, maxBackups: maxBackups, now: time.Now}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return nil, fmt.Errorf("failed to create access log directory: %w", err)
+	}
+	if err := r.open(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func (r *rotatingFile) open() error {
+	f, err := os.OpenFile(r.path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0640)
+	if err != nil {
+		return fmt.Errorf("failed to open access log: %w", err)
+	}
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return fmt.Errorf("failed to stat access log: %w", err)
+	}
+	r.f, r.size, r.opened = f, info.Size(), r.now()
+	// A file kept from before a restart ages from its last write, so
+	// restarts do not hold off rotating it by age
+	if info.Size() > 0 && info.ModTime().Before(r.opened) {
+		r.opened = info.ModTime()
+	}
+	return nil
+}
+
+func (r *rotatingFile) Write(p []byte) (int, error) {
+	if r.closed {
+		return 0, os.ErrClosed
+	}
+	if r.f == nil {
+		// A previous rotation could not reopen the file
+		if err := r.open(); err != nil {
+			return 0, err
+		}
+	}
+	if r.size > 0 && (r.size+int64(len(p)) > r.maxSize || (r.maxAge > 0 && r.now().Sub(r.opened) >= r.maxAge)) {
+		if err := r.rotate(); err != nil {
+			if r.f == nil {
+				return 0, err
+			}
+			// Keep appending to the current file rather than drop records
+			n, _ := r.f.Write(p)
+			r.size += int64(n)
+			return n, err
+		}
+	}
+	n, err := r.f.Write(p)
+	r.size += int64(n)
+	return n, err
+}
+
+func (r *rotatingFile) rotate() error {
+	if err := r.f.Close(); err != nil {
+		return err
+	}
+	r.f = nil
+	ext := filepath.Ext(r.path)
+	backup := strings.TrimSuffix(r.path, ext) + "-" + r.now().UTC().Format(backupTimeFormat) + ext
+	if err := os.Rename(r.path, backup); err != nil {
+		if openErr := r.open(); openErr != nil {
+			return openErr
+		}
+		return fmt.Errorf("failed to rotate access log: %w", err)
+	}
+	if err := r.open(); err != nil {
+		return err
+	}
+	r.prune()
+	return nil
+}
+
+// prune removes the oldest backups beyond maxBackups.
+func (r *rotatingFile) prune() {
+	backups := r.backups()
+	if len(backups) <= r.maxBackups {
+		return
+	}
+	for _, name := range backups[:len(backups)-r.maxBackups] {
+		os.Remove(name)
+	}
+}
+
+// backups lists the backups of path, oldest first.
+func (r *rotatingFile) backups() []string {
+	dir := filepath.Dir(r.path)
+	ext := filepath.Ext(r.path)
+	prefix := strings.TrimSuffix(filepath.Base(r.path), ext) + "-"
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil
+	}
+	var names []string
+	for _, e := range entries {
+		name := e.Name()
+		if e.IsDir() || !strings.HasPrefix(name, prefix) || !strings.HasSuffix(name, ext) {
+			continue
+		}
+		stamp := strings.TrimSuffix(strings.TrimPrefix(name, prefix), ext)
+		if _, err := time.Parse(backupTimeFormat, stamp); err != nil {
+			continue
+		}
+		names = append(names, filepath.Join(dir, name))
+	}
+	sort.Strings(names)
+	return names
+}
+
+func (r *rotatingFile) Close() error {
+	r.closed = true
+	if r.f == nil {
+		return nil
+	}
+	err := r.f.Close()
+	r.f = nil
+	return err
+}
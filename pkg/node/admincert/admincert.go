@@ -89,6 +89,19 @@ func (m *AdminCertManager) GetTLSConfig() *tls.Config {
 	}
 }
 
+// GetMTLSConfig returns TLS config for servers that also require clients
+// to present a certificate signed by the admin CA.
+func (m *AdminCertManager) GetMTLSConfig() *tls.Config {
+	cfg := m.GetTLSConfig()
+
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	cfg.ClientCAs = x509.NewCertPool()
+	cfg.ClientCAs.AddCert(m.caCert)
+	cfg.ClientAuth = tls.RequireAndVerifyClientCert
+	return cfg
+}
+
 // ensureCA loads or generates the CA certificate.
 func (m *AdminCertManager) ensureCA() error {
 	// Check if CA exists
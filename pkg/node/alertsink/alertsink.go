@@ -0,0 +1,411 @@
+// Package alertsink delivers node alerts to local sinks (webhook, syslog
+// and SMTP) besides the Hub, so they can be wired into existing tooling.
+package alertsink
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/ivere27/nitella/pkg/api/common"
+	pb "github.com/ivere27/nitella/pkg/api/proxy"
+	"github.com/ivere27/nitella/pkg/config"
+	"github.com/ivere27/nitella/pkg/log"
+	"google.golang.org/protobuf/proto"
+)
+
+// Event is an alert as delivered to sinks.
+type Event struct {
+	ID          string            `json:"id"`
+	NodeID      string            `json:"node_id,omitempty"`
+	Type        string            `json:"type"`
+	Severity    string            `json:"severity"`
+	Timestamp   int64             `json:"timestamp"`
+	Summary     string            `json:"summary,omitempty"`
+	SourceIP    string            `json:"source_ip,omitempty"`
+	Destination string            `json:"destination,omitempty"`
+	ProxyID     string            `json:"proxy_id,omitempty"`
+	ProxyName   string            `json:"proxy_name,omitempty"`
+	RuleID      string            `json:"rule_id,omitempty"`
+	GeoCountry  string            `json:"geo_country,omitempty"`
+	GeoCity     string            `json:"geo_city,omitempty"`
+	GeoISP      string            `json:"geo_isp,omitempty"`
+	Fields      map[string]string `json:"fields,omitempty"`
+}
+
+// NewEvent converts an alert and its plaintext details, as passed to an
+// AlertSender, to an Event.
+func NewEvent(alert *common.Alert, info string) *Event {
+	ev := &Event{
+		ID:        alert.GetId(),
+		NodeID:    alert.GetNodeId(),
+		Type:      alert.GetMetadata()[config.AlertMetadataType],
+		Severity:  alert.GetSeverity(),
+		Timestamp: alert.GetTimestampUnix(),
+	}
+	if ev.Type == "" {
+		ev.Type = config.AlertTypeApproval
+	}
+	if ev.Timestamp == 0 {
+		ev.Timestamp = time.Now().Unix()
+	}
+	var details common.AlertDetails
+	if proto.Unmarshal([]byte(info), &details) == nil {
+		ev.Summary = details.GetSummary()
+		ev.SourceIP = details.GetSourceIp()
+		ev.Destination = details.GetDestination()
+		ev.ProxyID = details.GetProxyId()
+		ev.ProxyName = details.GetProxyName()
+		ev.RuleID = details.GetRuleId()
+		ev.GeoCountry = details.GetGeoCountry()
+		ev.GeoCity = details.GetGeoCity()
+		ev.GeoISP = details.GetGeoIsp()
+		ev.Fields = details.GetFields()
+	}
+	return ev
+}
+
+// Text describes the event in one line.
+func (e *Event) Text() string {
+	text := e.Summary
+	if text == "" {
+		text = e.Type + " alert"
+		if e.Type == config.AlertTypeApproval {
+			text = "connection waiting for approval"
+		}
+		if e.SourceIP != "" {
+			text += " from " + e.SourceIP
+		}
+		if e.ProxyName != "" {
+			text += " on " + e.ProxyName
+		} else if e.ProxyID != "" {
+			text += " on " + e.ProxyID
+		}
+	}
+	// Keep the text on one line for syslog and mail headers
+	return strings.Join(strings.Fields(text), " ")
+}
+
+// Sink delivers batches of events to one destination.
+type Sink interface {
+	Deliver(ctx context.Context, events []*Event) error
+	Close() error
+}
+
+// severityRank orders alert severities; unknown severities rank as info.
+func severityRank(severity string) int {
+	switch strings.ToLower(severity) {
+	case "warning":
+		return 1
+	case "high":
+		return 2
+	case "critical":
+		return 3
+	}
+	return 0
+}
+
+// Dispatcher queues alerts for each configured sink and delivers them in
+// batches, retrying failed batches with backoff. It implements the node's
+// AlertSender.
+type Dispatcher struct {
+	queues []*queue
+}
+
+// queue is one sink's pending alerts and delivery counters.
+type queue struct {
+	name        string
+	typ         string
+	sink        Sink
+	minSeverity int
+	batchSize   int
+	batchWait   time.Duration
+	maxRetries  int
+	backoff     time.Duration
+
+	events chan *Event
+	stop   chan struct{}
+	done   chan struct{}
+
+	sent        atomic.Int64
+	retries     atomic.Int64
+	deadLetters atomic.Int64
+
+	mu      sync.Mutex
+	lastErr string
+}
+
+// NewDispatcher creates the sinks in cfgs and starts delivering to them.
+func NewDispatcher(cfgs []config.AlertSinkConfig) (*Dispatcher, error) {
+	d := &Dispatcher{}
+	names := make(map[string]bool)
+	for i, cfg := range cfgs {
+		if cfg.Name == "" {
+			cfg.Name = fmt.Sprintf("%s-%d", cfg.Type, i+1)
+		}
+		if names[cfg.Name] {
+			d.closeSinks()
+			return nil, fmt.Errorf("alert sink %s: duplicate name", cfg.Name)
+		}
+		names[cfg.Name] = true
+		q, err := newQueue(cfg)
+		if err != nil {
+			d.closeSinks()
+			return nil, fmt.Errorf("alert sink %s: %w", cfg.Name, err)
+		}
+		d.queues = append(d.queues, q)
+	}
+	for _, q := range d.queues {
+		go q.run()
+	}
+	return d, nil
+}
+
+func newQueue(cfg config.AlertSinkConfig) (*queue, error) {
+	var sink Sink
+	var err error
+	switch cfg.Type {
+	case "webhook":
+		sink, err = newWebhookSink(cfg)
+	case "syslog":
+		sink, err = newSyslogSink(cfg)
+	case "smtp":
+		sink, err = newSMTPSink(cfg)
+	default:
+		return nil, fmt.Errorf("unknown type %q (want webhook, syslog or smtp)", cfg.Type)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	q := &queue{
+		name:       cfg.Name,
+		typ:        cfg.Type,
+		sink:       sink,
+		batchSize:  cfg.BatchSize,
+		batchWait:  config.DefaultAlertSinkBatchWait,
+		maxRetries: cfg.MaxRetries,
+		backoff:    config.AlertSinkRetryBackoff,
+		events:     make(chan *Event, config.AlertSinkQueueSize),
+		stop:       make(chan struct{}),
+		done:       make(chan struct{}),
+	}
+	switch strings.ToLower(cfg.MinSeverity) {
+	case "", "info", "warning", "high", "critical":
+		q.minSeverity = severityRank(cfg.MinSeverity)
+	default:
+		return nil, fmt.Errorf("unknown minSeverity %q", cfg.MinSeverity)
+	}
+	if q.batchSize <= 0 {
+		q.batchSize = config.DefaultAlertSinkBatchSize
+	}
+	if cfg.BatchWait != "" {
+		if q.batchWait, err = time.ParseDuration(cfg.BatchWait); err != nil || q.batchWait < 0 {
+			return nil, fmt.Errorf("invalid batchWait %q", cfg.BatchWait)
+		}
+	}
+	if q.maxRetries <= 0 {
+		q.maxRetries = config.DefaultAlertSinkMaxRetries
+	}
+	return q, nil
+}
+
+// SendAlert queues alert for every sink whose severity filter it passes.
+// It never blocks: alerts over a full queue are counted as dead letters.
+func (d *Dispatcher) SendAlert(alert *common.Alert, info string) error {
+	if d == nil {
+		return nil
+	}
+	// Quorum requests are re-sent as votes arrive; sinks only get the first
+	if n, _ := strconv.Atoi(alert.GetMetadata()[config.AlertMetadataApprovals]); n > 0 {
+		return nil
+	}
+	var ev *Event
+	for _, q := range d.queues {
+		if severityRank(alert.GetSeverity()) < q.minSeverity {
+			continue
+		}
+		if ev == nil {
+			ev = NewEvent(alert, info)
+		}
+		select {
+		case q.events <- ev:
+		default:
+			q.deadLetters.Add(1)
+		}
+	}
+	return nil
+}
+
+// Sender is what the node sends alerts with.
+type Sender interface {
+	SendAlert(alert *common.Alert, info string) error
+}
+
+// Tee returns a Sender passing alerts to primary and copying them to d's
+// sinks. primary's error is returned; sinks never fail or delay a send.
+// A nil d returns primary, and a nil primary returns d.
+func (d *Dispatcher) Tee(primary Sender) Sender {
+	if d == nil {
+		return primary
+	}
+	if primary == nil {
+		return d
+	}
+	return &tee{primary: primary, sinks: d}
+}
+
+type tee struct {
+	primary Sender
+	sinks   *Dispatcher
+}
+
+func (t *tee) SendAlert(alert *common.Alert, info string) error {
+	t.sinks.SendAlert(alert, info)
+	return t.primary.SendAlert(alert, info)
+}
+
+// Stats returns the delivery counters of each sink.
+func (d *Dispatcher) Stats() []*pb.AlertSinkStats {
+	if d == nil {
+		return nil
+	}
+	stats := make([]*pb.AlertSinkStats, 0, len(d.queues))
+	for _, q := range d.queues {
+		q.mu.Lock()
+		lastErr := q.lastErr
+		q.mu.Unlock()
+		stats = append(stats, &pb.AlertSinkStats{
+			Name:            q.name,
+			Type:            q.typ,
+			SentTotal:       q.sent.Load(),
+			RetriesTotal:    q.retries.Load(),
+			DeadLetterTotal: q.deadLetters.Load(),
+			Queued:          int64(len(q.events)),
+			LastError:       lastErr,
+		})
+	}
+	return stats
+}
+
+// Close delivers the alerts already queued, trying each batch once, and
+// closes the sinks.
+func (d *Dispatcher) Close() {
+	if d == nil {
+		return
+	}
+	for _, q := range d.queues {
+		close(q.stop)
+	}
+	for _, q := range d.queues {
+		<-q.done
+	}
+}
+
+// closeSinks closes the sinks of a dispatcher that was never started.
+func (d *Dispatcher) closeSinks() {
+	for _, q := range d.queues {
+		q.sink.Close()
+	}
+}
+
+// run collects events into batches and delivers them until stopped.
+func (q *queue) run() {
+	defer close(q.done)
+	defer q.sink.Close()
+
+	var batch []*Event
+	timer := time.NewTimer(q.batchWait)
+	timer.Stop()
+	for {
+		select {
+		case ev := <-q.events:
+			if len(batch) == 0 {
+				timer.Reset(q.batchWait)
+			}
+			batch = append(batch, ev)
+			if len(batch) < q.batchSize {
+				continue
+			}
+			timer.Stop()
+		case <-timer.C:
+		case <-q.stop:
+			timer.Stop()
+			q.drain(batch)
+			return
+		}
+		q.deliver(batch)
+		batch = nil
+	}
+}
+
+// drain delivers batch and everything still queued, one attempt per batch.
+func (q *queue) drain(batch []*Event) {
+	for {
+		select {
+		case ev := <-q.events:
+			batch = append(batch, ev)
+			if len(batch) < q.batchSize {
+				continue
+			}
+		default:
+		}
+		if len(batch) == 0 {
+			return
+		}
+		if err := q.attempt(batch); err != nil {
+			q.fail(batch, err)
+		} else {
+			q.sent.Add(int64(len(batch)))
+		}
+		batch = nil
+	}
+}
+
+// deliver delivers batch, retrying with backoff. A batch still failing
+// after the last retry, or when the dispatcher closes, is dead-lettered.
+func (q *queue) deliver(batch []*Event) {
+	backoff := q.backoff
+	for retry := 0; ; retry++ {
+		err := q.attempt(batch)
+		if err == nil {
+			q.sent.Add(int64(len(batch)))
+			return
+		}
+		if retry == q.maxRetries {
+			q.fail(batch, err)
+			return
+		}
+		q.retries.Add(1)
+		select {
+		case <-time.After(backoff):
+		case <-q.stop:
+			q.fail(batch, err)
+			return
+		}
+		backoff = min(backoff*2, config.AlertSinkMaxBackoff)
+	}
+}
+
+func (q *queue) attempt(batch []*Event) error {
+	ctx, cancel := context.WithTimeout(context.Background(), config.AlertSinkTimeout)
+	defer cancel()
+	err := q.sink.Deliver(ctx, batch)
+	q.mu.Lock()
+	if err != nil {
+		q.lastErr = err.Error()
+	} else {
+		q.lastErr = ""
+	}
+	q.mu.Unlock()
+	return err
+}
+
+func (q *queue) fail(batch []*Event, err error) {
+	q.deadLetters.Add(int64(len(batch)))
+	log.Printf("[AlertSink] %s: gave up on %d alerts: %v", q.name, len(batch), err)
+}
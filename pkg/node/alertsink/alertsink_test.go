@@ -0,0 +1,315 @@
+package alertsink
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ivere27/nitella/pkg/api/common"
+	"github.com/ivere27/nitella/pkg/config"
+	nitellacrypto "github.com/ivere27/nitella/pkg/crypto"
+	"google.golang.org/protobuf/proto"
+)
+
+func honeypotAlert(id, severity string) (*common.Alert, string) {
+	info, _ := proto.Marshal(&common.AlertDetails{
+		SourceIp:  "192.0.2.1",
+		ProxyId:   "ssh",
+		ProxyName: "ssh-honeypot",
+		Summary:   "honeypot login attempt from 192.0.2.1",
+		Fields:    map[string]string{"username": "root"},
+	})
+	return &common.Alert{
+		Id:            id,
+		NodeId:        "node-1",
+		Severity:      severity,
+		TimestampUnix: time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC).Unix(),
+		Metadata:      map[string]string{config.AlertMetadataType: config.AlertTypeHoneypot},
+	}, string(info)
+}
+
+func TestWebhookSink(t *testing.T) {
+	var mu sync.Mutex
+	var batches [][]*Event
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if got := r.Header.Get(nitellacrypto.WebhookSignatureHeader); !nitellacrypto.VerifyWebhook("s3cret", body, got) {
+			t.Errorf("Expected a valid signature, got %q", got)
+		}
+		var payload WebhookPayload
+		if err := json.Unmarshal(body, &payload); err != nil {
+			t.Errorf("Failed to decode payload: %v", err)
+		}
+		mu.Lock()
+		batches = append(batches, payload.Alerts)
+		mu.Unlock()
+	}))
+	defer srv.Close()
+
+	d, err := NewDispatcher([]config.AlertSinkConfig{{
+		Name: "ops", Type: "webhook", URL: srv.URL, Secret: "s3cret",
+		MinSeverity: "warning", BatchSize: 2, BatchWait: "1h",
+	}})
+	if err != nil {
+		t.Fatalf("NewDispatcher failed: %v", err)
+	}
+	for i, severity := range []string{"warning", "info", "high"} {
+		d.SendAlert(honeypotAlert(strconv.Itoa(i), severity))
+	}
+	waitFor(t, func() bool { return d.Stats()[0].SentTotal == 2 })
+	d.Close()
+
+	if len(batches) != 1 || len(batches[0]) != 2 {
+		t.Fatalf("Expected one batch of 2 alerts, got %v", batches)
+	}
+	ev := batches[0][0]
+	if ev.ID != "0" || ev.Type != config.AlertTypeHoneypot || ev.SourceIP != "192.0.2.1" || ev.Fields["username"] != "root" {
+		t.Errorf("Unexpected event: %+v", ev)
+	}
+	if batches[0][1].ID != "2" {
+		t.Errorf("Expected the info alert filtered out, got %s second", batches[0][1].ID)
+	}
+}
+
+func TestDispatcher_RetryAndDeadLetter(t *testing.T) {
+	sink := &flakySink{failures: 2}
+	q := &queue{
+		name: "flaky", sink: sink, batchSize: 1, batchWait: time.Hour,
+		maxRetries: 2, backoff: time.Millisecond,
+		events: make(chan *Event, 1), stop: make(chan struct{}), done: make(chan struct{}),
+	}
+	d := &Dispatcher{queues: []*queue{q}}
+	go q.run()
+
+	// Delivered on the third attempt
+	d.SendAlert(honeypotAlert("1", "warning"))
+	waitFor(t, func() bool { return q.sent.Load() == 1 })
+	if q.retries.Load() != 2 {
+		t.Errorf("Expected 2 retries, got %d", q.retries.Load())
+	}
+
+	// Fails past the last retry
+	sink.setFailures(3)
+	d.SendAlert(honeypotAlert("2", "warning"))
+	waitFor(t, func() bool { return q.deadLetters.Load() == 1 })
+	if st := d.Stats()[0]; st.LastError != "sink down" || st.SentTotal != 1 {
+		t.Errorf("Unexpected stats: %v", st)
+	}
+	d.Close()
+}
+
+func TestDispatcher_SkipsQuorumResends(t *testing.T) {
+	q := &queue{events: make(chan *Event, 2)}
+	d := &Dispatcher{queues: []*queue{q}}
+	alert := &common.Alert{Id: "req-1", Severity: "high", Metadata: map[string]string{
+		config.AlertMetadataApprovals: "0", config.AlertMetadataQuorum: "2",
+	}}
+	d.SendAlert(alert, "")
+	alert.Metadata[config.AlertMetadataApprovals] = "1"
+	d.SendAlert(alert, "")
+	if len(q.events) != 1 {
+		t.Errorf("Expected only the first quorum request queued, got %d", len(q.events))
+	}
+	if ev := <-q.events; ev.Type != config.AlertTypeApproval || ev.Text() != "connection waiting for approval" {
+		t.Errorf("Unexpected approval event: %+v (%q)", ev, ev.Text())
+	}
+}
+
+func TestSyslogSink(t *testing.T) {
+	alert, info := honeypotAlert("a-1", "warning")
+	ev := NewEvent(alert, info)
+
+	pc, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("ListenPacket failed: %v", err)
+	}
+	defer pc.Close()
+	udp, err := newSyslogSink(config.AlertSinkConfig{Address: pc.LocalAddr().String(), Facility: "daemon"})
+	if err != nil {
+		t.Fatalf("newSyslogSink failed: %v", err)
+	}
+	defer udp.Close()
+	if err := udp.Deliver(t.Context(), []*Event{ev}); err != nil {
+		t.Fatalf("Deliver failed: %v", err)
+	}
+	buf := make([]byte, 2048)
+	n, _, err := pc.ReadFrom(buf)
+	if err != nil {
+		t.Fatalf("ReadFrom failed: %v", err)
+	}
+	msg := string(buf[:n])
+	// daemon (3) * 8 + warning (4)
+	if !strings.HasPrefix(msg, "<28>1 2026-10-19T12:00:00Z ") {
+		t.Errorf("Unexpected header: %q", msg)
+	}
+	if !strings.Contains(msg, ` honeypot [nitella@32473 id="a-1" node="node-1" severity="warning" source="192.0.2.1" proxy="ssh"] honeypot login attempt from 192.0.2.1`) {
+		t.Errorf("Unexpected message: %q", msg)
+	}
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Listen failed: %v", err)
+	}
+	defer l.Close()
+	tcp, err := newSyslogSink(config.AlertSinkConfig{Address: l.Addr().String(), Network: "tcp"})
+	if err != nil {
+		t.Fatalf("newSyslogSink failed: %v", err)
+	}
+	defer tcp.Close()
+	if err := tcp.Deliver(t.Context(), []*Event{ev, ev}); err != nil {
+		t.Fatalf("Deliver failed: %v", err)
+	}
+	conn, err := l.Accept()
+	if err != nil {
+		t.Fatalf("Accept failed: %v", err)
+	}
+	defer conn.Close()
+	r := bufio.NewReader(conn)
+	for i := 0; i < 2; i++ {
+		length, err := r.ReadString(' ')
+		if err != nil {
+			t.Fatalf("Failed to read frame length: %v", err)
+		}
+		n, _ := strconv.Atoi(strings.TrimSpace(length))
+		frame := make([]byte, n)
+		if _, err := io.ReadFull(r, frame); err != nil || !strings.HasPrefix(string(frame), "<132>1 ") {
+			t.Fatalf("Expected an octet-counted local0 message, got %q (%v)", frame, err)
+		}
+	}
+}
+
+func TestSMTPSink(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Listen failed: %v", err)
+	}
+	defer l.Close()
+	received := make(chan string, 1)
+	go serveSMTP(l, received)
+
+	sink, err := newSMTPSink(config.AlertSinkConfig{
+		Address: l.Addr().String(), From: "nitella@example.com", To: []string{"oncall@example.com"},
+	})
+	if err != nil {
+		t.Fatalf("newSMTPSink failed: %v", err)
+	}
+	a1, info1 := honeypotAlert("a-1", "warning")
+	a2, info2 := honeypotAlert("a-2", "high")
+	if err := sink.Deliver(t.Context(), []*Event{NewEvent(a1, info1), NewEvent(a2, info2)}); err != nil {
+		t.Fatalf("Deliver failed: %v", err)
+	}
+
+	msg := <-received
+	for _, want := range []string{
+		"MAIL FROM:<nitella@example.com>",
+		"RCPT TO:<oncall@example.com>",
+		"Subject: [nitella] 2 alerts",
+		"[high] honeypot login attempt from 192.0.2.1",
+		"ID:          a-2",
+	} {
+		if !strings.Contains(msg, want) {
+			t.Errorf("Expected %q in the SMTP session:\n%s", want, msg)
+		}
+	}
+}
+
+func TestNewDispatcher_InvalidConfig(t *testing.T) {
+	for _, cfg := range []config.AlertSinkConfig{
+		{Type: "pager"},
+		{Type: "webhook", URL: "ftp://example.com"},
+		{Type: "syslog", Address: "127.0.0.1:514", Network: "sctp"},
+		{Type: "syslog", Address: "127.0.0.1:514", Facility: "mail"},
+		{Type: "smtp", Address: "127.0.0.1:25", From: "nitella@example.com"},
+		{Type: "webhook", URL: "http://127.0.0.1", MinSeverity: "urgent"},
+	} {
+		if _, err := NewDispatcher([]config.AlertSinkConfig{cfg}); err == nil {
+			t.Errorf("Expected an error for %+v", cfg)
+		}
+	}
+}
+
+// flakySink fails its next failures deliveries.
+type flakySink struct {
+	mu       sync.Mutex
+	failures int
+}
+
+func (s *flakySink) Deliver(_ context.Context, _ []*Event) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.failures > 0 {
+		s.failures--
+		return errors.New("sink down")
+	}
+	return nil
+}
+
+func (s *flakySink) setFailures(n int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.failures = n
+}
+
+func (s *flakySink) Close() error { return nil }
+
+// serveSMTP accepts one SMTP session and sends what the client wrote.
+func serveSMTP(l net.Listener, received chan<- string) {
+	conn, err := l.Accept()
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+	var session strings.Builder
+	r := bufio.NewReader(conn)
+	io.WriteString(conn, "220 localhost ESMTP\r\n")
+	inData := false
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			break
+		}
+		session.WriteString(line)
+		if inData {
+			if line == ".\r\n" {
+				inData = false
+				io.WriteString(conn, "250 OK\r\n")
+			}
+			continue
+		}
+		switch cmd := strings.ToUpper(strings.TrimSpace(line)); {
+		case strings.HasPrefix(cmd, "EHLO"), strings.HasPrefix(cmd, "HELO"):
+			io.WriteString(conn, "250 localhost\r\n")
+		case cmd == "DATA":
+			inData = true
+			io.WriteString(conn, "354 End data with <CR><LF>.<CR><LF>\r\n")
+		case cmd == "QUIT":
+			io.WriteString(conn, "221 Bye\r\n")
+			received <- session.String()
+			return
+		default:
+			io.WriteString(conn, "250 OK\r\n")
+		}
+	}
+	received <- session.String()
+}
+
+func waitFor(t *testing.T, cond func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatal("Timed out waiting for delivery")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
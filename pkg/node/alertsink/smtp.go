@@ -0,0 +1,142 @@
+package alertsink
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"strings"
+	"time"
+
+	"github.com/ivere27/nitella/pkg/config"
+)
+
+// smtpSink mails each batch as one plain text message. STARTTLS is used
+// when the server offers it, and required before authenticating.
+type smtpSink struct {
+	address  string
+	host     string
+	from     string
+	to       []string
+	username string
+	password string
+}
+
+func newSMTPSink(cfg config.AlertSinkConfig) (*smtpSink, error) {
+	host, _, err := net.SplitHostPort(cfg.Address)
+	if err != nil {
+		return nil, fmt.Errorf("smtp sink needs an address (host:port): %w", err)
+	}
+	if _, err := mail.ParseAddress(cfg.From); err != nil {
+		return nil, fmt.Errorf("invalid from address %q", cfg.From)
+	}
+	if len(cfg.To) == 0 {
+		return nil, fmt.Errorf("smtp sink needs a to address")
+	}
+	for _, to := range cfg.To {
+		if _, err := mail.ParseAddress(to); err != nil {
+			return nil, fmt.Errorf("invalid to address %q", to)
+		}
+	}
+	return &smtpSink{
+		address:  cfg.Address,
+		host:     host,
+		from:     cfg.From,
+		to:       cfg.To,
+		username: cfg.Username,
+		password: cfg.Password,
+	}, nil
+}
+
+func (s *smtpSink) Deliver(ctx context.Context, events []*Event) error {
+	conn, err := (&net.Dialer{}).DialContext(ctx, "tcp", s.address)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline)
+	}
+	c, err := smtp.NewClient(conn, s.host)
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+
+	if ok, _ := c.Extension("STARTTLS"); ok {
+		if err := c.StartTLS(&tls.Config{ServerName: s.host, MinVersion: tls.VersionTLS12}); err != nil {
+			return err
+		}
+	}
+	if s.username != "" {
+		// PlainAuth refuses to send the password without TLS (except to localhost)
+		if err := c.Auth(smtp.PlainAuth("", s.username, s.password, s.host)); err != nil {
+			return err
+		}
+	}
+	if err := c.Mail(s.from); err != nil {
+		return err
+	}
+	for _, to := range s.to {
+		if err := c.Rcpt(to); err != nil {
+			return err
+		}
+	}
+	w, err := c.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(s.message(events)); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+	return c.Quit()
+}
+
+// message renders events as a mail message.
+func (s *smtpSink) message(events []*Event) []byte {
+	subject := fmt.Sprintf("[nitella] %d alerts", len(events))
+	if len(events) == 1 {
+		subject = fmt.Sprintf("[nitella] %s: %s", events[0].Severity, events[0].Text())
+	}
+
+	var b strings.Builder
+	b.WriteString("From: " + s.from + "\r\n")
+	b.WriteString("To: " + strings.Join(s.to, ", ") + "\r\n")
+	b.WriteString("Subject: " + subject + "\r\n")
+	b.WriteString("Date: " + time.Now().Format(time.RFC1123Z) + "\r\n")
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=utf-8\r\n\r\n")
+	for _, ev := range events {
+		b.WriteString(fmt.Sprintf("%s [%s] %s\r\n", time.Unix(ev.Timestamp, 0).UTC().Format(time.RFC3339), ev.Severity, ev.Text()))
+		for _, p := range [][2]string{
+			{"ID", ev.ID},
+			{"Node", ev.NodeID},
+			{"Type", ev.Type},
+			{"Source", ev.SourceIP},
+			{"Destination", ev.Destination},
+			{"Proxy", ev.ProxyName},
+			{"Rule", ev.RuleID},
+			{"Country", ev.GeoCountry},
+		} {
+			if p[1] != "" {
+				b.WriteString(fmt.Sprintf("  %-12s %s\r\n", p[0]+":", oneLine(p[1])))
+			}
+		}
+		b.WriteString("\r\n")
+	}
+	return []byte(b.String())
+}
+
+func (s *smtpSink) Close() error {
+	return nil
+}
+
+// oneLine keeps a value from breaking the message's lines.
+func oneLine(v string) string {
+	return strings.Join(strings.Fields(v), " ")
+}
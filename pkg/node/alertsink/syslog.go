@@ -0,0 +1,196 @@
+package alertsink
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ivere27/nitella/pkg/config"
+)
+
+// syslogSDID names the structured data element of syslog messages. 32473
+// is the private enterprise number reserved for examples (RFC 5612).
+const syslogSDID = "nitella@32473"
+
+// syslogFacilities maps facility names to their codes (RFC 5424).
+var syslogFacilities = map[string]int{
+	"user": 1, "daemon": 3, "auth": 4, "authpriv": 10,
+	"local0": 16, "local1": 17, "local2": 18, "local3": 19,
+	"local4": 20, "local5": 21, "local6": 22, "local7": 23,
+}
+
+// syslogSink sends each event as an RFC 5424 message: one datagram per
+// message over UDP, octet-counted framing (RFC 6587) over TCP and TLS. The
+// stream connection is kept open between batches.
+type syslogSink struct {
+	network  string
+	address  string
+	facility int
+	tls      *tls.Config
+	hostname string
+	procID   string
+
+	mu   sync.Mutex
+	conn net.Conn
+}
+
+func newSyslogSink(cfg config.AlertSinkConfig) (*syslogSink, error) {
+	if cfg.Address == "" {
+		return nil, fmt.Errorf("syslog sink needs an address")
+	}
+	s := &syslogSink{
+		network:  cfg.Network,
+		address:  cfg.Address,
+		facility: syslogFacilities["local0"],
+		hostname: "-",
+		procID:   strconv.Itoa(os.Getpid()),
+	}
+	switch s.network {
+	case "":
+		s.network = "udp"
+	case "udp", "tcp":
+	case "tls":
+		host, _, err := net.SplitHostPort(cfg.Address)
+		if err != nil {
+			return nil, fmt.Errorf("invalid syslog address %q: %w", cfg.Address, err)
+		}
+		s.tls = &tls.Config{ServerName: host, MinVersion: tls.VersionTLS12}
+		if cfg.CAFile != "" {
+			pem, err := os.ReadFile(cfg.CAFile)
+			if err != nil {
+				return nil, err
+			}
+			s.tls.RootCAs = x509.NewCertPool()
+			if !s.tls.RootCAs.AppendCertsFromPEM(pem) {
+				return nil, fmt.Errorf("no certificates in %s", cfg.CAFile)
+			}
+		}
+	default:
+		return nil, fmt.Errorf("unknown syslog network %q (want udp, tcp or tls)", cfg.Network)
+	}
+	if cfg.Facility != "" {
+		code, ok := syslogFacilities[cfg.Facility]
+		if !ok {
+			return nil, fmt.Errorf("unknown syslog facility %q", cfg.Facility)
+		}
+		s.facility = code
+	}
+	if h, err := os.Hostname(); err == nil && h != "" {
+		s.hostname = h
+	}
+	return s, nil
+}
+
+func (s *syslogSink) Deliver(ctx context.Context, events []*Event) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.conn == nil {
+		conn, err := s.dial(ctx)
+		if err != nil {
+			return err
+		}
+		s.conn = conn
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		s.conn.SetWriteDeadline(deadline)
+	}
+	for _, ev := range events {
+		msg := s.format(ev)
+		if s.network != "udp" {
+			msg = strconv.Itoa(len(msg)) + " " + msg
+		}
+		if _, err := s.conn.Write([]byte(msg)); err != nil {
+			// Reconnect on the next attempt
+			s.conn.Close()
+			s.conn = nil
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *syslogSink) dial(ctx context.Context) (net.Conn, error) {
+	d := &net.Dialer{}
+	if s.tls != nil {
+		td := &tls.Dialer{NetDialer: d, Config: s.tls}
+		return td.DialContext(ctx, "tcp", s.address)
+	}
+	return d.DialContext(ctx, s.network, s.address)
+}
+
+// format renders ev as an RFC 5424 message.
+func (s *syslogSink) format(ev *Event) string {
+	var sd strings.Builder
+	sd.WriteString("[" + syslogSDID)
+	for _, p := range [][2]string{
+		{"id", ev.ID},
+		{"node", ev.NodeID},
+		{"severity", ev.Severity},
+		{"source", ev.SourceIP},
+		{"proxy", ev.ProxyID},
+		{"rule", ev.RuleID},
+		{"country", ev.GeoCountry},
+	} {
+		if p[1] != "" {
+			sd.WriteString(" " + p[0] + `="` + sdEscape(p[1]) + `"`)
+		}
+	}
+	sd.WriteString("]")
+
+	pri := s.facility*8 + syslogSeverity(ev.Severity)
+	ts := time.Unix(ev.Timestamp, 0).UTC().Format(time.RFC3339)
+	return fmt.Sprintf("<%d>1 %s %s nitellad %s %s %s %s", pri, ts, s.hostname, s.procID, syslogMsgID(ev.Type), sd.String(), ev.Text())
+}
+
+func (s *syslogSink) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.conn != nil {
+		s.conn.Close()
+		s.conn = nil
+	}
+	return nil
+}
+
+// syslogSeverity maps an alert severity to a syslog severity.
+func syslogSeverity(severity string) int {
+	switch severityRank(severity) {
+	case 3:
+		return 2 // Critical
+	case 2:
+		return 3 // Error
+	case 1:
+		return 4 // Warning
+	}
+	return 6 // Informational
+}
+
+// syslogMsgID returns the alert type as a MSGID: at most 32 printable
+// ASCII characters without spaces.
+func syslogMsgID(alertType string) string {
+	id := strings.Map(func(r rune) rune {
+		if r <= ' ' || r > '~' {
+			return -1
+		}
+		return r
+	}, alertType)
+	if len(id) > 32 {
+		id = id[:32]
+	}
+	if id == "" {
+		return "-"
+	}
+	return id
+}
+
+// sdEscape escapes a structured data parameter value.
+func sdEscape(v string) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`, `]`, `\]`).Replace(v)
+}
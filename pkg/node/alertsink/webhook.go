@@ -0,0 +1,65 @@
+package alertsink
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/ivere27/nitella/pkg/config"
+	nitellacrypto "github.com/ivere27/nitella/pkg/crypto"
+)
+
+// WebhookPayload is the JSON body POSTed to a webhook sink.
+type WebhookPayload struct {
+	Alerts []*Event `json:"alerts"`
+}
+
+// webhookSink POSTs each batch as a WebhookPayload. Any 2xx response
+// acknowledges the batch.
+type webhookSink struct {
+	url    string
+	secret string
+	client *http.Client
+}
+
+func newWebhookSink(cfg config.AlertSinkConfig) (*webhookSink, error) {
+	u, err := url.Parse(cfg.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, fmt.Errorf("webhook sink needs an http or https url")
+	}
+	return &webhookSink{url: cfg.URL, secret: cfg.Secret, client: &http.Client{}}, nil
+}
+
+func (s *webhookSink) Deliver(ctx context.Context, events []*Event) error {
+	body, err := json.Marshal(&WebhookPayload{Alerts: events})
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if s.secret != "" {
+		req.Header.Set(nitellacrypto.WebhookSignatureHeader, nitellacrypto.SignWebhook(s.secret, body))
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("webhook returned %s", resp.Status)
+	}
+	return nil
+}
+
+func (s *webhookSink) Close() error {
+	s.client.CloseIdleConnections()
+	return nil
+}
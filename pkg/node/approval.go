@@ -2,13 +2,18 @@ package node
 
 import (
 	"context"
+	"crypto/ed25519"
 	"fmt"
 	"sync"
 	"sync/atomic"
 	"time"
 
 	"github.com/ivere27/nitella/pkg/api/common"
+	pb "github.com/ivere27/nitella/pkg/api/proxy"
 	"github.com/ivere27/nitella/pkg/config"
+	"github.com/ivere27/nitella/pkg/log"
+	"github.com/ivere27/nitella/pkg/node/metrics"
+	"google.golang.org/protobuf/proto"
 )
 
 // KeySeparator is used to join components in approval cache keys.
@@ -32,8 +37,25 @@ type ApprovalManager struct {
 	maxPendingIP    int            // Maximum pending requests per IP
 	maxPendingProxy int            // Maximum pending requests per proxy
 
+	// Registered approver keys by fingerprint, for quorum votes
+	approvers map[string]ed25519.PublicKey
+
+	// Local approvers rules can name: the config's exec and webhook
+	// approvers by name, and the approval queue (nil = none)
+	localApprovers map[string]config.LocalApproverConfig
+	queue          *ApprovalQueue
+
+	// Context attached to requests: earlier decisions per source IP,
+	// configured IP sets and reverse DNS (nil = no lookups)
+	decisions map[string]*sourceDecisions
+	ipSets    []*IPSet
+	rdns      *ReverseResolver
+
 	// Cache for time-limited approvals
 	cache *ApprovalCache
+
+	// Time from request to decision
+	decisionLatency *metrics.Histogram
 }
 
 // ApprovalCache stores time-limited approval decisions
@@ -44,10 +66,26 @@ type ApprovalCache struct {
 	// Optional callback to close connections on expiry
 	connCloser ConnectionCloser
 
+	// Optional store keeping decisions across restarts. Changes are queued,
+	// latest per key, and written by persistLoop, so database writes never
+	// hold mu, which every connection's Check takes.
+	store     ApprovalStore
+	writesMu  sync.Mutex
+	writes    map[string]approvalWrite
+	writeCh   chan struct{} // Wakes persistLoop
+	persistMu sync.Mutex    // Held while a batch of writes is stored, in order
+
 	// Stop channel for graceful shutdown
 	stopCh chan struct{}
 }
 
+// approvalWrite is a queued store change: the entry to save, or a delete
+// when entry is nil.
+type approvalWrite struct {
+	sourceIP, ruleID, tlsSessionID string
+	entry                          *ApprovalEntry
+}
+
 // LiveConnStats holds pointers to live byte counters for an active connection.
 // This allows GetActiveApprovals to read real-time byte counts without waiting
 // for connections to close.
@@ -79,14 +117,30 @@ type ApprovalEntry struct {
 	BytesIn      int64
 	BytesOut     int64
 	BlockedCount int32
+
+	// Pending quorum requests (see GetPendingQuorums)
+	RequestID string
+	Quorum    int
+	Approvers []string // Fingerprints of approvers that voted to allow
 }
 
 // Key returns the unique key for this approval entry
 // Format: sourceIP\x00ruleID\x00tlsSessionID (uses null byte to avoid collision)
+// Pending quorum requests are keyed by their request ID.
 func (e *ApprovalEntry) Key() string {
+	if e.RequestID != "" {
+		return e.RequestID
+	}
 	return buildKey(e.SourceIP, e.RuleID, e.TLSSessionID)
 }
 
+// ApprovalStore persists cached approval decisions so they survive a
+// restart. Traffic counters and live connections are not persisted.
+type ApprovalStore interface {
+	SaveApproval(entry *ApprovalEntry) error
+	DeleteApproval(sourceIP, ruleID, tlsSessionID string) error
+}
+
 // ConnectionCloser is an interface for closing connections
 type ConnectionCloser interface {
 	CloseConnection(proxyID, connID string) error
@@ -96,9 +150,12 @@ type ConnectionCloser interface {
 func NewApprovalCache() *ApprovalCache {
 	c := &ApprovalCache{
 		entries: make(map[string]*ApprovalEntry),
+		writes:  make(map[string]approvalWrite),
+		writeCh: make(chan struct{}, 1),
 		stopCh:  make(chan struct{}),
 	}
 	go c.cleanupLoop()
+	go c.persistLoop()
 	return c
 }
 
@@ -114,6 +171,87 @@ func (c *ApprovalCache) SetConnectionCloser(closer ConnectionCloser) {
 	c.connCloser = closer
 }
 
+// SetStore persists the cache in store from now on and restores entries
+// loaded from it, skipping those already expired or bound to a TLS session.
+func (c *ApprovalCache) SetStore(store ApprovalStore, entries []*ApprovalEntry) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.persistMu.Lock()
+	c.store = store
+	c.persistMu.Unlock()
+	now := time.Now()
+	for _, e := range entries {
+		if now.Before(e.ExpiresAt) && e.TLSSessionID == "" {
+			c.entries[e.Key()] = e
+		}
+	}
+}
+
+// save queues entry to be persisted, if the cache has a store. Approvals
+// bound to a TLS session end with it, so they are not persisted. Called
+// with c.mu held so the store sees changes to a key in order.
+func (c *ApprovalCache) save(entry *ApprovalEntry) {
+	if c.store == nil || entry.TLSSessionID != "" {
+		return
+	}
+	saved := *entry
+	saved.LiveConns = nil
+	c.queueWrite(approvalWrite{sourceIP: entry.SourceIP, ruleID: entry.RuleID, entry: &saved})
+}
+
+// unsave queues deleting a persisted entry, if the cache has a store.
+// Called with c.mu held.
+func (c *ApprovalCache) unsave(sourceIP, ruleID, tlsSessionID string) {
+	if c.store == nil || tlsSessionID != "" {
+		return
+	}
+	c.queueWrite(approvalWrite{sourceIP: sourceIP, ruleID: ruleID})
+}
+
+// queueWrite replaces any queued change to w's key and wakes persistLoop.
+func (c *ApprovalCache) queueWrite(w approvalWrite) {
+	c.writesMu.Lock()
+	c.writes[buildKey(w.sourceIP, w.ruleID, w.tlsSessionID)] = w
+	c.writesMu.Unlock()
+	select {
+	case c.writeCh <- struct{}{}:
+	default:
+	}
+}
+
+// persistLoop stores queued changes until the cache stops.
+func (c *ApprovalCache) persistLoop() {
+	for {
+		select {
+		case <-c.stopCh:
+			c.flush()
+			return
+		case <-c.writeCh:
+			c.flush()
+		}
+	}
+}
+
+// flush stores the queued changes.
+func (c *ApprovalCache) flush() {
+	c.persistMu.Lock()
+	defer c.persistMu.Unlock()
+	c.writesMu.Lock()
+	writes := c.writes
+	c.writes = make(map[string]approvalWrite)
+	c.writesMu.Unlock()
+
+	for _, w := range writes {
+		if w.entry != nil {
+			if err := c.store.SaveApproval(w.entry); err != nil {
+				log.Printf("[WARN] Failed to persist approval for %s: %v", w.sourceIP, err)
+			}
+		} else if err := c.store.DeleteApproval(w.sourceIP, w.ruleID, w.tlsSessionID); err != nil {
+			log.Printf("[WARN] Failed to delete persisted approval for %s: %v", w.sourceIP, err)
+		}
+	}
+}
+
 // cleanupLoop periodically removes expired entries
 func (c *ApprovalCache) cleanupLoop() {
 	ticker := time.NewTicker(config.ApprovalCacheCleanupInterval)
@@ -136,6 +274,7 @@ func (c *ApprovalCache) cleanupLoop() {
 						}
 					}
 					delete(c.entries, key)
+					c.unsave(entry.SourceIP, entry.RuleID, entry.TLSSessionID)
 				}
 			}
 			closer := c.connCloser
@@ -177,19 +316,7 @@ func (c *ApprovalCache) Check(sourceIP, ruleID, tlsSessionID string) (bool, bool
 
 // Add adds an approval decision to the cache
 func (c *ApprovalCache) Add(sourceIP, ruleID, proxyID, tlsSessionID string, decision bool, duration time.Duration) {
-	c.mu.Lock()
-	defer c.mu.Unlock()
-
-	key := buildKey(sourceIP, ruleID, tlsSessionID)
-	c.entries[key] = &ApprovalEntry{
-		SourceIP:     sourceIP,
-		RuleID:       ruleID,
-		ProxyID:      proxyID,
-		TLSSessionID: tlsSessionID,
-		Decision:     decision,
-		ExpiresAt:    time.Now().Add(duration),
-		CreatedAt:    time.Now(),
-	}
+	c.AddWithGeo(sourceIP, ruleID, proxyID, tlsSessionID, decision, duration, "", "", "")
 }
 
 // AddWithGeo adds an entry with GeoIP information
@@ -198,7 +325,7 @@ func (c *ApprovalCache) AddWithGeo(sourceIP, ruleID, proxyID, tlsSessionID strin
 	defer c.mu.Unlock()
 
 	key := buildKey(sourceIP, ruleID, tlsSessionID)
-	c.entries[key] = &ApprovalEntry{
+	entry := &ApprovalEntry{
 		SourceIP:     sourceIP,
 		RuleID:       ruleID,
 		ProxyID:      proxyID,
@@ -210,6 +337,8 @@ func (c *ApprovalCache) AddWithGeo(sourceIP, ruleID, proxyID, tlsSessionID strin
 		GeoCity:      geoCity,
 		GeoISP:       geoISP,
 	}
+	c.entries[key] = entry
+	c.save(entry)
 }
 
 // Remove removes a cached approval
@@ -219,6 +348,7 @@ func (c *ApprovalCache) Remove(sourceIP, ruleID, tlsSessionID string) {
 
 	key := buildKey(sourceIP, ruleID, tlsSessionID)
 	delete(c.entries, key)
+	c.unsave(sourceIP, ruleID, tlsSessionID)
 }
 
 // SetConnID adds a connection ID with live byte counter pointers to the cached approval.
@@ -348,6 +478,11 @@ type ApprovalResult struct {
 	Duration      time.Duration
 	RuleID        string // Rule that triggered the approval request
 	Reason        string // Optional reason for the decision
+
+	// TimedOut is set when no decision arrived in time and the result is
+	// the policy's decision on timeout. Mock asks for the policy's mock.
+	TimedOut bool
+	Mock     bool
 }
 
 // ApprovalRequestMeta holds metadata for logging
@@ -359,6 +494,9 @@ type ApprovalRequestMeta struct {
 	GeoCountry string
 	GeoCity    string
 	GeoISP     string
+
+	Policy  *pb.ApprovalPolicy      // Timeout and decision on timeout (nil = defaults)
+	Context *common.ApprovalContext // What the node knows about the source (nil = nothing)
 }
 
 // PendingRequest represents a pending approval request
@@ -367,6 +505,21 @@ type PendingRequest struct {
 	Meta     ApprovalRequestMeta
 	SourceIP string        // For per-IP tracking
 	CancelCh chan struct{} // For async cancellation
+
+	// Alert details, re-sent as quorum votes arrive
+	NodeID    string
+	Info      string
+	CreatedAt time.Time
+
+	// Allow votes by approver fingerprint (quorum policies)
+	Votes map[string]ApprovalResult
+
+	// Announced is set once approvers were sent the alert; they are told
+	// when the request is settled (decided, expired or withdrawn) so every
+	// device but the one that decided can dismiss it. A settled request
+	// takes no more decisions.
+	Announced bool
+	Settled   bool
 }
 
 // NewApprovalManager creates a new approval manager
@@ -379,7 +532,9 @@ func NewApprovalManager(sender AlertSender) *ApprovalManager {
 		maxPending:      config.DefaultMaxPendingApprovals,
 		maxPendingIP:    config.DefaultMaxPendingPerIP,
 		maxPendingProxy: config.DefaultMaxPendingPerProxy,
+		decisions:       make(map[string]*sourceDecisions),
 		cache:           NewApprovalCache(),
+		decisionLatency: metrics.NewHistogram(metrics.LatencyBuckets),
 	}
 }
 
@@ -403,6 +558,7 @@ func (am *ApprovalManager) BeginApprovalRequest(reqID string, nodeID string, inf
 	sourceIP := meta.SourceIP
 
 	proxyID := meta.ProxyID
+	now := time.Now()
 
 	am.mu.Lock()
 	// DoS protection: reject if too many pending requests globally
@@ -421,11 +577,16 @@ func (am *ApprovalManager) BeginApprovalRequest(reqID string, nodeID string, inf
 		am.mu.Unlock()
 		return nil, fmt.Errorf("too many pending approval requests for proxy %s (max: %d)", proxyID, am.maxPendingProxy)
 	}
+	local := meta.Policy.GetLocal()
 	am.requests[reqID] = &PendingRequest{
-		ResultCh: resultCh,
-		Meta:     meta,
-		SourceIP: sourceIP,
-		CancelCh: cancelCh,
+		ResultCh:  resultCh,
+		Meta:      meta,
+		SourceIP:  sourceIP,
+		CancelCh:  cancelCh,
+		NodeID:    nodeID,
+		Info:      info,
+		CreatedAt: now,
+		Announced: local.GetName() == "",
 	}
 	am.pendingByIP[sourceIP]++
 	if proxyID != "" {
@@ -433,14 +594,12 @@ func (am *ApprovalManager) BeginApprovalRequest(reqID string, nodeID string, inf
 	}
 	am.mu.Unlock()
 
-	// Send Alert
-	alert := &common.Alert{
-		Id:            reqID,
-		NodeId:        nodeID,
-		Severity:      "high",
-		TimestampUnix: time.Now().Unix(),
+	// Send Alert, unless a local approver decides first
+	alert := approvalAlert(reqID, nodeID, now, 0, approvalQuorum(meta.Policy))
+	if local.GetName() != "" {
+		go am.decideLocally(reqID, local.GetName(), alert, info)
+		return resultCh, nil
 	}
-
 	if err := am.sender.SendAlert(alert, info); err != nil {
 		// Cleanup on send failure
 		am.CancelApprovalRequest(reqID)
@@ -452,36 +611,69 @@ func (am *ApprovalManager) BeginApprovalRequest(reqID string, nodeID string, inf
 
 // WaitForApproval waits for an approval decision with optional connection close detection.
 // connClosedCh should be closed when the connection dies (can be nil to disable).
+// When the request's policy timeout passes first, the policy's decision on
+// timeout is returned with TimedOut set.
 func (am *ApprovalManager) WaitForApproval(ctx context.Context, reqID string, resultCh chan ApprovalResult, connClosedCh <-chan struct{}) (ApprovalResult, error) {
-	if connClosedCh != nil {
-		select {
-		case res := <-resultCh:
-			return res, nil
-		case <-connClosedCh:
-			return ApprovalResult{Allowed: false}, fmt.Errorf("connection closed while waiting for approval")
-		case <-ctx.Done():
-			return ApprovalResult{Allowed: false}, fmt.Errorf("approval timeout: %w", ctx.Err())
-		}
-	} else {
-		select {
-		case res := <-resultCh:
-			return res, nil
-		case <-ctx.Done():
-			return ApprovalResult{Allowed: false}, fmt.Errorf("approval timeout: %w", ctx.Err())
-		}
+	var meta ApprovalRequestMeta
+	am.mu.Lock()
+	if req, ok := am.requests[reqID]; ok {
+		meta = req.Meta
+	}
+	am.mu.Unlock()
+
+	timer := time.NewTimer(approvalTimeout(meta.Policy))
+	defer timer.Stop()
+
+	// A nil connClosedCh never fires
+	select {
+	case res := <-resultCh:
+		return res, nil
+	case <-connClosedCh:
+		return ApprovalResult{Allowed: false}, fmt.Errorf("connection closed while waiting for approval")
+	case <-ctx.Done():
+		return ApprovalResult{Allowed: false}, fmt.Errorf("approval timeout: %w", ctx.Err())
+	case <-timer.C:
+		am.settle(reqID, config.ApprovalDecisionExpired)
+		return timeoutResult(meta), nil
+	}
+}
+
+// approvalTimeout is how long a request under policy waits for a decision.
+func approvalTimeout(policy *pb.ApprovalPolicy) time.Duration {
+	if s := policy.GetTimeoutSeconds(); s > 0 {
+		return time.Duration(s) * time.Second
+	}
+	return ApprovalRequestTimeout
+}
+
+// timeoutResult is the decision for a request nobody answered in time.
+func timeoutResult(meta ApprovalRequestMeta) ApprovalResult {
+	res := ApprovalResult{
+		RetentionMode: common.ApprovalRetentionMode_APPROVAL_RETENTION_MODE_CONNECTION_ONLY,
+		RuleID:        meta.RuleID,
+		Reason:        "approval timed out",
+		TimedOut:      true,
+	}
+	switch meta.Policy.GetOnTimeout() {
+	case pb.ApprovalTimeoutAction_APPROVAL_TIMEOUT_ALLOW:
+		res.Allowed = true
+	case pb.ApprovalTimeoutAction_APPROVAL_TIMEOUT_MOCK:
+		res.Mock = true
 	}
+	return res
 }
 
 // CancelApprovalRequest cleans up a pending approval request.
 // Safe to call multiple times or if request doesn't exist.
 func (am *ApprovalManager) CancelApprovalRequest(reqID string) {
 	am.mu.Lock()
-	defer am.mu.Unlock()
-
 	req, ok := am.requests[reqID]
 	if !ok {
+		am.mu.Unlock()
 		return
 	}
+	withdrawn := req.Announced && !req.Settled
+	req.Settled = true
 
 	// Decrement per-IP counter
 	if req.SourceIP != "" {
@@ -508,40 +700,115 @@ func (am *ApprovalManager) CancelApprovalRequest(reqID string) {
 	}
 
 	delete(am.requests, reqID)
-}
+	am.mu.Unlock()
 
-// Resolve is called when a decision is received from Hub
-func (am *ApprovalManager) Resolve(reqID string, allowed bool, durationSeconds int64, reason string) *ApprovalRequestMeta {
-	return am.ResolveWithRetention(reqID, allowed, durationSeconds, reason, common.ApprovalRetentionMode_APPROVAL_RETENTION_MODE_CACHE)
+	if withdrawn {
+		am.sendResolved(reqID, req, config.ApprovalDecisionWithdrawn)
+	}
 }
 
-// ResolveWithRetention is called when a decision is received from Hub/Admin.
-func (am *ApprovalManager) ResolveWithRetention(reqID string, allowed bool, durationSeconds int64, reason string, retentionMode common.ApprovalRetentionMode) *ApprovalRequestMeta {
+// settle stops reqID taking decisions, telling approvers it ended with
+// decision.
+func (am *ApprovalManager) settle(reqID, decision string) {
 	am.mu.Lock()
 	req, ok := am.requests[reqID]
+	if !ok || req.Settled {
+		am.mu.Unlock()
+		return
+	}
+	req.Settled = true
+	announced := req.Announced
 	am.mu.Unlock()
 
-	if !ok {
-		return nil
+	if announced {
+		am.sendResolved(reqID, req, decision)
+	}
+}
+
+// sendResolved tells approvers that req no longer needs a decision, so
+// clients that did not make it dismiss the prompt.
+func (am *ApprovalManager) sendResolved(reqID string, req *PendingRequest, decision string) {
+	info, err := proto.Marshal(&common.AlertDetails{
+		SourceIp: req.Meta.SourceIP,
+		ProxyId:  req.Meta.ProxyID,
+		RuleId:   req.Meta.RuleID,
+		Summary:  "Approval request resolved: " + decision,
+		Fields:   map[string]string{config.AlertFieldDecision: decision},
+	})
+	if err != nil {
+		log.Printf("[Approval] Failed to encode resolution of %s: %v", reqID, err)
+		return
 	}
+	alert := &common.Alert{
+		Id:            reqID,
+		NodeId:        req.NodeID,
+		Severity:      "info",
+		TimestampUnix: time.Now().Unix(),
+		Metadata: map[string]string{
+			config.AlertMetadataType: config.AlertTypeApprovalResolved,
+		},
+	}
+	if err := am.sender.SendAlert(alert, string(info)); err != nil {
+		log.Printf("[Approval] Failed to send resolution of %s: %v", reqID, err)
+	}
+}
+
+// Resolve is called when a decision is received from Hub
+func (am *ApprovalManager) Resolve(reqID string, allowed bool, durationSeconds int64, reason string) *ApprovalRequestMeta {
+	return am.ResolveWithRetention(reqID, allowed, durationSeconds, reason, common.ApprovalRetentionMode_APPROVAL_RETENTION_MODE_CACHE)
+}
 
+// ResolveWithRetention is called when a decision is received from Hub/Admin.
+// The decision is unsigned, so it cannot allow a request needing a quorum;
+// use ResolveRequest for signed votes.
+func (am *ApprovalManager) ResolveWithRetention(reqID string, allowed bool, durationSeconds int64, reason string, retentionMode common.ApprovalRetentionMode) *ApprovalRequestMeta {
 	mode := retentionMode
 	if mode == common.ApprovalRetentionMode_APPROVAL_RETENTION_MODE_UNSPECIFIED {
 		mode = common.ApprovalRetentionMode_APPROVAL_RETENTION_MODE_CACHE
 	}
 
-	select {
-	case req.ResultCh <- ApprovalResult{
+	meta, _, err := am.vote(reqID, "", ApprovalResult{
 		Allowed:       allowed,
 		RetentionMode: mode,
 		Duration:      time.Duration(durationSeconds) * time.Second,
-		RuleID:        req.Meta.RuleID,
 		Reason:        reason,
-	}:
-	default:
+	})
+	if err != nil {
+		return nil
 	}
+	return meta
+}
 
-	return &req.Meta
+// HasPendingRequest reports whether a request from sourceIP under ruleID
+// on proxyID is waiting for a decision.
+func (am *ApprovalManager) HasPendingRequest(proxyID, sourceIP, ruleID string) bool {
+	am.mu.Lock()
+	defer am.mu.Unlock()
+	for _, req := range am.requests {
+		if !req.Settled && req.SourceIP == sourceIP && req.Meta.ProxyID == proxyID && req.Meta.RuleID == ruleID {
+			return true
+		}
+	}
+	return false
+}
+
+// PendingCount returns the number of requests waiting for a decision.
+func (am *ApprovalManager) PendingCount() int {
+	am.mu.Lock()
+	defer am.mu.Unlock()
+	n := 0
+	for _, req := range am.requests {
+		if !req.Settled {
+			n++
+		}
+	}
+	return n
+}
+
+// DecisionLatency returns the distribution of the time requests waited
+// for a decision, in seconds.
+func (am *ApprovalManager) DecisionLatency() metrics.HistogramSnapshot {
+	return am.decisionLatency.Snapshot()
 }
 
 // CheckCache checks if there is a valid cached approval
@@ -585,6 +852,18 @@ func (am *ApprovalManager) SetConnectionCloser(closer ConnectionCloser) {
 	am.cache.SetConnectionCloser(closer)
 }
 
+// SetStore persists cached decisions in store and restores entries loaded
+// from it.
+func (am *ApprovalManager) SetStore(store ApprovalStore, entries []*ApprovalEntry) {
+	am.cache.SetStore(store, entries)
+}
+
+// FlushStore stores cached decision changes still queued, e.g. before the
+// store's database closes.
+func (am *ApprovalManager) FlushStore() {
+	am.cache.flush()
+}
+
 // IncrementBlockedCount increments the blocked attempt counter
 func (am *ApprovalManager) IncrementBlockedCount(sourceIP, ruleID, tlsSessionID string) {
 	am.cache.IncrementBlockedCount(sourceIP, ruleID, tlsSessionID)
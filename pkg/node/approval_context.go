@@ -0,0 +1,254 @@
+package node
+
+import (
+	"context"
+	"crypto/x509"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ivere27/nitella/pkg/api/common"
+	"github.com/ivere27/nitella/pkg/config"
+	"github.com/ivere27/nitella/pkg/node/stats"
+)
+
+// maxDecisionSources bounds the per-IP decision history; the source decided
+// on longest ago is forgotten when it is reached.
+const maxDecisionSources = 10000
+
+// sourceDecisions counts the approval decisions made for a source IP.
+type sourceDecisions struct {
+	approvals int32
+	denials   int32
+	last      time.Time
+}
+
+// recordDecision counts a decision for sourceIP. Called with am.mu held.
+func (am *ApprovalManager) recordDecision(sourceIP string, allowed bool, now time.Time) {
+	if sourceIP == "" {
+		return
+	}
+	d, ok := am.decisions[sourceIP]
+	if !ok {
+		if len(am.decisions) >= maxDecisionSources {
+			var oldest string
+			for ip, e := range am.decisions {
+				if oldest == "" || e.last.Before(am.decisions[oldest].last) {
+					oldest = ip
+				}
+			}
+			delete(am.decisions, oldest)
+		}
+		d = &sourceDecisions{}
+		am.decisions[sourceIP] = d
+	}
+	if allowed {
+		d.approvals++
+	} else {
+		d.denials++
+	}
+	d.last = now
+}
+
+// SetIPSets sets the IP sets approval request sources are looked up in.
+func (am *ApprovalManager) SetIPSets(sets []*IPSet) {
+	am.mu.Lock()
+	defer am.mu.Unlock()
+	am.ipSets = sets
+}
+
+// SetReverseResolver sets the resolver naming approval request sources
+// (nil = no reverse DNS).
+func (am *ApprovalManager) SetReverseResolver(r *ReverseResolver) {
+	am.mu.Lock()
+	defer am.mu.Unlock()
+	am.rdns = r
+}
+
+// SourceContext returns what the manager knows about sourceIP: earlier
+// decisions, the IP sets holding it and its reverse DNS name. An uncached
+// name is waited for up to wait; a slower lookup still fills the cache for
+// the next request.
+func (am *ApprovalManager) SourceContext(sourceIP string, wait time.Duration) *common.ApprovalContext {
+	ctx := &common.ApprovalContext{}
+	am.mu.Lock()
+	if d, ok := am.decisions[sourceIP]; ok {
+		ctx.PriorApprovals = d.approvals
+		ctx.PriorDenials = d.denials
+	}
+	sets, rdns := am.ipSets, am.rdns
+	am.mu.Unlock()
+
+	if ip := net.ParseIP(sourceIP); ip != nil {
+		for _, set := range sets {
+			if set.Contains(ip) {
+				ctx.IpSets = append(ctx.IpSets, set.Name)
+			}
+		}
+	}
+	if rdns != nil {
+		ctx.ReverseDns = rdns.Lookup(sourceIP, wait)
+	}
+	return ctx
+}
+
+// addSourceStats fills ctx with the connections recorded from sourceIP.
+func addSourceStats(ctx *common.ApprovalContext, st *stats.StatsService, sourceIP string) {
+	if st == nil {
+		return
+	}
+	res, err := st.GetIPByIP(sourceIP)
+	if err != nil {
+		return // Not seen before
+	}
+	ctx.ConnectionCount = res.ConnectionCount
+	ctx.BlockedCount = res.BlockedCount
+	ctx.FirstSeenUnix = res.FirstSeen.Unix()
+	ctx.LastSeenUnix = res.LastSeen.Unix()
+}
+
+// addClientCert fills ctx with the subject of a TLS client certificate.
+func addClientCert(ctx *common.ApprovalContext, cert *x509.Certificate) {
+	if cert == nil {
+		return
+	}
+	ctx.TlsSubject = cert.Subject.String()
+	ctx.TlsCn = cert.Subject.CommonName
+}
+
+// ReverseResolver names IPs by reverse DNS for approval requests. Only
+// forward-confirmed names are reported, since whoever controls an address
+// controls its PTR record. Answers are cached, and lookups are bounded in
+// time and concurrency.
+type ReverseResolver struct {
+	lookupAddr func(ctx context.Context, addr string) ([]string, error)
+	lookupHost func(ctx context.Context, host string) ([]string, error)
+	timeout    time.Duration
+	ttl        time.Duration
+	maxEntries int
+	inflight   chan struct{}
+
+	mu    sync.Mutex
+	cache map[string]*reverseLookup
+}
+
+// reverseLookup is a cached or running lookup; name and expires are set
+// under the resolver's mu before done is closed.
+type reverseLookup struct {
+	done    chan struct{}
+	name    string
+	expires time.Time
+}
+
+// NewReverseResolver returns a resolver using the system resolver.
+func NewReverseResolver() *ReverseResolver {
+	return &ReverseResolver{
+		lookupAddr: net.DefaultResolver.LookupAddr,
+		lookupHost: net.DefaultResolver.LookupHost,
+		timeout:    config.ReverseDNSTimeout,
+		ttl:        config.ReverseDNSCacheTTL,
+		maxEntries: config.ReverseDNSCacheSize,
+		inflight:   make(chan struct{}, config.ReverseDNSMaxInflight),
+		cache:      make(map[string]*reverseLookup),
+	}
+}
+
+// Lookup returns the confirmed name of ip, waiting up to wait for a lookup
+// not yet cached. It returns "" when there is none, the lookup is still
+// running, or the resolver is saturated.
+func (r *ReverseResolver) Lookup(ip string, wait time.Duration) string {
+	if net.ParseIP(ip) == nil {
+		return ""
+	}
+	now := time.Now()
+	r.mu.Lock()
+	l, ok := r.cache[ip]
+	if ok {
+		select {
+		case <-l.done:
+			if now.After(l.expires) {
+				ok = false
+			}
+		default:
+		}
+	}
+	if !ok {
+		if l = r.start(ip, now); l == nil {
+			r.mu.Unlock()
+			return ""
+		}
+	}
+	r.mu.Unlock()
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-l.done:
+	case <-timer.C:
+		return ""
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return l.name
+}
+
+// start begins a lookup for ip, or returns nil when the cache or the
+// lookup slots are full. Called with r.mu held.
+func (r *ReverseResolver) start(ip string, now time.Time) *reverseLookup {
+	if _, cached := r.cache[ip]; !cached && len(r.cache) >= r.maxEntries {
+		for k, e := range r.cache {
+			select {
+			case <-e.done:
+				if now.After(e.expires) {
+					delete(r.cache, k)
+				}
+			default:
+			}
+		}
+		if len(r.cache) >= r.maxEntries {
+			return nil
+		}
+	}
+	select {
+	case r.inflight <- struct{}{}:
+	default:
+		return nil
+	}
+
+	l := &reverseLookup{done: make(chan struct{})}
+	r.cache[ip] = l
+	go func() {
+		defer func() { <-r.inflight }()
+		name := r.resolve(ip)
+		r.mu.Lock()
+		l.name = name
+		l.expires = time.Now().Add(r.ttl)
+		r.mu.Unlock()
+		close(l.done)
+	}()
+	return l
+}
+
+// resolve returns the first PTR name of ip that resolves back to it.
+func (r *ReverseResolver) resolve(ip string) string {
+	ctx, cancel := context.WithTimeout(context.Background(), r.timeout)
+	defer cancel()
+	names, err := r.lookupAddr(ctx, ip)
+	if err != nil {
+		return ""
+	}
+	want := net.ParseIP(ip)
+	for _, name := range names {
+		addrs, err := r.lookupHost(ctx, name)
+		if err != nil {
+			continue
+		}
+		for _, addr := range addrs {
+			if want.Equal(net.ParseIP(addr)) {
+				return strings.TrimSuffix(name, ".")
+			}
+		}
+	}
+	return ""
+}
@@ -0,0 +1,154 @@
+package node
+
+import (
+	"context"
+	"errors"
+	"net"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/ivere27/nitella/pkg/api/common"
+	pbProxy "github.com/ivere27/nitella/pkg/api/proxy"
+	"google.golang.org/protobuf/proto"
+)
+
+// newTestResolver resolves from ptr and hosts, counting reverse lookups.
+// Lookups of addresses in block wait for it to be closed.
+func newTestResolver(ptr, hosts map[string][]string, block chan struct{}, calls *int32) *ReverseResolver {
+	return &ReverseResolver{
+		lookupAddr: func(ctx context.Context, addr string) ([]string, error) {
+			atomic.AddInt32(calls, 1)
+			if block != nil {
+				<-block
+			}
+			if names, ok := ptr[addr]; ok {
+				return names, nil
+			}
+			return nil, errors.New("no PTR record")
+		},
+		lookupHost: func(ctx context.Context, host string) ([]string, error) {
+			if addrs, ok := hosts[host]; ok {
+				return addrs, nil
+			}
+			return nil, errors.New("no such host")
+		},
+		timeout:    time.Second,
+		ttl:        time.Minute,
+		maxEntries: 10,
+		inflight:   make(chan struct{}, 1),
+		cache:      make(map[string]*reverseLookup),
+	}
+}
+
+func TestReverseResolver(t *testing.T) {
+	var calls int32
+	r := newTestResolver(map[string][]string{
+		"192.0.2.1": {"gw.example.com."},
+		"192.0.2.2": {"office.victim.example."}, // Spoofed PTR, no matching forward record
+	}, map[string][]string{
+		"gw.example.com.":        {"192.0.2.1"},
+		"office.victim.example.": {"203.0.113.1"},
+	}, nil, &calls)
+
+	if got := r.Lookup("192.0.2.1", time.Second); got != "gw.example.com" {
+		t.Errorf("Expected the confirmed name, got %q", got)
+	}
+	if got := r.Lookup("192.0.2.1", time.Second); got != "gw.example.com" || atomic.LoadInt32(&calls) != 1 {
+		t.Errorf("Expected the cached name without a new lookup, got %q after %d lookups", got, calls)
+	}
+	if got := r.Lookup("192.0.2.2", time.Second); got != "" {
+		t.Errorf("Expected an unconfirmed name dropped, got %q", got)
+	}
+	if got := r.Lookup("not-an-ip", time.Second); got != "" {
+		t.Errorf("Expected no lookup for an invalid address, got %q", got)
+	}
+}
+
+func TestReverseResolver_SlowAndSaturated(t *testing.T) {
+	var calls int32
+	block := make(chan struct{})
+	r := newTestResolver(map[string][]string{"192.0.2.1": {"gw.example.com"}},
+		map[string][]string{"gw.example.com": {"192.0.2.1"}}, block, &calls)
+
+	if got := r.Lookup("192.0.2.1", 10*time.Millisecond); got != "" {
+		t.Errorf("Expected no name while the lookup runs, got %q", got)
+	}
+	// The only lookup slot is taken
+	if got := r.Lookup("192.0.2.9", time.Second); got != "" || atomic.LoadInt32(&calls) != 1 {
+		t.Errorf("Expected a saturated resolver to skip the lookup, got %q after %d lookups", got, calls)
+	}
+
+	// The slow answer is kept for the next request
+	close(block)
+	if got := r.Lookup("192.0.2.1", time.Second); got != "gw.example.com" || atomic.LoadInt32(&calls) != 1 {
+		t.Errorf("Expected the first lookup's answer, got %q after %d lookups", got, calls)
+	}
+}
+
+func TestApprovalContext_Listener(t *testing.T) {
+	l, sender, am := newApprovalPolicyListener(t, &pbProxy.ApprovalPolicy{TimeoutSeconds: 10})
+	office, _ := ParseIPSet("office", strings.NewReader("127.0.0.0/8"))
+	feed, _ := ParseIPSet("tor-exits", strings.NewReader("198.51.100.0/24"))
+	am.SetIPSets([]*IPSet{office, feed})
+	var calls int32
+	am.SetReverseResolver(newTestResolver(map[string][]string{"127.0.0.1": {"localhost."}},
+		map[string][]string{"localhost.": {"127.0.0.1"}}, nil, &calls))
+
+	// An earlier request from the same source was denied
+	if _, err := am.BeginApprovalRequest("earlier", "node-1", "", ApprovalRequestMeta{SourceIP: "127.0.0.1", RuleID: "approve"}); err != nil {
+		t.Fatalf("BeginApprovalRequest failed: %v", err)
+	}
+	if am.Resolve("earlier", false, 0, "") == nil {
+		t.Fatal("Expected the earlier request resolved")
+	}
+	am.CancelApprovalRequest("earlier")
+
+	conn, err := net.Dial("tcp", l.ListenAddr)
+	if err != nil {
+		t.Fatalf("Dial failed: %v", err)
+	}
+	defer conn.Close()
+	waitAlerts(t, sender, 2)
+
+	var details common.AlertDetails
+	if err := proto.Unmarshal([]byte(sender.infos[1]), &details); err != nil {
+		t.Fatalf("Failed to decode alert details: %v", err)
+	}
+	ctx := details.GetContext()
+	if ctx.GetPriorDenials() != 1 || ctx.GetPriorApprovals() != 0 {
+		t.Errorf("Expected 1 prior denial, got %d approvals and %d denials", ctx.GetPriorApprovals(), ctx.GetPriorDenials())
+	}
+	if ctx.GetReverseDns() != "localhost" {
+		t.Errorf("Expected the reverse DNS name, got %q", ctx.GetReverseDns())
+	}
+	if len(ctx.GetIpSets()) != 1 || ctx.GetIpSets()[0] != "office" {
+		t.Errorf("Expected the office IP set hit, got %v", ctx.GetIpSets())
+	}
+	if ctx.GetTlsSubject() != "" {
+		t.Errorf("Expected no client certificate on plain TCP, got %q", ctx.GetTlsSubject())
+	}
+}
+
+func TestRecordDecision_Bounded(t *testing.T) {
+	am := NewApprovalManager(&MockAlertSender{})
+	defer am.cache.Stop()
+	start := time.Now()
+
+	am.mu.Lock()
+	for i := 0; i < maxDecisionSources; i++ {
+		am.recordDecision(net.IPv4(10, byte(i>>16), byte(i>>8), byte(i)).String(), true, start.Add(time.Duration(i)*time.Second))
+	}
+	am.recordDecision("192.0.2.1", false, start.Add(time.Duration(maxDecisionSources)*time.Second))
+	n := len(am.decisions)
+	_, oldestKept := am.decisions["10.0.0.0"]
+	am.mu.Unlock()
+
+	if n != maxDecisionSources || oldestKept {
+		t.Errorf("Expected the oldest source forgotten at %d sources, got %d (oldest kept: %v)", maxDecisionSources, n, oldestKept)
+	}
+	if c := am.SourceContext("192.0.2.1", 0); c.GetPriorDenials() != 1 {
+		t.Errorf("Expected 1 prior denial, got %d", c.GetPriorDenials())
+	}
+}
@@ -0,0 +1,538 @@
+package node
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"os/exec"
+	"sync"
+	"time"
+
+	"github.com/ivere27/nitella/pkg/api/common"
+	"github.com/ivere27/nitella/pkg/config"
+	nitellacrypto "github.com/ivere27/nitella/pkg/crypto"
+	"github.com/ivere27/nitella/pkg/log"
+)
+
+const (
+	// maxLocalDecisionSize caps a local approver's decision.
+	maxLocalDecisionSize = 64 << 10
+
+	// maxApproverStderr caps the stderr an exec approver's error quotes.
+	maxApproverStderr = 4 << 10
+
+	// approvalQueueWriteTimeout bounds a write to an approval queue client.
+	approvalQueueWriteTimeout = 5 * time.Second
+)
+
+// ErrNoApprovalQueue is returned when a QUEUE approver has no local client
+// to ask.
+var ErrNoApprovalQueue = errors.New("no approval queue client connected")
+
+// LocalApprovalRequest is the JSON a local approver is asked to decide on.
+// Withdrawn requests (decided elsewhere or timed out) are sent to queue
+// clients with only ID and Withdrawn set.
+type LocalApprovalRequest struct {
+	ID             string `json:"id"`
+	NodeID         string `json:"node_id,omitempty"`
+	ProxyID        string `json:"proxy_id,omitempty"`
+	SourceIP       string `json:"source_ip,omitempty"`
+	Destination    string `json:"destination,omitempty"`
+	RuleID         string `json:"rule_id,omitempty"`
+	GeoCountry     string `json:"geo_country,omitempty"`
+	GeoCity        string `json:"geo_city,omitempty"`
+	GeoISP         string `json:"geo_isp,omitempty"`
+	TimeoutSeconds int64  `json:"timeout_seconds,omitempty"`
+	Timestamp      int64  `json:"timestamp,omitempty"`
+	Withdrawn      bool   `json:"withdrawn,omitempty"`
+
+	Context *common.ApprovalContext `json:"context,omitempty"`
+}
+
+// LocalApprovalDecision is a local approver's answer. Retention is "cache"
+// (the default, for DurationSeconds) or "connection".
+type LocalApprovalDecision struct {
+	ID              string `json:"id"`
+	Allow           bool   `json:"allow"`
+	DurationSeconds int64  `json:"duration_seconds,omitempty"`
+	Retention       string `json:"retention,omitempty"`
+	Reason          string `json:"reason,omitempty"`
+}
+
+// result converts d like a decision from the admin API.
+func (d *LocalApprovalDecision) result() (ApprovalResult, error) {
+	res := ApprovalResult{
+		Allowed:       d.Allow,
+		RetentionMode: common.ApprovalRetentionMode_APPROVAL_RETENTION_MODE_CACHE,
+		Reason:        d.Reason,
+	}
+	seconds := d.DurationSeconds
+	switch d.Retention {
+	case "", "cache":
+		if seconds <= 0 {
+			seconds = config.DefaultApprovalDurationSeconds
+		}
+	case "connection":
+		res.RetentionMode = common.ApprovalRetentionMode_APPROVAL_RETENTION_MODE_CONNECTION_ONLY
+		if seconds < 0 {
+			seconds = 0
+		}
+	default:
+		return ApprovalResult{}, fmt.Errorf("unknown retention %q", d.Retention)
+	}
+	res.Duration = time.Duration(seconds) * time.Second
+	return res, nil
+}
+
+// LocalApproverQueue names the node's approval queue in a policy.
+const LocalApproverQueue = "queue"
+
+// validateLocalApprover rejects a local approver config missing what its
+// type needs.
+func validateLocalApprover(cfg config.LocalApproverConfig) error {
+	if cfg.Name == "" {
+		return fmt.Errorf("local approver needs a name")
+	}
+	if cfg.Name == LocalApproverQueue {
+		return fmt.Errorf("local approver name %q is reserved for the approval queue", cfg.Name)
+	}
+	switch cfg.Type {
+	case "exec":
+		if cfg.Command == "" {
+			return fmt.Errorf("exec approver %q needs a command", cfg.Name)
+		}
+	case "webhook":
+		u, err := url.Parse(cfg.URL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("webhook approver %q needs an http or https URL", cfg.Name)
+		}
+		if cfg.Secret == "" {
+			return fmt.Errorf("webhook approver %q needs a secret", cfg.Name)
+		}
+	default:
+		return fmt.Errorf("local approver %q has unknown type %q (want exec or webhook)", cfg.Name, cfg.Type)
+	}
+	return nil
+}
+
+// SetLocalApprovers sets the exec and webhook approvers rules can name.
+func (am *ApprovalManager) SetLocalApprovers(approvers []config.LocalApproverConfig) error {
+	byName := make(map[string]config.LocalApproverConfig, len(approvers))
+	for _, cfg := range approvers {
+		if err := validateLocalApprover(cfg); err != nil {
+			return err
+		}
+		if _, dup := byName[cfg.Name]; dup {
+			return fmt.Errorf("duplicate local approver %q", cfg.Name)
+		}
+		byName[cfg.Name] = cfg
+	}
+	am.mu.Lock()
+	am.localApprovers = byName
+	am.mu.Unlock()
+	return nil
+}
+
+// hasLocalApprover reports whether a policy may name name. The queue is
+// always known; requests go to the Hub while no client is connected.
+func (am *ApprovalManager) hasLocalApprover(name string) bool {
+	if name == LocalApproverQueue {
+		return true
+	}
+	if am == nil {
+		return false
+	}
+	am.mu.Lock()
+	defer am.mu.Unlock()
+	_, ok := am.localApprovers[name]
+	return ok
+}
+
+// SetQueue sets the queue policies naming LocalApproverQueue ask (nil =
+// none, such requests go to the Hub).
+func (am *ApprovalManager) SetQueue(q *ApprovalQueue) {
+	am.mu.Lock()
+	am.queue = q
+	am.mu.Unlock()
+}
+
+// decideLocally asks the local approver named name about reqID and applies
+// its decision. When the approver is unknown, unavailable or gives no
+// usable answer while the request is still pending, the approval alert
+// goes to the Hub.
+func (am *ApprovalManager) decideLocally(reqID, name string, alert *common.Alert, info string) {
+	am.mu.Lock()
+	req, ok := am.requests[reqID]
+	if !ok {
+		am.mu.Unlock()
+		return
+	}
+	queue := am.queue
+	cfg, known := am.localApprovers[name]
+	timeout := approvalTimeout(req.Meta.Policy)
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	go func(cancelCh chan struct{}) {
+		select {
+		case <-cancelCh:
+			cancel()
+		case <-ctx.Done():
+		}
+	}(req.CancelCh)
+	lreq := &LocalApprovalRequest{
+		ID:             reqID,
+		NodeID:         req.NodeID,
+		ProxyID:        req.Meta.ProxyID,
+		SourceIP:       req.SourceIP,
+		Destination:    req.Meta.DestAddr,
+		RuleID:         req.Meta.RuleID,
+		GeoCountry:     req.Meta.GeoCountry,
+		GeoCity:        req.Meta.GeoCity,
+		GeoISP:         req.Meta.GeoISP,
+		TimeoutSeconds: int64(timeout / time.Second),
+		Timestamp:      req.CreatedAt.Unix(),
+		Context:        req.Meta.Context,
+	}
+	am.mu.Unlock()
+
+	var dec *LocalApprovalDecision
+	var err error
+	switch {
+	case name == LocalApproverQueue:
+		if queue == nil {
+			err = ErrNoApprovalQueue
+		} else {
+			dec, err = queue.decide(ctx, lreq)
+		}
+	case !known:
+		// Removed from the config since the rule was added
+		err = fmt.Errorf("unknown local approver")
+	case cfg.Type == "exec":
+		dec, err = execApprover(ctx, cfg, lreq)
+	case cfg.Type == "webhook":
+		dec, err = webhookApprover(ctx, cfg, lreq)
+	}
+	if err == nil && dec.ID != "" && dec.ID != reqID {
+		err = fmt.Errorf("decision for %q", dec.ID)
+	}
+	if err == nil {
+		var res ApprovalResult
+		if res, err = dec.result(); err == nil {
+			_, _, err = am.vote(reqID, "", res)
+		}
+	}
+	if err == nil || ctx.Err() != nil || errors.Is(err, ErrApprovalNotFound) {
+		return
+	}
+
+	log.Printf("[Approval] Local approver %q unavailable for %s, asking the Hub: %v", name, reqID, err)
+	am.mu.Lock()
+	if req, ok := am.requests[reqID]; ok {
+		req.Announced = true
+	}
+	am.mu.Unlock()
+	if err := am.sender.SendAlert(alert, info); err != nil {
+		log.Printf("[Approval] Failed to send approval request %s: %v", reqID, err)
+	}
+}
+
+// execApprover runs cfg's command with req as JSON on stdin and reads the
+// decision from stdout. A command writing more than a decision's worth is
+// killed.
+func execApprover(ctx context.Context, cfg config.LocalApproverConfig, req *LocalApprovalRequest) (*LocalApprovalDecision, error) {
+	ctx, cancel := context.WithTimeout(ctx, config.LocalApproverTimeout)
+	defer cancel()
+
+	body, err := json.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+	stderr := &cappedBuffer{max: maxApproverStderr}
+	cmd := exec.CommandContext(ctx, cfg.Command, cfg.Args...)
+	cmd.Stdin = bytes.NewReader(body)
+	cmd.Stderr = stderr
+	pipe, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, err
+	}
+	if err := cmd.Start(); err != nil {
+		return nil, fmt.Errorf("%s: %w", cfg.Command, err)
+	}
+	stdout, readErr := io.ReadAll(io.LimitReader(pipe, maxLocalDecisionSize+1))
+	if len(stdout) > maxLocalDecisionSize {
+		cancel()
+		cmd.Wait()
+		return nil, fmt.Errorf("%s: decision too large", cfg.Command)
+	}
+	if err := cmd.Wait(); err != nil {
+		if msg := bytes.TrimSpace(stderr.Bytes()); len(msg) > 0 {
+			return nil, fmt.Errorf("%s: %w: %s", cfg.Command, err, msg)
+		}
+		return nil, fmt.Errorf("%s: %w", cfg.Command, err)
+	}
+	if readErr != nil {
+		return nil, fmt.Errorf("%s: %w", cfg.Command, readErr)
+	}
+	var dec LocalApprovalDecision
+	if err := json.Unmarshal(stdout, &dec); err != nil {
+		return nil, fmt.Errorf("%s: invalid decision: %w", cfg.Command, err)
+	}
+	return &dec, nil
+}
+
+// cappedBuffer keeps the first max bytes written to it and discards the
+// rest.
+type cappedBuffer struct {
+	bytes.Buffer
+	max int
+}
+
+func (b *cappedBuffer) Write(p []byte) (int, error) {
+	if room := b.max - b.Len(); room < len(p) {
+		b.Buffer.Write(p[:max(room, 0)])
+		return len(p), nil
+	}
+	return b.Buffer.Write(p)
+}
+
+// webhookApprover POSTs req to cfg's URL and returns the decision in the
+// response. Both bodies are signed with cfg's secret, and the decision
+// must name req, so a recorded response cannot answer another request.
+func webhookApprover(ctx context.Context, cfg config.LocalApproverConfig, req *LocalApprovalRequest) (*LocalApprovalDecision, error) {
+	ctx, cancel := context.WithTimeout(ctx, config.LocalApproverTimeout)
+	defer cancel()
+
+	body, err := json.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+	hreq, err := http.NewRequestWithContext(ctx, http.MethodPost, cfg.URL, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	hreq.Header.Set("Content-Type", "application/json")
+	hreq.Header.Set(nitellacrypto.WebhookSignatureHeader, nitellacrypto.SignWebhook(cfg.Secret, body))
+
+	resp, err := http.DefaultClient.Do(hreq)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("webhook returned %s", resp.Status)
+	}
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxLocalDecisionSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > maxLocalDecisionSize {
+		return nil, fmt.Errorf("webhook decision too large")
+	}
+	if !nitellacrypto.VerifyWebhook(cfg.Secret, data, resp.Header.Get(nitellacrypto.WebhookSignatureHeader)) {
+		return nil, fmt.Errorf("webhook response has a bad signature")
+	}
+	var dec LocalApprovalDecision
+	if err := json.Unmarshal(data, &dec); err != nil {
+		return nil, fmt.Errorf("invalid webhook decision: %w", err)
+	}
+	if dec.ID != req.ID {
+		return nil, fmt.Errorf("webhook decision for %q", dec.ID)
+	}
+	return &dec, nil
+}
+
+// ApprovalQueue hands approval requests to local clients on a Unix socket,
+// one JSON LocalApprovalRequest per line, and takes their decisions back
+// as LocalApprovalDecision lines. Clients connecting later are sent the
+// requests still pending.
+type ApprovalQueue struct {
+	ln net.Listener
+
+	mu      sync.Mutex
+	clients map[net.Conn]struct{}
+	pending map[string]*queuedApproval
+}
+
+// queuedApproval is a request waiting for a queue client's decision.
+type queuedApproval struct {
+	line   []byte
+	result chan queuedResult
+}
+
+type queuedResult struct {
+	dec *LocalApprovalDecision
+	err error
+}
+
+// ListenApprovalQueue serves an approval queue on the Unix socket at path,
+// replacing a stale socket. Only the node's user can connect.
+func ListenApprovalQueue(path string) (*ApprovalQueue, error) {
+	if fi, err := os.Lstat(path); err == nil && fi.Mode()&os.ModeSocket != 0 {
+		os.Remove(path)
+	}
+	ln, err := net.Listen("unix", path)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.Chmod(path, 0600); err != nil {
+		ln.Close()
+		return nil, err
+	}
+	q := &ApprovalQueue{
+		ln:      ln,
+		clients: make(map[net.Conn]struct{}),
+		pending: make(map[string]*queuedApproval),
+	}
+	go q.acceptLoop()
+	return q, nil
+}
+
+// Close stops the queue and disconnects its clients. Pending requests fall
+// back to the Hub.
+func (q *ApprovalQueue) Close() error {
+	err := q.ln.Close()
+	q.mu.Lock()
+	for c := range q.clients {
+		c.Close()
+	}
+	q.mu.Unlock()
+	return err
+}
+
+func (q *ApprovalQueue) acceptLoop() {
+	for {
+		conn, err := q.ln.Accept()
+		if err != nil {
+			return
+		}
+		q.mu.Lock()
+		q.clients[conn] = struct{}{}
+		lines := make([][]byte, 0, len(q.pending))
+		for _, p := range q.pending {
+			lines = append(lines, p.line)
+		}
+		q.mu.Unlock()
+		for _, line := range lines {
+			q.write([]net.Conn{conn}, line)
+		}
+		go q.readLoop(conn)
+	}
+}
+
+// readLoop applies conn's decisions until it disconnects.
+func (q *ApprovalQueue) readLoop(conn net.Conn) {
+	defer q.drop(conn)
+	scanner := bufio.NewScanner(conn)
+	scanner.Buffer(make([]byte, 4096), maxLocalDecisionSize)
+	for scanner.Scan() {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		var dec LocalApprovalDecision
+		if err := json.Unmarshal(line, &dec); err != nil {
+			log.Printf("[Approval] Invalid decision from approval queue client: %v", err)
+			continue
+		}
+		q.mu.Lock()
+		p, ok := q.pending[dec.ID]
+		var conns []net.Conn
+		if ok {
+			delete(q.pending, dec.ID)
+			conns = q.conns()
+		}
+		q.mu.Unlock()
+		if ok {
+			p.result <- queuedResult{dec: &dec}
+			q.broadcastWithdrawn(conns, dec.ID)
+		}
+	}
+}
+
+// drop forgets conn. Requests pending when the last client leaves fall
+// back to the Hub.
+func (q *ApprovalQueue) drop(conn net.Conn) {
+	conn.Close()
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	delete(q.clients, conn)
+	if len(q.clients) > 0 {
+		return
+	}
+	for id, p := range q.pending {
+		delete(q.pending, id)
+		p.result <- queuedResult{err: ErrNoApprovalQueue}
+	}
+}
+
+// conns returns the connected clients. Called with q.mu held.
+func (q *ApprovalQueue) conns() []net.Conn {
+	conns := make([]net.Conn, 0, len(q.clients))
+	for c := range q.clients {
+		conns = append(conns, c)
+	}
+	return conns
+}
+
+// write sends line to conns, dropping a client that cannot keep up. Called
+// without q.mu, so a slow client does not stall the queue.
+func (q *ApprovalQueue) write(conns []net.Conn, line []byte) {
+	for _, conn := range conns {
+		conn.SetWriteDeadline(time.Now().Add(approvalQueueWriteTimeout))
+		if _, err := conn.Write(line); err != nil {
+			conn.Close() // readLoop drops it
+		}
+	}
+}
+
+// broadcastWithdrawn tells conns id no longer needs a decision.
+func (q *ApprovalQueue) broadcastWithdrawn(conns []net.Conn, id string) {
+	line, _ := json.Marshal(&LocalApprovalRequest{ID: id, Withdrawn: true})
+	q.write(conns, append(line, '\n'))
+}
+
+// decide queues req and waits for a client's decision until ctx is done.
+func (q *ApprovalQueue) decide(ctx context.Context, req *LocalApprovalRequest) (*LocalApprovalDecision, error) {
+	line, err := json.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+	line = append(line, '\n')
+	p := &queuedApproval{line: line, result: make(chan queuedResult, 1)}
+
+	q.mu.Lock()
+	if len(q.clients) == 0 {
+		q.mu.Unlock()
+		return nil, ErrNoApprovalQueue
+	}
+	q.pending[req.ID] = p
+	conns := q.conns()
+	q.mu.Unlock()
+	q.write(conns, line)
+
+	select {
+	case r := <-p.result:
+		return r.dec, r.err
+	case <-ctx.Done():
+		q.mu.Lock()
+		_, ok := q.pending[req.ID]
+		var conns []net.Conn
+		if ok {
+			delete(q.pending, req.ID)
+			conns = q.conns()
+		}
+		q.mu.Unlock()
+		if ok {
+			q.broadcastWithdrawn(conns, req.ID)
+		}
+		return nil, ctx.Err()
+	}
+}
@@ -0,0 +1,306 @@
+package node
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ivere27/nitella/pkg/api/common"
+	pbProxy "github.com/ivere27/nitella/pkg/api/proxy"
+	"github.com/ivere27/nitella/pkg/config"
+	nitellacrypto "github.com/ivere27/nitella/pkg/crypto"
+)
+
+// beginLocal starts a request on am decided by the local approver name.
+func beginLocal(t *testing.T, am *ApprovalManager, reqID, name string) chan ApprovalResult {
+	t.Helper()
+	meta := ApprovalRequestMeta{SourceIP: "1.2.3.4", RuleID: "r1", DestAddr: "10.0.0.1:22",
+		Policy: &pbProxy.ApprovalPolicy{TimeoutSeconds: 10, Local: &pbProxy.LocalApprover{Name: name}}}
+	resultCh, err := am.BeginApprovalRequest(reqID, "node-1", "{}", meta)
+	if err != nil {
+		t.Fatalf("BeginApprovalRequest failed: %v", err)
+	}
+	t.Cleanup(func() { am.CancelApprovalRequest(reqID) })
+	return resultCh
+}
+
+func waitResult(t *testing.T, resultCh chan ApprovalResult) ApprovalResult {
+	t.Helper()
+	select {
+	case res := <-resultCh:
+		return res
+	case <-time.After(5 * time.Second):
+		t.Fatal("Expected a local decision")
+	}
+	return ApprovalResult{}
+}
+
+// waitAlerts waits for sender to have n alerts.
+func waitAlerts(t *testing.T, sender *MockAlertSender, n int) {
+	t.Helper()
+	for deadline := time.Now().Add(5 * time.Second); len(sender.GetAlerts()) < n; {
+		if time.Now().After(deadline) {
+			t.Fatalf("Expected %d alerts, got %d", n, len(sender.GetAlerts()))
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestLocalApprover_Exec(t *testing.T) {
+	sender := &MockAlertSender{}
+	am := NewApprovalManager(sender)
+	defer am.cache.Stop()
+
+	// The script sees the request on stdin
+	err := am.SetLocalApprovers([]config.LocalApproverConfig{{
+		Name:    "script",
+		Type:    "exec",
+		Command: "sh",
+		Args:    []string{"-c", `grep -q '"destination":"10.0.0.1:22"' && echo '{"id":"req-1","allow":true,"duration_seconds":60}'`},
+	}})
+	if err != nil {
+		t.Fatalf("SetLocalApprovers failed: %v", err)
+	}
+	resultCh := beginLocal(t, am, "req-1", "script")
+	res := waitResult(t, resultCh)
+	if !res.Allowed || res.Duration != time.Minute || res.RetentionMode != common.ApprovalRetentionMode_APPROVAL_RETENTION_MODE_CACHE {
+		t.Errorf("Expected a 1m cached allow, got %+v", res)
+	}
+	if len(sender.GetAlerts()) != 0 {
+		t.Error("Expected no Hub alert for a local decision")
+	}
+}
+
+func TestLocalApprover_ExecFallsBackToHub(t *testing.T) {
+	sender := &MockAlertSender{}
+	am := NewApprovalManager(sender)
+	defer am.cache.Stop()
+
+	if err := am.SetLocalApprovers([]config.LocalApproverConfig{{Name: "fail", Type: "exec", Command: "false"}}); err != nil {
+		t.Fatalf("SetLocalApprovers failed: %v", err)
+	}
+	beginLocal(t, am, "req-1", "fail")
+	waitAlerts(t, sender, 1)
+	if sender.GetAlerts()[0].Id != "req-1" {
+		t.Errorf("Expected the approval alert for req-1, got %q", sender.GetAlerts()[0].Id)
+	}
+	if am.Resolve("req-1", false, 0, "") == nil {
+		t.Error("Expected the request still pending for the Hub")
+	}
+
+	// An approver removed from the config since the rule was added
+	beginLocal(t, am, "req-2", "removed")
+	waitAlerts(t, sender, 2)
+}
+
+func TestLocalApprover_ExecOutputCapped(t *testing.T) {
+	sender := &MockAlertSender{}
+	am := NewApprovalManager(sender)
+	defer am.cache.Stop()
+
+	// A command that never stops writing is cut off, not buffered
+	if err := am.SetLocalApprovers([]config.LocalApproverConfig{{Name: "noisy", Type: "exec", Command: "yes"}}); err != nil {
+		t.Fatalf("SetLocalApprovers failed: %v", err)
+	}
+	beginLocal(t, am, "req-1", "noisy")
+	waitAlerts(t, sender, 1)
+}
+
+func TestLocalApprover_Webhook(t *testing.T) {
+	const secret = "s3cret"
+	var got LocalApprovalRequest
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if !nitellacrypto.VerifyWebhook(secret, body, r.Header.Get(nitellacrypto.WebhookSignatureHeader)) {
+			http.Error(w, "bad signature", http.StatusUnauthorized)
+			return
+		}
+		json.Unmarshal(body, &got)
+		resp, _ := json.Marshal(&LocalApprovalDecision{ID: got.ID, Allow: true, Retention: "connection"})
+		w.Header().Set(nitellacrypto.WebhookSignatureHeader, nitellacrypto.SignWebhook(secret, resp))
+		w.Write(resp)
+	}))
+	defer srv.Close()
+
+	sender := &MockAlertSender{}
+	am := NewApprovalManager(sender)
+	defer am.cache.Stop()
+	err := am.SetLocalApprovers([]config.LocalApproverConfig{
+		{Name: "hook", Type: "webhook", URL: srv.URL, Secret: secret},
+		{Name: "forged", Type: "webhook", URL: srv.URL, Secret: "other"},
+	})
+	if err != nil {
+		t.Fatalf("SetLocalApprovers failed: %v", err)
+	}
+
+	res := waitResult(t, beginLocal(t, am, "req-1", "hook"))
+	if !res.Allowed || res.RetentionMode != common.ApprovalRetentionMode_APPROVAL_RETENTION_MODE_CONNECTION_ONLY {
+		t.Errorf("Expected a connection-only allow, got %+v", res)
+	}
+	if got.SourceIP != "1.2.3.4" || got.RuleID != "r1" || got.TimeoutSeconds != 10 {
+		t.Errorf("Unexpected webhook request %+v", got)
+	}
+
+	// A response signed with another secret is not trusted
+	beginLocal(t, am, "req-2", "forged")
+	waitAlerts(t, sender, 1)
+}
+
+func TestLocalApprover_Queue(t *testing.T) {
+	sender := &MockAlertSender{}
+	am := NewApprovalManager(sender)
+	defer am.cache.Stop()
+	path := filepath.Join(t.TempDir(), "approval.sock")
+	queue, err := ListenApprovalQueue(path)
+	if err != nil {
+		t.Fatalf("ListenApprovalQueue failed: %v", err)
+	}
+	defer queue.Close()
+	am.SetQueue(queue)
+	local := LocalApproverQueue
+
+	// Without a client the Hub is asked
+	beginLocal(t, am, "req-0", local)
+	waitAlerts(t, sender, 1)
+
+	client, err := net.Dial("unix", path)
+	if err != nil {
+		t.Fatalf("Dial failed: %v", err)
+	}
+	defer client.Close()
+	client.SetReadDeadline(time.Now().Add(5 * time.Second))
+	r := bufio.NewReader(client)
+	// The node registers the client asynchronously
+	for deadline := time.Now().Add(5 * time.Second); ; time.Sleep(10 * time.Millisecond) {
+		queue.mu.Lock()
+		n := len(queue.clients)
+		queue.mu.Unlock()
+		if n == 1 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("Expected the queue client registered")
+		}
+	}
+
+	resultCh := beginLocal(t, am, "req-1", local)
+	line, err := r.ReadBytes('\n')
+	if err != nil {
+		t.Fatalf("Expected a queued request: %v", err)
+	}
+	var req LocalApprovalRequest
+	if err := json.Unmarshal(line, &req); err != nil || req.ID != "req-1" || req.SourceIP != "1.2.3.4" {
+		t.Fatalf("Unexpected queued request %s, %v", line, err)
+	}
+	client.Write([]byte(`{"id":"req-1","allow":false,"reason":"unknown host"}` + "\n"))
+	if res := waitResult(t, resultCh); res.Allowed || res.Reason != "unknown host" {
+		t.Errorf("Expected the queue's deny, got %+v", res)
+	}
+	line, err = r.ReadBytes('\n')
+	if err != nil || json.Unmarshal(line, &req) != nil || req.ID != "req-1" || !req.Withdrawn {
+		t.Errorf("Expected req-1 withdrawn after the decision, got %s, %v", line, err)
+	}
+
+	// Requests left undecided go to the Hub when the client disconnects
+	beginLocal(t, am, "req-2", local)
+	if _, err := r.ReadBytes('\n'); err != nil {
+		t.Fatalf("Expected a queued request: %v", err)
+	}
+	client.Close()
+	waitAlerts(t, sender, 2)
+}
+
+func TestApprovalQueue_SlowClient(t *testing.T) {
+	queue, err := ListenApprovalQueue(filepath.Join(t.TempDir(), "approval.sock"))
+	if err != nil {
+		t.Fatalf("ListenApprovalQueue failed: %v", err)
+	}
+	defer queue.Close()
+
+	// A client that never reads
+	stalled, peer := net.Pipe()
+	defer peer.Close()
+	queue.mu.Lock()
+	queue.clients[stalled] = struct{}{}
+	queue.mu.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	go queue.decide(ctx, &LocalApprovalRequest{ID: "req-1"})
+	for deadline := time.Now().Add(5 * time.Second); ; time.Sleep(10 * time.Millisecond) {
+		queue.mu.Lock()
+		n := len(queue.pending)
+		queue.mu.Unlock()
+		if n == 1 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("Expected req-1 queued")
+		}
+	}
+
+	// The write to it is stuck, but the queue is not
+	locked := make(chan struct{})
+	go func() {
+		queue.mu.Lock()
+		queue.mu.Unlock()
+		close(locked)
+	}()
+	select {
+	case <-locked:
+	case <-time.After(approvalQueueWriteTimeout / 2):
+		t.Fatal("Expected the queue lock free while a client write is stuck")
+	}
+}
+
+func TestValidateLocalApprover(t *testing.T) {
+	am := NewApprovalManager(&MockAlertSender{})
+	defer am.cache.Stop()
+	for i, cfgs := range [][]config.LocalApproverConfig{
+		{{Name: "a", Type: "exec"}},
+		{{Name: "a", Type: "webhook", URL: "ftp://x", Secret: "s"}},
+		{{Name: "a", Type: "webhook", URL: "http://127.0.0.1:9/x"}},
+		{{Name: "a", Type: "script", Command: "x"}},
+		{{Type: "exec", Command: "x"}},
+		{{Name: LocalApproverQueue, Type: "exec", Command: "x"}},
+		{{Name: "a", Type: "exec", Command: "x"}, {Name: "a", Type: "exec", Command: "y"}},
+	} {
+		if am.SetLocalApprovers(cfgs) == nil {
+			t.Errorf("case %d: expected %+v rejected", i, cfgs)
+		}
+	}
+	err := am.SetLocalApprovers([]config.LocalApproverConfig{{Name: "hook", Type: "webhook", URL: "http://127.0.0.1:9/x", Secret: "s"}})
+	if err != nil {
+		t.Fatalf("SetLocalApprovers failed: %v", err)
+	}
+
+	tests := []struct {
+		name string
+		ok   bool
+	}{
+		{"", true},
+		{LocalApproverQueue, true},
+		{"hook", true},
+		{"unknown", false},
+	}
+	for _, tt := range tests {
+		policy := &pbProxy.ApprovalPolicy{Local: &pbProxy.LocalApprover{Name: tt.name}}
+		if err := validateApprovalPolicy(policy, am); (err == nil) != tt.ok {
+			t.Errorf("%q: expected ok=%v, got %v", tt.name, tt.ok, err)
+		}
+	}
+	if validateApprovalPolicy(&pbProxy.ApprovalPolicy{Local: &pbProxy.LocalApprover{Name: "hook"}}, nil) == nil {
+		t.Error("Expected named approvers unknown without an approval manager")
+	}
+	quorum := &pbProxy.ApprovalPolicy{Quorum: 2, Local: &pbProxy.LocalApprover{Name: LocalApproverQueue}}
+	if validateApprovalPolicy(quorum, am) == nil {
+		t.Error("Expected a local approver on a quorum rule rejected")
+	}
+}
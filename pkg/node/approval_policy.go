@@ -0,0 +1,235 @@
+package node
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"time"
+
+	pb "github.com/ivere27/nitella/pkg/api/proxy"
+	"github.com/ivere27/nitella/pkg/config"
+	"github.com/ivere27/nitella/pkg/log"
+)
+
+const (
+	// approvalHoldReadTimeout bounds how long an HTTP_PENDING hold waits
+	// for the request before answering it.
+	approvalHoldReadTimeout = 5 * time.Second
+
+	// approvalHoldMaxRequest is how much of the request HTTP_PENDING reads.
+	approvalHoldMaxRequest = 8 << 10
+
+	// approvalPendingRetry is when the pending page asks the client to
+	// retry, by which time the decision is usually cached.
+	approvalPendingRetry = 10
+)
+
+// approvalPendingPage is served by APPROVAL_HOLD_HTTP_PENDING.
+const approvalPendingPage = `<!DOCTYPE html>
+<html><head><title>Approval pending</title><meta http-equiv="refresh" content="%[1]d"></head>
+<body><h1>Approval pending</h1><p>This connection is waiting for approval. The page will retry in %[1]d seconds.</p></body></html>
+`
+
+// validateApprovalPolicy rejects out of range timeouts and quorums, local
+// approvers am does not know, and timeout actions the hold cannot deliver.
+// Timeouts are capped by how long the Hub keeps pending approval alerts.
+func validateApprovalPolicy(policy *pb.ApprovalPolicy, am *ApprovalManager) error {
+	if t := policy.GetTimeoutSeconds(); t < 0 || t > config.MaxApprovalTimeoutSeconds {
+		return fmt.Errorf("approval timeout must be between 0 and %d seconds", config.MaxApprovalTimeoutSeconds)
+	}
+	// HTTP_PENDING closes the connection after the pending page, and
+	// timeout decisions are not cached, so no retry would see them
+	if policy.GetHold() == pb.ApprovalHold_APPROVAL_HOLD_HTTP_PENDING &&
+		(policy.GetOnTimeout() == pb.ApprovalTimeoutAction_APPROVAL_TIMEOUT_ALLOW ||
+			policy.GetOnTimeout() == pb.ApprovalTimeoutAction_APPROVAL_TIMEOUT_MOCK) {
+		return fmt.Errorf("the HTTP_PENDING hold only supports denying on timeout")
+	}
+	if q := policy.GetQuorum(); q < 0 || q > maxApprovalQuorum {
+		return fmt.Errorf("approval quorum must be between 0 and %d", maxApprovalQuorum)
+	}
+	if name := policy.GetLocal().GetName(); name != "" {
+		if policy.GetQuorum() > 1 {
+			return fmt.Errorf("local approvers cannot vote towards a quorum")
+		}
+		if !am.hasLocalApprover(name) {
+			return fmt.Errorf("unknown local approver %q", name)
+		}
+	}
+	return nil
+}
+
+// approvalPolicyJSON serializes a policy for the DB ("" when unset).
+func approvalPolicyJSON(policy *pb.ApprovalPolicy) string {
+	if policy == nil {
+		return ""
+	}
+	b, _ := json.Marshal(policy)
+	return string(b)
+}
+
+// parseApprovalPolicyJSON restores a policy saved by approvalPolicyJSON.
+func parseApprovalPolicyJSON(s string) *pb.ApprovalPolicy {
+	if s == "" {
+		return nil
+	}
+	var policy pb.ApprovalPolicy
+	if err := json.Unmarshal([]byte(s), &policy); err != nil {
+		log.Printf("Warning: Failed to parse approval policy: %v", err)
+		return nil
+	}
+	return &policy
+}
+
+// approvalPolicyFields describes a policy in the approval alert, so the
+// approver knows what happens if they do not answer. Nil for no policy.
+func approvalPolicyFields(policy *pb.ApprovalPolicy) map[string]string {
+	if policy == nil {
+		return nil
+	}
+	onTimeout := "deny"
+	switch policy.OnTimeout {
+	case pb.ApprovalTimeoutAction_APPROVAL_TIMEOUT_ALLOW:
+		onTimeout = "allow"
+	case pb.ApprovalTimeoutAction_APPROVAL_TIMEOUT_MOCK:
+		onTimeout = "mock"
+	}
+	return map[string]string{
+		"timeout":    approvalTimeout(policy).String(),
+		"on_timeout": onTimeout,
+	}
+}
+
+// sshBannerPrefix starts the version banner SSH clients send on connect.
+const sshBannerPrefix = "SSH-"
+
+// approvalHold keeps a client occupied while its connection waits for an
+// approval decision.
+type approvalHold struct {
+	quit     chan struct{}
+	done     chan struct{}
+	answered bool     // The client got a pending page and the connection is closed
+	conn     net.Conn // Set while the hold reads from the connection
+	peeked   []byte   // Client bytes read by the hold
+}
+
+// holdForApproval starts the policy's hold behaviour on conn. The caller
+// must call stop before using conn again, then use the conn it returns.
+func (p *EmbeddedListener) holdForApproval(conn net.Conn, policy *pb.ApprovalPolicy) *approvalHold {
+	h := &approvalHold{quit: make(chan struct{}), done: make(chan struct{})}
+	switch policy.GetHold() {
+	case pb.ApprovalHold_APPROVAL_HOLD_TARPIT:
+		if !p.tarpitBudget.acquire(tarpitConnOverhead) {
+			close(h.done)
+			break
+		}
+		h.conn = conn
+		go func() {
+			defer close(h.done)
+			defer p.tarpitBudget.release(tarpitConnOverhead)
+			// Only SSH clients skip lines before the server's banner. They
+			// send their own banner first; other clients are held silently.
+			banner := make([]byte, len(sshBannerPrefix))
+			n, _ := io.ReadFull(conn, banner)
+			h.peeked = banner[:n]
+			if string(h.peeked) != sshBannerPrefix {
+				<-h.quit
+				return
+			}
+			dripPending(conn, h.quit)
+		}()
+	case pb.ApprovalHold_APPROVAL_HOLD_HTTP_PENDING:
+		h.answered = true
+		go func() {
+			defer close(h.done)
+			servePendingPage(conn, approvalPendingRetry)
+		}()
+	default:
+		close(h.done)
+	}
+	return h
+}
+
+// stop ends the hold and reports whether the connection was answered and
+// closed, in which case the decision only applies to later connections.
+func (h *approvalHold) stop() bool {
+	close(h.quit)
+	if h.conn != nil {
+		// Interrupt the banner read
+		h.conn.SetReadDeadline(time.Now())
+	}
+	<-h.done
+	if h.conn != nil {
+		h.conn.SetReadDeadline(time.Time{})
+	}
+	return h.answered
+}
+
+// wrap returns conn replaying the client bytes the hold read, if any.
+func (h *approvalHold) wrap(conn net.Conn) net.Conn {
+	if len(h.peeked) == 0 {
+		return conn
+	}
+	return &replayConn{Conn: conn, r: io.MultiReader(bytes.NewReader(h.peeked), conn)}
+}
+
+// replayConn serves reads from r, which starts with bytes already read
+// from the connection.
+type replayConn struct {
+	net.Conn
+	r io.Reader
+}
+
+func (c *replayConn) Read(p []byte) (int, error) { return c.r.Read(p) }
+
+// dripPending writes a short random line every tarpit drip interval until
+// quit. SSH clients skip lines before the server's version banner, so an
+// approved SSH connection still works afterwards.
+func dripPending(conn net.Conn, quit <-chan struct{}) {
+	defer conn.SetWriteDeadline(time.Time{})
+	interval := defaultTarpitDripIntervalMs * time.Millisecond
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	line := make([]byte, 8)
+	for {
+		select {
+		case <-quit:
+			return
+		case <-ticker.C:
+			rand.Read(line)
+			conn.SetWriteDeadline(time.Now().Add(interval))
+			if _, err := fmt.Fprintf(conn, "%x\r\n", line); err != nil {
+				return
+			}
+		}
+	}
+}
+
+// servePendingPage reads the client's HTTP request, answers it with a 503
+// pending page asking it to retry, and closes the connection.
+func servePendingPage(conn net.Conn, retrySeconds int) {
+	defer conn.Close()
+
+	// Read the request first: closing with unread data resets the
+	// connection and the client may never show the page
+	conn.SetReadDeadline(time.Now().Add(approvalHoldReadTimeout))
+	buf := make([]byte, approvalHoldMaxRequest)
+	n := 0
+	for n < len(buf) && !bytes.Contains(buf[:n], []byte("\r\n\r\n")) {
+		m, err := conn.Read(buf[n:])
+		n += m
+		if err != nil {
+			break
+		}
+	}
+
+	body := fmt.Sprintf(approvalPendingPage, retrySeconds)
+	conn.SetWriteDeadline(time.Now().Add(approvalHoldReadTimeout))
+	_, err := fmt.Fprintf(conn, "HTTP/1.1 503 Service Unavailable\r\nContent-Type: text/html; charset=utf-8\r\nContent-Length: %d\r\nRetry-After: %d\r\nCache-Control: no-store\r\nConnection: close\r\n\r\n%s",
+		len(body), retrySeconds, body)
+	if err != nil {
+		log.Printf("[Approval] Failed to send pending page to %s: %v", conn.RemoteAddr(), err)
+	}
+}
@@ -0,0 +1,253 @@
+package node
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/ivere27/nitella/pkg/api/common"
+	pbProxy "github.com/ivere27/nitella/pkg/api/proxy"
+	"github.com/ivere27/nitella/pkg/config"
+)
+
+func TestApprovalManager_TimeoutPolicy(t *testing.T) {
+	am := NewApprovalManager(&MockAlertSender{})
+	tests := []struct {
+		onTimeout pbProxy.ApprovalTimeoutAction
+		allowed   bool
+		mock      bool
+	}{
+		{pbProxy.ApprovalTimeoutAction_APPROVAL_TIMEOUT_UNSPECIFIED, false, false},
+		{pbProxy.ApprovalTimeoutAction_APPROVAL_TIMEOUT_ALLOW, true, false},
+		{pbProxy.ApprovalTimeoutAction_APPROVAL_TIMEOUT_MOCK, false, true},
+	}
+	for _, tt := range tests {
+		meta := ApprovalRequestMeta{SourceIP: "1.2.3.4", RuleID: "r1",
+			Policy: &pbProxy.ApprovalPolicy{TimeoutSeconds: 1, OnTimeout: tt.onTimeout}}
+		resultCh, err := am.BeginApprovalRequest("req-"+tt.onTimeout.String(), "node-1", "{}", meta)
+		if err != nil {
+			t.Fatalf("BeginApprovalRequest failed: %v", err)
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		start := time.Now()
+		result, err := am.WaitForApproval(ctx, "req-"+tt.onTimeout.String(), resultCh, nil)
+		cancel()
+		am.CancelApprovalRequest("req-" + tt.onTimeout.String())
+		if err != nil {
+			t.Fatalf("%v: expected the policy decision, got %v", tt.onTimeout, err)
+		}
+		if !result.TimedOut || result.Allowed != tt.allowed || result.Mock != tt.mock || result.RuleID != "r1" {
+			t.Errorf("%v: unexpected result %+v", tt.onTimeout, result)
+		}
+		if elapsed := time.Since(start); elapsed < time.Second || elapsed > 3*time.Second {
+			t.Errorf("%v: expected a 1s timeout, waited %v", tt.onTimeout, elapsed)
+		}
+	}
+}
+
+// newApprovalPolicyListener starts a listener in front of an echo backend
+// whose connections from 127.0.0.0/8 require approval under policy.
+func newApprovalPolicyListener(t *testing.T, policy *pbProxy.ApprovalPolicy) (*EmbeddedListener, *MockAlertSender, *ApprovalManager) {
+	backend := startEchoBackend(t)
+	sender := &MockAlertSender{}
+	am := NewApprovalManager(sender)
+	t.Cleanup(am.cache.Stop)
+
+	l := NewEmbeddedListener("test-approval-policy", "Policy", "127.0.0.1:0", backend.Addr().String(), common.ActionType_ACTION_TYPE_BLOCK, common.MockPreset_MOCK_PRESET_UNSPECIFIED, "", "", "", pbProxy.ClientAuthType_CLIENT_AUTH_AUTO, nil)
+	l.SetApprovalManager(am)
+	l.AddRule(&pbProxy.Rule{
+		Id: "approve", Priority: 10, Enabled: true, Action: common.ActionType_ACTION_TYPE_REQUIRE_APPROVAL,
+		Conditions:     []*pbProxy.Condition{cond(common.ConditionType_CONDITION_TYPE_SOURCE_IP, common.Operator_OPERATOR_CIDR, "127.0.0.0/8")},
+		ApprovalPolicy: policy,
+	})
+	if err := l.Start(); err != nil {
+		t.Fatalf("Start failed: %v", err)
+	}
+	t.Cleanup(func() { l.Stop() })
+	return l, sender, am
+}
+
+func TestValidateApprovalPolicy_Timeout(t *testing.T) {
+	for _, tt := range []struct {
+		timeout int64
+		ok      bool
+	}{
+		{0, true},
+		{config.MaxApprovalTimeoutSeconds, true},
+		{-1, false},
+		{config.MaxApprovalTimeoutSeconds + 1, false},
+	} {
+		if err := validateApprovalPolicy(&pbProxy.ApprovalPolicy{TimeoutSeconds: tt.timeout}, nil); (err == nil) != tt.ok {
+			t.Errorf("timeout %d: expected ok=%v, got %v", tt.timeout, tt.ok, err)
+		}
+	}
+}
+
+func TestValidateApprovalPolicy_HTTPPendingTimeout(t *testing.T) {
+	for _, tt := range []struct {
+		onTimeout pbProxy.ApprovalTimeoutAction
+		ok        bool
+	}{
+		{pbProxy.ApprovalTimeoutAction_APPROVAL_TIMEOUT_UNSPECIFIED, true},
+		{pbProxy.ApprovalTimeoutAction_APPROVAL_TIMEOUT_DENY, true},
+		{pbProxy.ApprovalTimeoutAction_APPROVAL_TIMEOUT_ALLOW, false},
+		{pbProxy.ApprovalTimeoutAction_APPROVAL_TIMEOUT_MOCK, false},
+	} {
+		policy := &pbProxy.ApprovalPolicy{
+			OnTimeout: tt.onTimeout,
+			Hold:      pbProxy.ApprovalHold_APPROVAL_HOLD_HTTP_PENDING,
+		}
+		if err := validateApprovalPolicy(policy, nil); (err == nil) != tt.ok {
+			t.Errorf("%v: expected ok=%v, got %v", tt.onTimeout, tt.ok, err)
+		}
+	}
+}
+
+func TestApprovalPolicy_TarpitThenAllow(t *testing.T) {
+	l, sender, _ := newApprovalPolicyListener(t, &pbProxy.ApprovalPolicy{
+		TimeoutSeconds: 2,
+		OnTimeout:      pbProxy.ApprovalTimeoutAction_APPROVAL_TIMEOUT_ALLOW,
+		Hold:           pbProxy.ApprovalHold_APPROVAL_HOLD_TARPIT,
+	})
+
+	conn, err := net.Dial("tcp", l.ListenAddr)
+	if err != nil {
+		t.Fatalf("Dial failed: %v", err)
+	}
+	defer conn.Close()
+	conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	r := bufio.NewReader(conn)
+
+	// An SSH client is fed junk lines while pending
+	conn.Write([]byte("SSH-2.0-test\r\n"))
+	line, err := r.ReadString('\n')
+	if err != nil || len(strings.TrimSpace(line)) != 16 {
+		t.Fatalf("Expected a tarpit line while pending, got %q, %v", line, err)
+	}
+
+	// Forwarded once the timeout allows it, banner first
+	conn.Write([]byte("ping\n"))
+	banner := false
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			t.Fatalf("Expected the connection forwarded after the timeout: %v", err)
+		}
+		if line == "SSH-2.0-test\r\n" {
+			banner = true
+		}
+		if line == "ping\n" {
+			break
+		}
+	}
+	if !banner {
+		t.Error("Expected the client's banner forwarded")
+	}
+
+	var details common.AlertDetails
+	if err := proto.Unmarshal([]byte(sender.infos[0]), &details); err != nil {
+		t.Fatalf("Failed to parse alert details: %v", err)
+	}
+	if details.Fields["timeout"] != "2s" || details.Fields["on_timeout"] != "allow" {
+		t.Errorf("Expected the policy in the approval alert, got %v", details.Fields)
+	}
+}
+
+func TestApprovalPolicy_TarpitHoldsOtherProtocolsSilently(t *testing.T) {
+	l, _, _ := newApprovalPolicyListener(t, &pbProxy.ApprovalPolicy{
+		TimeoutSeconds: 2,
+		OnTimeout:      pbProxy.ApprovalTimeoutAction_APPROVAL_TIMEOUT_ALLOW,
+		Hold:           pbProxy.ApprovalHold_APPROVAL_HOLD_TARPIT,
+	})
+
+	conn, err := net.Dial("tcp", l.ListenAddr)
+	if err != nil {
+		t.Fatalf("Dial failed: %v", err)
+	}
+	defer conn.Close()
+	request := "GET / HTTP/1.1\r\nHost: example\r\n\r\n"
+	conn.Write([]byte(request))
+
+	// Nothing but the echoed request once the timeout allows it
+	conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	got := make([]byte, len(request))
+	if _, err := io.ReadFull(conn, got); err != nil || string(got) != request {
+		t.Fatalf("Expected only the request echoed, got %q, %v", got, err)
+	}
+}
+
+func TestApprovalPolicy_HTTPPending(t *testing.T) {
+	l, sender, am := newApprovalPolicyListener(t, &pbProxy.ApprovalPolicy{
+		TimeoutSeconds: 10,
+		Hold:           pbProxy.ApprovalHold_APPROVAL_HOLD_HTTP_PENDING,
+	})
+
+	conn, err := net.Dial("tcp", l.ListenAddr)
+	if err != nil {
+		t.Fatalf("Dial failed: %v", err)
+	}
+	defer conn.Close()
+	conn.Write([]byte("GET / HTTP/1.1\r\nHost: example\r\n\r\n"))
+	conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	resp, err := io.ReadAll(conn)
+	if err != nil {
+		t.Fatalf("Read failed: %v", err)
+	}
+	if !strings.HasPrefix(string(resp), "HTTP/1.1 503") || !strings.Contains(string(resp), "Approval pending") {
+		t.Fatalf("Expected the pending page, got %q", resp)
+	}
+
+	// The decision made after the page was served applies to the retry
+	var alerts []*common.Alert
+	for deadline := time.Now().Add(2 * time.Second); len(alerts) == 0 && time.Now().Before(deadline); {
+		time.Sleep(10 * time.Millisecond)
+		alerts = sender.GetAlerts()
+	}
+	if len(alerts) != 1 {
+		t.Fatalf("Expected 1 approval alert, got %d", len(alerts))
+	}
+
+	// A retry before the decision gets the page again, without a new request
+	early, err := net.Dial("tcp", l.ListenAddr)
+	if err != nil {
+		t.Fatalf("Dial failed: %v", err)
+	}
+	defer early.Close()
+	early.Write([]byte("GET / HTTP/1.1\r\nHost: example\r\n\r\n"))
+	early.SetReadDeadline(time.Now().Add(5 * time.Second))
+	if resp, err := io.ReadAll(early); err != nil || !strings.HasPrefix(string(resp), "HTTP/1.1 503") {
+		t.Fatalf("Expected the pending page on an early retry, got %q, %v", resp, err)
+	}
+	if n := len(sender.GetAlerts()); n != 1 {
+		t.Fatalf("Expected the early retry to open no request, got %d alerts", n)
+	}
+
+	if am.Resolve(alerts[0].Id, true, 60, "") == nil {
+		t.Fatal("Expected the request still pending after the page was served")
+	}
+	deadline := time.Now().Add(2 * time.Second)
+	for {
+		if found, allowed := am.CheckCache("127.0.0.1", "approve", ""); found && allowed {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("Expected the approval cached")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	retry, err := net.Dial("tcp", l.ListenAddr)
+	if err != nil {
+		t.Fatalf("Dial failed: %v", err)
+	}
+	defer retry.Close()
+	if err := echo(t, retry, 16); err != nil {
+		t.Errorf("Expected the retry forwarded: %v", err)
+	}
+}
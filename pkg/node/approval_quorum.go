@@ -0,0 +1,295 @@
+package node
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ivere27/nitella/pkg/api/common"
+	pb "github.com/ivere27/nitella/pkg/api/proxy"
+	"github.com/ivere27/nitella/pkg/config"
+	nitellacrypto "github.com/ivere27/nitella/pkg/crypto"
+	"github.com/ivere27/nitella/pkg/log"
+)
+
+// maxApprovalQuorum caps ApprovalPolicy.Quorum.
+const maxApprovalQuorum = 16
+
+// ErrApprovalNotFound is returned for decisions on requests that are not
+// pending: already resolved, timed out or never made.
+var ErrApprovalNotFound = errors.New("approval request not found or already resolved")
+
+// errApproverNotRegistered is returned for votes signed by a key the node
+// does not know.
+var errApproverNotRegistered = errors.New("not registered")
+
+// QuorumStatus is a request's progress towards its quorum.
+type QuorumStatus struct {
+	Approvals int  // Distinct approvers that voted to allow
+	Quorum    int  // Allow votes needed (1 without a quorum)
+	Resolved  bool // The decision reached the waiting connection
+}
+
+// approvalQuorum is how many distinct approvers must allow a request under
+// policy.
+func approvalQuorum(policy *pb.ApprovalPolicy) int {
+	if q := int(policy.GetQuorum()); q > 1 {
+		return q
+	}
+	return 1
+}
+
+// votePayload is what the approver of req signed.
+func votePayload(req *pb.ResolveApprovalRequest) []byte {
+	return nitellacrypto.ApprovalVotePayload(req.GetReqId(), int32(req.GetAction()),
+		int32(req.GetRetentionMode()), req.GetDurationSeconds(), req.GetReason())
+}
+
+// ApproverFingerprint identifies an approver key (hex SHA-256).
+func ApproverFingerprint(key ed25519.PublicKey) string {
+	return nitellacrypto.ComputeHexFingerprint(key)
+}
+
+// shortFingerprint abbreviates a fingerprint for logs and errors.
+func shortFingerprint(fp string) string {
+	if len(fp) > 16 {
+		return fp[:16]
+	}
+	return fp
+}
+
+// ParseApproverKeys reads approver public keys from concatenated PEM blocks
+// (PUBLIC KEY or CERTIFICATE).
+func ParseApproverKeys(data []byte) ([]ed25519.PublicKey, error) {
+	var keys []ed25519.PublicKey
+	for {
+		block, rest := pem.Decode(data)
+		if block == nil {
+			break
+		}
+		key, err := nitellacrypto.DecodePublicKeyFromPEM(pem.EncodeToMemory(block))
+		if err != nil {
+			return nil, fmt.Errorf("approver key %d: %w", len(keys)+1, err)
+		}
+		keys = append(keys, key)
+		data = rest
+	}
+	if len(bytes.TrimSpace(data)) > 0 {
+		return nil, fmt.Errorf("approver key %d: not a PEM block", len(keys)+1)
+	}
+	return keys, nil
+}
+
+// SetApprovers registers the approver keys whose signed votes count towards
+// quorums, replacing any registered before.
+func (am *ApprovalManager) SetApprovers(keys []ed25519.PublicKey) {
+	approvers := make(map[string]ed25519.PublicKey, len(keys))
+	for _, key := range keys {
+		approvers[ApproverFingerprint(key)] = key
+	}
+	am.mu.Lock()
+	am.approvers = approvers
+	am.mu.Unlock()
+}
+
+// ResolveRequest applies a decision to its pending request. A signed req is
+// a vote from a registered approver. Requests whose policy sets a quorum are
+// allowed once that many distinct approvers voted to allow, and denied by
+// the first deny; other requests resolve on the first decision, where a
+// vote signed by an unregistered key counts as unsigned.
+// durationSeconds and retentionMode are req's after the caller's defaults.
+func (am *ApprovalManager) ResolveRequest(req *pb.ResolveApprovalRequest, durationSeconds int64, retentionMode common.ApprovalRetentionMode) (*ApprovalRequestMeta, QuorumStatus, error) {
+	approver := ""
+	if len(req.GetApproverKey()) > 0 || len(req.GetSignature()) > 0 {
+		fp, err := am.verifyVote(req)
+		if err != nil && !errors.Is(err, errApproverNotRegistered) {
+			return nil, QuorumStatus{}, err
+		}
+		if err != nil {
+			// Apps sign with their own key whether or not it is registered
+			meta, status, voteErr := am.vote(req.GetReqId(), "", approvalResult(req, durationSeconds, retentionMode))
+			if voteErr != nil && status.Quorum > 1 {
+				return nil, status, err
+			}
+			return meta, status, voteErr
+		}
+		approver = fp
+	}
+	return am.vote(req.GetReqId(), approver, approvalResult(req, durationSeconds, retentionMode))
+}
+
+// approvalResult is the decision req carries.
+func approvalResult(req *pb.ResolveApprovalRequest, durationSeconds int64, retentionMode common.ApprovalRetentionMode) ApprovalResult {
+	return ApprovalResult{
+		Allowed:       req.GetAction() == common.ApprovalActionType_APPROVAL_ACTION_TYPE_ALLOW,
+		RetentionMode: retentionMode,
+		Duration:      time.Duration(durationSeconds) * time.Second,
+		Reason:        req.GetReason(),
+	}
+}
+
+// verifyVote checks req is signed by a registered approver and returns the
+// approver's fingerprint.
+func (am *ApprovalManager) verifyVote(req *pb.ResolveApprovalRequest) (string, error) {
+	fp := ApproverFingerprint(req.GetApproverKey())
+	am.mu.Lock()
+	key, ok := am.approvers[fp]
+	am.mu.Unlock()
+	if !ok {
+		return "", fmt.Errorf("approver %s is %w", shortFingerprint(fp), errApproverNotRegistered)
+	}
+	if err := nitellacrypto.Verify(votePayload(req), req.GetSignature(), key); err != nil {
+		return "", fmt.Errorf("vote from approver %s: %w", shortFingerprint(fp), err)
+	}
+	return fp, nil
+}
+
+// vote records a decision from approver ("" = unsigned) on reqID and
+// delivers it once the request's quorum is met.
+func (am *ApprovalManager) vote(reqID, approver string, res ApprovalResult) (*ApprovalRequestMeta, QuorumStatus, error) {
+	am.mu.Lock()
+	req, ok := am.requests[reqID]
+	if !ok || req.Settled {
+		am.mu.Unlock()
+		return nil, QuorumStatus{}, ErrApprovalNotFound
+	}
+	status := QuorumStatus{Quorum: approvalQuorum(req.Meta.Policy)}
+	if res.Allowed && status.Quorum > 1 {
+		if approver == "" {
+			am.mu.Unlock()
+			return nil, status, fmt.Errorf("rule %s needs signed approvals from %d approvers", req.Meta.RuleID, status.Quorum)
+		}
+		if _, dup := req.Votes[approver]; dup {
+			status.Approvals = len(req.Votes)
+			am.mu.Unlock()
+			return nil, status, fmt.Errorf("approver %s already voted", shortFingerprint(approver))
+		}
+		if req.Votes == nil {
+			req.Votes = make(map[string]ApprovalResult)
+		}
+		req.Votes[approver] = res
+		status.Approvals = len(req.Votes)
+
+		if status.Approvals < status.Quorum {
+			// Other approvers see the vote count on the pending alert
+			alert := approvalAlert(reqID, req.NodeID, req.CreatedAt, status.Approvals, status.Quorum)
+			info, meta := req.Info, req.Meta
+			am.mu.Unlock()
+			if err := am.sender.SendAlert(alert, info); err != nil {
+				log.Printf("[Approval] Failed to update alert for %s: %v", reqID, err)
+			}
+			return &meta, status, nil
+		}
+		res = mergeVotes(req.Votes)
+	} else if res.Allowed {
+		status.Approvals = 1
+	}
+	res.RuleID = req.Meta.RuleID
+	meta, resultCh := req.Meta, req.ResultCh
+	am.recordDecision(req.SourceIP, res.Allowed, time.Now())
+	am.decisionLatency.Observe(time.Since(req.CreatedAt).Seconds())
+	req.Settled = true
+	announced := req.Announced
+	am.mu.Unlock()
+
+	select {
+	case resultCh <- res:
+	default:
+	}
+	if announced {
+		decision := config.ApprovalDecisionDeny
+		if res.Allowed {
+			decision = config.ApprovalDecisionAllow
+		}
+		am.sendResolved(reqID, req, decision)
+	}
+	status.Resolved = true
+	return &meta, status, nil
+}
+
+// mergeVotes combines a quorum's allow votes into the narrowest decision:
+// the shortest duration, and connection-only if any approver asked for it.
+func mergeVotes(votes map[string]ApprovalResult) ApprovalResult {
+	approvers := make([]string, 0, len(votes))
+	for fp := range votes {
+		approvers = append(approvers, fp)
+	}
+	sort.Strings(approvers)
+
+	merged := ApprovalResult{Allowed: true}
+	var reasons []string
+	for i, fp := range approvers {
+		v := votes[fp]
+		if i == 0 || v.Duration < merged.Duration {
+			merged.Duration = v.Duration
+		}
+		if i == 0 || v.RetentionMode == common.ApprovalRetentionMode_APPROVAL_RETENTION_MODE_CONNECTION_ONLY {
+			merged.RetentionMode = v.RetentionMode
+		}
+		if v.Reason != "" {
+			reasons = append(reasons, v.Reason)
+		}
+	}
+	merged.Reason = strings.Join(reasons, "; ")
+	return merged
+}
+
+// approvalAlert asks approvers to decide on reqID. Quorum requests carry
+// their vote count.
+func approvalAlert(reqID, nodeID string, createdAt time.Time, approvals, quorum int) *common.Alert {
+	alert := &common.Alert{
+		Id:            reqID,
+		NodeId:        nodeID,
+		Severity:      "high",
+		TimestampUnix: createdAt.Unix(),
+		Metadata: map[string]string{
+			config.AlertMetadataType: config.AlertTypeApproval,
+		},
+	}
+	if quorum > 1 {
+		alert.Metadata[config.AlertMetadataApprovals] = strconv.Itoa(approvals)
+		alert.Metadata[config.AlertMetadataQuorum] = strconv.Itoa(quorum)
+	}
+	return alert
+}
+
+// GetPendingQuorums returns the pending requests that need a quorum, with
+// the votes collected so far. Each entry's key is its request ID and it
+// expires when the request times out.
+func (am *ApprovalManager) GetPendingQuorums() []*ApprovalEntry {
+	am.mu.Lock()
+	defer am.mu.Unlock()
+
+	var result []*ApprovalEntry
+	for reqID, req := range am.requests {
+		quorum := approvalQuorum(req.Meta.Policy)
+		if quorum <= 1 {
+			continue
+		}
+		approvers := make([]string, 0, len(req.Votes))
+		for fp := range req.Votes {
+			approvers = append(approvers, fp)
+		}
+		sort.Strings(approvers)
+		result = append(result, &ApprovalEntry{
+			SourceIP:   req.SourceIP,
+			RuleID:     req.Meta.RuleID,
+			ProxyID:    req.Meta.ProxyID,
+			CreatedAt:  req.CreatedAt,
+			ExpiresAt:  req.CreatedAt.Add(approvalTimeout(req.Meta.Policy)),
+			GeoCountry: req.Meta.GeoCountry,
+			GeoCity:    req.Meta.GeoCity,
+			GeoISP:     req.Meta.GeoISP,
+			RequestID:  reqID,
+			Quorum:     quorum,
+			Approvers:  approvers,
+		})
+	}
+	return result
+}
@@ -0,0 +1,165 @@
+package node
+
+import (
+	"crypto/ed25519"
+	"testing"
+	"time"
+
+	"github.com/ivere27/nitella/pkg/api/common"
+	pbProxy "github.com/ivere27/nitella/pkg/api/proxy"
+	"github.com/ivere27/nitella/pkg/config"
+	nitellacrypto "github.com/ivere27/nitella/pkg/crypto"
+)
+
+// signedVote is a decision on reqID signed with key.
+func signedVote(t *testing.T, reqID string, allow bool, duration int64, key ed25519.PrivateKey) *pbProxy.ResolveApprovalRequest {
+	t.Helper()
+	req := &pbProxy.ResolveApprovalRequest{ReqId: reqID, Action: common.ApprovalActionType_APPROVAL_ACTION_TYPE_BLOCK, DurationSeconds: duration}
+	if allow {
+		req.Action = common.ApprovalActionType_APPROVAL_ACTION_TYPE_ALLOW
+	}
+	sig, err := nitellacrypto.Sign(votePayload(req), key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.ApproverKey = key.Public().(ed25519.PublicKey)
+	req.Signature = sig
+	return req
+}
+
+func newApproverKeys(t *testing.T, n int) []ed25519.PrivateKey {
+	t.Helper()
+	keys := make([]ed25519.PrivateKey, n)
+	for i := range keys {
+		key, err := nitellacrypto.GenerateKey()
+		if err != nil {
+			t.Fatal(err)
+		}
+		keys[i] = key
+	}
+	return keys
+}
+
+func TestApprovalManager_Quorum(t *testing.T) {
+	sender := &MockAlertSender{}
+	am := NewApprovalManager(sender)
+	defer am.cache.Stop()
+	keys := newApproverKeys(t, 3)
+	am.SetApprovers([]ed25519.PublicKey{keys[0].Public().(ed25519.PublicKey), keys[1].Public().(ed25519.PublicKey)})
+
+	meta := ApprovalRequestMeta{SourceIP: "1.2.3.4", RuleID: "r1", Policy: &pbProxy.ApprovalPolicy{Quorum: 2}}
+	resultCh, err := am.BeginApprovalRequest("req-1", "node-1", "{}", meta)
+	if err != nil {
+		t.Fatalf("BeginApprovalRequest failed: %v", err)
+	}
+	defer am.CancelApprovalRequest("req-1")
+	if got := sender.GetAlerts()[0].Metadata; got[config.AlertMetadataApprovals] != "0" || got[config.AlertMetadataQuorum] != "2" {
+		t.Errorf("Expected the vote count on the alert, got %v", got)
+	}
+
+	cache := common.ApprovalRetentionMode_APPROVAL_RETENTION_MODE_CACHE
+	if am.Resolve("req-1", true, 60, "") != nil {
+		t.Error("Expected an unsigned allow rejected")
+	}
+	if _, _, err := am.ResolveRequest(signedVote(t, "req-1", true, 60, keys[2]), 60, cache); err == nil {
+		t.Error("Expected a vote from an unregistered approver rejected")
+	}
+	forged := signedVote(t, "req-1", true, 60, keys[0])
+	forged.DurationSeconds = 3600
+	if _, _, err := am.ResolveRequest(forged, 3600, cache); err == nil {
+		t.Error("Expected a vote with a bad signature rejected")
+	}
+
+	_, status, err := am.ResolveRequest(signedVote(t, "req-1", true, 300, keys[0]), 300, cache)
+	if err != nil || status.Resolved || status.Approvals != 1 || status.Quorum != 2 {
+		t.Fatalf("Expected 1/2 approvals, got %+v, %v", status, err)
+	}
+	alerts := sender.GetAlerts()
+	if len(alerts) != 2 || alerts[1].Id != "req-1" || alerts[1].Metadata[config.AlertMetadataApprovals] != "1" {
+		t.Errorf("Expected the pending alert updated with the vote, got %d alerts", len(alerts))
+	}
+	pending := am.GetPendingQuorums()
+	if len(pending) != 1 || pending[0].Key() != "req-1" || len(pending[0].Approvers) != 1 {
+		t.Errorf("Expected the partial approval listed, got %+v", pending)
+	}
+	if _, _, err := am.ResolveRequest(signedVote(t, "req-1", true, 300, keys[0]), 300, cache); err == nil {
+		t.Error("Expected a second vote from the same approver rejected")
+	}
+	select {
+	case res := <-resultCh:
+		t.Fatalf("Expected no decision before the quorum, got %+v", res)
+	default:
+	}
+
+	_, status, err = am.ResolveRequest(signedVote(t, "req-1", true, 60, keys[1]), 60, cache)
+	if err != nil || !status.Resolved || status.Approvals != 2 {
+		t.Fatalf("Expected the quorum met, got %+v, %v", status, err)
+	}
+	res := <-resultCh
+	if !res.Allowed || res.Duration != time.Minute || res.RuleID != "r1" {
+		t.Errorf("Expected an allow for the shortest vote duration, got %+v", res)
+	}
+}
+
+func TestApprovalManager_QuorumDeny(t *testing.T) {
+	am := NewApprovalManager(&MockAlertSender{})
+	defer am.cache.Stop()
+	keys := newApproverKeys(t, 2)
+	am.SetApprovers([]ed25519.PublicKey{keys[0].Public().(ed25519.PublicKey), keys[1].Public().(ed25519.PublicKey)})
+
+	meta := ApprovalRequestMeta{SourceIP: "1.2.3.4", RuleID: "r1", Policy: &pbProxy.ApprovalPolicy{Quorum: 2}}
+	resultCh, err := am.BeginApprovalRequest("req-1", "node-1", "{}", meta)
+	if err != nil {
+		t.Fatalf("BeginApprovalRequest failed: %v", err)
+	}
+	defer am.CancelApprovalRequest("req-1")
+
+	cache := common.ApprovalRetentionMode_APPROVAL_RETENTION_MODE_CACHE
+	if _, _, err := am.ResolveRequest(signedVote(t, "req-1", true, 60, keys[0]), 60, cache); err != nil {
+		t.Fatal(err)
+	}
+	// Any one approver can deny
+	_, status, err := am.ResolveRequest(signedVote(t, "req-1", false, 60, keys[1]), 60, cache)
+	if err != nil || !status.Resolved {
+		t.Fatalf("Expected the deny to resolve the request, got %+v, %v", status, err)
+	}
+	if res := <-resultCh; res.Allowed {
+		t.Error("Expected the request denied")
+	}
+}
+
+func TestApprovalManager_UnregisteredVoteWithoutQuorum(t *testing.T) {
+	am := NewApprovalManager(&MockAlertSender{})
+	defer am.cache.Stop()
+	keys := newApproverKeys(t, 2)
+	am.SetApprovers([]ed25519.PublicKey{keys[0].Public().(ed25519.PublicKey)})
+
+	resultCh, err := am.BeginApprovalRequest("req-1", "node-1", "{}", ApprovalRequestMeta{SourceIP: "1.2.3.4", RuleID: "r1"})
+	if err != nil {
+		t.Fatalf("BeginApprovalRequest failed: %v", err)
+	}
+	defer am.CancelApprovalRequest("req-1")
+
+	// An app's own key decides like an unsigned decision
+	cache := common.ApprovalRetentionMode_APPROVAL_RETENTION_MODE_CACHE
+	_, status, err := am.ResolveRequest(signedVote(t, "req-1", true, 60, keys[1]), 60, cache)
+	if err != nil || !status.Resolved {
+		t.Fatalf("Expected the vote to resolve the request, got %+v, %v", status, err)
+	}
+	if res := <-resultCh; !res.Allowed {
+		t.Error("Expected the request allowed")
+	}
+}
+
+func TestParseApproverKeys(t *testing.T) {
+	keys := newApproverKeys(t, 2)
+	data := append(nitellacrypto.EncodePublicKeyToPEM(keys[0].Public().(ed25519.PublicKey)),
+		nitellacrypto.EncodePublicKeyToPEM(keys[1].Public().(ed25519.PublicKey))...)
+	parsed, err := ParseApproverKeys(data)
+	if err != nil || len(parsed) != 2 || !parsed[1].Equal(keys[1].Public()) {
+		t.Fatalf("Expected 2 approver keys, got %d, %v", len(parsed), err)
+	}
+	if _, err := ParseApproverKeys(append(data, "garbage"...)); err == nil {
+		t.Error("Expected trailing garbage rejected")
+	}
+}
@@ -0,0 +1,72 @@
+package node
+
+import (
+	"time"
+
+	"github.com/ivere27/nitella/pkg/log"
+	"xorm.io/xorm"
+)
+
+// dbApprovalStore keeps cached approval decisions in the node DB.
+type dbApprovalStore struct {
+	db *xorm.Engine
+}
+
+func (s dbApprovalStore) SaveApproval(e *ApprovalEntry) error {
+	if err := s.DeleteApproval(e.SourceIP, e.RuleID, e.TLSSessionID); err != nil {
+		return err
+	}
+	_, err := s.db.Insert(&ApprovalModel{
+		SourceIP:     e.SourceIP,
+		RuleID:       e.RuleID,
+		TLSSessionID: e.TLSSessionID,
+		ProxyID:      e.ProxyID,
+		Decision:     e.Decision,
+		ExpiresAt:    e.ExpiresAt,
+		CreatedAt:    e.CreatedAt,
+		GeoCountry:   e.GeoCountry,
+		GeoCity:      e.GeoCity,
+		GeoISP:       e.GeoISP,
+	})
+	return err
+}
+
+func (s dbApprovalStore) DeleteApproval(sourceIP, ruleID, tlsSessionID string) error {
+	_, err := s.db.Where("source_ip = ? AND rule_id = ? AND tls_session_id = ?", sourceIP, ruleID, tlsSessionID).
+		Delete(new(ApprovalModel))
+	return err
+}
+
+// restoreApprovals persists am's approval cache in the DB and restores the
+// decisions saved before a restart. Expired decisions and those bound to a
+// TLS session are dropped.
+func (m *ProxyManager) restoreApprovals(am *ApprovalManager) {
+	// Approvals bound to a TLS session ended with it
+	if _, err := m.db.Where("expires_at <= ? OR tls_session_id != ''", time.Now()).Delete(new(ApprovalModel)); err != nil {
+		log.Printf("[WARN] Failed to prune expired approvals: %v", err)
+	}
+	var models []ApprovalModel
+	if err := m.db.Find(&models); err != nil {
+		log.Printf("[WARN] Failed to load approvals: %v", err)
+	}
+
+	entries := make([]*ApprovalEntry, 0, len(models))
+	for _, a := range models {
+		entries = append(entries, &ApprovalEntry{
+			SourceIP:     a.SourceIP,
+			RuleID:       a.RuleID,
+			ProxyID:      a.ProxyID,
+			TLSSessionID: a.TLSSessionID,
+			Decision:     a.Decision,
+			ExpiresAt:    a.ExpiresAt,
+			CreatedAt:    a.CreatedAt,
+			GeoCountry:   a.GeoCountry,
+			GeoCity:      a.GeoCity,
+			GeoISP:       a.GeoISP,
+		})
+	}
+	am.SetStore(dbApprovalStore{db: m.db}, entries)
+	if len(entries) > 0 {
+		log.Printf("Restored %d approval decisions", len(entries))
+	}
+}
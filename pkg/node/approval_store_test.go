@@ -0,0 +1,101 @@
+package node
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestApprovalCache_PersistsAcrossRestart(t *testing.T) {
+	dbPath := filepath.Join(t.TempDir(), "nitella.db")
+	start := func() *ProxyManager {
+		pm := NewProxyManager(ListenerModeFfi)
+		t.Cleanup(pm.Close)
+		if err := pm.InitDB(dbPath); err != nil {
+			t.Fatalf("InitDB failed: %v", err)
+		}
+		am := NewApprovalManager(&MockAlertSender{})
+		t.Cleanup(am.cache.Stop)
+		pm.SetApprovalManager(am)
+		return pm
+	}
+
+	pm := start()
+	pm.Approval.AddToCacheWithGeo("203.0.113.7", "r1", "p1", "", true, 24*time.Hour, "KR", "Seoul", "ISP")
+	pm.Approval.AddToCache("203.0.113.8", "r1", "p1", "", false, time.Hour)
+	pm.Approval.AddToCache("203.0.113.9", "r1", "p1", "", true, time.Hour)
+	pm.Approval.AddToCache("203.0.113.10", "r1", "p1", "", true, time.Millisecond)
+	pm.Approval.AddToCache("203.0.113.11", "r1", "p1", "tls-session", true, time.Hour)
+	pm.Approval.RemoveApproval("203.0.113.9", "r1", "")
+	time.Sleep(10 * time.Millisecond)
+
+	// After a restart
+	pm.Approval.FlushStore()
+	pm = start()
+	if found, allowed := pm.Approval.CheckCache("203.0.113.7", "r1", ""); !found || !allowed {
+		t.Error("Expected the allow restored")
+	}
+	if found, allowed := pm.Approval.CheckCache("203.0.113.8", "r1", ""); !found || allowed {
+		t.Error("Expected the deny restored")
+	}
+	if found, _ := pm.Approval.CheckCache("203.0.113.9", "r1", ""); found {
+		t.Error("Expected the revoked approval to stay revoked")
+	}
+	if found, _ := pm.Approval.CheckCache("203.0.113.10", "r1", ""); found {
+		t.Error("Expected the expired approval dropped")
+	}
+	if found, _ := pm.Approval.CheckCache("203.0.113.11", "r1", "tls-session"); found {
+		t.Error("Expected the TLS-bound approval not persisted")
+	}
+	if e := pm.Approval.GetEntry("203.0.113.7", "r1", ""); e == nil || e.GeoCity != "Seoul" || time.Until(e.ExpiresAt) < 23*time.Hour {
+		t.Errorf("Expected the entry restored with its lifetime, got %+v", e)
+	}
+	if n := len(pm.Approval.GetActiveApprovals()); n != 2 {
+		t.Errorf("Expected 2 active approvals, got %d", n)
+	}
+
+	// Revoking after the restart sticks too
+	pm.Approval.RemoveApproval("203.0.113.7", "r1", "")
+	pm.Approval.FlushStore()
+	pm = start()
+	if found, _ := pm.Approval.CheckCache("203.0.113.7", "r1", ""); found {
+		t.Error("Expected the approval revoked after a restart to stay revoked")
+	}
+}
+
+// blockingStore holds every write until release is closed.
+type blockingStore struct{ release chan struct{} }
+
+func (s blockingStore) SaveApproval(*ApprovalEntry) error {
+	<-s.release
+	return nil
+}
+
+func (s blockingStore) DeleteApproval(string, string, string) error {
+	<-s.release
+	return nil
+}
+
+func TestApprovalCache_StoreWritesDoNotBlockChecks(t *testing.T) {
+	c := NewApprovalCache()
+	release := make(chan struct{})
+	c.SetStore(blockingStore{release}, nil)
+	defer c.Stop()
+	defer close(release)
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		c.Add("203.0.113.7", "r1", "p1", "", true, time.Hour)
+		c.Add("203.0.113.8", "r1", "p1", "", false, time.Hour)
+		c.Remove("203.0.113.8", "r1", "")
+		if found, allowed := c.Check("203.0.113.7", "r1", ""); !found || !allowed {
+			t.Error("Expected the allow cached")
+		}
+	}()
+	select {
+	case <-done:
+	case <-time.After(2 * time.Second):
+		t.Fatal("Expected cache updates and checks not to wait for the store")
+	}
+}
@@ -11,12 +11,15 @@ import (
 
 	"github.com/ivere27/nitella/pkg/api/common"
 	pb "github.com/ivere27/nitella/pkg/api/proxy"
+	"github.com/ivere27/nitella/pkg/config"
 )
 
-// ChannelAlertSender implements AlertSender with a channel for test interception
+// ChannelAlertSender implements AlertSender with a channel for test interception.
+// Resolutions of approval requests go to Resolved.
 type ChannelAlertSender struct {
 	Requests chan *common.Alert
 	Infos    chan string
+	Resolved chan *common.Alert
 	mu       sync.Mutex
 }
 
@@ -24,12 +27,20 @@ func NewChannelAlertSender(bufSize int) *ChannelAlertSender {
 	return &ChannelAlertSender{
 		Requests: make(chan *common.Alert, bufSize),
 		Infos:    make(chan string, bufSize),
+		Resolved: make(chan *common.Alert, bufSize),
 	}
 }
 
 func (s *ChannelAlertSender) SendAlert(alert *common.Alert, info string) error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
+	if alert.GetMetadata()[config.AlertMetadataType] == config.AlertTypeApprovalResolved {
+		select {
+		case s.Resolved <- alert:
+		default:
+		}
+		return nil
+	}
 	select {
 	case s.Requests <- alert:
 	default:
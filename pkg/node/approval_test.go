@@ -9,20 +9,30 @@ import (
 	"time"
 
 	"github.com/ivere27/nitella/pkg/api/common"
+	pb "github.com/ivere27/nitella/pkg/api/proxy"
 	"github.com/ivere27/nitella/pkg/config"
+	"google.golang.org/protobuf/proto"
 )
 
-// MockAlertSender implements AlertSender for testing
+// MockAlertSender implements AlertSender for testing. Resolutions of
+// approval requests are kept apart from the alerts.
 type MockAlertSender struct {
-	alerts []*common.Alert
-	infos  []string
-	mu     sync.Mutex
-	err    error
+	alerts        []*common.Alert
+	infos         []string
+	resolved      []*common.Alert
+	resolvedInfos []string
+	mu            sync.Mutex
+	err           error
 }
 
 func (m *MockAlertSender) SendAlert(alert *common.Alert, info string) error {
 	m.mu.Lock()
 	defer m.mu.Unlock()
+	if alert.GetMetadata()[config.AlertMetadataType] == config.AlertTypeApprovalResolved {
+		m.resolved = append(m.resolved, alert)
+		m.resolvedInfos = append(m.resolvedInfos, info)
+		return m.err
+	}
 	m.alerts = append(m.alerts, alert)
 	m.infos = append(m.infos, info)
 	return m.err
@@ -34,6 +44,19 @@ func (m *MockAlertSender) GetAlerts() []*common.Alert {
 	return m.alerts
 }
 
+// GetResolved returns the approval resolutions sent, as "id:decision".
+func (m *MockAlertSender) GetResolved() []string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var out []string
+	for i, a := range m.resolved {
+		var details common.AlertDetails
+		proto.Unmarshal([]byte(m.resolvedInfos[i]), &details)
+		out = append(out, a.Id+":"+details.GetFields()[config.AlertFieldDecision])
+	}
+	return out
+}
+
 // MockConnectionCloser implements ConnectionCloser for testing
 type MockConnectionCloser struct {
 	closedConns []struct{ proxyID, connID string }
@@ -387,6 +410,10 @@ func TestApprovalManager_RequestTimeout(t *testing.T) {
 	if len(alerts) != 1 {
 		t.Errorf("Expected 1 alert sent, got %d", len(alerts))
 	}
+	// Approvers are told the request was withdrawn
+	if got := sender.GetResolved(); len(got) != 1 || got[0] != "req-123:withdrawn" {
+		t.Errorf("Expected req-123 withdrawn, got %v", got)
+	}
 }
 
 func TestApprovalManager_ResolveAllow(t *testing.T) {
@@ -429,6 +456,62 @@ func TestApprovalManager_ResolveAllow(t *testing.T) {
 	}
 }
 
+func TestApprovalManager_FirstDecisionWins(t *testing.T) {
+	sender := &MockAlertSender{}
+	am := NewApprovalManager(sender)
+	defer am.cache.Stop()
+
+	resultCh, err := am.BeginApprovalRequest("req-1", "node-1", "", ApprovalRequestMeta{SourceIP: "1.2.3.4"})
+	if err != nil {
+		t.Fatalf("BeginApprovalRequest failed: %v", err)
+	}
+	defer am.CancelApprovalRequest("req-1")
+
+	// Two devices answer; the second is too late
+	if am.Resolve("req-1", true, 60, "") == nil {
+		t.Fatal("Expected the first decision accepted")
+	}
+	if am.Resolve("req-1", false, 0, "") != nil {
+		t.Error("Expected the second decision rejected")
+	}
+	if res := <-resultCh; !res.Allowed {
+		t.Errorf("Expected the first decision delivered, got %+v", res)
+	}
+	if got := sender.GetResolved(); len(got) != 1 || got[0] != "req-1:allow" {
+		t.Errorf("Expected one resolution of req-1, got %v", got)
+	}
+
+	// Cleanup after a decision withdraws nothing
+	am.CancelApprovalRequest("req-1")
+	if got := sender.GetResolved(); len(got) != 1 {
+		t.Errorf("Expected no more resolutions, got %v", got)
+	}
+}
+
+func TestApprovalManager_ExpiredRequestTakesNoDecision(t *testing.T) {
+	sender := &MockAlertSender{}
+	am := NewApprovalManager(sender)
+	defer am.cache.Stop()
+
+	meta := ApprovalRequestMeta{SourceIP: "1.2.3.4", Policy: &pb.ApprovalPolicy{TimeoutSeconds: 1}}
+	resultCh, err := am.BeginApprovalRequest("req-1", "node-1", "", meta)
+	if err != nil {
+		t.Fatalf("BeginApprovalRequest failed: %v", err)
+	}
+	defer am.CancelApprovalRequest("req-1")
+
+	res, err := am.WaitForApproval(context.Background(), "req-1", resultCh, nil)
+	if err != nil || !res.TimedOut {
+		t.Fatalf("Expected a timeout, got %+v, %v", res, err)
+	}
+	if am.Resolve("req-1", true, 60, "") != nil {
+		t.Error("Expected a decision after the timeout rejected")
+	}
+	if got := sender.GetResolved(); len(got) != 1 || got[0] != "req-1:expired" {
+		t.Errorf("Expected req-1 expired, got %v", got)
+	}
+}
+
 func TestApprovalManager_ResolveBlock(t *testing.T) {
 	sender := &MockAlertSender{}
 	am := NewApprovalManager(sender)
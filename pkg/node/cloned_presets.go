@@ -0,0 +1,155 @@
+package node
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/ivere27/nitella/pkg/api/proxy"
+	"github.com/ivere27/nitella/pkg/log"
+	"github.com/ivere27/nitella/pkg/mockproto"
+)
+
+// defaultCloneTimeout bounds a banner clone when the request sets none.
+const defaultCloneTimeout = 5 * time.Second
+
+// clonedPresets holds banners cloned from real backends, by preset name.
+// Mock connections look them up by MockConfig.cloned_preset.
+var clonedPresets = struct {
+	sync.RWMutex
+	entries map[string]*pb.ClonedPreset
+}{entries: make(map[string]*pb.ClonedPreset)}
+
+// lookupClonedPreset returns the cloned preset with this name, or nil.
+func lookupClonedPreset(name string) *pb.ClonedPreset {
+	clonedPresets.RLock()
+	defer clonedPresets.RUnlock()
+	return clonedPresets.entries[name]
+}
+
+// CloneBanner connects once to a real backend, records its banner and
+// stores it as a cloned preset, replacing any preset of the same name.
+// Presets are persisted when the node has a database.
+func (m *ProxyManager) CloneBanner(req *pb.CloneBannerRequest) (*pb.CloneBannerResponse, error) {
+	if req.Name == "" {
+		return nil, fmt.Errorf("preset name is required")
+	}
+	protocol, addr := req.Protocol, req.BackendAddr
+	if existing := lookupClonedPreset(req.Name); existing != nil {
+		if protocol == "" {
+			protocol = existing.Protocol
+		}
+		if addr == "" {
+			addr = existing.BackendAddr
+		}
+	}
+	if protocol == "" || addr == "" {
+		return nil, fmt.Errorf("protocol and backend_addr are required")
+	}
+	timeout := defaultCloneTimeout
+	if req.TimeoutMs > 0 {
+		timeout = time.Duration(req.TimeoutMs) * time.Millisecond
+	}
+
+	banner, err := mockproto.CloneBanner(protocol, addr, timeout)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+
+	if m.db != nil {
+		model := &ClonedPresetModel{Name: req.Name, Protocol: protocol, BackendAddr: addr, Banner: banner, ClonedAt: now}
+		m.db.ID(req.Name).Delete(new(ClonedPresetModel))
+		if _, err := m.db.Insert(model); err != nil {
+			return nil, fmt.Errorf("failed to save cloned preset: %w", err)
+		}
+	}
+
+	preset := &pb.ClonedPreset{
+		Name:        req.Name,
+		Protocol:    protocol,
+		BackendAddr: addr,
+		Banner:      banner,
+		ClonedAt:    timestamppb.New(now),
+	}
+	clonedPresets.Lock()
+	clonedPresets.entries[req.Name] = preset
+	clonedPresets.Unlock()
+	m.pushClonedPresets()
+
+	log.Printf("[Mock] Cloned %s banner from %s as preset %q: %q", protocol, addr, req.Name, banner)
+	return &pb.CloneBannerResponse{Preset: preset}, nil
+}
+
+// ListClonedPresets returns the cloned presets, sorted by name.
+func (m *ProxyManager) ListClonedPresets() *pb.ListClonedPresetsResponse {
+	return &pb.ListClonedPresetsResponse{Presets: clonedPresetList()}
+}
+
+// clonedPresetList returns the cloned presets, sorted by name.
+func clonedPresetList() []*pb.ClonedPreset {
+	clonedPresets.RLock()
+	defer clonedPresets.RUnlock()
+
+	list := make([]*pb.ClonedPreset, 0, len(clonedPresets.entries))
+	for _, p := range clonedPresets.entries {
+		list = append(list, p)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Name < list[j].Name })
+	return list
+}
+
+// SetClonedPresets replaces the cloned presets. Process mode children have
+// no database and get the parent's presets this way.
+func (m *ProxyManager) SetClonedPresets(presets []*pb.ClonedPreset) {
+	clonedPresets.Lock()
+	defer clonedPresets.Unlock()
+	clear(clonedPresets.entries)
+	for _, p := range presets {
+		clonedPresets.entries[p.Name] = p
+	}
+}
+
+// pushClonedPresets sends the cloned presets to every process mode child.
+func (m *ProxyManager) pushClonedPresets() {
+	var children []*ProcessListener
+	m.mu.RLock()
+	for _, p := range m.proxies {
+		if pl, ok := p.Listener.(*ProcessListener); ok {
+			children = append(children, pl)
+		}
+	}
+	m.mu.RUnlock()
+
+	if len(children) == 0 {
+		return
+	}
+	presets := clonedPresetList()
+	for _, pl := range children {
+		pl.configureClonedPresets(presets)
+	}
+}
+
+// loadClonedPresets restores cloned presets from the database.
+func (m *ProxyManager) loadClonedPresets() error {
+	var models []ClonedPresetModel
+	if err := m.db.Find(&models); err != nil {
+		return err
+	}
+
+	clonedPresets.Lock()
+	defer clonedPresets.Unlock()
+	for _, p := range models {
+		clonedPresets.entries[p.Name] = &pb.ClonedPreset{
+			Name:        p.Name,
+			Protocol:    p.Protocol,
+			BackendAddr: p.BackendAddr,
+			Banner:      p.Banner,
+			ClonedAt:    timestamppb.New(p.ClonedAt),
+		}
+	}
+	return nil
+}
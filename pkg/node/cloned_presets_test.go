@@ -0,0 +1,113 @@
+package node
+
+import (
+	"bufio"
+	"net"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+
+	"github.com/ivere27/nitella/pkg/api/common"
+	pbProxy "github.com/ivere27/nitella/pkg/api/proxy"
+)
+
+// fakeSMTPServer answers every connection with greeting.
+func fakeSMTPServer(t *testing.T, greeting *atomic.Value) string {
+	t.Helper()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Listen failed: %v", err)
+	}
+	t.Cleanup(func() { ln.Close() })
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			conn.Write([]byte(greeting.Load().(string)))
+			bufio.NewReader(conn).ReadString('\n')
+			conn.Close()
+		}
+	}()
+	return ln.Addr().String()
+}
+
+func TestCloneBannerPreset(t *testing.T) {
+	t.Cleanup(func() {
+		clonedPresets.Lock()
+		clear(clonedPresets.entries)
+		clonedPresets.Unlock()
+	})
+
+	pm := NewProxyManager(ListenerModeFfi)
+	if err := pm.InitDB(filepath.Join(t.TempDir(), "nitella.db")); err != nil {
+		t.Fatalf("InitDB failed: %v", err)
+	}
+
+	var served atomic.Value
+	greeting := "220 mx1.corp.example ESMTP Postfix (Debian/GNU)\r\n"
+	served.Store(greeting)
+	addr := fakeSMTPServer(t, &served)
+	resp, err := pm.CloneBanner(&pbProxy.CloneBannerRequest{Name: "corp-mx", Protocol: "smtp", BackendAddr: addr})
+	if err != nil {
+		t.Fatalf("CloneBanner failed: %v", err)
+	}
+	if resp.Preset.Banner != greeting {
+		t.Errorf("Expected banner %q, got %q", greeting, resp.Preset.Banner)
+	}
+
+	// Refresh by name reuses the stored backend
+	greeting = "220 mx1.corp.example ESMTP Postfix (Debian/GNU) upgraded\r\n"
+	served.Store(greeting)
+	if _, err := pm.CloneBanner(&pbProxy.CloneBannerRequest{Name: "corp-mx"}); err != nil {
+		t.Fatalf("Refresh failed: %v", err)
+	}
+
+	// Presets survive a restart
+	clonedPresets.Lock()
+	clear(clonedPresets.entries)
+	clonedPresets.Unlock()
+	if err := pm.loadClonedPresets(); err != nil {
+		t.Fatalf("loadClonedPresets failed: %v", err)
+	}
+	list := pm.ListClonedPresets()
+	if len(list.Presets) != 1 || list.Presets[0].Banner != greeting || list.Presets[0].BackendAddr != addr {
+		t.Fatalf("Unexpected presets after reload: %v", list.Presets)
+	}
+
+	// A mock rule naming the preset serves the cloned greeting
+	l := NewEmbeddedListener("test-smtp-clone", "SMTP Clone", "127.0.0.1:0", "", common.ActionType_ACTION_TYPE_MOCK, common.MockPreset_MOCK_PRESET_UNSPECIFIED, "", "", "", pbProxy.ClientAuthType_CLIENT_AUTH_AUTO, nil)
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Listen failed: %v", err)
+	}
+	defer ln.Close()
+	rule := &pbProxy.Rule{
+		Id:           "clone-rule",
+		Action:       common.ActionType_ACTION_TYPE_MOCK,
+		MockResponse: &pbProxy.MockConfig{ClonedPreset: "corp-mx"},
+	}
+	go func() {
+		conn, err := ln.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		l.HandleMockConnection(conn, rule, "conn-1", nil)
+	}()
+
+	client, err := net.Dial("tcp", ln.Addr().String())
+	if err != nil {
+		t.Fatalf("Dial failed: %v", err)
+	}
+	defer client.Close()
+	line, err := bufio.NewReader(client).ReadString('\n')
+	if err != nil {
+		t.Fatalf("Read failed: %v", err)
+	}
+	if line != greeting {
+		t.Errorf("Expected cloned greeting %q, got %q", greeting, line)
+	}
+	client.Write([]byte("QUIT\r\n"))
+}
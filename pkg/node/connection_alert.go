@@ -0,0 +1,133 @@
+package node
+
+import (
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	pb "github.com/ivere27/nitella/pkg/api/proxy"
+	"github.com/ivere27/nitella/pkg/config"
+	"github.com/ivere27/nitella/pkg/log"
+)
+
+// maxConnectionAlertKeys bounds the deduplication table.
+const maxConnectionAlertKeys = 10000
+
+// ConnectionAlertConfig limits the alerts raised for connections forwarded
+// by an ALLOW_ALERT rule or default action, for threshold crossings and for
+// honeypot captures.
+type ConnectionAlertConfig struct {
+	// Dedup folds repeat connections from one source to the same proxy and
+	// rule (or repeat captures of one kind) into a single alert (default 10m).
+	Dedup time.Duration
+	// PerMinute caps the alerts sent by the node (0 = unlimited).
+	PerMinute int
+}
+
+// connectionAlerter deduplicates and rate-limits connection, threshold and
+// honeypot capture alerts.
+type connectionAlerter struct {
+	mu          sync.Mutex
+	cfg         ConnectionAlertConfig
+	seen        map[string]*connectionAlertKey
+	windowStart time.Time
+	windowSent  int
+	dropped     int64 // Alerts dropped by the rate limit
+}
+
+type connectionAlertKey struct {
+	sent    time.Time
+	repeats int // Connections folded into the next alert
+}
+
+func newConnectionAlerter(cfg ConnectionAlertConfig) *connectionAlerter {
+	if cfg.Dedup <= 0 {
+		cfg.Dedup = config.DefaultConnectionAlertDedup
+	}
+	return &connectionAlerter{cfg: cfg, seen: make(map[string]*connectionAlertKey)}
+}
+
+// allow reports whether an alert for key may be sent now, and how many
+// connections were folded into it since the previous alert for key.
+func (a *connectionAlerter) allow(key string, now time.Time) (repeats int, ok bool) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	k := a.seen[key]
+	if k != nil && now.Sub(k.sent) < a.cfg.Dedup {
+		k.repeats++
+		return 0, false
+	}
+
+	if now.Sub(a.windowStart) >= time.Minute {
+		if a.dropped > 0 {
+			log.Printf("[Alert] Dropped %d alerts over the rate limit (%d/min)", a.dropped, a.cfg.PerMinute)
+			a.dropped = 0
+		}
+		a.windowStart = now
+		a.windowSent = 0
+	}
+	if a.cfg.PerMinute > 0 && a.windowSent >= a.cfg.PerMinute {
+		a.dropped++
+		return 0, false
+	}
+	a.windowSent++
+
+	if k != nil {
+		repeats = k.repeats
+	}
+	if k == nil && len(a.seen) >= maxConnectionAlertKeys {
+		a.prune(now)
+	}
+	a.seen[key] = &connectionAlertKey{sent: now}
+	return repeats, true
+}
+
+// prune forgets keys outside the deduplication window, or all keys if
+// every one is recent.
+func (a *connectionAlerter) prune(now time.Time) {
+	for key, k := range a.seen {
+		if now.Sub(k.sent) >= a.cfg.Dedup {
+			delete(a.seen, key)
+		}
+	}
+	if len(a.seen) >= maxConnectionAlertKeys {
+		clear(a.seen)
+	}
+}
+
+// SetConnectionAlerts configures deduplication and rate limiting of
+// ALLOW_ALERT connection, threshold and honeypot capture alerts.
+func (m *ProxyManager) SetConnectionAlerts(cfg ConnectionAlertConfig) {
+	m.connAlerts = newConnectionAlerter(cfg)
+}
+
+// sendConnectionAlert raises an alert for a connection forwarded by an
+// ALLOW_ALERT action.
+func (m *ProxyManager) sendConnectionAlert(model *ProxyModel, event *pb.ConnectionEvent) {
+	if m.Alerts() == nil {
+		return
+	}
+	proxyID := ""
+	if model != nil {
+		proxyID = model.ID
+	}
+	repeats, ok := m.connAlerts.allow(proxyID+KeySeparator+event.RuleMatched+KeySeparator+event.SourceIp, time.Now())
+	if !ok {
+		return
+	}
+
+	summary := fmt.Sprintf("allowed connection from %s", event.SourceIp)
+	if country := event.GetGeo().GetCountry(); country != "" {
+		summary += fmt.Sprintf(" (%s)", country)
+	}
+	summary += fmt.Sprintf(" to %s", event.TargetAddr)
+	fields := map[string]string{"backend": event.TargetAddr}
+	if repeats > 0 {
+		fields["repeats"] = strconv.Itoa(repeats)
+		summary += fmt.Sprintf(", %d more since the last alert", repeats)
+	}
+
+	m.sendEventAlert(model, event, config.AlertTypeConnection, "info", summary, fields)
+}
@@ -0,0 +1,117 @@
+package node
+
+import (
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/ivere27/nitella/pkg/api/common"
+	pbProxy "github.com/ivere27/nitella/pkg/api/proxy"
+	"github.com/ivere27/nitella/pkg/config"
+)
+
+func TestConnectionAlerter(t *testing.T) {
+	a := newConnectionAlerter(ConnectionAlertConfig{Dedup: time.Minute, PerMinute: 2})
+	now := time.Now()
+
+	if _, ok := a.allow("a", now); !ok {
+		t.Fatal("Expected first alert sent")
+	}
+	for i := 0; i < 3; i++ {
+		if _, ok := a.allow("a", now.Add(time.Second)); ok {
+			t.Fatal("Expected repeat within the dedup window suppressed")
+		}
+	}
+	if _, ok := a.allow("b", now); !ok {
+		t.Fatal("Expected alert for another key")
+	}
+	if _, ok := a.allow("c", now); ok {
+		t.Fatal("Expected rate limit to drop the third alert in a minute")
+	}
+
+	repeats, ok := a.allow("a", now.Add(61*time.Second))
+	if !ok || repeats != 3 {
+		t.Errorf("Expected alert folding 3 repeats after the window, got ok=%v repeats=%d", ok, repeats)
+	}
+}
+
+func TestAllowAlertAction(t *testing.T) {
+	backend, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Listen failed: %v", err)
+	}
+	defer backend.Close()
+	go func() {
+		for {
+			conn, err := backend.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				io.Copy(conn, conn)
+			}()
+		}
+	}()
+
+	l := NewEmbeddedListener("test-allow-alert", "Admin", "127.0.0.1:0", backend.Addr().String(), common.ActionType_ACTION_TYPE_ALLOW_ALERT, common.MockPreset_MOCK_PRESET_UNSPECIFIED, "", "", "", pbProxy.ClientAuthType_CLIENT_AUTH_AUTO, nil)
+	if err := l.Start(); err != nil {
+		t.Fatalf("Start failed: %v", err)
+	}
+	defer l.Stop()
+	events := l.Subscribe()
+	defer l.Unsubscribe(events)
+
+	// Forwarded like ALLOW
+	conn, err := net.Dial("tcp", l.ListenAddr)
+	if err != nil {
+		t.Fatalf("Dial failed: %v", err)
+	}
+	defer conn.Close()
+	conn.Write([]byte("ping"))
+	buf := make([]byte, 4)
+	conn.SetReadDeadline(time.Now().Add(2 * time.Second))
+	if _, err := io.ReadFull(conn, buf); err != nil || string(buf) != "ping" {
+		t.Fatalf("Expected echo through the backend, got %q, %v", buf, err)
+	}
+
+	var alertEvent *pbProxy.ConnectionEvent
+	deadline := time.After(2 * time.Second)
+	for alertEvent == nil {
+		select {
+		case ev := <-events:
+			if ev.EventType == pbProxy.EventType_EVENT_TYPE_ALERT {
+				alertEvent = ev
+			}
+		case <-deadline:
+			t.Fatal("Timeout waiting for alert event")
+		}
+	}
+	if alertEvent.ActionTaken != common.ActionType_ACTION_TYPE_ALLOW_ALERT || alertEvent.TargetAddr != backend.Addr().String() || alertEvent.RuleMatched != "default" {
+		t.Errorf("Unexpected alert event: %+v", alertEvent)
+	}
+
+	// The manager sends one alert per source within the dedup window
+	sender := &MockAlertSender{}
+	pm := &ProxyManager{alerts: sender, NodeID: "node-1", connAlerts: newConnectionAlerter(ConnectionAlertConfig{})}
+	model := &ProxyModel{ID: "p1", Name: "Admin", ListenAddr: ":22"}
+	alertEvent.Geo = &common.GeoInfo{Country: "BR"}
+	pm.sendConnectionAlert(model, alertEvent)
+	pm.sendConnectionAlert(model, alertEvent)
+	if len(sender.alerts) != 1 {
+		t.Fatalf("Expected 1 deduplicated alert, got %d", len(sender.alerts))
+	}
+	if got := sender.alerts[0].Metadata[config.AlertMetadataType]; got != config.AlertTypeConnection {
+		t.Errorf("Expected connection alert type, got %q", got)
+	}
+	var details common.AlertDetails
+	if err := proto.Unmarshal([]byte(sender.infos[0]), &details); err != nil {
+		t.Fatalf("Failed to parse alert details: %v", err)
+	}
+	if details.SourceIp != "127.0.0.1" || details.GeoCountry != "BR" || details.ProxyId != "p1" || details.Fields["backend"] != backend.Addr().String() {
+		t.Errorf("Unexpected details: %+v", &details)
+	}
+}
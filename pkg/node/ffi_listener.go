@@ -10,6 +10,7 @@ import (
 	pb "github.com/ivere27/nitella/pkg/api/process"
 	proxy_pb "github.com/ivere27/nitella/pkg/api/proxy"
 	"github.com/ivere27/nitella/pkg/log"
+	"github.com/ivere27/nitella/pkg/node/accesslog"
 	"github.com/ivere27/nitella/pkg/node/stats"
 	"google.golang.org/grpc"
 )
@@ -37,6 +38,7 @@ type FfiListener struct {
 	KeyPEM         string
 	CaPEM          string
 	ClientAuthType proxy_pb.ClientAuthType
+	Thresholds     *proxy_pb.ConnectionThresholds
 
 	// State
 	mu        sync.Mutex
@@ -86,6 +88,11 @@ func (f *FfiListener) SetStatsService(s *stats.StatsService) {
 	f.core.SetStatsService(s)
 }
 
+// SetAccessLog sets the structured access log.
+func (f *FfiListener) SetAccessLog(l *accesslog.Logger) {
+	f.core.SetAccessLog(l)
+}
+
 // SetApprovalManager sets the approval manager.
 func (f *FfiListener) SetApprovalManager(am *ApprovalManager) {
 	f.core.SetApprovalManager(am)
@@ -96,6 +103,11 @@ func (f *FfiListener) SetGlobalRules(gr *GlobalRulesStore) {
 	f.core.SetGlobalRules(gr)
 }
 
+// SetTarpitBudget sets the node-wide tarpit budget.
+func (f *FfiListener) SetTarpitBudget(b *TarpitBudget) {
+	f.core.SetTarpitBudget(b)
+}
+
 // SetNodeID sets the node ID for approval requests.
 func (f *FfiListener) SetNodeID(nodeID string) {
 	f.core.SetNodeID(nodeID)
@@ -107,6 +119,13 @@ func (f *FfiListener) SetFallback(action common.FallbackAction, mock common.Mock
 	f.FallbackMock = mock
 }
 
+// SetThresholds sets the connection thresholds, applying them at once if
+// the listener is running.
+func (f *FfiListener) SetThresholds(t *proxy_pb.ConnectionThresholds) {
+	f.Thresholds = t
+	f.core.SetThresholds(t)
+}
+
 // Start starts the listener via FFI.
 func (f *FfiListener) Start() error {
 	f.mu.Lock()
@@ -129,6 +148,7 @@ func (f *FfiListener) Start() error {
 		ClientAuthType: f.ClientAuthType,
 		FallbackAction: f.FallbackAction,
 		FallbackMock:   f.FallbackMock,
+		Thresholds:     f.Thresholds,
 	})
 	if err != nil {
 		return fmt.Errorf("failed to start listener via FFI: %w", err)
@@ -2,11 +2,14 @@ package node
 
 import (
 	"context"
+	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	pbCommon "github.com/ivere27/nitella/pkg/api/common"
 	"github.com/ivere27/nitella/pkg/geoip"
+	"github.com/ivere27/nitella/pkg/node/metrics"
 )
 
 const defaultGeoLookupTimeout = 3 * time.Second
@@ -15,6 +18,13 @@ const defaultGeoLookupTimeout = 3 * time.Second
 type GeoIPService struct {
 	client geoip.GeoIPClient
 	mu     sync.RWMutex
+
+	// Lookups answered from cache and otherwise, and the latency of
+	// lookups by the source (local database or provider) answering them
+	cacheHits   atomic.Int64
+	cacheMisses atomic.Int64
+	latencyMu   sync.Mutex
+	latency     map[string]*metrics.Histogram
 }
 
 // NewGeoIPService creates a new GeoIP service with the given client.
@@ -58,11 +68,56 @@ func (s *GeoIPService) LookupWithTimeout(ip string, timeout time.Duration) *pbCo
 
 	info, err := client.Lookup(ctx, ip)
 	if err != nil {
+		s.cacheMisses.Add(1)
 		return &pbCommon.GeoInfo{}
 	}
+	s.recordLookup(info)
 	return info
 }
 
+// recordLookup counts a successful lookup towards the cache and latency
+// metrics.
+func (s *GeoIPService) recordLookup(info *pbCommon.GeoInfo) {
+	if strings.HasPrefix(info.GetSource(), "cache-") {
+		s.cacheHits.Add(1)
+		return
+	}
+	s.cacheMisses.Add(1)
+	source := info.GetSource()
+	if source == "" {
+		source = "unknown"
+	}
+	s.latencyMu.Lock()
+	h, ok := s.latency[source]
+	if !ok {
+		if s.latency == nil {
+			s.latency = make(map[string]*metrics.Histogram)
+		}
+		h = metrics.NewHistogram(metrics.LatencyBuckets)
+		s.latency[source] = h
+	}
+	s.latencyMu.Unlock()
+	h.Observe(float64(info.GetLatencyMs()) / 1000)
+}
+
+// CacheStats returns the number of lookups answered from the L1 or L2
+// cache, and of those that were not (including failed lookups).
+func (s *GeoIPService) CacheStats() (hits, misses int64) {
+	return s.cacheHits.Load(), s.cacheMisses.Load()
+}
+
+// ProviderLatency returns the latency distribution, in seconds, of
+// lookups missing the cache by the source that answered them.
+func (s *GeoIPService) ProviderLatency() map[string]metrics.HistogramSnapshot {
+	s.latencyMu.Lock()
+	defer s.latencyMu.Unlock()
+	latency := make(map[string]metrics.HistogramSnapshot, len(s.latency))
+	for source, h := range s.latency {
+		latency[source] = h.Snapshot()
+	}
+	return latency
+}
+
 // Close closes the underlying client.
 func (s *GeoIPService) Close() {
 	s.mu.Lock()
@@ -72,3 +127,94 @@ func (s *GeoIPService) Close() {
 		s.client = nil
 	}
 }
+
+// geoLookup is a GeoIP lookup running in the background, started once a
+// connection's forwarding decision no longer depends on it.
+type geoLookup struct {
+	done chan struct{}
+	once sync.Once
+	info *pbCommon.GeoInfo
+
+	started bool // Set by start; used only by the starting goroutine
+}
+
+// newGeoLookup returns a lookup that completes once started or resolved,
+// so a connection's events can wait for its GeoIP data before the lookup
+// begins.
+func newGeoLookup() *geoLookup {
+	return &geoLookup{done: make(chan struct{})}
+}
+
+// resolvedGeo returns a completed lookup holding info.
+func resolvedGeo(info *pbCommon.GeoInfo) *geoLookup {
+	g := newGeoLookup()
+	g.resolve(info)
+	return g
+}
+
+// lookupAsync starts a lookup for ip. A nil service yields no data.
+func (s *GeoIPService) lookupAsync(ip string) *geoLookup {
+	g := newGeoLookup()
+	g.start(s, ip)
+	return g
+}
+
+// start looks up ip with s in the background, unless g has completed.
+// A nil service yields no data.
+func (g *geoLookup) start(s *GeoIPService, ip string) {
+	select {
+	case <-g.done:
+		return
+	default:
+	}
+	if s == nil {
+		g.resolve(nil)
+		return
+	}
+	g.started = true
+	go func() {
+		g.resolve(s.Lookup(ip))
+	}()
+}
+
+// finish completes g with no data unless a lookup was started.
+func (g *geoLookup) finish() {
+	if !g.started {
+		g.resolve(nil)
+	}
+}
+
+// resolve completes g with info. Only the first call has an effect.
+func (g *geoLookup) resolve(info *pbCommon.GeoInfo) {
+	g.once.Do(func() {
+		g.info = info
+		close(g.done)
+	})
+}
+
+// result waits for the lookup to complete.
+func (g *geoLookup) result() *pbCommon.GeoInfo {
+	<-g.done
+	return g.info
+}
+
+// resultWithin waits up to timeout for the lookup, returning nil if it is
+// still running.
+func (g *geoLookup) resultWithin(timeout time.Duration) *pbCommon.GeoInfo {
+	select {
+	case <-g.done:
+		return g.info
+	default:
+	}
+	if timeout <= 0 {
+		return nil
+	}
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	select {
+	case <-g.done:
+		return g.info
+	case <-timer.C:
+		return nil
+	}
+}
@@ -16,6 +16,7 @@ type GlobalRule struct {
 	Action    common.ActionType // ALLOW or BLOCK
 	ExpiresAt time.Time         // Zero means permanent (until restart)
 	CreatedAt time.Time
+	Trigger   string            // Mock listener that caused a honeypot auto-block, if any
 }
 
 // cidrRule holds a GlobalRule with pre-parsed CIDR for efficient matching
@@ -31,6 +32,10 @@ type GlobalRulesStore struct {
 	cidrRules  map[string]*cidrRule   // Keyed by ID, pre-parsed CIDR rules
 	idToIP     map[string]string      // Maps rule ID to IP for exact rule removal
 	stopCh     chan struct{}
+
+	// Honeypot blocks (see BlockHoneypotIP)
+	honeypotExempt   []*net.IPNet
+	honeypotDuration time.Duration
 }
 
 // NewGlobalRulesStore creates a new global rules store
@@ -40,6 +45,8 @@ func NewGlobalRulesStore() *GlobalRulesStore {
 		cidrRules:  make(map[string]*cidrRule),
 		idToIP:     make(map[string]string),
 		stopCh:     make(chan struct{}),
+
+		honeypotDuration: defaultHoneypotBlockDuration,
 	}
 	go store.cleanupLoop()
 	return store
@@ -104,6 +111,9 @@ func (s *GlobalRulesStore) BlockIP(ip string, duration time.Duration) string {
 	if _, ipNet, err := net.ParseCIDR(ip); err == nil {
 		s.cidrRules[id] = &cidrRule{GlobalRule: rule, ipNet: ipNet}
 	} else {
+		if old, ok := s.exactRules[ip]; ok {
+			delete(s.idToIP, old.ID) // Replaced rule is no longer removable by its ID
+		}
 		s.exactRules[ip] = rule  // Key by IP for O(1) lookup
 		s.idToIP[id] = ip        // Track ID->IP for removal
 	}
@@ -134,6 +144,9 @@ func (s *GlobalRulesStore) AllowIP(ip string, duration time.Duration) string {
 	if _, ipNet, err := net.ParseCIDR(ip); err == nil {
 		s.cidrRules[id] = &cidrRule{GlobalRule: rule, ipNet: ipNet}
 	} else {
+		if old, ok := s.exactRules[ip]; ok {
+			delete(s.idToIP, old.ID) // Replaced rule is no longer removable by its ID
+		}
 		s.exactRules[ip] = rule  // Key by IP for O(1) lookup
 		s.idToIP[id] = ip        // Track ID->IP for removal
 	}
@@ -159,17 +172,102 @@ func (s *GlobalRulesStore) Remove(id string) bool {
 	return false
 }
 
+// SetHoneypotPolicy sets the IPs and CIDRs BlockHoneypotIP never blocks
+// and the duration it uses when none is given.
+func (s *GlobalRulesStore) SetHoneypotPolicy(duration time.Duration, exempt []*net.IPNet) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if duration <= 0 {
+		duration = defaultHoneypotBlockDuration
+	}
+	s.honeypotDuration = duration
+	s.honeypotExempt = exempt
+}
+
+// BlockHoneypotIP adds a honeypot block for an IP, naming the mock listener
+// that triggered it, for duration or, if zero, the honeypot policy's. It
+// never replaces an active manual rule for the IP and is refused for exempt
+// IPs and IPs covered by a global ALLOW rule. Returns whether the block was
+// added.
+func (s *GlobalRulesStore) BlockHoneypotIP(ip string, duration time.Duration, trigger string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	if rule, ok := s.exactRules[ip]; ok && rule.Trigger == "" && (rule.ExpiresAt.IsZero() || now.Before(rule.ExpiresAt)) {
+		return false
+	}
+	if parsedIP := net.ParseIP(ip); parsedIP != nil {
+		for _, n := range s.honeypotExempt {
+			if n.Contains(parsedIP) {
+				return false
+			}
+		}
+		for _, cr := range s.cidrRules {
+			if cr.Action == common.ActionType_ACTION_TYPE_ALLOW && cr.ipNet.Contains(parsedIP) &&
+				(cr.ExpiresAt.IsZero() || now.Before(cr.ExpiresAt)) {
+				return false
+			}
+		}
+	}
+
+	id := "honeypot-block-" + ip
+	if duration <= 0 {
+		duration = s.honeypotDuration
+	}
+	expiresAt := now.Add(duration)
+	if old, ok := s.exactRules[ip]; ok {
+		delete(s.idToIP, old.ID)
+	}
+	s.exactRules[ip] = &GlobalRule{
+		ID:        id,
+		Name:      "Honeypot block: " + ip + " (via " + trigger + ")",
+		SourceIP:  ip,
+		Action:    common.ActionType_ACTION_TYPE_BLOCK,
+		ExpiresAt: expiresAt,
+		CreatedAt: now,
+		Trigger:   trigger,
+	}
+	s.idToIP[id] = ip
+	return true
+}
+
 // Check evaluates global rules for an IP.
 // Returns (matched, action). If matched is false, no global rule applies.
 // Priority: BLOCK rules take precedence over ALLOW rules.
 func (s *GlobalRulesStore) Check(sourceIP string) (bool, common.ActionType) {
+	return s.check(sourceIP, false)
+}
+
+// CheckListener is Check for one listener connection. Honeypot auto-blocks
+// are skipped for connections the listener hands to a mock, which keeps
+// serving the source so it can be observed. mocked reports that and is
+// only called when the source has a honeypot block; nil means never.
+func (s *GlobalRulesStore) CheckListener(sourceIP string, mocked func() bool) (bool, common.ActionType) {
+	skipHoneypot := false
+	if mocked != nil && s.honeypotBlocked(sourceIP) {
+		skipHoneypot = mocked()
+	}
+	return s.check(sourceIP, skipHoneypot)
+}
+
+// honeypotBlocked reports whether ip has an active honeypot auto-block.
+func (s *GlobalRulesStore) honeypotBlocked(ip string) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	rule, ok := s.exactRules[ip]
+	return ok && rule.Trigger != "" && (rule.ExpiresAt.IsZero() || time.Now().Before(rule.ExpiresAt))
+}
+
+func (s *GlobalRulesStore) check(sourceIP string, skipHoneypot bool) (bool, common.ActionType) {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 
 	now := time.Now()
 
 	// O(1) exact match first
-	if rule, ok := s.exactRules[sourceIP]; ok {
+	if rule, ok := s.exactRules[sourceIP]; ok && !(skipHoneypot && rule.Trigger != "") {
 		if rule.ExpiresAt.IsZero() || now.Before(rule.ExpiresAt) {
 			return true, rule.Action
 		}
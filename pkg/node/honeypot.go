@@ -0,0 +1,376 @@
+package node
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/ivere27/nitella/pkg/api/common"
+	pb "github.com/ivere27/nitella/pkg/api/proxy"
+	"github.com/ivere27/nitella/pkg/config"
+	"github.com/ivere27/nitella/pkg/log"
+	"github.com/ivere27/nitella/pkg/mockproto"
+	"github.com/ivere27/nitella/pkg/node/stats"
+)
+
+// sendCaptureAlert raises a honeypot alert for a credential captured by a mock.
+// Client fingerprints and HTTP requests other than canary hits are reported
+// as events only.
+func (m *ProxyManager) sendCaptureAlert(model *ProxyModel, event *pb.ConnectionEvent) {
+	capture := event.GetCapture()
+	if m.Alerts() == nil || capture == nil || capture.Kind == mockproto.CaptureClientInfo {
+		return
+	}
+	if capture.Kind == mockproto.CaptureHTTPRequest && capture.Fields["canary"] != "true" {
+		return
+	}
+	// A brute force yields a capture per attempt: fold repeats of one kind
+	// from a source like connection alerts, under the same rate limit
+	proxyID := ""
+	if model != nil {
+		proxyID = model.ID
+	}
+	key := config.AlertTypeHoneypot + KeySeparator + proxyID + KeySeparator + capture.Kind + KeySeparator + event.SourceIp
+	repeats, ok := m.connAlerts.allow(key, time.Now())
+	if !ok {
+		return
+	}
+
+	fields := map[string]string{
+		"protocol": capture.Protocol,
+		"kind":     capture.Kind,
+		"username": capture.Username,
+	}
+	if capture.Password != "" {
+		fields["password"] = capture.Password
+	}
+	for k, v := range capture.Fields {
+		fields[k] = v
+	}
+
+	summary := fmt.Sprintf("%s %s login attempt for %q", capture.Protocol, capture.Kind, capture.Username)
+	switch capture.Kind {
+	case mockproto.CaptureDownload:
+		summary = fmt.Sprintf("%s download attempt: %s", capture.Protocol, capture.Fields["url"])
+	case mockproto.CaptureLogin:
+		summary = fmt.Sprintf("%s login attempt for %q", capture.Protocol, capture.Username)
+		if db := capture.Fields["database"]; db != "" {
+			summary += fmt.Sprintf(" on database %q", db)
+		}
+	case mockproto.CaptureHTTPRequest:
+		summary = fmt.Sprintf("http canary hit: %s %s", capture.Fields["method"], capture.Fields["path"])
+	case mockproto.CaptureScript:
+		summary = "scripted mock capture"
+		if name := capture.Fields["script"]; name != "" {
+			summary += fmt.Sprintf(" from %q", name)
+		}
+	}
+
+	if repeats > 0 {
+		fields["repeats"] = strconv.Itoa(repeats)
+		summary += fmt.Sprintf(", %d more since the last alert", repeats)
+	}
+
+	m.sendHoneypotAlert(model, event, summary, fields)
+}
+
+// sendHoneypotAlert sends a honeypot alert about event's source.
+func (m *ProxyManager) sendHoneypotAlert(model *ProxyModel, event *pb.ConnectionEvent, summary string, fields map[string]string) {
+	m.sendEventAlert(model, event, config.AlertTypeHoneypot, "warning", summary, fields)
+}
+
+// sendEventAlert sends an informational alert of alertType about event's
+// source.
+func (m *ProxyManager) sendEventAlert(model *ProxyModel, event *pb.ConnectionEvent, alertType, severity, summary string, fields map[string]string) {
+	alerts := m.Alerts()
+	if alerts == nil {
+		return
+	}
+	details := &common.AlertDetails{
+		SourceIp: event.SourceIp,
+		RuleId:   event.RuleMatched,
+		Summary:  summary,
+		Fields:   fields,
+	}
+	if model != nil {
+		details.Destination = model.ListenAddr
+		details.ProxyId = model.ID
+		details.ProxyName = model.Name
+	}
+	if geo := event.Geo; geo != nil {
+		details.GeoCountry = geo.Country
+		details.GeoCity = geo.City
+		details.GeoIsp = geo.Isp
+	}
+
+	info, err := proto.Marshal(details)
+	if err != nil {
+		log.Printf("[Alert] Failed to marshal %s alert details: %v", alertType, err)
+		return
+	}
+
+	alert := &common.Alert{
+		Id:            uuid.New().String(),
+		NodeId:        m.NodeID,
+		Severity:      severity,
+		TimestampUnix: time.Now().Unix(),
+		Metadata: map[string]string{
+			config.AlertMetadataType: alertType,
+		},
+	}
+	if err := alerts.SendAlert(alert, string(info)); err != nil {
+		log.Printf("[Alert] Failed to send %s alert: %v", alertType, err)
+	}
+}
+
+// SetHoneypotBlock enables honeypot reputation: sources that touch a mock
+// listener (or submit credentials to one, per cfg.Trigger) are blocked
+// globally, except on mock listeners, for an escalating duration.
+func (m *ProxyManager) SetHoneypotBlock(cfg HoneypotBlockConfig) error {
+	r, err := newHoneypotReputation(cfg)
+	if err != nil {
+		return err
+	}
+	m.reputation = r
+	if m.GlobalRules != nil {
+		m.GlobalRules.SetHoneypotPolicy(r.cfg.Duration, r.exempt)
+	}
+	return nil
+}
+
+// honeypotBlock applies honeypot reputation to a mock interaction or
+// capture event, blocking the source and raising an alert naming the
+// listener that triggered it.
+func (m *ProxyManager) honeypotBlock(model *ProxyModel, event *pb.ConnectionEvent) {
+	r := m.reputation
+	if r == nil || m.GlobalRules == nil {
+		return
+	}
+	interaction := event.EventType == pb.EventType_EVENT_TYPE_MOCK_INTERACTION
+	if !r.triggeredBy(interaction, event.GetCapture().GetKind()) {
+		return
+	}
+	listener := event.TargetAddr
+	if model != nil && model.Name != "" {
+		listener = model.Name
+	}
+	duration, offense, ok := r.offend(event.SourceIp, func(d time.Duration) bool {
+		return m.GlobalRules.BlockHoneypotIP(event.SourceIp, d, listener)
+	})
+	if !ok {
+		return
+	}
+
+	trigger := "connection"
+	if !interaction {
+		trigger = event.GetCapture().GetProtocol() + " " + event.GetCapture().GetKind() + " capture"
+	}
+	log.Printf("[Honeypot] Blocked %s globally for %s after %s on mock listener %s (offense %d)", event.SourceIp, duration, trigger, listener, offense)
+
+	if m.Alerts() == nil {
+		return
+	}
+	m.sendHoneypotAlert(model, event, fmt.Sprintf("auto-blocked %s for %s after %s on mock listener %q", event.SourceIp, duration, trigger, listener), map[string]string{
+		"trigger_listener": listener,
+		"trigger":          trigger,
+		"block_seconds":    strconv.Itoa(int(duration.Seconds())),
+		"offense":          strconv.Itoa(offense),
+	})
+}
+
+// GetMockTranscripts returns recorded fake shell sessions, newest first.
+// Transcripts are kept in the stats database, so none are returned while
+// statistics are disabled.
+func (m *ProxyManager) GetMockTranscripts(req *pb.GetMockTranscriptsRequest) (*pb.GetMockTranscriptsResponse, error) {
+	if m.Stats == nil {
+		return &pb.GetMockTranscriptsResponse{}, nil
+	}
+
+	logs, err := m.Stats.GetTranscripts(&stats.TranscriptFilter{
+		ConnID:   req.ConnId,
+		SourceIP: req.SourceIp,
+		Limit:    int(req.Limit),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.GetMockTranscriptsResponse{}
+	for _, l := range logs {
+		resp.Transcripts = append(resp.Transcripts, &pb.MockTranscript{
+			ConnId:     l.ConnID,
+			SourceIp:   l.SourceIP,
+			SourcePort: l.SourcePort,
+			RuleId:     l.RuleID,
+			StartTime:  timestamppb.New(l.FirstSeen),
+			DurationMs: l.DurationMs,
+			GeoCountry: l.GeoCountry,
+			Transcript: l.Transcript,
+		})
+	}
+	return resp, nil
+}
+
+// httpRoutesCache holds parsed http_routes_file tables, reloaded when the
+// file changes.
+var httpRoutesCache = struct {
+	sync.Mutex
+	entries map[string]httpRoutesEntry
+}{entries: make(map[string]httpRoutesEntry)}
+
+type httpRoutesEntry struct {
+	modTime time.Time
+	routes  []mockproto.HTTPRoute
+}
+
+// httpRoutesFor builds the HTTP honeypot route table for a mock config:
+// routes from http_routes_file first, then the named packs in order.
+func httpRoutesFor(cfg *pb.MockConfig) []mockproto.HTTPRoute {
+	var routes []mockproto.HTTPRoute
+	if path := cfg.GetHttpRoutesFile(); path != "" {
+		routes = append(routes, loadHTTPRoutesCached(path)...)
+	}
+	for _, name := range cfg.GetHttpRoutePacks() {
+		pack := mockproto.HTTPRoutePack(name)
+		if pack == nil {
+			log.Printf("[Mock] Unknown HTTP route pack %q", name)
+			continue
+		}
+		routes = append(routes, pack...)
+	}
+	return routes
+}
+
+func loadHTTPRoutesCached(path string) []mockproto.HTTPRoute {
+	info, err := os.Stat(path)
+	if err != nil {
+		log.Printf("[Mock] HTTP routes file %s: %v", path, err)
+		return nil
+	}
+
+	httpRoutesCache.Lock()
+	defer httpRoutesCache.Unlock()
+
+	if e, ok := httpRoutesCache.entries[path]; ok && e.modTime.Equal(info.ModTime()) {
+		return e.routes
+	}
+	routes, err := mockproto.LoadHTTPRoutes(path)
+	if err != nil {
+		log.Printf("[Mock] %v", err)
+		return nil
+	}
+	httpRoutesCache.entries[path] = httpRoutesEntry{modTime: info.ModTime(), routes: routes}
+	return routes
+}
+
+// scriptCache holds parsed mock scripts: script_file entries are reloaded
+// when the file changes, inline scripts are keyed by their text.
+var scriptCache = struct {
+	sync.Mutex
+	files  map[string]scriptEntry
+	inline map[string]*mockproto.Script
+}{files: make(map[string]scriptEntry), inline: make(map[string]*mockproto.Script)}
+
+type scriptEntry struct {
+	modTime time.Time
+	script  *mockproto.Script
+}
+
+// maxInlineScripts bounds the inline script cache; it is reset when full.
+const maxInlineScripts = 256
+
+// scriptFor returns the script for a mock config, from script_file if set,
+// else from the inline script. Invalid scripts are logged and yield nil.
+func scriptFor(cfg *pb.MockConfig) *mockproto.Script {
+	if path := cfg.GetScriptFile(); path != "" {
+		return loadScriptCached(path)
+	}
+	text := cfg.GetScript()
+	if text == "" {
+		return nil
+	}
+
+	scriptCache.Lock()
+	defer scriptCache.Unlock()
+
+	if s, ok := scriptCache.inline[text]; ok {
+		return s
+	}
+	s, err := mockproto.ParseScript([]byte(text))
+	if err != nil {
+		log.Printf("[Mock] Inline script: %v", err)
+		return nil
+	}
+	if len(scriptCache.inline) >= maxInlineScripts {
+		clear(scriptCache.inline)
+	}
+	scriptCache.inline[text] = s
+	return s
+}
+
+// validateMockScript rejects a script mock whose script does not load, so
+// a bad script fails when the rule is added rather than on each connection.
+func validateMockScript(cfg *pb.MockConfig) error {
+	if path := cfg.GetScriptFile(); path != "" {
+		if _, err := mockproto.LoadScript(path); err != nil {
+			return fmt.Errorf("mock script_file: %w", err)
+		}
+		return nil
+	}
+	if text := cfg.GetScript(); text != "" {
+		if _, err := mockproto.ParseScript([]byte(text)); err != nil {
+			return fmt.Errorf("mock script: %w", err)
+		}
+		return nil
+	}
+	if cfg.GetProtocol() == "script" {
+		return fmt.Errorf("script mock needs script or script_file")
+	}
+	return nil
+}
+
+func loadScriptCached(path string) *mockproto.Script {
+	info, err := os.Stat(path)
+	if err != nil {
+		log.Printf("[Mock] Script file %s: %v", path, err)
+		return nil
+	}
+
+	scriptCache.Lock()
+	defer scriptCache.Unlock()
+
+	if e, ok := scriptCache.files[path]; ok && e.modTime.Equal(info.ModTime()) {
+		return e.script
+	}
+	s, err := mockproto.LoadScript(path)
+	if err != nil {
+		// Keep serving the last good version of an edited script
+		log.Printf("[Mock] %v", err)
+		if e, ok := scriptCache.files[path]; ok {
+			return e.script
+		}
+		return nil
+	}
+	scriptCache.files[path] = scriptEntry{modTime: info.ModTime(), script: s}
+	return s
+}
+
+// blockCanaryHit adds a honeypot block for a source IP that requested a
+// canary path, for blockSeconds or, if zero, the honeypot block duration.
+func (p *EmbeddedListener) blockCanaryHit(sourceIP, path string, blockSeconds int32) {
+	if p.globalRules == nil {
+		log.Printf("[Mock] Canary hit from %s on %s (no global rules store, not blocked)", sourceIP, path)
+		return
+	}
+	if !p.globalRules.BlockHoneypotIP(sourceIP, time.Duration(blockSeconds)*time.Second, p.Name) {
+		log.Printf("[Mock] Canary hit from %s on %s: not blocked (exempt, allowed or already blocked)", sourceIP, path)
+		return
+	}
+	log.Printf("[Mock] Canary hit from %s on %s: blocked globally", sourceIP, path)
+}
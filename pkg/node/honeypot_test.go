@@ -0,0 +1,308 @@
+package node
+
+import (
+	"bufio"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"golang.org/x/crypto/ssh"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/ivere27/nitella/pkg/api/common"
+	pbProxy "github.com/ivere27/nitella/pkg/api/proxy"
+	"github.com/ivere27/nitella/pkg/config"
+	"github.com/ivere27/nitella/pkg/mockproto"
+)
+
+func TestSSHMockCaptureEvent(t *testing.T) {
+	l := NewEmbeddedListener("test-ssh-honeypot", "SSH Honeypot", "127.0.0.1:0", "", common.ActionType_ACTION_TYPE_MOCK, common.MockPreset_MOCK_PRESET_SSH_SECURE, "", "", "", pbProxy.ClientAuthType_CLIENT_AUTH_AUTO, nil)
+	if err := l.Start(); err != nil {
+		t.Fatalf("Start failed: %v", err)
+	}
+	defer l.Stop()
+
+	events := l.Subscribe()
+	defer l.Unsubscribe(events)
+
+	go ssh.Dial("tcp", l.ListenAddr, &ssh.ClientConfig{
+		User:            "root",
+		Auth:            []ssh.AuthMethod{ssh.Password("toor")},
+		HostKeyCallback: ssh.InsecureIgnoreHostKey(),
+		Timeout:         5 * time.Second,
+	})
+
+	deadline := time.After(5 * time.Second)
+	var sawClientInfo bool
+	for {
+		select {
+		case ev := <-events:
+			if ev.EventType != pbProxy.EventType_EVENT_TYPE_MOCK_CAPTURE {
+				continue
+			}
+			c := ev.GetCapture()
+			if c.Kind == mockproto.CaptureClientInfo {
+				sawClientInfo = c.Fields["hassh"] != ""
+				continue
+			}
+			if c.Kind != mockproto.CapturePassword {
+				continue
+			}
+			if c.Username != "root" || c.Password != "toor" {
+				t.Errorf("Expected root/toor, got %s/%s", c.Username, c.Password)
+			}
+			if ev.ActionTaken != common.ActionType_ACTION_TYPE_MOCK || ev.ConnId == "" {
+				t.Errorf("Unexpected event context: %+v", ev)
+			}
+			if !sawClientInfo {
+				t.Error("Expected client_info capture with HASSH before password")
+			}
+			return
+		case <-deadline:
+			t.Fatal("Timeout waiting for password capture event")
+		}
+	}
+}
+
+func TestSendCaptureAlert(t *testing.T) {
+	sender := &MockAlertSender{}
+	pm := &ProxyManager{alerts: sender, NodeID: "node-1", connAlerts: newConnectionAlerter(ConnectionAlertConfig{})}
+	model := &ProxyModel{ID: "p1", Name: "ssh-trap", ListenAddr: ":2222"}
+
+	// Fingerprint-only captures are not alerted
+	pm.sendCaptureAlert(model, &pbProxy.ConnectionEvent{
+		SourceIp:  "203.0.113.7",
+		EventType: pbProxy.EventType_EVENT_TYPE_MOCK_CAPTURE,
+		Capture:   &pbProxy.MockCapture{Protocol: "ssh", Kind: mockproto.CaptureClientInfo},
+	})
+	if len(sender.alerts) != 0 {
+		t.Fatalf("Expected no alert for client_info, got %d", len(sender.alerts))
+	}
+
+	pm.sendCaptureAlert(model, &pbProxy.ConnectionEvent{
+		SourceIp:  "203.0.113.7",
+		EventType: pbProxy.EventType_EVENT_TYPE_MOCK_CAPTURE,
+		Geo:       &common.GeoInfo{Country: "NL"},
+		Capture: &pbProxy.MockCapture{
+			Protocol: "ssh",
+			Kind:     mockproto.CapturePassword,
+			Username: "admin",
+			Password: "123456",
+		},
+	})
+	if len(sender.alerts) != 1 {
+		t.Fatalf("Expected 1 alert, got %d", len(sender.alerts))
+	}
+
+	alert := sender.alerts[0]
+	if alert.Metadata[config.AlertMetadataType] != config.AlertTypeHoneypot {
+		t.Errorf("Expected honeypot alert type, got %q", alert.Metadata[config.AlertMetadataType])
+	}
+	if alert.NodeId != "node-1" {
+		t.Errorf("Expected node-1, got %q", alert.NodeId)
+	}
+
+	var details common.AlertDetails
+	if err := proto.Unmarshal([]byte(sender.infos[0]), &details); err != nil {
+		t.Fatalf("Failed to parse alert details: %v", err)
+	}
+	if details.SourceIp != "203.0.113.7" || details.ProxyName != "ssh-trap" || details.GeoCountry != "NL" {
+		t.Errorf("Unexpected details: %+v", &details)
+	}
+	if details.Fields["username"] != "admin" || details.Fields["password"] != "123456" {
+		t.Errorf("Expected captured credentials in fields, got %v", details.Fields)
+	}
+
+	// Further attempts from the source are folded into the next alert
+	for _, password := range []string{"root", "admin", "letmein"} {
+		pm.sendCaptureAlert(model, &pbProxy.ConnectionEvent{
+			SourceIp:  "203.0.113.7",
+			EventType: pbProxy.EventType_EVENT_TYPE_MOCK_CAPTURE,
+			Capture:   &pbProxy.MockCapture{Protocol: "ssh", Kind: mockproto.CapturePassword, Username: "admin", Password: password},
+		})
+	}
+	if len(sender.alerts) != 1 {
+		t.Fatalf("Expected repeat captures folded, got %d alerts", len(sender.alerts))
+	}
+	pm.sendCaptureAlert(model, &pbProxy.ConnectionEvent{
+		SourceIp:  "198.51.100.9",
+		EventType: pbProxy.EventType_EVENT_TYPE_MOCK_CAPTURE,
+		Capture:   &pbProxy.MockCapture{Protocol: "ssh", Kind: mockproto.CapturePassword, Username: "root"},
+	})
+	if len(sender.alerts) != 2 {
+		t.Fatalf("Expected an alert for another source, got %d alerts", len(sender.alerts))
+	}
+}
+
+func TestHTTPMockCanaryBlocksIP(t *testing.T) {
+	l := NewEmbeddedListener("test-http-honeypot", "HTTP Honeypot", "127.0.0.1:0", "", common.ActionType_ACTION_TYPE_MOCK, common.MockPreset_MOCK_PRESET_UNSPECIFIED, "", "", "", pbProxy.ClientAuthType_CLIENT_AUTH_AUTO, nil)
+	gr := NewGlobalRulesStore()
+	defer gr.Stop()
+	l.SetGlobalRules(gr)
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Listen failed: %v", err)
+	}
+	defer ln.Close()
+
+	rule := &pbProxy.Rule{
+		Id:     "canary-rule",
+		Action: common.ActionType_ACTION_TYPE_MOCK,
+		MockResponse: &pbProxy.MockConfig{
+			Protocol:       "http",
+			HttpRoutePacks: []string{"wordpress"},
+			CanaryPaths:    []string{"/.env"},
+		},
+	}
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		conn, err := ln.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		l.HandleMockConnection(conn, rule, "conn-1", nil)
+	}()
+
+	client, err := net.Dial("tcp", ln.Addr().String())
+	if err != nil {
+		t.Fatalf("Dial failed: %v", err)
+	}
+	defer client.Close()
+
+	// A pack route first: served, not blocked
+	fmt.Fprint(client, "GET /wp-login.php HTTP/1.1\r\nHost: x\r\n\r\n")
+	resp, err := http.ReadResponse(bufio.NewReader(client), nil)
+	if err != nil {
+		t.Fatalf("ReadResponse failed: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("Expected 200 for /wp-login.php, got %d", resp.StatusCode)
+	}
+	if matched, _ := gr.Check("127.0.0.1"); matched {
+		t.Fatal("Expected no block before canary hit")
+	}
+
+	fmt.Fprint(client, "GET /.env HTTP/1.1\r\nHost: x\r\nConnection: close\r\n\r\n")
+	<-done
+
+	matched, action := gr.Check("127.0.0.1")
+	if !matched || action != common.ActionType_ACTION_TYPE_BLOCK {
+		t.Errorf("Expected global block after canary hit, got matched=%v action=%v", matched, action)
+	}
+	// With no canary_block_seconds the honeypot block duration applies
+	for _, r := range gr.List() {
+		if r.ID == "honeypot-block-127.0.0.1" && time.Until(r.ExpiresAt).Round(time.Minute) != defaultHoneypotBlockDuration {
+			t.Errorf("Expected the default honeypot duration, got expiry %v", r.ExpiresAt)
+		}
+	}
+}
+
+func TestProtocolMockPresets(t *testing.T) {
+	presets := map[common.MockPreset]string{
+		common.MockPreset_MOCK_PRESET_POSTGRES_SECURE:      "postgres",
+		common.MockPreset_MOCK_PRESET_POSTGRES_TARPIT:      "postgres",
+		common.MockPreset_MOCK_PRESET_MONGODB_SECURE:       "mongodb",
+		common.MockPreset_MOCK_PRESET_MONGODB_TARPIT:       "mongodb",
+		common.MockPreset_MOCK_PRESET_ELASTICSEARCH_SECURE: "elasticsearch",
+		common.MockPreset_MOCK_PRESET_ELASTICSEARCH_TARPIT: "elasticsearch",
+		common.MockPreset_MOCK_PRESET_MEMCACHED_SECURE:     "memcached",
+		common.MockPreset_MOCK_PRESET_MEMCACHED_TARPIT:     "memcached",
+		common.MockPreset_MOCK_PRESET_FTP_SECURE:           "ftp",
+		common.MockPreset_MOCK_PRESET_FTP_TARPIT:           "ftp",
+		common.MockPreset_MOCK_PRESET_VNC_SECURE:           "vnc",
+		common.MockPreset_MOCK_PRESET_VNC_TARPIT:           "vnc",
+		common.MockPreset_MOCK_PRESET_SMB_SECURE:           "smb",
+		common.MockPreset_MOCK_PRESET_LDAP_SECURE:          "ldap",
+		common.MockPreset_MOCK_PRESET_LDAP_TARPIT:          "ldap",
+	}
+	for enum, protocol := range presets {
+		name := MockPresetToString(enum)
+		if StringToMockPreset(name) != enum {
+			t.Errorf("%s: round trip via %q failed", enum, name)
+		}
+		p := config.GetPreset(name)
+		if p == nil || p.Protocol != protocol {
+			t.Errorf("%s: expected %s preset, got %+v", enum, protocol, p)
+			continue
+		}
+		if wantTarpit := strings.HasSuffix(name, "-tarpit"); p.Behavior.Tarpit != wantTarpit {
+			t.Errorf("%s: Tarpit = %v", name, p.Behavior.Tarpit)
+		}
+	}
+}
+
+func TestScriptForReloadsFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "banner.yaml")
+	write := func(body string, mtime time.Time) {
+		if err := os.WriteFile(path, []byte(body), 0644); err != nil {
+			t.Fatal(err)
+		}
+		os.Chtimes(path, mtime, mtime)
+	}
+	base := time.Now().Add(-time.Hour)
+	cfg := &pbProxy.MockConfig{Protocol: "script", ScriptFile: path}
+
+	write("name: v1\nsteps:\n  - send: one\n", base)
+	if s := scriptFor(cfg); s == nil || s.Name != "v1" {
+		t.Fatalf("Expected v1, got %+v", s)
+	}
+
+	write("name: v2\nsteps:\n  - send: two\n", base.Add(time.Minute))
+	if s := scriptFor(cfg); s == nil || s.Name != "v2" {
+		t.Fatalf("Expected reloaded v2, got %+v", s)
+	}
+
+	// A broken edit keeps the last good script
+	write("name: v3\nsteps:\n  - goto: nowhere\n", base.Add(2*time.Minute))
+	if s := scriptFor(cfg); s == nil || s.Name != "v2" {
+		t.Fatalf("Expected v2 to survive an invalid edit, got %+v", s)
+	}
+
+	inline := &pbProxy.MockConfig{Protocol: "script", Script: "name: inline\nsteps:\n  - close: true\n"}
+	if s := scriptFor(inline); s == nil || s.Name != "inline" || scriptFor(inline) != s {
+		t.Errorf("Expected cached inline script, got %+v", s)
+	}
+}
+
+func TestAddRuleRejectsBadScript(t *testing.T) {
+	pm := NewProxyManager(ListenerModeFfi)
+	defer pm.Close()
+	resp, err := pm.CreateProxy(&pbProxy.CreateProxyRequest{
+		Name:          "script",
+		ListenAddr:    "127.0.0.1:0",
+		DefaultAction: common.ActionType_ACTION_TYPE_BLOCK,
+	})
+	if err != nil || !resp.Success {
+		t.Fatalf("CreateProxy failed: %v %s", err, resp.GetErrorMessage())
+	}
+	defer pm.DisableProxy(resp.ProxyId)
+
+	good := filepath.Join(t.TempDir(), "good.yaml")
+	if err := os.WriteFile(good, []byte("steps:\n  - send: hi\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	for name, mock := range map[string]*pbProxy.MockConfig{
+		"missing file":   {Protocol: "script", ScriptFile: filepath.Join(t.TempDir(), "missing.yaml")},
+		"invalid inline": {Protocol: "script", Script: "steps:\n  - goto: nowhere\n"},
+		"no script":      {Protocol: "script"},
+	} {
+		rule := &pbProxy.Rule{Action: common.ActionType_ACTION_TYPE_MOCK, MockResponse: mock}
+		if _, err := pm.AddRule(&pbProxy.AddRuleRequest{ProxyId: resp.ProxyId, Rule: rule}); err == nil {
+			t.Errorf("%s: expected the rule to be rejected", name)
+		}
+	}
+
+	rule := &pbProxy.Rule{Action: common.ActionType_ACTION_TYPE_MOCK, MockResponse: &pbProxy.MockConfig{Protocol: "script", ScriptFile: good}}
+	if _, err := pm.AddRule(&pbProxy.AddRuleRequest{ProxyId: resp.ProxyId, Rule: rule}); err != nil {
+		t.Errorf("Expected a valid script file to be accepted: %v", err)
+	}
+}
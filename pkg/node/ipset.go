@@ -0,0 +1,84 @@
+package node
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"strings"
+)
+
+// IPSet is a named list of addresses and networks, e.g. a threat feed or
+// the office ranges. Approval requests name the sets holding their source.
+type IPSet struct {
+	Name string
+	nets []*net.IPNet
+}
+
+// ParseIPSet reads one IP or CIDR per line. Blank lines and text after '#'
+// are ignored.
+func ParseIPSet(name string, r io.Reader) (*IPSet, error) {
+	set := &IPSet{Name: name}
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		entry := scanner.Text()
+		if i := strings.IndexByte(entry, '#'); i >= 0 {
+			entry = entry[:i]
+		}
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		ipNet, err := parseIPNet(entry)
+		if err != nil {
+			return nil, fmt.Errorf("IP set %s line %d: %w", name, line, err)
+		}
+		set.nets = append(set.nets, ipNet)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("IP set %s: %w", name, err)
+	}
+	return set, nil
+}
+
+// LoadIPSet reads the IP set name from path.
+func LoadIPSet(name, path string) (*IPSet, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return ParseIPSet(name, f)
+}
+
+// Contains reports whether ip is in the set.
+func (s *IPSet) Contains(ip net.IP) bool {
+	for _, n := range s.nets {
+		if n.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// Len returns the number of entries in the set.
+func (s *IPSet) Len() int {
+	return len(s.nets)
+}
+
+// parseIPNet parses a CIDR, or a single address as a host network.
+func parseIPNet(s string) (*net.IPNet, error) {
+	if !strings.Contains(s, "/") {
+		ip := net.ParseIP(s)
+		if ip == nil {
+			return nil, fmt.Errorf("invalid IP address %q", s)
+		}
+		if ip4 := ip.To4(); ip4 != nil {
+			return &net.IPNet{IP: ip4, Mask: net.CIDRMask(32, 32)}, nil
+		}
+		return &net.IPNet{IP: ip, Mask: net.CIDRMask(128, 128)}, nil
+	}
+	_, ipNet, err := net.ParseCIDR(s)
+	return ipNet, err
+}
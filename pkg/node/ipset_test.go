@@ -0,0 +1,37 @@
+package node
+
+import (
+	"net"
+	"strings"
+	"testing"
+)
+
+func TestParseIPSet(t *testing.T) {
+	set, err := ParseIPSet("office", strings.NewReader(`
+# Office ranges
+192.0.2.0/24
+198.51.100.7   # VPN gateway
+2001:db8::/32
+`))
+	if err != nil {
+		t.Fatalf("ParseIPSet failed: %v", err)
+	}
+	if set.Name != "office" || set.Len() != 3 {
+		t.Fatalf("Expected 3 entries in office, got %d in %s", set.Len(), set.Name)
+	}
+	for ip, want := range map[string]bool{
+		"192.0.2.200":  true,
+		"198.51.100.7": true,
+		"198.51.100.8": false,
+		"2001:db8::1":  true,
+		"2001:db9::1":  false,
+	} {
+		if got := set.Contains(net.ParseIP(ip)); got != want {
+			t.Errorf("Contains(%s) = %v, want %v", ip, got, want)
+		}
+	}
+
+	if _, err := ParseIPSet("bad", strings.NewReader("192.0.2.1\nnot-an-ip\n")); err == nil || !strings.Contains(err.Error(), "line 2") {
+		t.Errorf("Expected an error naming line 2, got %v", err)
+	}
+}
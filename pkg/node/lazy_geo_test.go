@@ -0,0 +1,143 @@
+package node
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/ivere27/nitella/pkg/api/common"
+	pbProxy "github.com/ivere27/nitella/pkg/api/proxy"
+)
+
+func TestRulesNeedGeo(t *testing.T) {
+	l := NewEmbeddedListener("test-needs-geo", "Needs Geo", "127.0.0.1:0", "", common.ActionType_ACTION_TYPE_ALLOW, common.MockPreset_MOCK_PRESET_UNSPECIFIED, "", "", "", pbProxy.ClientAuthType_CLIENT_AUTH_AUTO, nil)
+
+	l.AddRule(&pbProxy.Rule{
+		Id:     "ip-rule",
+		Action: common.ActionType_ACTION_TYPE_BLOCK,
+		Conditions: []*pbProxy.Condition{
+			{Type: common.ConditionType_CONDITION_TYPE_SOURCE_IP, Value: "10.0.0.0/8"},
+		},
+	})
+	if l.ruleSet().needsGeo {
+		t.Error("IP-only rules should not need GeoIP")
+	}
+
+	l.AddRule(&pbProxy.Rule{
+		Id:     "geo-rule",
+		Action: common.ActionType_ACTION_TYPE_BLOCK,
+		Conditions: []*pbProxy.Condition{
+			{Type: common.ConditionType_CONDITION_TYPE_GEO_COUNTRY, Value: "CN"},
+		},
+	})
+	if !l.ruleSet().needsGeo {
+		t.Error("Expected a country rule to need GeoIP")
+	}
+
+	if err := l.RemoveRule("geo-rule"); err != nil {
+		t.Fatalf("RemoveRule failed: %v", err)
+	}
+	if l.ruleSet().needsGeo {
+		t.Error("Expected GeoIP no longer needed after removing the country rule")
+	}
+}
+
+func TestGeoLookupResultWithin(t *testing.T) {
+	info := &common.GeoInfo{Country: "KR"}
+	if got := resolvedGeo(info).resultWithin(0); got != info {
+		t.Errorf("Expected resolved lookup to return its info, got %v", got)
+	}
+
+	pending := &geoLookup{done: make(chan struct{})}
+	if got := pending.resultWithin(10 * time.Millisecond); got != nil {
+		t.Errorf("Expected nil from a running lookup, got %v", got)
+	}
+	go func() {
+		pending.info = info
+		close(pending.done)
+	}()
+	if got := pending.result(); got != info {
+		t.Errorf("Expected info once the lookup completes, got %v", got)
+	}
+
+	var nilService *GeoIPService
+	if got := nilService.lookupAsync("1.2.3.4").resultWithin(0); got != nil {
+		t.Errorf("Expected no data without a GeoIP service, got %v", got)
+	}
+}
+
+func TestConnEventsCarryAsyncGeo(t *testing.T) {
+	l := NewEmbeddedListener("test-events-geo", "Events Geo", "127.0.0.1:0", "", common.ActionType_ACTION_TYPE_ALLOW, common.MockPreset_MOCK_PRESET_UNSPECIFIED, "", "", "", pbProxy.ClientAuthType_CLIENT_AUTH_AUTO, nil)
+	sub := l.Subscribe()
+	defer l.Unsubscribe(sub)
+
+	geo := newGeoLookup()
+	events := l.connEvents(geo)
+	events.emit(&pbProxy.ConnectionEvent{ConnId: "c1", EventType: pbProxy.EventType_EVENT_TYPE_CONNECTED})
+	events.emit(&pbProxy.ConnectionEvent{ConnId: "c1", EventType: pbProxy.EventType_EVENT_TYPE_CLOSED})
+
+	select {
+	case ev := <-sub:
+		t.Fatalf("Expected events to wait for the lookup, got %v", ev.EventType)
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	info := &common.GeoInfo{Country: "KR"}
+	geo.resolve(info)
+	for _, want := range []pbProxy.EventType{pbProxy.EventType_EVENT_TYPE_CONNECTED, pbProxy.EventType_EVENT_TYPE_CLOSED} {
+		select {
+		case ev := <-sub:
+			if ev.EventType != want {
+				t.Errorf("Expected %v, got %v", want, ev.EventType)
+			}
+			if ev.Geo != info {
+				t.Errorf("Expected %v to carry the looked-up geo, got %v", ev.EventType, ev.Geo)
+			}
+		case <-time.After(2 * time.Second):
+			t.Fatalf("Timed out waiting for %v", want)
+		}
+	}
+
+	// A connection that never starts a lookup emits without geo
+	idle := newGeoLookup()
+	l.connEvents(idle).emit(&pbProxy.ConnectionEvent{ConnId: "c2", EventType: pbProxy.EventType_EVENT_TYPE_BLOCKED})
+	idle.finish()
+	select {
+	case ev := <-sub:
+		if ev.Geo != nil {
+			t.Errorf("Expected no geo without a lookup, got %v", ev.Geo)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("Timed out waiting for BLOCKED")
+	}
+}
+
+func TestDecisionLatencyMetric(t *testing.T) {
+	l := NewEmbeddedListener("test-decision", "Decision", "127.0.0.1:0", "", common.ActionType_ACTION_TYPE_BLOCK, common.MockPreset_MOCK_PRESET_UNSPECIFIED, "", "", "", pbProxy.ClientAuthType_CLIENT_AUTH_AUTO, nil)
+	if err := l.Start(); err != nil {
+		t.Fatalf("Start failed: %v", err)
+	}
+	defer l.Stop()
+
+	for i := 0; i < 2; i++ {
+		conn, err := net.Dial("tcp", l.ListenAddr)
+		if err != nil {
+			t.Fatalf("Dial failed: %v", err)
+		}
+		conn.SetReadDeadline(time.Now().Add(2 * time.Second))
+		conn.Read(make([]byte, 1))
+		conn.Close()
+	}
+
+	deadline := time.Now().Add(2 * time.Second)
+	for l.GetStatus().Decisions < 2 {
+		if time.Now().After(deadline) {
+			t.Fatalf("Expected 2 decisions, got %d", l.GetStatus().Decisions)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	st := l.GetStatus()
+	if st.DecisionLatencyMaxUs > st.DecisionLatencyTotalUs {
+		t.Errorf("Max latency %dus exceeds total %dus", st.DecisionLatencyMaxUs, st.DecisionLatencyTotalUs)
+	}
+}
@@ -14,7 +14,9 @@ import (
 	"github.com/ivere27/nitella/pkg/api/common"
 	pbCommon "github.com/ivere27/nitella/pkg/api/common"
 	pb "github.com/ivere27/nitella/pkg/api/proxy"
+	"github.com/ivere27/nitella/pkg/config"
 	"github.com/ivere27/nitella/pkg/log"
+	"github.com/ivere27/nitella/pkg/node/accesslog"
 	"github.com/ivere27/nitella/pkg/node/stats"
 	"github.com/ivere27/nitella/pkg/node/stream"
 	"google.golang.org/protobuf/proto"
@@ -28,6 +30,10 @@ const ApprovalRequestTimeout = 2 * time.Minute
 // If GeoIP is slow/unavailable, approval flow should still be near-real-time.
 const ApprovalGeoLookupTimeout = 250 * time.Millisecond
 
+// ApprovalReverseDNSTimeout bounds how long an approval request waits for its
+// source's reverse DNS name when it is not cached.
+const ApprovalReverseDNSTimeout = 250 * time.Millisecond
+
 // TarpitHistoryMaxAge is how long to keep tarpit history entries before cleanup.
 // Entries older than this are removed to prevent memory leak from port scanners.
 const TarpitHistoryMaxAge = 1 * time.Hour
@@ -43,6 +49,8 @@ func closeWrite(conn net.Conn) {
 		c.CloseWrite()
 	case *tls.Conn:
 		c.CloseWrite()
+	case *replayConn:
+		closeWrite(c.Conn)
 	}
 }
 
@@ -91,17 +99,28 @@ type EmbeddedListener struct {
 	bytesOut    int64
 	startTime   time.Time
 
+	// Decision latency: accept to forwarding decision
+	decisions        int64
+	decisionNanos    int64
+	decisionMaxNanos int64
+
+	// Connections matched per rule, and blocked by rule rate limits
+	ruleHits    map[string]int64
+	rateLimited int64
+
 	// Rules
 	rules        []*pb.Rule
 	ruleLimiters map[string]*RateLimiter // RuleID -> RateLimiter
 	rulesMux     sync.RWMutex
+	compiled     atomic.Pointer[ruleSet] // Compiled from rules on first use after a change
 
 	// Event Broadcasting
 	subscribers    map[chan *pb.ConnectionEvent]struct{}
 	subscribersMux sync.RWMutex
 
-	geoIP *GeoIPService
-	stats *stats.StatsService
+	geoIP     *GeoIPService
+	stats     *stats.StatsService
+	accessLog atomic.Pointer[accesslog.Logger]
 
 	// Approval system
 	approval    *ApprovalManager
@@ -115,11 +134,16 @@ type EmbeddedListener struct {
 	// Tarpit persistence
 	tarpitHistory map[string][]time.Time // IP -> List of recent connection times
 	tarpitMux     sync.Mutex
+	tarpitBudget  *TarpitBudget // Node-wide, shared by all listeners (nil = unlimited)
 
 	// Active connections tracking
 	conns    map[string]*ConnectionMetadata // ConnID -> Metadata
 	connsMux sync.RWMutex
 
+	// Connection thresholds (rules may override)
+	thresholds atomic.Pointer[pb.ConnectionThresholds]
+	sourceOut  sourceUsage // Bytes out per source over the last hour
+
 	// Cleanup manager for periodic maintenance tasks
 	cleanup *CleanupManager
 }
@@ -168,6 +192,11 @@ func (p *EmbeddedListener) SetGlobalRules(gr *GlobalRulesStore) {
 	p.globalRules = gr
 }
 
+// SetTarpitBudget sets the node-wide tarpit budget
+func (p *EmbeddedListener) SetTarpitBudget(b *TarpitBudget) {
+	p.tarpitBudget = b
+}
+
 // SetNodeID sets the node ID for approval requests
 func (p *EmbeddedListener) SetNodeID(nodeID string) {
 	p.nodeID = nodeID
@@ -232,6 +261,54 @@ func (p *EmbeddedListener) broadcast(event *pb.ConnectionEvent) {
 	}
 }
 
+// connEvents broadcasts one connection's events in order, each carrying
+// the connection's GeoIP data once its lookup completes, without holding
+// up the connection. Events are emitted from the connection's handler.
+type connEvents struct {
+	p    *EmbeddedListener
+	geo  *geoLookup
+	prev chan struct{} // Closed once the previous event was broadcast
+}
+
+func (p *EmbeddedListener) connEvents(geo *geoLookup) *connEvents {
+	return &connEvents{p: p, geo: geo}
+}
+
+// emit broadcasts event after the connection's earlier events, filling
+// in its GeoIP data.
+func (e *connEvents) emit(event *pb.ConnectionEvent) {
+	prev := e.prev
+	if (prev == nil || isClosed(prev)) && isClosed(e.geo.done) {
+		if event.Geo == nil {
+			event.Geo = e.geo.info
+		}
+		e.p.broadcast(event)
+		return
+	}
+	done := make(chan struct{})
+	e.prev = done
+	go func() {
+		defer close(done)
+		if event.Geo == nil {
+			event.Geo = e.geo.result()
+		}
+		if prev != nil {
+			<-prev
+		}
+		e.p.broadcast(event)
+	}()
+}
+
+// isClosed reports whether ch is closed.
+func isClosed(ch <-chan struct{}) bool {
+	select {
+	case <-ch:
+		return true
+	default:
+		return false
+	}
+}
+
 func (p *EmbeddedListener) Start() error {
 	// Initialize shutdown context for cancelling long-running operations
 	p.stopCtx, p.stopCancel = context.WithCancel(context.Background())
@@ -300,6 +377,7 @@ func (p *EmbeddedListener) Start() error {
 	// Initialize cleanup manager for periodic maintenance tasks
 	p.cleanup = NewCleanupManager(1 * time.Second)
 	p.cleanup.Register("tarpit-history", TarpitCleanupInterval, p.cleanupTarpitHistory)
+	p.cleanup.Register("source-usage", SourceUsageCleanupInterval, p.cleanupSourceUsage)
 	p.cleanup.Start()
 
 	log.Tracef("[TRACE] EmbeddedListener.Start: Starting accept loop goroutine...")
@@ -453,6 +531,7 @@ func (p *EmbeddedListener) AddRule(rule *pb.Rule) {
 	p.rules = append(p.rules, nil)
 	copy(p.rules[insertIdx+1:], p.rules[insertIdx:])
 	p.rules[insertIdx] = rule
+	p.compiled.Store(nil)
 }
 
 func (p *EmbeddedListener) RemoveRule(ruleID string) error {
@@ -463,12 +542,18 @@ func (p *EmbeddedListener) RemoveRule(ruleID string) error {
 		if r.Id == ruleID {
 			// Remove from slice
 			p.rules = append(p.rules[:i], p.rules[i+1:]...)
+			p.compiled.Store(nil)
 
 			// Stop and remove rate limiter if exists
 			if limiter, ok := p.ruleLimiters[ruleID]; ok && limiter != nil {
 				limiter.Stop()
 			}
 			delete(p.ruleLimiters, ruleID)
+
+			// Keep hit counters to live rules
+			p.statsMux.Lock()
+			delete(p.ruleHits, ruleID)
+			p.statsMux.Unlock()
 			return nil
 		}
 	}
@@ -531,36 +616,59 @@ func (p *EmbeddedListener) handleConn(conn net.Conn) {
 		p.connsMux.Unlock()
 	}()
 
-	// GeoIP Lookup
+	// GeoIP Lookup: before the decision only if a rule matches on geo data,
+	// otherwise in the background once the decision is made
+	p.rulesMux.RLock()
+	needsGeo := p.ruleSet().needsGeo
+	p.rulesMux.RUnlock()
 	var geoInfo *pbCommon.GeoInfo
-	if p.geoIP != nil {
+	geo := newGeoLookup()
+	defer geo.finish() // Events wait only on a lookup that was started
+	if p.geoIP != nil && needsGeo {
 		geoInfo = p.geoIP.LookupWithTimeout(sourceIP, ApprovalGeoLookupTimeout)
+		if geoInfo != nil {
+			geo.resolve(geoInfo)
+		}
 	}
+	events := p.connEvents(geo)
 
 	// Emit CONNECTED
-	p.broadcast(&pb.ConnectionEvent{
+	events.emit(&pb.ConnectionEvent{
 		ConnId:     connID,
 		SourceIp:   sourceIP,
 		SourcePort: int32(sourcePort),
 		EventType:  pb.EventType_EVENT_TYPE_CONNECTED,
 		Timestamp:  connStart.Unix(),
-		Geo:        geoInfo,
 	})
 
 	// 0. Check Global Rules first (highest priority)
 	// Note: Global ALLOW only prevents blocking, it does NOT bypass REQUIRE_APPROVAL.
 	// This ensures human oversight is maintained for high-security scenarios.
+	// Honeypot auto-blocks are not applied to connections served by a mock.
 	globalAllowed := false
 	if p.globalRules != nil {
-		if matched, globalAction := p.globalRules.Check(sourceIP); matched {
+		mocked := func() bool { return p.servesMock(conn, geoInfo) }
+		if matched, globalAction := p.globalRules.CheckListener(sourceIP, mocked); matched {
 			if globalAction == common.ActionType_ACTION_TYPE_BLOCK {
-				p.broadcast(&pb.ConnectionEvent{
+				p.recordDecision(time.Since(connStart))
+				if rec := p.accessRecord(conn, accesslog.EventBlock, "", "global rule"); rec != nil {
+					// Only geo the rules already looked up: a flood of blocked
+					// sources must not become a flood of GeoIP lookups
+					p.recordAccess(&stats.ConnectionEvent{
+						SourceIP:   sourceIP,
+						SourcePort: int32(sourcePort),
+						StartTime:  connStart,
+						EndTime:    time.Now(),
+						Action:     int32(common.ActionType_ACTION_TYPE_BLOCK),
+						ConnID:     connID,
+					}, resolvedGeo(geoInfo), rec)
+				}
+				events.emit(&pb.ConnectionEvent{
 					ConnId:      connID,
 					SourceIp:    sourceIP,
 					EventType:   pb.EventType_EVENT_TYPE_BLOCKED,
 					Timestamp:   time.Now().Unix(),
 					ActionTaken: common.ActionType_ACTION_TYPE_BLOCK,
-					Geo:         geoInfo,
 				})
 				log.Printf("Blocked by global rule: %s", sourceIP)
 				conn.Close()
@@ -584,6 +692,7 @@ func (p *EmbeddedListener) handleConn(conn net.Conn) {
 	var tlsSessionID string   // For approval cache tracking
 	var hasApprovalEntry bool // Track if this conn has an approval cache entry
 	var connectionOnlyMaxDuration time.Duration
+	var approvalMock common.MockPreset // Mock chosen by an approval timeout
 	if rule != nil {
 		action = rule.Action
 		backend = rule.TargetBackend
@@ -606,6 +715,9 @@ func (p *EmbeddedListener) handleConn(conn net.Conn) {
 		log.Tracef("[TRACE] Global allow overriding BLOCK for %s", sourceIP)
 		action = common.ActionType_ACTION_TYPE_ALLOW
 	}
+	p.recordDecision(time.Since(connStart))
+
+	geo.start(p.geoIP, sourceIP)
 
 	// 2. Handle REQUIRE_APPROVAL action
 	if action == common.ActionType_ACTION_TYPE_REQUIRE_APPROVAL {
@@ -621,9 +733,13 @@ func (p *EmbeddedListener) handleConn(conn net.Conn) {
 			// - connID: Fallback when TLSUnique is empty (common in TLS 1.3 after first request)
 			// This prevents IP-based binding weakness (sharing approval across same IP).
 			isTLS := false
+			var clientCert *x509.Certificate
 			if tc, ok := conn.(*tls.Conn); ok {
 				isTLS = true
 				state := tc.ConnectionState()
+				if len(state.PeerCertificates) > 0 {
+					clientCert = state.PeerCertificates[0]
+				}
 				if len(state.TLSUnique) > 0 {
 					tlsSessionID = fmt.Sprintf("%x", state.TLSUnique)
 				}
@@ -652,10 +768,23 @@ func (p *EmbeddedListener) handleConn(conn net.Conn) {
 			} else {
 				// Cache miss - request approval using async pattern
 				// This allows immediate cleanup if the connection closes during approval wait
-				ctx, cancel := context.WithTimeout(p.stopCtx, ApprovalRequestTimeout)
+				// The policy's timeout ends the wait (see WaitForApproval)
+				policy := rule.GetApprovalPolicy()
+				if policy.GetHold() == pb.ApprovalHold_APPROVAL_HOLD_HTTP_PENDING && p.approval.HasPendingRequest(p.ID, sourceIP, ruleId) {
+					// A client retrying the pending page waits on the
+					// request it already opened
+					servePendingPage(conn, approvalPendingRetry)
+					return
+				}
+				ctx, cancel := context.WithCancel(p.stopCtx)
 				defer cancel()
 
-				// Build approval request info
+				// Build approval request info. The geo lookup keeps running
+				// while the source's reverse DNS name is looked up.
+				approvalCtx := p.approval.SourceContext(sourceIP, ApprovalReverseDNSTimeout)
+				addSourceStats(approvalCtx, p.stats, sourceIP)
+				addClientCert(approvalCtx, clientCert)
+				geoInfo = geo.resultWithin(ApprovalGeoLookupTimeout)
 				geoCountry, geoCity, geoISP := "", "", ""
 				if geoInfo != nil {
 					geoCountry = geoInfo.Country
@@ -672,6 +801,8 @@ func (p *EmbeddedListener) handleConn(conn net.Conn) {
 					GeoCountry:  geoCountry,
 					GeoCity:     geoCity,
 					GeoIsp:      geoISP,
+					Fields:      approvalPolicyFields(policy),
+					Context:     approvalCtx,
 				}
 				info, err := proto.Marshal(alertDetails)
 				if err != nil {
@@ -686,10 +817,12 @@ func (p *EmbeddedListener) handleConn(conn net.Conn) {
 					GeoCountry: geoCountry,
 					GeoCity:    geoCity,
 					GeoISP:     geoISP,
+					Policy:     policy,
+					Context:    approvalCtx,
 				}
 
 				// Emit PENDING event
-				p.broadcast(&pb.ConnectionEvent{
+				events.emit(&pb.ConnectionEvent{
 					ConnId:    connID,
 					SourceIp:  sourceIP,
 					EventType: pb.EventType_EVENT_TYPE_PENDING_APPROVAL,
@@ -711,15 +844,33 @@ func (p *EmbeddedListener) handleConn(conn net.Conn) {
 					// Protection against ghost requests:
 					// 1. Per-IP limit (DefaultMaxPendingPerIP=10) limits single-source attacks
 					// 2. Async pattern + defer ensures cleanup when connection handler exits
-					// 3. Timeout (ApprovalRequestTimeout) bounds maximum wait time
+					// 3. The policy timeout (default ApprovalRequestTimeout) bounds maximum wait time
 					//
 					// Note: We can't reliably detect TCP RST without consuming data from
 					// the connection. Per-IP limits provide the primary DoS protection.
+					hold := p.holdForApproval(conn, policy)
 					result, err := p.approval.WaitForApproval(ctx, connID, resultCh, nil)
+					answered := hold.stop()
+					conn = hold.wrap(conn)
 					if err != nil {
-						// Timeout or error - block
+						// Shutdown or error - block
 						log.Printf("[WARN] Approval request failed: %v - blocking", err)
 						action = common.ActionType_ACTION_TYPE_BLOCK
+					} else if result.TimedOut {
+						// No decision: the policy decides this connection only
+						action = common.ActionType_ACTION_TYPE_BLOCK
+						if result.Allowed {
+							action = common.ActionType_ACTION_TYPE_ALLOW
+						} else if result.Mock {
+							approvalMock = policy.GetTimeoutMock()
+							if approvalMock == common.MockPreset_MOCK_PRESET_UNSPECIFIED {
+								approvalMock = p.DefaultMock
+							}
+							if approvalMock != common.MockPreset_MOCK_PRESET_UNSPECIFIED {
+								action = common.ActionType_ACTION_TYPE_MOCK
+							}
+						}
+						log.Printf("[Approval] No decision for %s from %s within %v - %v", connID, sourceIP, approvalTimeout(policy), action)
 					} else if result.Allowed {
 						action = common.ActionType_ACTION_TYPE_ALLOW
 						if result.RetentionMode == common.ApprovalRetentionMode_APPROVAL_RETENTION_MODE_CONNECTION_ONLY {
@@ -742,6 +893,37 @@ func (p *EmbeddedListener) handleConn(conn net.Conn) {
 							p.approval.AddToCacheWithGeo(sourceIP, ruleId, p.ID, tlsSessionID, false, result.Duration, geoCountry, geoCity, geoISP)
 						}
 					}
+
+					decision := config.ApprovalDecisionDeny
+					switch {
+					case err != nil:
+						decision = config.ApprovalDecisionWithdrawn
+					case result.TimedOut:
+						decision = config.ApprovalDecisionExpired
+					case result.Allowed:
+						decision = config.ApprovalDecisionAllow
+					}
+					if rec := p.accessRecord(conn, accesslog.EventApproval, "", ""); rec != nil {
+						rec.Approval = decision
+						p.recordAccess(&stats.ConnectionEvent{
+							SourceIP:   sourceIP,
+							SourcePort: int32(sourcePort),
+							StartTime:  connStart,
+							EndTime:    time.Now(),
+							Action:     int32(action),
+							RuleID:     ruleId,
+							ConnID:     connID,
+						}, geo, rec)
+					}
+
+					if answered {
+						// The client got a pending page; the decision applies to its retry
+						if hasApprovalEntry {
+							p.approval.RemoveConnID(sourceIP, ruleId, tlsSessionID, connID)
+						}
+						log.Printf("[Approval] Decision for %s from %s (%v) applies to later connections", connID, sourceIP, action)
+						return
+					}
 				}
 			}
 		}
@@ -749,6 +931,8 @@ func (p *EmbeddedListener) handleConn(conn net.Conn) {
 
 	// Helper for fallback logic
 	handleFallback := func(errReason string) {
+		connAction := action
+
 		// Get fallback config with proper synchronization
 		fallbackAction, fallbackMock := p.getFallback()
 
@@ -770,24 +954,22 @@ func (p *EmbeddedListener) handleConn(conn net.Conn) {
 
 			if preset != common.MockPreset_MOCK_PRESET_UNSPECIFIED {
 				log.Printf("Fallback to Mock (%s): %s", errReason, preset)
-				p.HandleMockConnection(conn, &pb.Rule{
+				transcript := p.HandleMockConnection(conn, &pb.Rule{
 					Action:       common.ActionType_ACTION_TYPE_MOCK,
 					MockResponse: &pb.MockConfig{Preset: preset},
-				})
+				}, connID, geo.resultWithin(ApprovalGeoLookupTimeout))
 
 				// Record stats for fallback mocked connection
-				if p.stats != nil {
-					fallbackRuleID := "fallback-" + errReason
-					p.stats.RecordConnection(&stats.ConnectionEvent{
-						SourceIP:   sourceIP,
-						SourcePort: int32(sourcePort),
-						StartTime:  connStart,
-						EndTime:    time.Now(),
-						Action:     int32(common.ActionType_ACTION_TYPE_MOCK),
-						RuleID:     fallbackRuleID,
-						Geo:        geoInfo,
-					})
-				}
+				p.recordStats(&stats.ConnectionEvent{
+					SourceIP:   sourceIP,
+					SourcePort: int32(sourcePort),
+					StartTime:  connStart,
+					EndTime:    time.Now(),
+					Action:     int32(common.ActionType_ACTION_TYPE_MOCK),
+					RuleID:     "fallback-" + errReason,
+					ConnID:     connID,
+					Transcript: transcript,
+				}, geo, p.accessRecord(conn, accesslog.EventMock, "", errReason))
 				conn.Close()
 				return
 			}
@@ -795,41 +977,50 @@ func (p *EmbeddedListener) handleConn(conn net.Conn) {
 
 		// Close (default)
 		log.Printf("Closing connection (%s)", errReason)
+		if errReason != "block" {
+			// Blocks are already logged; this is a connection that could not be forwarded
+			p.recordAccess(&stats.ConnectionEvent{
+				SourceIP:   sourceIP,
+				SourcePort: int32(sourcePort),
+				StartTime:  connStart,
+				EndTime:    time.Now(),
+				Action:     int32(connAction),
+				RuleID:     ruleId,
+				ConnID:     connID,
+			}, geo, p.accessRecord(conn, accesslog.EventClose, "", errReason))
+		}
 		conn.Close()
 	}
 
 	if action == common.ActionType_ACTION_TYPE_BLOCK {
 		// Emit BLOCKED
-		p.broadcast(&pb.ConnectionEvent{
+		events.emit(&pb.ConnectionEvent{
 			ConnId:      connID,
 			SourceIp:    sourceIP,
 			EventType:   pb.EventType_EVENT_TYPE_BLOCKED,
 			Timestamp:   time.Now().Unix(),
 			ActionTaken: common.ActionType_ACTION_TYPE_BLOCK,
-			Geo:         geoInfo,
 		})
 
 		// Record stats for blocked connection
-		if p.stats != nil {
-			blockRuleID := ""
-			if rule != nil {
-				blockRuleID = rule.Id
-			}
-			p.stats.RecordConnection(&stats.ConnectionEvent{
-				SourceIP:   sourceIP,
-				SourcePort: int32(sourcePort),
-				StartTime:  connStart,
-				EndTime:    time.Now(),
-				Action:     int32(common.ActionType_ACTION_TYPE_BLOCK),
-				RuleID:     blockRuleID,
-				Geo:        geoInfo,
-			})
+		blockRuleID := ""
+		if rule != nil {
+			blockRuleID = rule.Id
 		}
+		p.recordStats(&stats.ConnectionEvent{
+			SourceIP:   sourceIP,
+			SourcePort: int32(sourcePort),
+			StartTime:  connStart,
+			EndTime:    time.Now(),
+			Action:     int32(common.ActionType_ACTION_TYPE_BLOCK),
+			RuleID:     blockRuleID,
+			ConnID:     connID,
+		}, geo, p.accessRecord(conn, accesslog.EventBlock, "", ""))
 
-		// Safely get geo country for log message
+		// Safely get geo country for log message (if already known)
 		geoCountry := "unknown"
-		if geoInfo != nil {
-			geoCountry = geoInfo.GetCountry()
+		if g := geo.resultWithin(0); g != nil {
+			geoCountry = g.GetCountry()
 		}
 		log.Printf("Blocked connection from %s (%s)", conn.RemoteAddr(), geoCountry)
 
@@ -842,7 +1033,13 @@ func (p *EmbeddedListener) handleConn(conn net.Conn) {
 		mockStart := time.Now()
 		// If no rule matched, use DefaultMock preset
 		mockRule := rule
-		if mockRule == nil {
+		if approvalMock != common.MockPreset_MOCK_PRESET_UNSPECIFIED {
+			mockRule = &pb.Rule{
+				Id:           ruleId,
+				Action:       common.ActionType_ACTION_TYPE_MOCK,
+				MockResponse: &pb.MockConfig{Preset: approvalMock},
+			}
+		} else if mockRule == nil {
 			mockRule = &pb.Rule{
 				Action: common.ActionType_ACTION_TYPE_MOCK,
 				MockResponse: &pb.MockConfig{
@@ -850,24 +1047,23 @@ func (p *EmbeddedListener) handleConn(conn net.Conn) {
 				},
 			}
 		}
-		p.HandleMockConnection(conn, mockRule)
+		transcript := p.HandleMockConnection(conn, mockRule, connID, geo.resultWithin(ApprovalGeoLookupTimeout))
 
 		// Record stats for mocked connection
-		if p.stats != nil {
-			mockRuleID := ""
-			if rule != nil {
-				mockRuleID = rule.Id
-			}
-			p.stats.RecordConnection(&stats.ConnectionEvent{
-				SourceIP:   sourceIP,
-				SourcePort: int32(sourcePort),
-				StartTime:  mockStart,
-				EndTime:    time.Now(),
-				Action:     int32(common.ActionType_ACTION_TYPE_MOCK),
-				RuleID:     mockRuleID,
-				Geo:        geoInfo,
-			})
+		mockRuleID := ""
+		if rule != nil {
+			mockRuleID = rule.Id
 		}
+		p.recordStats(&stats.ConnectionEvent{
+			SourceIP:   sourceIP,
+			SourcePort: int32(sourcePort),
+			StartTime:  mockStart,
+			EndTime:    time.Now(),
+			Action:     int32(common.ActionType_ACTION_TYPE_MOCK),
+			RuleID:     mockRuleID,
+			ConnID:     connID,
+			Transcript: transcript,
+		}, geo, p.accessRecord(conn, accesslog.EventMock, "", ""))
 
 		conn.Close()
 		return
@@ -892,6 +1088,21 @@ func (p *EmbeddedListener) handleConn(conn net.Conn) {
 		return
 	}
 
+	// ALLOW_ALERT: forward without waiting, the alert follows once the
+	// GeoIP lookup completes (the manager deduplicates and rate-limits)
+	if action == common.ActionType_ACTION_TYPE_ALLOW_ALERT {
+		events.emit(&pb.ConnectionEvent{
+			ConnId:      connID,
+			SourceIp:    sourceIP,
+			SourcePort:  int32(sourcePort),
+			TargetAddr:  targetBackend,
+			EventType:   pb.EventType_EVENT_TYPE_ALERT,
+			Timestamp:   connStart.Unix(),
+			RuleMatched: ruleId,
+			ActionTaken: action,
+		})
+	}
+
 	// 3. Connect to Backend
 	defer func() {
 		connDuration := time.Since(connStart)
@@ -903,7 +1114,7 @@ func (p *EmbeddedListener) handleConn(conn net.Conn) {
 		}
 
 		// Emit CLOSED
-		p.broadcast(&pb.ConnectionEvent{
+		events.emit(&pb.ConnectionEvent{
 			ConnId:    connID,
 			SourceIp:  sourceIP,
 			EventType: pb.EventType_EVENT_TYPE_CLOSED,
@@ -937,6 +1148,24 @@ func (p *EmbeddedListener) handleConn(conn net.Conn) {
 		}()
 	}
 
+	// Thresholds: alert, close or throttle once the connection crosses one
+	var throttle *stream.Throttle
+	var monitor *thresholdMonitor
+	if limits := p.connThresholds(rule); limits != nil {
+		throttle = &stream.Throttle{}
+		monitor = p.monitorThresholds(limits, &pb.ConnectionEvent{
+			ConnId:      connID,
+			SourceIp:    sourceIP,
+			SourcePort:  int32(sourcePort),
+			TargetAddr:  targetBackend,
+			RuleMatched: ruleId,
+			ActionTaken: action,
+		}, geo, connStart, &connBytesIn, &connBytesOut, throttle, func() {
+			conn.Close()
+			backendConn.Close()
+		})
+	}
+
 	// Bidirectional copy - track bytes for stats
 	var wg sync.WaitGroup
 	wg.Add(2)
@@ -945,7 +1174,7 @@ func (p *EmbeddedListener) handleConn(conn net.Conn) {
 		defer wg.Done()
 		// conn -> backend (BytesIn)
 		// Use SpliceProxy for zero-copy on Linux (if TCP) or pooled buffer copy otherwise
-		n, _ := stream.SpliceProxy(backendConn, conn, &connBytesIn)
+		n, _ := stream.SpliceProxyThrottled(backendConn, conn, &connBytesIn, throttle)
 		p.addBytesIn(n)
 		closeWrite(backendConn)
 	}()
@@ -954,12 +1183,13 @@ func (p *EmbeddedListener) handleConn(conn net.Conn) {
 		defer wg.Done()
 		// backend -> conn (BytesOut)
 		// Use SpliceProxy for zero-copy on Linux (if TCP) or pooled buffer copy otherwise
-		n, _ := stream.SpliceProxy(conn, backendConn, &connBytesOut)
+		n, _ := stream.SpliceProxyThrottled(conn, backendConn, &connBytesOut, throttle)
 		p.addBytesOut(n)
 		closeWrite(conn)
 	}()
 
 	wg.Wait()
+	crossed := monitor.stop()
 
 	// Update approval cache with final byte counts and remove connection tracking
 	if hasApprovalEntry && p.approval != nil {
@@ -970,23 +1200,45 @@ func (p *EmbeddedListener) handleConn(conn net.Conn) {
 	}
 
 	// Record stats after connection completes (non-blocking)
-	if p.stats != nil {
-		allowRuleID := ""
-		if rule != nil {
-			allowRuleID = rule.Id
-		}
-		p.stats.RecordConnection(&stats.ConnectionEvent{
-			SourceIP:   sourceIP,
-			SourcePort: int32(sourcePort),
-			StartTime:  connStart,
-			EndTime:    time.Now(),
-			BytesIn:    atomic.LoadInt64(&connBytesIn),
-			BytesOut:   atomic.LoadInt64(&connBytesOut),
-			Action:     int32(action),
-			RuleID:     allowRuleID,
-			Geo:        geoInfo,
-		})
+	allowRuleID := ""
+	if rule != nil {
+		allowRuleID = rule.Id
+	}
+	p.recordStats(&stats.ConnectionEvent{
+		SourceIP:   sourceIP,
+		SourcePort: int32(sourcePort),
+		StartTime:  connStart,
+		EndTime:    time.Now(),
+		BytesIn:    atomic.LoadInt64(&connBytesIn),
+		BytesOut:   atomic.LoadInt64(&connBytesOut),
+		Action:     int32(action),
+		RuleID:     allowRuleID,
+		ConnID:     connID,
+		Thresholds: crossed,
+	}, geo, p.accessRecord(conn, accesslog.EventClose, targetBackend, ""))
+}
+
+// ruleSet returns the compiled rules, compiling them if they changed.
+// Callers hold rulesMux, so the rules cannot change while compiling.
+func (p *EmbeddedListener) ruleSet() *ruleSet {
+	if rs := p.compiled.Load(); rs != nil {
+		return rs
+	}
+	rs := compileRuleSet(p.rules)
+	p.compiled.CompareAndSwap(nil, rs)
+	return rs
+}
+
+// servesMock reports whether the connection is handed to a mock, by its
+// matching rule or else the default action.
+func (p *EmbeddedListener) servesMock(conn net.Conn, geo *pbCommon.GeoInfo) bool {
+	p.rulesMux.RLock()
+	rule := p.ruleSet().match(conn, geo)
+	p.rulesMux.RUnlock()
+	if rule != nil {
+		return rule.Action == common.ActionType_ACTION_TYPE_MOCK
 	}
+	return p.DefaultAction == common.ActionType_ACTION_TYPE_MOCK
 }
 
 func (p *EmbeddedListener) evaluateRules(conn net.Conn, geo *pbCommon.GeoInfo) (*pb.Rule, *RateLimiter) {
@@ -997,32 +1249,93 @@ func (p *EmbeddedListener) evaluateRules(conn net.Conn, geo *pbCommon.GeoInfo) (
 	sourceAddr := conn.RemoteAddr().String()
 	sourceIP, _, _ := net.SplitHostPort(sourceAddr)
 
-	for _, rule := range p.rules {
-		if MatchRule(rule, conn, geo) {
-			// Check Rate Limit if present
-			if limiter, ok := p.ruleLimiters[rule.Id]; ok {
-				if !limiter.Check(sourceIP) {
-					// Blocked by rate limiter -> Return a temporary block rule
-					blockRule := &pb.Rule{
-						Id:     rule.Id,
-						Action: common.ActionType_ACTION_TYPE_BLOCK,
-					}
-					return blockRule, nil
-				}
-				// Track this connection attempt
-				limiter.TrackConnection(sourceIP)
-				return rule, limiter
+	rule := p.ruleSet().match(conn, geo)
+	if rule == nil {
+		// Default: No rule matched
+		return nil, nil
+	}
+	p.recordRuleHit(rule.Id)
+
+	// Check Rate Limit if present
+	if limiter, ok := p.ruleLimiters[rule.Id]; ok {
+		if !limiter.Check(sourceIP) {
+			p.statsMux.Lock()
+			p.rateLimited++
+			p.statsMux.Unlock()
+
+			// Blocked by rate limiter -> Return a temporary block rule
+			blockRule := &pb.Rule{
+				Id:     rule.Id,
+				Action: common.ActionType_ACTION_TYPE_BLOCK,
 			}
-
-			return rule, nil
+			return blockRule, nil
 		}
+		// Track this connection attempt
+		limiter.TrackConnection(sourceIP)
+		return rule, limiter
 	}
 
-	// Default: No rule matched
-	return nil, nil
+	return rule, nil
 }
 
 // Stats helpers
+
+// recordStats records a connection, and writes rec to the access log,
+// once its GeoIP lookup completes, without holding up the caller.
+func (p *EmbeddedListener) recordStats(ev *stats.ConnectionEvent, geo *geoLookup, rec *accesslog.Record) {
+	p.recordAfterGeo(ev, geo, p.stats, rec)
+}
+
+// recordAccess writes rec to the access log only, for outcomes the stats
+// database does not keep.
+func (p *EmbeddedListener) recordAccess(ev *stats.ConnectionEvent, geo *geoLookup, rec *accesslog.Record) {
+	p.recordAfterGeo(ev, geo, nil, rec)
+}
+
+func (p *EmbeddedListener) recordAfterGeo(ev *stats.ConnectionEvent, geo *geoLookup, st *stats.StatsService, rec *accesslog.Record) {
+	if st == nil && rec == nil {
+		return
+	}
+	record := func() {
+		if st != nil {
+			st.RecordConnection(ev)
+		}
+		p.logAccess(rec, ev)
+	}
+	select {
+	case <-geo.done:
+		ev.Geo = geo.info
+		record()
+	default:
+		go func() {
+			ev.Geo = geo.result()
+			record()
+		}()
+	}
+}
+
+// recordDecision records the time a connection waited for its forwarding
+// decision.
+func (p *EmbeddedListener) recordDecision(d time.Duration) {
+	p.statsMux.Lock()
+	p.decisions++
+	p.decisionNanos += int64(d)
+	if int64(d) > p.decisionMaxNanos {
+		p.decisionMaxNanos = int64(d)
+	}
+	p.statsMux.Unlock()
+}
+
+// recordRuleHit counts a connection matching ruleID.
+func (p *EmbeddedListener) recordRuleHit(ruleID string) {
+	p.statsMux.Lock()
+	if p.ruleHits == nil {
+		p.ruleHits = make(map[string]int64)
+	}
+	p.ruleHits[ruleID]++
+	p.statsMux.Unlock()
+}
+
 func (p *EmbeddedListener) incrementActiveConns() {
 	p.statsMux.Lock()
 	p.activeConns++
@@ -1054,6 +1367,12 @@ func (p *EmbeddedListener) GetStatus() *pb.ProxyStatus {
 	bytesOut := p.bytesOut
 	activeConns := p.activeConns
 	totalConns := p.totalConns
+	decisions, decisionNanos, decisionMaxNanos := p.decisions, p.decisionNanos, p.decisionMaxNanos
+	ruleHits := make(map[string]int64, len(p.ruleHits))
+	for id, n := range p.ruleHits {
+		ruleHits[id] = n
+	}
+	rateLimited := p.rateLimited
 	p.statsMux.RUnlock()
 
 	// Also add bytes from currently active connections (real-time)
@@ -1083,6 +1402,13 @@ func (p *EmbeddedListener) GetStatus() *pb.ProxyStatus {
 		FallbackAction:    common.FallbackAction(p.FallbackAction),
 		FallbackMock:      p.FallbackMock,
 		ClientAuthType:    p.ClientAuthType,
+
+		Decisions:              decisions,
+		DecisionLatencyTotalUs: decisionNanos / int64(time.Microsecond),
+		DecisionLatencyMaxUs:   decisionMaxNanos / int64(time.Microsecond),
+
+		RuleHits:    ruleHits,
+		RateLimited: rateLimited,
 	}
 }
 
@@ -11,6 +11,7 @@ import (
 	pb "github.com/ivere27/nitella/pkg/api/process"
 	proxy_pb "github.com/ivere27/nitella/pkg/api/proxy"
 	"github.com/ivere27/nitella/pkg/log"
+	"github.com/ivere27/nitella/pkg/node/accesslog"
 	"github.com/ivere27/nitella/pkg/node/stats"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -33,6 +34,7 @@ type ListenerCore struct {
 	KeyPEM         string
 	CaPEM          string
 	ClientAuthType proxy_pb.ClientAuthType
+	Thresholds     *proxy_pb.ConnectionThresholds
 
 	// Internal listener (actual proxy)
 	listener *EmbeddedListener
@@ -42,8 +44,10 @@ type ListenerCore struct {
 	// Services
 	geoIP       *GeoIPService
 	stats       *stats.StatsService
+	accessLog   *accesslog.Logger
 	approval    *ApprovalManager
 	globalRules *GlobalRulesStore
+	tarpit      *TarpitBudget
 	nodeID      string
 }
 
@@ -64,6 +68,16 @@ func (c *ListenerCore) SetStatsService(s *stats.StatsService) {
 	}
 }
 
+// SetAccessLog sets the structured access log.
+func (c *ListenerCore) SetAccessLog(l *accesslog.Logger) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.accessLog = l
+	if c.listener != nil {
+		c.listener.SetAccessLog(l)
+	}
+}
+
 // SetApprovalManager sets the approval manager.
 func (c *ListenerCore) SetApprovalManager(am *ApprovalManager) {
 	c.mu.Lock()
@@ -84,6 +98,16 @@ func (c *ListenerCore) SetGlobalRules(gr *GlobalRulesStore) {
 	}
 }
 
+// SetTarpitBudget sets the node-wide tarpit budget.
+func (c *ListenerCore) SetTarpitBudget(b *TarpitBudget) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.tarpit = b
+	if c.listener != nil {
+		c.listener.SetTarpitBudget(b)
+	}
+}
+
 // SetNodeID sets the node ID for approval requests.
 func (c *ListenerCore) SetNodeID(nodeID string) {
 	c.mu.Lock()
@@ -94,6 +118,16 @@ func (c *ListenerCore) SetNodeID(nodeID string) {
 	}
 }
 
+// SetThresholds sets the connection thresholds.
+func (c *ListenerCore) SetThresholds(t *proxy_pb.ConnectionThresholds) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.Thresholds = t
+	if c.listener != nil {
+		c.listener.SetThresholds(t)
+	}
+}
+
 // StartListener initializes and starts the listener.
 func (c *ListenerCore) StartListener(ctx context.Context, req *pb.StartListenerRequest) (*pb.StartListenerResponse, error) {
 	c.mu.Lock()
@@ -124,6 +158,7 @@ func (c *ListenerCore) StartListener(ctx context.Context, req *pb.StartListenerR
 	c.ClientAuthType = req.ClientAuthType
 	c.FallbackAction = req.FallbackAction
 	c.FallbackMock = req.FallbackMock
+	c.Thresholds = req.Thresholds
 
 	// Create embedded listener
 	c.listener = NewEmbeddedListener(
@@ -144,9 +179,15 @@ func (c *ListenerCore) StartListener(ctx context.Context, req *pb.StartListenerR
 	if c.stats != nil {
 		c.listener.SetStatsService(c.stats)
 	}
+	if c.accessLog != nil {
+		c.listener.SetAccessLog(c.accessLog)
+	}
 	if c.globalRules != nil {
 		c.listener.SetGlobalRules(c.globalRules)
 	}
+	if c.tarpit != nil {
+		c.listener.SetTarpitBudget(c.tarpit)
+	}
 	if c.approval != nil {
 		c.listener.SetApprovalManager(c.approval)
 	}
@@ -154,6 +195,7 @@ func (c *ListenerCore) StartListener(ctx context.Context, req *pb.StartListenerR
 		c.listener.SetNodeID(c.nodeID)
 	}
 	c.listener.SetFallback(c.FallbackAction, c.FallbackMock)
+	c.listener.SetThresholds(c.Thresholds)
 
 	// Start
 	if err := c.listener.Start(); err != nil {
@@ -371,6 +413,12 @@ func (c *ListenerCore) StreamEventsInternal(ctx context.Context, req *pb.StreamE
 	}
 }
 
+// StreamAccessLogInternal is required by the generated FfiServer interface.
+// In-process listeners write the access log themselves.
+func (c *ListenerCore) StreamAccessLogInternal(ctx context.Context, req *pb.StreamAccessLogRequest) (*pb.AccessLogRecord, error) {
+	return nil, fmt.Errorf("access log records are not streamed in process")
+}
+
 // GetListenAddr returns the actual listen address (useful when :0 was used).
 func (c *ListenerCore) GetListenAddr() string {
 	c.mu.Lock()
@@ -19,6 +19,8 @@ import (
 	"github.com/ivere27/nitella/pkg/config"
 	"github.com/ivere27/nitella/pkg/geoip"
 	"github.com/ivere27/nitella/pkg/log"
+	"github.com/ivere27/nitella/pkg/node/accesslog"
+	"github.com/ivere27/nitella/pkg/node/alertsink"
 	"github.com/ivere27/nitella/pkg/node/health"
 	"github.com/ivere27/nitella/pkg/node/stats"
 )
@@ -72,12 +74,40 @@ type ProxyManager struct {
 	Stats       *stats.StatsService
 	HealthCheck *health.HealthChecker
 
+	// Structured access log (nil = none); not written in process mode
+	AccessLog *accesslog.Logger
+
 	// Global Runtime Rules (block/allow across all proxies)
 	GlobalRules *GlobalRulesStore
 
+	// Tarpit budget shared by all in-process listeners
+	Tarpit *TarpitBudget
+
+	// The tarpit budget split among process mode children
+	tarpitShares *tarpitShares
+
 	// Approval System
 	Approval *ApprovalManager
 
+	// Alerts for non-approval events (e.g. honeypot captures), guarded by mu
+	alerts AlertSender
+
+	// Node-local alert sinks (nil = none), reported in the status summary
+	AlertSinks *alertsink.Dispatcher
+
+	// Deduplication and rate limit of ALLOW_ALERT alerts
+	connAlerts *connectionAlerter
+
+	// Honeypot reputation (nil = disabled), see SetHoneypotBlock
+	reputation *honeypotReputation
+
+	// Periodic node-wide maintenance (rule expiry)
+	cleanup    *CleanupManager
+	ruleExpiry RuleExpiryMode // "" leaves expired rules to the parent (process mode children)
+
+	// Process mode child restarts and crashes
+	children *childCounters
+
 	// Node Identity
 	NodeID string
 }
@@ -93,7 +123,17 @@ func NewProxyManager(mode ListenerMode) *ProxyManager {
 		globalSubs:  make(map[chan *pb.ConnectionEvent]struct{}),
 		GeoIP:       geoIP,
 		GlobalRules: NewGlobalRulesStore(),
-	}
+		Tarpit:      NewTarpitBudget(TarpitBudgetConfig{}),
+		connAlerts: newConnectionAlerter(ConnectionAlertConfig{
+			PerMinute: config.DefaultConnectionAlertRate,
+		}),
+		cleanup:      NewCleanupManager(time.Second),
+		ruleExpiry:   RuleExpiryRemove,
+		children:     newChildCounters(),
+		tarpitShares: newTarpitShares(),
+	}
+	pm.cleanup.Register("rule-expiry", RuleExpiryInterval, pm.expireRules)
+	pm.cleanup.Start()
 
 	// Initialize HealthCheck immediately so we can add services dynamically
 	pm.HealthCheck = health.NewHealthChecker(nil)
@@ -115,6 +155,9 @@ func NewProxyManagerWithBool(useEmbedded bool) *ProxyManager {
 // SetApprovalManager sets the approval manager and wires it to all proxies
 func (m *ProxyManager) SetApprovalManager(am *ApprovalManager) {
 	m.Approval = am
+	if m.db != nil {
+		m.restoreApprovals(am)
+	}
 	// Wire to existing proxies
 	m.mu.RLock()
 	for _, p := range m.proxies {
@@ -134,6 +177,27 @@ func (m *ProxyManager) SetApprovalManager(am *ApprovalManager) {
 	m.mu.RUnlock()
 }
 
+// SetAlertSender sets the sender used for honeypot capture and connection alerts.
+// Alerts are raised from the event bus, so this covers every listener mode.
+func (m *ProxyManager) SetAlertSender(s AlertSender) {
+	m.mu.Lock()
+	m.alerts = s
+	m.mu.Unlock()
+}
+
+// Alerts returns the sender of non-approval alerts (nil = none).
+func (m *ProxyManager) Alerts() AlertSender {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.alerts
+}
+
+// SetAlertSinks sets the node-local alert sinks. The caller copies alerts
+// to them with d.Tee when setting the alert senders.
+func (m *ProxyManager) SetAlertSinks(d *alertsink.Dispatcher) {
+	m.AlertSinks = d
+}
+
 // SetNodeID sets the node identifier for approval requests
 func (m *ProxyManager) SetNodeID(nodeID string) {
 	m.NodeID = nodeID
@@ -175,6 +239,23 @@ func (m *ProxyManager) SetStatsService(s *stats.StatsService) {
 	m.mu.RUnlock()
 }
 
+// SetAccessLog sets the structured access log for all proxies. Process
+// mode children forward their records to it from their next start.
+func (m *ProxyManager) SetAccessLog(l *accesslog.Logger) {
+	m.AccessLog = l
+	// Update existing proxies
+	m.mu.RLock()
+	for _, p := range m.proxies {
+		switch pl := p.Listener.(type) {
+		case *EmbeddedListener:
+			pl.SetAccessLog(l)
+		case *FfiListener:
+			pl.SetAccessLog(l)
+		}
+	}
+	m.mu.RUnlock()
+}
+
 // GetStatsService returns the stats service
 func (m *ProxyManager) GetStatsService() *stats.StatsService {
 	return m.Stats
@@ -239,6 +320,17 @@ func (m *ProxyManager) startEventForwarder(mp *ManagedProxy) {
 					return
 				}
 				m.broadcastGlobal(event)
+				switch event.EventType {
+				case pb.EventType_EVENT_TYPE_MOCK_CAPTURE:
+					m.sendCaptureAlert(mp.Model, event)
+					m.honeypotBlock(mp.Model, event)
+				case pb.EventType_EVENT_TYPE_MOCK_INTERACTION:
+					m.honeypotBlock(mp.Model, event)
+				case pb.EventType_EVENT_TYPE_ALERT:
+					m.sendConnectionAlert(mp.Model, event)
+				case pb.EventType_EVENT_TYPE_THRESHOLD_ALERT, pb.EventType_EVENT_TYPE_THRESHOLD_CLOSED, pb.EventType_EVENT_TYPE_THRESHOLD_THROTTLED:
+					m.sendThresholdAlert(mp.Model, event)
+				}
 			}
 		}
 	}()
@@ -309,6 +401,9 @@ func (m *ProxyManager) CreateProxyWithID(id string, req *pb.CreateProxyRequest)
 	// Sanitize inputs
 	req.ListenAddr = sanitizeAddress(req.ListenAddr)
 	req.DefaultBackend = sanitizeAddress(req.DefaultBackend)
+	if err := validateThresholds(req.Thresholds); err != nil {
+		return &pb.CreateProxyResponse{Success: false, ErrorMessage: err.Error()}, nil
+	}
 
 	// Use Enum directly
 	action := req.DefaultAction
@@ -325,22 +420,33 @@ func (m *ProxyManager) CreateProxyWithID(id string, req *pb.CreateProxyRequest)
 		if m.Stats != nil {
 			fl.SetStatsService(m.Stats)
 		}
+		if m.AccessLog != nil {
+			fl.SetAccessLog(m.AccessLog)
+		}
 		fl.SetFallback(req.FallbackAction, req.FallbackMock)
 		if m.GlobalRules != nil {
 			fl.SetGlobalRules(m.GlobalRules)
 		}
+		if m.Tarpit != nil {
+			fl.SetTarpitBudget(m.Tarpit)
+		}
 		if m.Approval != nil {
 			fl.SetApprovalManager(m.Approval)
 		}
 		if m.NodeID != "" {
 			fl.SetNodeID(m.NodeID)
 		}
+		fl.SetThresholds(req.Thresholds)
 		proxy = fl
 
 	case ListenerModeProcess:
 		// Process mode: spawn a child process for each proxy
 		pl := NewProcessListener(id, req.Name, req.ListenAddr, req.DefaultBackend, action, req.DefaultMock, req.CertPem, req.KeyPem, req.CaPem, req.ClientAuthType)
 		pl.SetFallback(req.FallbackAction, req.FallbackMock)
+		pl.SetThresholds(req.Thresholds)
+		pl.children = m.children
+		pl.tarpit = m.tarpitShares
+		pl.accessLog = m.AccessLog
 		proxy = pl
 	}
 
@@ -372,6 +478,7 @@ func (m *ProxyManager) CreateProxyWithID(id string, req *pb.CreateProxyRequest)
 		KeyPEM:          req.KeyPem,
 		CaPEM:           req.CaPem,
 		HealthCheckJSON: hcJSON,
+		ThresholdsJSON:  thresholdsJSON(req.Thresholds),
 		CreatedAt:       time.Now(),
 		UpdatedAt:       time.Now(),
 	}
@@ -556,21 +663,32 @@ func (m *ProxyManager) EnableProxy(id string) (*pb.EnableProxyResponse, error) {
 		if m.Stats != nil {
 			fl.SetStatsService(m.Stats)
 		}
+		if m.AccessLog != nil {
+			fl.SetAccessLog(m.AccessLog)
+		}
 		fl.SetFallback(common.FallbackAction(model.FallbackAction), StringToMockPreset(model.FallbackMock))
 		if m.GlobalRules != nil {
 			fl.SetGlobalRules(m.GlobalRules)
 		}
+		if m.Tarpit != nil {
+			fl.SetTarpitBudget(m.Tarpit)
+		}
 		if m.Approval != nil {
 			fl.SetApprovalManager(m.Approval)
 		}
 		if m.NodeID != "" {
 			fl.SetNodeID(m.NodeID)
 		}
+		fl.SetThresholds(parseThresholdsJSON(model.ThresholdsJSON))
 		proxy = fl
 
 	case ListenerModeProcess:
 		pl := NewProcessListener(id, model.Name, model.ListenAddr, model.DefaultBackend, action, mockPreset, model.CertPEM, model.KeyPEM, model.CaPEM, pb.ClientAuthType(model.ClientAuthType))
 		pl.SetFallback(common.FallbackAction(model.FallbackAction), StringToMockPreset(model.FallbackMock))
+		pl.SetThresholds(parseThresholdsJSON(model.ThresholdsJSON))
+		pl.children = m.children
+		pl.tarpit = m.tarpitShares
+		pl.accessLog = m.AccessLog
 		proxy = pl
 	}
 
@@ -623,6 +741,18 @@ func (m *ProxyManager) UpdateProxy(req *pb.UpdateProxyRequest) (*pb.UpdateProxyR
 	if req.DefaultMock != common.MockPreset_MOCK_PRESET_UNSPECIFIED {
 		mp.Model.DefaultMock = MockPresetToString(req.DefaultMock)
 	}
+	if req.Thresholds != nil {
+		if err := validateThresholds(req.Thresholds); err != nil {
+			return &pb.UpdateProxyResponse{Success: false, ErrorMessage: err.Error()}, nil
+		}
+		mp.Model.ThresholdsJSON = thresholdsJSON(req.Thresholds)
+		// Applied to new connections at once (process mode: on restart)
+		if l, ok := mp.Listener.(interface {
+			SetThresholds(*pb.ConnectionThresholds)
+		}); ok {
+			l.SetThresholds(req.Thresholds)
+		}
+	}
 
 	// Note: To apply listen address change, proxy needs to be restarted
 	needsRestart := req.ListenAddr != "" && mp.Listener != nil
@@ -676,21 +806,32 @@ func (m *ProxyManager) RestartListeners() (*pb.RestartListenersResponse, error)
 			if m.Stats != nil {
 				fl.SetStatsService(m.Stats)
 			}
+			if m.AccessLog != nil {
+				fl.SetAccessLog(m.AccessLog)
+			}
 			fl.SetFallback(common.FallbackAction(model.FallbackAction), StringToMockPreset(model.FallbackMock))
 			if m.GlobalRules != nil {
 				fl.SetGlobalRules(m.GlobalRules)
 			}
+			if m.Tarpit != nil {
+				fl.SetTarpitBudget(m.Tarpit)
+			}
 			if m.Approval != nil {
 				fl.SetApprovalManager(m.Approval)
 			}
 			if m.NodeID != "" {
 				fl.SetNodeID(m.NodeID)
 			}
+			fl.SetThresholds(parseThresholdsJSON(model.ThresholdsJSON))
 			proxy = fl
 
 		case ListenerModeProcess:
 			pl := NewProcessListener(pid, model.Name, model.ListenAddr, model.DefaultBackend, action, mockPreset, model.CertPEM, model.KeyPEM, model.CaPEM, pb.ClientAuthType(model.ClientAuthType))
 			pl.SetFallback(common.FallbackAction(model.FallbackAction), StringToMockPreset(model.FallbackMock))
+			pl.SetThresholds(parseThresholdsJSON(model.ThresholdsJSON))
+			pl.children = m.children
+			pl.tarpit = m.tarpitShares
+			pl.accessLog = m.AccessLog
 			proxy = pl
 		}
 
@@ -733,6 +874,21 @@ func (m *ProxyManager) ReloadRules(proxyID string, rules []*pb.Rule) (*pb.Reload
 		}, nil
 	}
 
+	for _, rule := range rules {
+		if err := validateApprovalPolicy(rule.ApprovalPolicy, m.Approval); err != nil {
+			return &pb.ReloadRulesResponse{
+				Success:      false,
+				ErrorMessage: fmt.Sprintf("rule %s: %v", rule.Id, err),
+			}, nil
+		}
+		if err := validateMockScript(rule.MockResponse); err != nil {
+			return &pb.ReloadRulesResponse{
+				Success:      false,
+				ErrorMessage: fmt.Sprintf("rule %s: %v", rule.Id, err),
+			}, nil
+		}
+	}
+
 	// Get current rules and remove them
 	currentRules := mp.Listener.GetRules()
 	for _, r := range currentRules {
@@ -766,7 +922,12 @@ func (m *ProxyManager) ReloadRules(proxyID string, rules []*pb.Rule) (*pb.Reload
 				TargetBackend:  rule.TargetBackend,
 				ConditionsJSON: string(condBytes),
 				MockConfigJSON: string(mockBytes),
+				ThresholdsJSON: thresholdsJSON(rule.Thresholds),
+				ApprovalJSON:   approvalPolicyJSON(rule.ApprovalPolicy),
 				Expression:     rule.Expression,
+				NotBefore:      ruleTime(rule.NotBefore),
+				ExpiresAt:      ruleTime(rule.ExpiresAt),
+				Schedule:       rule.Schedule,
 			}
 			if _, err := m.db.Insert(ruleModel); err != nil {
 				log.Printf("Failed to save rule to DB: %v", err)
@@ -805,6 +966,11 @@ func (m *ProxyManager) StopAllListeners() {
 
 // Close shuts down the proxy manager and releases all resources.
 func (m *ProxyManager) Close() {
+	if m.cleanup != nil {
+		m.cleanup.Stop()
+		m.cleanup = nil
+	}
+
 	m.StopAllListeners()
 
 	if m.HealthCheck != nil {
@@ -815,6 +981,10 @@ func (m *ProxyManager) Close() {
 		m.GeoIP.Close()
 	}
 
+	if m.Approval != nil {
+		m.Approval.FlushStore()
+	}
+
 	if m.db != nil {
 		m.db.Close()
 	}
@@ -932,6 +1102,19 @@ func (m *ProxyManager) AddRule(req *pb.AddRuleRequest) (*pb.Rule, error) {
 		return nil, fmt.Errorf("proxy not found")
 	}
 
+	if err := validateRuleLifetime(req.Rule); err != nil {
+		return nil, err
+	}
+	if err := validateThresholds(req.Rule.Thresholds); err != nil {
+		return nil, err
+	}
+	if err := validateApprovalPolicy(req.Rule.ApprovalPolicy, m.Approval); err != nil {
+		return nil, err
+	}
+	if err := validateMockScript(req.Rule.MockResponse); err != nil {
+		return nil, err
+	}
+
 	if req.Rule.Id == "" {
 		req.Rule.Id = uuid.New().String()
 	}
@@ -954,7 +1137,12 @@ func (m *ProxyManager) AddRule(req *pb.AddRuleRequest) (*pb.Rule, error) {
 			TargetBackend:  req.Rule.TargetBackend,
 			ConditionsJSON: string(condBytes),
 			MockConfigJSON: string(mockBytes),
+			ThresholdsJSON: thresholdsJSON(req.Rule.Thresholds),
+			ApprovalJSON:   approvalPolicyJSON(req.Rule.ApprovalPolicy),
 			Expression:     req.Rule.Expression,
+			NotBefore:      ruleTime(req.Rule.NotBefore),
+			ExpiresAt:      ruleTime(req.Rule.ExpiresAt),
+			Schedule:       req.Rule.Schedule,
 			CreatedAt:      time.Now(),
 			UpdatedAt:      time.Now(),
 		}
@@ -1013,13 +1201,18 @@ func (m *ProxyManager) GetRules(proxyID string) ([]*pb.Rule, error) {
 		var pbRules []*pb.Rule
 		for _, r := range rules {
 			pbRules = append(pbRules, &pb.Rule{
-				Id:            r.ID,
-				Name:          r.Name,
-				Priority:      int32(r.Priority),
-				Enabled:       r.Enabled,
-				Action:        common.ActionType(r.Action),
-				TargetBackend: r.TargetBackend,
-				Expression:    r.Expression,
+				Id:             r.ID,
+				Name:           r.Name,
+				Priority:       int32(r.Priority),
+				Enabled:        r.Enabled,
+				Action:         common.ActionType(r.Action),
+				TargetBackend:  r.TargetBackend,
+				Expression:     r.Expression,
+				NotBefore:      ruleTimestamp(r.NotBefore),
+				ExpiresAt:      ruleTimestamp(r.ExpiresAt),
+				Schedule:       r.Schedule,
+				Thresholds:     parseThresholdsJSON(r.ThresholdsJSON),
+				ApprovalPolicy: parseApprovalPolicyJSON(r.ApprovalJSON),
 			})
 		}
 		return pbRules, nil
@@ -1262,9 +1455,12 @@ func (m *ProxyManager) InitDB(dbPath string) error {
 		return fmt.Errorf("failed to create xorm engine: %w", err)
 	}
 
-	if err := m.db.Sync2(new(ProxyModel), new(RuleModel)); err != nil {
+	if err := m.db.Sync2(new(ProxyModel), new(RuleModel), new(ClonedPresetModel), new(ApprovalModel)); err != nil {
 		return fmt.Errorf("failed to sync schema: %w", err)
 	}
+	if err := m.loadClonedPresets(); err != nil {
+		log.Printf("[WARN] Failed to load cloned presets: %v", err)
+	}
 
 	return m.LoadState()
 }
@@ -1292,17 +1488,24 @@ func (m *ProxyManager) LoadState() error {
 		if m.Stats != nil {
 			proxy.SetStatsService(m.Stats)
 		}
+		if m.AccessLog != nil {
+			proxy.SetAccessLog(m.AccessLog)
+		}
 		proxy.SetFallback(common.FallbackAction(p.FallbackAction), StringToMockPreset(p.FallbackMock))
 		// Wire global rules and approval
 		if m.GlobalRules != nil {
 			proxy.SetGlobalRules(m.GlobalRules)
 		}
+		if m.Tarpit != nil {
+			proxy.SetTarpitBudget(m.Tarpit)
+		}
 		if m.Approval != nil {
 			proxy.SetApprovalManager(m.Approval)
 		}
 		if m.NodeID != "" {
 			proxy.SetNodeID(m.NodeID)
 		}
+		proxy.SetThresholds(parseThresholdsJSON(p.ThresholdsJSON))
 
 		if err := proxy.Start(); err != nil {
 			log.Printf("Failed to restore proxy %s: %v\n", p.Name, err)
@@ -1330,15 +1533,20 @@ func (m *ProxyManager) LoadState() error {
 				}
 
 				rule := &pb.Rule{
-					Id:            r.ID,
-					Name:          r.Name,
-					Priority:      int32(r.Priority),
-					Enabled:       r.Enabled,
-					Action:        common.ActionType(r.Action),
-					TargetBackend: r.TargetBackend,
-					Conditions:    conds,
-					MockResponse:  &mockCfg,
-					Expression:    r.Expression,
+					Id:             r.ID,
+					Name:           r.Name,
+					Priority:       int32(r.Priority),
+					Enabled:        r.Enabled,
+					Action:         common.ActionType(r.Action),
+					TargetBackend:  r.TargetBackend,
+					Conditions:     conds,
+					MockResponse:   &mockCfg,
+					Expression:     r.Expression,
+					NotBefore:      ruleTimestamp(r.NotBefore),
+					ExpiresAt:      ruleTimestamp(r.ExpiresAt),
+					Schedule:       r.Schedule,
+					Thresholds:     parseThresholdsJSON(r.ThresholdsJSON),
+					ApprovalPolicy: parseApprovalPolicyJSON(r.ApprovalJSON),
 				}
 				proxy.AddRule(rule)
 			}
@@ -0,0 +1,122 @@
+package node
+
+import (
+	"io"
+	"sort"
+
+	pb "github.com/ivere27/nitella/pkg/api/proxy"
+	"github.com/ivere27/nitella/pkg/node/metrics"
+)
+
+// WriteMetrics writes the node's metrics to w in the Prometheus text
+// exposition format.
+func (m *ProxyManager) WriteMetrics(w io.Writer) error {
+	mw := metrics.NewWriter(w)
+
+	statuses := m.GetAllStatuses()
+	sort.Slice(statuses, func(i, j int) bool { return statuses[i].ProxyId < statuses[j].ProxyId })
+	writeListenerMetrics(mw, statuses)
+
+	if m.Approval != nil {
+		mw.Family("nitella_approval_pending", metrics.TypeGauge, "Connections waiting for an approval decision.")
+		mw.Sample("nitella_approval_pending", float64(m.Approval.PendingCount()))
+		mw.Family("nitella_approval_decision_seconds", metrics.TypeHistogram, "Time from an approval request to its decision.")
+		mw.Histogram("nitella_approval_decision_seconds", m.Approval.DecisionLatency())
+	}
+
+	if m.GeoIP != nil {
+		hits, misses := m.GeoIP.CacheStats()
+		ratio := 0.0
+		if hits+misses > 0 {
+			ratio = float64(hits) / float64(hits+misses)
+		}
+		mw.Family("nitella_geoip_cache_hits_total", metrics.TypeCounter, "GeoIP lookups answered from the L1 or L2 cache.")
+		mw.Sample("nitella_geoip_cache_hits_total", float64(hits))
+		mw.Family("nitella_geoip_cache_misses_total", metrics.TypeCounter, "GeoIP lookups not answered from cache, including failed lookups.")
+		mw.Sample("nitella_geoip_cache_misses_total", float64(misses))
+		mw.Family("nitella_geoip_cache_hit_ratio", metrics.TypeGauge, "Share of GeoIP lookups answered from cache since start.")
+		mw.Sample("nitella_geoip_cache_hit_ratio", ratio)
+
+		latency := m.GeoIP.ProviderLatency()
+		providers := make([]string, 0, len(latency))
+		for p := range latency {
+			providers = append(providers, p)
+		}
+		sort.Strings(providers)
+		mw.Family("nitella_geoip_lookup_seconds", metrics.TypeHistogram, "Latency of GeoIP lookups missing the cache, by the provider answering them.")
+		for _, p := range providers {
+			mw.Histogram("nitella_geoip_lookup_seconds", latency[p], "provider", p)
+		}
+	}
+
+	if m.Stats != nil {
+		mw.Family("nitella_stats_queue_depth", metrics.TypeGauge, "Connection events waiting to be written to the statistics database.")
+		mw.Sample("nitella_stats_queue_depth", float64(m.Stats.QueueDepth()))
+		mw.Family("nitella_stats_dropped_events_total", metrics.TypeCounter, "Connection events dropped over a full statistics queue.")
+		mw.Sample("nitella_stats_dropped_events_total", float64(m.Stats.DroppedEvents()))
+	}
+
+	if m.mode == ListenerModeProcess {
+		children := m.children.stats()
+		mw.Family("nitella_process_child_starts_total", metrics.TypeCounter, "Times a proxy's child process was started, including by enable and restart requests.")
+		for _, c := range children {
+			mw.Sample("nitella_process_child_starts_total", float64(c.Starts), "proxy", c.ProxyID)
+		}
+		mw.Family("nitella_process_child_crashes_total", metrics.TypeCounter, "Child processes that exited without being stopped.")
+		for _, c := range children {
+			mw.Sample("nitella_process_child_crashes_total", float64(c.Crashes), "proxy", c.ProxyID)
+		}
+	}
+
+	if sinks := m.AlertSinks.Stats(); len(sinks) > 0 {
+		mw.Family("nitella_alert_sink_sent_total", metrics.TypeCounter, "Alerts delivered to a local alert sink.")
+		for _, s := range sinks {
+			mw.Sample("nitella_alert_sink_sent_total", float64(s.SentTotal), "sink", s.Name)
+		}
+		mw.Family("nitella_alert_sink_dead_letters_total", metrics.TypeCounter, "Alerts a local alert sink gave up on.")
+		for _, s := range sinks {
+			mw.Sample("nitella_alert_sink_dead_letters_total", float64(s.DeadLetterTotal), "sink", s.Name)
+		}
+		mw.Family("nitella_alert_sink_queued", metrics.TypeGauge, "Alerts waiting for delivery to a local alert sink.")
+		for _, s := range sinks {
+			mw.Sample("nitella_alert_sink_queued", float64(s.Queued), "sink", s.Name)
+		}
+	}
+
+	return mw.Err()
+}
+
+// writeListenerMetrics writes the per-proxy connection, byte and rule
+// counters of statuses.
+func writeListenerMetrics(mw *metrics.Writer, statuses []*pb.ProxyStatus) {
+	perProxy := func(name, typ, help string, value func(*pb.ProxyStatus) int64) {
+		mw.Family(name, typ, help)
+		for _, st := range statuses {
+			mw.Sample(name, float64(value(st)), "proxy", st.ProxyId)
+		}
+	}
+
+	perProxy("nitella_proxy_up", metrics.TypeGauge, "Whether the proxy's listener is running.", func(st *pb.ProxyStatus) int64 {
+		if st.Running {
+			return 1
+		}
+		return 0
+	})
+	perProxy("nitella_proxy_active_connections", metrics.TypeGauge, "Open connections.", (*pb.ProxyStatus).GetActiveConnections)
+	perProxy("nitella_proxy_connections_total", metrics.TypeCounter, "Accepted connections.", (*pb.ProxyStatus).GetTotalConnections)
+	perProxy("nitella_proxy_received_bytes_total", metrics.TypeCounter, "Bytes received from clients.", (*pb.ProxyStatus).GetBytesIn)
+	perProxy("nitella_proxy_sent_bytes_total", metrics.TypeCounter, "Bytes sent to clients.", (*pb.ProxyStatus).GetBytesOut)
+	perProxy("nitella_proxy_rate_limited_total", metrics.TypeCounter, "Connections blocked by a rule's rate limit.", (*pb.ProxyStatus).GetRateLimited)
+
+	mw.Family("nitella_rule_hits_total", metrics.TypeCounter, "Connections matching a rule.")
+	for _, st := range statuses {
+		rules := make([]string, 0, len(st.RuleHits))
+		for id := range st.RuleHits {
+			rules = append(rules, id)
+		}
+		sort.Strings(rules)
+		for _, id := range rules {
+			mw.Sample("nitella_rule_hits_total", float64(st.RuleHits[id]), "proxy", st.ProxyId, "rule", id)
+		}
+	}
+}
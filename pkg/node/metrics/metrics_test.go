@@ -0,0 +1,71 @@
+package metrics
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestWriter(t *testing.T) {
+	var b strings.Builder
+	w := NewWriter(&b)
+	w.Family("nitella_rule_hits_total", TypeCounter, "Connections matching a rule.\nPer proxy.")
+	w.Sample("nitella_rule_hits_total", 3, "proxy", "web", "rule", `say "hi"\`)
+	w.Family("nitella_approval_pending", TypeGauge, "Pending approvals.")
+	w.Sample("nitella_approval_pending", 0.5)
+
+	want := `# HELP nitella_rule_hits_total Connections matching a rule.\nPer proxy.
+# TYPE nitella_rule_hits_total counter
+nitella_rule_hits_total{proxy="web",rule="say \"hi\"\\"} 3
+# HELP nitella_approval_pending Pending approvals.
+# TYPE nitella_approval_pending gauge
+nitella_approval_pending 0.5
+`
+	if b.String() != want {
+		t.Errorf("Unexpected output:\n%s\nwant:\n%s", b.String(), want)
+	}
+	if w.Err() != nil {
+		t.Errorf("Unexpected error: %v", w.Err())
+	}
+}
+
+func TestHistogram(t *testing.T) {
+	h := NewHistogram([]float64{0.1, 1})
+	for _, v := range []float64{0.05, 0.1, 0.5, 3} {
+		h.Observe(v)
+	}
+	var nilHist *Histogram
+	nilHist.Observe(1)
+
+	var b strings.Builder
+	w := NewWriter(&b)
+	w.Histogram("nitella_geoip_lookup_seconds", h.Snapshot(), "provider", "local")
+	want := `nitella_geoip_lookup_seconds_bucket{provider="local",le="0.1"} 2
+nitella_geoip_lookup_seconds_bucket{provider="local",le="1"} 3
+nitella_geoip_lookup_seconds_bucket{provider="local",le="+Inf"} 4
+nitella_geoip_lookup_seconds_sum{provider="local"} 3.65
+nitella_geoip_lookup_seconds_count{provider="local"} 4
+`
+	if b.String() != want {
+		t.Errorf("Unexpected output:\n%s\nwant:\n%s", b.String(), want)
+	}
+
+	b.Reset()
+	w.Histogram("nitella_approval_decision_seconds", nilHist.Snapshot())
+	if !strings.HasPrefix(b.String(), `nitella_approval_decision_seconds_bucket{le="+Inf"} 0`) {
+		t.Errorf("Unexpected empty histogram: %s", b.String())
+	}
+}
+
+func TestWriterKeepsFirstError(t *testing.T) {
+	w := NewWriter(failingWriter{})
+	w.Family("nitella_proxy_up", TypeGauge, "Up.")
+	w.Sample("nitella_proxy_up", 1)
+	if w.Err() == nil || w.Err().Error() != "disk full" {
+		t.Errorf("Expected the write error, got %v", w.Err())
+	}
+}
+
+type failingWriter struct{}
+
+func (failingWriter) Write([]byte) (int, error) { return 0, errors.New("disk full") }
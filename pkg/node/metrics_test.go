@@ -0,0 +1,119 @@
+package node
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/ivere27/nitella/pkg/api/common"
+	pbGeo "github.com/ivere27/nitella/pkg/api/geoip"
+	pbProxy "github.com/ivere27/nitella/pkg/api/proxy"
+)
+
+// fakeGeoClient answers lookups from a fixed table.
+type fakeGeoClient struct {
+	answers map[string]*common.GeoInfo
+}
+
+func (c *fakeGeoClient) Lookup(_ context.Context, ip string) (*common.GeoInfo, error) {
+	if info, ok := c.answers[ip]; ok {
+		return info, nil
+	}
+	return nil, errors.New("lookup failed")
+}
+
+func (c *fakeGeoClient) GetStatus(context.Context) (*pbGeo.ServiceStatus, error) {
+	return &pbGeo.ServiceStatus{}, nil
+}
+
+func (c *fakeGeoClient) Close() error { return nil }
+
+func TestListenerRuleCounters(t *testing.T) {
+	l := NewEmbeddedListener("test-counters", "Counters", "127.0.0.1:0", "", common.ActionType_ACTION_TYPE_ALLOW, common.MockPreset_MOCK_PRESET_UNSPECIFIED, "", "", "", pbProxy.ClientAuthType_CLIENT_AUTH_AUTO, nil)
+	l.AddRule(&pbProxy.Rule{
+		Id: "limited", Priority: 10, Enabled: true, Action: common.ActionType_ACTION_TYPE_ALLOW,
+		Conditions: []*pbProxy.Condition{cond(common.ConditionType_CONDITION_TYPE_SOURCE_IP, common.Operator_OPERATOR_CIDR, "10.0.0.0/8")},
+		RateLimit:  &pbProxy.RateLimitConfig{MaxConnections: 1, IntervalSeconds: 60},
+	})
+	l.AddRule(&pbProxy.Rule{Id: "catch-all", Enabled: true, Action: common.ActionType_ACTION_TYPE_BLOCK})
+	defer l.RemoveRule("limited")
+
+	for _, ip := range []string{"10.0.0.1", "10.0.0.1", "192.0.2.1"} {
+		l.evaluateRules(connFrom(ip), nil)
+	}
+	st := l.GetStatus()
+	if st.RuleHits["limited"] != 2 || st.RuleHits["catch-all"] != 1 {
+		t.Errorf("Unexpected rule hits: %v", st.RuleHits)
+	}
+	if st.RateLimited != 1 {
+		t.Errorf("Expected 1 rate-limited connection, got %d", st.RateLimited)
+	}
+
+	l.RemoveRule("catch-all")
+	if _, ok := l.GetStatus().RuleHits["catch-all"]; ok {
+		t.Error("Expected the removed rule's hits dropped")
+	}
+}
+
+func TestWriteMetrics(t *testing.T) {
+	pm := NewProxyManager(ListenerModeProcess)
+	defer pm.Close()
+
+	l := NewEmbeddedListener("web", "Web", "127.0.0.1:0", "", common.ActionType_ACTION_TYPE_ALLOW, common.MockPreset_MOCK_PRESET_UNSPECIFIED, "", "", "", pbProxy.ClientAuthType_CLIENT_AUTH_AUTO, nil)
+	l.AddRule(&pbProxy.Rule{Id: "office", Enabled: true, Action: common.ActionType_ACTION_TYPE_ALLOW})
+	l.evaluateRules(connFrom("192.0.2.1"), nil)
+	pm.proxies["web"] = &ManagedProxy{Listener: l, Model: &ProxyModel{ID: "web"}}
+
+	pm.GeoIP.SetClient(&fakeGeoClient{answers: map[string]*common.GeoInfo{
+		"192.0.2.1":    {Source: "cache-l1"},
+		"192.0.2.2":    {Source: "cache-l2"},
+		"198.51.100.1": {Source: "ip-api", LatencyMs: 40},
+	}})
+	for _, ip := range []string{"192.0.2.1", "192.0.2.2", "198.51.100.1", "203.0.113.1"} {
+		pm.GeoIP.Lookup(ip)
+	}
+
+	am := NewApprovalManager(&MockAlertSender{})
+	defer am.cache.Stop()
+	pm.Approval = am
+	for _, id := range []string{"req-1", "req-2"} {
+		if _, err := am.BeginApprovalRequest(id, "node-1", "", ApprovalRequestMeta{SourceIP: "192.0.2.1"}); err != nil {
+			t.Fatalf("BeginApprovalRequest failed: %v", err)
+		}
+		defer am.CancelApprovalRequest(id)
+	}
+	am.Resolve("req-1", true, 60, "")
+
+	pm.children.started("web")
+	pm.children.started("web")
+	pm.children.crashed("web")
+
+	var b strings.Builder
+	if err := pm.WriteMetrics(&b); err != nil {
+		t.Fatalf("WriteMetrics failed: %v", err)
+	}
+	out := b.String()
+	for _, want := range []string{
+		"# TYPE nitella_proxy_connections_total counter\n",
+		`nitella_proxy_up{proxy="web"} 0` + "\n", // Never started
+		`nitella_rule_hits_total{proxy="web",rule="office"} 1` + "\n",
+		`nitella_proxy_rate_limited_total{proxy="web"} 0` + "\n",
+		"nitella_approval_pending 1\n",
+		"nitella_approval_decision_seconds_count 1\n",
+		"nitella_geoip_cache_hits_total 2\n",
+		"nitella_geoip_cache_misses_total 2\n",
+		"nitella_geoip_cache_hit_ratio 0.5\n",
+		`nitella_geoip_lookup_seconds_bucket{provider="ip-api",le="0.025"} 0` + "\n",
+		`nitella_geoip_lookup_seconds_bucket{provider="ip-api",le="0.1"} 1` + "\n",
+		`nitella_process_child_starts_total{proxy="web"} 2` + "\n",
+		`nitella_process_child_crashes_total{proxy="web"} 1` + "\n",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("Expected %q in:\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, "nitella_stats_") || strings.Contains(out, "nitella_alert_sink_") {
+		t.Errorf("Expected no metrics of unconfigured services:\n%s", out)
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"crypto/rand"
 	"encoding/binary"
 	"net"
+	"strconv"
+	"sync"
 	"time"
 
 	"github.com/ivere27/nitella/pkg/api/common"
@@ -23,8 +25,26 @@ func secureRandomInt(max int) int {
 	return int(binary.LittleEndian.Uint64(buf[:]) % uint64(max))
 }
 
-// HandleMockConnection handles the connection for ACTION_MOCK
-func (p *EmbeddedListener) HandleMockConnection(conn net.Conn, rule *pb.Rule) {
+// HandleMockConnection handles the connection for ACTION_MOCK.
+// The connection is announced as an EVENT_TYPE_MOCK_INTERACTION event, and
+// credentials and fingerprints captured by the mock are broadcast as
+// EVENT_TYPE_MOCK_CAPTURE events for connID. Returns the fake shell
+// transcript when the mock accepted a login, or "".
+func (p *EmbeddedListener) HandleMockConnection(conn net.Conn, rule *pb.Rule, connID string, geo *common.GeoInfo) string {
+	sourceIP, sourcePortStr, _ := net.SplitHostPort(conn.RemoteAddr().String())
+	sourcePort, _ := strconv.Atoi(sourcePortStr)
+	p.broadcast(&pb.ConnectionEvent{
+		ConnId:      connID,
+		SourceIp:    sourceIP,
+		SourcePort:  int32(sourcePort),
+		TargetAddr:  p.ListenAddr,
+		EventType:   pb.EventType_EVENT_TYPE_MOCK_INTERACTION,
+		Timestamp:   time.Now().Unix(),
+		RuleMatched: rule.GetId(),
+		ActionTaken: common.ActionType_ACTION_TYPE_MOCK,
+		Geo:         geo,
+	})
+
 	mockResp := rule.MockResponse
 	if mockResp == nil {
 		mockResp = &pb.MockConfig{
@@ -69,6 +89,36 @@ func (p *EmbeddedListener) HandleMockConnection(conn net.Conn, rule *pb.Rule) {
 			presetKey = "telnet-secure"
 		case common.MockPreset_MOCK_PRESET_RAW_TARPIT:
 			presetKey = "raw-tarpit"
+		case common.MockPreset_MOCK_PRESET_POSTGRES_SECURE:
+			presetKey = "postgres-secure"
+		case common.MockPreset_MOCK_PRESET_POSTGRES_TARPIT:
+			presetKey = "postgres-tarpit"
+		case common.MockPreset_MOCK_PRESET_MONGODB_SECURE:
+			presetKey = "mongodb-secure"
+		case common.MockPreset_MOCK_PRESET_MONGODB_TARPIT:
+			presetKey = "mongodb-tarpit"
+		case common.MockPreset_MOCK_PRESET_ELASTICSEARCH_SECURE:
+			presetKey = "elasticsearch-secure"
+		case common.MockPreset_MOCK_PRESET_ELASTICSEARCH_TARPIT:
+			presetKey = "elasticsearch-tarpit"
+		case common.MockPreset_MOCK_PRESET_MEMCACHED_SECURE:
+			presetKey = "memcached-secure"
+		case common.MockPreset_MOCK_PRESET_MEMCACHED_TARPIT:
+			presetKey = "memcached-tarpit"
+		case common.MockPreset_MOCK_PRESET_FTP_SECURE:
+			presetKey = "ftp-secure"
+		case common.MockPreset_MOCK_PRESET_FTP_TARPIT:
+			presetKey = "ftp-tarpit"
+		case common.MockPreset_MOCK_PRESET_VNC_SECURE:
+			presetKey = "vnc-secure"
+		case common.MockPreset_MOCK_PRESET_VNC_TARPIT:
+			presetKey = "vnc-tarpit"
+		case common.MockPreset_MOCK_PRESET_SMB_SECURE:
+			presetKey = "smb-secure"
+		case common.MockPreset_MOCK_PRESET_LDAP_SECURE:
+			presetKey = "ldap-secure"
+		case common.MockPreset_MOCK_PRESET_LDAP_TARPIT:
+			presetKey = "ldap-tarpit"
 		}
 
 		if p, ok := config.Presets[presetKey]; ok {
@@ -93,7 +143,10 @@ func (p *EmbeddedListener) HandleMockConnection(conn net.Conn, rule *pb.Rule) {
 				statusCode = 403
 			case common.MockPreset_MOCK_PRESET_HTTP_404:
 				statusCode = 404
-			case common.MockPreset_MOCK_PRESET_SSH_TARPIT, common.MockPreset_MOCK_PRESET_MYSQL_TARPIT, common.MockPreset_MOCK_PRESET_RAW_TARPIT:
+			case common.MockPreset_MOCK_PRESET_SSH_TARPIT, common.MockPreset_MOCK_PRESET_MYSQL_TARPIT, common.MockPreset_MOCK_PRESET_RAW_TARPIT,
+				common.MockPreset_MOCK_PRESET_POSTGRES_TARPIT, common.MockPreset_MOCK_PRESET_MONGODB_TARPIT,
+				common.MockPreset_MOCK_PRESET_ELASTICSEARCH_TARPIT, common.MockPreset_MOCK_PRESET_MEMCACHED_TARPIT,
+				common.MockPreset_MOCK_PRESET_FTP_TARPIT, common.MockPreset_MOCK_PRESET_VNC_TARPIT, common.MockPreset_MOCK_PRESET_LDAP_TARPIT:
 				randomDelay = true
 				if preset.Behavior.DelayMs == 0 {
 					delayMs = 0
@@ -102,6 +155,14 @@ func (p *EmbeddedListener) HandleMockConnection(conn net.Conn, rule *pb.Rule) {
 		}
 	}
 
+	cloned := lookupClonedPreset(mockResp.GetClonedPreset())
+	if mockResp.GetClonedPreset() != "" && cloned == nil {
+		log.Printf("[Mock] Unknown cloned preset %q", mockResp.GetClonedPreset())
+	}
+	if cloned != nil && protocol == "" {
+		protocol = cloned.Protocol
+	}
+
 	// Use shared mock implementation
 	mockConfig := mockproto.MockConfig{
 		Protocol:       protocol,
@@ -112,17 +173,56 @@ func (p *EmbeddedListener) HandleMockConnection(conn net.Conn, rule *pb.Rule) {
 		DripBanner:     preset != nil && preset.Behavior.DripBanner,
 		DripIntervalMs: 0,
 		NeverComplete:  preset != nil && preset.Behavior.NeverComplete,
+		AcceptLogin:    mockResp.AcceptLogin,
+	}
+	if mockConfig.AcceptLogin {
+		mockConfig.Transcript = &mockproto.Transcript{}
+	}
+	if mockResp.Tls {
+		mockConfig.TLS = &mockproto.TLSConfig{
+			CommonName:   mockResp.TlsCommonName,
+			Organization: mockResp.TlsOrganization,
+			SANs:         mockResp.TlsSans,
+		}
+	}
+	if cloned != nil {
+		if cloned.Protocol == protocol {
+			mockConfig.ApplyClonedBanner(cloned.Protocol, cloned.Banner)
+		} else {
+			log.Printf("[Mock] Cloned preset %q is for %s, not %s; ignored", cloned.Name, cloned.Protocol, protocol)
+		}
+	}
+	switch protocol {
+	case "http":
+		mockConfig.HTTPRoutes = httpRoutesFor(mockResp)
+		mockConfig.CanaryPaths = mockResp.CanaryPaths
+	case "smb":
+		mockConfig.SMBDialect = mockResp.SmbDialect
+		mockConfig.SMBOSVersion = mockResp.SmbOsVersion
+		mockConfig.SMBHostname = mockResp.SmbHostname
+	case "script":
+		mockConfig.Script = scriptFor(mockResp)
 	}
 
+	var canaryOnce sync.Once
+	mockConfig.OnCapture = func(c mockproto.Capture) {
+		p.reportMockCapture(connID, sourceIP, sourcePort, rule.GetId(), geo, c)
+		if c.Fields["canary"] == "true" {
+			canaryOnce.Do(func() { p.blockCanaryHit(sourceIP, c.Fields["path"], mockResp.CanaryBlockSeconds) })
+		}
+	}
+
+	attempts := 0 // recent visits from sourceIP, when tracked
 	if preset != nil {
 		mockConfig.DripIntervalMs = preset.Behavior.DripIntervalMs
+		mockConfig.Tarpit = preset.Behavior.Tarpit
+		mockConfig.CompleteKex = preset.Behavior.CompleteKex
+		mockConfig.AuthAttempts = preset.Behavior.AuthAttempts
+		mockConfig.AuthDelayMs = preset.Behavior.AuthDelayMs
 
 		// Apply Reconnect Penalty if configured
 		if preset.Behavior.ReconnectPenalty {
-			sourceAddr := conn.RemoteAddr().String()
-			sourceIP, _, _ := net.SplitHostPort(sourceAddr)
-
-			attempts := p.trackConnection(sourceIP, preset.Behavior.PenaltyDuration)
+			attempts = p.trackConnection(sourceIP, preset.Behavior.PenaltyDuration)
 			if attempts > 1 {
 				// Linear backoff: delay * attempts
 				// e.g. 2nd retry -> 2x delay
@@ -139,7 +239,72 @@ func (p *EmbeddedListener) HandleMockConnection(conn net.Conn, rule *pb.Rule) {
 		}
 	}
 
+	if mockConfig.Tarpit || mockConfig.RandomDelay || mockConfig.DripBanner || mockConfig.NeverComplete {
+		cost := int64(tarpitConnOverhead + len(mockConfig.Payload))
+		if !p.tarpitBudget.acquire(cost) {
+			// Over budget: close fast and free the socket instead of holding it
+			if tc, ok := conn.(*net.TCPConn); ok {
+				tc.SetLinger(0)
+			}
+			return ""
+		}
+		defer p.tarpitBudget.release(cost)
+
+		if p.tarpitBudget.adaptive() {
+			if attempts == 0 {
+				attempts = p.trackConnection(sourceIP, adaptiveTarpitWindow)
+			}
+			base := mockConfig.DripIntervalMs
+			if base <= 0 {
+				base = defaultTarpitDripIntervalMs
+			}
+			if interval := p.tarpitBudget.dripInterval(base, attempts); interval != base {
+				mockConfig.DripIntervalMs = interval
+				log.Printf("[Tarpit] Adaptive drip for %s: %d recent visits -> %dms per byte", sourceIP, attempts, interval)
+			}
+		}
+	}
+
 	mockproto.HandleConnection(conn, mockConfig)
+	return mockConfig.Transcript.String()
+}
+
+// reportMockCapture logs a mock capture and broadcasts it to subscribers.
+// Captured data never reaches a backend.
+func (p *EmbeddedListener) reportMockCapture(connID, sourceIP string, sourcePort int, ruleID string, geo *common.GeoInfo, c mockproto.Capture) {
+	switch c.Kind {
+	case mockproto.CaptureClientInfo:
+		log.Printf("[Mock] %s client from %s: %v", c.Protocol, sourceIP, c.Fields)
+	case mockproto.CaptureDownload:
+		log.Printf("[Mock] %s download attempt from %s: %s", c.Protocol, sourceIP, c.Fields["url"])
+	case mockproto.CaptureHTTPRequest:
+		log.Printf("[Mock] http request from %s: %s %s", sourceIP, c.Fields["method"], c.Fields["path"])
+	case mockproto.CaptureScript:
+		log.Printf("[Mock] script capture from %s: %v", sourceIP, c.Fields)
+	case mockproto.CaptureLogin:
+		log.Printf("[Mock] %s login attempt from %s: user=%q %v", c.Protocol, sourceIP, c.Username, c.Fields)
+	default:
+		log.Printf("[Mock] %s %s attempt from %s: user=%q", c.Protocol, c.Kind, sourceIP, c.Username)
+	}
+
+	p.broadcast(&pb.ConnectionEvent{
+		ConnId:      connID,
+		SourceIp:    sourceIP,
+		SourcePort:  int32(sourcePort),
+		TargetAddr:  p.ListenAddr,
+		EventType:   pb.EventType_EVENT_TYPE_MOCK_CAPTURE,
+		Timestamp:   time.Now().Unix(),
+		RuleMatched: ruleID,
+		ActionTaken: common.ActionType_ACTION_TYPE_MOCK,
+		Geo:         geo,
+		Capture: &pb.MockCapture{
+			Protocol: c.Protocol,
+			Kind:     c.Kind,
+			Username: c.Username,
+			Password: c.Password,
+			Fields:   c.Fields,
+		},
+	})
 }
 
 // trackConnection records a connection attempt and returns the count of recent attempts
@@ -22,6 +22,7 @@ type ProxyModel struct {
 	CaPEM           string    `xorm:"'ca_pem' text"`
 	ClientAuthType  int       `xorm:"default 0"` // 0=Auto, 1=None, 2=Request, 3=Require
 	HealthCheckJSON string    `xorm:"'health_check_json' text"`      // JSON of HealthCheckConfig
+	ThresholdsJSON  string    `xorm:"'thresholds_json' text"`        // JSON of ConnectionThresholds
 	CreatedAt       time.Time `xorm:"created"`
 	UpdatedAt       time.Time `xorm:"updated"`
 }
@@ -40,12 +41,42 @@ type RuleModel struct {
 	ConditionsJSON string `xorm:"'conditions_json' text"` // JSON array of conditions
 	MockConfigJSON string `xorm:"'mock_config_json' text"` // JSON of MockConfig
 	RateLimitJSON  string `xorm:"'rate_limit_json' text"` // JSON of RateLimitConfig
+	ThresholdsJSON string `xorm:"'thresholds_json' text"` // JSON of ConnectionThresholds
+	ApprovalJSON   string `xorm:"'approval_json' text"`   // JSON of ApprovalPolicy
 	Expression     string // Traefik-style expression string
 
+	// Lifetime (zero = unbounded) and recurring schedule
+	NotBefore time.Time
+	ExpiresAt time.Time `xorm:"index"`
+	Schedule  string
+
 	CreatedAt time.Time `xorm:"created"`
 	UpdatedAt time.Time `xorm:"updated"`
 }
 
+// ClonedPresetModel is a banner recorded from a real backend (see CloneBanner)
+type ClonedPresetModel struct {
+	Name        string `xorm:"'name' pk"`
+	Protocol    string
+	BackendAddr string
+	Banner      string    `xorm:"text"`
+	ClonedAt    time.Time
+}
+
+// ApprovalModel is a cached approval decision, kept across restarts
+type ApprovalModel struct {
+	SourceIP     string    `xorm:"'source_ip' pk"`
+	RuleID       string    `xorm:"'rule_id' pk"`
+	TLSSessionID string    `xorm:"'tls_session_id' pk"`
+	ProxyID      string    `xorm:"'proxy_id' index"`
+	Decision     bool
+	ExpiresAt    time.Time `xorm:"index"`
+	CreatedAt    time.Time
+	GeoCountry   string
+	GeoCity      string
+	GeoISP       string `xorm:"'geo_isp'"`
+}
+
 // MockPresetToString converts protobuf MockPreset enum to legacy string key (for DB/Config)
 func MockPresetToString(p common.MockPreset) string {
 	switch p {
@@ -71,6 +102,36 @@ func MockPresetToString(p common.MockPreset) string {
 		return "telnet-secure"
 	case common.MockPreset_MOCK_PRESET_RAW_TARPIT:
 		return "raw-tarpit"
+	case common.MockPreset_MOCK_PRESET_POSTGRES_SECURE:
+		return "postgres-secure"
+	case common.MockPreset_MOCK_PRESET_POSTGRES_TARPIT:
+		return "postgres-tarpit"
+	case common.MockPreset_MOCK_PRESET_MONGODB_SECURE:
+		return "mongodb-secure"
+	case common.MockPreset_MOCK_PRESET_MONGODB_TARPIT:
+		return "mongodb-tarpit"
+	case common.MockPreset_MOCK_PRESET_ELASTICSEARCH_SECURE:
+		return "elasticsearch-secure"
+	case common.MockPreset_MOCK_PRESET_ELASTICSEARCH_TARPIT:
+		return "elasticsearch-tarpit"
+	case common.MockPreset_MOCK_PRESET_MEMCACHED_SECURE:
+		return "memcached-secure"
+	case common.MockPreset_MOCK_PRESET_MEMCACHED_TARPIT:
+		return "memcached-tarpit"
+	case common.MockPreset_MOCK_PRESET_FTP_SECURE:
+		return "ftp-secure"
+	case common.MockPreset_MOCK_PRESET_FTP_TARPIT:
+		return "ftp-tarpit"
+	case common.MockPreset_MOCK_PRESET_VNC_SECURE:
+		return "vnc-secure"
+	case common.MockPreset_MOCK_PRESET_VNC_TARPIT:
+		return "vnc-tarpit"
+	case common.MockPreset_MOCK_PRESET_SMB_SECURE:
+		return "smb-secure"
+	case common.MockPreset_MOCK_PRESET_LDAP_SECURE:
+		return "ldap-secure"
+	case common.MockPreset_MOCK_PRESET_LDAP_TARPIT:
+		return "ldap-tarpit"
 	default:
 		return ""
 	}
@@ -101,6 +162,36 @@ func StringToMockPreset(s string) common.MockPreset {
 		return common.MockPreset_MOCK_PRESET_TELNET_SECURE
 	case "raw-tarpit":
 		return common.MockPreset_MOCK_PRESET_RAW_TARPIT
+	case "postgres-secure":
+		return common.MockPreset_MOCK_PRESET_POSTGRES_SECURE
+	case "postgres-tarpit":
+		return common.MockPreset_MOCK_PRESET_POSTGRES_TARPIT
+	case "mongodb-secure":
+		return common.MockPreset_MOCK_PRESET_MONGODB_SECURE
+	case "mongodb-tarpit":
+		return common.MockPreset_MOCK_PRESET_MONGODB_TARPIT
+	case "elasticsearch-secure":
+		return common.MockPreset_MOCK_PRESET_ELASTICSEARCH_SECURE
+	case "elasticsearch-tarpit":
+		return common.MockPreset_MOCK_PRESET_ELASTICSEARCH_TARPIT
+	case "memcached-secure":
+		return common.MockPreset_MOCK_PRESET_MEMCACHED_SECURE
+	case "memcached-tarpit":
+		return common.MockPreset_MOCK_PRESET_MEMCACHED_TARPIT
+	case "ftp-secure":
+		return common.MockPreset_MOCK_PRESET_FTP_SECURE
+	case "ftp-tarpit":
+		return common.MockPreset_MOCK_PRESET_FTP_TARPIT
+	case "vnc-secure":
+		return common.MockPreset_MOCK_PRESET_VNC_SECURE
+	case "vnc-tarpit":
+		return common.MockPreset_MOCK_PRESET_VNC_TARPIT
+	case "smb-secure":
+		return common.MockPreset_MOCK_PRESET_SMB_SECURE
+	case "ldap-secure":
+		return common.MockPreset_MOCK_PRESET_LDAP_SECURE
+	case "ldap-tarpit":
+		return common.MockPreset_MOCK_PRESET_LDAP_TARPIT
 	default:
 		return common.MockPreset_MOCK_PRESET_UNSPECIFIED
 	}
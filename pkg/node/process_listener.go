@@ -2,9 +2,11 @@ package node
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"os"
 	"os/exec"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
@@ -14,6 +16,7 @@ import (
 	process_pb "github.com/ivere27/nitella/pkg/api/process"
 	pb "github.com/ivere27/nitella/pkg/api/proxy"
 	"github.com/ivere27/nitella/pkg/log"
+	"github.com/ivere27/nitella/pkg/node/accesslog"
 	"github.com/ivere27/synurang/pkg/synurang"
 	"google.golang.org/grpc"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -35,6 +38,7 @@ type ProcessListener struct {
 	KeyPEM         string
 	CaPEM          string
 	ClientAuthType pb.ClientAuthType
+	Thresholds     *pb.ConnectionThresholds
 
 	cmd     *exec.Cmd
 	quit    chan struct{}
@@ -51,6 +55,147 @@ type ProcessListener struct {
 
 	// Start time for uptime calculation
 	startTime time.Time
+
+	// Starts and crashes of the proxy's children (nil = not counted)
+	children *childCounters
+
+	// Node-wide tarpit budget split among children (nil = unlimited)
+	tarpit *tarpitShares
+
+	// Node's access log, written with the child's records (nil = none)
+	accessLog *accesslog.Logger
+}
+
+// configPushTimeout bounds sending a child a node-wide setting, such as
+// its new tarpit budget share.
+const configPushTimeout = 5 * time.Second
+
+// tarpitShares splits the node-wide tarpit budget evenly among running
+// child processes, so process mode stays within the node's limits. Shares
+// are rebalanced as children start and stop; a shrinking share keeps the
+// child's tarpits already admitted.
+type tarpitShares struct {
+	mu      sync.Mutex
+	cfg     TarpitBudgetConfig
+	members map[*ProcessListener]process_pb.ProcessControlClient
+}
+
+func newTarpitShares() *tarpitShares {
+	return &tarpitShares{members: make(map[*ProcessListener]process_pb.ProcessControlClient)}
+}
+
+// configure replaces the node-wide budget and resends every share.
+func (s *tarpitShares) configure(cfg TarpitBudgetConfig) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.cfg = cfg
+	s.rebalance(nil)
+}
+
+// join adds a starting child, resends the other shares and returns the
+// child's share for its StartListener request.
+func (s *tarpitShares) join(p *ProcessListener, client process_pb.ProcessControlClient) *process_pb.TarpitBudget {
+	if s == nil {
+		return nil
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.members[p] = client
+	return s.rebalance(p)
+}
+
+// leave removes a stopped child and resends the other shares.
+func (s *tarpitShares) leave(p *ProcessListener) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.members[p]; !ok {
+		return
+	}
+	delete(s.members, p)
+	s.rebalance(nil)
+}
+
+// rebalance sends each member its share, except joining, whose share is
+// returned. Called with s.mu held.
+func (s *tarpitShares) rebalance(joining *ProcessListener) *process_pb.TarpitBudget {
+	members := make([]*ProcessListener, 0, len(s.members))
+	for p := range s.members {
+		members = append(members, p)
+	}
+	sort.Slice(members, func(i, j int) bool { return members[i].ID < members[j].ID })
+
+	var joined *process_pb.TarpitBudget
+	for i, p := range members {
+		budget := s.cfg.share(i, len(members)).toProto()
+		if p == joining {
+			joined = budget
+			continue
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), configPushTimeout)
+		_, err := s.members[p].ConfigureTarpit(ctx, &process_pb.ConfigureTarpitRequest{Budget: budget})
+		cancel()
+		if err != nil {
+			log.Printf("[ProcessListener] Failed to send tarpit budget to %s: %v", p.ID, err)
+		}
+	}
+	return joined
+}
+
+// childCounters counts child process starts and unexpected exits per
+// proxy, across the ProcessListeners a proxy is run with over time.
+type childCounters struct {
+	mu      sync.Mutex
+	starts  map[string]int64
+	crashes map[string]int64
+}
+
+func newChildCounters() *childCounters {
+	return &childCounters{starts: make(map[string]int64), crashes: make(map[string]int64)}
+}
+
+func (c *childCounters) started(proxyID string) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	c.starts[proxyID]++
+	c.mu.Unlock()
+}
+
+func (c *childCounters) crashed(proxyID string) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	c.crashes[proxyID]++
+	c.mu.Unlock()
+}
+
+// ChildStats is a proxy's child process counters in process mode.
+type ChildStats struct {
+	ProxyID string
+	Starts  int64 // Including the first
+	Crashes int64 // Exits not asked for by Stop
+}
+
+func (c *childCounters) stats() []ChildStats {
+	if c == nil {
+		return nil
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	stats := make([]ChildStats, 0, len(c.starts))
+	for id, n := range c.starts {
+		stats = append(stats, ChildStats{ProxyID: id, Starts: n, Crashes: c.crashes[id]})
+	}
+	sort.Slice(stats, func(i, j int) bool { return stats[i].ProxyID < stats[j].ProxyID })
+	return stats
 }
 
 // NewProcessListener creates a new process-isolated listener.
@@ -118,11 +263,16 @@ func (p *ProcessListener) Start() error {
 	p.cmd = cmd
 	p.conn = conn
 	p.client = process_pb.NewProcessControlClient(conn)
+	tarpitBudget := p.tarpit.join(p, p.client)
 	p.running = true
 	p.startTime = time.Now()
+	p.children.started(p.ID)
 
 	// Monitor exit
 	go p.monitorExit()
+	if p.accessLog != nil {
+		go p.forwardAccessLog(p.client)
+	}
 
 	// 4. Initialize the listener in the child process
 	// We might need a small delay or retry if the server isn't instantly ready?
@@ -141,6 +291,10 @@ func (p *ProcessListener) Start() error {
 		ClientAuthType: p.ClientAuthType,
 		FallbackAction: p.FallbackAction,
 		FallbackMock:   p.FallbackMock,
+		Thresholds:     p.Thresholds,
+		TarpitBudget:   tarpitBudget,
+		ClonedPresets:  clonedPresetList(),
+		AccessLog:      p.accessLog != nil,
 	})
 	if err != nil {
 		p.Stop()
@@ -164,6 +318,7 @@ func (p *ProcessListener) Stop() error {
 	p.mu.Lock()
 	defer p.mu.Unlock()
 
+	p.tarpit.leave(p)
 	if p.conn != nil {
 		p.conn.Close()
 		p.conn = nil
@@ -200,6 +355,24 @@ func (p *ProcessListener) AddRule(rule *pb.Rule) {
 	}
 }
 
+// configureClonedPresets sends the child the parent's cloned presets.
+func (p *ProcessListener) configureClonedPresets(presets []*pb.ClonedPreset) {
+	p.mu.Lock()
+	client := p.client
+	p.mu.Unlock()
+
+	if client == nil {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), configPushTimeout)
+	defer cancel()
+	_, err := client.ConfigureClonedPresets(ctx, &process_pb.ConfigureClonedPresetsRequest{Presets: presets})
+	if err != nil {
+		log.Printf("[ProcessListener] Failed to send cloned presets to %s: %v", p.ID, err)
+	}
+}
+
 // RemoveRule removes a rule via IPC.
 func (p *ProcessListener) RemoveRule(ruleID string) error {
 	p.mu.Lock()
@@ -275,6 +448,8 @@ func (p *ProcessListener) GetStatus() *pb.ProxyStatus {
 			status.TotalConnections = resp.Status.TotalConnections
 			status.BytesIn = resp.Status.BytesIn
 			status.BytesOut = resp.Status.BytesOut
+			status.RuleHits = resp.Status.RuleHits
+			status.RateLimited = resp.Status.RateLimited
 			// Use actual listen address from child process
 			if resp.Status.ListenAddr != "" {
 				status.ListenAddr = resp.Status.ListenAddr
@@ -433,6 +608,34 @@ func (p *ProcessListener) SetFallback(action common.FallbackAction, mock common.
 	p.FallbackMock = mock
 }
 
+// SetThresholds sets the connection thresholds, applied when the child
+// process starts.
+func (p *ProcessListener) SetThresholds(t *pb.ConnectionThresholds) {
+	p.Thresholds = t
+}
+
+// forwardAccessLog writes the child's access log records to the node's
+// access log until the child's IPC connection closes.
+func (p *ProcessListener) forwardAccessLog(client process_pb.ProcessControlClient) {
+	stream, err := client.StreamAccessLog(context.Background(), &process_pb.StreamAccessLogRequest{})
+	if err != nil {
+		log.Printf("[ProcessListener] %s: access log stream failed: %v", p.ID, err)
+		return
+	}
+	for {
+		msg, err := stream.Recv()
+		if err != nil {
+			return
+		}
+		var rec accesslog.Record
+		if err := json.Unmarshal(msg.Json, &rec); err != nil {
+			log.Printf("[ProcessListener] %s: invalid access log record: %v", p.ID, err)
+			continue
+		}
+		p.accessLog.Log(&rec)
+	}
+}
+
 // monitorExit waits for the process to exit and cleans up resources.
 func (p *ProcessListener) monitorExit() {
 	if p.cmd == nil {
@@ -440,8 +643,13 @@ func (p *ProcessListener) monitorExit() {
 	}
 	err := p.cmd.Wait()
 	log.Printf("[ProcessListener] %s exited: %v", p.ID, err)
+	p.tarpit.leave(p)
 	p.mu.Lock()
 	defer p.mu.Unlock()
+	if p.running {
+		// Stop clears running before the child is reaped
+		p.children.crashed(p.ID)
+	}
 	p.running = false
 	if p.conn != nil {
 		p.conn.Close()
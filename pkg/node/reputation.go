@@ -0,0 +1,158 @@
+package node
+
+import (
+	"fmt"
+	"math"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ivere27/nitella/pkg/mockproto"
+)
+
+// Honeypot block triggers
+const (
+	HoneypotTriggerTouch   = "touch"   // Any connection handed to a mock
+	HoneypotTriggerCapture = "capture" // Credentials submitted to a mock
+)
+
+// maxHoneypotOffenders bounds the offense history; entries past their
+// forgiveness window are pruned when it is reached.
+const maxHoneypotOffenders = 10000
+
+// defaultHoneypotBlockDuration is the first honeypot block, and the block
+// for a canary hit that sets no duration.
+const defaultHoneypotBlockDuration = 10 * time.Minute
+
+// HoneypotBlockConfig configures honeypot reputation: sources that interact
+// with a mock listener are blocked globally on the other listeners.
+type HoneypotBlockConfig struct {
+	Trigger     string        // HoneypotTriggerTouch or HoneypotTriggerCapture
+	Duration    time.Duration // First block, and canary blocks with no duration (default 10m)
+	Escalation  float64       // Duration multiplier per repeat offense (default 2)
+	MaxDuration time.Duration // Longest block; also how long a source stays on record after its last block (default 24h)
+	Exempt      []string      // IPs or CIDRs never auto-blocked
+}
+
+// honeypotReputation tracks repeat offenders to escalate block durations.
+type honeypotReputation struct {
+	cfg    HoneypotBlockConfig
+	exempt []*net.IPNet
+
+	mu       sync.Mutex
+	offenses map[string]*honeypotOffense
+}
+
+type honeypotOffense struct {
+	count        int
+	blockedUntil time.Time
+}
+
+func newHoneypotReputation(cfg HoneypotBlockConfig) (*honeypotReputation, error) {
+	if cfg.Trigger != HoneypotTriggerTouch && cfg.Trigger != HoneypotTriggerCapture {
+		return nil, fmt.Errorf("unknown honeypot block trigger %q (want %s or %s)", cfg.Trigger, HoneypotTriggerTouch, HoneypotTriggerCapture)
+	}
+	if cfg.Duration <= 0 {
+		cfg.Duration = defaultHoneypotBlockDuration
+	}
+	if cfg.Escalation < 1 {
+		cfg.Escalation = 2
+	}
+	if cfg.MaxDuration <= 0 {
+		cfg.MaxDuration = 24 * time.Hour
+	}
+	if cfg.MaxDuration < cfg.Duration {
+		cfg.MaxDuration = cfg.Duration
+	}
+
+	r := &honeypotReputation{cfg: cfg, offenses: make(map[string]*honeypotOffense)}
+	for _, e := range cfg.Exempt {
+		e = strings.TrimSpace(e)
+		if e == "" {
+			continue
+		}
+		if !strings.Contains(e, "/") {
+			if ip := net.ParseIP(e); ip != nil && ip.To4() != nil {
+				e += "/32"
+			} else {
+				e += "/128"
+			}
+		}
+		_, ipNet, err := net.ParseCIDR(e)
+		if err != nil {
+			return nil, fmt.Errorf("invalid honeypot block exemption %q: %w", e, err)
+		}
+		r.exempt = append(r.exempt, ipNet)
+	}
+	return r, nil
+}
+
+// triggeredBy reports whether an event of this kind counts as an offense.
+func (r *honeypotReputation) triggeredBy(interaction bool, captureKind string) bool {
+	if interaction {
+		return r.cfg.Trigger == HoneypotTriggerTouch
+	}
+	switch captureKind {
+	case mockproto.CapturePassword, mockproto.CapturePublicKey, mockproto.CaptureLogin:
+		return true
+	}
+	return false
+}
+
+// offend blocks ip for its next offense with block and, if the block is
+// applied, records the offense. It returns the block duration and the
+// offense count. ok is false for exempt sources, sources still blocked
+// from an earlier offense and blocks that were refused.
+func (r *honeypotReputation) offend(ip string, block func(time.Duration) bool) (duration time.Duration, count int, ok bool) {
+	parsed := net.ParseIP(ip)
+	if parsed == nil {
+		return 0, 0, false
+	}
+	for _, n := range r.exempt {
+		if n.Contains(parsed) {
+			return 0, 0, false
+		}
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := time.Now()
+	o := r.offenses[ip]
+	if o != nil && now.Before(o.blockedUntil) {
+		return 0, 0, false
+	}
+	count = 1
+	if o != nil && now.Sub(o.blockedUntil) <= r.cfg.MaxDuration {
+		count = o.count + 1
+	}
+	d := float64(r.cfg.Duration) * math.Pow(r.cfg.Escalation, float64(count-1))
+	duration = r.cfg.MaxDuration
+	if d < float64(r.cfg.MaxDuration) {
+		duration = time.Duration(d)
+	}
+	if !block(duration) {
+		return 0, 0, false
+	}
+
+	if o == nil || count == 1 {
+		if o == nil && len(r.offenses) >= maxHoneypotOffenders {
+			r.prune(now)
+		}
+		o = &honeypotOffense{}
+		r.offenses[ip] = o
+	}
+	o.count = count
+	o.blockedUntil = now.Add(duration)
+	return duration, count, true
+}
+
+// prune forgets sources whose last block ended over MaxDuration ago.
+func (r *honeypotReputation) prune(now time.Time) {
+	for ip, o := range r.offenses {
+		if now.Sub(o.blockedUntil) > r.cfg.MaxDuration {
+			delete(r.offenses, ip)
+		}
+	}
+}
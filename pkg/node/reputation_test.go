@@ -0,0 +1,218 @@
+package node
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/ivere27/nitella/pkg/api/common"
+	pbProxy "github.com/ivere27/nitella/pkg/api/proxy"
+	"github.com/ivere27/nitella/pkg/mockproto"
+)
+
+func TestHoneypotReputation_Escalation(t *testing.T) {
+	r, err := newHoneypotReputation(HoneypotBlockConfig{
+		Trigger:     HoneypotTriggerTouch,
+		Duration:    time.Minute,
+		Escalation:  3,
+		MaxDuration: 5 * time.Minute,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	d, n, ok := r.offend("203.0.113.7", allowBlock)
+	if !ok || d != time.Minute || n != 1 {
+		t.Fatalf("First offense: got %v, %d, %v", d, n, ok)
+	}
+	if _, _, ok := r.offend("203.0.113.7", allowBlock); ok {
+		t.Error("Expected no new offense while still blocked")
+	}
+
+	// Expire the block: the next offense escalates, then hits the cap
+	r.offenses["203.0.113.7"].blockedUntil = time.Now().Add(-time.Second)
+	if d, n, _ := r.offend("203.0.113.7", allowBlock); d != 3*time.Minute || n != 2 {
+		t.Errorf("Second offense: got %v, %d", d, n)
+	}
+	r.offenses["203.0.113.7"].blockedUntil = time.Now().Add(-time.Second)
+	if d, _, _ := r.offend("203.0.113.7", allowBlock); d != 5*time.Minute {
+		t.Errorf("Third offense should be capped, got %v", d)
+	}
+
+	// Forgiven after MaxDuration without blocks
+	r.offenses["203.0.113.7"].blockedUntil = time.Now().Add(-6 * time.Minute)
+	if d, n, _ := r.offend("203.0.113.7", allowBlock); d != time.Minute || n != 1 {
+		t.Errorf("Expected offenses forgiven, got %v, %d", d, n)
+	}
+
+	// A refused block is not counted as an offense
+	r.offenses["203.0.113.7"].blockedUntil = time.Now().Add(-time.Second)
+	if _, _, ok := r.offend("203.0.113.7", func(time.Duration) bool { return false }); ok {
+		t.Error("Expected no offense when the block is refused")
+	}
+	if d, n, _ := r.offend("203.0.113.7", allowBlock); d != 3*time.Minute || n != 2 {
+		t.Errorf("Expected the refused block to leave the count unchanged, got %v, %d", d, n)
+	}
+}
+
+func allowBlock(time.Duration) bool { return true }
+
+func servesMock() bool { return true }
+
+func TestHoneypotReputation_Config(t *testing.T) {
+	if _, err := newHoneypotReputation(HoneypotBlockConfig{Trigger: "always"}); err == nil {
+		t.Error("Expected error for unknown trigger")
+	}
+	if _, err := newHoneypotReputation(HoneypotBlockConfig{Trigger: HoneypotTriggerTouch, Exempt: []string{"10.0.0.0/33"}}); err == nil {
+		t.Error("Expected error for invalid exemption")
+	}
+
+	r, err := newHoneypotReputation(HoneypotBlockConfig{Trigger: HoneypotTriggerCapture, Exempt: []string{"10.0.0.0/8", " 192.0.2.1", ""}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, ip := range []string{"10.1.2.3", "192.0.2.1"} {
+		if _, _, ok := r.offend(ip, allowBlock); ok {
+			t.Errorf("%s should be exempt", ip)
+		}
+	}
+	if r.triggeredBy(true, "") {
+		t.Error("Capture trigger should ignore plain connections")
+	}
+	if r.triggeredBy(false, mockproto.CaptureClientInfo) || !r.triggeredBy(false, mockproto.CapturePassword) {
+		t.Error("Capture trigger should fire on credentials only")
+	}
+}
+
+func TestHoneypotBlock(t *testing.T) {
+	sender := &MockAlertSender{}
+	gr := NewGlobalRulesStore()
+	defer gr.Stop()
+	pm := &ProxyManager{alerts: sender, GlobalRules: gr, NodeID: "node-1"}
+	if err := pm.SetHoneypotBlock(HoneypotBlockConfig{Trigger: HoneypotTriggerTouch, Duration: time.Hour}); err != nil {
+		t.Fatal(err)
+	}
+	model := &ProxyModel{ID: "p1", Name: "ssh-trap", ListenAddr: ":2222"}
+
+	pm.honeypotBlock(model, &pbProxy.ConnectionEvent{
+		SourceIp:   "203.0.113.7",
+		TargetAddr: ":2222",
+		EventType:  pbProxy.EventType_EVENT_TYPE_MOCK_INTERACTION,
+	})
+
+	if matched, action := gr.Check("203.0.113.7"); !matched || action != common.ActionType_ACTION_TYPE_BLOCK {
+		t.Fatalf("Expected source blocked on service listeners, got %v %v", matched, action)
+	}
+	if matched, _ := gr.CheckListener("203.0.113.7", servesMock); matched {
+		t.Error("Honeypot block should not apply on mock listeners")
+	}
+
+	if len(sender.alerts) != 1 {
+		t.Fatalf("Expected 1 alert, got %d", len(sender.alerts))
+	}
+	var details common.AlertDetails
+	if err := proto.Unmarshal([]byte(sender.infos[0]), &details); err != nil {
+		t.Fatal(err)
+	}
+	if details.Fields["trigger_listener"] != "ssh-trap" || details.Fields["block_seconds"] != "3600" || details.Fields["offense"] != "1" {
+		t.Errorf("Unexpected alert fields: %v", details.Fields)
+	}
+
+	// Repeat hits while blocked neither escalate nor alert again
+	pm.honeypotBlock(model, &pbProxy.ConnectionEvent{
+		SourceIp:  "203.0.113.7",
+		EventType: pbProxy.EventType_EVENT_TYPE_MOCK_CAPTURE,
+		Capture:   &pbProxy.MockCapture{Protocol: "ssh", Kind: mockproto.CapturePassword},
+	})
+	if len(sender.alerts) != 1 {
+		t.Errorf("Expected no alert for an already blocked source, got %d", len(sender.alerts))
+	}
+}
+
+func TestGlobalRules_HoneypotBlockYieldsToManualRules(t *testing.T) {
+	store := NewGlobalRulesStore()
+	defer store.Stop()
+
+	store.BlockIP("198.51.100.1", 0)
+	if store.BlockHoneypotIP("198.51.100.1", time.Minute, "trap") {
+		t.Error("Honeypot block should not replace a permanent manual block")
+	}
+	store.AllowIP("10.0.0.0/8", 0)
+	if store.BlockHoneypotIP("10.9.9.9", time.Minute, "trap") {
+		t.Error("Honeypot block should not apply to allowlisted CIDRs")
+	}
+
+	if !store.BlockHoneypotIP("198.51.100.2", time.Minute, "trap") {
+		t.Fatal("Expected honeypot block")
+	}
+	// A manual rule replaces it, and removing the stale honeypot ID is a no-op
+	store.BlockIP("198.51.100.2", 0)
+	if store.Remove("honeypot-block-198.51.100.2") {
+		t.Error("Stale honeypot rule ID should not remove the manual rule")
+	}
+	if matched, _ := store.CheckListener("198.51.100.2", servesMock); !matched {
+		t.Error("Manual block should apply on mock listeners too")
+	}
+}
+
+func TestGlobalRules_HoneypotPolicy(t *testing.T) {
+	store := NewGlobalRulesStore()
+	defer store.Stop()
+
+	store.AllowIP("198.51.100.1", 0)
+	if store.BlockHoneypotIP("198.51.100.1", time.Minute, "trap") {
+		t.Error("Honeypot block should not replace a manual allow")
+	}
+
+	_, exempt, _ := net.ParseCIDR("192.0.2.0/24")
+	store.SetHoneypotPolicy(time.Hour, []*net.IPNet{exempt})
+	if store.BlockHoneypotIP("192.0.2.9", time.Minute, "trap") {
+		t.Error("Honeypot block should not apply to exempt sources")
+	}
+
+	// No duration means the policy's, never a permanent block
+	if !store.BlockHoneypotIP("198.51.100.3", 0, "trap") {
+		t.Fatal("Expected honeypot block")
+	}
+	for _, r := range store.List() {
+		if r.SourceIP == "198.51.100.3" && time.Until(r.ExpiresAt).Round(time.Minute) != time.Hour {
+			t.Errorf("Expected the policy duration, got expiry %v", r.ExpiresAt)
+		}
+	}
+}
+
+func TestHoneypotBlockSkippedForMockRules(t *testing.T) {
+	gr := NewGlobalRulesStore()
+	defer gr.Stop()
+	l := NewEmbeddedListener("test-mock-rule", "Service", "127.0.0.1:0", "", common.ActionType_ACTION_TYPE_ALLOW, common.MockPreset_MOCK_PRESET_UNSPECIFIED, "", "", "", pbProxy.ClientAuthType_CLIENT_AUTH_AUTO, nil)
+	l.AddRule(&pbProxy.Rule{
+		Id:      "trap",
+		Enabled: true,
+		Action:  common.ActionType_ACTION_TYPE_MOCK,
+		Conditions: []*pbProxy.Condition{
+			cond(common.ConditionType_CONDITION_TYPE_SOURCE_IP, common.Operator_OPERATOR_EQ, "203.0.113.7"),
+		},
+	})
+
+	calls := 0
+	mocked := func(ip string) func() bool {
+		return func() bool {
+			calls++
+			return l.servesMock(connFrom(ip), nil)
+		}
+	}
+	if matched, _ := gr.CheckListener("203.0.113.7", mocked("203.0.113.7")); matched || calls != 0 {
+		t.Errorf("Expected no rule and no mock check without a honeypot block, got %v after %d calls", matched, calls)
+	}
+
+	gr.BlockHoneypotIP("203.0.113.7", time.Hour, "ssh-trap")
+	gr.BlockHoneypotIP("203.0.113.8", time.Hour, "ssh-trap")
+	if matched, _ := gr.CheckListener("203.0.113.7", mocked("203.0.113.7")); matched {
+		t.Error("Honeypot block should not apply to a connection served by a mock rule")
+	}
+	if matched, action := gr.CheckListener("203.0.113.8", mocked("203.0.113.8")); !matched || action != common.ActionType_ACTION_TYPE_BLOCK {
+		t.Errorf("Expected honeypot block for a forwarded connection, got %v %v", matched, action)
+	}
+}
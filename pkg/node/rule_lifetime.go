@@ -0,0 +1,281 @@
+package node
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/ivere27/nitella/pkg/api/proxy"
+	"github.com/ivere27/nitella/pkg/log"
+)
+
+// RuleExpiryInterval is how often the node looks for expired rules.
+const RuleExpiryInterval = 30 * time.Second
+
+// RuleExpiryMode is what the node does with a rule past its expires_at.
+type RuleExpiryMode string
+
+const (
+	RuleExpiryRemove  RuleExpiryMode = "remove"  // Delete the rule (default)
+	RuleExpiryDisable RuleExpiryMode = "disable" // Keep the rule, disabled
+)
+
+var weekdays = map[string]time.Weekday{
+	"sun": time.Sunday, "mon": time.Monday, "tue": time.Tuesday, "wed": time.Wednesday,
+	"thu": time.Thursday, "fri": time.Friday, "sat": time.Saturday,
+}
+
+// ruleSchedule is a parsed Rule.Schedule: a set of weekdays and an
+// optional daily time range, in the node's local time.
+type ruleSchedule struct {
+	days       [7]bool // By time.Weekday
+	hasRange   bool
+	start, end int // Minutes since midnight
+}
+
+// parseSchedule parses "[days] [HH:MM-HH:MM]", where days is a comma
+// separated list of days or day ranges ("Mon-Fri", "Sat,Sun", "Fri-Mon").
+// Either part may be omitted. An empty schedule returns nil (always active).
+func parseSchedule(s string) (*ruleSchedule, error) {
+	fields := strings.Fields(s)
+	if len(fields) == 0 {
+		return nil, nil
+	}
+	if len(fields) > 2 {
+		return nil, fmt.Errorf("invalid schedule %q: expected \"[days] [HH:MM-HH:MM]\"", s)
+	}
+
+	sched := &ruleSchedule{}
+	hasDays := false
+	for _, field := range fields {
+		if strings.Contains(field, ":") {
+			if sched.hasRange {
+				return nil, fmt.Errorf("invalid schedule %q: more than one time range", s)
+			}
+			start, end, ok := parseTimeRange(field)
+			if !ok {
+				return nil, fmt.Errorf("invalid schedule %q: bad time range %q", s, field)
+			}
+			sched.hasRange, sched.start, sched.end = true, start, end
+			continue
+		}
+		if hasDays {
+			return nil, fmt.Errorf("invalid schedule %q: more than one day list", s)
+		}
+		hasDays = true
+		for _, part := range strings.Split(field, ",") {
+			from, to, isRange := strings.Cut(part, "-")
+			first, ok1 := weekdays[strings.ToLower(from)]
+			last, ok2 := first, true
+			if isRange {
+				last, ok2 = weekdays[strings.ToLower(to)]
+			}
+			if !ok1 || !ok2 {
+				return nil, fmt.Errorf("invalid schedule %q: bad day %q", s, part)
+			}
+			for d := first; ; d = (d + 1) % 7 {
+				sched.days[d] = true
+				if d == last {
+					break
+				}
+			}
+		}
+	}
+	if !hasDays {
+		sched.days = [7]bool{true, true, true, true, true, true, true}
+	}
+	return sched, nil
+}
+
+// active reports whether now falls within the schedule. The part of an
+// overnight range after midnight belongs to the day it started on.
+func (s *ruleSchedule) active(now time.Time) bool {
+	if s == nil {
+		return true
+	}
+	day := now.Weekday()
+	if !s.hasRange {
+		return s.days[day]
+	}
+	if !inTimeRange(s.start, s.end, now) {
+		return false
+	}
+	if s.start > s.end && now.Hour()*60+now.Minute() <= s.end {
+		day = (day + 6) % 7
+	}
+	return s.days[day]
+}
+
+// ruleLifetime is the activation window of a rule.
+type ruleLifetime struct {
+	notBefore, expiresAt time.Time // Zero = unbounded
+	schedule             *ruleSchedule
+	invalid              bool // Schedule does not parse: the rule never matches
+}
+
+func compileLifetime(rule *pb.Rule) ruleLifetime {
+	lt := ruleLifetime{
+		notBefore: ruleTime(rule.NotBefore),
+		expiresAt: ruleTime(rule.ExpiresAt),
+	}
+	sched, err := parseSchedule(rule.Schedule)
+	lt.schedule, lt.invalid = sched, err != nil
+	return lt
+}
+
+// always reports whether the rule is active at any time.
+func (lt *ruleLifetime) always() bool {
+	return lt.notBefore.IsZero() && lt.expiresAt.IsZero() && lt.schedule == nil && !lt.invalid
+}
+
+func (lt *ruleLifetime) active(now time.Time) bool {
+	if lt.invalid {
+		return false
+	}
+	if !lt.notBefore.IsZero() && now.Before(lt.notBefore) {
+		return false
+	}
+	if !lt.expiresAt.IsZero() && !now.Before(lt.expiresAt) {
+		return false
+	}
+	return lt.schedule.active(now)
+}
+
+// ruleActive reports whether the rule's lifetime and schedule allow it to
+// match at now.
+func ruleActive(rule *pb.Rule, now time.Time) bool {
+	if rule.NotBefore == nil && rule.ExpiresAt == nil && rule.Schedule == "" {
+		return true
+	}
+	lt := compileLifetime(rule)
+	return lt.active(now)
+}
+
+// ruleExpired reports whether the rule has an expiry that has passed.
+func ruleExpired(rule *pb.Rule, now time.Time) bool {
+	return rule.ExpiresAt != nil && !now.Before(rule.ExpiresAt.AsTime())
+}
+
+// validateRuleLifetime rejects schedules that do not parse and lifetimes
+// that end before they start.
+func validateRuleLifetime(rule *pb.Rule) error {
+	if _, err := parseSchedule(rule.Schedule); err != nil {
+		return err
+	}
+	if rule.NotBefore != nil && rule.ExpiresAt != nil && !rule.ExpiresAt.AsTime().After(rule.NotBefore.AsTime()) {
+		return fmt.Errorf("rule expires_at must be after not_before")
+	}
+	return nil
+}
+
+// ruleTime converts an optional rule timestamp; unset is the zero time.
+func ruleTime(ts *timestamppb.Timestamp) time.Time {
+	if ts == nil {
+		return time.Time{}
+	}
+	return ts.AsTime()
+}
+
+// ruleTimestamp converts a stored rule time back; the zero time is unset.
+func ruleTimestamp(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
+
+// SetRuleExpiry sets whether expired rules are removed (default) or
+// disabled.
+func (m *ProxyManager) SetRuleExpiry(mode RuleExpiryMode) error {
+	switch mode {
+	case RuleExpiryRemove, RuleExpiryDisable:
+	default:
+		return fmt.Errorf("invalid rule expiry mode %q (use remove or disable)", mode)
+	}
+	m.mu.Lock()
+	m.ruleExpiry = mode
+	m.mu.Unlock()
+	return nil
+}
+
+// DisableRuleExpiry leaves expired rules in place. Process mode children
+// use it: their parent owns the rules and its own expiry removes or
+// disables them through the child. Expired rules stop matching regardless.
+func (m *ProxyManager) DisableRuleExpiry() {
+	m.mu.Lock()
+	m.ruleExpiry = ""
+	m.mu.Unlock()
+}
+
+// expireRules removes or disables the enabled rules whose expires_at has
+// passed. Called periodically by CleanupManager; the rules stop matching
+// at expiry regardless.
+func (m *ProxyManager) expireRules() {
+	now := time.Now()
+
+	m.mu.RLock()
+	mode := m.ruleExpiry
+	if mode == "" {
+		m.mu.RUnlock()
+		return
+	}
+	proxyIDs := make([]string, 0, len(m.proxies))
+	for id := range m.proxies {
+		proxyIDs = append(proxyIDs, id)
+	}
+	m.mu.RUnlock()
+
+	for _, proxyID := range proxyIDs {
+		rules, err := m.GetRules(proxyID)
+		if err != nil {
+			continue
+		}
+		for _, rule := range rules {
+			if !rule.Enabled || !ruleExpired(rule, now) {
+				continue
+			}
+			verb := "removed"
+			if mode == RuleExpiryDisable {
+				verb = "disabled"
+				err = m.disableRule(proxyID, rule)
+			} else {
+				err = m.RemoveRule(&pb.RemoveRuleRequest{ProxyId: proxyID, RuleId: rule.Id})
+			}
+			if err != nil {
+				log.Printf("[Rules] Failed to expire rule %s on proxy %s: %v", rule.Id, proxyID, err)
+				continue
+			}
+			log.Printf("[Rules] Rule %q (%s) on proxy %s expired, %s", rule.Name, rule.Id, proxyID, verb)
+		}
+	}
+}
+
+// disableRule replaces a rule with a disabled copy and persists the change.
+func (m *ProxyManager) disableRule(proxyID string, rule *pb.Rule) error {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	mp, ok := m.proxies[proxyID]
+	if !ok {
+		return fmt.Errorf("proxy not found")
+	}
+
+	if mp.Listener != nil {
+		disabled := proto.Clone(rule).(*pb.Rule)
+		disabled.Enabled = false
+		if err := mp.Listener.RemoveRule(rule.Id); err != nil {
+			return err
+		}
+		mp.Listener.AddRule(disabled)
+	}
+
+	if m.db != nil {
+		if _, err := m.db.ID(rule.Id).Cols("enabled").Update(&RuleModel{Enabled: false}); err != nil {
+			log.Printf("Failed to disable rule in DB: %v\n", err)
+		}
+	}
+	return nil
+}
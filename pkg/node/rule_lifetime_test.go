@@ -0,0 +1,243 @@
+package node
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/ivere27/nitella/pkg/api/common"
+	process_pb "github.com/ivere27/nitella/pkg/api/process"
+	pbProxy "github.com/ivere27/nitella/pkg/api/proxy"
+)
+
+func TestRuleSchedule(t *testing.T) {
+	// 2026-10-19 is a Monday
+	at := func(day, hour, min int) time.Time {
+		return time.Date(2026, time.October, day, hour, min, 0, 0, time.Local)
+	}
+	tests := []struct {
+		schedule string
+		now      time.Time
+		want     bool
+	}{
+		{"", at(19, 3, 0), true},
+		{"Mon-Fri 09:00-17:00", at(19, 9, 0), true},
+		{"Mon-Fri 09:00-17:00", at(19, 17, 1), false},
+		{"Mon-Fri 09:00-17:00", at(24, 10, 0), false}, // Saturday
+		{"Sat,Sun", at(25, 23, 59), true},
+		{"Sat,Sun", at(23, 12, 0), false},
+		{"Fri-Mon", at(19, 12, 0), true},
+		{"Fri-Mon", at(21, 12, 0), false},
+		{"22:00-06:00", at(20, 23, 30), true},
+		{"22:00-06:00", at(20, 12, 0), false},
+		// The early hours belong to the night the range started on
+		{"Fri 22:00-06:00", at(24, 5, 0), true},
+		{"Fri 22:00-06:00", at(23, 5, 0), false},
+		{"mon 00:00-23:59", at(19, 12, 0), true},
+	}
+	for _, tt := range tests {
+		sched, err := parseSchedule(tt.schedule)
+		if err != nil {
+			t.Fatalf("parseSchedule(%q) failed: %v", tt.schedule, err)
+		}
+		if got := sched.active(tt.now); got != tt.want {
+			t.Errorf("%q at %s: expected %v, got %v", tt.schedule, tt.now.Format("Mon 15:04"), tt.want, got)
+		}
+	}
+
+	for _, bad := range []string{"Mon-Funday", "09:00-25:00", "Mon Tue", "09:00-10:00 11:00-12:00", "Mon 09:00-17:00 extra"} {
+		if _, err := parseSchedule(bad); err == nil {
+			t.Errorf("Expected parseSchedule(%q) to fail", bad)
+		}
+	}
+}
+
+func TestRuleLifetimeMatching(t *testing.T) {
+	now := time.Now()
+	l := NewEmbeddedListener("test-rule-lifetime", "Lifetime", "127.0.0.1:0", "", common.ActionType_ACTION_TYPE_ALLOW, common.MockPreset_MOCK_PRESET_UNSPECIFIED, "", "", "", pbProxy.ClientAuthType_CLIENT_AUTH_AUTO, nil)
+	add := func(id string, priority int32, notBefore, expiresAt time.Time, schedule string) {
+		rule := &pbProxy.Rule{Id: id, Priority: priority, Enabled: true, Action: common.ActionType_ACTION_TYPE_BLOCK, Schedule: schedule,
+			Conditions: []*pbProxy.Condition{cond(common.ConditionType_CONDITION_TYPE_SOURCE_IP, common.Operator_OPERATOR_CIDR, "10.0.0.0/8")}}
+		rule.NotBefore, rule.ExpiresAt = ruleTimestamp(notBefore), ruleTimestamp(expiresAt)
+		l.AddRule(rule)
+	}
+	add("expired", 50, time.Time{}, now.Add(-time.Minute), "")
+	add("pending", 40, now.Add(time.Hour), time.Time{}, "")
+	add("bad-schedule", 30, time.Time{}, time.Time{}, "Someday")
+	add("contractor", 20, now.Add(-time.Minute), now.Add(2*time.Hour), "")
+	add("permanent", 10, time.Time{}, time.Time{}, "")
+
+	conn := connFrom("10.1.2.3")
+	rule, _ := l.evaluateRules(conn, nil)
+	if rule == nil || rule.Id != "contractor" {
+		t.Fatalf("Expected the active temporary rule to match, got %v", rule)
+	}
+	if got := linearMatch(l.GetRules(), conn, nil); got != rule {
+		t.Errorf("MatchRule disagrees with the rule set: %v", got)
+	}
+
+	if err := l.RemoveRule("contractor"); err != nil {
+		t.Fatal(err)
+	}
+	if rule, _ := l.evaluateRules(conn, nil); rule == nil || rule.Id != "permanent" {
+		t.Errorf("Expected the permanent rule after removal, got %v", rule)
+	}
+}
+
+func TestExpireRules(t *testing.T) {
+	pm := NewProxyManager(ListenerModeFfi)
+	defer pm.Close()
+	if err := pm.InitDB(filepath.Join(t.TempDir(), "nitella.db")); err != nil {
+		t.Fatalf("InitDB failed: %v", err)
+	}
+	resp, err := pm.CreateProxy(&pbProxy.CreateProxyRequest{
+		Name:          "expiry",
+		ListenAddr:    "127.0.0.1:0",
+		DefaultAction: common.ActionType_ACTION_TYPE_BLOCK,
+	})
+	if err != nil || !resp.Success {
+		t.Fatalf("CreateProxy failed: %v %s", err, resp.GetErrorMessage())
+	}
+
+	past := timestamppb.New(time.Now().Add(-time.Second))
+	future := timestamppb.New(time.Now().Add(time.Hour))
+	for _, rule := range []*pbProxy.Rule{
+		{Id: "old", Name: "contractor", Enabled: true, ExpiresAt: past},
+		{Id: "new", Name: "visitor", Enabled: true, ExpiresAt: future, Schedule: "Mon-Fri"},
+		{Id: "keep", Name: "office", Enabled: true},
+	} {
+		if _, err := pm.AddRule(&pbProxy.AddRuleRequest{ProxyId: resp.ProxyId, Rule: rule}); err != nil {
+			t.Fatalf("AddRule failed: %v", err)
+		}
+	}
+	if _, err := pm.AddRule(&pbProxy.AddRuleRequest{ProxyId: resp.ProxyId, Rule: &pbProxy.Rule{Schedule: "Mon 9am"}}); err == nil {
+		t.Error("Expected an invalid schedule to be rejected")
+	}
+	if _, err := pm.AddRule(&pbProxy.AddRuleRequest{ProxyId: resp.ProxyId, Rule: &pbProxy.Rule{NotBefore: future, ExpiresAt: past}}); err == nil {
+		t.Error("Expected expiry before not_before to be rejected")
+	}
+
+	stored := func() map[string]RuleModel {
+		var models []RuleModel
+		if err := pm.db.Where("proxy_id = ?", resp.ProxyId).Find(&models); err != nil {
+			t.Fatalf("Find failed: %v", err)
+		}
+		byID := make(map[string]RuleModel)
+		for _, m := range models {
+			byID[m.ID] = m
+		}
+		return byID
+	}
+	if m := stored()["new"]; m.ExpiresAt.Unix() != future.Seconds || m.Schedule != "Mon-Fri" || !m.NotBefore.IsZero() {
+		t.Errorf("Lifetime not persisted: %+v", m)
+	}
+
+	// Disable mode keeps the rule, disabled, in the listener and the DB
+	if err := pm.SetRuleExpiry(RuleExpiryDisable); err != nil {
+		t.Fatal(err)
+	}
+	pm.expireRules()
+	rules, _ := pm.GetRules(resp.ProxyId)
+	if len(rules) != 3 {
+		t.Fatalf("Expected 3 rules, got %d", len(rules))
+	}
+	for _, r := range rules {
+		if r.Enabled != (r.Id != "old") {
+			t.Errorf("Rule %s: unexpected enabled=%v", r.Id, r.Enabled)
+		}
+	}
+	if m := stored()["old"]; m.Enabled {
+		t.Error("Expected the expired rule disabled in the DB")
+	}
+
+	// Remove mode deletes expired rules
+	pm.SetRuleExpiry(RuleExpiryRemove)
+	l := pm.proxies[resp.ProxyId].Listener
+	l.RemoveRule("old")
+	l.AddRule(&pbProxy.Rule{Id: "old", Name: "contractor", Enabled: true, ExpiresAt: past})
+	pm.expireRules()
+	rules, _ = pm.GetRules(resp.ProxyId)
+	if len(rules) != 2 {
+		t.Fatalf("Expected 2 rules after removal, got %d", len(rules))
+	}
+	if _, ok := stored()["old"]; ok {
+		t.Error("Expected the expired rule deleted from the DB")
+	}
+
+	if err := pm.SetRuleExpiry("archive"); err == nil {
+		t.Error("Expected an unknown expiry mode to be rejected")
+	}
+}
+
+// childClient serves rule calls from an in-process child ProxyManager, as
+// a child process's ProcessServer does.
+type childClient struct {
+	process_pb.ProcessControlClient
+	pm      *ProxyManager
+	proxyID string
+}
+
+func (c *childClient) AddRule(ctx context.Context, req *process_pb.AddRuleRequest, opts ...grpc.CallOption) (*process_pb.AddRuleResponse, error) {
+	_, err := c.pm.AddRule(&pbProxy.AddRuleRequest{ProxyId: c.proxyID, Rule: req.Rule})
+	return &process_pb.AddRuleResponse{Success: err == nil}, err
+}
+
+func (c *childClient) RemoveRule(ctx context.Context, req *process_pb.RemoveRuleRequest, opts ...grpc.CallOption) (*process_pb.RemoveRuleResponse, error) {
+	err := c.pm.RemoveRule(&pbProxy.RemoveRuleRequest{ProxyId: c.proxyID, RuleId: req.RuleId})
+	return &process_pb.RemoveRuleResponse{Success: err == nil}, err
+}
+
+func (c *childClient) ListRules(ctx context.Context, req *process_pb.ListRulesRequest, opts ...grpc.CallOption) (*process_pb.ListRulesResponse, error) {
+	rules, err := c.pm.GetRules(c.proxyID)
+	return &process_pb.ListRulesResponse{Rules: rules}, err
+}
+
+func TestExpireRules_ProcessMode(t *testing.T) {
+	child := NewProxyManager(ListenerModeFfi)
+	defer child.Close()
+	child.DisableRuleExpiry()
+	resp, err := child.CreateProxy(&pbProxy.CreateProxyRequest{
+		Name:          "expiry-child",
+		ListenAddr:    "127.0.0.1:0",
+		DefaultAction: common.ActionType_ACTION_TYPE_BLOCK,
+	})
+	if err != nil || !resp.Success {
+		t.Fatalf("CreateProxy failed: %v %s", err, resp.GetErrorMessage())
+	}
+
+	parent := NewProxyManager(ListenerModeProcess)
+	defer parent.Close()
+	if err := parent.InitDB(filepath.Join(t.TempDir(), "nitella.db")); err != nil {
+		t.Fatalf("InitDB failed: %v", err)
+	}
+	if err := parent.SetRuleExpiry(RuleExpiryDisable); err != nil {
+		t.Fatal(err)
+	}
+	pl := &ProcessListener{ID: resp.ProxyId, client: &childClient{pm: child, proxyID: resp.ProxyId}}
+	parent.proxies[resp.ProxyId] = &ManagedProxy{Listener: pl}
+
+	past := timestamppb.New(time.Now().Add(-time.Second))
+	rule := &pbProxy.Rule{Id: "old", Name: "contractor", Enabled: true, ExpiresAt: past}
+	if _, err := parent.AddRule(&pbProxy.AddRuleRequest{ProxyId: resp.ProxyId, Rule: rule}); err != nil {
+		t.Fatalf("AddRule failed: %v", err)
+	}
+
+	// The child leaves the expired rule to the parent
+	child.expireRules()
+	if rules, _ := child.GetRules(resp.ProxyId); len(rules) != 1 || !rules[0].Enabled {
+		t.Fatalf("Expected the child to keep the rule, got %v", rules)
+	}
+
+	parent.expireRules()
+	if rules, _ := child.GetRules(resp.ProxyId); len(rules) != 1 || rules[0].Enabled {
+		t.Errorf("Expected the parent to disable the rule in the child, got %v", rules)
+	}
+	var m RuleModel
+	if ok, err := parent.db.ID("old").Get(&m); err != nil || !ok || m.Enabled {
+		t.Errorf("Expected the rule disabled in the DB, got %+v (found=%v, err=%v)", m, ok, err)
+	}
+}
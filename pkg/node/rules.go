@@ -75,9 +75,10 @@ func getCompiledRegex(pattern string) *regexp.Regexp {
 }
 
 // MatchRule checks if a connection matches the rule's conditions.
-// It returns true if ALL conditions match (AND logic).
+// It returns true if the rule is active (see Rule.expires_at and schedule)
+// and ALL conditions match (AND logic).
 func MatchRule(rule *pb.Rule, conn net.Conn, geo *pbCommon.GeoInfo) bool {
-	if !rule.Enabled {
+	if !rule.Enabled || !ruleActive(rule, time.Now()) {
 		return false
 	}
 
@@ -95,6 +96,23 @@ func MatchRule(rule *pb.Rule, conn net.Conn, geo *pbCommon.GeoInfo) bool {
 	return true
 }
 
+// rulesNeedGeo reports whether any rule matches on GeoIP data, so the
+// lookup has to finish before rules are evaluated. TLS attributes are read
+// from the connection only when a TLS condition is evaluated.
+func rulesNeedGeo(rules []*pb.Rule) bool {
+	for _, rule := range rules {
+		for _, cond := range rule.Conditions {
+			switch cond.Type {
+			case common.ConditionType_CONDITION_TYPE_GEO_COUNTRY,
+				common.ConditionType_CONDITION_TYPE_GEO_CITY,
+				common.ConditionType_CONDITION_TYPE_GEO_ISP:
+				return true
+			}
+		}
+	}
+	return false
+}
+
 func matchCondition(cond *pb.Condition, conn net.Conn, geo *pbCommon.GeoInfo) bool {
 	matched := false
 
@@ -128,8 +146,33 @@ func matchCondition(cond *pb.Condition, conn net.Conn, geo *pbCommon.GeoInfo) bo
 		// Format: "HH:MM-HH:MM" (24h)
 		matched = matchTimeRange(cond.Value)
 
+	case common.ConditionType_CONDITION_TYPE_TLS_FINGERPRINT,
+		common.ConditionType_CONDITION_TYPE_TLS_CN,
+		common.ConditionType_CONDITION_TYPE_TLS_SERIAL,
+		common.ConditionType_CONDITION_TYPE_TLS_PRESENT,
+		common.ConditionType_CONDITION_TYPE_TLS_CA,
+		common.ConditionType_CONDITION_TYPE_TLS_SAN,
+		common.ConditionType_CONDITION_TYPE_TLS_OU:
+		return matchTLSCondition(cond, getTLSState(conn))
+
+	default:
+		// Unsupported condition type
+		return false
+	}
+
+	if cond.Negate {
+		return !matched
+	}
+	return matched
+}
+
+// matchTLSCondition matches a TLS condition against the client's
+// handshake state, nil if the connection is not TLS.
+func matchTLSCondition(cond *pb.Condition, cs *tls.ConnectionState) bool {
+	matched := false
+
+	switch cond.Type {
 	case common.ConditionType_CONDITION_TYPE_TLS_FINGERPRINT:
-		cs := getTLSState(conn)
 		if cs == nil || len(cs.PeerCertificates) == 0 {
 			return false
 		}
@@ -139,14 +182,12 @@ func matchCondition(cond *pb.Condition, conn net.Conn, geo *pbCommon.GeoInfo) bo
 		matched = matchString(cond.Op, cond.Value, fp)
 
 	case common.ConditionType_CONDITION_TYPE_TLS_CN:
-		cs := getTLSState(conn)
 		if cs == nil || len(cs.PeerCertificates) == 0 {
 			return false
 		}
 		matched = matchString(cond.Op, cond.Value, cs.PeerCertificates[0].Subject.CommonName)
 
 	case common.ConditionType_CONDITION_TYPE_TLS_SERIAL:
-		cs := getTLSState(conn)
 		if cs == nil || len(cs.PeerCertificates) == 0 {
 			return false
 		}
@@ -154,7 +195,6 @@ func matchCondition(cond *pb.Condition, conn net.Conn, geo *pbCommon.GeoInfo) bo
 		matched = matchString(cond.Op, cond.Value, serial)
 
 	case common.ConditionType_CONDITION_TYPE_TLS_PRESENT:
-		cs := getTLSState(conn)
 		hasCert := cs != nil && len(cs.PeerCertificates) > 0
 		if cond.Value == "false" {
 			matched = !hasCert
@@ -163,7 +203,6 @@ func matchCondition(cond *pb.Condition, conn net.Conn, geo *pbCommon.GeoInfo) bo
 		}
 
 	case common.ConditionType_CONDITION_TYPE_TLS_CA:
-		cs := getTLSState(conn)
 		if cs == nil || len(cs.PeerCertificates) == 0 {
 			return false
 		}
@@ -172,7 +211,6 @@ func matchCondition(cond *pb.Condition, conn net.Conn, geo *pbCommon.GeoInfo) bo
 		matched = matchString(cond.Op, cond.Value, issuerCN)
 
 	case common.ConditionType_CONDITION_TYPE_TLS_SAN:
-		cs := getTLSState(conn)
 		if cs == nil || len(cs.PeerCertificates) == 0 {
 			return false
 		}
@@ -202,7 +240,6 @@ func matchCondition(cond *pb.Condition, conn net.Conn, geo *pbCommon.GeoInfo) bo
 		}
 
 	case common.ConditionType_CONDITION_TYPE_TLS_OU:
-		cs := getTLSState(conn)
 		if cs == nil || len(cs.PeerCertificates) == 0 {
 			return false
 		}
@@ -215,7 +252,6 @@ func matchCondition(cond *pb.Condition, conn net.Conn, geo *pbCommon.GeoInfo) bo
 		}
 
 	default:
-		// Unsupported condition type
 		return false
 	}
 
@@ -227,18 +263,27 @@ func matchCondition(cond *pb.Condition, conn net.Conn, geo *pbCommon.GeoInfo) bo
 
 // matchTimeRange checks if current time falls within "HH:MM-HH:MM" range
 func matchTimeRange(value string) bool {
+	start, end, ok := parseTimeRange(value)
+	return ok && inTimeRange(start, end, time.Now())
+}
+
+// parseTimeRange parses "HH:MM-HH:MM" to minutes since midnight
+func parseTimeRange(value string) (start, end int, ok bool) {
 	parts := strings.Split(value, "-")
 	if len(parts) != 2 {
-		return false
+		return 0, 0, false
 	}
 
 	start, err1 := parseTime(parts[0])
 	end, err2 := parseTime(parts[1])
 	if err1 != nil || err2 != nil {
-		return false
+		return 0, 0, false
 	}
+	return start, end, true
+}
 
-	now := time.Now()
+// inTimeRange checks if now falls within start-end (minutes since midnight)
+func inTimeRange(start, end int, now time.Time) bool {
 	current := now.Hour()*60 + now.Minute()
 
 	if start <= end {
@@ -283,25 +328,13 @@ func matchString(op common.Operator, targetValue, actualValue string) bool {
 		return strings.Contains(actualValue, targetValue)
 
 	case common.Operator_OPERATOR_REGEX:
-		// Use cached compiled regex with timeout protection
+		// Use cached compiled regex. Matching runs in linear time in the
+		// input (RE2), so it is done inline.
 		re := getCompiledRegex(targetValue)
 		if re == nil {
 			return false // Invalid or too long regex
 		}
-
-		// Add timeout protection for matching
-		done := make(chan bool, 1)
-		go func() {
-			done <- re.MatchString(actualValue)
-		}()
-
-		select {
-		case result := <-done:
-			return result
-		case <-time.After(100 * time.Millisecond):
-			// Regex match took too long - potential ReDoS
-			return false
-		}
+		return re.MatchString(actualValue)
 
 	case common.Operator_OPERATOR_CIDR:
 		_, ipNet, err := net.ParseCIDR(targetValue)
@@ -0,0 +1,345 @@
+package node
+
+import (
+	"crypto/tls"
+	"net"
+	"net/netip"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/ivere27/nitella/pkg/api/common"
+	pbCommon "github.com/ivere27/nitella/pkg/api/common"
+	pb "github.com/ivere27/nitella/pkg/api/proxy"
+)
+
+// ruleSet is a listener's rules compiled for evaluation. Values are parsed
+// once when the rules change instead of on every connection, and rules
+// keyed on a source IP/CIDR or an exact GeoIP value are indexed so a
+// connection only evaluates the rules that can match it. Evaluation keeps
+// the priority order of MatchRule over the sorted rule slice.
+type ruleSet struct {
+	rules    []*compiledRule // Enabled rules in priority order
+	needsGeo bool
+
+	// Indexes map a key to the positions of the rules keyed on it
+	exactIP map[string][]int
+	v4      cidrTrie
+	v6      cidrTrie
+	country map[string][]int
+	city    map[string][]int
+	isp     map[string][]int
+	scan    []int // Rules without an indexable condition
+}
+
+type compiledRule struct {
+	rule     *pb.Rule
+	conds    []compiledCondition
+	lifetime *ruleLifetime // nil if the rule is always active
+}
+
+// compiledCondition is a pb.Condition with its value pre-parsed.
+type compiledCondition struct {
+	cond    *pb.Condition
+	invalid bool // Value does not parse: the condition never matches
+
+	re                 *regexp.Regexp // OPERATOR_REGEX
+	prefix             netip.Prefix   // OPERATOR_CIDR
+	startMins, endMins int            // TIME_RANGE
+}
+
+// compileRuleSet compiles rules, which must be sorted by priority.
+func compileRuleSet(rules []*pb.Rule) *ruleSet {
+	rs := &ruleSet{
+		needsGeo: rulesNeedGeo(rules),
+		exactIP:  make(map[string][]int),
+		country:  make(map[string][]int),
+		city:     make(map[string][]int),
+		isp:      make(map[string][]int),
+	}
+	for _, rule := range rules {
+		if !rule.Enabled {
+			continue
+		}
+		idx := len(rs.rules)
+		cr := &compiledRule{rule: rule, conds: make([]compiledCondition, len(rule.Conditions))}
+		for i, cond := range rule.Conditions {
+			cr.conds[i] = compileCondition(cond)
+		}
+		if lt := compileLifetime(rule); !lt.always() {
+			cr.lifetime = &lt
+		}
+		rs.rules = append(rs.rules, cr)
+		if !rs.index(cr, idx) {
+			rs.scan = append(rs.scan, idx)
+		}
+	}
+	return rs
+}
+
+// index files a rule under its first indexable condition: a positive
+// exact or CIDR source IP match, or an exact GeoIP value. Every other
+// condition of the rule is checked when the rule is evaluated.
+func (rs *ruleSet) index(cr *compiledRule, idx int) bool {
+	for _, c := range cr.conds {
+		if c.cond.Negate {
+			continue
+		}
+		if c.invalid {
+			// Never matches; evaluating it by scan keeps the result exact
+			continue
+		}
+		switch c.cond.Type {
+		case common.ConditionType_CONDITION_TYPE_SOURCE_IP:
+			switch c.cond.Op {
+			case common.Operator_OPERATOR_EQ:
+				rs.exactIP[c.cond.Value] = append(rs.exactIP[c.cond.Value], idx)
+				return true
+			case common.Operator_OPERATOR_CIDR:
+				if c.prefix.Addr().Is4() {
+					rs.v4.insert(c.prefix, idx)
+				} else {
+					rs.v6.insert(c.prefix, idx)
+				}
+				return true
+			}
+		case common.ConditionType_CONDITION_TYPE_GEO_COUNTRY,
+			common.ConditionType_CONDITION_TYPE_GEO_CITY,
+			common.ConditionType_CONDITION_TYPE_GEO_ISP:
+			if c.cond.Op != common.Operator_OPERATOR_EQ {
+				continue
+			}
+			m := rs.geoIndex(c.cond.Type)
+			m[c.cond.Value] = append(m[c.cond.Value], idx)
+			return true
+		}
+	}
+	return false
+}
+
+func (rs *ruleSet) geoIndex(t common.ConditionType) map[string][]int {
+	switch t {
+	case common.ConditionType_CONDITION_TYPE_GEO_COUNTRY:
+		return rs.country
+	case common.ConditionType_CONDITION_TYPE_GEO_CITY:
+		return rs.city
+	default:
+		return rs.isp
+	}
+}
+
+func compileCondition(cond *pb.Condition) compiledCondition {
+	c := compiledCondition{cond: cond}
+	switch cond.Op {
+	case common.Operator_OPERATOR_REGEX:
+		c.re = getCompiledRegex(cond.Value)
+		c.invalid = c.re == nil
+	case common.Operator_OPERATOR_CIDR:
+		prefix, ok := parsePrefix(cond.Value)
+		c.prefix, c.invalid = prefix, !ok
+	}
+	if cond.Type == common.ConditionType_CONDITION_TYPE_TIME_RANGE {
+		start, end, ok := parseTimeRange(cond.Value)
+		c.startMins, c.endMins, c.invalid = start, end, !ok
+	}
+	return c
+}
+
+// parsePrefix parses a CIDR the way net.ParseCIDR does, with IPv4-mapped
+// IPv6 prefixes folded into IPv4 to match net.IPNet.Contains.
+func parsePrefix(s string) (netip.Prefix, bool) {
+	prefix, err := netip.ParsePrefix(s)
+	if err != nil {
+		return netip.Prefix{}, false
+	}
+	if addr := prefix.Addr(); addr.Is4In6() {
+		if prefix.Bits() < 96 {
+			return netip.Prefix{}, false
+		}
+		prefix = netip.PrefixFrom(addr.Unmap(), prefix.Bits()-96)
+	}
+	return prefix.Masked(), true
+}
+
+// ruleInput holds the per-connection values rules are matched against.
+// TLS state is read when the first TLS condition is evaluated, so only
+// connections reaching a TLS rule wait for the handshake.
+type ruleInput struct {
+	conn net.Conn
+	host string
+	addr netip.Addr // Invalid if host is not an IP
+	geo  *pbCommon.GeoInfo
+
+	tlsRead  bool
+	tlsState *tls.ConnectionState // nil if the connection is not TLS
+}
+
+// tls returns the connection's TLS state, completing the handshake once.
+func (in *ruleInput) tls() *tls.ConnectionState {
+	if !in.tlsRead {
+		in.tlsState = getTLSState(in.conn)
+		in.tlsRead = true
+	}
+	return in.tlsState
+}
+
+// match returns the highest-priority rule matching the connection, or nil.
+func (rs *ruleSet) match(conn net.Conn, geo *pbCommon.GeoInfo) *pb.Rule {
+	in := ruleInput{conn: conn, geo: geo}
+	host, _, err := net.SplitHostPort(conn.RemoteAddr().String())
+	if err != nil {
+		host = conn.RemoteAddr().String() // Fallback
+	}
+	in.host = host
+	if addr, err := netip.ParseAddr(host); err == nil {
+		in.addr = addr.Unmap()
+	}
+
+	// Candidates from the indexes, merged in priority order with the
+	// rules that have to be scanned
+	var buf [16]int
+	candidates := append(buf[:0], rs.exactIP[host]...)
+	if in.addr.Is4() {
+		candidates = rs.v4.lookup(in.addr, candidates)
+	} else if in.addr.IsValid() {
+		candidates = rs.v6.lookup(in.addr, candidates)
+	}
+	if geo != nil {
+		candidates = append(candidates, rs.country[geo.Country]...)
+		candidates = append(candidates, rs.city[geo.City]...)
+		candidates = append(candidates, rs.isp[geo.Isp]...)
+	}
+	slices.Sort(candidates)
+
+	scan := rs.scan
+	for len(candidates) > 0 || len(scan) > 0 {
+		var idx int
+		if len(scan) == 0 || (len(candidates) > 0 && candidates[0] < scan[0]) {
+			idx, candidates = candidates[0], candidates[1:]
+		} else {
+			idx, scan = scan[0], scan[1:]
+		}
+		if rs.rules[idx].matches(&in) {
+			return rs.rules[idx].rule
+		}
+	}
+	return nil
+}
+
+// matches reports whether the rule is active and all conditions match
+// (AND logic).
+func (cr *compiledRule) matches(in *ruleInput) bool {
+	if cr.lifetime != nil && !cr.lifetime.active(time.Now()) {
+		return false
+	}
+	for i := range cr.conds {
+		if !cr.conds[i].matches(in) {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *compiledCondition) matches(in *ruleInput) bool {
+	var matched bool
+	switch c.cond.Type {
+	case common.ConditionType_CONDITION_TYPE_SOURCE_IP:
+		if c.cond.Op == common.Operator_OPERATOR_CIDR {
+			matched = !c.invalid && in.addr.IsValid() && c.prefix.Contains(in.addr)
+		} else {
+			matched = c.matchString(in.host)
+		}
+
+	case common.ConditionType_CONDITION_TYPE_GEO_COUNTRY:
+		if in.geo == nil {
+			return false
+		}
+		matched = c.matchString(in.geo.Country)
+
+	case common.ConditionType_CONDITION_TYPE_GEO_CITY:
+		if in.geo == nil {
+			return false
+		}
+		matched = c.matchString(in.geo.City)
+
+	case common.ConditionType_CONDITION_TYPE_GEO_ISP:
+		if in.geo == nil {
+			return false
+		}
+		matched = c.matchString(in.geo.Isp)
+
+	case common.ConditionType_CONDITION_TYPE_TIME_RANGE:
+		matched = !c.invalid && inTimeRange(c.startMins, c.endMins, time.Now())
+
+	default:
+		// TLS attributes come from the handshake, done on first use
+		return matchTLSCondition(c.cond, in.tls())
+	}
+
+	if c.cond.Negate {
+		return !matched
+	}
+	return matched
+}
+
+// matchString is matchString with the value parsed at compile time.
+func (c *compiledCondition) matchString(actual string) bool {
+	switch c.cond.Op {
+	case common.Operator_OPERATOR_EQ:
+		return c.cond.Value == actual
+	case common.Operator_OPERATOR_CONTAINS:
+		return strings.Contains(actual, c.cond.Value)
+	case common.Operator_OPERATOR_REGEX:
+		return c.re != nil && c.re.MatchString(actual)
+	case common.Operator_OPERATOR_CIDR:
+		addr, err := netip.ParseAddr(actual)
+		return err == nil && !c.invalid && c.prefix.Contains(addr.Unmap())
+	default:
+		return false
+	}
+}
+
+// cidrTrie is a binary radix tree of CIDR prefixes of one address family.
+type cidrTrie struct {
+	root *cidrNode
+}
+
+type cidrNode struct {
+	child [2]*cidrNode
+	rules []int // Rules keyed on the prefix ending at this node
+}
+
+func (t *cidrTrie) insert(prefix netip.Prefix, idx int) {
+	if t.root == nil {
+		t.root = &cidrNode{}
+	}
+	n := t.root
+	bytes := prefix.Addr().AsSlice()
+	for i := 0; i < prefix.Bits(); i++ {
+		bit := bytes[i/8] >> (7 - i%8) & 1
+		if n.child[bit] == nil {
+			n.child[bit] = &cidrNode{}
+		}
+		n = n.child[bit]
+	}
+	n.rules = append(n.rules, idx)
+}
+
+// lookup appends the rules of every prefix containing addr to dst.
+func (t *cidrTrie) lookup(addr netip.Addr, dst []int) []int {
+	n := t.root
+	if n == nil {
+		return dst
+	}
+	bytes := addr.AsSlice()
+	for i := 0; ; i++ {
+		dst = append(dst, n.rules...)
+		if i == len(bytes)*8 {
+			return dst
+		}
+		if n = n.child[bytes[i/8]>>(7-i%8)&1]; n == nil {
+			return dst
+		}
+	}
+}
@@ -0,0 +1,195 @@
+package node
+
+import (
+	"fmt"
+	"math/rand"
+	"net"
+	"testing"
+
+	"github.com/ivere27/nitella/pkg/api/common"
+	pbProxy "github.com/ivere27/nitella/pkg/api/proxy"
+)
+
+// addrConn is a net.Conn with only a remote address, for rule matching.
+type addrConn struct {
+	net.Conn
+	addr net.Addr
+}
+
+func (c addrConn) RemoteAddr() net.Addr { return c.addr }
+
+func connFrom(ip string) net.Conn {
+	return addrConn{addr: &net.TCPAddr{IP: net.ParseIP(ip), Port: 40000}}
+}
+
+// linearMatch is the reference: the first rule in priority order that
+// MatchRule accepts.
+func linearMatch(rules []*pbProxy.Rule, conn net.Conn, geo *common.GeoInfo) *pbProxy.Rule {
+	for _, rule := range rules {
+		if MatchRule(rule, conn, geo) {
+			return rule
+		}
+	}
+	return nil
+}
+
+func cond(t common.ConditionType, op common.Operator, value string) *pbProxy.Condition {
+	return &pbProxy.Condition{Type: t, Op: op, Value: value}
+}
+
+func TestRuleSetPriority(t *testing.T) {
+	const (
+		srcIP   = common.ConditionType_CONDITION_TYPE_SOURCE_IP
+		country = common.ConditionType_CONDITION_TYPE_GEO_COUNTRY
+		eq      = common.Operator_OPERATOR_EQ
+		cidr    = common.Operator_OPERATOR_CIDR
+	)
+	l := NewEmbeddedListener("test-ruleset", "Rule Set", "127.0.0.1:0", "", common.ActionType_ACTION_TYPE_ALLOW, common.MockPreset_MOCK_PRESET_UNSPECIFIED, "", "", "", pbProxy.ClientAuthType_CLIENT_AUTH_AUTO, nil)
+	add := func(id string, priority int32, conds ...*pbProxy.Condition) {
+		l.AddRule(&pbProxy.Rule{Id: id, Priority: priority, Enabled: true, Action: common.ActionType_ACTION_TYPE_BLOCK, Conditions: conds})
+	}
+	add("lan", 10, cond(srcIP, cidr, "10.0.0.0/8"))
+	add("host", 20, cond(srcIP, eq, "10.1.2.3"))
+	add("subnet-not-kr", 30, cond(srcIP, cidr, "10.1.0.0/16"), &pbProxy.Condition{Type: country, Op: eq, Value: "KR", Negate: true})
+	add("kr", 5, cond(country, eq, "KR"))
+	add("v6", 10, cond(srcIP, cidr, "2001:db8::/32"))
+	add("mapped", 1, cond(srcIP, cidr, "::ffff:192.0.2.0/120"))
+	add("catch-all", 0)
+	add("disabled", 100, cond(srcIP, cidr, "0.0.0.0/0"))
+	l.rules[0].Enabled = false
+
+	tests := []struct {
+		ip, country, want string
+	}{
+		{"10.1.2.3", "US", "subnet-not-kr"},
+		{"10.1.2.3", "KR", "host"},
+		{"10.9.9.9", "KR", "lan"},
+		{"172.16.0.1", "KR", "kr"},
+		{"2001:db8::1", "", "v6"},
+		{"192.0.2.7", "", "mapped"},
+		{"::ffff:192.0.2.7", "", "mapped"},
+		{"198.51.100.1", "", "catch-all"},
+	}
+	for _, tt := range tests {
+		geo := &common.GeoInfo{Country: tt.country}
+		rule, _ := l.evaluateRules(connFrom(tt.ip), geo)
+		if rule == nil || rule.Id != tt.want {
+			t.Errorf("%s/%s: expected %s, got %v", tt.ip, tt.country, tt.want, rule)
+		}
+	}
+
+	if err := l.RemoveRule("catch-all"); err != nil {
+		t.Fatal(err)
+	}
+	if rule, _ := l.evaluateRules(connFrom("198.51.100.1"), nil); rule != nil {
+		t.Errorf("Expected no match after removing catch-all, got %s", rule.Id)
+	}
+}
+
+// randomRules builds n rules over a small value space so that indexed,
+// scanned, negated and invalid conditions overlap.
+func randomRules(r *rand.Rand, n int) []*pbProxy.Rule {
+	countries := []string{"KR", "US", "CN", "DE"}
+	rules := make([]*pbProxy.Rule, 0, n)
+	for i := 0; i < n; i++ {
+		var conds []*pbProxy.Condition
+		for j := r.Intn(3); j >= 0; j-- {
+			var c *pbProxy.Condition
+			switch r.Intn(8) {
+			case 0:
+				c = cond(common.ConditionType_CONDITION_TYPE_SOURCE_IP, common.Operator_OPERATOR_EQ, fmt.Sprintf("10.0.%d.%d", r.Intn(4), r.Intn(4)))
+			case 1, 2:
+				c = cond(common.ConditionType_CONDITION_TYPE_SOURCE_IP, common.Operator_OPERATOR_CIDR, fmt.Sprintf("10.%d.0.0/%d", r.Intn(2), 8+r.Intn(17)))
+			case 3:
+				c = cond(common.ConditionType_CONDITION_TYPE_SOURCE_IP, common.Operator_OPERATOR_CIDR, fmt.Sprintf("2001:db8:%x::/%d", r.Intn(4), 32+r.Intn(17)))
+			case 4:
+				c = cond(common.ConditionType_CONDITION_TYPE_GEO_COUNTRY, common.Operator_OPERATOR_EQ, countries[r.Intn(len(countries))])
+			case 5:
+				c = cond(common.ConditionType_CONDITION_TYPE_GEO_ISP, common.Operator_OPERATOR_REGEX, "^(Amazon|Google)")
+			case 6:
+				c = cond(common.ConditionType_CONDITION_TYPE_SOURCE_IP, common.Operator_OPERATOR_CONTAINS, fmt.Sprintf(".%d.", r.Intn(4)))
+			case 7:
+				c = cond(common.ConditionType_CONDITION_TYPE_SOURCE_IP, common.Operator_OPERATOR_CIDR, "not-a-cidr")
+			}
+			c.Negate = r.Intn(5) == 0
+			conds = append(conds, c)
+		}
+		rules = append(rules, &pbProxy.Rule{Id: fmt.Sprintf("rule-%d", i), Enabled: r.Intn(10) > 0, Conditions: conds})
+	}
+	return rules
+}
+
+func TestRuleSetMatchesLinearScan(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	isps := []string{"Amazon.com", "Google LLC", "Comcast"}
+	for round := 0; round < 50; round++ {
+		rules := randomRules(r, 1+r.Intn(40))
+		rs := compileRuleSet(rules)
+		for i := 0; i < 200; i++ {
+			ip := fmt.Sprintf("10.%d.%d.%d", r.Intn(2), r.Intn(4), r.Intn(4))
+			if r.Intn(4) == 0 {
+				ip = fmt.Sprintf("2001:db8:%x::%x", r.Intn(4), r.Intn(16))
+			}
+			var geo *common.GeoInfo
+			if r.Intn(4) > 0 {
+				geo = &common.GeoInfo{Country: []string{"KR", "US", "CN", "DE"}[r.Intn(4)], Isp: isps[r.Intn(len(isps))]}
+			}
+			conn := connFrom(ip)
+			if got, want := rs.match(conn, geo), linearMatch(rules, conn, geo); got != want {
+				t.Fatalf("round %d, %s %v: compiled matched %v, linear matched %v", round, ip, geo, got, want)
+			}
+		}
+	}
+}
+
+// blocklistRules mimics an imported blocklist plus per-IP approvals: n
+// CIDR and single-IP block rules followed by a few geo rules.
+func blocklistRules(n int) []*pbProxy.Rule {
+	rules := make([]*pbProxy.Rule, 0, n+3)
+	for i := 0; i < n; i++ {
+		c := cond(common.ConditionType_CONDITION_TYPE_SOURCE_IP, common.Operator_OPERATOR_CIDR, fmt.Sprintf("%d.%d.%d.0/24", 11+i>>16, i>>8&0xff, i&0xff))
+		if i%2 == 1 {
+			c = cond(common.ConditionType_CONDITION_TYPE_SOURCE_IP, common.Operator_OPERATOR_EQ, fmt.Sprintf("%d.%d.%d.1", 11+i>>16, i>>8&0xff, i&0xff))
+		}
+		rules = append(rules, &pbProxy.Rule{Id: fmt.Sprintf("block-%d", i), Enabled: true, Action: common.ActionType_ACTION_TYPE_BLOCK, Conditions: []*pbProxy.Condition{c}})
+	}
+	return append(rules,
+		&pbProxy.Rule{Id: "cn", Enabled: true, Conditions: []*pbProxy.Condition{cond(common.ConditionType_CONDITION_TYPE_GEO_COUNTRY, common.Operator_OPERATOR_EQ, "CN")}},
+		&pbProxy.Rule{Id: "cloud", Enabled: true, Conditions: []*pbProxy.Condition{cond(common.ConditionType_CONDITION_TYPE_GEO_ISP, common.Operator_OPERATOR_REGEX, "^(Amazon|Google)")}},
+		&pbProxy.Rule{Id: "office", Enabled: true, Conditions: []*pbProxy.Condition{cond(common.ConditionType_CONDITION_TYPE_TIME_RANGE, common.Operator_OPERATOR_EQ, "09:00-18:00")}},
+	)
+}
+
+// BenchmarkRuleEvaluation compares the linear scan with the compiled rule
+// set for a connection that matches none of the blocklist rules, the worst
+// case for a scan.
+func BenchmarkRuleEvaluation(b *testing.B) {
+	conn := connFrom("203.0.113.9")
+	geo := &common.GeoInfo{Country: "KR", Isp: "Comcast"}
+	for _, n := range []int{10, 100, 1000, 10000} {
+		rules := blocklistRules(n)
+		b.Run(fmt.Sprintf("linear/%d", n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				linearMatch(rules, conn, geo)
+			}
+		})
+		b.Run(fmt.Sprintf("compiled/%d", n), func(b *testing.B) {
+			rs := compileRuleSet(rules)
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				rs.match(conn, geo)
+			}
+		})
+	}
+}
+
+func BenchmarkRuleSetCompile(b *testing.B) {
+	for _, n := range []int{1000, 10000} {
+		rules := blocklistRules(n)
+		b.Run(fmt.Sprint(n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				compileRuleSet(rules)
+			}
+		})
+	}
+}
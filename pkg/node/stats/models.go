@@ -24,6 +24,9 @@ type ConnectionLog struct {
 	GeoCountry string    `xorm:"varchar(8) index"`
 	GeoCity    string    `xorm:"varchar(128)"`
 	GeoISP     string    `xorm:"varchar(256)"`
+	ConnID     string    `xorm:"varchar(64) index"` // Listener connection ID
+	Transcript string    `xorm:"text"`              // Fake shell session (MOCK only)
+	Thresholds string    `xorm:"varchar(128)"`      // Thresholds crossed, e.g. "bytes_out:close"
 }
 
 // TableName returns the table name for XORM
@@ -225,6 +225,38 @@ func (s *StatsService) GetRawLogs(filter *RawLogFilter) ([]*ConnectionLog, int64
 	return logs, total, nil
 }
 
+// TranscriptFilter defines filters for querying honeypot session transcripts.
+type TranscriptFilter struct {
+	ConnID   string
+	SourceIP string
+	Limit    int
+}
+
+// GetTranscripts retrieves MOCK connection logs that recorded a fake shell
+// session, newest first.
+func (s *StatsService) GetTranscripts(filter *TranscriptFilter) ([]*ConnectionLog, error) {
+	if filter == nil {
+		filter = &TranscriptFilter{}
+	}
+	if filter.Limit <= 0 {
+		filter.Limit = 20
+	}
+
+	query := s.db.NewSession()
+	defer query.Close()
+
+	query = query.Where("transcript IS NOT NULL AND transcript != ''")
+
+	// Non-zero fields of the bean become equality conditions
+	cond := &ConnectionLog{ConnID: filter.ConnID, SourceIP: filter.SourceIP}
+
+	var logs []*ConnectionLog
+	if err := query.Desc("first_seen").Limit(filter.Limit).Find(&logs, cond); err != nil {
+		return nil, err
+	}
+	return logs, nil
+}
+
 // GetTopBlockedIPs returns the most frequently blocked IPs.
 func (s *StatsService) GetTopBlockedIPs(limit int) ([]*IPStatsResult, error) {
 	if limit <= 0 {
@@ -24,6 +24,9 @@ type ConnectionEvent struct {
 	Action     int32 // 0=ALLOW, 1=BLOCK, 2=MOCK
 	RuleID     string
 	Geo        *pbCommon.GeoInfo
+	ConnID     string
+	Transcript string // Fake shell transcript for MOCK connections
+	Thresholds string // Thresholds crossed while open, e.g. "bytes_out:close"
 }
 
 // StatsService manages connection statistics collection and retrieval.
@@ -44,6 +47,9 @@ type StatsService struct {
 	samplingRate           int
 	sampleCounter          atomic.Int64
 
+	// Events dropped over a full queue
+	dropped atomic.Int64
+
 	// Batch processing
 	batchSize     int
 	flushInterval time.Duration
@@ -173,9 +179,9 @@ func (s *StatsService) RecordConnection(event *ConnectionEvent) {
 		return
 	}
 
-	// Sampling
+	// Sampling (honeypot transcripts and threshold crossings are always kept)
 	sampleRate := s.getSamplingRate()
-	if sampleRate > 1 {
+	if sampleRate > 1 && event.Transcript == "" && event.Thresholds == "" {
 		count := s.sampleCounter.Add(1)
 		if count%int64(sampleRate) != 0 {
 			return
@@ -187,9 +193,20 @@ func (s *StatsService) RecordConnection(event *ConnectionEvent) {
 	case s.eventCh <- event:
 	default:
 		// Buffer full, drop event
+		s.dropped.Add(1)
 	}
 }
 
+// QueueDepth returns the number of events waiting to be written.
+func (s *StatsService) QueueDepth() int {
+	return len(s.eventCh)
+}
+
+// DroppedEvents returns the number of events dropped over a full queue.
+func (s *StatsService) DroppedEvents() int64 {
+	return s.dropped.Load()
+}
+
 func (s *StatsService) getSamplingRate() int {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
@@ -258,6 +275,9 @@ func (s *StatsService) processBatch(events []*ConnectionEvent) {
 			DurationMs: event.EndTime.Sub(event.StartTime).Milliseconds(),
 			Action:     event.Action,
 			RuleID:     event.RuleID,
+			ConnID:     event.ConnID,
+			Transcript: event.Transcript,
+			Thresholds: event.Thresholds,
 		}
 		if event.Geo != nil {
 			log.GeoCountry = event.Geo.Country
@@ -266,3 +266,77 @@ func TestStatsService_Configuration(t *testing.T) {
 		t.Errorf("Expected samplingRate 2, got %d", samplingRate)
 	}
 }
+
+func TestStatsService_Transcripts(t *testing.T) {
+	tmpFile, err := os.CreateTemp("", "stats_test_*.db")
+	if err != nil {
+		t.Fatalf("Failed to create temp file: %v", err)
+	}
+	tmpFile.Close()
+	defer os.Remove(tmpFile.Name())
+
+	svc, err := NewStatsService(tmpFile.Name())
+	if err != nil {
+		t.Fatalf("Failed to create stats service: %v", err)
+	}
+	if err := svc.Start(); err != nil {
+		t.Fatalf("Failed to start service: %v", err)
+	}
+	defer svc.Stop()
+
+	// Transcripts must survive sampling
+	svc.Configure(true, 0, 0, 0, 0, 100)
+
+	now := time.Now()
+	svc.RecordConnection(&ConnectionEvent{
+		SourceIP:   "203.0.113.9",
+		StartTime:  now,
+		EndTime:    now.Add(time.Second),
+		Action:     2, // MOCK
+		ConnID:     "conn-1",
+		Transcript: "root@web01:~# uname\nLinux\n",
+	})
+	svc.RecordConnection(&ConnectionEvent{
+		SourceIP:  "203.0.113.9",
+		StartTime: now,
+		EndTime:   now.Add(time.Second),
+		Action:    2,
+		ConnID:    "conn-2",
+	})
+
+	time.Sleep(2 * time.Second)
+
+	logs, err := svc.GetTranscripts(&TranscriptFilter{SourceIP: "203.0.113.9"})
+	if err != nil {
+		t.Fatalf("GetTranscripts failed: %v", err)
+	}
+	if len(logs) != 1 {
+		t.Fatalf("Expected 1 transcript, got %d", len(logs))
+	}
+	if logs[0].ConnID != "conn-1" || logs[0].Transcript != "root@web01:~# uname\nLinux\n" {
+		t.Errorf("Unexpected transcript log: %+v", logs[0])
+	}
+
+	logs, err = svc.GetTranscripts(&TranscriptFilter{ConnID: "conn-2"})
+	if err != nil {
+		t.Fatalf("GetTranscripts failed: %v", err)
+	}
+	if len(logs) != 0 {
+		t.Errorf("Expected no transcript for conn-2, got %d", len(logs))
+	}
+}
+
+func TestStatsService_DroppedEvents(t *testing.T) {
+	svc := &StatsService{eventCh: make(chan *ConnectionEvent, 1)}
+	svc.enabled.Store(true)
+
+	for i := 0; i < 3; i++ {
+		svc.RecordConnection(&ConnectionEvent{SourceIP: "192.0.2.1"})
+	}
+	if svc.QueueDepth() != 1 {
+		t.Errorf("Expected 1 queued event, got %d", svc.QueueDepth())
+	}
+	if svc.DroppedEvents() != 2 {
+		t.Errorf("Expected 2 dropped events, got %d", svc.DroppedEvents())
+	}
+}
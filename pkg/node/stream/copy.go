@@ -8,12 +8,16 @@ import (
 // UserspaceCopy performs a copy using a pooled userspace buffer.
 // This is the fallback for non-Linux systems or non-TCP connections (TLS).
 func UserspaceCopy(dst io.Writer, src io.Reader, written *int64) (int64, error) {
+	return userspaceCopy(dst, src, written, nil)
+}
+
+func userspaceCopy(dst io.Writer, src io.Reader, written *int64, t *Throttle) (int64, error) {
 	buf := GetBuffer()
 	defer PutBuffer(buf)
 
 	var total int64
 	for {
-		nr, er := src.Read(buf)
+		nr, er := src.Read(buf[:t.chunk(len(buf))])
 		if nr > 0 {
 			nw, ew := dst.Write(buf[0:nr])
 			if nw > 0 {
@@ -22,6 +26,7 @@ func UserspaceCopy(dst io.Writer, src io.Reader, written *int64) (int64, error)
 				if written != nil {
 					atomic.AddInt64(written, n)
 				}
+				t.wait(n)
 			}
 			if ew != nil {
 				return total, ew
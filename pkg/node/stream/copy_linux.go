@@ -22,6 +22,12 @@ const (
 //
 // Returns the number of bytes copied and any error occurred.
 func SpliceProxy(dst io.Writer, src io.Reader, written *int64) (int64, error) {
+	return SpliceProxyThrottled(dst, src, written, nil)
+}
+
+// SpliceProxyThrottled is SpliceProxy limited by t, which may be engaged
+// while the copy runs.
+func SpliceProxyThrottled(dst io.Writer, src io.Reader, written *int64, t *Throttle) (int64, error) {
 	// 1. Unwrap to get raw file descriptors
 	// We need *net.TCPConn to access the SyscallConn
 	srcTCP, srcOK := asTCPConn(src)
@@ -29,17 +35,17 @@ func SpliceProxy(dst io.Writer, src io.Reader, written *int64) (int64, error) {
 
 	// If either side is not a TCP connection (e.g. TLS, Mock, Buffer), fallback to userspace copy
 	if !srcOK || !dstOK {
-		return UserspaceCopy(dst, src, written)
+		return userspaceCopy(dst, src, written, t)
 	}
 
 	// 2. Get Raw FDs
 	srcRC, err := srcTCP.SyscallConn()
 	if err != nil {
-		return UserspaceCopy(dst, src, written)
+		return userspaceCopy(dst, src, written, t)
 	}
 	dstRC, err := dstTCP.SyscallConn()
 	if err != nil {
-		return UserspaceCopy(dst, src, written)
+		return userspaceCopy(dst, src, written, t)
 	}
 
 	var total int64
@@ -50,7 +56,7 @@ func SpliceProxy(dst io.Writer, src io.Reader, written *int64) (int64, error) {
 	// We need a temporary pipe.
 	var pipe [2]int
 	if err := syscall.Pipe2(pipe[:], syscall.O_CLOEXEC|syscall.O_NONBLOCK); err != nil {
-		return UserspaceCopy(dst, src, written)
+		return userspaceCopy(dst, src, written, t)
 	}
 	defer syscall.Close(pipe[0])
 	defer syscall.Close(pipe[1])
@@ -66,7 +72,7 @@ func SpliceProxy(dst io.Writer, src io.Reader, written *int64) (int64, error) {
 
 		// Step A: Splice from SRC to PIPE
 		readErr = srcRC.Read(func(fd uintptr) bool {
-			n, errS = syscall.Splice(int(fd), nil, pipe[1], nil, maxSplice, SPLICE_F_MOVE|SPLICE_F_NONBLOCK)
+			n, errS = syscall.Splice(int(fd), nil, pipe[1], nil, t.chunk(maxSplice), SPLICE_F_MOVE|SPLICE_F_NONBLOCK)
 			if errS == syscall.EAGAIN {
 				return false // Wait for read readiness
 			}
@@ -112,6 +118,7 @@ func SpliceProxy(dst io.Writer, src io.Reader, written *int64) (int64, error) {
 		if written != nil {
 			atomic.AddInt64(written, n)
 		}
+		t.wait(n)
 	}
 }
 
@@ -8,5 +8,10 @@ import (
 
 // SpliceProxy is a stub for non-Linux systems. It falls back to UserspaceCopy.
 func SpliceProxy(dst io.Writer, src io.Reader, written *int64) (int64, error) {
-	return UserspaceCopy(dst, src, written)
+	return userspaceCopy(dst, src, written, nil)
+}
+
+// SpliceProxyThrottled is SpliceProxy limited by t.
+func SpliceProxyThrottled(dst io.Writer, src io.Reader, written *int64, t *Throttle) (int64, error) {
+	return userspaceCopy(dst, src, written, t)
 }
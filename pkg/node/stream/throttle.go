@@ -0,0 +1,68 @@
+package stream
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// minThrottleChunk is the smallest read while throttled, so a low rate
+// still moves data in reasonably sized writes.
+const minThrottleChunk = 1024
+
+// Throttle limits the throughput of the copies it is passed to. It starts
+// unlimited and can be engaged while a copy is running; copies sharing a
+// Throttle share its rate. A nil *Throttle never limits.
+type Throttle struct {
+	rate atomic.Int64 // Bytes per second, 0 = unlimited
+
+	mu   sync.Mutex
+	next time.Time // When the bytes sent so far are paid for
+}
+
+// SetRate limits the copies to bytesPerSec (0 = unlimited).
+func (t *Throttle) SetRate(bytesPerSec int64) {
+	t.rate.Store(bytesPerSec)
+}
+
+// Rate returns the current limit in bytes per second (0 = unlimited).
+func (t *Throttle) Rate() int64 {
+	if t == nil {
+		return 0
+	}
+	return t.rate.Load()
+}
+
+// chunk returns how much to read at once: max when unlimited, otherwise
+// about a tenth of a second's worth.
+func (t *Throttle) chunk(max int) int {
+	rate := t.Rate()
+	if rate <= 0 {
+		return max
+	}
+	n := int(rate / 10)
+	if n < minThrottleChunk {
+		n = minThrottleChunk
+	}
+	if n > max {
+		n = max
+	}
+	return n
+}
+
+// wait blocks until n more bytes fit within the rate.
+func (t *Throttle) wait(n int64) {
+	rate := t.Rate()
+	if rate <= 0 {
+		return
+	}
+	t.mu.Lock()
+	now := time.Now()
+	if t.next.Before(now) {
+		t.next = now
+	}
+	t.next = t.next.Add(time.Duration(n * int64(time.Second) / rate))
+	d := t.next.Sub(now)
+	t.mu.Unlock()
+	time.Sleep(d)
+}
@@ -0,0 +1,202 @@
+package node
+
+import (
+	"sync"
+	"time"
+
+	process_pb "github.com/ivere27/nitella/pkg/api/process"
+	pb "github.com/ivere27/nitella/pkg/api/proxy"
+	"github.com/ivere27/nitella/pkg/log"
+)
+
+const (
+	// tarpitConnOverhead estimates the memory a tarpitted connection holds
+	// besides its payload: goroutine stack, buffers and connection state.
+	tarpitConnOverhead = 32 << 10
+
+	// defaultTarpitDripIntervalMs is the drip interval mockproto uses when
+	// none is configured.
+	defaultTarpitDripIntervalMs = 1000
+
+	// defaultMaxAdaptiveDrip caps the adaptive drip interval.
+	defaultMaxAdaptiveDrip = 10 * time.Second
+
+	// adaptiveTarpitWindow is how far back repeat visits count.
+	adaptiveTarpitWindow = int(TarpitHistoryMaxAge / time.Second)
+)
+
+// TarpitBudgetConfig bounds the resources held by tarpitted mock
+// connections across all listeners of a node.
+type TarpitBudgetConfig struct {
+	MaxConns  int   // Concurrent tarpitted connections (0 = unlimited, negative = none)
+	MaxMemory int64 // Estimated bytes held by tarpits (0 = unlimited, negative = none)
+
+	// Adaptive doubles the drip interval for each recent repeat visit of
+	// a source, up to MaxDripInterval (default 10s).
+	Adaptive        bool
+	MaxDripInterval time.Duration
+}
+
+// share returns the i-th of n even shares of the budget limits. Shares
+// of a limit too small to split among n get none.
+func (cfg TarpitBudgetConfig) share(i, n int) TarpitBudgetConfig {
+	split := func(limit int64) int64 {
+		if limit <= 0 {
+			return limit
+		}
+		s := limit / int64(n)
+		if int64(i) < limit%int64(n) {
+			s++
+		}
+		if s == 0 {
+			return -1
+		}
+		return s
+	}
+	cfg.MaxConns = int(split(int64(cfg.MaxConns)))
+	cfg.MaxMemory = split(cfg.MaxMemory)
+	return cfg
+}
+
+// toProto converts the budget for a child process.
+func (cfg TarpitBudgetConfig) toProto() *process_pb.TarpitBudget {
+	return &process_pb.TarpitBudget{
+		MaxConns:          int64(cfg.MaxConns),
+		MaxMemoryBytes:    cfg.MaxMemory,
+		Adaptive:          cfg.Adaptive,
+		MaxDripIntervalMs: cfg.MaxDripInterval.Milliseconds(),
+	}
+}
+
+// TarpitBudgetFromProto converts a budget sent to a child process.
+func TarpitBudgetFromProto(b *process_pb.TarpitBudget) TarpitBudgetConfig {
+	return TarpitBudgetConfig{
+		MaxConns:        int(b.GetMaxConns()),
+		MaxMemory:       b.GetMaxMemoryBytes(),
+		Adaptive:        b.GetAdaptive(),
+		MaxDripInterval: time.Duration(b.GetMaxDripIntervalMs()) * time.Millisecond,
+	}
+}
+
+// TarpitBudget admits tarpitted connections within a node-wide budget.
+// Connections over budget are closed immediately instead of tarpitted.
+// A nil budget admits everything.
+type TarpitBudget struct {
+	mu        sync.Mutex
+	cfg       TarpitBudgetConfig
+	active    int64
+	memory    int64
+	admitted  int64
+	rejected  int64
+	exhausted bool // logged once until a connection is admitted again
+}
+
+// NewTarpitBudget creates a tarpit budget.
+func NewTarpitBudget(cfg TarpitBudgetConfig) *TarpitBudget {
+	b := &TarpitBudget{}
+	b.Configure(cfg)
+	return b
+}
+
+// Configure replaces the budget limits. Connections already admitted
+// keep running.
+func (b *TarpitBudget) Configure(cfg TarpitBudgetConfig) {
+	if cfg.MaxDripInterval <= 0 {
+		cfg.MaxDripInterval = defaultMaxAdaptiveDrip
+	}
+	b.mu.Lock()
+	b.cfg = cfg
+	b.mu.Unlock()
+}
+
+// acquire reserves cost bytes for a tarpitted connection. It reports
+// false when the budget is exhausted.
+func (b *TarpitBudget) acquire(cost int64) bool {
+	if b == nil {
+		return true
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if (b.cfg.MaxConns != 0 && b.active >= int64(b.cfg.MaxConns)) ||
+		(b.cfg.MaxMemory != 0 && b.memory+cost > b.cfg.MaxMemory) {
+		b.rejected++
+		if !b.exhausted {
+			b.exhausted = true
+			log.Printf("[Tarpit] Budget exhausted (%d connections, %d bytes): fast-closing new tarpit connections", b.active, b.memory)
+		}
+		return false
+	}
+	b.active++
+	b.memory += cost
+	b.admitted++
+	b.exhausted = false
+	return true
+}
+
+// release returns a reservation made by acquire.
+func (b *TarpitBudget) release(cost int64) {
+	if b == nil {
+		return
+	}
+	b.mu.Lock()
+	b.active--
+	b.memory -= cost
+	b.mu.Unlock()
+}
+
+// dripInterval returns the drip interval for a source on its attempts-th
+// recent visit: base doubled per repeat visit when adaptive, capped.
+func (b *TarpitBudget) dripInterval(baseMs, attempts int) int {
+	if b == nil || attempts <= 1 {
+		return baseMs
+	}
+	b.mu.Lock()
+	adaptive, maxMs := b.cfg.Adaptive, int(b.cfg.MaxDripInterval/time.Millisecond)
+	b.mu.Unlock()
+	if !adaptive || baseMs >= maxMs {
+		return baseMs
+	}
+
+	interval := baseMs
+	for i := 1; i < attempts && interval < maxMs; i++ {
+		interval *= 2
+	}
+	return min(interval, maxMs)
+}
+
+// adaptive reports whether repeat offenders get a longer drip interval.
+func (b *TarpitBudget) adaptive() bool {
+	if b == nil {
+		return false
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.cfg.Adaptive
+}
+
+// Stats returns the current budget usage.
+func (b *TarpitBudget) Stats() *pb.TarpitStats {
+	if b == nil {
+		return &pb.TarpitStats{}
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return &pb.TarpitStats{
+		ActiveConns:    b.active,
+		MaxConns:       int64(b.cfg.MaxConns),
+		MemoryBytes:    b.memory,
+		MaxMemoryBytes: b.cfg.MaxMemory,
+		AdmittedTotal:  b.admitted,
+		RejectedTotal:  b.rejected,
+		Adaptive:       b.cfg.Adaptive,
+	}
+}
+
+// SetTarpitBudget configures the node-wide tarpit budget shared by all
+// in-process listeners. In process mode it is split evenly among the
+// running child processes.
+func (m *ProxyManager) SetTarpitBudget(cfg TarpitBudgetConfig) {
+	m.Tarpit.Configure(cfg)
+	m.tarpitShares.configure(cfg)
+}
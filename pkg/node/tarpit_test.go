@@ -0,0 +1,197 @@
+package node
+
+import (
+	"context"
+	"io"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ivere27/nitella/pkg/api/common"
+	process_pb "github.com/ivere27/nitella/pkg/api/process"
+	pbProxy "github.com/ivere27/nitella/pkg/api/proxy"
+	"google.golang.org/grpc"
+)
+
+func TestTarpitBudget_Limits(t *testing.T) {
+	b := NewTarpitBudget(TarpitBudgetConfig{MaxConns: 2, MaxMemory: 100})
+
+	if !b.acquire(40) || !b.acquire(40) {
+		t.Fatal("Expected first two connections admitted")
+	}
+	if b.acquire(1) {
+		t.Error("Expected connection limit to reject")
+	}
+	b.release(40)
+	if b.acquire(61) {
+		t.Error("Expected memory limit to reject")
+	}
+	if !b.acquire(60) {
+		t.Error("Expected connection within memory budget admitted")
+	}
+
+	st := b.Stats()
+	if st.ActiveConns != 2 || st.MemoryBytes != 100 || st.AdmittedTotal != 3 || st.RejectedTotal != 2 {
+		t.Errorf("Unexpected stats: %v", st)
+	}
+
+	// Raising the limits applies to new connections
+	b.Configure(TarpitBudgetConfig{})
+	if !b.acquire(1000) {
+		t.Error("Expected unlimited budget to admit")
+	}
+
+	var nilBudget *TarpitBudget
+	if !nilBudget.acquire(1) {
+		t.Error("Nil budget should admit everything")
+	}
+	nilBudget.release(1)
+}
+
+func TestTarpitBudget_AdaptiveDrip(t *testing.T) {
+	b := NewTarpitBudget(TarpitBudgetConfig{Adaptive: true, MaxDripInterval: 5 * time.Second})
+	tests := []struct{ base, attempts, want int }{
+		{1000, 1, 1000},
+		{1000, 2, 2000},
+		{1000, 3, 4000},
+		{1000, 4, 5000},
+		{1000, 50, 5000},
+		{8000, 3, 8000}, // already above the cap
+	}
+	for _, tt := range tests {
+		if got := b.dripInterval(tt.base, tt.attempts); got != tt.want {
+			t.Errorf("dripInterval(%d, %d) = %d, want %d", tt.base, tt.attempts, got, tt.want)
+		}
+	}
+
+	b.Configure(TarpitBudgetConfig{})
+	if got := b.dripInterval(1000, 5); got != 1000 {
+		t.Errorf("Expected no adaptation when disabled, got %d", got)
+	}
+}
+
+func TestTarpitBudgetFastClose(t *testing.T) {
+	l := NewEmbeddedListener("test-tarpit-budget", "Tarpit", "127.0.0.1:0", "", common.ActionType_ACTION_TYPE_MOCK, common.MockPreset_MOCK_PRESET_UNSPECIFIED, "", "", "", pbProxy.ClientAuthType_CLIENT_AUTH_AUTO, nil)
+	budget := NewTarpitBudget(TarpitBudgetConfig{MaxConns: 1})
+	l.SetTarpitBudget(budget)
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Listen failed: %v", err)
+	}
+	defer ln.Close()
+
+	rule := &pbProxy.Rule{
+		Id:           "tarpit-rule",
+		Action:       common.ActionType_ACTION_TYPE_MOCK,
+		MockResponse: &pbProxy.MockConfig{Preset: common.MockPreset_MOCK_PRESET_RAW_TARPIT},
+	}
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				l.HandleMockConnection(conn, rule, "conn", nil)
+			}()
+		}
+	}()
+
+	held, err := net.Dial("tcp", ln.Addr().String())
+	if err != nil {
+		t.Fatalf("Dial failed: %v", err)
+	}
+	defer held.Close()
+	deadline := time.Now().Add(2 * time.Second)
+	for budget.Stats().ActiveConns != 1 {
+		if time.Now().After(deadline) {
+			t.Fatal("First connection was not tarpitted")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	// Over budget: closed at once instead of tarpitted
+	rejected, err := net.Dial("tcp", ln.Addr().String())
+	if err != nil {
+		t.Fatalf("Dial failed: %v", err)
+	}
+	defer rejected.Close()
+	rejected.SetReadDeadline(time.Now().Add(2 * time.Second))
+	if _, err := io.ReadAll(rejected); err != nil {
+		if ne, ok := err.(net.Error); ok && ne.Timeout() {
+			t.Fatal("Expected over-budget connection to be closed immediately")
+		}
+	}
+	if st := budget.Stats(); st.RejectedTotal != 1 || st.ActiveConns != 1 {
+		t.Errorf("Unexpected stats: %v", st)
+	}
+}
+
+func TestTarpitBudget_Share(t *testing.T) {
+	cfg := TarpitBudgetConfig{MaxConns: 5, MaxMemory: 2, Adaptive: true}
+	var conns int
+	for i := 0; i < 3; i++ {
+		share := cfg.share(i, 3)
+		conns += share.MaxConns
+		if !share.Adaptive {
+			t.Error("Expected shares to keep the adaptive setting")
+		}
+	}
+	if conns != 5 {
+		t.Errorf("Expected shares to add up to 5 connections, got %d", conns)
+	}
+	if got := cfg.share(2, 3).MaxMemory; got != -1 {
+		t.Errorf("Expected no memory for a share of a too small budget, got %d", got)
+	}
+	if got := (TarpitBudgetConfig{}).share(0, 3).MaxConns; got != 0 {
+		t.Errorf("Expected an unlimited budget to stay unlimited, got %d", got)
+	}
+
+	none := NewTarpitBudget(TarpitBudgetConfig{MaxConns: -1})
+	if none.acquire(1) {
+		t.Error("Expected a share with no connections to reject")
+	}
+}
+
+// budgetClient records the tarpit budgets sent to a child process.
+type budgetClient struct {
+	process_pb.ProcessControlClient
+	mu     sync.Mutex
+	budget *process_pb.TarpitBudget
+}
+
+func (c *budgetClient) ConfigureTarpit(ctx context.Context, req *process_pb.ConfigureTarpitRequest, opts ...grpc.CallOption) (*process_pb.ConfigureTarpitResponse, error) {
+	c.mu.Lock()
+	c.budget = req.Budget
+	c.mu.Unlock()
+	return &process_pb.ConfigureTarpitResponse{Success: true}, nil
+}
+
+func TestTarpitShares_Rebalance(t *testing.T) {
+	shares := newTarpitShares()
+	shares.configure(TarpitBudgetConfig{MaxConns: 10})
+
+	a, b := &ProcessListener{ID: "a"}, &ProcessListener{ID: "b"}
+	ca, cb := &budgetClient{}, &budgetClient{}
+	if got := shares.join(a, ca).GetMaxConns(); got != 10 {
+		t.Errorf("Expected the only child to get the whole budget, got %d", got)
+	}
+	if got := shares.join(b, cb).GetMaxConns(); got != 5 {
+		t.Errorf("Expected the second child to get half, got %d", got)
+	}
+	if got := ca.budget.GetMaxConns(); got != 5 {
+		t.Errorf("Expected the first child's share cut to 5, got %d", got)
+	}
+
+	shares.leave(a)
+	if got := cb.budget.GetMaxConns(); got != 10 {
+		t.Errorf("Expected the remaining child to get the whole budget, got %d", got)
+	}
+	shares.configure(TarpitBudgetConfig{MaxConns: 4})
+	if got := cb.budget.GetMaxConns(); got != 4 {
+		t.Errorf("Expected a new budget sent to the child, got %d", got)
+	}
+}
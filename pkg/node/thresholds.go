@@ -0,0 +1,376 @@
+package node
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	pb "github.com/ivere27/nitella/pkg/api/proxy"
+	"github.com/ivere27/nitella/pkg/config"
+	"github.com/ivere27/nitella/pkg/log"
+	"github.com/ivere27/nitella/pkg/node/stream"
+)
+
+// Thresholds, as named in ConnectionEvent.threshold and ConnectionLog.
+const (
+	ThresholdBytesOut           = "bytes_out"
+	ThresholdSourceBytesOutHour = "source_bytes_out_hour"
+	ThresholdDuration           = "duration"
+)
+
+// SourceUsageCleanupInterval is how often idle sources are dropped from
+// the per-source bytes out counters.
+const SourceUsageCleanupInterval = time.Minute
+
+// thresholdsSet reports whether t limits anything.
+func thresholdsSet(t *pb.ConnectionThresholds) bool {
+	return t.GetMaxBytesOut() > 0 || t.GetMaxSourceBytesOutHour() > 0 || t.GetMaxDurationSeconds() > 0
+}
+
+// validateThresholds rejects negative limits.
+func validateThresholds(t *pb.ConnectionThresholds) error {
+	if t.GetMaxBytesOut() < 0 || t.GetMaxSourceBytesOutHour() < 0 || t.GetMaxDurationSeconds() < 0 || t.GetThrottleBytesPerSecond() < 0 {
+		return fmt.Errorf("connection thresholds must not be negative")
+	}
+	return nil
+}
+
+// thresholdsJSON serializes thresholds for the DB ("" when none are set).
+func thresholdsJSON(t *pb.ConnectionThresholds) string {
+	if !thresholdsSet(t) {
+		return ""
+	}
+	b, _ := json.Marshal(t)
+	return string(b)
+}
+
+// parseThresholdsJSON restores thresholds saved by thresholdsJSON.
+func parseThresholdsJSON(s string) *pb.ConnectionThresholds {
+	if s == "" {
+		return nil
+	}
+	var t pb.ConnectionThresholds
+	if err := json.Unmarshal([]byte(s), &t); err != nil {
+		log.Printf("Warning: Failed to parse connection thresholds: %v", err)
+		return nil
+	}
+	return &t
+}
+
+// thresholdActionName is the lower-case action name used in logs and
+// ConnectionLog.
+func thresholdActionName(a pb.ThresholdAction) string {
+	switch a {
+	case pb.ThresholdAction_THRESHOLD_ACTION_CLOSE:
+		return "close"
+	case pb.ThresholdAction_THRESHOLD_ACTION_THROTTLE:
+		return "throttle"
+	default:
+		return "alert"
+	}
+}
+
+// SetThresholds sets the connection thresholds of the listener. Rules with
+// thresholds of their own replace them; nil removes them.
+func (p *EmbeddedListener) SetThresholds(t *pb.ConnectionThresholds) {
+	if !thresholdsSet(t) {
+		t = nil
+	}
+	p.thresholds.Store(t)
+}
+
+// connThresholds returns the thresholds for a connection forwarded by rule
+// (nil for the default action), or nil if there are none.
+func (p *EmbeddedListener) connThresholds(rule *pb.Rule) *pb.ConnectionThresholds {
+	if rule != nil && thresholdsSet(rule.Thresholds) {
+		return rule.Thresholds
+	}
+	return p.thresholds.Load()
+}
+
+// sourceUsage counts the bytes sent to each source over the last hour, in
+// one-minute buckets.
+type sourceUsage struct {
+	mu      sync.Mutex
+	sources map[string]*sourceMinutes
+}
+
+type sourceMinutes struct {
+	bytes  [60]int64
+	minute [60]int64 // Unix minute each bucket counts
+	last   int64     // Last minute written
+}
+
+// add counts n bytes sent to ip and returns the total for the last hour.
+func (u *sourceUsage) add(ip string, n int64, now time.Time) int64 {
+	minute := now.Unix() / 60
+
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	if u.sources == nil {
+		u.sources = make(map[string]*sourceMinutes)
+	}
+	s := u.sources[ip]
+	if s == nil {
+		s = &sourceMinutes{}
+		u.sources[ip] = s
+	}
+	i := minute % 60
+	if s.minute[i] != minute {
+		s.minute[i], s.bytes[i] = minute, 0
+	}
+	s.bytes[i] += n
+	s.last = minute
+
+	var total int64
+	for j := range s.bytes {
+		if minute-s.minute[j] < 60 {
+			total += s.bytes[j]
+		}
+	}
+	return total
+}
+
+// prune drops sources with nothing sent in the last hour.
+func (u *sourceUsage) prune(now time.Time) {
+	minute := now.Unix() / 60
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	for ip, s := range u.sources {
+		if minute-s.last >= 60 {
+			delete(u.sources, ip)
+		}
+	}
+}
+
+func (p *EmbeddedListener) cleanupSourceUsage() {
+	p.sourceOut.prune(time.Now())
+}
+
+// thresholdMonitor checks one forwarded connection against its thresholds
+// and acts on each threshold the first time it is crossed.
+type thresholdMonitor struct {
+	p         *EmbeddedListener
+	limits    *pb.ConnectionThresholds
+	event     *pb.ConnectionEvent // Template for the threshold events
+	geo       *geoLookup
+	start     time.Time
+	bytesIn   *int64
+	bytesOut  *int64
+	throttle  *stream.Throttle
+	closeConn func()
+
+	counted int64    // Bytes out already counted toward the source
+	crossed []string // Thresholds crossed, in order
+	closed  bool
+	quit    chan struct{}
+	done    chan struct{}
+}
+
+// monitorThresholds starts checking a connection. The copies must use
+// throttle so a THROTTLE action can slow them down; closeConn must end them.
+func (p *EmbeddedListener) monitorThresholds(limits *pb.ConnectionThresholds, event *pb.ConnectionEvent, geo *geoLookup, start time.Time, bytesIn, bytesOut *int64, throttle *stream.Throttle, closeConn func()) *thresholdMonitor {
+	m := &thresholdMonitor{
+		p:         p,
+		limits:    limits,
+		event:     event,
+		geo:       geo,
+		start:     start,
+		bytesIn:   bytesIn,
+		bytesOut:  bytesOut,
+		throttle:  throttle,
+		closeConn: closeConn,
+		quit:      make(chan struct{}),
+		done:      make(chan struct{}),
+	}
+	go m.run()
+	return m
+}
+
+func (m *thresholdMonitor) run() {
+	defer close(m.done)
+	ticker := time.NewTicker(config.ThresholdCheckInterval)
+	defer ticker.Stop()
+
+	// A source already over its hourly budget is caught straight away
+	m.check(time.Now())
+	for {
+		select {
+		case <-m.quit:
+			m.countSource(time.Now())
+			return
+		case now := <-ticker.C:
+			if !m.closed {
+				m.check(now)
+			}
+		}
+	}
+}
+
+// stop ends the monitor once the connection is done and returns the
+// thresholds crossed, as recorded in ConnectionLog ("bytes_out:close").
+// A nil monitor returns "".
+func (m *thresholdMonitor) stop() string {
+	if m == nil {
+		return ""
+	}
+	close(m.quit)
+	<-m.done
+	if len(m.crossed) == 0 {
+		return ""
+	}
+	action := thresholdActionName(m.limits.Action)
+	parts := make([]string, len(m.crossed))
+	for i, name := range m.crossed {
+		parts[i] = name + ":" + action
+	}
+	return strings.Join(parts, ",")
+}
+
+// countSource adds the bytes sent since the last call to the source's
+// hourly total and returns it. Sources are only counted when the
+// connection has an hourly limit.
+func (m *thresholdMonitor) countSource(now time.Time) int64 {
+	if m.limits.MaxSourceBytesOutHour <= 0 {
+		return 0
+	}
+	out := atomic.LoadInt64(m.bytesOut)
+	n := out - m.counted
+	m.counted = out
+	return m.p.sourceOut.add(m.event.SourceIp, n, now)
+}
+
+func (m *thresholdMonitor) check(now time.Time) {
+	sourceOut := m.countSource(now)
+	if max := m.limits.MaxBytesOut; max > 0 && atomic.LoadInt64(m.bytesOut) >= max {
+		m.cross(ThresholdBytesOut, now)
+	}
+	if max := m.limits.MaxSourceBytesOutHour; max > 0 && sourceOut >= max {
+		m.cross(ThresholdSourceBytesOutHour, now)
+	}
+	if max := m.limits.MaxDurationSeconds; max > 0 && now.Sub(m.start) >= time.Duration(max)*time.Second {
+		m.cross(ThresholdDuration, now)
+	}
+}
+
+func (m *thresholdMonitor) cross(name string, now time.Time) {
+	if m.closed || slices.Contains(m.crossed, name) {
+		return
+	}
+	m.crossed = append(m.crossed, name)
+
+	eventType := pb.EventType_EVENT_TYPE_THRESHOLD_ALERT
+	switch m.limits.Action {
+	case pb.ThresholdAction_THRESHOLD_ACTION_CLOSE:
+		eventType = pb.EventType_EVENT_TYPE_THRESHOLD_CLOSED
+		m.closed = true
+		m.closeConn()
+	case pb.ThresholdAction_THRESHOLD_ACTION_THROTTLE:
+		eventType = pb.EventType_EVENT_TYPE_THRESHOLD_THROTTLED
+		if m.throttle.Rate() == 0 {
+			rate := m.limits.ThrottleBytesPerSecond
+			if rate <= 0 {
+				rate = config.DefaultThresholdThrottleRate
+			}
+			m.throttle.SetRate(rate)
+		}
+	}
+
+	bytesOut := atomic.LoadInt64(m.bytesOut)
+	log.Printf("[Threshold] Connection %s from %s crossed %s (%d bytes out in %v), %s",
+		m.event.ConnId, m.event.SourceIp, name, bytesOut, now.Sub(m.start).Round(time.Second), thresholdActionName(m.limits.Action))
+
+	event := proto.Clone(m.event).(*pb.ConnectionEvent)
+	event.EventType = eventType
+	event.Timestamp = now.Unix()
+	event.Threshold = name
+	event.BytesIn = atomic.LoadInt64(m.bytesIn)
+	event.BytesOut = bytesOut
+	event.Geo = m.geo.resultWithin(ApprovalGeoLookupTimeout)
+	m.p.broadcast(event)
+}
+
+// thresholdDescriptions complete "connection from <ip> ..." in alerts.
+var thresholdDescriptions = map[string]string{
+	ThresholdBytesOut:           "exceeded its bytes out limit",
+	ThresholdSourceBytesOutHour: "pushed its source over the hourly bytes out limit",
+	ThresholdDuration:           "exceeded its duration limit",
+}
+
+// sendThresholdAlert alerts on a connection crossing a threshold. Repeat
+// crossings by one source are folded like connection alerts.
+func (m *ProxyManager) sendThresholdAlert(model *ProxyModel, event *pb.ConnectionEvent) {
+	if m.Alerts() == nil {
+		return
+	}
+	proxyID := ""
+	if model != nil {
+		proxyID = model.ID
+	}
+	key := config.AlertTypeThreshold + KeySeparator + proxyID + KeySeparator + event.Threshold + KeySeparator + event.SourceIp
+	repeats, ok := m.connAlerts.allow(key, time.Now())
+	if !ok {
+		return
+	}
+
+	summary := fmt.Sprintf("connection from %s to %s %s (%d bytes out)",
+		event.SourceIp, event.TargetAddr, thresholdDescriptions[event.Threshold], event.BytesOut)
+	action := "alert"
+	switch event.EventType {
+	case pb.EventType_EVENT_TYPE_THRESHOLD_CLOSED:
+		action = "close"
+		summary += ", closed"
+	case pb.EventType_EVENT_TYPE_THRESHOLD_THROTTLED:
+		action = "throttle"
+		summary += ", throttled"
+	}
+	fields := map[string]string{
+		"threshold": event.Threshold,
+		"action":    action,
+		"bytes_out": strconv.FormatInt(event.BytesOut, 10),
+		"backend":   event.TargetAddr,
+	}
+	if repeats > 0 {
+		fields["repeats"] = strconv.Itoa(repeats)
+		summary += fmt.Sprintf(", %d more since the last alert", repeats)
+	}
+
+	m.sendEventAlert(model, event, config.AlertTypeThreshold, "warning", summary, fields)
+}
+
+// ThresholdsFromConfig converts YAML thresholds (nil = none).
+func ThresholdsFromConfig(c *config.ThresholdsConfig) (*pb.ConnectionThresholds, error) {
+	if c == nil {
+		return nil, nil
+	}
+	t := &pb.ConnectionThresholds{
+		MaxBytesOut:            c.MaxBytesOut,
+		MaxSourceBytesOutHour:  c.MaxSourceBytesOutHour,
+		ThrottleBytesPerSecond: c.ThrottleBytesPerSecond,
+	}
+	if c.MaxDuration != "" {
+		d, err := time.ParseDuration(c.MaxDuration)
+		if err != nil {
+			return nil, fmt.Errorf("invalid threshold maxDuration %q: %v", c.MaxDuration, err)
+		}
+		t.MaxDurationSeconds = int64(d / time.Second)
+	}
+	switch strings.ToLower(c.Action) {
+	case "", "alert":
+		t.Action = pb.ThresholdAction_THRESHOLD_ACTION_ALERT
+	case "close":
+		t.Action = pb.ThresholdAction_THRESHOLD_ACTION_CLOSE
+	case "throttle":
+		t.Action = pb.ThresholdAction_THRESHOLD_ACTION_THROTTLE
+	default:
+		return nil, fmt.Errorf("invalid threshold action %q (use alert, close or throttle)", c.Action)
+	}
+	return t, validateThresholds(t)
+}
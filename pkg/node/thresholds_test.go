@@ -0,0 +1,203 @@
+package node
+
+import (
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/ivere27/nitella/pkg/api/common"
+	pbProxy "github.com/ivere27/nitella/pkg/api/proxy"
+	"github.com/ivere27/nitella/pkg/config"
+)
+
+func TestSourceUsage(t *testing.T) {
+	var u sourceUsage
+	now := time.Unix(1_800_000_000, 0)
+
+	u.add("10.0.0.1", 100, now)
+	u.add("10.0.0.2", 5, now)
+	if got := u.add("10.0.0.1", 50, now.Add(30*time.Minute)); got != 150 {
+		t.Errorf("Expected 150 bytes in the hour, got %d", got)
+	}
+	// The first minute's bytes fall out of the window
+	if got := u.add("10.0.0.1", 0, now.Add(61*time.Minute)); got != 50 {
+		t.Errorf("Expected 50 bytes after an hour, got %d", got)
+	}
+
+	u.prune(now.Add(90 * time.Minute))
+	if _, ok := u.sources["10.0.0.2"]; ok {
+		t.Error("Expected idle source pruned")
+	}
+	if _, ok := u.sources["10.0.0.1"]; !ok {
+		t.Error("Expected recent source kept")
+	}
+}
+
+func TestThresholdsFromConfig(t *testing.T) {
+	th, err := ThresholdsFromConfig(&config.ThresholdsConfig{MaxBytesOut: 1 << 20, MaxDuration: "2h", Action: "Throttle"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if th.MaxDurationSeconds != 7200 || th.Action != pbProxy.ThresholdAction_THRESHOLD_ACTION_THROTTLE {
+		t.Errorf("Unexpected thresholds: %+v", th)
+	}
+	if got := parseThresholdsJSON(thresholdsJSON(th)); !proto.Equal(got, th) {
+		t.Errorf("Thresholds did not survive the DB round trip: %+v", got)
+	}
+	for _, bad := range []*config.ThresholdsConfig{{Action: "kill"}, {MaxDuration: "soon"}, {MaxBytesOut: -1}} {
+		if _, err := ThresholdsFromConfig(bad); err == nil {
+			t.Errorf("Expected %+v to be rejected", bad)
+		}
+	}
+}
+
+// startEchoBackend starts a backend echoing what it receives.
+func startEchoBackend(t *testing.T) net.Listener {
+	backend, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Listen failed: %v", err)
+	}
+	t.Cleanup(func() { backend.Close() })
+	go func() {
+		for {
+			conn, err := backend.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				io.Copy(conn, conn)
+			}()
+		}
+	}()
+	return backend
+}
+
+// waitThresholdEvent waits for a threshold event of the given type.
+func waitThresholdEvent(t *testing.T, events chan *pbProxy.ConnectionEvent, eventType pbProxy.EventType) *pbProxy.ConnectionEvent {
+	t.Helper()
+	deadline := time.After(5 * time.Second)
+	for {
+		select {
+		case ev := <-events:
+			if ev.EventType == eventType {
+				return ev
+			}
+		case <-deadline:
+			t.Fatalf("Timeout waiting for %v", eventType)
+			return nil
+		}
+	}
+}
+
+// echo sends n bytes through conn and reads them back.
+func echo(t *testing.T, conn net.Conn, n int) error {
+	t.Helper()
+	if _, err := conn.Write(make([]byte, n)); err != nil {
+		return err
+	}
+	conn.SetReadDeadline(time.Now().Add(10 * time.Second))
+	_, err := io.ReadFull(conn, make([]byte, n))
+	return err
+}
+
+func TestConnectionThresholds(t *testing.T) {
+	backend := startEchoBackend(t)
+	l := NewEmbeddedListener("test-thresholds", "DB", "127.0.0.1:0", backend.Addr().String(), common.ActionType_ACTION_TYPE_ALLOW, common.MockPreset_MOCK_PRESET_UNSPECIFIED, "", "", "", pbProxy.ClientAuthType_CLIENT_AUTH_AUTO, nil)
+	l.SetThresholds(&pbProxy.ConnectionThresholds{MaxBytesOut: 4096, Action: pbProxy.ThresholdAction_THRESHOLD_ACTION_CLOSE})
+	if err := l.Start(); err != nil {
+		t.Fatalf("Start failed: %v", err)
+	}
+	defer l.Stop()
+	events := l.Subscribe()
+	defer l.Unsubscribe(events)
+
+	t.Run("close", func(t *testing.T) {
+		conn, err := net.Dial("tcp", l.ListenAddr)
+		if err != nil {
+			t.Fatalf("Dial failed: %v", err)
+		}
+		defer conn.Close()
+		if err := echo(t, conn, 8192); err != nil {
+			t.Fatalf("Echo failed: %v", err)
+		}
+		ev := waitThresholdEvent(t, events, pbProxy.EventType_EVENT_TYPE_THRESHOLD_CLOSED)
+		if ev.Threshold != ThresholdBytesOut || ev.BytesOut < 8192 || ev.RuleMatched != "default" {
+			t.Errorf("Unexpected event: %+v", ev)
+		}
+		conn.SetReadDeadline(time.Now().Add(2 * time.Second))
+		if _, err := conn.Read(make([]byte, 1)); err != io.EOF {
+			t.Errorf("Expected the connection closed, got %v", err)
+		}
+	})
+
+	t.Run("rule throttle", func(t *testing.T) {
+		l.AddRule(&pbProxy.Rule{
+			Id: "throttled", Priority: 10, Enabled: true, Action: common.ActionType_ACTION_TYPE_ALLOW,
+			Conditions: []*pbProxy.Condition{cond(common.ConditionType_CONDITION_TYPE_SOURCE_IP, common.Operator_OPERATOR_CIDR, "127.0.0.0/8")},
+			Thresholds: &pbProxy.ConnectionThresholds{MaxBytesOut: 1024, Action: pbProxy.ThresholdAction_THRESHOLD_ACTION_THROTTLE, ThrottleBytesPerSecond: 16384},
+		})
+		defer l.RemoveRule("throttled")
+
+		conn, err := net.Dial("tcp", l.ListenAddr)
+		if err != nil {
+			t.Fatalf("Dial failed: %v", err)
+		}
+		defer conn.Close()
+		if err := echo(t, conn, 2048); err != nil {
+			t.Fatalf("Echo failed: %v", err)
+		}
+		ev := waitThresholdEvent(t, events, pbProxy.EventType_EVENT_TYPE_THRESHOLD_THROTTLED)
+		if ev.Threshold != ThresholdBytesOut || ev.RuleMatched != "throttled" {
+			t.Errorf("Unexpected event: %+v", ev)
+		}
+
+		// 32 KiB through a 16 KiB/s connection (both directions) takes ~2s
+		start := time.Now()
+		if err := echo(t, conn, 16384); err != nil {
+			t.Fatalf("Echo failed: %v", err)
+		}
+		if elapsed := time.Since(start); elapsed < time.Second {
+			t.Errorf("Expected the connection throttled, echo took %v", elapsed)
+		}
+	})
+
+	t.Run("duration alert", func(t *testing.T) {
+		l.SetThresholds(&pbProxy.ConnectionThresholds{MaxDurationSeconds: 1})
+		conn, err := net.Dial("tcp", l.ListenAddr)
+		if err != nil {
+			t.Fatalf("Dial failed: %v", err)
+		}
+		defer conn.Close()
+		ev := waitThresholdEvent(t, events, pbProxy.EventType_EVENT_TYPE_THRESHOLD_ALERT)
+		if ev.Threshold != ThresholdDuration {
+			t.Errorf("Unexpected event: %+v", ev)
+		}
+		// Alerting keeps the connection open
+		if err := echo(t, conn, 16); err != nil {
+			t.Errorf("Expected the connection still forwarding: %v", err)
+		}
+
+		sender := &MockAlertSender{}
+		pm := &ProxyManager{alerts: sender, NodeID: "node-1", connAlerts: newConnectionAlerter(ConnectionAlertConfig{})}
+		model := &ProxyModel{ID: "p1", Name: "DB", ListenAddr: ":5432"}
+		pm.sendThresholdAlert(model, ev)
+		pm.sendThresholdAlert(model, ev)
+		if len(sender.alerts) != 1 {
+			t.Fatalf("Expected 1 deduplicated alert, got %d", len(sender.alerts))
+		}
+		if got := sender.alerts[0].Metadata[config.AlertMetadataType]; got != config.AlertTypeThreshold {
+			t.Errorf("Expected threshold alert type, got %q", got)
+		}
+		var details common.AlertDetails
+		if err := proto.Unmarshal([]byte(sender.infos[0]), &details); err != nil {
+			t.Fatalf("Failed to parse alert details: %v", err)
+		}
+		if details.Fields["threshold"] != ThresholdDuration || details.Fields["action"] != "alert" {
+			t.Errorf("Unexpected details: %+v", &details)
+		}
+	})
+}
@@ -587,7 +587,23 @@ func (m *Manager) SendApprovalRequest(req *ApprovalRequest) int {
 		log.Printf("[P2P] Failed to create approval request message: %v", err)
 		return 0
 	}
+	return m.sendToSessions(msg, "approval request", req.RequestID)
+}
+
+// SendAlert broadcasts an informational alert to all connected P2P sessions
+// Returns the number of sessions notified, or 0 if none connected
+func (m *Manager) SendAlert(alert *Alert) int {
+	msg, err := NewP2PMessage(MessageTypeAlert, alert)
+	if err != nil {
+		log.Printf("[P2P] Failed to create alert message: %v", err)
+		return 0
+	}
+	return m.sendToSessions(msg, "alert", alert.AlertID)
+}
 
+// sendToSessions encrypts msg with each connected session's public key and
+// sends it. Returns the number of sessions reached.
+func (m *Manager) sendToSessions(msg *P2PMessage, what, id string) int {
 	sessions := m.GetConnectedSessions()
 	sent := 0
 	for _, sessionID := range sessions {
@@ -606,14 +622,14 @@ func (m *Manager) SendApprovalRequest(req *ApprovalRequest) int {
 		}
 
 		if err := m.SendCommand(sessionID, data); err != nil {
-			log.Printf("[P2P] Failed to send approval request to %s: %v", sessionID, err)
+			log.Printf("[P2P] Failed to send %s to %s: %v", what, sessionID, err)
 		} else {
 			sent++
 		}
 	}
 
 	if sent > 0 {
-		log.Printf("[P2P] Sent encrypted approval request %s to %d sessions", req.RequestID, sent)
+		log.Printf("[P2P] Sent encrypted %s %s to %d sessions", what, id, sent)
 	}
 
 	return sent
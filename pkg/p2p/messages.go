@@ -9,6 +9,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/ivere27/nitella/pkg/api/common"
 	nitellacrypto "github.com/ivere27/nitella/pkg/crypto"
 )
 
@@ -16,6 +17,7 @@ import (
 const (
 	MessageTypeApprovalRequest  = "approval_request"
 	MessageTypeApprovalDecision = "approval_decision"
+	MessageTypeAlert            = "alert" // Informational alert, no decision expected
 	MessageTypeMetrics          = "metrics"
 	MessageTypeCommand          = "command"
 	MessageTypeCommandResponse  = "command_response"
@@ -95,14 +97,50 @@ type ApprovalRequest struct {
 	GeoCity    string `json:"geo_city,omitempty"`
 	GeoISP     string `json:"geo_isp,omitempty"`
 	Severity   string `json:"severity"`
+
+	Context *common.ApprovalContext `json:"context,omitempty"`
+}
+
+// Alert is sent from Node to CLI via P2P for alerts that expect no
+// decision, e.g. a connection allowed by an ALLOW_ALERT rule
+type Alert struct {
+	AlertID    string            `json:"alert_id"`
+	NodeID     string            `json:"node_id"`
+	Type       string            `json:"type"` // Alert.Metadata type, e.g. "connection"
+	Severity   string            `json:"severity"`
+	ProxyID    string            `json:"proxy_id"`
+	SourceIP   string            `json:"source_ip"`
+	DestAddr   string            `json:"dest_addr"`
+	RuleID     string            `json:"rule_id"`
+	GeoCountry string            `json:"geo_country,omitempty"`
+	GeoCity    string            `json:"geo_city,omitempty"`
+	GeoISP     string            `json:"geo_isp,omitempty"`
+	Summary    string            `json:"summary,omitempty"`
+	Fields     map[string]string `json:"fields,omitempty"`
 }
 
-// ApprovalDecision is sent from CLI to Node via P2P
+// ApprovalDecision is sent from CLI to Node via P2P. Decisions signed with
+// an approver key (see Sign) count as that approver's vote.
 type ApprovalDecision struct {
 	RequestID       string `json:"request_id"`
 	Action          int32  `json:"action"`           // 1=allow, 2=block, 3=block+add_rule
 	DurationSeconds int64  `json:"duration_seconds"` // How long to cache
 	Reason          string `json:"reason,omitempty"`
+	RetentionMode   int32  `json:"retention_mode,omitempty"` // common.ApprovalRetentionMode
+	ApproverKey     []byte `json:"approver_key,omitempty"`   // Ed25519 public key of the signer
+	Signature       []byte `json:"signature,omitempty"`      // Over nitellacrypto.ApprovalVotePayload
+}
+
+// Sign signs the decision as a vote from the approver holding key.
+func (d *ApprovalDecision) Sign(key ed25519.PrivateKey) error {
+	payload := nitellacrypto.ApprovalVotePayload(d.RequestID, d.Action, d.RetentionMode, d.DurationSeconds, d.Reason)
+	sig, err := nitellacrypto.Sign(payload, key)
+	if err != nil {
+		return err
+	}
+	d.ApproverKey = key.Public().(ed25519.PublicKey)
+	d.Signature = sig
+	return nil
 }
 
 // generateNonce creates a random 16-byte hex-encoded nonce
@@ -192,6 +230,15 @@ func (m *P2PMessage) ParseApprovalRequest() (*ApprovalRequest, error) {
 	return &req, nil
 }
 
+// ParseAlert extracts Alert from P2PMessage payload
+func (m *P2PMessage) ParseAlert() (*Alert, error) {
+	var alert Alert
+	if err := json.Unmarshal(m.Payload, &alert); err != nil {
+		return nil, err
+	}
+	return &alert, nil
+}
+
 // ParseApprovalDecision extracts ApprovalDecision from P2PMessage payload
 func (m *P2PMessage) ParseApprovalDecision() (*ApprovalDecision, error) {
 	var dec ApprovalDecision
@@ -5,6 +5,8 @@ import (
 	"crypto/rand"
 	"encoding/json"
 	"testing"
+
+	nitellacrypto "github.com/ivere27/nitella/pkg/crypto"
 )
 
 func TestP2PMessage_MarshalParse(t *testing.T) {
@@ -92,6 +94,61 @@ func TestP2PMessage_ApprovalDecision(t *testing.T) {
 	}
 }
 
+func TestApprovalDecision_Sign(t *testing.T) {
+	_, key, _ := ed25519.GenerateKey(rand.Reader)
+	decision := &ApprovalDecision{RequestID: "req-123", Action: 1, DurationSeconds: 300, RetentionMode: 1}
+	if err := decision.Sign(key); err != nil {
+		t.Fatalf("Sign failed: %v", err)
+	}
+
+	msg, _ := NewP2PMessage(MessageTypeApprovalDecision, decision)
+	data, _ := msg.Marshal()
+	parsed, _ := ParseP2PMessage(data)
+	got, err := parsed.ParseApprovalDecision()
+	if err != nil {
+		t.Fatalf("ParseApprovalDecision failed: %v", err)
+	}
+	payload := nitellacrypto.ApprovalVotePayload(got.RequestID, got.Action, got.RetentionMode, got.DurationSeconds, got.Reason)
+	if !ed25519.PublicKey(got.ApproverKey).Equal(key.Public()) || !ed25519.Verify(got.ApproverKey, payload, got.Signature) {
+		t.Error("Expected the parsed decision to carry a valid vote signature")
+	}
+}
+
+func TestP2PMessage_Alert(t *testing.T) {
+	alert := &Alert{
+		AlertID:    "alert-1",
+		NodeID:     "node-1",
+		Type:       "connection",
+		Severity:   "info",
+		SourceIP:   "203.0.113.9",
+		GeoCountry: "BR",
+		Summary:    "allowed connection from 203.0.113.9 (BR) to 10.0.0.5:22",
+		Fields:     map[string]string{"backend": "10.0.0.5:22"},
+	}
+
+	msg, err := NewP2PMessage(MessageTypeAlert, alert)
+	if err != nil {
+		t.Fatalf("NewP2PMessage failed: %v", err)
+	}
+
+	data, _ := msg.Marshal()
+	parsed, _ := ParseP2PMessage(data)
+	if parsed.Type != MessageTypeAlert {
+		t.Errorf("Expected type %s, got %s", MessageTypeAlert, parsed.Type)
+	}
+
+	parsedAlert, err := parsed.ParseAlert()
+	if err != nil {
+		t.Fatalf("ParseAlert failed: %v", err)
+	}
+	if parsedAlert.AlertID != alert.AlertID || parsedAlert.Type != alert.Type || parsedAlert.Summary != alert.Summary {
+		t.Errorf("Alert mismatch: %+v", parsedAlert)
+	}
+	if parsedAlert.Fields["backend"] != "10.0.0.5:22" {
+		t.Errorf("Fields mismatch: %v", parsedAlert.Fields)
+	}
+}
+
 func TestEncryptP2PMessage(t *testing.T) {
 	recipientPub, recipientPriv, err := ed25519.GenerateKey(rand.Reader)
 	if err != nil {
@@ -64,6 +64,9 @@ type Transport struct {
 	// Callback for approval requests (P2P approval flow)
 	onApprovalRequest func(nodeID string, req *ApprovalRequest)
 
+	// Callback for informational alerts (no decision expected)
+	onAlert func(nodeID string, alert *Alert)
+
 	// Known node public keys for verification (extracted from verified certs)
 	nodePublicKeys map[string]ed25519.PublicKey
 	nodeKeysMu     sync.RWMutex
@@ -779,6 +782,11 @@ func (t *Transport) SetApprovalRequestHandler(handler func(nodeID string, req *A
 	t.onApprovalRequest = handler
 }
 
+// SetAlertHandler sets the callback for incoming P2P informational alerts
+func (t *Transport) SetAlertHandler(handler func(nodeID string, alert *Alert)) {
+	t.onAlert = handler
+}
+
 // SendApprovalDecision sends an approval decision to a specific node via P2P
 // The message is encrypted with the node's public key
 func (t *Transport) SendApprovalDecision(nodeID string, decision *ApprovalDecision) error {
@@ -909,6 +917,15 @@ func (t *Transport) handleP2PMessage(senderID string, data []byte) bool {
 			}
 		}
 		return true
+	case MessageTypeAlert:
+		if t.onAlert != nil {
+			if alert, err := msg.ParseAlert(); err == nil {
+				t.onAlert(senderID, alert)
+			} else {
+				log.Printf("[P2P] Failed to parse alert: %v", err)
+			}
+		}
+		return true
 	case MessageTypeCommandResponse:
 		// Route to pending request if we have a matching RequestID
 		if resp, err := msg.ParseCommandResponse(); err == nil && resp.RequestID != "" {
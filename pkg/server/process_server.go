@@ -2,11 +2,13 @@ package server
 
 import (
 	"context"
+	"encoding/json"
 
 	"github.com/ivere27/nitella/pkg/api/common"
 	pb "github.com/ivere27/nitella/pkg/api/process"
 	proxy_pb "github.com/ivere27/nitella/pkg/api/proxy"
 	"github.com/ivere27/nitella/pkg/node"
+	"github.com/ivere27/nitella/pkg/node/accesslog"
 	"google.golang.org/grpc"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -16,17 +18,22 @@ func RegisterProcessControl(s *grpc.Server, srv *ProcessServer) {
 	pb.RegisterProcessControlServer(s, srv)
 }
 
+// accessLogBacklog is how many access log records a child queues for the
+// parent; records beyond it are dropped rather than stall connections.
+const accessLogBacklog = 1024
+
 // ProcessServer handles IPC from parent process to child process.
 // Each child process runs a single listener and receives commands via Unix socket.
 type ProcessServer struct {
 	pb.UnimplementedProcessControlServer
 	pm             *node.ProxyManager
 	currentProxyID string
+	accessLog      chan *pb.AccessLogRecord
 }
 
 // NewProcessServer creates a new ProcessServer.
 func NewProcessServer(pm *node.ProxyManager) *ProcessServer {
-	return &ProcessServer{pm: pm}
+	return &ProcessServer{pm: pm, accessLog: make(chan *pb.AccessLogRecord, accessLogBacklog)}
 }
 
 // StartListener initializes the listener in this child process.
@@ -55,6 +62,14 @@ func (s *ProcessServer) StartListener(ctx context.Context, req *pb.StartListener
 		FallbackMock:   req.FallbackMock,
 	}
 
+	if req.TarpitBudget != nil {
+		s.pm.SetTarpitBudget(node.TarpitBudgetFromProto(req.TarpitBudget))
+	}
+	s.pm.SetClonedPresets(req.ClonedPresets)
+	if req.AccessLog {
+		s.pm.SetAccessLog(accesslog.NewForwarder(s.forwardAccessLog))
+	}
+
 	resp, err := s.pm.CreateProxyWithID(req.Id, proxyReq)
 	if err != nil {
 		return &pb.StartListenerResponse{Success: false, ErrorMessage: err.Error()}, nil
@@ -67,6 +82,19 @@ func (s *ProcessServer) StartListener(ctx context.Context, req *pb.StartListener
 	return &pb.StartListenerResponse{Success: true}, nil
 }
 
+// ConfigureTarpit replaces this child's share of the node's tarpit budget.
+func (s *ProcessServer) ConfigureTarpit(ctx context.Context, req *pb.ConfigureTarpitRequest) (*pb.ConfigureTarpitResponse, error) {
+	s.pm.SetTarpitBudget(node.TarpitBudgetFromProto(req.Budget))
+	return &pb.ConfigureTarpitResponse{Success: true}, nil
+}
+
+// ConfigureClonedPresets replaces this child's copy of the parent's
+// cloned presets.
+func (s *ProcessServer) ConfigureClonedPresets(ctx context.Context, req *pb.ConfigureClonedPresetsRequest) (*pb.ConfigureClonedPresetsResponse, error) {
+	s.pm.SetClonedPresets(req.Presets)
+	return &pb.ConfigureClonedPresetsResponse{Success: true}, nil
+}
+
 // StopListener stops the listener in this child process.
 func (s *ProcessServer) StopListener(ctx context.Context, req *pb.StopListenerRequest) (*pb.StopListenerResponse, error) {
 	if s.currentProxyID != "" {
@@ -243,6 +271,34 @@ func (s *ProcessServer) StreamEvents(req *pb.StreamEventsRequest, stream pb.Proc
 	}
 }
 
+// forwardAccessLog queues rec for the parent's StreamAccessLog.
+func (s *ProcessServer) forwardAccessLog(rec *accesslog.Record) {
+	b, err := json.Marshal(rec)
+	if err != nil {
+		return
+	}
+	select {
+	case s.accessLog <- &pb.AccessLogRecord{Json: b}:
+	default:
+		// The parent is not keeping up
+	}
+}
+
+// StreamAccessLog streams this child's access log records to the parent,
+// which writes them to the node's access log.
+func (s *ProcessServer) StreamAccessLog(req *pb.StreamAccessLogRequest, stream pb.ProcessControl_StreamAccessLogServer) error {
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case rec := <-s.accessLog:
+			if err := stream.Send(rec); err != nil {
+				return err
+			}
+		}
+	}
+}
+
 // GetProxyID returns the current proxy ID (for testing).
 func (s *ProcessServer) GetProxyID() string {
 	return s.currentProxyID
@@ -297,6 +297,14 @@ func (s *ProxyAdminServer) dispatchCommand(ctx context.Context, cmdType hubpb.Co
 	case hubpb.CommandType_COMMAND_TYPE_CANCEL_APPROVAL:
 		return s.cmdCancelApproval(payload)
 
+	// --- Honeypot ---
+	case hubpb.CommandType_COMMAND_TYPE_GET_MOCK_TRANSCRIPTS:
+		return s.cmdGetMockTranscripts(payload)
+	case hubpb.CommandType_COMMAND_TYPE_CLONE_BANNER:
+		return s.cmdCloneBanner(payload)
+	case hubpb.CommandType_COMMAND_TYPE_LIST_CLONED_PRESETS:
+		return proto.Marshal(s.pm.ListClonedPresets())
+
 	default:
 		return nil, fmt.Errorf("unknown command type: %v", cmdType)
 	}
@@ -322,6 +330,8 @@ func (s *ProxyAdminServer) cmdStatus() ([]byte, error) {
 		ActiveConnections: activeConns,
 		ProxyCount:        int32(len(statuses)),
 		Timestamp:         timestamppb.Now(),
+		Tarpit:            s.pm.Tarpit.Stats(),
+		AlertSinks:        s.pm.AlertSinks.Stats(),
 	}
 	return proto.Marshal(resp)
 }
@@ -432,6 +442,8 @@ func (s *ProxyAdminServer) cmdGetMetrics() ([]byte, error) {
 		ActiveConnections: activeConns,
 		ProxyCount:        int32(len(statuses)),
 		Timestamp:         timestamppb.Now(),
+		Tarpit:            s.pm.Tarpit.Stats(),
+		AlertSinks:        s.pm.AlertSinks.Stats(),
 	}
 	return proto.Marshal(resp)
 }
@@ -681,6 +693,20 @@ func (s *ProxyAdminServer) cmdListActiveApprovals(payload []byte) ([]byte, error
 			ConnIds: connIDs, GeoCountry: e.GeoCountry, GeoCity: e.GeoCity, GeoIsp: e.GeoISP,
 		})
 	}
+	for _, e := range s.pm.Approval.GetPendingQuorums() {
+		if req.ProxyId != "" && e.ProxyID != req.ProxyId {
+			continue
+		}
+		if req.SourceIp != "" && e.SourceIP != req.SourceIp {
+			continue
+		}
+		approvals = append(approvals, &pb.ActiveApproval{
+			Key: e.Key(), SourceIp: e.SourceIP, RuleId: e.RuleID, ProxyId: e.ProxyID,
+			CreatedAt: timestamppb.New(e.CreatedAt), ExpiresAt: timestamppb.New(e.ExpiresAt),
+			GeoCountry: e.GeoCountry, GeoCity: e.GeoCity, GeoIsp: e.GeoISP,
+			Pending: true, Approvals: int32(len(e.Approvers)), Quorum: int32(e.Quorum), Approvers: e.Approvers,
+		})
+	}
 	return proto.Marshal(&pb.ListActiveApprovalsResponse{Approvals: approvals})
 }
 
@@ -718,6 +744,30 @@ func (s *ProxyAdminServer) cmdCancelApproval(payload []byte) ([]byte, error) {
 	return proto.Marshal(&pb.CancelApprovalResponse{Success: true, ConnectionsClosed: connectionsClosed})
 }
 
+func (s *ProxyAdminServer) cmdGetMockTranscripts(payload []byte) ([]byte, error) {
+	var req pb.GetMockTranscriptsRequest
+	if err := proto.Unmarshal(payload, &req); err != nil {
+		return nil, err
+	}
+	resp, err := s.pm.GetMockTranscripts(&req)
+	if err != nil {
+		return nil, err
+	}
+	return proto.Marshal(resp)
+}
+
+func (s *ProxyAdminServer) cmdCloneBanner(payload []byte) ([]byte, error) {
+	var req pb.CloneBannerRequest
+	if err := proto.Unmarshal(payload, &req); err != nil {
+		return nil, err
+	}
+	resp, err := s.pm.CloneBanner(&req)
+	if err != nil {
+		return nil, err
+	}
+	return proto.Marshal(resp)
+}
+
 func (s *ProxyAdminServer) cmdResolveApproval(payload []byte) ([]byte, error) {
 	var req pb.ResolveApprovalRequest
 	if err := proto.Unmarshal(payload, &req); err != nil {
@@ -738,12 +788,19 @@ func (s *ProxyAdminServer) cmdResolveApproval(payload []byte) ([]byte, error) {
 		durationSeconds = 0
 	}
 	allowed := req.Action == common.ApprovalActionType_APPROVAL_ACTION_TYPE_ALLOW
-	meta := s.pm.Approval.ResolveWithRetention(req.ReqId, allowed, durationSeconds, req.Reason, retentionMode)
-	if meta == nil {
+	_, status, err := s.pm.Approval.ResolveRequest(&req, durationSeconds, retentionMode)
+	if err == node.ErrApprovalNotFound {
 		return proto.Marshal(&pb.ResolveApprovalResponse{Success: false, ErrorMessage: "Approval request not found or already resolved"})
 	}
-	log.Printf("[Admin] Approval resolved: %s -> %v (mode=%v, duration: %ds)", req.ReqId, allowed, retentionMode, durationSeconds)
-	return proto.Marshal(&pb.ResolveApprovalResponse{Success: true})
+	if err != nil {
+		return proto.Marshal(&pb.ResolveApprovalResponse{Success: false, ErrorMessage: err.Error()})
+	}
+	if status.Resolved {
+		log.Printf("[Admin] Approval resolved: %s -> %v (mode=%v, duration: %ds)", req.ReqId, allowed, retentionMode, durationSeconds)
+	} else {
+		log.Printf("[Admin] Approval vote: %s has %d/%d approvals", req.ReqId, status.Approvals, status.Quorum)
+	}
+	return proto.Marshal(&pb.ResolveApprovalResponse{Success: true, Approvals: int32(status.Approvals), Quorum: int32(status.Quorum)})
 }
 
 // ============================================================================
@@ -818,9 +875,11 @@ func (s *ProxyAdminServer) StreamMetrics(req *pb.StreamMetricsRequest, stream pb
 					bytesOutRate = (totalBytesOut - prevBytesOut) / elapsed
 				}
 			}
+			tarpit := s.pm.Tarpit.Stats()
 			sample := &pb.MetricsSample{
 				Timestamp: now, ActiveConns: totalActive, TotalConns: totalConns,
 				BytesInRate: bytesInRate, BytesOutRate: bytesOutRate,
+				TarpitActive: tarpit.ActiveConns, TarpitMemoryBytes: tarpit.MemoryBytes,
 			}
 			encPayload, err := s.encryptStreamPayload(sample, "MetricsSample", viewerPubKey)
 			if err != nil {
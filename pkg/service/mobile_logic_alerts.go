@@ -0,0 +1,101 @@
+package service
+
+import (
+	"time"
+
+	"github.com/ivere27/nitella/pkg/api/common"
+	pb "github.com/ivere27/nitella/pkg/api/local"
+	"github.com/ivere27/nitella/pkg/config"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ===========================================================================
+// Informational Alerts
+// ===========================================================================
+
+// infoAlertTitles titles the informational alert types nodes send. They are
+// shown to the user as alerts, without approve or deny actions.
+var infoAlertTitles = map[string]string{
+	config.AlertTypeHoneypot:   "Honeypot activity",
+	config.AlertTypeConnection: "Connection allowed",
+	config.AlertTypeThreshold:  "Connection threshold exceeded",
+}
+
+// shownAlertTTL is how long shown alert IDs are remembered, so a copy of
+// the same alert arriving over another path is not shown twice.
+const shownAlertTTL = 10 * time.Minute
+
+// isInfoAlertType reports whether alertType is an informational alert type.
+func isInfoAlertType(alertType string) bool {
+	_, ok := infoAlertTitles[alertType]
+	return ok
+}
+
+// newInfoAlert builds the UI alert for an informational node alert.
+func newInfoAlert(id, nodeID, alertType, severity string, ts time.Time, details *common.AlertDetails) *pb.Alert {
+	metadata := make(map[string]string, len(details.GetFields())+7)
+	for k, v := range details.GetFields() {
+		metadata[k] = v
+	}
+	for k, v := range map[string]string{
+		"source_ip":   details.GetSourceIp(),
+		"destination": details.GetDestination(),
+		"proxy_id":    details.GetProxyId(),
+		"proxy_name":  details.GetProxyName(),
+		"rule_id":     details.GetRuleId(),
+		"geo_country": details.GetGeoCountry(),
+	} {
+		if v != "" {
+			metadata[k] = v
+		}
+	}
+	metadata[config.AlertMetadataType] = alertType
+
+	return &pb.Alert{
+		Id:        id,
+		NodeId:    nodeID,
+		Title:     infoAlertTitles[alertType],
+		Message:   details.GetSummary(),
+		Severity:  alertSeverity(severity),
+		Timestamp: timestamppb.New(ts),
+		Metadata:  metadata,
+	}
+}
+
+// alertSeverity maps a node alert severity to the UI severity.
+func alertSeverity(severity string) pb.AlertSeverity {
+	switch severity {
+	case "info":
+		return pb.AlertSeverity_ALERT_SEVERITY_INFO
+	case "warning":
+		return pb.AlertSeverity_ALERT_SEVERITY_WARNING
+	case "high", "critical":
+		return pb.AlertSeverity_ALERT_SEVERITY_CRITICAL
+	default:
+		return pb.AlertSeverity_ALERT_SEVERITY_UNSPECIFIED
+	}
+}
+
+// notifyAlert shows an informational alert in the UI, once per alert ID.
+func (s *MobileLogicService) notifyAlert(alert *pb.Alert) {
+	now := time.Now()
+	s.shownAlertsMu.Lock()
+	if _, seen := s.shownAlerts[alert.GetId()]; seen {
+		s.shownAlertsMu.Unlock()
+		return
+	}
+	for id, at := range s.shownAlerts {
+		if now.Sub(at) >= shownAlertTTL {
+			delete(s.shownAlerts, id)
+		}
+	}
+	s.shownAlerts[alert.GetId()] = now
+	s.shownAlertsMu.Unlock()
+
+	s.mu.RLock()
+	cb := s.uiCallback
+	s.mu.RUnlock()
+	if cb != nil {
+		go cb.OnAlert(alert)
+	}
+}
@@ -0,0 +1,163 @@
+package service
+
+import (
+	"crypto/ed25519"
+	"testing"
+	"time"
+
+	"github.com/ivere27/nitella/pkg/api/common"
+	pb "github.com/ivere27/nitella/pkg/api/local"
+	"github.com/ivere27/nitella/pkg/config"
+	nitellacrypto "github.com/ivere27/nitella/pkg/crypto"
+	"github.com/ivere27/nitella/pkg/p2p"
+)
+
+// alertRecorder is a UICallback collecting the alerts shown to the user.
+type alertRecorder struct {
+	alerts chan *pb.Alert
+}
+
+func newAlertRecorder() *alertRecorder {
+	return &alertRecorder{alerts: make(chan *pb.Alert, 8)}
+}
+
+func (r *alertRecorder) OnApprovalRequest(*pb.ApprovalRequest) error   { return nil }
+func (r *alertRecorder) OnNodeStatusChange(*pb.NodeStatusChange) error { return nil }
+func (r *alertRecorder) OnConnectionEvent(*pb.ConnectionEvent) error   { return nil }
+func (r *alertRecorder) OnToast(*pb.ToastMessage) error                { return nil }
+func (r *alertRecorder) OnAlert(alert *pb.Alert) error {
+	r.alerts <- alert
+	return nil
+}
+
+// next returns the next alert shown, or nil if none is within a second.
+func (r *alertRecorder) next() *pb.Alert {
+	select {
+	case alert := <-r.alerts:
+		return alert
+	case <-time.After(time.Second):
+		return nil
+	}
+}
+
+func TestProcessIncomingAlertShowsHoneypotAlert(t *testing.T) {
+	svc := NewMobileLogicService()
+	ui := newAlertRecorder()
+	svc.SetUICallback(ui)
+	priv, err := nitellacrypto.GenerateKey()
+	if err != nil {
+		t.Fatalf("GenerateKey() error = %v", err)
+	}
+
+	alert := encryptedAlert(t, priv, "alert-1", config.AlertTypeHoneypot, &common.AlertDetails{
+		SourceIp:  "192.0.2.1",
+		ProxyId:   "ssh",
+		ProxyName: "ssh-honeypot",
+		Summary:   `ssh login attempt for "root"`,
+		Fields:    map[string]string{"username": "root"},
+	})
+	alert.Severity = "warning"
+	alert.TimestampUnix = time.Now().Add(-time.Minute).Unix()
+	svc.processIncomingAlert(alert, priv)
+
+	got := ui.next()
+	if got == nil {
+		t.Fatalf("expected the honeypot alert to be shown")
+	}
+	if got.GetTitle() != "Honeypot activity" || got.GetMessage() != `ssh login attempt for "root"` ||
+		got.GetSeverity() != pb.AlertSeverity_ALERT_SEVERITY_WARNING || got.GetNodeId() != "node-1" {
+		t.Fatalf("unexpected alert: %v", got)
+	}
+	md := got.GetMetadata()
+	if md[config.AlertMetadataType] != config.AlertTypeHoneypot || md["source_ip"] != "192.0.2.1" || md["username"] != "root" {
+		t.Fatalf("unexpected metadata: %v", md)
+	}
+	if got.GetTimestamp().AsTime().Unix() != alert.TimestampUnix {
+		t.Fatalf("unexpected timestamp: got=%s", got.GetTimestamp().AsTime())
+	}
+	if svc.getPendingApproval("alert-1") != nil {
+		t.Fatalf("informational alert must not become a pending approval")
+	}
+
+	// The same alert again is not shown twice
+	svc.processIncomingAlert(alert, priv)
+	if dup := ui.next(); dup != nil {
+		t.Fatalf("unexpected duplicate alert: %v", dup)
+	}
+}
+
+func TestProcessIncomingAlertDropsUndecryptableInfoAlert(t *testing.T) {
+	svc := NewMobileLogicService()
+	ui := newAlertRecorder()
+	svc.SetUICallback(ui)
+	priv, _ := nitellacrypto.GenerateKey()
+	other, _ := nitellacrypto.GenerateKey()
+
+	svc.processIncomingAlert(encryptedAlert(t, priv, "alert-2", config.AlertTypeConnection,
+		&common.AlertDetails{Summary: "allowed connection"}), other)
+	svc.processIncomingAlert(&common.Alert{
+		Id: "alert-3", NodeId: "node-1",
+		Metadata: map[string]string{config.AlertMetadataType: config.AlertTypeConnection},
+	}, priv)
+	if got := ui.next(); got != nil {
+		t.Fatalf("unexpected alert: %v", got)
+	}
+}
+
+func TestConnectionAlertOverP2PReachesApp(t *testing.T) {
+	svc := NewMobileLogicService()
+	ui := newAlertRecorder()
+	svc.SetUICallback(ui)
+	mobileKey, err := nitellacrypto.GenerateKey()
+	if err != nil {
+		t.Fatalf("GenerateKey() error = %v", err)
+	}
+
+	// The node encrypts the alert for the session's key, the transport
+	// decrypts it and hands it to the service
+	msg, err := p2p.NewP2PMessage(p2p.MessageTypeAlert, &p2p.Alert{
+		AlertID:    "alert-4",
+		NodeID:     "node-1",
+		Type:       config.AlertTypeConnection,
+		Severity:   "info",
+		ProxyID:    "web",
+		SourceIP:   "192.0.2.1",
+		DestAddr:   "0.0.0.0:443",
+		RuleID:     "allow-alert",
+		GeoCountry: "KR",
+		Summary:    "allowed connection from 192.0.2.1 (KR) to 10.0.0.5:443",
+		Fields:     map[string]string{"backend": "10.0.0.5:443"},
+	})
+	if err != nil {
+		t.Fatalf("NewP2PMessage() error = %v", err)
+	}
+	data, err := p2p.EncryptP2PMessage(msg, mobileKey.Public().(ed25519.PublicKey))
+	if err != nil {
+		t.Fatalf("EncryptP2PMessage() error = %v", err)
+	}
+	received, err := p2p.DecryptP2PMessage(data, mobileKey)
+	if err != nil {
+		t.Fatalf("DecryptP2PMessage() error = %v", err)
+	}
+	alert, err := received.ParseAlert()
+	if err != nil {
+		t.Fatalf("ParseAlert() error = %v", err)
+	}
+	svc.handleP2PAlert("node-1", alert)
+
+	got := ui.next()
+	if got == nil {
+		t.Fatalf("expected the connection alert to be shown")
+	}
+	if got.GetId() != "alert-4" || got.GetTitle() != "Connection allowed" ||
+		got.GetMessage() != "allowed connection from 192.0.2.1 (KR) to 10.0.0.5:443" ||
+		got.GetSeverity() != pb.AlertSeverity_ALERT_SEVERITY_INFO {
+		t.Fatalf("unexpected alert: %v", got)
+	}
+	if md := got.GetMetadata(); md["backend"] != "10.0.0.5:443" || md["geo_country"] != "KR" || md["rule_id"] != "allow-alert" {
+		t.Fatalf("unexpected metadata: %v", md)
+	}
+	if svc.getPendingApproval("alert-4") != nil {
+		t.Fatalf("connection alert must not become a pending approval")
+	}
+}
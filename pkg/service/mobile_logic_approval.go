@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
+	"strconv"
 	"strings"
 	"time"
 
@@ -13,6 +14,7 @@ import (
 	pbHub "github.com/ivere27/nitella/pkg/api/hub"
 	pb "github.com/ivere27/nitella/pkg/api/local"
 	pbProxy "github.com/ivere27/nitella/pkg/api/proxy"
+	"github.com/ivere27/nitella/pkg/config"
 	nitellacrypto "github.com/ivere27/nitella/pkg/crypto"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -180,6 +182,7 @@ func (s *MobileLogicService) ApproveRequest(ctx context.Context, req *pb.Approve
 		RetentionMode:   retentionMode,
 		DurationSeconds: durationSeconds,
 	}
+	s.signApprovalVote(resolveReq)
 	payload, err := proto.Marshal(resolveReq)
 	if err != nil {
 		return &pb.ApproveRequestResponse{
@@ -315,6 +318,7 @@ func (s *MobileLogicService) DenyRequest(ctx context.Context, req *pb.DenyReques
 		RetentionMode:   retentionMode,
 		DurationSeconds: durationSeconds,
 	}
+	s.signApprovalVote(resolveReq)
 	payload, err := proto.Marshal(resolveReq)
 	if err != nil {
 		return &pb.DenyRequestResponse{
@@ -477,6 +481,23 @@ func normalizeApprovalDurationSeconds(mode common.ApprovalRetentionMode, seconds
 	return seconds
 }
 
+// signApprovalVote signs req with the approver key, if one is set.
+func (s *MobileLogicService) signApprovalVote(req *pbProxy.ResolveApprovalRequest) {
+	s.mu.RLock()
+	key := s.approverKey
+	s.mu.RUnlock()
+	if key == nil {
+		return
+	}
+	payload := nitellacrypto.ApprovalVotePayload(req.ReqId, int32(req.Action), int32(req.RetentionMode), req.DurationSeconds, req.Reason)
+	sig, err := nitellacrypto.Sign(payload, key)
+	if err != nil {
+		return
+	}
+	req.ApproverKey = key.Public().(ed25519.PublicKey)
+	req.Signature = sig
+}
+
 func validateApprovalDecisionDuration(mode common.ApprovalRetentionMode, seconds int64) error {
 	switch mode {
 	case common.ApprovalRetentionMode_APPROVAL_RETENTION_MODE_CONNECTION_ONLY:
@@ -547,17 +568,49 @@ func (s *MobileLogicService) StreamApprovals(req *pb.StreamApprovalsRequest, str
 }
 
 // processIncomingAlert processes an alert received from the Hub's StreamAlerts.
-// It decrypts the alert payload and converts it into an ApprovalRequest.
+// It decrypts the alert payload and converts an approval alert into an
+// ApprovalRequest; informational alerts are shown in the UI.
 func (s *MobileLogicService) processIncomingAlert(alert *common.Alert, privKey ed25519.PrivateKey) {
 	requestID := strings.TrimSpace(alert.GetId())
 	if requestID == "" {
 		return
 	}
+	// Only approval requests become pending approvals; resolutions dismiss
+	// them and informational alerts are shown as they are
+	switch alertType := alert.GetMetadata()[config.AlertMetadataType]; {
+	case alertType == "" || alertType == config.AlertTypeApproval:
+	case alertType == config.AlertTypeApprovalResolved:
+		// Only the node can say a request was settled
+		var details common.AlertDetails
+		if plaintext := s.decryptAlertPayload(alert, privKey); plaintext != nil && proto.Unmarshal(plaintext, &details) == nil {
+			s.dismissApproval(alert.GetNodeId(), requestID, details.GetFields()[config.AlertFieldDecision])
+		}
+		return
+	case isInfoAlertType(alertType):
+		// Details are only shown when the node's signature verifies
+		var details common.AlertDetails
+		if plaintext := s.decryptAlertPayload(alert, privKey); plaintext != nil && proto.Unmarshal(plaintext, &details) == nil {
+			ts := time.Now()
+			if tsUnix := alert.GetTimestampUnix(); tsUnix > 0 {
+				ts = time.Unix(tsUnix, 0)
+			}
+			s.notifyAlert(newInfoAlert(requestID, alert.GetNodeId(), alertType, alert.GetSeverity(), ts, &details))
+		}
+		return
+	default:
+		return
+	}
 
 	approvalReq := &pb.ApprovalRequest{
 		RequestId: requestID,
 		NodeId:    alert.NodeId,
 	}
+	// Quorum requests are re-sent with their vote count as votes arrive
+	if q, err := strconv.Atoi(alert.GetMetadata()[config.AlertMetadataQuorum]); err == nil {
+		approvals, _ := strconv.Atoi(alert.GetMetadata()[config.AlertMetadataApprovals])
+		approvalReq.Approvals = int32(approvals)
+		approvalReq.Quorum = int32(q)
+	}
 	if tsUnix := alert.GetTimestampUnix(); tsUnix > 0 {
 		approvalReq.Timestamp = timestamppb.New(time.Unix(tsUnix, 0))
 	} else {
@@ -566,27 +619,12 @@ func (s *MobileLogicService) processIncomingAlert(alert *common.Alert, privKey e
 
 	// Try to decrypt encrypted payload for details
 	if alert.Encrypted != nil && privKey != nil {
-		cryptoPayload := &nitellacrypto.EncryptedPayload{
-			EphemeralPubKey:   alert.Encrypted.EphemeralPubkey,
-			Nonce:             alert.Encrypted.Nonce,
-			Ciphertext:        alert.Encrypted.Ciphertext,
-			SenderFingerprint: alert.Encrypted.SenderFingerprint,
-			Signature:         alert.Encrypted.Signature,
-		}
-
-		// Verify signature if present (zero-trust: reject forged alerts)
-		if len(cryptoPayload.Signature) > 0 {
-			// Look up node public key for signature verification
-			if nodePubKey := s.getNodePublicKey(alert.NodeId); nodePubKey != nil {
-				if err := nitellacrypto.VerifySignature(cryptoPayload, nodePubKey); err != nil {
-					// Signature verification failed — alert may be forged
-					return
-				}
-			}
+		if s.alertForged(alert) {
+			return
 		}
-
-		if plaintext, err := nitellacrypto.Decrypt(cryptoPayload, privKey); err == nil {
+		if plaintext := s.decryptAlertPayload(alert, privKey); plaintext != nil {
 			var info map[string]interface{}
+			var details common.AlertDetails
 			if json.Unmarshal(plaintext, &info) == nil {
 				if v, ok := info["source_ip"].(string); ok {
 					approvalReq.SourceIp = v
@@ -597,6 +635,9 @@ func (s *MobileLogicService) processIncomingAlert(alert *common.Alert, privKey e
 				if v, ok := info["proxy_id"].(string); ok {
 					approvalReq.ProxyId = v
 				}
+			} else if proto.Unmarshal(plaintext, &details) == nil {
+				// Nodes send proto-encoded AlertDetails
+				applyAlertDetails(approvalReq, &details)
 			}
 		}
 	}
@@ -604,9 +645,72 @@ func (s *MobileLogicService) processIncomingAlert(alert *common.Alert, privKey e
 	s.handleApprovalRequest(approvalReq)
 }
 
+// alertForged reports whether alert's payload carries a signature that does
+// not verify against its node's key.
+func (s *MobileLogicService) alertForged(alert *common.Alert) bool {
+	sig := alert.GetEncrypted().GetSignature()
+	if len(sig) == 0 {
+		return false
+	}
+	// Look up node public key for signature verification
+	nodePubKey := s.getNodePublicKey(alert.NodeId)
+	if nodePubKey == nil {
+		return false
+	}
+	return nitellacrypto.VerifySignature(alertCryptoPayload(alert), nodePubKey) != nil
+}
+
+// decryptAlertPayload returns alert's decrypted payload, or nil when it
+// cannot be decrypted or its signature does not verify (zero-trust: reject
+// forged alerts).
+func (s *MobileLogicService) decryptAlertPayload(alert *common.Alert, privKey ed25519.PrivateKey) []byte {
+	if alert.GetEncrypted() == nil || privKey == nil || s.alertForged(alert) {
+		return nil
+	}
+	plaintext, err := nitellacrypto.Decrypt(alertCryptoPayload(alert), privKey)
+	if err != nil {
+		return nil
+	}
+	return plaintext
+}
+
+func alertCryptoPayload(alert *common.Alert) *nitellacrypto.EncryptedPayload {
+	return &nitellacrypto.EncryptedPayload{
+		EphemeralPubKey:   alert.Encrypted.EphemeralPubkey,
+		Nonce:             alert.Encrypted.Nonce,
+		Ciphertext:        alert.Encrypted.Ciphertext,
+		SenderFingerprint: alert.Encrypted.SenderFingerprint,
+		Signature:         alert.Encrypted.Signature,
+	}
+}
+
+// applyAlertDetails fills req from a node's approval alert details.
+func applyAlertDetails(req *pb.ApprovalRequest, details *common.AlertDetails) {
+	req.SourceIp = details.GetSourceIp()
+	req.DestAddr = details.GetDestination()
+	req.ProxyId = details.GetProxyId()
+	req.ProxyName = details.GetProxyName()
+	req.RuleId = details.GetRuleId()
+	req.Context = details.GetContext()
+	req.TlsCn = details.GetContext().GetTlsCn()
+	if details.GetGeoCountry() != "" || details.GetGeoCity() != "" || details.GetGeoIsp() != "" {
+		req.Geo = &common.GeoInfo{
+			Country: details.GetGeoCountry(),
+			City:    details.GetGeoCity(),
+			Isp:     details.GetGeoIsp(),
+		}
+	}
+}
+
 // handleApprovalRequest processes an incoming approval request from a node.
 // This is called by the Hub connection handler when an approval alert arrives.
 func (s *MobileLogicService) handleApprovalRequest(req *pb.ApprovalRequest) {
+	// Nodes send approval requests both over P2P and through the Hub; the
+	// second copy is dropped unless it carries new quorum votes
+	if prev := s.getPendingApproval(req.RequestId); prev != nil && prev.GetApprovals() == req.GetApprovals() {
+		return
+	}
+
 	// Store pending approval for later lookup
 	s.addPendingApproval(req)
 
@@ -614,6 +718,35 @@ func (s *MobileLogicService) handleApprovalRequest(req *pb.ApprovalRequest) {
 	s.notifyApprovalStreams(req)
 }
 
+// dismissApproval drops a pending approval nodeID no longer waits on, e.g.
+// because another device decided first, and tells streams and the UI so
+// they dismiss the prompt. While this device is deciding the request
+// itself, the decision's response reports the outcome instead.
+func (s *MobileLogicService) dismissApproval(nodeID, requestID, resolution string) {
+	s.pendingApprovalsMu.Lock()
+	req, ok := s.pendingApprovals[requestID]
+	if !ok || req.GetNodeId() != nodeID {
+		s.pendingApprovalsMu.Unlock()
+		return
+	}
+	delete(s.pendingApprovals, requestID)
+	deciding := s.pendingDecisions[requestID]
+	s.pendingApprovalsMu.Unlock()
+	if deciding {
+		return
+	}
+
+	s.notifyApprovalStreams(&pb.ApprovalRequest{
+		RequestId:  requestID,
+		NodeId:     nodeID,
+		ProxyId:    req.GetProxyId(),
+		SourceIp:   req.GetSourceIp(),
+		Timestamp:  timestamppb.Now(),
+		Resolved:   true,
+		Resolution: resolution,
+	})
+}
+
 // addPendingApproval stores a pending approval for later lookup.
 func (s *MobileLogicService) addPendingApproval(req *pb.ApprovalRequest) {
 	s.pendingApprovalsMu.Lock()
@@ -750,6 +883,7 @@ func (s *MobileLogicService) buildApprovalHistoryEntry(
 		BlockType:       blockType,
 		RuleId:          ruleID,
 		DecidedAt:       timestamppb.Now(),
+		TlsCn:           pending.TlsCn,
 	}
 	if entry.NodeId == "" {
 		entry.NodeId = nodeID
@@ -0,0 +1,376 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ivere27/nitella/pkg/api/common"
+	pb "github.com/ivere27/nitella/pkg/api/local"
+	pbProxy "github.com/ivere27/nitella/pkg/api/proxy"
+	"google.golang.org/protobuf/proto"
+)
+
+// defaultRuleProposalMinDecisions is how many matching decisions, with none
+// the other way, a pattern needs before it is proposed as a rule.
+const defaultRuleProposalMinDecisions = 5
+
+// maxProposalScheduleHours is the widest daily window an allow proposal is
+// narrowed to; decisions spread wider than this get no time window.
+const maxProposalScheduleHours = 12
+
+// proposalPattern is a rule condition decisions can be grouped by.
+type proposalPattern struct {
+	condType common.ConditionType
+	op       common.Operator
+	allow    bool // Proposed for approvals
+	block    bool // Proposed for denials
+	value    func(e *pb.ApprovalHistoryEntry) string
+	label    func(e *pb.ApprovalHistoryEntry, value string) string
+}
+
+// proposalPatterns are tried narrowest first; a broader pattern covering
+// exactly the same decisions as a narrower one is not proposed.
+var proposalPatterns = []proposalPattern{
+	{
+		condType: common.ConditionType_CONDITION_TYPE_TLS_CN,
+		op:       common.Operator_OPERATOR_EQ,
+		allow:    true,
+		block:    true,
+		value:    func(e *pb.ApprovalHistoryEntry) string { return e.GetTlsCn() },
+		label:    func(_ *pb.ApprovalHistoryEntry, v string) string { return "client certificate CN=" + v },
+	},
+	{
+		condType: common.ConditionType_CONDITION_TYPE_SOURCE_IP,
+		op:       common.Operator_OPERATOR_EQ,
+		allow:    true,
+		block:    true,
+		value:    func(e *pb.ApprovalHistoryEntry) string { return e.GetSourceIp() },
+		label:    func(_ *pb.ApprovalHistoryEntry, v string) string { return v },
+	},
+	{
+		condType: common.ConditionType_CONDITION_TYPE_SOURCE_IP,
+		op:       common.Operator_OPERATOR_CIDR,
+		allow:    true,
+		block:    true,
+		value: func(e *pb.ApprovalHistoryEntry) string {
+			cidr, err := normalizeQuickSourceIP(e.GetSourceIp(), true)
+			if err != nil {
+				return ""
+			}
+			return cidr
+		},
+		label: func(_ *pb.ApprovalHistoryEntry, v string) string { return v },
+	},
+	{
+		condType: common.ConditionType_CONDITION_TYPE_GEO_ISP,
+		op:       common.Operator_OPERATOR_EQ,
+		allow:    true,
+		block:    true,
+		value:    func(e *pb.ApprovalHistoryEntry) string { return e.GetGeo().GetIsp() },
+		label: func(e *pb.ApprovalHistoryEntry, v string) string {
+			if as := strings.Fields(e.GetGeo().GetAs()); len(as) > 0 && strings.HasPrefix(as[0], "AS") {
+				return fmt.Sprintf("ISP %s (%s)", v, as[0])
+			}
+			return "ISP " + v
+		},
+	},
+	{
+		// Allowing a whole country is too broad to suggest
+		condType: common.ConditionType_CONDITION_TYPE_GEO_COUNTRY,
+		op:       common.Operator_OPERATOR_EQ,
+		block:    true,
+		value:    func(e *pb.ApprovalHistoryEntry) string { return e.GetGeo().GetCountry() },
+		label:    func(_ *pb.ApprovalHistoryEntry, v string) string { return "country " + v },
+	},
+}
+
+// proposeRules finds decisions in history that one rule would cover: at
+// least minDecisions approvals (or denials) on the same node and proxy
+// sharing a certificate CN, source IP or /24, ISP or, for denials, country,
+// with no decision the other way. Decisions already covered by a rule are
+// skipped. Allow proposals are narrowed to the days and hours the approvals
+// were made, in local time.
+func proposeRules(history []*pb.ApprovalHistoryEntry, minDecisions int) []*pb.RuleProposal {
+	if minDecisions <= 0 {
+		minDecisions = defaultRuleProposalMinDecisions
+	}
+
+	type scope struct{ nodeID, proxyID string }
+	byScope := make(map[scope][]*pb.ApprovalHistoryEntry)
+	var scopes []scope
+	for _, e := range history {
+		if e.GetRuleId() != "" {
+			continue
+		}
+		if e.GetAction() != pb.ApprovalHistoryAction_APPROVAL_HISTORY_ACTION_APPROVED &&
+			e.GetAction() != pb.ApprovalHistoryAction_APPROVAL_HISTORY_ACTION_DENIED {
+			continue
+		}
+		sc := scope{e.GetNodeId(), e.GetProxyId()}
+		if _, ok := byScope[sc]; !ok {
+			scopes = append(scopes, sc)
+		}
+		byScope[sc] = append(byScope[sc], e)
+	}
+
+	var proposals []*pb.RuleProposal
+	for _, sc := range scopes {
+		covered := make(map[string]bool) // Decision sets already proposed
+		for _, pat := range proposalPatterns {
+			groups := make(map[string][2][]*pb.ApprovalHistoryEntry) // value -> approvals, denials
+			var values []string
+			for _, e := range byScope[sc] {
+				v := pat.value(e)
+				if v == "" {
+					continue
+				}
+				g, ok := groups[v]
+				if !ok {
+					values = append(values, v)
+				}
+				if e.GetAction() == pb.ApprovalHistoryAction_APPROVAL_HISTORY_ACTION_APPROVED {
+					g[0] = append(g[0], e)
+				} else {
+					g[1] = append(g[1], e)
+				}
+				groups[v] = g
+			}
+
+			for _, v := range values {
+				g := groups[v]
+				allow := len(g[0]) > 0
+				decisions, conflicts := g[0], g[1]
+				if !allow {
+					decisions, conflicts = g[1], g[0]
+				}
+				if len(decisions) < minDecisions || len(conflicts) > 0 || (allow && !pat.allow) || (!allow && !pat.block) {
+					continue
+				}
+				key := decisionSetKey(allow, decisions)
+				if covered[key] {
+					continue
+				}
+				covered[key] = true
+				proposals = append(proposals, newRuleProposal(sc.nodeID, sc.proxyID, pat, v, allow, decisions))
+			}
+		}
+	}
+
+	sort.SliceStable(proposals, func(i, j int) bool {
+		return proposals[i].DecisionCount > proposals[j].DecisionCount
+	})
+	return proposals
+}
+
+// decisionSetKey identifies a set of decisions of one kind.
+func decisionSetKey(allow bool, decisions []*pb.ApprovalHistoryEntry) string {
+	ids := make([]string, 0, len(decisions))
+	for _, e := range decisions {
+		ids = append(ids, e.GetRequestId())
+	}
+	sort.Strings(ids)
+	return fmt.Sprintf("%v:%s", allow, strings.Join(ids, ","))
+}
+
+func newRuleProposal(nodeID, proxyID string, pat proposalPattern, value string, allow bool, decisions []*pb.ApprovalHistoryEntry) *pb.RuleProposal {
+	label := pat.label(decisions[0], value)
+	rule := &pbProxy.Rule{
+		Enabled:    true,
+		Action:     common.ActionType_ACTION_TYPE_BLOCK,
+		Conditions: []*pbProxy.Condition{{Type: pat.condType, Op: pat.op, Value: value}},
+	}
+	summary := fmt.Sprintf("Denied %d requests from %s", len(decisions), label)
+	if allow {
+		rule.Action = common.ActionType_ACTION_TYPE_ALLOW
+		rule.Schedule = proposalSchedule(decisions)
+		summary = fmt.Sprintf("Approved %d requests from %s", len(decisions), label)
+		if rule.Schedule != "" {
+			summary += ", " + rule.Schedule
+		}
+		rule.Name = "Learned: Allow " + label
+	} else {
+		rule.Name = "Learned: Block " + label
+	}
+
+	sum := sha256.Sum256([]byte(strings.Join([]string{nodeID, proxyID, rule.Action.String(), pat.condType.String(), pat.op.String(), value, rule.Schedule}, "\x00")))
+	matched := make([]*pb.ApprovalHistoryEntry, 0, len(decisions))
+	for _, e := range decisions {
+		matched = append(matched, cloneApprovalHistoryEntry(e))
+	}
+	return &pb.RuleProposal{
+		ProposalId:    hex.EncodeToString(sum[:6]),
+		NodeId:        nodeID,
+		ProxyId:       proxyID,
+		Rule:          rule,
+		Summary:       summary,
+		DecisionCount: int32(len(decisions)),
+		Matched:       matched,
+	}
+}
+
+// proposalSchedule is the rule schedule covering when decisions were made:
+// "Mon-Fri" when all fell on weekdays, and the whole hours spanning them
+// when that is at most maxProposalScheduleHours. "" when neither applies.
+func proposalSchedule(decisions []*pb.ApprovalHistoryEntry) string {
+	weekdays := true
+	first, last := 24, -1
+	for _, e := range decisions {
+		if e.GetDecidedAt() == nil {
+			return ""
+		}
+		t := e.GetDecidedAt().AsTime().In(time.Local)
+		if t.Weekday() == time.Saturday || t.Weekday() == time.Sunday {
+			weekdays = false
+		}
+		if t.Hour() < first {
+			first = t.Hour()
+		}
+		if t.Hour() > last {
+			last = t.Hour()
+		}
+	}
+
+	var parts []string
+	if weekdays {
+		parts = append(parts, "Mon-Fri")
+	}
+	if last+1-first <= maxProposalScheduleHours {
+		parts = append(parts, fmt.Sprintf("%02d:00-%02d:00", first, (last+1)%24))
+	}
+	return strings.Join(parts, " ")
+}
+
+// proposalPriority is the priority for a proposed rule on a proxy with
+// rules: one above its highest approval rule, so the proposal decides the
+// connections that rule would otherwise hold for approval.
+func proposalPriority(rules []*pbProxy.Rule) int32 {
+	var priority int32
+	for _, r := range rules {
+		if r.GetAction() == common.ActionType_ACTION_TYPE_REQUIRE_APPROVAL && r.GetPriority() >= priority {
+			priority = r.GetPriority() + 1
+		}
+	}
+	return priority
+}
+
+// ruleProposals proposes rules from the approval history of nodeID (all
+// nodes when empty).
+func (s *MobileLogicService) ruleProposals(nodeID string, minDecisions int) []*pb.RuleProposal {
+	s.approvalHistoryMu.RLock()
+	history := make([]*pb.ApprovalHistoryEntry, 0, len(s.approvalHistory))
+	for _, e := range s.approvalHistory {
+		if nodeID == "" || e.GetNodeId() == nodeID {
+			history = append(history, e)
+		}
+	}
+	proposals := proposeRules(history, minDecisions)
+	s.approvalHistoryMu.RUnlock()
+	return proposals
+}
+
+// ListRuleProposals proposes permanent rules for decisions the user keeps
+// making by hand, each with the past decisions it would have matched.
+func (s *MobileLogicService) ListRuleProposals(ctx context.Context, req *pb.ListRuleProposalsRequest) (*pb.ListRuleProposalsResponse, error) {
+	_ = ctx
+	return &pb.ListRuleProposalsResponse{
+		Proposals: s.ruleProposals(strings.TrimSpace(req.GetNodeId()), int(req.GetMinDecisions())),
+	}, nil
+}
+
+// AcceptRuleProposal creates the rule a proposal describes, on every proxy
+// of the node when the proposal is not for one proxy, above that proxy's
+// approval rules. The decisions it covers are marked with the rule so they
+// are not proposed again.
+func (s *MobileLogicService) AcceptRuleProposal(ctx context.Context, req *pb.AcceptRuleProposalRequest) (*pb.AcceptRuleProposalResponse, error) {
+	proposalID := strings.TrimSpace(req.GetProposalId())
+	if proposalID == "" {
+		return &pb.AcceptRuleProposalResponse{Success: false, Error: "proposal_id is required"}, nil
+	}
+
+	var proposal *pb.RuleProposal
+	for _, p := range s.ruleProposals(strings.TrimSpace(req.GetNodeId()), int(req.GetMinDecisions())) {
+		if p.GetProposalId() == proposalID {
+			proposal = p
+			break
+		}
+	}
+	if proposal == nil {
+		return &pb.AcceptRuleProposalResponse{Success: false, Error: "proposal not found: " + proposalID}, nil
+	}
+
+	proxyTargets, err := s.resolveQuickRuleProxyTargets(ctx, proposal.GetNodeId(), proposal.GetProxyId())
+	if err != nil {
+		return &pb.AcceptRuleProposalResponse{Success: false, Error: err.Error()}, nil
+	}
+
+	var rulesCreated int32
+	ruleID := ""
+	lastErr := ""
+	for _, targetProxyID := range proxyTargets {
+		// The rule must outrank the approval rule that kept asking
+		existing, err := s.ListRules(ctx, &pb.ListRulesRequest{
+			NodeId:  proposal.GetNodeId(),
+			ProxyId: targetProxyID,
+		})
+		if err != nil {
+			lastErr = err.Error()
+			continue
+		}
+		rule := proto.Clone(proposal.GetRule()).(*pbProxy.Rule)
+		rule.Priority = proposalPriority(existing.GetRules())
+		created, err := s.AddRule(ctx, &pb.AddRuleRequest{
+			NodeId:  proposal.GetNodeId(),
+			ProxyId: targetProxyID,
+			Rule:    rule,
+		})
+		if err != nil {
+			lastErr = err.Error()
+			continue
+		}
+		if ruleID == "" {
+			ruleID = created.GetId()
+		}
+		rulesCreated++
+	}
+	if rulesCreated == 0 {
+		return &pb.AcceptRuleProposalResponse{Success: false, Error: lastErr}, nil
+	}
+
+	resp := &pb.AcceptRuleProposalResponse{
+		Success:      true,
+		RuleId:       ruleID,
+		RulesCreated: rulesCreated,
+	}
+	if err := s.markHistoryCovered(proposal.GetMatched(), ruleID); err != nil {
+		resp.Error = "rule created but failed to persist approval history: " + err.Error()
+	}
+	return resp, nil
+}
+
+// markHistoryCovered records that ruleID now covers the decisions in
+// entries.
+func (s *MobileLogicService) markHistoryCovered(entries []*pb.ApprovalHistoryEntry, ruleID string) error {
+	if ruleID == "" {
+		ruleID = "rule_created"
+	}
+	covered := make(map[string]bool, len(entries))
+	for _, e := range entries {
+		covered[e.GetNodeId()+"\x00"+e.GetRequestId()] = true
+	}
+
+	s.approvalHistoryMu.Lock()
+	defer s.approvalHistoryMu.Unlock()
+	for i, e := range s.approvalHistory {
+		if covered[e.GetNodeId()+"\x00"+e.GetRequestId()] {
+			e = cloneApprovalHistoryEntry(e)
+			e.RuleId = ruleID
+			s.approvalHistory[i] = e
+		}
+	}
+	return s.saveApprovalHistoryLocked()
+}
@@ -0,0 +1,152 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/ivere27/nitella/pkg/api/common"
+	pb "github.com/ivere27/nitella/pkg/api/local"
+	pbProxy "github.com/ivere27/nitella/pkg/api/proxy"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// learningEntry is a decision on proxy p1 of node-1 made on Wednesday
+// 2026-10-14 at hour:00 local time.
+func learningEntry(id string, approved bool, ip string, geo *common.GeoInfo, hour int) *pb.ApprovalHistoryEntry {
+	action := pb.ApprovalHistoryAction_APPROVAL_HISTORY_ACTION_DENIED
+	if approved {
+		action = pb.ApprovalHistoryAction_APPROVAL_HISTORY_ACTION_APPROVED
+	}
+	return &pb.ApprovalHistoryEntry{
+		RequestId: id,
+		NodeId:    "node-1",
+		ProxyId:   "p1",
+		SourceIp:  ip,
+		Geo:       geo,
+		Action:    action,
+		DecidedAt: timestamppb.New(time.Date(2026, 10, 14, hour, 0, 0, 0, time.Local)),
+	}
+}
+
+func TestProposeRules(t *testing.T) {
+	kt := &common.GeoInfo{Country: "KR", Isp: "Korea Telecom", As: "AS4766 Korea Telecom"}
+	var history []*pb.ApprovalHistoryEntry
+	// Office ISP approved from many addresses during business hours
+	for i := 0; i < 6; i++ {
+		history = append(history, learningEntry(fmt.Sprintf("kt-%d", i), true, fmt.Sprintf("175.%d.1.10", i), kt, 10+i))
+	}
+	// Denied from one country across ISPs
+	for i := 0; i < 5; i++ {
+		geo := &common.GeoInfo{Country: "CN", Isp: fmt.Sprintf("ISP %d", i)}
+		history = append(history, learningEntry(fmt.Sprintf("cn-%d", i), false, fmt.Sprintf("36.%d.0.1", i), geo, 3))
+	}
+	// Mixed decisions for one address propose nothing
+	for i := 0; i < 5; i++ {
+		history = append(history, learningEntry(fmt.Sprintf("mixed-%d", i), true, "198.51.100.7", nil, 12))
+	}
+	history = append(history, learningEntry("mixed-deny", false, "198.51.100.7", nil, 12))
+	// Decisions already covered by a rule are ignored
+	for i := 0; i < 5; i++ {
+		e := learningEntry(fmt.Sprintf("ruled-%d", i), true, "203.0.113.9", nil, 12)
+		e.RuleId = "r-1"
+		history = append(history, e)
+	}
+
+	proposals := proposeRules(history, 0)
+	if len(proposals) != 2 {
+		for _, p := range proposals {
+			t.Logf("proposal: %s", p.GetSummary())
+		}
+		t.Fatalf("expected 2 proposals, got %d", len(proposals))
+	}
+
+	allow := proposals[0]
+	if got, want := allow.GetSummary(), "Approved 6 requests from ISP Korea Telecom (AS4766), Mon-Fri 10:00-16:00"; got != want {
+		t.Fatalf("unexpected summary: got=%q want=%q", got, want)
+	}
+	rule := allow.GetRule()
+	if rule.GetAction() != common.ActionType_ACTION_TYPE_ALLOW || rule.GetSchedule() != "Mon-Fri 10:00-16:00" ||
+		rule.GetConditions()[0].GetType() != common.ConditionType_CONDITION_TYPE_GEO_ISP || rule.GetConditions()[0].GetValue() != "Korea Telecom" {
+		t.Fatalf("unexpected allow rule: %v", rule)
+	}
+	if allow.GetNodeId() != "node-1" || allow.GetProxyId() != "p1" || len(allow.GetMatched()) != 6 {
+		t.Fatalf("unexpected allow proposal scope or preview: %v", allow)
+	}
+
+	block := proposals[1]
+	if block.GetRule().GetAction() != common.ActionType_ACTION_TYPE_BLOCK || block.GetRule().GetSchedule() != "" ||
+		block.GetRule().GetConditions()[0].GetType() != common.ConditionType_CONDITION_TYPE_GEO_COUNTRY {
+		t.Fatalf("unexpected block rule: %v", block.GetRule())
+	}
+	if block.GetSummary() != "Denied 5 requests from country CN" {
+		t.Fatalf("unexpected summary: %q", block.GetSummary())
+	}
+
+	if again := proposeRules(history, 0); again[0].GetProposalId() != allow.GetProposalId() {
+		t.Fatalf("expected stable proposal IDs")
+	}
+}
+
+func TestProposeRulesPrefersNarrowestCondition(t *testing.T) {
+	geo := &common.GeoInfo{Country: "KR", Isp: "Home ISP"}
+	var history []*pb.ApprovalHistoryEntry
+	for i := 0; i < 5; i++ {
+		e := learningEntry(fmt.Sprintf("req-%d", i), true, "192.0.2.10", geo, 9)
+		e.TlsCn = "alice"
+		history = append(history, e)
+	}
+
+	proposals := proposeRules(history, 5)
+	if len(proposals) != 1 {
+		t.Fatalf("expected 1 proposal, got %d", len(proposals))
+	}
+	cond := proposals[0].GetRule().GetConditions()[0]
+	if cond.GetType() != common.ConditionType_CONDITION_TYPE_TLS_CN || cond.GetValue() != "alice" {
+		t.Fatalf("expected the certificate CN proposed, got %v", cond)
+	}
+	if got := proposals[0].GetRule().GetSchedule(); got != "Mon-Fri 09:00-10:00" {
+		t.Fatalf("unexpected schedule: %q", got)
+	}
+}
+
+func TestProposalPriorityOutranksApprovalRules(t *testing.T) {
+	rules := []*pbProxy.Rule{
+		{Id: "block", Priority: 500, Action: common.ActionType_ACTION_TYPE_BLOCK},
+		{Id: "ask", Priority: 100, Action: common.ActionType_ACTION_TYPE_REQUIRE_APPROVAL},
+		{Id: "ask-low", Priority: 10, Action: common.ActionType_ACTION_TYPE_REQUIRE_APPROVAL},
+		{Id: "allow", Priority: 0, Action: common.ActionType_ACTION_TYPE_ALLOW},
+	}
+	if got := proposalPriority(rules); got != 101 {
+		t.Fatalf("expected priority 101 above the approval rule, got %d", got)
+	}
+	if got := proposalPriority(rules[3:]); got != 0 {
+		t.Fatalf("expected priority 0 without approval rules, got %d", got)
+	}
+}
+
+func TestAcceptedProposalIsNotProposedAgain(t *testing.T) {
+	svc := NewMobileLogicService()
+	svc.dataDir = t.TempDir()
+	for i := 0; i < 5; i++ {
+		svc.approvalHistory = append(svc.approvalHistory, learningEntry(fmt.Sprintf("req-%d", i), false, "192.0.2.66", nil, 2))
+	}
+
+	resp, err := svc.ListRuleProposals(context.Background(), &pb.ListRuleProposalsRequest{NodeId: "node-1"})
+	if err != nil || len(resp.GetProposals()) != 1 {
+		t.Fatalf("expected 1 proposal, got %v, %v", resp.GetProposals(), err)
+	}
+	if err := svc.markHistoryCovered(resp.GetProposals()[0].GetMatched(), "r-9"); err != nil {
+		t.Fatalf("markHistoryCovered() error = %v", err)
+	}
+	resp, _ = svc.ListRuleProposals(context.Background(), &pb.ListRuleProposalsRequest{})
+	if len(resp.GetProposals()) != 0 {
+		t.Fatalf("expected no proposals once covered, got %d", len(resp.GetProposals()))
+	}
+
+	accept, _ := svc.AcceptRuleProposal(context.Background(), &pb.AcceptRuleProposalRequest{ProposalId: "missing"})
+	if accept.GetSuccess() {
+		t.Fatalf("expected an unknown proposal rejected")
+	}
+}
@@ -2,12 +2,17 @@ package service
 
 import (
 	"context"
+	"crypto/ed25519"
 	"strings"
 	"testing"
 	"time"
 
 	"github.com/ivere27/nitella/pkg/api/common"
 	pb "github.com/ivere27/nitella/pkg/api/local"
+	"github.com/ivere27/nitella/pkg/config"
+	nitellacrypto "github.com/ivere27/nitella/pkg/crypto"
+	"github.com/ivere27/nitella/pkg/p2p"
+	"google.golang.org/protobuf/proto"
 )
 
 func TestResolveApprovalDecisionRequiresDecision(t *testing.T) {
@@ -180,3 +185,137 @@ func TestProcessIncomingAlertSetsTimestampWhenMissingInAlert(t *testing.T) {
 		t.Fatalf("unexpected timestamp range: got=%s before=%s after=%s", got, before, after)
 	}
 }
+
+func TestProcessIncomingAlertDecryptsApprovalContext(t *testing.T) {
+	svc := NewMobileLogicService()
+	priv, err := nitellacrypto.GenerateKey()
+	if err != nil {
+		t.Fatalf("GenerateKey() error = %v", err)
+	}
+	info, _ := proto.Marshal(&common.AlertDetails{
+		SourceIp: "192.0.2.1",
+		Context: &common.ApprovalContext{
+			ConnectionCount: 12,
+			PriorDenials:    2,
+			ReverseDns:      "gw.example.com",
+			IpSets:          []string{"office"},
+			TlsCn:           "alice",
+		},
+	})
+	enc, err := nitellacrypto.Encrypt(info, priv.Public().(ed25519.PublicKey))
+	if err != nil {
+		t.Fatalf("Encrypt() error = %v", err)
+	}
+
+	svc.processIncomingAlert(&common.Alert{
+		Id:     "req-3",
+		NodeId: "node-1",
+		Encrypted: &common.EncryptedPayload{
+			EphemeralPubkey: enc.EphemeralPubKey,
+			Nonce:           enc.Nonce,
+			Ciphertext:      enc.Ciphertext,
+		},
+	}, priv)
+
+	req := svc.getPendingApproval("req-3")
+	if req == nil {
+		t.Fatalf("expected pending approval to be stored")
+	}
+	if got := req.GetContext(); got.GetConnectionCount() != 12 || got.GetPriorDenials() != 2 ||
+		got.GetReverseDns() != "gw.example.com" || len(got.GetIpSets()) != 1 {
+		t.Fatalf("unexpected approval context: %v", got)
+	}
+	if req.GetTlsCn() != "alice" {
+		t.Fatalf("unexpected tls cn: got=%q want=%q", req.GetTlsCn(), "alice")
+	}
+}
+
+func encryptedAlert(t *testing.T, priv ed25519.PrivateKey, id, alertType string, details *common.AlertDetails) *common.Alert {
+	t.Helper()
+	info, _ := proto.Marshal(details)
+	enc, err := nitellacrypto.Encrypt(info, priv.Public().(ed25519.PublicKey))
+	if err != nil {
+		t.Fatalf("Encrypt() error = %v", err)
+	}
+	return &common.Alert{
+		Id:       id,
+		NodeId:   "node-1",
+		Metadata: map[string]string{config.AlertMetadataType: alertType},
+		Encrypted: &common.EncryptedPayload{
+			EphemeralPubkey: enc.EphemeralPubKey,
+			Nonce:           enc.Nonce,
+			Ciphertext:      enc.Ciphertext,
+		},
+	}
+}
+
+func TestProcessIncomingAlertDismissesResolvedApproval(t *testing.T) {
+	svc := NewMobileLogicService()
+	priv, err := nitellacrypto.GenerateKey()
+	if err != nil {
+		t.Fatalf("GenerateKey() error = %v", err)
+	}
+	ch := make(chan *pb.ApprovalRequest, 4)
+	svc.approvalStreams = append(svc.approvalStreams, ch)
+
+	svc.processIncomingAlert(encryptedAlert(t, priv, "req-4", config.AlertTypeApproval,
+		&common.AlertDetails{SourceIp: "192.0.2.1"}), priv)
+	<-ch
+
+	// Another node cannot dismiss the request
+	other := encryptedAlert(t, priv, "req-4", config.AlertTypeApprovalResolved,
+		&common.AlertDetails{Fields: map[string]string{config.AlertFieldDecision: config.ApprovalDecisionAllow}})
+	other.NodeId = "node-2"
+	svc.processIncomingAlert(other, priv)
+	if svc.getPendingApproval("req-4") == nil {
+		t.Fatalf("expected approval to stay pending after another node's resolution")
+	}
+
+	svc.processIncomingAlert(encryptedAlert(t, priv, "req-4", config.AlertTypeApprovalResolved,
+		&common.AlertDetails{Fields: map[string]string{config.AlertFieldDecision: config.ApprovalDecisionDeny}}), priv)
+	if svc.getPendingApproval("req-4") != nil {
+		t.Fatalf("expected approval to be dismissed")
+	}
+	select {
+	case got := <-ch:
+		if !got.GetResolved() || got.GetResolution() != config.ApprovalDecisionDeny || got.GetSourceIp() != "192.0.2.1" {
+			t.Fatalf("unexpected dismissal: %v", got)
+		}
+	default:
+		t.Fatalf("expected dismissal to reach approval streams")
+	}
+}
+
+func TestDismissApprovalSkipsNotifyingOwnDecision(t *testing.T) {
+	svc := NewMobileLogicService()
+	ch := make(chan *pb.ApprovalRequest, 4)
+	svc.approvalStreams = append(svc.approvalStreams, ch)
+	svc.pendingApprovals["req-5"] = &pb.ApprovalRequest{RequestId: "req-5", NodeId: "node-1"}
+	if !svc.beginPendingDecision("req-5") {
+		t.Fatalf("expected decision to begin")
+	}
+
+	svc.dismissApproval("node-1", "req-5", config.ApprovalDecisionAllow)
+	if svc.getPendingApproval("req-5") != nil {
+		t.Fatalf("expected approval to be dismissed")
+	}
+	if len(ch) != 0 {
+		t.Fatalf("unexpected dismissal notification for a request decided here")
+	}
+}
+
+func TestApprovalRequestFromHubAndP2PShownOnce(t *testing.T) {
+	svc := NewMobileLogicService()
+	ch := make(chan *pb.ApprovalRequest, 4)
+	svc.approvalStreams = append(svc.approvalStreams, ch)
+
+	svc.handleP2PApprovalRequest("node-1", &p2p.ApprovalRequest{RequestID: "req-6", SourceIP: "192.0.2.1"})
+	svc.processIncomingAlert(&common.Alert{Id: "req-6", NodeId: "node-1"}, nil)
+
+	if len(ch) != 1 {
+		t.Fatalf("unexpected approval notifications: got=%d want=1", len(ch))
+	}
+	if got := <-ch; got.GetRequestId() != "req-6" {
+		t.Fatalf("unexpected request id: got=%q want=%q", got.GetRequestId(), "req-6")
+	}
+}
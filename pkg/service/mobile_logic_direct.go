@@ -635,6 +635,8 @@ func (s *MobileLogicService) listPendingApprovalsDirect(ctx context.Context, nod
 					City:    a.GeoCity,
 					Isp:     a.GeoIsp,
 				},
+				Approvals: a.Approvals,
+				Quorum:    a.Quorum,
 			})
 		}
 	}
@@ -643,12 +645,14 @@ func (s *MobileLogicService) listPendingApprovalsDirect(ctx context.Context, nod
 
 // approveRequestDirect approves a request on a direct node.
 func (s *MobileLogicService) approveRequestDirect(ctx context.Context, nodeID, reqID string, duration int64, retentionMode common.ApprovalRetentionMode) (*pb.ApproveRequestResponse, error) {
-	result, err := s.secureDirectCommand(ctx, nodeID, hubpb.CommandType_COMMAND_TYPE_RESOLVE_APPROVAL, &pbProxy.ResolveApprovalRequest{
+	resolveReq := &pbProxy.ResolveApprovalRequest{
 		ReqId:           reqID,
 		Action:          common.ApprovalActionType_APPROVAL_ACTION_TYPE_ALLOW,
 		RetentionMode:   retentionMode,
 		DurationSeconds: duration,
-	})
+	}
+	s.signApprovalVote(resolveReq)
+	result, err := s.secureDirectCommand(ctx, nodeID, hubpb.CommandType_COMMAND_TYPE_RESOLVE_APPROVAL, resolveReq)
 	if err != nil {
 		return &pb.ApproveRequestResponse{Success: false, Error: err.Error()}, nil
 	}
@@ -689,12 +693,14 @@ func (s *MobileLogicService) denyRequestDirect(ctx context.Context, nodeID, reqI
 	}
 
 	// Resolve the approval as BLOCK
-	result, err := s.secureDirectCommand(ctx, nodeID, hubpb.CommandType_COMMAND_TYPE_RESOLVE_APPROVAL, &pbProxy.ResolveApprovalRequest{
+	resolveReq := &pbProxy.ResolveApprovalRequest{
 		ReqId:           reqID,
 		Action:          common.ApprovalActionType_APPROVAL_ACTION_TYPE_BLOCK,
 		RetentionMode:   retentionMode,
 		DurationSeconds: duration,
-	})
+	}
+	s.signApprovalVote(resolveReq)
+	result, err := s.secureDirectCommand(ctx, nodeID, hubpb.CommandType_COMMAND_TYPE_RESOLVE_APPROVAL, resolveReq)
 	if err != nil {
 		return &pb.DenyRequestResponse{Success: false, Error: err.Error()}, nil
 	}
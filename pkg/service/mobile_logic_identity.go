@@ -77,6 +77,7 @@ func (s *MobileLogicService) CreateIdentity(ctx context.Context, req *pb.CreateI
 	if s.ctrl != nil {
 		s.ctrl.SetIdentity(id)
 	}
+	s.loadApproverKeyLocked()
 
 	// Capture mnemonic for one-time display, then wipe from memory
 	mnemonic := id.Mnemonic
@@ -148,6 +149,7 @@ func (s *MobileLogicService) RestoreIdentity(ctx context.Context, req *pb.Restor
 	if s.ctrl != nil {
 		s.ctrl.SetIdentity(id)
 	}
+	s.loadApproverKeyLocked()
 
 	return &pb.RestoreIdentityResponse{
 		Success:  true,
@@ -204,6 +206,7 @@ func (s *MobileLogicService) ImportIdentity(ctx context.Context, req *pb.ImportI
 	if s.ctrl != nil {
 		s.ctrl.SetIdentity(id)
 	}
+	s.loadApproverKeyLocked()
 
 	return &pb.ImportIdentityResponse{
 		Success:  true,
@@ -237,6 +240,7 @@ func (s *MobileLogicService) UnlockIdentity(ctx context.Context, req *pb.UnlockI
 	if s.ctrl != nil {
 		s.ctrl.SetIdentity(id)
 	}
+	s.loadApproverKeyLocked()
 
 	// Initialize P2P Transport with new identity
 	if s.mobileClient != nil {
@@ -390,7 +394,7 @@ func (s *MobileLogicService) ResetIdentity(ctx context.Context, _ *emptypb.Empty
 	}
 
 	// 5. Delete identity files from disk
-	for _, name := range []string{"root_ca.crt", "root_ca.key"} {
+	for _, name := range []string{"root_ca.crt", "root_ca.key", approverKeyFile} {
 		p := filepath.Join(s.dataDir, name)
 		os.Remove(p)
 	}
@@ -413,6 +417,7 @@ func (s *MobileLogicService) ResetIdentity(ctx context.Context, _ *emptypb.Empty
 	if s.ctrl != nil {
 		s.ctrl.SetIdentity(nil)
 	}
+	s.approverKey = nil
 	s.nodes = make(map[string]*pb.NodeInfo)
 	s.nodePublicKeys = make(map[string]ed25519.PublicKey)
 	s.pairingSessions = make(map[string]*pairingSession)
@@ -454,6 +459,53 @@ func (s *MobileLogicService) saveIdentity(id *identity.Identity, passphrase stri
 	return nil
 }
 
+// approverKeyFile holds this device's approver key in the data directory.
+const approverKeyFile = "approver.key"
+
+// loadApproverKeyLocked loads this device's approver key, creating it with
+// the identity. Nodes count the decisions it signs towards quorums once its
+// public key (IdentityInfo.approver_public_key_pem) is registered with
+// --approver-keys. A key set with SetApproverKey is kept.
+// Caller MUST hold write lock.
+func (s *MobileLogicService) loadApproverKeyLocked() {
+	if s.approverKey != nil {
+		return
+	}
+	path := filepath.Join(s.dataDir, approverKeyFile)
+	if keyPEM, err := os.ReadFile(path); err == nil {
+		key, err := nitellacrypto.DecodePrivateKeyFromPEM(keyPEM)
+		if err != nil {
+			log.Printf("warning: failed to load approver key: %v\n", err)
+			return
+		}
+		s.approverKey = key
+		return
+	} else if !os.IsNotExist(err) {
+		log.Printf("warning: failed to load approver key: %v\n", err)
+		return
+	}
+
+	key, err := nitellacrypto.GenerateKey()
+	if err != nil {
+		log.Printf("warning: failed to create approver key: %v\n", err)
+		return
+	}
+	if err := writeFile(path, nitellacrypto.EncodePrivateKeyToPEM(key), 0600); err != nil {
+		log.Printf("warning: failed to save approver key: %v\n", err)
+		return
+	}
+	s.approverKey = key
+}
+
+// approverPublicKeyPEM returns the approver public key as PEM, or "" if
+// there is none. Caller MUST hold lock.
+func (s *MobileLogicService) approverPublicKeyPEM() string {
+	if s.approverKey == nil {
+		return ""
+	}
+	return string(nitellacrypto.EncodePublicKeyToPEM(s.approverKey.Public().(ed25519.PublicKey)))
+}
+
 // writeFile is a helper to write data to a file with given permissions.
 func writeFile(path string, data []byte, perm os.FileMode) error {
 	return os.WriteFile(path, data, perm)
@@ -1,10 +1,17 @@
 package service
 
 import (
+	"bytes"
 	"context"
+	"os"
+	"path/filepath"
 	"testing"
 
+	"github.com/ivere27/nitella/pkg/api/common"
 	pb "github.com/ivere27/nitella/pkg/api/local"
+	pbProxy "github.com/ivere27/nitella/pkg/api/proxy"
+	nitellacrypto "github.com/ivere27/nitella/pkg/crypto"
+	"google.golang.org/protobuf/types/known/emptypb"
 )
 
 func TestEvaluatePassphrase(t *testing.T) {
@@ -54,3 +61,43 @@ func TestValidatePassphrasePolicy(t *testing.T) {
 		t.Fatalf("strong passphrase should be allowed: %v", err)
 	}
 }
+
+func TestCreateIdentityProvisionsApproverKey(t *testing.T) {
+	ctx := context.Background()
+	dataDir := t.TempDir()
+
+	svc := NewMobileLogicService()
+	svc.dataDir = dataDir
+	resp, err := svc.CreateIdentity(ctx, &pb.CreateIdentityRequest{CommonName: "Test User"})
+	if err != nil || !resp.GetSuccess() {
+		t.Fatalf("CreateIdentity() = %v, %v", resp.GetError(), err)
+	}
+	pubPEM := resp.GetIdentity().GetApproverPublicKeyPem()
+	pub, err := nitellacrypto.DecodePublicKeyFromPEM([]byte(pubPEM))
+	if err != nil {
+		t.Fatalf("approver public key %q: %v", pubPEM, err)
+	}
+
+	// Decisions are signed with the key
+	req := &pbProxy.ResolveApprovalRequest{ReqId: "req-1", Action: common.ApprovalActionType_APPROVAL_ACTION_TYPE_ALLOW}
+	svc.signApprovalVote(req)
+	payload := nitellacrypto.ApprovalVotePayload(req.ReqId, int32(req.Action), int32(req.RetentionMode), req.DurationSeconds, req.Reason)
+	if !bytes.Equal(req.GetApproverKey(), pub) || nitellacrypto.Verify(payload, req.GetSignature(), pub) != nil {
+		t.Fatalf("expected the decision signed with the approver key")
+	}
+
+	// The same key is loaded on the next start, and removed with the identity
+	restarted := NewMobileLogicService()
+	if _, err := restarted.Initialize(ctx, &pb.InitializeRequest{DataDir: dataDir, CacheDir: t.TempDir()}); err != nil {
+		t.Fatalf("Initialize() error = %v", err)
+	}
+	if got := restarted.buildIdentityInfo().GetApproverPublicKeyPem(); got != pubPEM {
+		t.Fatalf("approver key after restart = %q, want %q", got, pubPEM)
+	}
+	if _, err := restarted.ResetIdentity(ctx, &emptypb.Empty{}); err != nil {
+		t.Fatalf("ResetIdentity() error = %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(dataDir, approverKeyFile)); !os.IsNotExist(err) {
+		t.Fatalf("expected the approver key removed, stat error = %v", err)
+	}
+}
@@ -124,6 +124,7 @@ func defaultRuleComposerPolicy() *pb.RuleComposerPolicy {
 			common.ActionType_ACTION_TYPE_BLOCK,
 			common.ActionType_ACTION_TYPE_MOCK,
 			common.ActionType_ACTION_TYPE_REQUIRE_APPROVAL,
+			common.ActionType_ACTION_TYPE_ALLOW_ALERT,
 		},
 		DefaultPriority: 100,
 	}
@@ -540,6 +540,8 @@ func actionTypeToString(at common.ActionType) string {
 		return "mock"
 	case common.ActionType_ACTION_TYPE_REQUIRE_APPROVAL:
 		return "ask"
+	case common.ActionType_ACTION_TYPE_ALLOW_ALERT:
+		return "alert"
 	default:
 		return "unknown"
 	}
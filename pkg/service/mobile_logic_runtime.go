@@ -112,6 +112,34 @@ func (s *MobileLogicService) GetGeoIPStatus(ctx context.Context, req *pb.GetGeoI
 	return resp, nil
 }
 
+// GetMockTranscripts returns fake shell sessions recorded by a node's honeypot listeners.
+func (s *MobileLogicService) GetMockTranscripts(ctx context.Context, req *pb.GetMockTranscriptsNodeRequest) (*pbProxy.GetMockTranscriptsResponse, error) {
+	if req.NodeId == "" {
+		return nil, fmt.Errorf("node_id is required")
+	}
+
+	result, err := s.sendNodeRuntimeCommand(ctx, req.NodeId, pbHub.CommandType_COMMAND_TYPE_GET_MOCK_TRANSCRIPTS, &pbProxy.GetMockTranscriptsRequest{
+		ConnId:   req.ConnId,
+		SourceIp: req.SourceIp,
+		Limit:    req.Limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if result.Status != "OK" {
+		return nil, fmt.Errorf("failed to get transcripts: %s", result.ErrorMessage)
+	}
+
+	resp := &pbProxy.GetMockTranscriptsResponse{}
+	if len(result.ResponsePayload) > 0 {
+		if err := proto.Unmarshal(result.ResponsePayload, resp); err != nil {
+			return nil, fmt.Errorf("failed to parse response: %w", err)
+		}
+	}
+
+	return resp, nil
+}
+
 // sendNodeRuntimeCommand sends runtime-control commands to either direct or hub-connected nodes.
 func (s *MobileLogicService) sendNodeRuntimeCommand(ctx context.Context, nodeID string, cmdType pbHub.CommandType, req proto.Message) (*pbHub.CommandResult, error) {
 	s.mu.RLock()
@@ -21,6 +21,7 @@ import (
 	"github.com/ivere27/nitella/pkg/api/common"
 	pbHub "github.com/ivere27/nitella/pkg/api/hub"
 	pb "github.com/ivere27/nitella/pkg/api/local"
+	"github.com/ivere27/nitella/pkg/config"
 	"github.com/ivere27/nitella/pkg/core"
 	"github.com/ivere27/nitella/pkg/geoip"
 	"github.com/ivere27/nitella/pkg/hub/routing"
@@ -61,6 +62,9 @@ type MobileLogicService struct {
 	identity     *identity.Identity
 	identityLock bool // True if identity is locked (key not in memory)
 
+	// Approver key signing approval decisions (nil = unsigned)
+	approverKey ed25519.PrivateKey
+
 	// Hub connection
 	hubAddr       string
 	hubConn       *grpc.ClientConn
@@ -108,6 +112,10 @@ type MobileLogicService struct {
 	approvalHistory   []*pb.ApprovalHistoryEntry
 	approvalHistoryMu sync.RWMutex
 
+	// Informational alerts shown to the user (alertID -> when)
+	shownAlerts   map[string]time.Time
+	shownAlertsMu sync.Mutex
+
 	// Connection streams
 	connStreamsMu sync.RWMutex
 	connStreams   []chan *pb.ConnectionEvent
@@ -216,6 +224,7 @@ func NewMobileLogicService() *MobileLogicService {
 		pendingApprovals:      make(map[string]*pb.ApprovalRequest),
 		pendingDecisions:      make(map[string]bool),
 		approvalHistory:       make([]*pb.ApprovalHistoryEntry, 0),
+		shownAlerts:           make(map[string]time.Time),
 		ctrl:                  ctrl,
 	}
 }
@@ -263,6 +272,15 @@ func (s *MobileLogicService) SetUICallback(cb UICallback) {
 	s.uiCallback = cb
 }
 
+// SetApproverKey sets this device's approver key, replacing the one created
+// with the identity. Approval decisions are signed with it so they count
+// towards quorums on nodes that registered it.
+func (s *MobileLogicService) SetApproverKey(key ed25519.PrivateKey) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.approverKey = key
+}
+
 // RegisterServer registers the service with a gRPC server.
 func (s *MobileLogicService) RegisterServer(server *grpc.Server) {
 	pb.RegisterMobileLogicServiceServer(server, s)
@@ -332,6 +350,7 @@ func (s *MobileLogicService) Initialize(ctx context.Context, req *pb.InitializeR
 			s.identity = id
 			s.identityLock = false
 			s.ctrl.SetIdentity(id)
+			s.loadApproverKeyLocked()
 		} else {
 			s.identityLock = true
 		}
@@ -1490,6 +1509,8 @@ func (s *MobileLogicService) buildIdentityInfo() *pb.IdentityInfo {
 		RootCertPem: string(s.identity.RootCertPEM),
 		CreatedAt:   createdAt,
 		PairedNodes: int32(len(s.nodes)),
+
+		ApproverPublicKeyPem: s.approverPublicKeyPEM(),
 	}
 }
 
@@ -1633,6 +1654,7 @@ func (s *MobileLogicService) initP2PTransportLocked() {
 	// Set handlers
 	t.SetPeerStatusHandler(s.handleP2PPeerStatus)
 	t.SetApprovalRequestHandler(s.handleP2PApprovalRequest)
+	t.SetAlertHandler(s.handleP2PAlert)
 
 	// Register known node keys
 	for nodeID := range s.nodes {
@@ -1690,17 +1712,19 @@ func (s *MobileLogicService) handleP2PPeerStatus(nodeID string, connected bool)
 }
 
 // handleP2PApprovalRequest converts a P2P approval request to a local
-// ApprovalRequest and pushes it through the same approval pipeline as Hub alerts.
+// ApprovalRequest and pushes it through the same approval pipeline as Hub
+// alerts. It keeps the node's request ID, which decisions are sent for and
+// which the Hub's copy of the request carries too.
 func (s *MobileLogicService) handleP2PApprovalRequest(nodeID string, req *p2p.ApprovalRequest) {
-	compositeID := nodeID + ":" + req.RequestID
-
 	approvalReq := &pb.ApprovalRequest{
-		RequestId: compositeID,
+		RequestId: req.RequestID,
 		NodeId:    nodeID,
 		SourceIp:  req.SourceIP,
 		DestAddr:  req.DestAddr,
 		ProxyId:   req.ProxyID,
 		Timestamp: timestamppb.Now(),
+		Context:   req.Context,
+		TlsCn:     req.Context.GetTlsCn(),
 	}
 
 	// Add geo info if available
@@ -1714,3 +1738,25 @@ func (s *MobileLogicService) handleP2PApprovalRequest(nodeID string, req *p2p.Ap
 
 	s.handleApprovalRequest(approvalReq)
 }
+
+// handleP2PAlert handles informational alerts from a node over P2P.
+// Approval resolutions dismiss the pending request; connection and other
+// informational alerts are shown in the UI.
+func (s *MobileLogicService) handleP2PAlert(nodeID string, alert *p2p.Alert) {
+	switch {
+	case alert.Type == config.AlertTypeApprovalResolved:
+		s.dismissApproval(nodeID, alert.AlertID, alert.Fields[config.AlertFieldDecision])
+	case isInfoAlertType(alert.Type) && alert.AlertID != "":
+		s.notifyAlert(newInfoAlert(alert.AlertID, nodeID, alert.Type, alert.Severity, time.Now(), &common.AlertDetails{
+			SourceIp:    alert.SourceIP,
+			Destination: alert.DestAddr,
+			ProxyId:     alert.ProxyID,
+			RuleId:      alert.RuleID,
+			GeoCountry:  alert.GeoCountry,
+			GeoCity:     alert.GeoCity,
+			GeoIsp:      alert.GeoISP,
+			Summary:     alert.Summary,
+			Fields:      alert.Fields,
+		}))
+	}
+}
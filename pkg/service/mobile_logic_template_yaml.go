@@ -245,6 +245,8 @@ func parseActionType(v interface{}, def common.ActionType) common.ActionType {
 		return common.ActionType_ACTION_TYPE_MOCK
 	case "REQUIRE_APPROVAL", "APPROVAL", "2FA":
 		return common.ActionType_ACTION_TYPE_REQUIRE_APPROVAL
+	case "ALLOW_ALERT", "ALERT":
+		return common.ActionType_ACTION_TYPE_ALLOW_ALERT
 	default:
 		return def
 	}
@@ -1,6 +1,7 @@
 package integration
 
 import (
+	"bufio"
 	"fmt"
 	"io"
 	"net"
@@ -9,12 +10,15 @@ import (
 	"path/filepath"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/ivere27/nitella/pkg/api/common"
 	pb "github.com/ivere27/nitella/pkg/api/proxy"
+	"github.com/ivere27/nitella/pkg/config"
 	"github.com/ivere27/nitella/pkg/node"
+	"github.com/ivere27/nitella/pkg/node/accesslog"
 )
 
 var nitelladBinary string
@@ -410,6 +414,136 @@ func TestProcessListener_MockFallback(t *testing.T) {
 	t.Log("Mock Fallback in Child Process Test Passed")
 }
 
+// TestProcessListener_ClonedPreset tests that children serve banners cloned
+// by the parent, both those cloned before the child started and after.
+func TestProcessListener_ClonedPreset(t *testing.T) {
+	setupProcessTest(t)
+
+	// Real SMTP server to clone from
+	var greeting atomic.Value
+	greeting.Store("220 mx1.corp.example ESMTP Postfix\r\n")
+	smtpLn, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Failed to create SMTP server: %v", err)
+	}
+	defer smtpLn.Close()
+	go func() {
+		for {
+			conn, err := smtpLn.Accept()
+			if err != nil {
+				return
+			}
+			conn.Write([]byte(greeting.Load().(string)))
+			bufio.NewReader(conn).ReadString('\n')
+			conn.Close()
+		}
+	}()
+
+	pm := node.NewProxyManagerWithBool(false)
+	if _, err := pm.CloneBanner(&pb.CloneBannerRequest{Name: "corp-mx", Protocol: "smtp", BackendAddr: smtpLn.Addr().String()}); err != nil {
+		t.Fatalf("CloneBanner failed: %v", err)
+	}
+
+	resp, err := pm.CreateProxy(&pb.CreateProxyRequest{
+		Name:          "test-child-cloned",
+		ListenAddr:    "127.0.0.1:0",
+		DefaultAction: common.ActionType_ACTION_TYPE_ALLOW,
+	})
+	if err != nil || !resp.Success {
+		t.Fatalf("CreateProxy failed: %v", err)
+	}
+	proxyID := resp.ProxyId
+	defer pm.DisableProxy(proxyID)
+
+	time.Sleep(500 * time.Millisecond)
+
+	_, err = pm.AddRule(&pb.AddRuleRequest{
+		ProxyId: proxyID,
+		Rule: &pb.Rule{
+			Id:           "cloned-mock",
+			Priority:     100,
+			Enabled:      true,
+			Action:       common.ActionType_ACTION_TYPE_MOCK,
+			MockResponse: &pb.MockConfig{ClonedPreset: "corp-mx"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("AddRule failed: %v", err)
+	}
+
+	status, _ := pm.GetStatus(proxyID)
+	readGreeting := func() string {
+		conn, err := net.DialTimeout("tcp", status.ListenAddr, 3*time.Second)
+		if err != nil {
+			t.Fatalf("Dial failed: %v", err)
+		}
+		defer conn.Close()
+		conn.SetReadDeadline(time.Now().Add(3 * time.Second))
+		line, _ := bufio.NewReader(conn).ReadString('\n')
+		return line
+	}
+
+	if got := readGreeting(); got != greeting.Load().(string) {
+		t.Fatalf("Expected the cloned greeting, got %q", got)
+	}
+
+	// A refreshed clone reaches the running child
+	greeting.Store("220 mx1.corp.example ESMTP Postfix (upgraded)\r\n")
+	if _, err := pm.CloneBanner(&pb.CloneBannerRequest{Name: "corp-mx"}); err != nil {
+		t.Fatalf("Refresh failed: %v", err)
+	}
+	if got := readGreeting(); got != greeting.Load().(string) {
+		t.Fatalf("Expected the refreshed greeting, got %q", got)
+	}
+}
+
+// TestProcessListener_AccessLog tests that children forward access log
+// records to the parent's access log.
+func TestProcessListener_AccessLog(t *testing.T) {
+	setupProcessTest(t)
+
+	path := filepath.Join(t.TempDir(), "access.log")
+	accessLog, err := accesslog.New(config.AccessLogConfig{Path: path})
+	if err != nil {
+		t.Fatalf("Failed to open access log: %v", err)
+	}
+	defer accessLog.Close()
+
+	pm := node.NewProxyManagerWithBool(false)
+	pm.SetAccessLog(accessLog)
+
+	resp, err := pm.CreateProxy(&pb.CreateProxyRequest{
+		Name:          "test-child-access-log",
+		ListenAddr:    "127.0.0.1:0",
+		DefaultAction: common.ActionType_ACTION_TYPE_MOCK,
+		DefaultMock:   common.MockPreset_MOCK_PRESET_HTTP_403,
+	})
+	if err != nil || !resp.Success {
+		t.Fatalf("CreateProxy failed: %v", err)
+	}
+	proxyID := resp.ProxyId
+	defer pm.DisableProxy(proxyID)
+
+	time.Sleep(500 * time.Millisecond)
+
+	status, _ := pm.GetStatus(proxyID)
+	if !testProcessConnection(t, status.ListenAddr, "403") {
+		t.Fatal("Should receive mock 403 response")
+	}
+
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		data, _ := os.ReadFile(path)
+		if strings.Contains(string(data), `"event":"mock"`) && strings.Contains(string(data), proxyID) {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("Expected the child's mock record in the access log, got %q", data)
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+}
+
 // TestProcessListener_ForceKillAndRecover tests killing child process and recovering
 func TestProcessListener_ForceKillAndRecover(t *testing.T) {
 	setupProcessTest(t)